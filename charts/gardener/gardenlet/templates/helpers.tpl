@@ -244,6 +244,12 @@ controllers:
     {{- if .Values.config.controllers.seedCare.conditionThresholds }}
 {{ toYaml .Values.config.controllers.seedCare.conditionThresholds | indent 4 }}
     {{- end }}
+  {{- if .Values.config.controllers.shootCertificates }}
+  shootCertificates:
+    concurrentSyncs: {{ required ".Values.config.controllers.shootCertificates.concurrentSyncs is required" .Values.config.controllers.shootCertificates.concurrentSyncs }}
+    syncPeriod: {{ required ".Values.config.controllers.shootCertificates.syncPeriod is required" .Values.config.controllers.shootCertificates.syncPeriod }}
+    expirationThreshold: {{ required ".Values.config.controllers.shootCertificates.expirationThreshold is required" .Values.config.controllers.shootCertificates.expirationThreshold }}
+  {{- end }}
   {{- if .Values.config.controllers.shootState }}
   shootState:
     concurrentSyncs: {{ required ".Values.config.controllers.shootState.concurrentSyncs is required" .Values.config.controllers.shootState.concurrentSyncs }}
@@ -358,6 +364,22 @@ etcdConfig:
 exposureClassHandlers:
 {{ toYaml .Values.config.exposureClassHandlers }}
 {{- end }}
+{{- if .Values.config.chartCache }}
+chartCache:
+{{ toYaml .Values.config.chartCache | indent 2 }}
+{{- end }}
+{{- if .Values.config.chartVerification }}
+chartVerification:
+{{ toYaml .Values.config.chartVerification | indent 2 }}
+{{- end }}
+{{- if .Values.config.secrets }}
+secrets:
+{{ toYaml .Values.config.secrets | indent 2 }}
+{{- end }}
+{{- if .Values.config.nodeAgent }}
+nodeAgent:
+{{ toYaml .Values.config.nodeAgent | indent 2 }}
+{{- end }}
 {{- if .Values.nodeToleration }}
 nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 2 }}
@@ -760,6 +760,11 @@ func ComputeExpectedGardenletConfiguration(
 					},
 				},
 			},
+			ShootCertificates: &gardenletconfigv1alpha1.ShootCertificatesControllerConfiguration{
+				ConcurrentSyncs:     &five,
+				SyncPeriod:          &metav1.Duration{Duration: time.Hour},
+				ExpirationThreshold: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+			},
 			ShootState: &gardenletconfigv1alpha1.ShootStateControllerConfiguration{
 				ConcurrentSyncs: &five,
 				SyncPeriod:      &metav1.Duration{Duration: 6 * time.Hour},
@@ -859,6 +864,9 @@ func ComputeExpectedGardenletConfiguration(
 			DefaultNotReadyTolerationSeconds:    ptr.To[int64](60),
 			DefaultUnreachableTolerationSeconds: ptr.To[int64](60),
 		},
+		ChartCache: &gardenletconfigv1alpha1.ChartCacheConfiguration{
+			MaxSize: ptr.To(resource.MustParse("128Mi")),
+		},
 	}
 
 	if hasGardenClientConnectionKubeconfig {
@@ -148,6 +148,14 @@ config.yaml: |
   nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 4 }}
   {{- end }}
+  {{- if .Values.config.chartCache }}
+  chartCache:
+{{ toYaml .Values.config.chartCache | indent 4 }}
+  {{- end }}
+  {{- if .Values.config.chartVerification }}
+  chartVerification:
+{{ toYaml .Values.config.chartVerification | indent 4 }}
+  {{- end }}
 {{- end -}}
 
 {{- define "operator.config.name" -}}
@@ -16,7 +16,9 @@ import (
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/discover"
 	initcmd "github.com/gardener/gardener/pkg/gardenadm/cmd/init"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/join"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/reset"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/token"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/version"
 )
 
@@ -67,6 +69,8 @@ func prepareClusterBootstrapGroup(cmd *cobra.Command, opts *cmd.Options) {
 	for _, subcommand := range []*cobra.Command{
 		initcmd.NewCommand(opts),
 		join.NewCommand(opts),
+		reset.NewCommand(opts),
+		upgrade.NewCommand(opts),
 		bootstrap.NewCommand(opts),
 		token.NewCommand(opts),
 	} {
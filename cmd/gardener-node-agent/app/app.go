@@ -7,6 +7,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"os"
@@ -31,6 +32,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	"github.com/gardener/gardener/cmd/utils/initrun"
@@ -113,9 +115,11 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *n
 		return fmt.Errorf("failed getting REST config: %w", err)
 	}
 
-	var extraHandlers map[string]http.Handler
+	healthTracker := gardenerhealthz.NewTracker(clock.RealClock{}, runtimemetrics.Registry)
+
+	extraHandlers := map[string]http.Handler{gardenerhealthz.VerbosePath: healthTracker}
 	if cfg.Debugging != nil && ptr.Deref(cfg.Debugging.EnableProfiling, false) {
-		extraHandlers = routes.ProfilingHandlers
+		maps.Copy(extraHandlers, routes.ProfilingHandlers)
 		if ptr.Deref(cfg.Debugging.EnableContentionProfiling, false) {
 			goruntime.SetBlockProfileRate(1)
 		}
@@ -153,15 +157,18 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *n
 	}
 
 	log.Info("Setting up health check endpoints")
-	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+	if err := mgr.AddHealthzCheck("ping", healthTracker.Healthz("ping", healthz.Ping)); err != nil {
 		return err
 	}
-	if err := mgr.AddHealthzCheck("informer-sync", gardenerhealthz.NewCacheSyncHealthzWithDeadline(mgr.GetLogger(), clock.RealClock{}, mgr.GetCache(), gardenerhealthz.DefaultCacheSyncDeadline)); err != nil {
+	if err := mgr.AddHealthzCheck("informer-sync", healthTracker.Healthz("informer-sync", gardenerhealthz.NewCacheSyncHealthzWithDeadline(mgr.GetLogger(), clock.RealClock{}, mgr.GetCache(), gardenerhealthz.DefaultCacheSyncDeadline))); err != nil {
 		return err
 	}
-	if err := mgr.AddReadyzCheck("informer-sync", gardenerhealthz.NewCacheSyncHealthz(mgr.GetCache())); err != nil {
+	if err := mgr.AddReadyzCheck("informer-sync", healthTracker.Readyz("informer-sync", gardenerhealthz.NewCacheSyncHealthz(mgr.GetCache()))); err != nil {
 		return err
 	}
+	if err := mgr.Add(healthTracker); err != nil {
+		return fmt.Errorf("failed adding health tracker to manager: %w", err)
+	}
 
 	log.Info("Creating directory for temporary files", "path", nodeagentconfigv1alpha1.TempDir)
 	if err := fs.MkdirAll(nodeagentconfigv1alpha1.TempDir, os.ModeDir); err != nil {
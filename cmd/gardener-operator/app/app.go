@@ -7,6 +7,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"os"
@@ -25,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	controllerwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
@@ -43,6 +45,7 @@ import (
 	operatorclient "github.com/gardener/gardener/pkg/operator/client"
 	"github.com/gardener/gardener/pkg/operator/controller"
 	"github.com/gardener/gardener/pkg/operator/webhook"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // Name is a const for the name of this component.
@@ -76,6 +79,29 @@ func NewCommand() *cobra.Command {
 func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *operatorconfigv1alpha1.OperatorConfiguration) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
+	if cfg.ChartCache != nil {
+		cacheOpts := oci.CacheOptions{MaxBytes: cfg.ChartCache.MaxSize.Value()}
+		if cfg.ChartCache.Directory != nil {
+			cacheOpts.Directory = *cfg.ChartCache.Directory
+		}
+		if cfg.ChartCache.DirectoryMaxSize != nil {
+			cacheOpts.DirectoryMaxBytes = cfg.ChartCache.DirectoryMaxSize.Value()
+		}
+		if err := oci.ConfigureDefaultCache(cacheOpts); err != nil {
+			return fmt.Errorf("failed configuring cache for Helm charts: %w", err)
+		}
+	}
+
+	if cfg.ChartVerification != nil {
+		var publicKeys [][]byte
+		for _, publicKey := range cfg.ChartVerification.PublicKeys {
+			publicKeys = append(publicKeys, []byte(publicKey))
+		}
+		if err := oci.ConfigureSignatureVerification(publicKeys...); err != nil {
+			return fmt.Errorf("failed configuring signature verification for Helm charts: %w", err)
+		}
+	}
+
 	log.Info("Getting rest config")
 	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
 		cfg.RuntimeClientConnection.Kubeconfig = kubeconfig
@@ -86,9 +112,11 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *o
 		return err
 	}
 
-	var extraHandlers map[string]http.Handler
+	healthTracker := gardenerhealthz.NewTracker(clock.RealClock{}, runtimemetrics.Registry)
+
+	extraHandlers := map[string]http.Handler{gardenerhealthz.VerbosePath: healthTracker}
 	if cfg.Debugging != nil && ptr.Deref(cfg.Debugging.EnableProfiling, false) {
-		extraHandlers = routes.ProfilingHandlers
+		maps.Copy(extraHandlers, routes.ProfilingHandlers)
 		if ptr.Deref(cfg.Debugging.EnableContentionProfiling, false) {
 			goruntime.SetBlockProfileRate(1)
 		}
@@ -126,18 +154,21 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *o
 	}
 
 	log.Info("Setting up health check endpoints")
-	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+	if err := mgr.AddHealthzCheck("ping", healthTracker.Healthz("ping", healthz.Ping)); err != nil {
 		return err
 	}
-	if err := mgr.AddHealthzCheck("informer-sync", gardenerhealthz.NewCacheSyncHealthzWithDeadline(mgr.GetLogger(), clock.RealClock{}, mgr.GetCache(), gardenerhealthz.DefaultCacheSyncDeadline)); err != nil {
+	if err := mgr.AddHealthzCheck("informer-sync", healthTracker.Healthz("informer-sync", gardenerhealthz.NewCacheSyncHealthzWithDeadline(mgr.GetLogger(), clock.RealClock{}, mgr.GetCache(), gardenerhealthz.DefaultCacheSyncDeadline))); err != nil {
 		return err
 	}
-	if err := mgr.AddReadyzCheck("informer-sync", gardenerhealthz.NewCacheSyncHealthz(mgr.GetCache())); err != nil {
+	if err := mgr.AddReadyzCheck("informer-sync", healthTracker.Readyz("informer-sync", gardenerhealthz.NewCacheSyncHealthz(mgr.GetCache()))); err != nil {
 		return err
 	}
-	if err := mgr.AddReadyzCheck("webhook-server", mgr.GetWebhookServer().StartedChecker()); err != nil {
+	if err := mgr.AddReadyzCheck("webhook-server", healthTracker.Readyz("webhook-server", mgr.GetWebhookServer().StartedChecker())); err != nil {
 		return err
 	}
+	if err := mgr.Add(healthTracker); err != nil {
+		return fmt.Errorf("failed adding health tracker to manager: %w", err)
+	}
 
 	log.Info("Perform Gardener version verification")
 	if err := bootstrappers.VerifyGardenerVersion(ctx, mgr.GetLogger(), mgr.GetAPIReader()); err != nil {
@@ -7,6 +7,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"os"
@@ -30,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	controllerwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
@@ -103,9 +105,11 @@ func run(ctx context.Context, log logr.Logger, cfg *resourcemanagerconfigv1alpha
 		managerScheme = resourcemanagerclient.SourceScheme
 	}
 
-	var extraHandlers map[string]http.Handler
+	healthTracker := gardenerhealthz.NewTracker(clock.RealClock{}, runtimemetrics.Registry)
+
+	extraHandlers := map[string]http.Handler{gardenerhealthz.VerbosePath: healthTracker}
 	if cfg.Debugging != nil && ptr.Deref(cfg.Debugging.EnableProfiling, false) {
-		extraHandlers = routes.ProfilingHandlers
+		maps.Copy(extraHandlers, routes.ProfilingHandlers)
 		if ptr.Deref(cfg.Debugging.EnableContentionProfiling, false) {
 			goruntime.SetBlockProfileRate(1)
 		}
@@ -151,21 +155,24 @@ func run(ctx context.Context, log logr.Logger, cfg *resourcemanagerconfigv1alpha
 		return fmt.Errorf("could not create clientset for source cluster: %+v", err)
 	}
 
-	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+	if err := mgr.AddHealthzCheck("ping", healthTracker.Healthz("ping", healthz.Ping)); err != nil {
 		return err
 	}
-	if err := mgr.AddHealthzCheck("apiserver-healthz", gardenerhealthz.NewAPIServerHealthz(ctx, sourceClientSet.RESTClient())); err != nil {
+	if err := mgr.AddHealthzCheck("apiserver-healthz", healthTracker.Healthz("apiserver-healthz", gardenerhealthz.NewAPIServerHealthz(ctx, sourceClientSet.RESTClient()))); err != nil {
 		return err
 	}
-	if err := mgr.AddHealthzCheck("source-informer-sync", gardenerhealthz.NewCacheSyncHealthzWithDeadline(mgr.GetLogger(), clock.RealClock{}, mgr.GetCache(), gardenerhealthz.DefaultCacheSyncDeadline)); err != nil {
+	if err := mgr.AddHealthzCheck("source-informer-sync", healthTracker.Healthz("source-informer-sync", gardenerhealthz.NewCacheSyncHealthzWithDeadline(mgr.GetLogger(), clock.RealClock{}, mgr.GetCache(), gardenerhealthz.DefaultCacheSyncDeadline))); err != nil {
 		return err
 	}
-	if err := mgr.AddReadyzCheck("source-informer-sync", gardenerhealthz.NewCacheSyncHealthz(mgr.GetCache())); err != nil {
+	if err := mgr.AddReadyzCheck("source-informer-sync", healthTracker.Readyz("source-informer-sync", gardenerhealthz.NewCacheSyncHealthz(mgr.GetCache()))); err != nil {
 		return err
 	}
-	if err := mgr.AddReadyzCheck("webhook-server", mgr.GetWebhookServer().StartedChecker()); err != nil {
+	if err := mgr.AddReadyzCheck("webhook-server", healthTracker.Readyz("webhook-server", mgr.GetWebhookServer().StartedChecker())); err != nil {
 		return err
 	}
+	if err := mgr.Add(healthTracker); err != nil {
+		return fmt.Errorf("failed adding health tracker to manager: %w", err)
+	}
 
 	var targetCluster cluster.Cluster = mgr
 	if targetRESTConfig != nil {
@@ -209,10 +216,10 @@ func run(ctx context.Context, log logr.Logger, cfg *resourcemanagerconfigv1alpha
 		}
 
 		log.Info("Setting up checks for target informer sync")
-		if err := mgr.AddHealthzCheck("target-informer-sync", gardenerhealthz.NewCacheSyncHealthzWithDeadline(mgr.GetLogger(), clock.RealClock{}, targetCluster.GetCache(), gardenerhealthz.DefaultCacheSyncDeadline)); err != nil {
+		if err := mgr.AddHealthzCheck("target-informer-sync", healthTracker.Healthz("target-informer-sync", gardenerhealthz.NewCacheSyncHealthzWithDeadline(mgr.GetLogger(), clock.RealClock{}, targetCluster.GetCache(), gardenerhealthz.DefaultCacheSyncDeadline))); err != nil {
 			return err
 		}
-		if err := mgr.AddReadyzCheck("target-informer-sync", gardenerhealthz.NewCacheSyncHealthz(targetCluster.GetCache())); err != nil {
+		if err := mgr.AddReadyzCheck("target-informer-sync", healthTracker.Readyz("target-informer-sync", gardenerhealthz.NewCacheSyncHealthz(targetCluster.GetCache()))); err != nil {
 			return err
 		}
 
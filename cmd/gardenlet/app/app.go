@@ -7,6 +7,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"os"
@@ -30,6 +31,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	kubernetesclientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/component-base/version/verflag"
 	"k8s.io/utils/clock"
@@ -40,6 +42,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	"github.com/gardener/gardener/cmd/utils/initrun"
@@ -68,7 +71,9 @@ import (
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
+	"github.com/gardener/gardener/pkg/utils/oci"
 	"github.com/gardener/gardener/pkg/utils/retry"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // Name is a const for the name of this component.
@@ -102,6 +107,29 @@ func NewCommand() *cobra.Command {
 func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *gardenletconfigv1alpha1.GardenletConfiguration) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
+	if cfg.ChartCache != nil {
+		cacheOpts := oci.CacheOptions{MaxBytes: cfg.ChartCache.MaxSize.Value()}
+		if cfg.ChartCache.Directory != nil {
+			cacheOpts.Directory = *cfg.ChartCache.Directory
+		}
+		if cfg.ChartCache.DirectoryMaxSize != nil {
+			cacheOpts.DirectoryMaxBytes = cfg.ChartCache.DirectoryMaxSize.Value()
+		}
+		if err := oci.ConfigureDefaultCache(cacheOpts); err != nil {
+			return fmt.Errorf("failed configuring cache for Helm charts: %w", err)
+		}
+	}
+
+	if cfg.ChartVerification != nil {
+		var publicKeys [][]byte
+		for _, publicKey := range cfg.ChartVerification.PublicKeys {
+			publicKeys = append(publicKeys, []byte(publicKey))
+		}
+		if err := oci.ConfigureSignatureVerification(publicKeys...); err != nil {
+			return fmt.Errorf("failed configuring signature verification for Helm charts: %w", err)
+		}
+	}
+
 	if kubeconfig := os.Getenv("GARDEN_KUBECONFIG"); kubeconfig != "" {
 		cfg.GardenClientConnection.Kubeconfig = kubeconfig
 	}
@@ -109,15 +137,28 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *g
 		cfg.SeedClientConnection.Kubeconfig = kubeconfig
 	}
 
+	shutdownTracerProvider, err := tracing.SetupGlobalTracerProvider(ctx, "gardenlet")
+	if err != nil {
+		return fmt.Errorf("failed setting up tracing: %w", err)
+	}
+	defer func() {
+		if err := shutdownTracerProvider(context.Background()); err != nil {
+			log.Error(err, "Failed shutting down tracer provider")
+		}
+	}()
+
 	log.Info("Getting rest config for runtime cluster")
 	runtimeRESTConfig, err := kubernetes.RESTConfigFromClientConnectionConfiguration(&cfg.SeedClientConnection.ClientConnectionConfiguration, nil)
 	if err != nil {
 		return err
 	}
 
-	var extraHandlers map[string]http.Handler
+	healthTracker := gardenerhealthz.NewTracker(clock.RealClock{}, runtimemetrics.Registry)
+
+	extraHandlers := map[string]http.Handler{gardenerhealthz.VerbosePath: healthTracker}
 	if cfg.Debugging != nil && ptr.Deref(cfg.Debugging.EnableProfiling, false) {
-		extraHandlers = routes.ProfilingHandlers
+		maps.Copy(extraHandlers, routes.ProfilingHandlers)
+		extraHandlers[flow.DebugHandlerPath] = flow.NewDebugHandler()
 		if ptr.Deref(cfg.Debugging.EnableContentionProfiling, false) {
 			goruntime.SetBlockProfileRate(1)
 		}
@@ -163,19 +204,34 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *g
 	healthManager := gardenerhealthz.NewPeriodicHealthz(clock.RealClock{}, healthGracePeriod)
 	healthManager.Set(true)
 
+	runtimeClientSet, err := kubernetesclientset.NewForConfig(runtimeRESTConfig)
+	if err != nil {
+		return fmt.Errorf("failed creating runtime clientset: %w", err)
+	}
+	gardenAPIServerHealthz := &gardenerhealthz.DelegatingChecker{}
+
 	log.Info("Setting up health check endpoints")
-	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+	if err := mgr.AddHealthzCheck("ping", healthTracker.Healthz("ping", healthz.Ping)); err != nil {
+		return err
+	}
+	if err := mgr.AddHealthzCheck("runtime-informer-sync", healthTracker.Healthz("runtime-informer-sync", gardenerhealthz.NewCacheSyncHealthzWithDeadline(mgr.GetLogger(), clock.RealClock{}, mgr.GetCache(), gardenerhealthz.DefaultCacheSyncDeadline))); err != nil {
 		return err
 	}
-	if err := mgr.AddHealthzCheck("runtime-informer-sync", gardenerhealthz.NewCacheSyncHealthzWithDeadline(mgr.GetLogger(), clock.RealClock{}, mgr.GetCache(), gardenerhealthz.DefaultCacheSyncDeadline)); err != nil {
+	if err := mgr.AddReadyzCheck("runtime-informer-sync", healthTracker.Readyz("runtime-informer-sync", gardenerhealthz.NewCacheSyncHealthz(mgr.GetCache()))); err != nil {
 		return err
 	}
-	if err := mgr.AddReadyzCheck("runtime-informer-sync", gardenerhealthz.NewCacheSyncHealthz(mgr.GetCache())); err != nil {
+	if err := mgr.AddHealthzCheck("periodic-health", healthTracker.Healthz("periodic-health", gardenerhealthz.CheckerFunc(healthManager))); err != nil {
 		return err
 	}
-	if err := mgr.AddHealthzCheck("periodic-health", gardenerhealthz.CheckerFunc(healthManager)); err != nil {
+	if err := mgr.AddReadyzCheck("seed-apiserver", healthTracker.Readyz("seed-apiserver", gardenerhealthz.NewAPIServerHealthz(ctx, runtimeClientSet.RESTClient()))); err != nil {
 		return err
 	}
+	if err := mgr.AddReadyzCheck("garden-apiserver", healthTracker.Readyz("garden-apiserver", gardenAPIServerHealthz.Check)); err != nil {
+		return err
+	}
+	if err := mgr.Add(healthTracker); err != nil {
+		return fmt.Errorf("failed adding health tracker to manager: %w", err)
+	}
 
 	var selfHostedShootMeta *types.NamespacedName
 	if gardenlet.IsResponsibleForSelfHostedShoot() {
@@ -208,6 +264,7 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *g
 					config:                    cfg,
 					selfHostedShootMeta:       selfHostedShootMeta,
 					healthManager:             healthManager,
+					gardenAPIServerHealthz:    gardenAPIServerHealthz,
 					kubeconfigBootstrapResult: kubeconfigBootstrapResult,
 				},
 			},
@@ -237,6 +294,7 @@ type garden struct {
 	config                    *gardenletconfigv1alpha1.GardenletConfiguration
 	selfHostedShootMeta       *types.NamespacedName
 	healthManager             gardenerhealthz.Manager
+	gardenAPIServerHealthz    *gardenerhealthz.DelegatingChecker
 	kubeconfigBootstrapResult *bootstrappers.KubeconfigBootstrapResult
 }
 
@@ -413,6 +471,12 @@ func (g *garden) Start(ctx context.Context) error {
 		return fmt.Errorf("failed adding garden cluster to manager: %w", err)
 	}
 
+	gardenClientSet, err := kubernetesclientset.NewForConfig(gardenRESTConfig)
+	if err != nil {
+		return fmt.Errorf("failed creating garden clientset: %w", err)
+	}
+	g.gardenAPIServerHealthz.Set(gardenerhealthz.NewAPIServerHealthz(ctx, gardenClientSet.RESTClient()))
+
 	waitForSyncCtx, waitForSyncCancel := context.WithTimeout(ctx, 5*time.Second)
 	defer waitForSyncCancel()
 
@@ -9,6 +9,9 @@ import (
 	"fmt"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -25,8 +28,11 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
+const tracerName = "github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
+
 type reconciler struct {
 	actuator        Actuator
 	configValidator ConfigValidator
@@ -66,6 +72,30 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
 	}
 
+	if v1beta1helper.HasOperationAnnotation(infrastructure.Annotations) {
+		// The operation annotation is still present for restore and migrate operations. For reconcile operations, the trace
+		// context has already been extracted by the operation annotation wrapper.
+		ctx = tracing.ExtractFromObject(ctx, infrastructure)
+	}
+
+	if trace.SpanContextFromContext(ctx).IsRemote() {
+		// Continue the trace of the gardenlet task which requested the operation so that the spans of the actuator (e.g.,
+		// of the flows it runs) are part of the trace of the shoot operation.
+		var span trace.Span
+		ctx, span = otel.Tracer(tracerName).Start(ctx, "Infrastructure reconciliation",
+			trace.WithAttributes(attribute.String("namespace", infrastructure.Namespace), attribute.String("name", infrastructure.Name)),
+		)
+		defer span.End()
+
+		// The trace context belongs to the requested operation only, hence it is removed once this reconciliation is
+		// finished. Later reconciliations (e.g., periodic ones) must not be added to the trace.
+		defer func() {
+			if err := extensionscontroller.RemoveAnnotation(ctx, r.client, infrastructure, v1beta1constants.GardenerTraceParent); client.IgnoreNotFound(err) != nil {
+				log.Error(err, "Failed removing trace context annotation")
+			}
+		}()
+	}
+
 	cluster, err := extensionscontroller.GetCluster(ctx, r.client, infrastructure.Namespace)
 	if err != nil {
 		return reconcile.Result{}, err
@@ -14,6 +14,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -336,6 +337,76 @@ var _ = Describe("Worker Reconcile", func() {
 			wantErr: true,
 		}),
 	)
+
+	Describe("tracing", func() {
+		const traceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+		var (
+			traceID    trace.TraceID
+			fakeClient client.Client
+			actuator   *extensionsmockworker.MockActuator
+		)
+
+		BeforeEach(func() {
+			var err error
+			traceID, err = trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+			Expect(err).NotTo(HaveOccurred())
+
+			actuator = extensionsmockworker.NewMockActuator(ctrl)
+		})
+
+		JustBeforeEach(func() {
+			mgr.EXPECT().GetClient().Return(fakeClient).AnyTimes()
+			mgr.EXPECT().GetAPIReader().Return(mockclient.NewMockReader(ctrl)).AnyTimes()
+		})
+
+		expectReconcileWithTraceID := func(expectedTraceID trace.TraceID) {
+			actuator.EXPECT().Reconcile(gomock.Any(), gomock.AssignableToTypeOf(logr.Logger{}), gomock.AssignableToTypeOf(&extensionsv1alpha1.Worker{}), gomock.AssignableToTypeOf(&extensionscontroller.Cluster{})).
+				DoAndReturn(func(ctx context.Context, _ logr.Logger, _ *extensionsv1alpha1.Worker, _ *extensionscontroller.Cluster) error {
+					Expect(trace.SpanContextFromContext(ctx).TraceID()).To(Equal(expectedTraceID))
+					return nil
+				})
+		}
+
+		Context("trace context requested together with the operation annotation", func() {
+			BeforeEach(func() {
+				w := addOperationAnnotationToWorker(getWorker(), v1beta1constants.GardenerOperationReconcile)
+				w.Annotations[v1beta1constants.GardenerTraceParent] = traceParent
+				fakeClient = fake.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(w, getCluster()).WithStatusSubresource(&extensionsv1alpha1.Worker{}).Build()
+			})
+
+			It("should continue the trace and remove it after the reconciliation", func() {
+				expectReconcileWithTraceID(traceID)
+				Expect(worker.NewReconciler(mgr, actuator).Reconcile(ctx, arguments.request)).To(Equal(reconcile.Result{}))
+
+				w := &extensionsv1alpha1.Worker{}
+				Expect(fakeClient.Get(ctx, arguments.request.NamespacedName, w)).To(Succeed())
+				Expect(w.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+				Expect(w.Annotations).NotTo(HaveKey(v1beta1constants.GardenerTraceParent))
+			})
+
+			It("should not continue the trace in a reconciliation after the trace is done", func() {
+				expectReconcileWithTraceID(traceID)
+				Expect(worker.NewReconciler(mgr, actuator).Reconcile(ctx, arguments.request)).To(Equal(reconcile.Result{}))
+
+				expectReconcileWithTraceID(trace.TraceID{})
+				Expect(worker.NewReconciler(mgr, actuator).Reconcile(ctx, arguments.request)).To(Equal(reconcile.Result{}))
+			})
+		})
+
+		Context("trace context without operation annotation", func() {
+			BeforeEach(func() {
+				w := getWorker()
+				w.Annotations = map[string]string{v1beta1constants.GardenerTraceParent: traceParent}
+				fakeClient = fake.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(w, getCluster()).WithStatusSubresource(&extensionsv1alpha1.Worker{}).Build()
+			})
+
+			It("should not continue the trace", func() {
+				expectReconcileWithTraceID(trace.TraceID{})
+				Expect(worker.NewReconciler(mgr, actuator).Reconcile(ctx, arguments.request)).To(Equal(reconcile.Result{}))
+			})
+		})
+	})
 })
 
 func getWorker() *extensionsv1alpha1.Worker {
@@ -20,6 +20,8 @@ type Quota struct {
 
 	// Spec defines the Quota constraints.
 	Spec QuotaSpec
+	// Status contains the current usage of the Quota.
+	Status QuotaStatus
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -44,6 +46,27 @@ type QuotaSpec struct {
 	Scope corev1.ObjectReference
 }
 
+// QuotaStatus is the status of a Quota.
+type QuotaStatus struct {
+	// ObservedGeneration is the most recent generation observed for this Quota.
+	ObservedGeneration int64
+	// Hard is the set of enforced constraints, i.e., the metrics of the Quota spec.
+	Hard corev1.ResourceList
+	// Used is the amount of resources currently allocated by all Shoots referring to the Quota. For Quotas with scope
+	// 'project', the usage is enforced per project, see Projects.
+	Used corev1.ResourceList
+	// Projects contains the usage per project namespace. It is only maintained for Quotas with scope 'project'.
+	Projects []QuotaProjectStatus
+}
+
+// QuotaProjectStatus contains the usage of a Quota in a project namespace.
+type QuotaProjectStatus struct {
+	// Namespace is the namespace of the project.
+	Namespace string
+	// Used is the amount of resources currently allocated by the Shoots in this project namespace.
+	Used corev1.ResourceList
+}
+
 const (
 	// QuotaMetricCPU is the constraint for the amount of CPUs
 	QuotaMetricCPU corev1.ResourceName = corev1.ResourceCPU
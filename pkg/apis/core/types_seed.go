@@ -99,6 +99,9 @@ type SeedStatus struct {
 	ClientCertificateExpirationTimestamp *metav1.Time
 	// LastOperation holds information about the last operation on the Seed.
 	LastOperation *LastOperation
+	// Usage represents the resources of a seed that are used by shoot control planes, i.e., the aggregated resource
+	// requests of the pods in the shoot namespaces.
+	Usage corev1.ResourceList
 }
 
 // Backup contains the object store configuration for backups for shoot (currently only etcd).
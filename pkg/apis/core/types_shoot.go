@@ -222,6 +222,20 @@ type ShootCredentials struct {
 	Rotation *ShootCredentialsRotation
 	// EncryptionAtRest contains information about Shoot data encryption at rest.
 	EncryptionAtRest *EncryptionAtRest
+	// Certificates contains a summary of the certificates managed for the Shoot cluster.
+	Certificates *ShootCertificatesSummary
+}
+
+// ShootCertificatesSummary contains a summary of the certificates managed for the Shoot cluster.
+type ShootCertificatesSummary struct {
+	// Total is the number of certificates managed for the Shoot cluster.
+	Total int32
+	// EarliestExpirationTime is the expiration time of the certificate which expires first.
+	EarliestExpirationTime *metav1.Time
+	// Expiring contains the names of the certificates which expire soon while no rotation is scheduled for them.
+	Expiring []string
+	// LastUpdateTime is the time when the summary was last updated.
+	LastUpdateTime *metav1.Time
 }
 
 // ShootCredentialsRotation contains information about the rotation of credentials.
@@ -1255,6 +1269,13 @@ type MaintenanceTimeWindow struct {
 	// End is the end of the time window in the format HHMMSS+ZONE, e.g. "220000+0100".
 	// If not present, the value will be computed based on the "Begin" value.
 	End string
+	// Location is the IANA time zone name in which the time window is evaluated, e.g. "Europe/Berlin". If set, Begin and
+	// End are wall clock times in this location and must use the zone offset "+0000". The time window then follows the
+	// daylight saving time transitions of the location.
+	Location *string
+	// Weekdays are the days of the week on which the time window begins, e.g. ["Tue", "Thu"]. Valid values are "Mon",
+	// "Tue", "Wed", "Thu", "Fri", "Sat", and "Sun". If not set, the time window begins on every day.
+	Weekdays []string
 }
 
 // Monitoring contains information about the monitoring configuration for the shoot.
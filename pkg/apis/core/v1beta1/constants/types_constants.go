@@ -223,6 +223,9 @@ const (
 	// GardenerTimestamp is a constant for an annotation on a resource that describes the timestamp when a reconciliation has been requested.
 	// It is only used to guarantee an update event for watching clients in case the operation-annotation is already present.
 	GardenerTimestamp = "gardener.cloud/timestamp"
+	// GardenerTraceParent is a constant for an annotation on a resource that carries the W3C trace context of the
+	// operation which requested the reconciliation. Controllers can use it to continue the same trace.
+	GardenerTraceParent = "gardener.cloud/traceparent"
 	// GardenerOperationMigrate is a constant for the value of the operation annotation describing a migration
 	// operation.
 	GardenerOperationMigrate = "migrate"
@@ -663,6 +666,12 @@ const (
 	EvictionRequirementNever = "never"
 	// AnnotationShootMaintenanceWindow is a constant for an annotation key used on VPA objects to hold the Shoot's maintenance window start and end.
 	AnnotationShootMaintenanceWindow = "shoot.gardener.cloud/maintenance-window"
+	// AnnotationShootMaintenanceWindowLocation is a constant for an annotation key used on VPA objects to hold the
+	// location of the Shoot's maintenance window.
+	AnnotationShootMaintenanceWindowLocation = "shoot.gardener.cloud/maintenance-window-location"
+	// AnnotationShootMaintenanceWindowWeekdays is a constant for an annotation key used on VPA objects to hold the
+	// comma-separated weekdays on which the Shoot's maintenance window begins.
+	AnnotationShootMaintenanceWindowWeekdays = "shoot.gardener.cloud/maintenance-window-weekdays"
 
 	// GardenNamespace is the namespace in which the configuration and secrets for
 	// the Gardener controller manager will be stored (e.g., secrets for the Seed clusters).
@@ -688,6 +697,9 @@ const (
 	// DataTypeMachineState is a constant for a value of the 'Type' field in 'GardenerResourceData' structs describing
 	// that the data is machine state.
 	DataTypeMachineState = "machine-state"
+	// DataTypeFlowState is a constant for a value of the 'Type' field in 'GardenerResourceData' structs describing
+	// that the data is the task state of an interrupted or failed flow execution.
+	DataTypeFlowState = "flow-state"
 
 	// DefaultSchedulerName is the name of the default scheduler.
 	DefaultSchedulerName = "default-scheduler"
@@ -1053,6 +1065,17 @@ const (
 	// should wait with reconciliation of the operating system config (to prevent too many node-agents from restarting
 	// kubelet or other critical units at the same time).
 	AnnotationNodeAgentReconciliationDelay = "node-agent.gardener.cloud/reconciliation-delay"
+	// AnnotationNodeAgentRolloutMaxUnavailable is the annotation key on operating system config secrets for specifying
+	// how many nodes of the worker pool may apply a new operating system config at the same time (absolute number or
+	// percentage). If set, gardener-node-agent waits for the permission to apply a new operating system config.
+	AnnotationNodeAgentRolloutMaxUnavailable = "node-agent.gardener.cloud/rollout-max-unavailable"
+	// AnnotationNodeAgentRolloutCoordinatedExternally is the annotation key on operating system config secrets for
+	// specifying that the staged rollout is coordinated by another component (e.g., `gardenadm upgrade apply`), i.e.,
+	// gardener-resource-manager does not permit any nodes to apply the operating system config.
+	AnnotationNodeAgentRolloutCoordinatedExternally = "node-agent.gardener.cloud/rollout-coordinated-externally"
+	// AnnotationNodeAgentRolloutPermittedChecksum is the annotation key on nodes for specifying the checksum of the
+	// operating system config which the gardener-node-agent is permitted to apply.
+	AnnotationNodeAgentRolloutPermittedChecksum = "node-agent.gardener.cloud/rollout-permitted-checksum"
 	// NodeAgentsGroup is the identity group for gardener-node-agents when authenticating to the API server.
 	NodeAgentsGroup = "gardener.cloud:node-agents"
 	// NodeAgentUserNamePrefix is the identity username prefix for gardener-node-agent when authenticating to the API server.
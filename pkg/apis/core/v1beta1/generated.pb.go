@@ -3963,10 +3963,38 @@ func (m *QuotaList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuotaList proto.InternalMessageInfo
 
+func (m *QuotaProjectStatus) Reset()      { *m = QuotaProjectStatus{} }
+func (*QuotaProjectStatus) ProtoMessage() {}
+func (*QuotaProjectStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
+}
+func (m *QuotaProjectStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaProjectStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuotaProjectStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaProjectStatus.Merge(m, src)
+}
+func (m *QuotaProjectStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaProjectStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaProjectStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaProjectStatus proto.InternalMessageInfo
+
 func (m *QuotaSpec) Reset()      { *m = QuotaSpec{} }
 func (*QuotaSpec) ProtoMessage() {}
 func (*QuotaSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
 }
 func (m *QuotaSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3991,10 +4019,38 @@ func (m *QuotaSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuotaSpec proto.InternalMessageInfo
 
+func (m *QuotaStatus) Reset()      { *m = QuotaStatus{} }
+func (*QuotaStatus) ProtoMessage() {}
+func (*QuotaStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
+}
+func (m *QuotaStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuotaStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaStatus.Merge(m, src)
+}
+func (m *QuotaStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaStatus proto.InternalMessageInfo
+
 func (m *Region) Reset()      { *m = Region{} }
 func (*Region) ProtoMessage() {}
 func (*Region) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
 }
 func (m *Region) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4022,7 +4078,7 @@ var xxx_messageInfo_Region proto.InternalMessageInfo
 func (m *ResourceData) Reset()      { *m = ResourceData{} }
 func (*ResourceData) ProtoMessage() {}
 func (*ResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
 }
 func (m *ResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4050,7 +4106,7 @@ var xxx_messageInfo_ResourceData proto.InternalMessageInfo
 func (m *ResourceWatchCacheSize) Reset()      { *m = ResourceWatchCacheSize{} }
 func (*ResourceWatchCacheSize) ProtoMessage() {}
 func (*ResourceWatchCacheSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
 }
 func (m *ResourceWatchCacheSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4078,7 +4134,7 @@ var xxx_messageInfo_ResourceWatchCacheSize proto.InternalMessageInfo
 func (m *SSHAccess) Reset()      { *m = SSHAccess{} }
 func (*SSHAccess) ProtoMessage() {}
 func (*SSHAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
 }
 func (m *SSHAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4106,7 +4162,7 @@ var xxx_messageInfo_SSHAccess proto.InternalMessageInfo
 func (m *SecretBinding) Reset()      { *m = SecretBinding{} }
 func (*SecretBinding) ProtoMessage() {}
 func (*SecretBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
 }
 func (m *SecretBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4134,7 +4190,7 @@ var xxx_messageInfo_SecretBinding proto.InternalMessageInfo
 func (m *SecretBindingList) Reset()      { *m = SecretBindingList{} }
 func (*SecretBindingList) ProtoMessage() {}
 func (*SecretBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
 }
 func (m *SecretBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4162,7 +4218,7 @@ var xxx_messageInfo_SecretBindingList proto.InternalMessageInfo
 func (m *SecretBindingProvider) Reset()      { *m = SecretBindingProvider{} }
 func (*SecretBindingProvider) ProtoMessage() {}
 func (*SecretBindingProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
 }
 func (m *SecretBindingProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4190,7 +4246,7 @@ var xxx_messageInfo_SecretBindingProvider proto.InternalMessageInfo
 func (m *Seed) Reset()      { *m = Seed{} }
 func (*Seed) ProtoMessage() {}
 func (*Seed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
 }
 func (m *Seed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4218,7 +4274,7 @@ var xxx_messageInfo_Seed proto.InternalMessageInfo
 func (m *SeedDNS) Reset()      { *m = SeedDNS{} }
 func (*SeedDNS) ProtoMessage() {}
 func (*SeedDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
 }
 func (m *SeedDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4246,7 +4302,7 @@ var xxx_messageInfo_SeedDNS proto.InternalMessageInfo
 func (m *SeedDNSProvider) Reset()      { *m = SeedDNSProvider{} }
 func (*SeedDNSProvider) ProtoMessage() {}
 func (*SeedDNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
 }
 func (m *SeedDNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4274,7 +4330,7 @@ var xxx_messageInfo_SeedDNSProvider proto.InternalMessageInfo
 func (m *SeedDNSProviderConfig) Reset()      { *m = SeedDNSProviderConfig{} }
 func (*SeedDNSProviderConfig) ProtoMessage() {}
 func (*SeedDNSProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
 }
 func (m *SeedDNSProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4302,7 +4358,7 @@ var xxx_messageInfo_SeedDNSProviderConfig proto.InternalMessageInfo
 func (m *SeedList) Reset()      { *m = SeedList{} }
 func (*SeedList) ProtoMessage() {}
 func (*SeedList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
 }
 func (m *SeedList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4330,7 +4386,7 @@ var xxx_messageInfo_SeedList proto.InternalMessageInfo
 func (m *SeedNetworks) Reset()      { *m = SeedNetworks{} }
 func (*SeedNetworks) ProtoMessage() {}
 func (*SeedNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
 }
 func (m *SeedNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4358,7 +4414,7 @@ var xxx_messageInfo_SeedNetworks proto.InternalMessageInfo
 func (m *SeedProvider) Reset()      { *m = SeedProvider{} }
 func (*SeedProvider) ProtoMessage() {}
 func (*SeedProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
 }
 func (m *SeedProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4386,7 +4442,7 @@ var xxx_messageInfo_SeedProvider proto.InternalMessageInfo
 func (m *SeedSelector) Reset()      { *m = SeedSelector{} }
 func (*SeedSelector) ProtoMessage() {}
 func (*SeedSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
 }
 func (m *SeedSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4414,7 +4470,7 @@ var xxx_messageInfo_SeedSelector proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdog) Reset()      { *m = SeedSettingDependencyWatchdog{} }
 func (*SeedSettingDependencyWatchdog) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
 }
 func (m *SeedSettingDependencyWatchdog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4442,7 +4498,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdog proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdogProber) Reset()      { *m = SeedSettingDependencyWatchdogProber{} }
 func (*SeedSettingDependencyWatchdogProber) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogProber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
 }
 func (m *SeedSettingDependencyWatchdogProber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4470,7 +4526,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogProber proto.InternalMessageInf
 func (m *SeedSettingDependencyWatchdogWeeder) Reset()      { *m = SeedSettingDependencyWatchdogWeeder{} }
 func (*SeedSettingDependencyWatchdogWeeder) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogWeeder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
 }
 func (m *SeedSettingDependencyWatchdogWeeder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4498,7 +4554,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogWeeder proto.InternalMessageInf
 func (m *SeedSettingExcessCapacityReservation) Reset()      { *m = SeedSettingExcessCapacityReservation{} }
 func (*SeedSettingExcessCapacityReservation) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
 }
 func (m *SeedSettingExcessCapacityReservation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4528,7 +4584,7 @@ func (m *SeedSettingExcessCapacityReservationConfig) Reset() {
 }
 func (*SeedSettingExcessCapacityReservationConfig) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
 }
 func (m *SeedSettingExcessCapacityReservationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4556,7 +4612,7 @@ var xxx_messageInfo_SeedSettingExcessCapacityReservationConfig proto.InternalMes
 func (m *SeedSettingLoadBalancerServices) Reset()      { *m = SeedSettingLoadBalancerServices{} }
 func (*SeedSettingLoadBalancerServices) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
 }
 func (m *SeedSettingLoadBalancerServices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4586,7 +4642,7 @@ func (m *SeedSettingLoadBalancerServicesZonalIngress) Reset() {
 }
 func (*SeedSettingLoadBalancerServicesZonalIngress) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServicesZonalIngress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
 }
 func (m *SeedSettingLoadBalancerServicesZonalIngress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4614,7 +4670,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServicesZonalIngress proto.InternalMe
 func (m *SeedSettingLoadBalancerServicesZones) Reset()      { *m = SeedSettingLoadBalancerServicesZones{} }
 func (*SeedSettingLoadBalancerServicesZones) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServicesZones) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
 }
 func (m *SeedSettingLoadBalancerServicesZones) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4642,7 +4698,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServicesZones proto.InternalMessageIn
 func (m *SeedSettingScheduling) Reset()      { *m = SeedSettingScheduling{} }
 func (*SeedSettingScheduling) ProtoMessage() {}
 func (*SeedSettingScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
 }
 func (m *SeedSettingScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4670,7 +4726,7 @@ var xxx_messageInfo_SeedSettingScheduling proto.InternalMessageInfo
 func (m *SeedSettingTopologyAwareRouting) Reset()      { *m = SeedSettingTopologyAwareRouting{} }
 func (*SeedSettingTopologyAwareRouting) ProtoMessage() {}
 func (*SeedSettingTopologyAwareRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
 }
 func (m *SeedSettingTopologyAwareRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4698,7 +4754,7 @@ var xxx_messageInfo_SeedSettingTopologyAwareRouting proto.InternalMessageInfo
 func (m *SeedSettingVerticalPodAutoscaler) Reset()      { *m = SeedSettingVerticalPodAutoscaler{} }
 func (*SeedSettingVerticalPodAutoscaler) ProtoMessage() {}
 func (*SeedSettingVerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
 }
 func (m *SeedSettingVerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4726,7 +4782,7 @@ var xxx_messageInfo_SeedSettingVerticalPodAutoscaler proto.InternalMessageInfo
 func (m *SeedSettings) Reset()      { *m = SeedSettings{} }
 func (*SeedSettings) ProtoMessage() {}
 func (*SeedSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
 }
 func (m *SeedSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4754,7 +4810,7 @@ var xxx_messageInfo_SeedSettings proto.InternalMessageInfo
 func (m *SeedSpec) Reset()      { *m = SeedSpec{} }
 func (*SeedSpec) ProtoMessage() {}
 func (*SeedSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
 }
 func (m *SeedSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4782,7 +4838,7 @@ var xxx_messageInfo_SeedSpec proto.InternalMessageInfo
 func (m *SeedStatus) Reset()      { *m = SeedStatus{} }
 func (*SeedStatus) ProtoMessage() {}
 func (*SeedStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
 }
 func (m *SeedStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4810,7 +4866,7 @@ var xxx_messageInfo_SeedStatus proto.InternalMessageInfo
 func (m *SeedTaint) Reset()      { *m = SeedTaint{} }
 func (*SeedTaint) ProtoMessage() {}
 func (*SeedTaint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
 }
 func (m *SeedTaint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4838,7 +4894,7 @@ var xxx_messageInfo_SeedTaint proto.InternalMessageInfo
 func (m *SeedTemplate) Reset()      { *m = SeedTemplate{} }
 func (*SeedTemplate) ProtoMessage() {}
 func (*SeedTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
 }
 func (m *SeedTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4866,7 +4922,7 @@ var xxx_messageInfo_SeedTemplate proto.InternalMessageInfo
 func (m *SeedVolume) Reset()      { *m = SeedVolume{} }
 func (*SeedVolume) ProtoMessage() {}
 func (*SeedVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
 }
 func (m *SeedVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4894,7 +4950,7 @@ var xxx_messageInfo_SeedVolume proto.InternalMessageInfo
 func (m *SeedVolumeProvider) Reset()      { *m = SeedVolumeProvider{} }
 func (*SeedVolumeProvider) ProtoMessage() {}
 func (*SeedVolumeProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
 }
 func (m *SeedVolumeProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4922,7 +4978,7 @@ var xxx_messageInfo_SeedVolumeProvider proto.InternalMessageInfo
 func (m *ServiceAccountConfig) Reset()      { *m = ServiceAccountConfig{} }
 func (*ServiceAccountConfig) ProtoMessage() {}
 func (*ServiceAccountConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
 }
 func (m *ServiceAccountConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4950,7 +5006,7 @@ var xxx_messageInfo_ServiceAccountConfig proto.InternalMessageInfo
 func (m *ServiceAccountKeyRotation) Reset()      { *m = ServiceAccountKeyRotation{} }
 func (*ServiceAccountKeyRotation) ProtoMessage() {}
 func (*ServiceAccountKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
 }
 func (m *ServiceAccountKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4978,7 +5034,7 @@ var xxx_messageInfo_ServiceAccountKeyRotation proto.InternalMessageInfo
 func (m *Shoot) Reset()      { *m = Shoot{} }
 func (*Shoot) ProtoMessage() {}
 func (*Shoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
 }
 func (m *Shoot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5006,7 +5062,7 @@ var xxx_messageInfo_Shoot proto.InternalMessageInfo
 func (m *ShootAdvertisedAddress) Reset()      { *m = ShootAdvertisedAddress{} }
 func (*ShootAdvertisedAddress) ProtoMessage() {}
 func (*ShootAdvertisedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
 }
 func (m *ShootAdvertisedAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5031,10 +5087,38 @@ func (m *ShootAdvertisedAddress) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ShootAdvertisedAddress proto.InternalMessageInfo
 
+func (m *ShootCertificatesSummary) Reset()      { *m = ShootCertificatesSummary{} }
+func (*ShootCertificatesSummary) ProtoMessage() {}
+func (*ShootCertificatesSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
+}
+func (m *ShootCertificatesSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootCertificatesSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootCertificatesSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootCertificatesSummary.Merge(m, src)
+}
+func (m *ShootCertificatesSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootCertificatesSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootCertificatesSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootCertificatesSummary proto.InternalMessageInfo
+
 func (m *ShootCredentials) Reset()      { *m = ShootCredentials{} }
 func (*ShootCredentials) ProtoMessage() {}
 func (*ShootCredentials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
 }
 func (m *ShootCredentials) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5062,7 +5146,7 @@ var xxx_messageInfo_ShootCredentials proto.InternalMessageInfo
 func (m *ShootCredentialsRotation) Reset()      { *m = ShootCredentialsRotation{} }
 func (*ShootCredentialsRotation) ProtoMessage() {}
 func (*ShootCredentialsRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
 }
 func (m *ShootCredentialsRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5090,7 +5174,7 @@ var xxx_messageInfo_ShootCredentialsRotation proto.InternalMessageInfo
 func (m *ShootKubeconfigRotation) Reset()      { *m = ShootKubeconfigRotation{} }
 func (*ShootKubeconfigRotation) ProtoMessage() {}
 func (*ShootKubeconfigRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
 }
 func (m *ShootKubeconfigRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5118,7 +5202,7 @@ var xxx_messageInfo_ShootKubeconfigRotation proto.InternalMessageInfo
 func (m *ShootList) Reset()      { *m = ShootList{} }
 func (*ShootList) ProtoMessage() {}
 func (*ShootList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
 }
 func (m *ShootList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5146,7 +5230,7 @@ var xxx_messageInfo_ShootList proto.InternalMessageInfo
 func (m *ShootMachineImage) Reset()      { *m = ShootMachineImage{} }
 func (*ShootMachineImage) ProtoMessage() {}
 func (*ShootMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{185}
 }
 func (m *ShootMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5174,7 +5258,7 @@ var xxx_messageInfo_ShootMachineImage proto.InternalMessageInfo
 func (m *ShootNetworks) Reset()      { *m = ShootNetworks{} }
 func (*ShootNetworks) ProtoMessage() {}
 func (*ShootNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{186}
 }
 func (m *ShootNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5202,7 +5286,7 @@ var xxx_messageInfo_ShootNetworks proto.InternalMessageInfo
 func (m *ShootSSHKeypairRotation) Reset()      { *m = ShootSSHKeypairRotation{} }
 func (*ShootSSHKeypairRotation) ProtoMessage() {}
 func (*ShootSSHKeypairRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{187}
 }
 func (m *ShootSSHKeypairRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5230,7 +5314,7 @@ var xxx_messageInfo_ShootSSHKeypairRotation proto.InternalMessageInfo
 func (m *ShootSpec) Reset()      { *m = ShootSpec{} }
 func (*ShootSpec) ProtoMessage() {}
 func (*ShootSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{185}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{188}
 }
 func (m *ShootSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5258,7 +5342,7 @@ var xxx_messageInfo_ShootSpec proto.InternalMessageInfo
 func (m *ShootState) Reset()      { *m = ShootState{} }
 func (*ShootState) ProtoMessage() {}
 func (*ShootState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{186}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{189}
 }
 func (m *ShootState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5286,7 +5370,7 @@ var xxx_messageInfo_ShootState proto.InternalMessageInfo
 func (m *ShootStateList) Reset()      { *m = ShootStateList{} }
 func (*ShootStateList) ProtoMessage() {}
 func (*ShootStateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{187}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{190}
 }
 func (m *ShootStateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5314,7 +5398,7 @@ var xxx_messageInfo_ShootStateList proto.InternalMessageInfo
 func (m *ShootStateSpec) Reset()      { *m = ShootStateSpec{} }
 func (*ShootStateSpec) ProtoMessage() {}
 func (*ShootStateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{188}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{191}
 }
 func (m *ShootStateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5342,7 +5426,7 @@ var xxx_messageInfo_ShootStateSpec proto.InternalMessageInfo
 func (m *ShootStatus) Reset()      { *m = ShootStatus{} }
 func (*ShootStatus) ProtoMessage() {}
 func (*ShootStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{189}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{192}
 }
 func (m *ShootStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5370,7 +5454,7 @@ var xxx_messageInfo_ShootStatus proto.InternalMessageInfo
 func (m *ShootTemplate) Reset()      { *m = ShootTemplate{} }
 func (*ShootTemplate) ProtoMessage() {}
 func (*ShootTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{190}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{193}
 }
 func (m *ShootTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5398,7 +5482,7 @@ var xxx_messageInfo_ShootTemplate proto.InternalMessageInfo
 func (m *StructuredAuthentication) Reset()      { *m = StructuredAuthentication{} }
 func (*StructuredAuthentication) ProtoMessage() {}
 func (*StructuredAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{191}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{194}
 }
 func (m *StructuredAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5426,7 +5510,7 @@ var xxx_messageInfo_StructuredAuthentication proto.InternalMessageInfo
 func (m *StructuredAuthorization) Reset()      { *m = StructuredAuthorization{} }
 func (*StructuredAuthorization) ProtoMessage() {}
 func (*StructuredAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{192}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{195}
 }
 func (m *StructuredAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5454,7 +5538,7 @@ var xxx_messageInfo_StructuredAuthorization proto.InternalMessageInfo
 func (m *SystemComponents) Reset()      { *m = SystemComponents{} }
 func (*SystemComponents) ProtoMessage() {}
 func (*SystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{193}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{196}
 }
 func (m *SystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5482,7 +5566,7 @@ var xxx_messageInfo_SystemComponents proto.InternalMessageInfo
 func (m *Toleration) Reset()      { *m = Toleration{} }
 func (*Toleration) ProtoMessage() {}
 func (*Toleration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{194}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{197}
 }
 func (m *Toleration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5510,7 +5594,7 @@ var xxx_messageInfo_Toleration proto.InternalMessageInfo
 func (m *VerticalPodAutoscaler) Reset()      { *m = VerticalPodAutoscaler{} }
 func (*VerticalPodAutoscaler) ProtoMessage() {}
 func (*VerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{195}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{198}
 }
 func (m *VerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5538,7 +5622,7 @@ var xxx_messageInfo_VerticalPodAutoscaler proto.InternalMessageInfo
 func (m *Volume) Reset()      { *m = Volume{} }
 func (*Volume) ProtoMessage() {}
 func (*Volume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{196}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{199}
 }
 func (m *Volume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5566,7 +5650,7 @@ var xxx_messageInfo_Volume proto.InternalMessageInfo
 func (m *VolumeType) Reset()      { *m = VolumeType{} }
 func (*VolumeType) ProtoMessage() {}
 func (*VolumeType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{197}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{200}
 }
 func (m *VolumeType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5594,7 +5678,7 @@ var xxx_messageInfo_VolumeType proto.InternalMessageInfo
 func (m *WatchCacheSizes) Reset()      { *m = WatchCacheSizes{} }
 func (*WatchCacheSizes) ProtoMessage() {}
 func (*WatchCacheSizes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{198}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{201}
 }
 func (m *WatchCacheSizes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5622,7 +5706,7 @@ var xxx_messageInfo_WatchCacheSizes proto.InternalMessageInfo
 func (m *Worker) Reset()      { *m = Worker{} }
 func (*Worker) ProtoMessage() {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{199}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{202}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5650,7 +5734,7 @@ var xxx_messageInfo_Worker proto.InternalMessageInfo
 func (m *WorkerControlPlane) Reset()      { *m = WorkerControlPlane{} }
 func (*WorkerControlPlane) ProtoMessage() {}
 func (*WorkerControlPlane) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{200}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{203}
 }
 func (m *WorkerControlPlane) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5678,7 +5762,7 @@ var xxx_messageInfo_WorkerControlPlane proto.InternalMessageInfo
 func (m *WorkerKubernetes) Reset()      { *m = WorkerKubernetes{} }
 func (*WorkerKubernetes) ProtoMessage() {}
 func (*WorkerKubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{201}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{204}
 }
 func (m *WorkerKubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5706,7 +5790,7 @@ var xxx_messageInfo_WorkerKubernetes proto.InternalMessageInfo
 func (m *WorkerSystemComponents) Reset()      { *m = WorkerSystemComponents{} }
 func (*WorkerSystemComponents) ProtoMessage() {}
 func (*WorkerSystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{202}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{205}
 }
 func (m *WorkerSystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5734,7 +5818,7 @@ var xxx_messageInfo_WorkerSystemComponents proto.InternalMessageInfo
 func (m *WorkersSettings) Reset()      { *m = WorkersSettings{} }
 func (*WorkersSettings) ProtoMessage() {}
 func (*WorkersSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{203}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{206}
 }
 func (m *WorkersSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5912,8 +5996,13 @@ func init() {
 	proto.RegisterType((*Provider)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Provider")
 	proto.RegisterType((*Quota)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Quota")
 	proto.RegisterType((*QuotaList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaList")
+	proto.RegisterType((*QuotaProjectStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaProjectStatus")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaProjectStatus.UsedEntry")
 	proto.RegisterType((*QuotaSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec")
 	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec.MetricsEntry")
+	proto.RegisterType((*QuotaStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus.HardEntry")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus.UsedEntry")
 	proto.RegisterType((*Region)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region.LabelsEntry")
 	proto.RegisterType((*ResourceData)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ResourceData")
@@ -5952,6 +6041,7 @@ func init() {
 	proto.RegisterType((*SeedStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedStatus")
 	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedStatus.AllocatableEntry")
 	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedStatus.CapacityEntry")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedStatus.UsageEntry")
 	proto.RegisterType((*SeedTaint)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedTaint")
 	proto.RegisterType((*SeedTemplate)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedTemplate")
 	proto.RegisterType((*SeedVolume)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedVolume")
@@ -5960,6 +6050,7 @@ func init() {
 	proto.RegisterType((*ServiceAccountKeyRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ServiceAccountKeyRotation")
 	proto.RegisterType((*Shoot)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Shoot")
 	proto.RegisterType((*ShootAdvertisedAddress)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootAdvertisedAddress")
+	proto.RegisterType((*ShootCertificatesSummary)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootCertificatesSummary")
 	proto.RegisterType((*ShootCredentials)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootCredentials")
 	proto.RegisterType((*ShootCredentialsRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootCredentialsRotation")
 	proto.RegisterType((*ShootKubeconfigRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootKubeconfigRotation")
@@ -5998,934 +6089,947 @@ func init() {
 }
 
 var fileDescriptor_ca37af0df9a5bbd2 = []byte{
-	// 14824 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x24, 0xc9,
-	0x55, 0xa0, 0xab, 0xf5, 0xfd, 0xf4, 0x31, 0x52, 0x8e, 0x66, 0x46, 0xa3, 0xf9, 0xe8, 0x71, 0xad,
-	0x6d, 0x66, 0x59, 0x5b, 0xc3, 0xae, 0xd7, 0x5e, 0xef, 0x9a, 0xfd, 0x90, 0xba, 0xa5, 0x99, 0xf6,
-	0x48, 0x1a, 0x6d, 0xb6, 0xb4, 0xbb, 0xde, 0x85, 0x35, 0xa5, 0xea, 0x54, 0xab, 0x76, 0xaa, 0xab,
-	0x7a, 0xab, 0xaa, 0x35, 0xd2, 0xac, 0x8d, 0x3f, 0xc0, 0x9c, 0x6d, 0x0c, 0xc7, 0xb7, 0xcf, 0x36,
-	0x04, 0x8b, 0x09, 0xcc, 0xdd, 0x71, 0x67, 0x2e, 0x20, 0xb8, 0x08, 0x20, 0x20, 0x0e, 0x22, 0x38,
-	0x0c, 0x81, 0x09, 0x02, 0xc3, 0x9d, 0x39, 0xa0, 0xc1, 0x3a, 0x1f, 0x5c, 0xc4, 0x7d, 0xc6, 0x11,
-	0x71, 0x44, 0xcc, 0x11, 0xdc, 0x45, 0x7e, 0x54, 0x55, 0xd6, 0x57, 0xab, 0x55, 0x2d, 0xc9, 0xbb,
-	0x87, 0x7f, 0x49, 0x9d, 0x2f, 0xf3, 0xbd, 0xfc, 0xaa, 0x97, 0xef, 0xbd, 0x7c, 0xf9, 0x1e, 0x2c,
-	0xd4, 0x0d, 0x6f, 0xbb, 0xb5, 0x39, 0xa7, 0xdb, 0x8d, 0x6b, 0x75, 0xcd, 0xa9, 0x11, 0x8b, 0x38,
-	0xe1, 0x3f, 0xcd, 0xdb, 0xf5, 0x6b, 0x5a, 0xd3, 0x70, 0xaf, 0xe9, 0xb6, 0x43, 0xae, 0xed, 0x3c,
-	0xb8, 0x49, 0x3c, 0xed, 0xc1, 0x6b, 0x75, 0x0a, 0xd3, 0x3c, 0x52, 0x9b, 0x6b, 0x3a, 0xb6, 0x67,
-	0xa3, 0x87, 0x42, 0x1c, 0x73, 0x7e, 0xd3, 0xf0, 0x9f, 0xe6, 0xed, 0xfa, 0x1c, 0xc5, 0x31, 0x47,
-	0x71, 0xcc, 0x09, 0x1c, 0xb3, 0x6f, 0x93, 0xe9, 0xda, 0x75, 0xfb, 0x1a, 0x43, 0xb5, 0xd9, 0xda,
-	0x62, 0xbf, 0xd8, 0x0f, 0xf6, 0x1f, 0x27, 0x31, 0x7b, 0xff, 0xed, 0x77, 0xb9, 0x73, 0x86, 0x4d,
-	0x3b, 0x73, 0x4d, 0x6b, 0x79, 0xb6, 0xab, 0x6b, 0xa6, 0x61, 0xd5, 0xaf, 0xed, 0x24, 0x7a, 0x33,
-	0xab, 0x4a, 0x55, 0x45, 0xb7, 0x3b, 0xd6, 0x71, 0x36, 0x35, 0x3d, 0xad, 0xce, 0x8d, 0xb0, 0x0e,
-	0xd9, 0xf5, 0x88, 0xe5, 0x1a, 0xb6, 0xe5, 0xbe, 0x8d, 0x8e, 0x84, 0x38, 0x3b, 0xf2, 0xdc, 0x44,
-	0x2a, 0xa4, 0x61, 0x7a, 0x38, 0xc4, 0xd4, 0xd0, 0xf4, 0x6d, 0xc3, 0x22, 0xce, 0x9e, 0xdf, 0xfc,
-	0x9a, 0x43, 0x5c, 0xbb, 0xe5, 0xe8, 0xe4, 0x50, 0xad, 0xdc, 0x6b, 0x0d, 0xe2, 0x69, 0x69, 0xb4,
-	0xae, 0x65, 0xb5, 0x72, 0x5a, 0x96, 0x67, 0x34, 0x92, 0x64, 0xde, 0x79, 0x50, 0x03, 0x57, 0xdf,
-	0x26, 0x0d, 0x2d, 0xd1, 0xee, 0xed, 0x59, 0xed, 0x5a, 0x9e, 0x61, 0x5e, 0x33, 0x2c, 0xcf, 0xf5,
-	0x9c, 0x78, 0x23, 0xf5, 0x13, 0x0a, 0x4c, 0xce, 0xaf, 0x55, 0xaa, 0x6c, 0x06, 0x97, 0xed, 0x7a,
-	0xdd, 0xb0, 0xea, 0xe8, 0x01, 0x18, 0xd9, 0x21, 0xce, 0xa6, 0xed, 0x1a, 0xde, 0xde, 0x8c, 0x72,
-	0x45, 0xb9, 0x3a, 0xb0, 0x30, 0xbe, 0xdf, 0x2e, 0x8e, 0x3c, 0xe3, 0x17, 0xe2, 0x10, 0x8e, 0x2a,
-	0x70, 0x7a, 0xdb, 0xf3, 0x9a, 0xf3, 0xba, 0x4e, 0x5c, 0x37, 0xa8, 0x31, 0x53, 0x60, 0xcd, 0xce,
-	0xed, 0xb7, 0x8b, 0xa7, 0x6f, 0xac, 0xaf, 0xaf, 0xc5, 0xc0, 0x38, 0xad, 0x8d, 0xfa, 0x0b, 0x0a,
-	0x4c, 0x05, 0x9d, 0xc1, 0xe4, 0xe5, 0x16, 0x71, 0x3d, 0x17, 0x61, 0x38, 0xdb, 0xd0, 0x76, 0x57,
-	0x6d, 0x6b, 0xa5, 0xe5, 0x69, 0x9e, 0x61, 0xd5, 0x2b, 0xd6, 0x96, 0x69, 0xd4, 0xb7, 0x3d, 0xd1,
-	0xb5, 0xd9, 0xfd, 0x76, 0xf1, 0xec, 0x4a, 0x6a, 0x0d, 0x9c, 0xd1, 0x92, 0x76, 0xba, 0xa1, 0xed,
-	0x26, 0x10, 0x4a, 0x9d, 0x5e, 0x49, 0x82, 0x71, 0x5a, 0x1b, 0xf5, 0x1d, 0x30, 0xc5, 0xc7, 0x81,
-	0x89, 0xeb, 0x39, 0x86, 0xee, 0x19, 0xb6, 0x85, 0xae, 0x40, 0xbf, 0xa5, 0x35, 0x08, 0xeb, 0xe1,
-	0xc8, 0xc2, 0xd8, 0x17, 0xdb, 0xc5, 0x37, 0xec, 0xb7, 0x8b, 0xfd, 0xab, 0x5a, 0x83, 0x60, 0x06,
-	0x51, 0xff, 0x77, 0x01, 0x2e, 0x26, 0xda, 0x3d, 0x6b, 0x78, 0xdb, 0xb7, 0x9a, 0xf4, 0x3f, 0x17,
-	0x7d, 0xbf, 0x02, 0x53, 0x5a, 0xbc, 0x02, 0x43, 0x38, 0xfa, 0xd0, 0xe2, 0xdc, 0xe1, 0x3f, 0xf0,
-	0xb9, 0x04, 0xb5, 0x85, 0xf3, 0xa2, 0x5f, 0xc9, 0x01, 0xe0, 0x24, 0x69, 0xf4, 0x31, 0x05, 0x86,
-	0x6c, 0xde, 0xb9, 0x99, 0xc2, 0x95, 0xbe, 0xab, 0xa3, 0x0f, 0x7d, 0xfb, 0x91, 0x74, 0x43, 0x1a,
-	0xf4, 0x9c, 0xf8, 0xbb, 0x68, 0x79, 0xce, 0xde, 0xc2, 0x29, 0xd1, 0xbd, 0x21, 0x51, 0x8a, 0x7d,
-	0xf2, 0xb3, 0x8f, 0xc1, 0x98, 0x5c, 0x13, 0x4d, 0x42, 0xdf, 0x6d, 0xc2, 0xb7, 0xea, 0x08, 0xa6,
-	0xff, 0xa2, 0x69, 0x18, 0xd8, 0xd1, 0xcc, 0x16, 0x61, 0x4b, 0x3a, 0x82, 0xf9, 0x8f, 0xc7, 0x0a,
-	0xef, 0x52, 0xd4, 0x87, 0x60, 0x60, 0xbe, 0x56, 0xb3, 0x2d, 0x74, 0x3f, 0x0c, 0x11, 0x4b, 0xdb,
-	0x34, 0x49, 0x8d, 0x35, 0x1c, 0x0e, 0xe9, 0x2d, 0xf2, 0x62, 0xec, 0xc3, 0xd5, 0x1f, 0x2d, 0xc0,
-	0x20, 0x6b, 0xe4, 0xa2, 0x1f, 0x52, 0xe0, 0xf4, 0xed, 0xd6, 0x26, 0x71, 0x2c, 0xe2, 0x11, 0xb7,
-	0xac, 0xb9, 0xdb, 0x9b, 0xb6, 0xe6, 0xd4, 0xc4, 0xc2, 0x5c, 0xcf, 0x33, 0x23, 0x37, 0x93, 0xe8,
-	0xf8, 0x1e, 0x4c, 0x01, 0xe0, 0x34, 0xe2, 0x68, 0x07, 0xc6, 0xac, 0xba, 0x61, 0xed, 0x56, 0xac,
-	0xba, 0x43, 0x5c, 0x97, 0x0d, 0x7a, 0xf4, 0xa1, 0xa7, 0xf2, 0x74, 0x66, 0x55, 0xc2, 0xb3, 0x30,
-	0xb9, 0xdf, 0x2e, 0x8e, 0xc9, 0x25, 0x38, 0x42, 0x47, 0xfd, 0x7b, 0x05, 0x4e, 0xcd, 0xd7, 0x1a,
-	0x86, 0x4b, 0x39, 0xed, 0x9a, 0xd9, 0xaa, 0x1b, 0x5d, 0x6c, 0x7d, 0xf4, 0x34, 0x0c, 0xea, 0xb6,
-	0xb5, 0x65, 0xd4, 0x45, 0x3f, 0xdf, 0x36, 0xc7, 0x39, 0xd7, 0x9c, 0xcc, 0xb9, 0x58, 0xf7, 0x04,
-	0xc7, 0x9b, 0xc3, 0xda, 0x9d, 0x45, 0x9f, 0xa1, 0x2f, 0xc0, 0x7e, 0xbb, 0x38, 0x58, 0x62, 0x08,
-	0xb0, 0x40, 0x84, 0xae, 0xc2, 0x70, 0xcd, 0x70, 0xf9, 0x62, 0xf6, 0xb1, 0xc5, 0x1c, 0xdb, 0x6f,
-	0x17, 0x87, 0xcb, 0xa2, 0x0c, 0x07, 0x50, 0xb4, 0x0c, 0xd3, 0x74, 0x06, 0x79, 0xbb, 0x2a, 0xd1,
-	0x1d, 0xe2, 0xd1, 0xae, 0xcd, 0xf4, 0xb3, 0xee, 0xce, 0xec, 0xb7, 0x8b, 0xd3, 0x37, 0x53, 0xe0,
-	0x38, 0xb5, 0x95, 0xba, 0x04, 0xc3, 0xf3, 0x26, 0x71, 0x28, 0x43, 0x40, 0x8f, 0xc1, 0x04, 0x69,
-	0x68, 0x86, 0x89, 0x89, 0x4e, 0x8c, 0x1d, 0xe2, 0xb8, 0x33, 0xca, 0x95, 0xbe, 0xab, 0x23, 0x0b,
-	0x68, 0xbf, 0x5d, 0x9c, 0x58, 0x8c, 0x40, 0x70, 0xac, 0xa6, 0xfa, 0x61, 0x05, 0x46, 0xe7, 0x5b,
-	0x35, 0xc3, 0xe3, 0xe3, 0x42, 0x0e, 0x8c, 0x6a, 0xf4, 0xe7, 0x9a, 0x6d, 0x1a, 0xfa, 0x9e, 0xd8,
-	0x5c, 0x4f, 0xe6, 0xfa, 0xdc, 0x42, 0x34, 0x0b, 0xa7, 0xf6, 0xdb, 0xc5, 0x51, 0xa9, 0x00, 0xcb,
-	0x44, 0xd4, 0x6d, 0x90, 0x61, 0xe8, 0xbd, 0x30, 0xc6, 0x87, 0xbb, 0xa2, 0x35, 0x31, 0xd9, 0x12,
-	0x7d, 0xb8, 0x4f, 0x5a, 0x2b, 0x9f, 0xd0, 0xdc, 0xad, 0xcd, 0x97, 0x88, 0xee, 0x61, 0xb2, 0x45,
-	0x1c, 0x62, 0xe9, 0x84, 0x6f, 0x9b, 0x92, 0xd4, 0x18, 0x47, 0x50, 0xa9, 0x3f, 0xac, 0xc0, 0xa5,
-	0xf9, 0x96, 0xb7, 0x6d, 0x3b, 0xc6, 0x5d, 0xe2, 0x84, 0xd3, 0x1d, 0x60, 0x40, 0x4f, 0xc0, 0x84,
-	0x16, 0x54, 0x58, 0x0d, 0xb7, 0xd3, 0x59, 0xb1, 0x9d, 0x26, 0xe6, 0x23, 0x50, 0x1c, 0xab, 0x8d,
-	0x1e, 0x02, 0x70, 0xc3, 0xb5, 0x65, 0x3c, 0x60, 0x01, 0x89, 0xb6, 0x20, 0xad, 0xaa, 0x54, 0x4b,
-	0xfd, 0x0b, 0x7a, 0x14, 0xee, 0x68, 0x86, 0xa9, 0x6d, 0x1a, 0xa6, 0xe1, 0xed, 0x3d, 0x6f, 0x5b,
-	0xa4, 0x8b, 0xdd, 0xbc, 0x01, 0xe7, 0x5a, 0x96, 0xc6, 0xdb, 0x99, 0x64, 0x85, 0xef, 0xdf, 0xf5,
-	0xbd, 0x26, 0xe1, 0x5c, 0x72, 0x64, 0xe1, 0xc2, 0x7e, 0xbb, 0x78, 0x6e, 0x23, 0xbd, 0x0a, 0xce,
-	0x6a, 0x4b, 0x4f, 0x3d, 0x09, 0xf4, 0x8c, 0x6d, 0xb6, 0x1a, 0x02, 0x6b, 0x1f, 0xc3, 0xca, 0x4e,
-	0xbd, 0x8d, 0xd4, 0x1a, 0x38, 0xa3, 0xa5, 0xfa, 0xf9, 0x02, 0x0c, 0x2e, 0x68, 0xfa, 0xed, 0x56,
-	0x13, 0xbd, 0x15, 0x86, 0x9b, 0x8e, 0xbd, 0x63, 0xd4, 0x88, 0x23, 0xc6, 0x36, 0x29, 0xc6, 0x36,
-	0xbc, 0x26, 0xca, 0x71, 0x50, 0x03, 0x19, 0x30, 0xe1, 0xff, 0x5f, 0xea, 0xe1, 0xcb, 0x65, 0x5f,
-	0xc2, 0x5a, 0x04, 0x11, 0x8e, 0x21, 0x46, 0x2a, 0x0c, 0x3a, 0xa4, 0x4e, 0x8f, 0xba, 0x3e, 0xd6,
-	0x2d, 0xf6, 0xb5, 0x63, 0x56, 0x82, 0x05, 0x04, 0xbd, 0x0f, 0x26, 0x74, 0x87, 0xd4, 0x88, 0xe5,
-	0x19, 0x9a, 0xe9, 0xd2, 0xcd, 0x39, 0xd0, 0xfd, 0xe6, 0x64, 0x9d, 0x28, 0x45, 0x9a, 0xe3, 0x18,
-	0x3a, 0xf5, 0x8b, 0x05, 0x18, 0xe3, 0x13, 0xb5, 0xd0, 0xd2, 0x6f, 0x13, 0x0f, 0x7d, 0x07, 0x0c,
-	0x53, 0xf9, 0xae, 0xa6, 0x79, 0x9a, 0xf8, 0x10, 0xbe, 0x25, 0x73, 0xe8, 0xec, 0x1b, 0xa4, 0xb5,
-	0x43, 0xea, 0x2b, 0xc4, 0xd3, 0xc2, 0xfd, 0x17, 0x96, 0xe1, 0x00, 0x2b, 0xda, 0x82, 0x7e, 0xb7,
-	0x49, 0x74, 0x31, 0xb1, 0xe5, 0x3c, 0x9f, 0xba, 0xdc, 0xe3, 0x6a, 0x93, 0xe8, 0xe1, 0x76, 0xa5,
-	0xbf, 0x30, 0xc3, 0x8f, 0x2c, 0x18, 0x74, 0x3d, 0xcd, 0x6b, 0xb9, 0x6c, 0x7e, 0x47, 0x1f, 0x5a,
-	0xea, 0x99, 0x12, 0xc3, 0xb6, 0x30, 0x21, 0x68, 0x0d, 0xf2, 0xdf, 0x58, 0x50, 0x51, 0xff, 0xbd,
-	0x02, 0x93, 0x72, 0xf5, 0x65, 0xc3, 0xf5, 0xd0, 0xb7, 0x25, 0xa6, 0x73, 0xae, 0xbb, 0xe9, 0xa4,
-	0xad, 0xd9, 0x64, 0x06, 0xbb, 0xd5, 0x2f, 0x91, 0xa6, 0x92, 0xc0, 0x80, 0xe1, 0x91, 0x86, 0x2f,
-	0xa5, 0x3c, 0xd5, 0xeb, 0x08, 0x17, 0xc6, 0x05, 0xb1, 0x81, 0x0a, 0x45, 0x8b, 0x39, 0x76, 0xf5,
-	0x3b, 0x60, 0x5a, 0xae, 0xe5, 0xef, 0x6b, 0xca, 0x32, 0xbc, 0xbd, 0x66, 0x82, 0x65, 0xd0, 0x4f,
-	0x10, 0x33, 0x08, 0x7a, 0x4b, 0xb0, 0xc7, 0x39, 0x67, 0x0a, 0xe6, 0x2e, 0xba, 0xcf, 0xd5, 0x0f,
-	0xf5, 0x45, 0xe7, 0x8e, 0x2e, 0x23, 0xda, 0x89, 0x7d, 0xb9, 0xa3, 0x0f, 0xdd, 0xe8, 0x75, 0x80,
-	0x7e, 0xd7, 0x5f, 0x2b, 0x3c, 0xe0, 0x2a, 0x0c, 0xbb, 0x84, 0xd4, 0xa4, 0x73, 0x99, 0x9d, 0xe6,
-	0x55, 0x51, 0x86, 0x03, 0xe8, 0xf1, 0x73, 0x82, 0x57, 0xfb, 0x01, 0x25, 0x77, 0xbb, 0x3c, 0x19,
-	0xbc, 0x44, 0x2c, 0x45, 0x2f, 0x93, 0x21, 0x3e, 0x9c, 0x18, 0x62, 0x74, 0x17, 0xc6, 0x4d, 0xcd,
-	0xf5, 0x6e, 0x35, 0xa9, 0xde, 0xe6, 0xef, 0x99, 0xd1, 0x87, 0xe6, 0xf3, 0x2c, 0xfa, 0xb2, 0x8c,
-	0x68, 0x61, 0x6a, 0xbf, 0x5d, 0x1c, 0x8f, 0x14, 0xe1, 0x28, 0x29, 0xf4, 0x12, 0x8c, 0xd0, 0x82,
-	0x45, 0xc7, 0xb1, 0x1d, 0xc1, 0x2f, 0x1e, 0xcf, 0x4b, 0x97, 0x21, 0xe1, 0x7a, 0x64, 0xf0, 0x13,
-	0x87, 0xe8, 0xd1, 0x7b, 0x00, 0xd9, 0x9b, 0x4c, 0x93, 0xaf, 0x5d, 0xe7, 0x4a, 0x2a, 0x1d, 0x2c,
-	0x5d, 0xfe, 0xbe, 0x85, 0x59, 0xb1, 0x2f, 0xd1, 0xad, 0x44, 0x0d, 0x9c, 0xd2, 0x0a, 0xdd, 0x06,
-	0x14, 0x28, 0xba, 0xfc, 0xb4, 0x3f, 0x60, 0x6b, 0x04, 0x95, 0xc4, 0xd6, 0x38, 0x4b, 0x89, 0x5d,
-	0x4f, 0xa0, 0xc0, 0x29, 0x68, 0xd5, 0xdf, 0x2a, 0xc0, 0x28, 0xdf, 0x22, 0x5c, 0x19, 0x39, 0xfe,
-	0xb3, 0x82, 0x44, 0xce, 0x8a, 0x52, 0xfe, 0xcf, 0x9f, 0x75, 0x38, 0xf3, 0xa8, 0x68, 0xc4, 0x8e,
-	0x8a, 0xc5, 0x5e, 0x09, 0x75, 0x3e, 0x29, 0xfe, 0x58, 0x81, 0x53, 0x52, 0xed, 0x13, 0x38, 0x28,
-	0x6a, 0xd1, 0x83, 0xe2, 0xc9, 0x1e, 0xc7, 0x97, 0x71, 0x4e, 0xd8, 0x91, 0x61, 0x31, 0x1e, 0xfe,
-	0x10, 0xc0, 0x26, 0x63, 0x27, 0x92, 0x68, 0x1b, 0x2c, 0xf9, 0x42, 0x00, 0xc1, 0x52, 0xad, 0x08,
-	0x53, 0x2c, 0x74, 0x62, 0x8a, 0xea, 0x7f, 0xea, 0x83, 0xa9, 0xc4, 0xb4, 0x27, 0xf9, 0x88, 0xf2,
-	0x75, 0xe2, 0x23, 0x85, 0xaf, 0x07, 0x1f, 0xe9, 0xcb, 0xc5, 0x47, 0xba, 0x3f, 0x88, 0x1c, 0x40,
-	0x0d, 0xa3, 0xce, 0x9b, 0x55, 0x3d, 0xcd, 0xf1, 0xd6, 0x8d, 0x06, 0x11, 0x1c, 0xe7, 0x9b, 0xbb,
-	0xdb, 0xb2, 0xb4, 0x05, 0x67, 0x3c, 0x2b, 0x09, 0x4c, 0x38, 0x05, 0xbb, 0xfa, 0x5d, 0x05, 0x18,
-	0x5a, 0xd0, 0x5c, 0xd6, 0xd3, 0x0f, 0xc0, 0x98, 0x40, 0x5d, 0x69, 0x68, 0x75, 0xd2, 0x8b, 0x39,
-	0x42, 0xa0, 0x5c, 0x91, 0xd0, 0x71, 0x8d, 0x4e, 0x2e, 0xc1, 0x11, 0x72, 0x68, 0x0f, 0x46, 0x1b,
-	0xa1, 0xf6, 0x22, 0x96, 0x78, 0xa9, 0x77, 0xea, 0x14, 0x1b, 0x57, 0x5b, 0xa5, 0x02, 0x2c, 0xd3,
-	0x52, 0x5f, 0x84, 0xd3, 0x29, 0x3d, 0xee, 0x42, 0x71, 0x7b, 0x33, 0x0c, 0x51, 0xdd, 0x3b, 0x14,
-	0xc3, 0x46, 0xf7, 0xdb, 0xc5, 0xa1, 0x67, 0x78, 0x11, 0xf6, 0x61, 0xea, 0x3b, 0xa9, 0x00, 0x10,
-	0xef, 0x53, 0x37, 0x06, 0xbe, 0x01, 0x80, 0xd2, 0x3c, 0xb6, 0x3d, 0xbe, 0x95, 0x9e, 0x84, 0x81,
-	0xe6, 0xb6, 0xe6, 0xfa, 0x2d, 0xee, 0xf7, 0x59, 0xc5, 0x1a, 0x2d, 0xbc, 0xd7, 0x2e, 0xce, 0xc8,
-	0x82, 0x88, 0x68, 0xc4, 0x60, 0x98, 0xb7, 0xa3, 0x3b, 0x8c, 0x6e, 0xf2, 0x92, 0xdd, 0x68, 0x9a,
-	0x84, 0x42, 0xd9, 0x0e, 0x2b, 0xe4, 0xdb, 0x61, 0xcb, 0x09, 0x4c, 0x38, 0x05, 0xbb, 0x4f, 0xb3,
-	0x62, 0x19, 0x9e, 0xa1, 0x05, 0x34, 0xfb, 0xf2, 0xd3, 0x8c, 0x62, 0xc2, 0x29, 0xd8, 0xd1, 0x27,
-	0x14, 0x98, 0x8d, 0x16, 0x2f, 0x19, 0x96, 0xe1, 0x6e, 0x93, 0x1a, 0x23, 0xde, 0x7f, 0x68, 0xe2,
-	0x97, 0xf7, 0xdb, 0xc5, 0xd9, 0xe5, 0x4c, 0x8c, 0xb8, 0x03, 0x35, 0xf4, 0x7d, 0x0a, 0x5c, 0x88,
-	0xcd, 0x8b, 0x63, 0xd4, 0xeb, 0xc4, 0x11, 0xbd, 0x39, 0xfc, 0x07, 0x5e, 0xdc, 0x6f, 0x17, 0x2f,
-	0x2c, 0x67, 0xa3, 0xc4, 0x9d, 0xe8, 0xa1, 0x9f, 0x52, 0xe0, 0x6c, 0x93, 0x58, 0x35, 0xc3, 0xaa,
-	0x3f, 0x6b, 0x3b, 0xb7, 0x89, 0xe3, 0x62, 0xdb, 0x34, 0xed, 0x96, 0xe7, 0xce, 0x0c, 0xb2, 0x33,
-	0xac, 0x92, 0xe7, 0x9b, 0x5b, 0x4b, 0xc3, 0xb8, 0x70, 0x59, 0x6c, 0xd1, 0xb3, 0xa9, 0x60, 0x17,
-	0x67, 0x74, 0x44, 0xfd, 0x4d, 0x05, 0xfa, 0x4a, 0xb8, 0x82, 0x1e, 0x88, 0x7c, 0x22, 0xe7, 0xe4,
-	0x4f, 0xe4, 0x5e, 0xbb, 0x38, 0x54, 0xc2, 0x15, 0xe9, 0x63, 0xfc, 0x3e, 0x05, 0xa6, 0x74, 0xdb,
-	0xf2, 0x34, 0x3a, 0x77, 0x98, 0xcb, 0xca, 0xfe, 0xb9, 0x9c, 0x4b, 0x19, 0x2e, 0xc5, 0x90, 0x85,
-	0xc6, 0xee, 0x38, 0xc4, 0xc5, 0x49, 0xca, 0xea, 0xe7, 0x14, 0x98, 0x2e, 0x69, 0x4d, 0x61, 0x0a,
-	0x2a, 0x93, 0x2d, 0x83, 0x6e, 0x90, 0x6e, 0x2c, 0xfb, 0x68, 0x1b, 0x06, 0x99, 0xb5, 0xd9, 0xed,
-	0x45, 0x97, 0x0f, 0x69, 0x3f, 0xc3, 0x70, 0x71, 0x3b, 0x08, 0xff, 0x1f, 0x0b, 0xfc, 0xea, 0xe3,
-	0x30, 0x19, 0xaf, 0x87, 0x8a, 0xbe, 0x4c, 0xc3, 0x8d, 0x8f, 0x23, 0x71, 0x71, 0xe4, 0xb1, 0xe1,
-	0x7f, 0xf2, 0x6a, 0xf1, 0x0d, 0x1f, 0xfa, 0xb3, 0x2b, 0x6f, 0x50, 0xbf, 0xa2, 0xc0, 0x58, 0xc9,
-	0xb4, 0x5b, 0xb5, 0x35, 0xc7, 0xde, 0x32, 0x4c, 0xf2, 0xfa, 0xb0, 0x72, 0xc8, 0x3d, 0xce, 0x12,
-	0x5d, 0x99, 0xd5, 0x41, 0xae, 0xf8, 0x3a, 0xb1, 0x3a, 0xc8, 0x5d, 0xce, 0x90, 0x26, 0x5f, 0x80,
-	0x33, 0x72, 0xad, 0xd0, 0x64, 0x7a, 0x05, 0xfa, 0x6f, 0x1b, 0x56, 0x2d, 0xbe, 0x31, 0x6f, 0x1a,
-	0x56, 0x0d, 0x33, 0x48, 0xb0, 0x75, 0x0b, 0x99, 0x67, 0xd6, 0xdf, 0x8c, 0x44, 0xa7, 0x8d, 0x09,
-	0xab, 0x57, 0x61, 0x58, 0xd7, 0x16, 0x5a, 0x56, 0xcd, 0x0c, 0x76, 0x3d, 0x9d, 0x82, 0xd2, 0x3c,
-	0x2f, 0xc3, 0x01, 0x14, 0xdd, 0x05, 0x08, 0x6f, 0x27, 0x7a, 0x11, 0x02, 0xc2, 0x8b, 0x8f, 0x2a,
-	0xf1, 0x3c, 0xc3, 0xaa, 0xbb, 0xe1, 0xbe, 0x0a, 0x61, 0x58, 0xa2, 0x86, 0x3e, 0x00, 0xe3, 0xb2,
-	0x44, 0xc2, 0xcd, 0xa4, 0x39, 0x97, 0x21, 0x22, 0xfa, 0x9c, 0x11, 0x84, 0xc7, 0xe5, 0x52, 0x17,
-	0x47, 0xa9, 0xa1, 0xbd, 0x40, 0xfe, 0xe2, 0x46, 0xda, 0xfe, 0xfc, 0x1a, 0x85, 0x2c, 0xfa, 0x4c,
-	0x0b, 0xe2, 0x63, 0x11, 0xa3, 0x71, 0x84, 0x54, 0x8a, 0x61, 0x66, 0xe0, 0xb8, 0x0c, 0x33, 0x04,
-	0x86, 0xb8, 0x69, 0xca, 0x3f, 0x6e, 0x1e, 0xcb, 0x33, 0x40, 0x6e, 0xe5, 0x0a, 0xaf, 0xdb, 0xf8,
-	0x6f, 0x17, 0xfb, 0xb8, 0xd1, 0x0e, 0x8c, 0x51, 0xc1, 0xba, 0x4a, 0x4c, 0xa2, 0x7b, 0xb6, 0x33,
-	0x33, 0x94, 0xff, 0x3a, 0xab, 0x2a, 0xe1, 0xe1, 0x52, 0xac, 0x5c, 0x82, 0x23, 0x74, 0x02, 0xcb,
-	0xdd, 0x70, 0xa6, 0xe5, 0xae, 0x05, 0xa3, 0x3b, 0x92, 0x29, 0x7e, 0x84, 0x4d, 0xc2, 0x13, 0x79,
-	0x3a, 0x16, 0xda, 0xe5, 0x17, 0x4e, 0x0b, 0x42, 0xa3, 0xb2, 0x0d, 0x5f, 0xa6, 0x83, 0x36, 0x61,
-	0x68, 0x93, 0xcb, 0xa0, 0x33, 0xc0, 0xe6, 0xe2, 0xdd, 0x3d, 0x88, 0xd6, 0x5c, 0xce, 0x15, 0x3f,
-	0xb0, 0x8f, 0x18, 0xbd, 0x08, 0x83, 0xa6, 0xd1, 0x30, 0x3c, 0x77, 0x66, 0x94, 0x91, 0xc8, 0xb5,
-	0xb4, 0xcb, 0x0c, 0x03, 0x3f, 0xac, 0xf8, 0xff, 0x58, 0x60, 0x45, 0x9f, 0x52, 0xe0, 0xb4, 0xd8,
-	0x86, 0xc1, 0xa1, 0x65, 0x10, 0x77, 0x66, 0x8c, 0xcd, 0xe1, 0x8d, 0xde, 0x0e, 0xc9, 0xf0, 0x80,
-	0x5e, 0xb8, 0x20, 0x66, 0xf3, 0xf4, 0x4a, 0x92, 0x18, 0x4e, 0xeb, 0x81, 0xfa, 0x89, 0x29, 0x98,
-	0x2a, 0x99, 0x2d, 0xd7, 0x23, 0xce, 0xbc, 0xf0, 0x64, 0x21, 0x0e, 0xfa, 0x88, 0x02, 0x67, 0xd9,
-	0xbf, 0x65, 0xfb, 0x8e, 0x55, 0x26, 0xa6, 0xb6, 0x37, 0xbf, 0x45, 0x6b, 0xd4, 0x6a, 0x87, 0x3b,
-	0x3c, 0xca, 0x2d, 0xa1, 0x26, 0xb3, 0x1b, 0x9b, 0x6a, 0x2a, 0x46, 0x9c, 0x41, 0x09, 0x7d, 0xaf,
-	0x02, 0xe7, 0x53, 0x40, 0x65, 0x62, 0x12, 0xcf, 0x17, 0xfe, 0x0f, 0xdb, 0x8f, 0x4b, 0xfb, 0xed,
-	0xe2, 0xf9, 0x6a, 0x16, 0x52, 0x9c, 0x4d, 0x0f, 0x7d, 0xbf, 0x02, 0xb3, 0x29, 0xd0, 0x25, 0xcd,
-	0x30, 0x5b, 0x8e, 0xaf, 0x17, 0x1c, 0xb6, 0x3b, 0x4c, 0x3c, 0xaf, 0x66, 0x62, 0xc5, 0x1d, 0x28,
-	0xa2, 0x0f, 0xc2, 0x99, 0x00, 0xba, 0x61, 0x59, 0x84, 0xd4, 0x22, 0x5a, 0xc2, 0x61, 0xbb, 0x72,
-	0x7e, 0xbf, 0x5d, 0x3c, 0x53, 0x4d, 0x43, 0x88, 0xd3, 0xe9, 0xa0, 0x3a, 0x5c, 0x0a, 0x01, 0x9e,
-	0x61, 0x1a, 0x77, 0xb9, 0x22, 0xb3, 0xed, 0x10, 0x77, 0xdb, 0x36, 0x6b, 0x8c, 0x15, 0x2b, 0x0b,
-	0x6f, 0xdc, 0x6f, 0x17, 0x2f, 0x55, 0x3b, 0x55, 0xc4, 0x9d, 0xf1, 0xa0, 0x1a, 0x8c, 0xb9, 0xba,
-	0x66, 0x55, 0x2c, 0x8f, 0x38, 0x3b, 0x9a, 0x39, 0x33, 0x98, 0x6b, 0x80, 0x9c, 0x01, 0x4a, 0x78,
-	0x70, 0x04, 0x2b, 0x7a, 0x17, 0x0c, 0x93, 0xdd, 0xa6, 0x66, 0xd5, 0x08, 0x67, 0xba, 0x23, 0x0b,
-	0x17, 0xe9, 0x51, 0xbf, 0x28, 0xca, 0xee, 0xb5, 0x8b, 0x63, 0xfe, 0xff, 0x2b, 0x76, 0x8d, 0xe0,
-	0xa0, 0x36, 0x7a, 0x3f, 0x4c, 0x33, 0x57, 0x9b, 0x1a, 0x61, 0x47, 0x88, 0xeb, 0xeb, 0x8a, 0xc3,
-	0xb9, 0xfa, 0xc9, 0xae, 0xe1, 0x57, 0x52, 0xf0, 0xe1, 0x54, 0x2a, 0x74, 0x19, 0x1a, 0xda, 0xee,
-	0x75, 0x47, 0xd3, 0xc9, 0x56, 0xcb, 0x5c, 0x27, 0x4e, 0xc3, 0xb0, 0xb8, 0xb1, 0x84, 0xe8, 0xb6,
-	0x55, 0xa3, 0x8c, 0x5a, 0xb9, 0x3a, 0xc0, 0x97, 0x61, 0xa5, 0x53, 0x45, 0xdc, 0x19, 0x0f, 0x7a,
-	0x18, 0xc6, 0x8c, 0xba, 0x65, 0x3b, 0x64, 0x5d, 0x33, 0x2c, 0xcf, 0x9d, 0x01, 0x26, 0x64, 0xb3,
-	0x69, 0xad, 0x48, 0xe5, 0x38, 0x52, 0x0b, 0xed, 0x00, 0xb2, 0xc8, 0x9d, 0x35, 0xbb, 0xc6, 0xb6,
-	0xc0, 0x46, 0x93, 0x6d, 0x64, 0xc1, 0x66, 0x0f, 0x3b, 0x35, 0x4c, 0x95, 0x5e, 0x4d, 0x60, 0xc3,
-	0x29, 0x14, 0xd0, 0x12, 0xa0, 0x86, 0xb6, 0xbb, 0xd8, 0x68, 0x7a, 0x7b, 0x0b, 0x2d, 0xf3, 0xb6,
-	0xe0, 0x1a, 0x63, 0x6c, 0x2e, 0xb8, 0xa1, 0x29, 0x01, 0xc5, 0x29, 0x2d, 0x90, 0x06, 0x17, 0xf8,
-	0x78, 0xca, 0x1a, 0x69, 0xd8, 0x96, 0x4b, 0x3c, 0x57, 0xda, 0xa4, 0x33, 0xe3, 0xcc, 0xe1, 0x82,
-	0x29, 0xb6, 0x95, 0xec, 0x6a, 0xb8, 0x13, 0x8e, 0xa8, 0xcb, 0xd9, 0xc4, 0x01, 0x2e, 0x67, 0x8f,
-	0xc0, 0xb8, 0xeb, 0x69, 0x8e, 0xd7, 0x6a, 0x8a, 0x65, 0x38, 0xc5, 0x96, 0x81, 0xd9, 0x21, 0xab,
-	0x32, 0x00, 0x47, 0xeb, 0xd1, 0xe5, 0xe3, 0xc6, 0x66, 0xd1, 0x6e, 0x32, 0x5c, 0xbe, 0xaa, 0x54,
-	0x8e, 0x23, 0xb5, 0xd0, 0x06, 0x9c, 0x6b, 0x68, 0xbb, 0xc1, 0xe7, 0xbb, 0xa6, 0x39, 0x9a, 0x69,
-	0x12, 0xd3, 0x70, 0x1b, 0x33, 0x53, 0xac, 0xa7, 0xec, 0x86, 0x7f, 0x25, 0xbd, 0x0a, 0xce, 0x6a,
-	0x2b, 0x7c, 0xd0, 0xca, 0x8e, 0x66, 0x44, 0x50, 0xa2, 0x88, 0x0f, 0x5a, 0x1c, 0x8c, 0xd3, 0xda,
-	0xa0, 0x9f, 0x50, 0xa0, 0xc8, 0xce, 0x3f, 0xcd, 0xa4, 0x1f, 0xc7, 0x75, 0xc7, 0x6e, 0x35, 0x17,
-	0x34, 0xfd, 0xb6, 0xbd, 0xb5, 0xe5, 0x6f, 0x9c, 0x99, 0xd3, 0xb9, 0xb6, 0xdb, 0x7d, 0xfb, 0xed,
-	0x62, 0xb1, 0xd2, 0x19, 0x35, 0x3e, 0x88, 0x36, 0xfa, 0x41, 0x05, 0x2e, 0x88, 0x0f, 0x37, 0xb5,
-	0x6f, 0xd3, 0xb9, 0xfa, 0xc6, 0x76, 0xdc, 0x4a, 0x36, 0x5a, 0xdc, 0x89, 0x26, 0xfa, 0x11, 0x05,
-	0x2e, 0x5a, 0x31, 0x20, 0x26, 0x2e, 0x61, 0xb6, 0x55, 0xbb, 0xe5, 0xcd, 0x9c, 0xc9, 0xd5, 0xa9,
-	0x2b, 0xfb, 0xed, 0xe2, 0xc5, 0xd5, 0x0e, 0x78, 0x71, 0x47, 0xaa, 0xea, 0xff, 0xea, 0x87, 0x99,
-	0x84, 0x30, 0xe2, 0xfb, 0x04, 0x1e, 0x78, 0xdc, 0x28, 0x47, 0x74, 0xdc, 0x34, 0xe1, 0x4a, 0x50,
-	0xe1, 0x7a, 0xb3, 0x95, 0x4a, 0xab, 0xc0, 0x68, 0xbd, 0x69, 0xbf, 0x5d, 0xbc, 0x52, 0x3d, 0xa0,
-	0x2e, 0x3e, 0x10, 0x5b, 0xf6, 0x51, 0xde, 0x77, 0x42, 0x47, 0xf9, 0xfb, 0x61, 0x5a, 0x02, 0x38,
-	0x44, 0xab, 0xed, 0xf5, 0x20, 0x4a, 0xb0, 0x13, 0xac, 0x9a, 0x82, 0x0f, 0xa7, 0x52, 0xc9, 0x3c,
-	0x3f, 0x07, 0x4e, 0xe2, 0xfc, 0x54, 0xdb, 0x7d, 0x30, 0x52, 0xb2, 0xad, 0x1a, 0x37, 0x71, 0x3d,
-	0x18, 0x71, 0x60, 0xb8, 0x24, 0xab, 0x41, 0xf7, 0xda, 0xc5, 0xf1, 0xa0, 0xa2, 0xa4, 0x17, 0x3d,
-	0x1a, 0x5c, 0x15, 0x72, 0xe3, 0xc2, 0x1b, 0xa3, 0x77, 0x7c, 0xf7, 0xda, 0xc5, 0x53, 0x41, 0xb3,
-	0xe8, 0xb5, 0x1f, 0x3d, 0x1c, 0x4d, 0xcd, 0xf5, 0xd6, 0x1d, 0xcd, 0x72, 0x8d, 0x1e, 0x6c, 0xcc,
-	0xc1, 0xdd, 0xce, 0x72, 0x02, 0x1b, 0x4e, 0xa1, 0x80, 0x5e, 0x82, 0x09, 0x5a, 0xba, 0xd1, 0xac,
-	0x69, 0x1e, 0xc9, 0x69, 0x5a, 0x0e, 0xdc, 0xd1, 0x96, 0x23, 0x98, 0x70, 0x0c, 0x33, 0x77, 0xf8,
-	0xd0, 0x5c, 0xdb, 0x62, 0xeb, 0x19, 0x71, 0xf8, 0xa0, 0xa5, 0x58, 0x40, 0xd1, 0xfd, 0x30, 0xd4,
-	0x20, 0xae, 0xab, 0xd5, 0x09, 0x13, 0xf0, 0x46, 0x42, 0x1d, 0x79, 0x85, 0x17, 0x63, 0x1f, 0x8e,
-	0xde, 0x0a, 0x03, 0xba, 0x5d, 0x23, 0xee, 0xcc, 0x10, 0x3b, 0xc3, 0xe8, 0x71, 0x3e, 0x50, 0xa2,
-	0x05, 0xf7, 0xda, 0xc5, 0x11, 0x76, 0x13, 0x46, 0x7f, 0x61, 0x5e, 0x49, 0xfd, 0x49, 0x05, 0x26,
-	0xe3, 0x76, 0xcf, 0x2e, 0x1c, 0x55, 0x4e, 0xce, 0xe7, 0x43, 0xfd, 0x94, 0x02, 0x63, 0xb4, 0x87,
-	0x8e, 0x6d, 0xae, 0x99, 0x9a, 0x45, 0xd0, 0xf7, 0x28, 0x30, 0xb9, 0x6d, 0xd4, 0xb7, 0x65, 0x97,
-	0x3c, 0xa1, 0x79, 0xe5, 0xb2, 0x1b, 0xde, 0x88, 0xe1, 0x5a, 0x98, 0xde, 0x6f, 0x17, 0x27, 0xe3,
-	0xa5, 0x38, 0x41, 0x53, 0xfd, 0xcb, 0x02, 0x9c, 0x93, 0x7b, 0x36, 0x1f, 0xbe, 0x76, 0x40, 0x7f,
-	0xac, 0x00, 0x34, 0x0c, 0x6b, 0xde, 0x34, 0xed, 0x3b, 0xcc, 0x8f, 0x98, 0xea, 0xb2, 0x2f, 0xe4,
-	0xb5, 0x57, 0xa7, 0x50, 0x98, 0x5b, 0x09, 0xb0, 0xf3, 0x3b, 0xe6, 0xe7, 0x7c, 0x3b, 0x58, 0x08,
-	0xb8, 0xd7, 0x2e, 0x16, 0x93, 0x4f, 0x2c, 0xe6, 0xb0, 0x78, 0xc7, 0xb0, 0x6c, 0xb8, 0xde, 0x47,
-	0xfe, 0xa2, 0x63, 0x15, 0x7e, 0xd9, 0x1c, 0x0e, 0x64, 0xb6, 0x01, 0xa7, 0x62, 0x84, 0x53, 0x7c,
-	0xac, 0xcb, 0xb2, 0x8f, 0xf5, 0x01, 0x4c, 0x6a, 0xce, 0x7f, 0x55, 0x31, 0xf7, 0x74, 0x4b, 0xb3,
-	0x3c, 0x3a, 0xd3, 0x92, 0x4f, 0xf6, 0x9f, 0x17, 0x60, 0x5a, 0x4c, 0x80, 0x49, 0xb5, 0xcd, 0xa6,
-	0x69, 0xef, 0x35, 0x88, 0x75, 0x12, 0x7e, 0x77, 0xfe, 0x47, 0x50, 0xc8, 0xfc, 0x08, 0x1a, 0x89,
-	0x8f, 0xa0, 0x2f, 0xcf, 0x47, 0x10, 0xf0, 0x8a, 0x03, 0x6c, 0x6c, 0x18, 0xce, 0x1a, 0x16, 0xed,
-	0xe8, 0x75, 0xb6, 0x61, 0x42, 0xef, 0x58, 0xc6, 0x9f, 0x86, 0xb9, 0x19, 0xa1, 0x92, 0x5a, 0x03,
-	0x67, 0xb4, 0x54, 0xff, 0x5a, 0x81, 0x99, 0xb4, 0xf9, 0x3d, 0x01, 0xb3, 0x78, 0x23, 0x6a, 0x16,
-	0xbf, 0xd1, 0xc3, 0xb7, 0x11, 0xe9, 0x7a, 0x86, 0x79, 0xfc, 0xaf, 0x0a, 0x70, 0x36, 0xac, 0x5e,
-	0xb1, 0x5c, 0x4f, 0x33, 0x4d, 0x2e, 0xf0, 0x1d, 0xff, 0x5e, 0x6a, 0x46, 0x6e, 0x37, 0x56, 0x7b,
-	0x1b, 0xaa, 0xdc, 0xf7, 0x4c, 0x17, 0x9d, 0xdd, 0x98, 0x8b, 0xce, 0xda, 0x11, 0xd2, 0xec, 0xec,
-	0xad, 0xf3, 0x5f, 0x14, 0x98, 0x4d, 0x6f, 0x78, 0x02, 0x9b, 0xca, 0x8e, 0x6e, 0xaa, 0xf7, 0x1c,
-	0xdd, 0xa8, 0x33, 0xb6, 0xd5, 0x2f, 0x14, 0xb2, 0x46, 0xcb, 0xae, 0x48, 0xb6, 0xe0, 0x94, 0x43,
-	0xea, 0x86, 0xeb, 0x09, 0xc5, 0xe3, 0x70, 0xee, 0xf2, 0xfe, 0xd5, 0xe8, 0x29, 0x1c, 0xc5, 0x81,
-	0xe3, 0x48, 0xd1, 0x2a, 0x0c, 0xb9, 0x84, 0xd4, 0x28, 0xfe, 0x42, 0xf7, 0xf8, 0x03, 0x21, 0xa2,
-	0xca, 0xdb, 0x62, 0x1f, 0x09, 0xfa, 0x36, 0x18, 0xaf, 0x05, 0x5f, 0x14, 0xc5, 0xda, 0xd7, 0x3d,
-	0x56, 0xa6, 0x6d, 0x97, 0xe5, 0xd6, 0x38, 0x8a, 0x4c, 0xfd, 0x3b, 0x05, 0x2e, 0x76, 0xda, 0x5b,
-	0xe8, 0x65, 0x00, 0xdd, 0x97, 0x0a, 0x5d, 0x71, 0x78, 0x3e, 0x9e, 0x73, 0x2d, 0x39, 0x96, 0xf0,
-	0x03, 0x0d, 0x8a, 0x5c, 0x2c, 0x11, 0x49, 0x71, 0xdc, 0x2c, 0x1c, 0x93, 0xe3, 0xa6, 0xfa, 0x5f,
-	0x15, 0x99, 0x15, 0xc9, 0x6b, 0xfb, 0x7a, 0x63, 0x45, 0x72, 0xdf, 0x33, 0xaf, 0x5c, 0xbf, 0x5c,
-	0x80, 0x2b, 0xe9, 0x4d, 0xa4, 0xf3, 0xfc, 0x29, 0x18, 0x6c, 0xf2, 0x27, 0x2d, 0xdc, 0xbb, 0xff,
-	0x2a, 0xe5, 0x2c, 0xfc, 0xc1, 0xc9, 0xbd, 0x76, 0x71, 0x36, 0x8d, 0xd1, 0x8b, 0xa7, 0x2a, 0xa2,
-	0x1d, 0x32, 0x62, 0x77, 0x43, 0x5c, 0x68, 0x7f, 0x7b, 0x97, 0xcc, 0x45, 0xdb, 0x24, 0x66, 0xd7,
-	0xd7, 0x41, 0x1f, 0x56, 0x60, 0x22, 0xb2, 0xa3, 0xdd, 0x99, 0x01, 0xb6, 0x47, 0x73, 0xf9, 0xcc,
-	0x45, 0x3e, 0x95, 0x50, 0x1a, 0x88, 0x14, 0xbb, 0x38, 0x46, 0x30, 0xc6, 0x66, 0xe5, 0x59, 0x7d,
-	0xdd, 0xb1, 0x59, 0xb9, 0xf3, 0x19, 0x6c, 0xf6, 0xc7, 0x0b, 0x59, 0xa3, 0x65, 0x6c, 0xf6, 0x0e,
-	0x8c, 0xf8, 0x62, 0xa4, 0xcf, 0x2e, 0x96, 0x7a, 0xed, 0x13, 0x47, 0xb7, 0x30, 0x25, 0xfa, 0x33,
-	0xe2, 0x97, 0xb8, 0x38, 0xa4, 0x85, 0xbe, 0x5b, 0x01, 0x08, 0x17, 0x46, 0x7c, 0x54, 0xeb, 0x47,
-	0x37, 0x1d, 0x92, 0x58, 0x33, 0x41, 0x3f, 0x69, 0x69, 0x53, 0x48, 0x74, 0xd5, 0x7f, 0xd7, 0x0f,
-	0x28, 0xd9, 0xf7, 0xee, 0x6e, 0xfe, 0x0f, 0x10, 0x72, 0x9b, 0x30, 0xe9, 0x50, 0x69, 0x51, 0x37,
-	0x4c, 0xe2, 0x1b, 0xc0, 0xf2, 0x59, 0x3e, 0x98, 0x5a, 0x85, 0x63, 0xb8, 0x70, 0x02, 0x3b, 0x7a,
-	0x33, 0x0c, 0x35, 0x1d, 0xa3, 0xa1, 0x39, 0x7b, 0x4c, 0x29, 0x1e, 0xe6, 0xd7, 0x92, 0x6b, 0xbc,
-	0x08, 0xfb, 0x30, 0xf4, 0x7e, 0x18, 0x31, 0x8d, 0x2d, 0xa2, 0xef, 0xe9, 0x26, 0x11, 0xb7, 0x1e,
-	0xb7, 0x8e, 0x66, 0xcd, 0x97, 0x7d, 0xb4, 0xc2, 0x99, 0xd4, 0xff, 0x89, 0x43, 0x82, 0xa8, 0x02,
-	0xa7, 0xef, 0x30, 0xf7, 0x26, 0x93, 0xb8, 0x6e, 0xb5, 0xd5, 0x6c, 0xda, 0x8e, 0x47, 0x6a, 0xec,
-	0x6e, 0x64, 0x98, 0xdb, 0x68, 0x9f, 0x4d, 0x82, 0x71, 0x5a, 0x1b, 0xf4, 0x38, 0x80, 0xd6, 0xf2,
-	0x6c, 0xfe, 0xb6, 0x74, 0x66, 0x98, 0x69, 0xed, 0x97, 0xe8, 0x62, 0xcf, 0x07, 0xa5, 0xf7, 0xda,
-	0xc5, 0x51, 0x61, 0xfb, 0x63, 0x4b, 0x23, 0x35, 0x40, 0x2f, 0xc0, 0xb4, 0xce, 0x41, 0x25, 0xbb,
-	0xd1, 0xd4, 0x3c, 0x43, 0x68, 0xc4, 0x23, 0x0c, 0xd1, 0x37, 0xed, 0xb7, 0x8b, 0xd3, 0xa5, 0x14,
-	0x78, 0x1c, 0x65, 0x2a, 0x12, 0xf5, 0x13, 0x05, 0xb8, 0xd0, 0x61, 0x82, 0x10, 0xa6, 0x1f, 0x9e,
-	0x58, 0x3f, 0xb1, 0xcd, 0x1e, 0xe6, 0x1f, 0x8b, 0x28, 0xbc, 0xd7, 0x2e, 0xde, 0xd7, 0x01, 0x41,
-	0x95, 0xee, 0x73, 0x52, 0xdf, 0xc3, 0x21, 0x1a, 0x54, 0x81, 0xc1, 0x5a, 0x78, 0x8d, 0x39, 0xb2,
-	0xf0, 0x20, 0x3d, 0x0a, 0xf8, 0x85, 0x43, 0xb7, 0xd8, 0x04, 0x02, 0xb4, 0x0c, 0x43, 0xdc, 0x3d,
-	0x96, 0x88, 0x63, 0xe5, 0x21, 0x66, 0x32, 0xe1, 0x45, 0xdd, 0x22, 0xf3, 0x51, 0xa8, 0x7f, 0xab,
-	0xc0, 0x50, 0xc9, 0x76, 0x48, 0x79, 0xb5, 0x8a, 0xf6, 0x60, 0x54, 0x0a, 0x6e, 0x20, 0x58, 0x6c,
-	0x4e, 0x9e, 0xc3, 0x30, 0x4a, 0xaa, 0xbd, 0xff, 0x1c, 0x33, 0x28, 0xc0, 0x32, 0x2d, 0xf4, 0x32,
-	0x9d, 0xf3, 0x3b, 0x8e, 0xe1, 0x51, 0xc2, 0xbd, 0xf8, 0x4b, 0x71, 0xc2, 0xd8, 0xc7, 0xc5, 0x77,
-	0x7b, 0xf0, 0x13, 0x87, 0x54, 0xd4, 0x35, 0xca, 0x5e, 0xe2, 0xdd, 0x44, 0x8f, 0x41, 0x7f, 0xc3,
-	0xae, 0xf9, 0xeb, 0xfe, 0x16, 0x9f, 0x79, 0xac, 0xd8, 0x35, 0x3a, 0xb7, 0x67, 0x93, 0x2d, 0xd8,
-	0xd5, 0x20, 0x6b, 0xa3, 0xae, 0xc2, 0x64, 0x9c, 0x3e, 0x7a, 0x0c, 0x26, 0x74, 0xbb, 0xd1, 0xb0,
-	0xad, 0x6a, 0x6b, 0x6b, 0xcb, 0xd8, 0x25, 0x91, 0x77, 0xb2, 0xa5, 0x08, 0x04, 0xc7, 0x6a, 0xaa,
-	0x9f, 0x55, 0xa0, 0x8f, 0xae, 0x8b, 0x0a, 0x83, 0x35, 0xbb, 0xa1, 0x19, 0x96, 0xe8, 0x15, 0x73,
-	0x38, 0x28, 0xb3, 0x12, 0x2c, 0x20, 0xa8, 0x09, 0x23, 0xbe, 0x44, 0xd6, 0x93, 0x87, 0x7f, 0x79,
-	0xb5, 0x1a, 0x3c, 0x90, 0x0a, 0x8e, 0x09, 0xbf, 0xc4, 0xc5, 0x21, 0x11, 0x55, 0x83, 0xa9, 0xf2,
-	0x6a, 0xb5, 0x62, 0xe9, 0x66, 0xab, 0x46, 0x16, 0x77, 0xd9, 0x1f, 0xca, 0xe7, 0x0c, 0x5e, 0x22,
-	0xc6, 0xc9, 0xf8, 0x9c, 0xa8, 0x84, 0x7d, 0x18, 0xad, 0x46, 0x78, 0x0b, 0xf1, 0x6c, 0x94, 0x55,
-	0x13, 0x48, 0xb0, 0x0f, 0x53, 0xbf, 0x52, 0x80, 0x51, 0xa9, 0x43, 0xc8, 0x84, 0x21, 0x3e, 0x5c,
-	0xb7, 0x97, 0xd0, 0x00, 0x89, 0x5e, 0x73, 0xea, 0x7c, 0x42, 0x5d, 0xec, 0x93, 0x90, 0x79, 0x76,
-	0xa1, 0x03, 0xcf, 0x9e, 0x8b, 0xbc, 0xbe, 0xe5, 0x9f, 0xe4, 0x44, 0xf6, 0xcb, 0x5b, 0x74, 0x51,
-	0x1c, 0x4f, 0xdc, 0xc5, 0x7e, 0x38, 0x76, 0x34, 0x6d, 0xc1, 0xc0, 0x5d, 0xdb, 0x22, 0xae, 0xb0,
-	0x85, 0x1f, 0xd1, 0x00, 0x99, 0x63, 0xe4, 0xf3, 0x14, 0x2f, 0xe6, 0xe8, 0xd5, 0x9f, 0x52, 0x00,
-	0xca, 0x9a, 0xa7, 0x71, 0x2f, 0x9c, 0x2e, 0x1c, 0x3d, 0x2f, 0x46, 0x4e, 0xd5, 0xe1, 0xc4, 0x23,
-	0xbf, 0x7e, 0xd7, 0xb8, 0xeb, 0x0f, 0x3f, 0x90, 0xd6, 0x39, 0xf6, 0xaa, 0x71, 0x97, 0x60, 0x06,
-	0x47, 0x0f, 0xc0, 0x08, 0xb1, 0x74, 0x67, 0xaf, 0x49, 0x0f, 0x16, 0x6e, 0xe2, 0x61, 0x5f, 0xe8,
-	0xa2, 0x5f, 0x88, 0x43, 0xb8, 0xfa, 0x20, 0x44, 0x55, 0xae, 0x2e, 0xfc, 0xd0, 0xff, 0x5e, 0x81,
-	0x73, 0xe5, 0x96, 0x66, 0xce, 0x37, 0xe9, 0x46, 0xd5, 0xcc, 0x25, 0x9b, 0xbb, 0x73, 0x50, 0x3d,
-	0xe4, 0xad, 0x30, 0xec, 0x0b, 0x39, 0xf1, 0x57, 0xc0, 0x3e, 0xa3, 0xc4, 0x41, 0x0d, 0xa4, 0xc1,
-	0xb0, 0xeb, 0x8b, 0xdd, 0x85, 0x1e, 0xc4, 0x6e, 0x9f, 0x44, 0x20, 0x76, 0x07, 0x68, 0xb9, 0xf1,
-	0x8b, 0x2d, 0x50, 0x95, 0x38, 0x3b, 0x86, 0x4e, 0xe6, 0x75, 0xdd, 0x6e, 0x59, 0x9e, 0x2b, 0x5e,
-	0xf5, 0x0b, 0xe3, 0x57, 0x5a, 0x0d, 0x9c, 0xd1, 0x52, 0xfd, 0xa2, 0x02, 0xfd, 0x8b, 0xeb, 0xa5,
-	0x32, 0xfa, 0x36, 0xe8, 0x0f, 0x58, 0x46, 0x4e, 0xaf, 0x2d, 0x8a, 0x87, 0xdb, 0xe9, 0xf8, 0x7a,
-	0xaf, 0x50, 0x86, 0xc3, 0xb0, 0xa2, 0x4d, 0x18, 0x24, 0x3b, 0x84, 0x76, 0xb5, 0x70, 0x24, 0xf8,
-	0x19, 0x4b, 0x5b, 0x64, 0x18, 0xb1, 0xc0, 0xac, 0x7e, 0x52, 0x01, 0x08, 0xab, 0xa0, 0xef, 0x4c,
-	0x3b, 0x9d, 0x6e, 0x1e, 0xa1, 0xf5, 0xb9, 0xf3, 0x11, 0xa5, 0xfe, 0xfa, 0x00, 0x9c, 0xa7, 0xdd,
-	0x11, 0x5b, 0xd5, 0xb0, 0xad, 0x9b, 0x64, 0xef, 0x1b, 0x2f, 0x1e, 0xbe, 0xf1, 0xe2, 0xe1, 0x08,
-	0x5f, 0x3c, 0xbc, 0x00, 0xe7, 0xe9, 0x96, 0x13, 0x60, 0xc2, 0xdc, 0xbf, 0xd6, 0x1c, 0xd2, 0xd4,
-	0x1c, 0x52, 0x63, 0xfa, 0xc0, 0x30, 0x77, 0x68, 0x9b, 0xcf, 0xaa, 0x84, 0xb3, 0xdb, 0xab, 0x4f,
-	0xc2, 0x64, 0xb8, 0x77, 0xe7, 0x3d, 0x4c, 0x5c, 0x8f, 0xf2, 0xe3, 0xa8, 0x92, 0x39, 0xe2, 0x4b,
-	0x4c, 0x49, 0xc5, 0x30, 0x8a, 0x40, 0x7c, 0x95, 0x87, 0x42, 0x70, 0x4f, 0x81, 0xc9, 0xc5, 0xdd,
-	0xa6, 0xe1, 0xb0, 0x58, 0x0d, 0xfc, 0xc9, 0x11, 0xba, 0x3f, 0x7c, 0x99, 0xa4, 0x44, 0xaf, 0x01,
-	0xe3, 0xaf, 0x93, 0xd0, 0x16, 0x4c, 0x10, 0xd6, 0x9c, 0xa9, 0x91, 0x9a, 0x97, 0xe7, 0xfb, 0xe0,
-	0x01, 0x4a, 0x22, 0x58, 0x70, 0x0c, 0x2b, 0xaa, 0xc2, 0x84, 0x6e, 0x6a, 0xae, 0x6b, 0x6c, 0x19,
-	0x7a, 0xf8, 0xa2, 0x6e, 0x64, 0xe1, 0x01, 0x26, 0xb4, 0x45, 0x20, 0xf7, 0xda, 0xc5, 0x33, 0xa2,
-	0x9f, 0x51, 0x00, 0x8e, 0xa1, 0x50, 0x3f, 0x5d, 0x80, 0xf1, 0xc5, 0xdd, 0xa6, 0xed, 0xb6, 0x1c,
-	0xc2, 0xaa, 0x9e, 0x80, 0x61, 0xec, 0x7e, 0x18, 0xda, 0xd6, 0xac, 0x9a, 0x49, 0x1c, 0x71, 0x6e,
-	0x07, 0x73, 0x7b, 0x83, 0x17, 0x63, 0x1f, 0x8e, 0x5e, 0x01, 0x70, 0xf5, 0x6d, 0x52, 0x6b, 0x31,
-	0xee, 0xda, 0x97, 0x9f, 0xbb, 0x46, 0xc6, 0x58, 0x0d, 0x50, 0x0a, 0x99, 0x28, 0xf8, 0x8d, 0x25,
-	0x72, 0xea, 0x9f, 0x28, 0x30, 0x15, 0x69, 0x77, 0x02, 0xf6, 0x9e, 0xad, 0xa8, 0xbd, 0x67, 0xbe,
-	0xe7, 0xb1, 0x66, 0x98, 0x79, 0x3e, 0x56, 0x80, 0x73, 0x19, 0x73, 0x92, 0xf0, 0xfd, 0x56, 0x4e,
-	0xc8, 0xf7, 0xbb, 0x05, 0xa3, 0x9e, 0x6d, 0x8a, 0x87, 0x9f, 0xfe, 0x0c, 0xe4, 0x3a, 0xc3, 0xd7,
-	0x03, 0x34, 0xa1, 0x67, 0x77, 0x58, 0xe6, 0x62, 0x99, 0x8e, 0xfa, 0x9b, 0x0a, 0x8c, 0x04, 0x66,
-	0xe5, 0xd7, 0xd4, 0x8d, 0x7c, 0xf7, 0x31, 0x95, 0xd4, 0xdf, 0x2b, 0xc0, 0xd9, 0x00, 0xb7, 0xcf,
-	0xe6, 0xaa, 0x1e, 0xe5, 0x1b, 0x07, 0xdb, 0xa6, 0x2e, 0x46, 0x5e, 0xa5, 0x0c, 0x27, 0x1f, 0x69,
-	0x36, 0x5b, 0x4e, 0xd3, 0x76, 0x7d, 0x41, 0x9a, 0x6b, 0x1c, 0xbc, 0x08, 0xfb, 0x30, 0xb4, 0x0a,
-	0x03, 0x2e, 0xa5, 0x27, 0x0e, 0xcb, 0x43, 0xce, 0x06, 0xd3, 0x05, 0x58, 0x7f, 0x31, 0x47, 0x83,
-	0x5e, 0x91, 0x79, 0xf8, 0x40, 0x7e, 0xeb, 0x27, 0x1d, 0x49, 0x2d, 0x10, 0xa5, 0xc3, 0xbb, 0x93,
-	0x4e, 0xd6, 0x46, 0x75, 0x19, 0x26, 0x85, 0x83, 0x33, 0xdf, 0x36, 0x96, 0x4e, 0xd0, 0xbb, 0x22,
-	0x3b, 0xe3, 0x4d, 0x31, 0x9f, 0x9c, 0xe9, 0x78, 0xfd, 0x70, 0xc7, 0xa8, 0x2e, 0x0c, 0x5f, 0x17,
-	0x9d, 0x44, 0xb3, 0x50, 0x30, 0xfc, 0xb5, 0x00, 0x81, 0xa3, 0x50, 0x29, 0xe3, 0x82, 0xd1, 0xc5,
-	0xeb, 0x20, 0xf9, 0x58, 0xea, 0xeb, 0x7c, 0x2c, 0xa9, 0x5f, 0x2b, 0xc0, 0xb4, 0x4f, 0xd5, 0x1f,
-	0x63, 0x59, 0x5c, 0xb7, 0x1f, 0xa0, 0x55, 0x1d, 0x6c, 0xab, 0xbc, 0x05, 0xfd, 0x8c, 0x01, 0xe6,
-	0xba, 0x86, 0x0f, 0x10, 0xd2, 0xee, 0x60, 0x86, 0x08, 0xbd, 0x1f, 0x06, 0x4d, 0xaa, 0xa2, 0xf8,
-	0xcf, 0x76, 0x72, 0x59, 0x76, 0xd3, 0x86, 0xcb, 0x35, 0x1f, 0x11, 0xce, 0x2e, 0xb8, 0x49, 0xe5,
-	0x85, 0x58, 0xd0, 0x9c, 0x7d, 0x14, 0x46, 0xa5, 0x6a, 0x87, 0x8a, 0x65, 0xf7, 0xd9, 0x02, 0xcc,
-	0xdc, 0x20, 0x66, 0x23, 0xd5, 0x77, 0xa2, 0x08, 0x03, 0xfa, 0xb6, 0xe6, 0xf0, 0x30, 0x89, 0x63,
-	0x7c, 0x93, 0x97, 0x68, 0x01, 0xe6, 0xe5, 0x54, 0x63, 0x89, 0x3c, 0x54, 0x7c, 0x42, 0x9a, 0xc9,
-	0x30, 0x7e, 0xe6, 0xfb, 0x82, 0x00, 0x9b, 0xe1, 0xc0, 0x23, 0x15, 0xe8, 0xf1, 0xf2, 0x9e, 0xea,
-	0xad, 0xd5, 0xb4, 0x27, 0x8a, 0xe8, 0x2e, 0x8c, 0xdb, 0xba, 0x81, 0x49, 0xd3, 0x76, 0x0d, 0xcf,
-	0x76, 0xf6, 0xc4, 0xa2, 0xe5, 0x3a, 0x5a, 0x6e, 0x95, 0x2a, 0x21, 0x22, 0x7e, 0xff, 0x18, 0x29,
-	0xc2, 0x51, 0x52, 0xea, 0x17, 0x14, 0x18, 0xbd, 0x61, 0x6c, 0x12, 0x87, 0xfb, 0x70, 0x33, 0x13,
-	0x4b, 0x24, 0xe0, 0xdf, 0x68, 0x5a, 0xb0, 0x3f, 0xb4, 0x0b, 0x23, 0xe2, 0x1c, 0x0e, 0x5e, 0xa0,
-	0x5e, 0xcf, 0xe7, 0x70, 0x14, 0x90, 0x16, 0xe7, 0x9b, 0xf4, 0xe1, 0xfb, 0x25, 0x2e, 0x0e, 0x89,
-	0xa9, 0xaf, 0xc0, 0xe9, 0x94, 0x46, 0x74, 0x21, 0x99, 0x1b, 0xb3, 0xf8, 0x68, 0x7c, 0x6e, 0x45,
-	0x17, 0x92, 0x95, 0xa3, 0xf3, 0xd0, 0x47, 0xac, 0x9a, 0xf8, 0x62, 0x86, 0xf6, 0xdb, 0xc5, 0xbe,
-	0x45, 0xab, 0x86, 0x69, 0x19, 0x65, 0xe2, 0xa6, 0x1d, 0x91, 0xd8, 0x18, 0x13, 0x5f, 0x16, 0x65,
-	0x38, 0x80, 0x32, 0x17, 0xb1, 0xb8, 0x37, 0x14, 0x55, 0x4d, 0x26, 0xb7, 0x62, 0xbc, 0xa5, 0x17,
-	0x27, 0xac, 0x38, 0x9f, 0x5a, 0x98, 0x11, 0x13, 0x92, 0xe0, 0x78, 0x38, 0x41, 0x57, 0xfd, 0x95,
-	0x7e, 0xb8, 0x74, 0xc3, 0x76, 0x8c, 0xbb, 0xb6, 0xe5, 0x69, 0xe6, 0x9a, 0x5d, 0x0b, 0x1d, 0x64,
-	0xc5, 0x91, 0xf5, 0x51, 0x05, 0xce, 0xe9, 0xcd, 0x96, 0xf0, 0x57, 0x16, 0x3e, 0xa6, 0x6b, 0xc4,
-	0x31, 0xec, 0xbc, 0x8f, 0x76, 0x98, 0x6b, 0x77, 0x69, 0x6d, 0x23, 0x0d, 0x25, 0xce, 0xa2, 0xc5,
-	0xde, 0x0e, 0xd5, 0xec, 0x3b, 0x16, 0xeb, 0x5c, 0xd5, 0x63, 0xb3, 0x79, 0x37, 0x5c, 0x84, 0x9c,
-	0x6f, 0x87, 0xca, 0xa9, 0x18, 0x71, 0x06, 0x25, 0xf4, 0x41, 0x38, 0x23, 0xfc, 0xb2, 0x31, 0xd1,
-	0x6a, 0x86, 0x45, 0x5c, 0x97, 0x3f, 0x3c, 0xe8, 0xe1, 0x71, 0x4c, 0x25, 0x0d, 0x21, 0x4e, 0xa7,
-	0x83, 0x5e, 0x04, 0x70, 0xf7, 0x2c, 0x5d, 0xcc, 0x7f, 0x3e, 0x4f, 0x56, 0x2e, 0x22, 0x07, 0x58,
-	0xb0, 0x84, 0x91, 0x2a, 0x5a, 0x5e, 0xb0, 0x29, 0x07, 0x99, 0x37, 0x32, 0x53, 0xb4, 0xc2, 0x3d,
-	0x14, 0xc2, 0xd5, 0x4f, 0x2a, 0x30, 0x51, 0xb1, 0xd6, 0x4c, 0x4d, 0x27, 0xdc, 0x31, 0xd3, 0x45,
-	0xd7, 0x60, 0xc4, 0x0d, 0xae, 0x74, 0x38, 0x47, 0x08, 0xbf, 0xcf, 0xe0, 0x32, 0x27, 0xac, 0xc3,
-	0x3c, 0xf6, 0x0d, 0x4b, 0x1c, 0x76, 0x4b, 0xb6, 0xc3, 0x11, 0x89, 0xef, 0x8e, 0x7b, 0xec, 0x27,
-	0xc1, 0x38, 0xad, 0x8d, 0xfa, 0xf3, 0x0a, 0x4c, 0x47, 0xbb, 0x23, 0x7c, 0x22, 0x7e, 0x4c, 0x81,
-	0xe9, 0xc8, 0xc3, 0x7a, 0x01, 0xee, 0x25, 0xd6, 0xd7, 0x5a, 0x0a, 0x3e, 0xee, 0x23, 0x9c, 0x06,
-	0xc1, 0xa9, 0xf4, 0xd5, 0x7f, 0xa1, 0xc0, 0x90, 0x88, 0xfb, 0x89, 0xde, 0x12, 0x33, 0xbf, 0x07,
-	0x47, 0x5b, 0xcc, 0x04, 0xbf, 0xc7, 0x1c, 0x3c, 0xc4, 0xd1, 0x24, 0x4e, 0x99, 0x5c, 0xf6, 0x5b,
-	0x41, 0x38, 0x3c, 0xe7, 0x22, 0x8e, 0x1e, 0xfe, 0xdd, 0x8e, 0x44, 0x4c, 0x7d, 0x55, 0x81, 0xa9,
-	0x44, 0xab, 0x2e, 0xc4, 0xd1, 0x13, 0x74, 0x79, 0xfd, 0x72, 0x3f, 0xdd, 0x92, 0x1e, 0xe5, 0xf7,
-	0x26, 0xb7, 0x8c, 0x9f, 0x80, 0xfe, 0xfb, 0x00, 0x8c, 0x18, 0x8d, 0x46, 0xcb, 0x63, 0xb7, 0x90,
-	0x03, 0xa1, 0xb9, 0xb9, 0xe2, 0x17, 0xe2, 0x10, 0x8e, 0x2c, 0x21, 0x69, 0xf1, 0x53, 0x70, 0x39,
-	0xdf, 0xca, 0xc9, 0x03, 0x9c, 0xa3, 0x52, 0x11, 0x17, 0x87, 0xd2, 0x04, 0xb1, 0xef, 0x51, 0x00,
-	0x5c, 0xcf, 0x31, 0xac, 0x3a, 0x2d, 0x14, 0xd2, 0x18, 0x3e, 0x02, 0xb2, 0xd5, 0x00, 0x29, 0x27,
-	0x1e, 0xc6, 0x02, 0x0d, 0x00, 0x58, 0xa2, 0x8c, 0xe6, 0x85, 0x10, 0xca, 0x8f, 0xcc, 0xb7, 0xc5,
-	0xc4, 0xed, 0x4b, 0x29, 0xae, 0xb4, 0x9c, 0x50, 0x28, 0xa5, 0xce, 0x3e, 0x02, 0x23, 0x01, 0xbd,
-	0x83, 0x84, 0xba, 0x31, 0x49, 0xa8, 0x9b, 0x7d, 0x1c, 0x4e, 0xc5, 0xba, 0x7b, 0x28, 0x99, 0xf0,
-	0x4f, 0x15, 0x40, 0xd1, 0xd1, 0x9f, 0x80, 0xe5, 0xa0, 0x1e, 0xb5, 0x1c, 0x2c, 0xf4, 0xbe, 0x64,
-	0x19, 0xa6, 0x83, 0x9f, 0x45, 0xc0, 0xc2, 0x22, 0x07, 0x61, 0xc2, 0xc5, 0xc9, 0x4f, 0x05, 0x95,
-	0x30, 0x1a, 0x80, 0xf8, 0x72, 0x7b, 0x10, 0x54, 0x6e, 0xc6, 0x70, 0x85, 0x82, 0x4a, 0x1c, 0x82,
-	0x13, 0x74, 0xd1, 0xc7, 0x15, 0x98, 0xd4, 0xa2, 0x61, 0x91, 0xfd, 0x99, 0xc9, 0x15, 0xac, 0x2d,
-	0x16, 0x62, 0x39, 0xec, 0x4b, 0x0c, 0xe0, 0xe2, 0x04, 0x59, 0xf4, 0x30, 0x8c, 0x69, 0x4d, 0x63,
-	0xbe, 0x55, 0x33, 0xa8, 0xe6, 0xe9, 0x47, 0x8f, 0x65, 0xd6, 0x90, 0xf9, 0xb5, 0x4a, 0x50, 0x8e,
-	0x23, 0xb5, 0x82, 0xf8, 0xc3, 0xa5, 0xd0, 0xf3, 0xb8, 0x97, 0xf8, 0xc3, 0x62, 0x0e, 0xc3, 0xf8,
-	0xc3, 0x62, 0xea, 0x64, 0x22, 0xc8, 0x02, 0xb0, 0x8d, 0x9a, 0x2e, 0x48, 0x0e, 0xe6, 0xbf, 0x44,
-	0xb9, 0x55, 0x29, 0x97, 0x04, 0x45, 0x26, 0x3e, 0x84, 0xbf, 0xb1, 0x44, 0x01, 0x7d, 0x4a, 0x81,
-	0x71, 0xc1, 0xbb, 0x05, 0xcd, 0x21, 0xb6, 0x44, 0xcf, 0xe7, 0xdd, 0x2f, 0xb1, 0x3d, 0x39, 0x87,
-	0x65, 0xe4, 0x9c, 0xef, 0x04, 0xc1, 0x24, 0x22, 0x30, 0x1c, 0xed, 0x07, 0x93, 0x01, 0xdc, 0xc8,
-	0x2d, 0x96, 0xe8, 0xe0, 0x70, 0x7e, 0x19, 0xa0, 0x9a, 0x82, 0x4f, 0xbc, 0x52, 0x4a, 0x81, 0xe0,
-	0x54, 0xfa, 0x54, 0xae, 0x3d, 0x75, 0x47, 0xf3, 0xf4, 0xed, 0x92, 0xa6, 0x6f, 0xb3, 0x4b, 0x4c,
-	0xfe, 0xb4, 0x36, 0xe7, 0xbe, 0x7e, 0x36, 0x8a, 0x6a, 0xe1, 0xf4, 0x7e, 0xbb, 0x78, 0x2a, 0x56,
-	0x88, 0xe3, 0x04, 0x91, 0x0d, 0xc3, 0x8e, 0xc8, 0x0d, 0x20, 0xa2, 0x21, 0xe4, 0x0b, 0x87, 0x1f,
-	0x4f, 0x34, 0xc0, 0x35, 0x23, 0xff, 0x17, 0x0e, 0x88, 0xa0, 0x3a, 0x5c, 0xe2, 0xba, 0xe1, 0xbc,
-	0x65, 0x5b, 0x7b, 0x0d, 0xbb, 0xe5, 0xce, 0xb7, 0xbc, 0x6d, 0x62, 0x79, 0xbe, 0x29, 0x7c, 0x94,
-	0x1d, 0xa3, 0xec, 0xd5, 0xdd, 0x62, 0xa7, 0x8a, 0xb8, 0x33, 0x1e, 0xf4, 0x1c, 0x0c, 0xb3, 0x8b,
-	0xbe, 0xf5, 0xf5, 0x65, 0xf6, 0x4a, 0xf7, 0xf0, 0xe2, 0x32, 0x1b, 0xc2, 0xa2, 0xc0, 0x81, 0x03,
-	0x6c, 0xe8, 0x36, 0x0c, 0x99, 0x3c, 0xb9, 0x03, 0x7b, 0xad, 0x9b, 0x93, 0x29, 0xc6, 0x13, 0x45,
-	0x70, 0x05, 0x5a, 0xfc, 0xc0, 0x3e, 0x05, 0xd4, 0x84, 0x2b, 0x35, 0xb2, 0xa5, 0xb5, 0x4c, 0x6f,
-	0xd5, 0xf6, 0x30, 0x7b, 0xe2, 0x16, 0x58, 0x3c, 0xfd, 0x07, 0xd9, 0x13, 0x2c, 0x1e, 0x1f, 0x7b,
-	0x3c, 0x58, 0x3e, 0xa0, 0x2e, 0x3e, 0x10, 0x1b, 0xda, 0x83, 0xfb, 0x44, 0x1d, 0xf6, 0xa6, 0x4e,
-	0xdf, 0xa6, 0xb3, 0x9c, 0x24, 0x7a, 0x8a, 0x11, 0xfd, 0xa6, 0xfd, 0x76, 0xf1, 0xbe, 0xf2, 0xc1,
-	0xd5, 0x71, 0x37, 0x38, 0xd9, 0x33, 0x25, 0x12, 0xbb, 0x02, 0x9a, 0x99, 0xcc, 0x3f, 0xc7, 0xf1,
-	0xeb, 0x24, 0xee, 0x4f, 0x17, 0x2f, 0xc5, 0x09, 0x9a, 0xe8, 0xf3, 0x0a, 0xcc, 0xb8, 0x9e, 0xd3,
-	0xd2, 0xbd, 0x96, 0x43, 0x6a, 0xb1, 0x1d, 0x3a, 0xc5, 0x3a, 0x94, 0x4b, 0x80, 0xab, 0x66, 0xe0,
-	0x64, 0xa1, 0x01, 0x66, 0xb2, 0xa0, 0x38, 0xb3, 0x2f, 0xe8, 0x73, 0x0a, 0x9c, 0x8b, 0x02, 0xa9,
-	0x4e, 0xcf, 0xfb, 0x89, 0xf2, 0x5f, 0xb2, 0x54, 0xd3, 0x51, 0x72, 0x0d, 0x3e, 0x03, 0x88, 0xb3,
-	0x3a, 0x12, 0xbf, 0x5a, 0x3f, 0x7d, 0xc2, 0x57, 0xeb, 0xb3, 0x4f, 0x01, 0x4a, 0x1e, 0x1f, 0x07,
-	0xc9, 0x81, 0xc3, 0xb2, 0x1c, 0xf8, 0x99, 0x01, 0xb8, 0x40, 0x4f, 0xa5, 0x50, 0xfb, 0x59, 0xd1,
-	0x2c, 0xad, 0xfe, 0xda, 0x94, 0x98, 0xbe, 0xa0, 0xc0, 0xb9, 0xed, 0x74, 0xd3, 0x8e, 0xd0, 0xbf,
-	0x9e, 0xce, 0x65, 0x82, 0xeb, 0x64, 0x2d, 0xe2, 0x0c, 0xbb, 0x63, 0x15, 0x9c, 0xd5, 0x29, 0xf4,
-	0x14, 0x4c, 0x5a, 0x76, 0x8d, 0x94, 0x2a, 0x65, 0xbc, 0xa2, 0xb9, 0xb7, 0xab, 0xbe, 0xa7, 0xcf,
-	0x00, 0xff, 0x5e, 0x57, 0x63, 0x30, 0x9c, 0xa8, 0x8d, 0x76, 0x00, 0x35, 0xed, 0xda, 0xe2, 0x0e,
-	0x4f, 0x82, 0xd2, 0x9b, 0xcf, 0x2d, 0xf3, 0x36, 0x58, 0x4b, 0x60, 0xc3, 0x29, 0x14, 0x98, 0x6d,
-	0x8a, 0x76, 0x66, 0xc5, 0xb6, 0x0c, 0xcf, 0x76, 0x58, 0xb0, 0x8b, 0x9e, 0x4c, 0x34, 0xcc, 0x36,
-	0xb5, 0x9a, 0x8a, 0x11, 0x67, 0x50, 0x52, 0xff, 0xa7, 0x02, 0xa7, 0xe8, 0xb6, 0x58, 0x73, 0xec,
-	0xdd, 0xbd, 0xd7, 0xe2, 0x86, 0xbc, 0x5f, 0x38, 0x3d, 0x72, 0xdb, 0xce, 0x19, 0xc9, 0xe1, 0x71,
-	0x84, 0xf5, 0x39, 0xf4, 0x71, 0x94, 0xcd, 0xca, 0x7d, 0xd9, 0x66, 0x65, 0xf5, 0x53, 0x05, 0xae,
-	0xb9, 0xf8, 0x66, 0xdd, 0xd7, 0xe4, 0x77, 0xf8, 0x08, 0x8c, 0xd3, 0xb2, 0x15, 0x6d, 0x77, 0xad,
-	0xfc, 0x8c, 0x6d, 0xfa, 0xcf, 0xb9, 0x99, 0xad, 0xfd, 0xa6, 0x0c, 0xc0, 0xd1, 0x7a, 0xe8, 0x31,
-	0x18, 0x6a, 0xf2, 0x98, 0x71, 0x42, 0x67, 0xbe, 0xc2, 0x3d, 0x03, 0x59, 0xd1, 0xbd, 0x76, 0x71,
-	0x2a, 0xbc, 0xe2, 0xf5, 0x23, 0xd7, 0xf9, 0x0d, 0xd4, 0x5f, 0x39, 0x0b, 0x0c, 0xb9, 0x49, 0xbc,
-	0xd7, 0xe2, 0x9c, 0x3c, 0x08, 0xa3, 0x7a, 0xb3, 0x55, 0x5a, 0xaa, 0x3e, 0xdd, 0xb2, 0x99, 0x2d,
-	0x84, 0xe5, 0x0a, 0xa2, 0xdc, 0xbb, 0xb4, 0xb6, 0xe1, 0x17, 0x63, 0xb9, 0x0e, 0xe5, 0x0e, 0x7a,
-	0xb3, 0x25, 0xf8, 0xed, 0x9a, 0xfc, 0xe0, 0x85, 0x71, 0x87, 0xd2, 0xda, 0x46, 0x04, 0x86, 0x13,
-	0xb5, 0xd1, 0x07, 0x61, 0x8c, 0x88, 0x0f, 0xf7, 0x86, 0xe6, 0xd4, 0x04, 0x5f, 0xa8, 0xe4, 0x1d,
-	0x7c, 0x30, 0xb5, 0x3e, 0x37, 0xe0, 0x1a, 0xe0, 0xa2, 0x44, 0x02, 0x47, 0x08, 0xa2, 0x17, 0xe0,
-	0xbc, 0xff, 0x9b, 0xae, 0xb2, 0x5d, 0x8b, 0x33, 0x8a, 0x01, 0xee, 0x77, 0xb3, 0x98, 0x55, 0x09,
-	0x67, 0xb7, 0x47, 0x3f, 0xa7, 0xc0, 0xd9, 0x00, 0x6a, 0x58, 0x46, 0xa3, 0xd5, 0xc0, 0x44, 0x37,
-	0x35, 0xa3, 0x21, 0xf4, 0xbe, 0x67, 0x8f, 0x6c, 0xa0, 0x51, 0xf4, 0x9c, 0x59, 0xa5, 0xc3, 0x70,
-	0x46, 0x97, 0xd0, 0xab, 0x0a, 0x5c, 0xf1, 0x41, 0x6b, 0x0e, 0x71, 0xdd, 0x96, 0x43, 0xc2, 0x60,
-	0x02, 0x62, 0x4a, 0x86, 0x72, 0xf1, 0x4e, 0x26, 0x00, 0x2f, 0x1e, 0x80, 0x1b, 0x1f, 0x48, 0x5d,
-	0xde, 0x2e, 0x55, 0x7b, 0xcb, 0x13, 0x8a, 0xe2, 0x71, 0x6d, 0x17, 0x4a, 0x02, 0x47, 0x08, 0xa2,
-	0x9f, 0x57, 0xe0, 0x9c, 0x5c, 0x20, 0xef, 0x16, 0xae, 0x21, 0x3e, 0x77, 0x64, 0x9d, 0x89, 0xe1,
-	0xe7, 0x12, 0x5e, 0x06, 0x10, 0x67, 0xf5, 0x8a, 0xb2, 0xed, 0x06, 0xdb, 0x98, 0x5c, 0x8b, 0x1c,
-	0xe0, 0x6c, 0x9b, 0xef, 0x55, 0x17, 0xfb, 0x30, 0xf4, 0x30, 0x8c, 0x35, 0xed, 0xda, 0x9a, 0x51,
-	0x73, 0x59, 0x3c, 0x3b, 0xa6, 0xeb, 0xf5, 0xf1, 0xe9, 0x58, 0xb3, 0x6b, 0x6b, 0x95, 0x32, 0x2f,
-	0xc7, 0x91, 0x5a, 0x68, 0x0e, 0x60, 0x4b, 0x33, 0xcc, 0xea, 0x1d, 0xad, 0x79, 0xcb, 0x0f, 0x90,
-	0xc4, 0x6c, 0x11, 0x4b, 0x41, 0x29, 0x96, 0x6a, 0xd0, 0xf5, 0xa3, 0x7c, 0x07, 0x13, 0x1e, 0x82,
-	0x9c, 0xa9, 0x47, 0x47, 0xb1, 0x7e, 0x3e, 0x42, 0xde, 0xe1, 0x9b, 0x12, 0x09, 0x1c, 0x21, 0x88,
-	0x3e, 0xaa, 0xc0, 0x84, 0xbb, 0xe7, 0x7a, 0xa4, 0x11, 0xf4, 0xe1, 0xd4, 0x51, 0xf7, 0x81, 0xd9,
-	0xc4, 0xab, 0x11, 0x22, 0x38, 0x46, 0x94, 0x85, 0x9a, 0x6a, 0x68, 0x75, 0x72, 0xbd, 0x74, 0xc3,
-	0xa8, 0x6f, 0x07, 0xe1, 0x61, 0xd6, 0x88, 0xa3, 0x13, 0xcb, 0x63, 0x8a, 0xd5, 0x80, 0x08, 0x35,
-	0x95, 0x5d, 0x0d, 0x77, 0xc2, 0x81, 0x5e, 0x84, 0x59, 0x01, 0x5e, 0xb6, 0xef, 0x24, 0x28, 0xf0,
-	0x88, 0x4e, 0xcc, 0x83, 0xb2, 0x92, 0x59, 0x0b, 0x77, 0xc0, 0x80, 0x2a, 0x70, 0xda, 0x25, 0x0e,
-	0xbb, 0x13, 0xe4, 0xd1, 0x41, 0xd7, 0x5a, 0xa6, 0xe9, 0x32, 0xd5, 0x46, 0xbc, 0x19, 0xaa, 0x26,
-	0xc1, 0x38, 0xad, 0x0d, 0x7a, 0x3c, 0x78, 0x57, 0xbc, 0x47, 0x0b, 0x9e, 0x5e, 0xab, 0x32, 0x4d,
-	0x64, 0x80, 0x5b, 0x4a, 0x70, 0x14, 0x84, 0xe3, 0x75, 0xe9, 0x69, 0xee, 0x17, 0x2d, 0xb4, 0x1c,
-	0xd7, 0x63, 0x71, 0x96, 0x06, 0xf8, 0x69, 0x8e, 0x65, 0x00, 0x8e, 0xd6, 0x43, 0x8f, 0xc1, 0x84,
-	0x4b, 0x74, 0xdd, 0x6e, 0x34, 0x85, 0x9e, 0xcc, 0x82, 0x21, 0x0d, 0x8b, 0x15, 0x8c, 0x40, 0x70,
-	0xac, 0x26, 0xda, 0x83, 0xd3, 0x41, 0x38, 0xe5, 0x65, 0xbb, 0xbe, 0xa2, 0xed, 0x32, 0xe1, 0xf8,
-	0x6c, 0x9e, 0x18, 0x11, 0x7c, 0xba, 0x4a, 0x49, 0x74, 0x38, 0x8d, 0x06, 0x5a, 0x86, 0xe9, 0x58,
-	0xf1, 0x92, 0x61, 0x12, 0x77, 0xe6, 0x1c, 0x1b, 0x36, 0x33, 0x76, 0x95, 0x52, 0xe0, 0x38, 0xb5,
-	0x15, 0xba, 0x05, 0x67, 0x9a, 0x8e, 0xed, 0x11, 0xdd, 0xbb, 0x49, 0x05, 0x02, 0x53, 0x0c, 0xd0,
-	0x9d, 0x99, 0x61, 0x73, 0xc1, 0xee, 0x43, 0xd7, 0xd2, 0x2a, 0xe0, 0xf4, 0x76, 0xe8, 0x33, 0x0a,
-	0x5c, 0x76, 0x3d, 0x87, 0x68, 0x0d, 0xc3, 0xaa, 0x97, 0x6c, 0xcb, 0x22, 0x8c, 0x31, 0x55, 0x6a,
-	0xe1, 0x93, 0xbb, 0xf3, 0xb9, 0x4e, 0x11, 0x75, 0xbf, 0x5d, 0xbc, 0x5c, 0xed, 0x88, 0x19, 0x1f,
-	0x40, 0x19, 0xbd, 0x02, 0xd0, 0x20, 0x0d, 0xdb, 0xd9, 0xa3, 0x1c, 0x69, 0x66, 0x36, 0xbf, 0xbe,
-	0xbb, 0x12, 0x60, 0xe1, 0x9f, 0x7f, 0xe4, 0x26, 0x37, 0x04, 0x62, 0x89, 0x1c, 0x9d, 0x6a, 0xca,
-	0x6f, 0x45, 0x44, 0x33, 0xe9, 0xa3, 0xb9, 0xc0, 0x56, 0x8e, 0x4d, 0xf5, 0x4a, 0x5a, 0x05, 0x9c,
-	0xde, 0x0e, 0xb9, 0x30, 0xc5, 0xbe, 0x50, 0x71, 0x92, 0x5f, 0x2f, 0xcd, 0xd7, 0xc9, 0xcc, 0xc5,
-	0x5c, 0x93, 0x4b, 0x65, 0xff, 0xa9, 0x4a, 0x1c, 0x19, 0x4e, 0xe2, 0x0f, 0x89, 0x6a, 0xbb, 0x21,
-	0xd1, 0x4b, 0xbd, 0x12, 0x95, 0x90, 0xe1, 0x24, 0x7e, 0xb5, 0x5d, 0x80, 0x33, 0xa9, 0xa7, 0x24,
-	0x65, 0x1e, 0x7c, 0x8a, 0xe7, 0xfd, 0x5c, 0x70, 0xe2, 0xda, 0x93, 0x31, 0x8f, 0x95, 0x28, 0x08,
-	0xc7, 0xeb, 0x52, 0x19, 0x96, 0x51, 0x5b, 0xaa, 0x86, 0xed, 0x0b, 0xa1, 0x0c, 0x5b, 0x89, 0xc1,
-	0x70, 0xa2, 0x36, 0x2a, 0x89, 0xf9, 0x58, 0xaa, 0x56, 0xa8, 0x1a, 0xe8, 0x2e, 0x39, 0xc4, 0xd7,
-	0x0e, 0xc2, 0xf1, 0xc9, 0x40, 0x9c, 0xac, 0x4f, 0x47, 0x41, 0x7f, 0xc8, 0xbd, 0xe8, 0x0f, 0x47,
-	0xb1, 0x1a, 0x05, 0xe1, 0x78, 0x5d, 0x5f, 0x4f, 0x8f, 0x74, 0x61, 0x20, 0x1c, 0xc5, 0x6a, 0x0c,
-	0x86, 0x13, 0xb5, 0xd5, 0x3f, 0xeb, 0x87, 0xfb, 0xba, 0x90, 0x2c, 0x51, 0x23, 0x7d, 0xba, 0x0f,
-	0xcf, 0xf3, 0xba, 0x5b, 0x9e, 0x66, 0xc6, 0xf2, 0x1c, 0x9e, 0x5e, 0xb7, 0xcb, 0xe9, 0x66, 0x2d,
-	0xe7, 0xe1, 0x49, 0x76, 0xbf, 0xfc, 0x8d, 0xf4, 0xe5, 0xcf, 0x39, 0xab, 0x07, 0x6e, 0x97, 0x66,
-	0xc6, 0x76, 0xc9, 0x39, 0xab, 0x5d, 0x6c, 0xaf, 0x3f, 0xef, 0x87, 0x37, 0x75, 0x23, 0xe5, 0xe6,
-	0xdc, 0x5f, 0x29, 0xbc, 0xe5, 0x58, 0xf7, 0x57, 0xd6, 0x83, 0xf0, 0x63, 0xdc, 0x5f, 0x1d, 0xd9,
-	0xe7, 0xf1, 0xec, 0xaf, 0xac, 0x59, 0x3d, 0xae, 0xfd, 0x95, 0x35, 0xab, 0x5d, 0xec, 0xaf, 0xbf,
-	0x89, 0x9f, 0x0f, 0x81, 0xa8, 0x5d, 0x81, 0x3e, 0xbd, 0xd9, 0xca, 0xc9, 0xa4, 0x98, 0x97, 0x61,
-	0x69, 0x6d, 0x03, 0x53, 0x1c, 0x08, 0xc3, 0x20, 0xdf, 0x3f, 0x39, 0x59, 0x10, 0xf3, 0x1c, 0xe5,
-	0x5b, 0x12, 0x0b, 0x4c, 0x74, 0xaa, 0x48, 0x73, 0x9b, 0x34, 0x88, 0xa3, 0x99, 0x55, 0xcf, 0x76,
-	0xb4, 0x7a, 0x5e, 0x6e, 0xc3, 0x6f, 0x50, 0x62, 0xb8, 0x70, 0x02, 0x3b, 0x9d, 0x90, 0xa6, 0x51,
-	0xcb, 0xc9, 0x5f, 0xd8, 0x84, 0xac, 0x55, 0xca, 0x98, 0xe2, 0x50, 0x7f, 0x7d, 0x18, 0xa4, 0x44,
-	0x05, 0xe8, 0x13, 0x0a, 0x4c, 0xe9, 0xf1, 0xa0, 0x9e, 0xbd, 0xf8, 0x43, 0x25, 0x22, 0x84, 0xf2,
-	0x2d, 0x9f, 0x28, 0xc6, 0x49, 0xb2, 0xe8, 0x43, 0x0a, 0x37, 0xf2, 0x05, 0xb7, 0x79, 0x62, 0x5a,
-	0xaf, 0x1f, 0xd1, 0xbd, 0x77, 0x68, 0x2d, 0x0c, 0xaf, 0x58, 0xa3, 0x04, 0xd1, 0xab, 0x0a, 0x9c,
-	0xb9, 0x9d, 0x76, 0x37, 0x21, 0x26, 0xff, 0x56, 0xde, 0xae, 0x64, 0x5c, 0x76, 0x70, 0x09, 0x32,
-	0xb5, 0x02, 0x4e, 0xef, 0x48, 0x30, 0x4b, 0x81, 0xb9, 0x56, 0x7c, 0xa7, 0xb9, 0x67, 0x29, 0x66,
-	0xf7, 0x0d, 0x67, 0x29, 0x00, 0xe0, 0x28, 0x41, 0xd4, 0x84, 0x91, 0xdb, 0xbe, 0x8d, 0x5c, 0xd8,
-	0xc5, 0x4a, 0x79, 0xa9, 0x4b, 0x86, 0x76, 0xee, 0xef, 0x15, 0x14, 0xe2, 0x90, 0x08, 0xda, 0x86,
-	0xa1, 0xdb, 0x9c, 0x57, 0x08, 0x7b, 0xd6, 0x7c, 0xcf, 0xda, 0x3f, 0x37, 0xab, 0x88, 0x22, 0xec,
-	0xa3, 0x97, 0xdf, 0x12, 0x0c, 0x1f, 0xf0, 0xc4, 0xed, 0x33, 0x0a, 0x9c, 0xd9, 0x21, 0x8e, 0x67,
-	0xe8, 0xf1, 0x9b, 0xa1, 0x91, 0xfc, 0x16, 0x8a, 0x67, 0xd2, 0x10, 0xf2, 0x6d, 0x92, 0x0a, 0xc2,
-	0xe9, 0x5d, 0x40, 0xcf, 0x40, 0x3f, 0xf1, 0xf4, 0x9a, 0x08, 0xe6, 0xfd, 0xae, 0xbc, 0x6f, 0x7e,
-	0xf9, 0xbb, 0x17, 0xfa, 0x1f, 0x66, 0xf8, 0xd4, 0xbf, 0x52, 0x20, 0x61, 0x9b, 0x46, 0x3f, 0xa0,
-	0xc0, 0xd8, 0x16, 0xd1, 0xbc, 0x96, 0x43, 0xae, 0x0b, 0x9f, 0xd0, 0xbe, 0xab, 0xa3, 0x0f, 0x3d,
-	0x73, 0x14, 0x26, 0xf1, 0xb9, 0x25, 0x09, 0x31, 0x77, 0x56, 0x09, 0x92, 0x8f, 0xc8, 0x20, 0x1c,
-	0xe9, 0xc1, 0xec, 0x93, 0x30, 0x95, 0x68, 0x78, 0xa8, 0x6b, 0xca, 0x5f, 0x53, 0x20, 0x2d, 0xcf,
-	0x3d, 0x7a, 0x11, 0x06, 0xb4, 0x5a, 0x2d, 0x48, 0x77, 0xfa, 0x68, 0x3e, 0xbf, 0xa9, 0x9a, 0x1c,
-	0x6a, 0x88, 0xfd, 0xc4, 0x1c, 0x2d, 0x5a, 0x02, 0xa4, 0x45, 0xee, 0xa5, 0x57, 0xc2, 0x18, 0x17,
-	0xec, 0x3a, 0x6d, 0x3e, 0x01, 0xc5, 0x29, 0x2d, 0xd4, 0x8f, 0x29, 0x80, 0x92, 0xe9, 0x6a, 0x90,
-	0x03, 0xc3, 0x62, 0xff, 0xfa, 0xab, 0x54, 0xce, 0xf9, 0x9a, 0x2e, 0xf2, 0x34, 0x34, 0x74, 0xc2,
-	0x13, 0x05, 0x2e, 0x0e, 0xe8, 0xa8, 0x7f, 0xa7, 0x40, 0x98, 0x12, 0x11, 0xbd, 0x03, 0x46, 0x6b,
-	0xc4, 0xd5, 0x1d, 0xa3, 0xe9, 0x85, 0x0f, 0x49, 0x83, 0x07, 0x69, 0xe5, 0x10, 0x84, 0xe5, 0x7a,
-	0x48, 0x85, 0x41, 0x4f, 0x73, 0x6f, 0x57, 0xca, 0x42, 0xd9, 0x63, 0x47, 0xf3, 0x3a, 0x2b, 0xc1,
-	0x02, 0x12, 0xc6, 0x9e, 0xed, 0xeb, 0x22, 0xf6, 0x2c, 0xda, 0x3a, 0x82, 0x40, 0xbb, 0xe8, 0xe0,
-	0x20, 0xbb, 0xea, 0xcf, 0x14, 0xe0, 0x14, 0xad, 0xb2, 0xa2, 0x19, 0x96, 0x47, 0x2c, 0xf6, 0x6c,
-	0x2a, 0xe7, 0x24, 0xd4, 0x61, 0xdc, 0x8b, 0xbc, 0x7a, 0x3e, 0xfc, 0xa3, 0xda, 0xc0, 0xd3, 0x2b,
-	0xfa, 0xd6, 0x39, 0x8a, 0x17, 0x3d, 0xea, 0xbf, 0x5b, 0xe3, 0x6a, 0xf1, 0x7d, 0xfe, 0x56, 0x65,
-	0x8f, 0xd1, 0xee, 0x89, 0x27, 0xe4, 0x41, 0x1e, 0xcd, 0xc8, 0x13, 0xb5, 0x47, 0x60, 0x5c, 0xbc,
-	0x90, 0xe0, 0x41, 0x84, 0x85, 0x5a, 0xcc, 0x8e, 0x95, 0x25, 0x19, 0x80, 0xa3, 0xf5, 0xd4, 0x3f,
-	0x2c, 0x40, 0x34, 0x5b, 0x67, 0xde, 0x59, 0x4a, 0x46, 0x50, 0x2e, 0x1c, 0x5b, 0x04, 0x65, 0x9e,
-	0xaf, 0x9e, 0xb9, 0x73, 0x8b, 0x7b, 0x76, 0x39, 0x57, 0x35, 0x2b, 0xc7, 0x41, 0x8d, 0x70, 0x5a,
-	0xfb, 0x0f, 0x3d, 0xad, 0xef, 0x10, 0x9e, 0xbf, 0x03, 0x91, 0x38, 0xd6, 0xbe, 0xe7, 0xef, 0x54,
-	0xa4, 0xa1, 0xf4, 0xca, 0x6e, 0x1e, 0x44, 0xbe, 0x1b, 0xba, 0x2e, 0x22, 0xc6, 0xb6, 0xbb, 0x6e,
-	0x7b, 0x9a, 0xc9, 0xa6, 0x53, 0x18, 0x5d, 0x57, 0x64, 0x00, 0x8e, 0xd6, 0x53, 0x57, 0xe1, 0x8d,
-	0xcb, 0xb6, 0x56, 0x5b, 0xd0, 0x4c, 0xba, 0x75, 0x1d, 0xe1, 0x96, 0xe7, 0xb2, 0x93, 0x79, 0xcd,
-	0xb1, 0x3d, 0x5b, 0xb7, 0x4d, 0x7a, 0x6e, 0x6a, 0x41, 0xb0, 0x61, 0x76, 0x11, 0xe9, 0x9f, 0x9b,
-	0x22, 0x58, 0x2f, 0xf6, 0xe1, 0xea, 0xef, 0x28, 0x30, 0x24, 0x72, 0xe0, 0x74, 0xf1, 0xb0, 0x74,
-	0x0b, 0x06, 0x98, 0x76, 0xd4, 0x8b, 0x54, 0x5a, 0xdd, 0xb6, 0x6d, 0x2f, 0x92, 0x3c, 0x8b, 0xa7,
-	0x9f, 0x63, 0x09, 0x43, 0x39, 0x7a, 0xe6, 0x8f, 0xea, 0xe8, 0xdb, 0x86, 0x47, 0x98, 0xdb, 0x8d,
-	0xd8, 0xf8, 0xdc, 0x1f, 0x55, 0x2a, 0xc7, 0x91, 0x5a, 0xea, 0xff, 0x18, 0x80, 0x2b, 0x7e, 0x3e,
-	0x9f, 0xb8, 0xa8, 0x16, 0xf0, 0xdc, 0xbd, 0x20, 0xc1, 0x10, 0x4b, 0x90, 0xe0, 0xdb, 0x54, 0xf3,
-	0x69, 0xc9, 0xe7, 0xa4, 0x14, 0x42, 0x32, 0x3a, 0x9c, 0x46, 0x83, 0x87, 0x6f, 0x67, 0xc5, 0x37,
-	0x88, 0x66, 0x7a, 0xdb, 0x3e, 0xed, 0x42, 0x2f, 0xe1, 0xdb, 0x93, 0xf8, 0x70, 0x2a, 0x15, 0xe6,
-	0xd2, 0xe1, 0x27, 0x36, 0x72, 0x88, 0x26, 0xfb, 0x93, 0xf4, 0xf0, 0xdc, 0x68, 0x25, 0x15, 0x23,
-	0xce, 0xa0, 0xc4, 0xcc, 0x8d, 0xda, 0x2e, 0xb3, 0x5e, 0x60, 0xe2, 0x39, 0x06, 0x4b, 0x82, 0x16,
-	0xdc, 0x55, 0xac, 0x44, 0x41, 0x38, 0x5e, 0x17, 0x3d, 0x06, 0x13, 0xcc, 0x45, 0x26, 0x8c, 0x07,
-	0x3a, 0x10, 0x46, 0x85, 0x5a, 0x8d, 0x40, 0x70, 0xac, 0x26, 0xfa, 0x2e, 0x05, 0xa6, 0x0d, 0xf9,
-	0x31, 0x8d, 0x3f, 0xfa, 0xc1, 0x5e, 0xf2, 0x4a, 0xf0, 0x6d, 0x9c, 0x82, 0x16, 0xa7, 0x12, 0x43,
-	0xcb, 0x30, 0x2d, 0x5e, 0x44, 0x47, 0xf7, 0x00, 0x0f, 0x16, 0xc7, 0xd6, 0xb4, 0x9c, 0x02, 0xc7,
-	0xa9, 0xad, 0xd4, 0x0f, 0x17, 0x60, 0xec, 0x90, 0x09, 0x6d, 0x5b, 0x92, 0xcc, 0xd1, 0xc3, 0xbb,
-	0x45, 0x99, 0x6a, 0x17, 0x62, 0x07, 0x7a, 0x0e, 0x26, 0x5a, 0x6c, 0x22, 0xfc, 0x50, 0x6a, 0xe2,
-	0x9b, 0xfe, 0x16, 0xba, 0x72, 0x1b, 0x11, 0xc8, 0xbd, 0x76, 0x71, 0x56, 0x46, 0x1f, 0x85, 0xe2,
-	0x18, 0x1e, 0xf5, 0x37, 0x0a, 0x80, 0xe4, 0xea, 0x4b, 0xa6, 0xb6, 0x63, 0x3b, 0xe8, 0x73, 0x0a,
-	0x8c, 0xe9, 0x72, 0x0a, 0x31, 0x2e, 0x60, 0x3d, 0xd7, 0xeb, 0x60, 0x39, 0xfa, 0x39, 0x39, 0x37,
-	0x18, 0x17, 0x84, 0xfd, 0xe7, 0xd6, 0x63, 0x32, 0xe8, 0x5e, 0xec, 0x37, 0x8e, 0xf4, 0x69, 0xf6,
-	0xa3, 0x0a, 0x4c, 0x25, 0x30, 0xa5, 0x48, 0xc6, 0xcf, 0x47, 0x83, 0xa8, 0x1f, 0x49, 0xb2, 0x50,
-	0x59, 0xbe, 0xfe, 0xc9, 0x01, 0x38, 0x9d, 0xb2, 0xa2, 0xcc, 0xc5, 0x86, 0xc4, 0xa4, 0xcb, 0x5e,
-	0x5c, 0x6c, 0x12, 0x92, 0x6a, 0xe0, 0x62, 0x13, 0x87, 0xe0, 0x04, 0x5d, 0xf4, 0x0c, 0xf4, 0xe9,
-	0x8e, 0x21, 0x36, 0xed, 0x23, 0xb9, 0xa6, 0x00, 0x57, 0x16, 0x46, 0x05, 0xc5, 0xbe, 0x12, 0xae,
-	0x60, 0x8a, 0x90, 0x9e, 0xc5, 0xf2, 0x31, 0xe2, 0x0b, 0xac, 0xec, 0x2c, 0x96, 0x4f, 0x1b, 0x17,
-	0x47, 0xeb, 0xa1, 0xe7, 0x60, 0x46, 0x68, 0xaa, 0x7e, 0x24, 0x13, 0xdb, 0x72, 0x3d, 0xca, 0xf1,
-	0x3d, 0x21, 0x53, 0x30, 0xef, 0xd7, 0x9b, 0x19, 0x75, 0x70, 0x66, 0x6b, 0xf4, 0x9d, 0x30, 0x11,
-	0xe1, 0x1e, 0x7e, 0x58, 0xb3, 0x9c, 0x4f, 0x66, 0x64, 0x4c, 0x9c, 0x57, 0x46, 0xcb, 0x70, 0x8c,
-	0x1a, 0xfa, 0xc7, 0x0a, 0x4c, 0x05, 0x1b, 0x75, 0x8f, 0x6f, 0x79, 0x3f, 0x9b, 0xe3, 0xd2, 0xd1,
-	0x7c, 0x41, 0x52, 0xaa, 0xdd, 0x38, 0x21, 0x9c, 0xa4, 0xad, 0xfe, 0xc8, 0x20, 0xc8, 0xd9, 0xbd,
-	0xd1, 0x4a, 0x2f, 0x76, 0xc9, 0x70, 0x0f, 0xf8, 0xb6, 0xc9, 0x15, 0xe8, 0xab, 0x37, 0x5b, 0x39,
-	0x0d, 0x93, 0x01, 0xba, 0xeb, 0x14, 0x5d, 0xbd, 0xd9, 0x42, 0xcf, 0x04, 0xa6, 0xce, 0x7c, 0xc6,
-	0xc8, 0xe0, 0xa9, 0x64, 0xcc, 0xdc, 0xe9, 0xb3, 0xf7, 0xfe, 0x4c, 0xf6, 0xde, 0x80, 0x21, 0x57,
-	0xd8, 0x41, 0x07, 0xf2, 0xc7, 0xa1, 0x94, 0x66, 0x5a, 0xd8, 0x3d, 0xb9, 0x85, 0xc6, 0x37, 0x8b,
-	0xfa, 0x34, 0xa8, 0x22, 0xd8, 0x62, 0xe7, 0x92, 0x88, 0xb2, 0xc4, 0x14, 0xc1, 0x0d, 0x56, 0x82,
-	0x05, 0x24, 0x21, 0xcc, 0x0d, 0x75, 0x23, 0xcc, 0xa1, 0xcf, 0xc6, 0xf9, 0xf7, 0x30, 0xdb, 0x7d,
-	0x4f, 0xf7, 0x38, 0x9c, 0xff, 0xcf, 0x18, 0xf7, 0x3f, 0x0a, 0x0f, 0x3f, 0x69, 0xb1, 0xd0, 0x7d,
-	0x30, 0xc0, 0xa2, 0x28, 0x09, 0x39, 0x20, 0x30, 0x6e, 0xb0, 0x38, 0x3a, 0x98, 0xc3, 0x50, 0x55,
-	0xc4, 0x1e, 0xcc, 0xb7, 0xe9, 0x99, 0x6f, 0xa3, 0xa0, 0x27, 0x05, 0x2a, 0xbc, 0x12, 0x79, 0x13,
-	0x99, 0xa6, 0x43, 0x6c, 0xc0, 0x50, 0xc3, 0xb0, 0x98, 0xbb, 0x47, 0x3e, 0x23, 0x3a, 0x77, 0xc1,
-	0xe2, 0x28, 0xb0, 0x8f, 0x4b, 0xfd, 0xf3, 0x02, 0x65, 0x10, 0xa1, 0x52, 0xbf, 0xc7, 0x23, 0xe9,
-	0x8a, 0xd7, 0xd7, 0x4a, 0x7e, 0x23, 0xa0, 0x84, 0x74, 0x3e, 0x40, 0xc8, 0x1d, 0x15, 0xc2, 0xdf,
-	0x58, 0x22, 0x46, 0x49, 0x7b, 0x46, 0x83, 0x3c, 0x6b, 0x58, 0x35, 0xfb, 0x8e, 0x98, 0xde, 0x5e,
-	0x49, 0xaf, 0x07, 0x08, 0x39, 0xe9, 0xf0, 0x37, 0x96, 0x88, 0xd1, 0x23, 0x89, 0x65, 0xf3, 0xb0,
-	0x58, 0x56, 0x66, 0xd1, 0x37, 0x9e, 0x73, 0x5d, 0xf8, 0x1d, 0xb3, 0x23, 0xa9, 0x94, 0x51, 0x07,
-	0x67, 0xb6, 0x56, 0x7f, 0x4e, 0x81, 0x33, 0xa9, 0x53, 0x81, 0xae, 0xc3, 0x54, 0xe8, 0x0e, 0x2b,
-	0x0b, 0x09, 0xc3, 0x21, 0x8f, 0xbf, 0x19, 0xaf, 0x80, 0x93, 0x6d, 0x78, 0xae, 0xbb, 0x84, 0x10,
-	0x22, 0x7c, 0x69, 0x65, 0x55, 0x4b, 0x06, 0xe3, 0xb4, 0x36, 0xea, 0x0b, 0x91, 0xce, 0x86, 0x93,
-	0x45, 0xbf, 0x8c, 0x4d, 0x52, 0x0f, 0xde, 0xa4, 0x07, 0x5f, 0xc6, 0x02, 0x2d, 0xc4, 0x1c, 0x86,
-	0x2e, 0xc9, 0xa1, 0x32, 0x02, 0xee, 0xee, 0x87, 0xcb, 0x50, 0x7f, 0x4d, 0x81, 0x73, 0x2b, 0x9a,
-	0xd5, 0xd2, 0x4c, 0xfe, 0xf8, 0x7d, 0xcd, 0xb6, 0x4d, 0x31, 0x4d, 0x9d, 0x72, 0xef, 0x2b, 0xaf,
-	0x95, 0xdc, 0xfb, 0xef, 0x83, 0x73, 0x19, 0xfe, 0x37, 0xa8, 0x0c, 0x63, 0xee, 0x1d, 0xad, 0xb9,
-	0x40, 0xb6, 0xb5, 0x1d, 0x43, 0x04, 0xd6, 0xe2, 0x6e, 0xda, 0x63, 0x55, 0xa9, 0xfc, 0x5e, 0xec,
-	0x37, 0x8e, 0xb4, 0x52, 0x3d, 0x00, 0xe1, 0xce, 0x6f, 0x58, 0x75, 0xb4, 0x05, 0xc3, 0x9a, 0x49,
-	0x1c, 0x2f, 0x8c, 0x3e, 0xf9, 0xad, 0xb9, 0xec, 0xb4, 0x02, 0x07, 0x7f, 0xbe, 0xe6, 0xff, 0xc2,
-	0x01, 0x6e, 0xf5, 0x9f, 0x29, 0x70, 0x36, 0x3d, 0x94, 0x52, 0x17, 0x6a, 0x51, 0x03, 0x46, 0x9d,
-	0xb0, 0x99, 0xf8, 0x68, 0xdf, 0x29, 0xa7, 0xb8, 0x90, 0x1e, 0xde, 0x50, 0x45, 0xb0, 0xe4, 0xd8,
-	0xae, 0xbf, 0x73, 0xe3, 0x59, 0x2f, 0x02, 0xab, 0x98, 0xd4, 0x13, 0x2c, 0xe3, 0x67, 0x19, 0x68,
-	0x28, 0x75, 0xb7, 0xa9, 0xe9, 0xa4, 0x76, 0xc2, 0xf9, 0xf5, 0x8f, 0x20, 0xed, 0x43, 0x7a, 0xdf,
-	0x8f, 0x37, 0x03, 0x4d, 0x06, 0xcd, 0x83, 0x33, 0xd0, 0xa4, 0x37, 0x7c, 0x9d, 0xa4, 0x46, 0x48,
-	0xef, 0x7c, 0xc6, 0xc3, 0xf7, 0xff, 0x36, 0x98, 0x35, 0xda, 0x43, 0x26, 0xe9, 0xdf, 0x39, 0xc6,
-	0x24, 0xfd, 0x13, 0xdf, 0x48, 0xd0, 0x9f, 0x92, 0xa0, 0x3f, 0x96, 0x34, 0x7e, 0xf0, 0x84, 0x92,
-	0xc6, 0xbf, 0x0c, 0x83, 0x4d, 0xcd, 0x21, 0x96, 0x7f, 0x97, 0x5b, 0xc9, 0xe7, 0x68, 0x10, 0x6e,
-	0xb4, 0x90, 0x0b, 0x06, 0x9f, 0xe4, 0x1a, 0x23, 0x80, 0x05, 0xa1, 0x94, 0xe0, 0x29, 0xc3, 0xc7,
-	0x15, 0x9d, 0x30, 0x4c, 0x57, 0x3f, 0x72, 0x1c, 0xe9, 0xea, 0xd5, 0xbf, 0x55, 0xe0, 0x62, 0x27,
-	0xb6, 0xc4, 0x0c, 0x28, 0x7a, 0xec, 0x33, 0xec, 0xc5, 0x80, 0x92, 0xe0, 0xb6, 0x81, 0x01, 0x25,
-	0x0e, 0xc1, 0x09, 0xba, 0xe8, 0x3d, 0x80, 0xec, 0x4d, 0xee, 0xd7, 0x73, 0x9d, 0xd2, 0xe0, 0xaf,
-	0x69, 0x0b, 0xec, 0xad, 0x42, 0x90, 0x18, 0xf3, 0x56, 0xa2, 0x06, 0x4e, 0x69, 0xa5, 0xfe, 0x4a,
-	0x01, 0x60, 0x95, 0x78, 0x77, 0x6c, 0xe7, 0x36, 0x3d, 0xe3, 0x2f, 0x46, 0xae, 0x0e, 0x86, 0xbf,
-	0x7e, 0xf1, 0x28, 0x2f, 0x42, 0x7f, 0xd3, 0xae, 0xb9, 0x42, 0xff, 0x60, 0x1d, 0x61, 0x4f, 0x35,
-	0x58, 0x29, 0x2a, 0xc2, 0x00, 0x73, 0x7a, 0x12, 0x0a, 0x34, 0xbb, 0x78, 0x60, 0x97, 0x2b, 0x98,
-	0x97, 0x53, 0x0e, 0x29, 0x62, 0x1a, 0xb8, 0xe2, 0x72, 0x67, 0x8c, 0x07, 0x21, 0xe7, 0x65, 0x38,
-	0x80, 0xa2, 0xc7, 0x00, 0x8c, 0xe6, 0x92, 0xd6, 0x30, 0x4c, 0x43, 0x7c, 0xae, 0x23, 0xcc, 0x22,
-	0x0e, 0x95, 0x35, 0xbf, 0xf4, 0x5e, 0xbb, 0x38, 0x2c, 0x7e, 0xed, 0x61, 0xa9, 0xb6, 0xfa, 0x05,
-	0x05, 0x26, 0xc3, 0xc9, 0x13, 0x5b, 0xc5, 0xef, 0x39, 0x0f, 0x06, 0x9c, 0xd9, 0x73, 0x1e, 0xf7,
-	0xbf, 0x73, 0xcf, 0xb9, 0x01, 0x2b, 0xab, 0xe7, 0x0f, 0xc2, 0x28, 0xe1, 0x21, 0x8f, 0x2a, 0x65,
-	0xcc, 0x79, 0xdc, 0x08, 0x57, 0xe7, 0x16, 0xc3, 0x62, 0x2c, 0xd7, 0x51, 0xff, 0xbe, 0x0f, 0xc6,
-	0x56, 0xeb, 0x86, 0xb5, 0xeb, 0xc7, 0x76, 0x0a, 0x2e, 0xde, 0x95, 0xe3, 0xb9, 0x78, 0x7f, 0x0e,
-	0x66, 0x4c, 0xf9, 0x9a, 0x8b, 0x0b, 0x4e, 0x9a, 0x55, 0x0f, 0x66, 0x80, 0xe9, 0x31, 0xcb, 0x19,
-	0x75, 0x70, 0x66, 0x6b, 0xe4, 0xc1, 0xa0, 0xee, 0x27, 0x68, 0xcc, 0x1d, 0xaf, 0x48, 0x9e, 0x8b,
-	0x39, 0x39, 0x74, 0x47, 0xc0, 0xf3, 0xc4, 0xf6, 0x14, 0xb4, 0xd0, 0x47, 0x14, 0x38, 0x43, 0x76,
-	0x79, 0xe8, 0x9a, 0x75, 0x47, 0xdb, 0xda, 0x32, 0x74, 0xf1, 0xe2, 0x8f, 0xef, 0xc4, 0xe5, 0xfd,
-	0x76, 0xf1, 0xcc, 0x62, 0x5a, 0x85, 0x7b, 0xed, 0xe2, 0xb5, 0xd4, 0x48, 0x42, 0x6c, 0x35, 0x53,
-	0x9b, 0xe0, 0x74, 0x52, 0xb3, 0x8f, 0xc2, 0xe8, 0x21, 0xde, 0x89, 0x47, 0xe2, 0x05, 0xfd, 0x6a,
-	0x01, 0xc6, 0xe8, 0x76, 0x5b, 0xb6, 0x75, 0xcd, 0x2c, 0xaf, 0x56, 0xd1, 0xfd, 0xf1, 0x30, 0x89,
-	0xc1, 0x15, 0x63, 0x22, 0x54, 0xe2, 0x32, 0x4c, 0x6f, 0xd9, 0x8e, 0x4e, 0xd6, 0x4b, 0x6b, 0xeb,
-	0xb6, 0x70, 0x3e, 0x2b, 0xaf, 0x56, 0x85, 0x5e, 0xc7, 0xae, 0x3c, 0x96, 0x52, 0xe0, 0x38, 0xb5,
-	0x15, 0xba, 0x05, 0x67, 0xc2, 0xf2, 0x8d, 0x26, 0x7f, 0xb0, 0x40, 0xd1, 0xf5, 0x85, 0x0f, 0x2e,
-	0x96, 0xd2, 0x2a, 0xe0, 0xf4, 0x76, 0x48, 0x83, 0x0b, 0xe2, 0x6e, 0x65, 0xc9, 0x76, 0xee, 0x68,
-	0x4e, 0x2d, 0x8a, 0xb6, 0x3f, 0xcc, 0x5b, 0x5f, 0xce, 0xae, 0x86, 0x3b, 0xe1, 0x50, 0xff, 0x4e,
-	0x81, 0x68, 0x10, 0x4a, 0x74, 0x1e, 0xfa, 0x1c, 0x91, 0xff, 0x4f, 0x04, 0x63, 0xa4, 0x2a, 0x02,
-	0x2d, 0x43, 0x73, 0x00, 0x4e, 0x18, 0x09, 0xb3, 0x10, 0xe6, 0xc5, 0x90, 0x62, 0x58, 0x4a, 0x35,
-	0x28, 0x2a, 0x4f, 0xab, 0x0b, 0x86, 0xc7, 0x50, 0xad, 0x6b, 0x75, 0x4c, 0xcb, 0x58, 0x02, 0x14,
-	0xa3, 0x4e, 0x5c, 0xdf, 0x1c, 0xcd, 0x13, 0xa0, 0xb0, 0x12, 0x2c, 0x20, 0x48, 0x83, 0xf1, 0x66,
-	0xcb, 0x14, 0xf1, 0x94, 0xa8, 0xea, 0xc3, 0xcd, 0x86, 0x57, 0xd3, 0xb2, 0xfb, 0xb1, 0xd5, 0x4f,
-	0x4d, 0xf1, 0xb7, 0x26, 0xa3, 0xc0, 0x51, 0x8c, 0xea, 0x8f, 0x0f, 0x82, 0x14, 0x5e, 0xe7, 0x10,
-	0x52, 0xe8, 0x4f, 0x2b, 0x30, 0xad, 0x9b, 0x06, 0xb1, 0xbc, 0x58, 0xa4, 0x0a, 0x7e, 0x7c, 0x6c,
-	0xe4, 0x8a, 0xfb, 0xd3, 0x24, 0x56, 0xa5, 0x2c, 0x9e, 0xb7, 0x94, 0x52, 0x90, 0x8b, 0x27, 0x40,
-	0x29, 0x10, 0x9c, 0xda, 0x19, 0x36, 0x1e, 0x56, 0x5e, 0x29, 0xcb, 0xd1, 0x33, 0x4b, 0xa2, 0x0c,
-	0x07, 0x50, 0xca, 0x79, 0xeb, 0x8e, 0xdd, 0x6a, 0xba, 0x25, 0xf6, 0x8a, 0x95, 0x2f, 0x0a, 0xe3,
-	0xbc, 0xd7, 0xc3, 0x62, 0x2c, 0xd7, 0x41, 0x0f, 0xc3, 0x18, 0xff, 0xb9, 0xe6, 0x90, 0x2d, 0x63,
-	0x57, 0x1c, 0x4a, 0xcc, 0x78, 0x7a, 0x5d, 0x2a, 0xc7, 0x91, 0x5a, 0x2c, 0x7e, 0x9b, 0xeb, 0xb6,
-	0x88, 0xb3, 0x81, 0x97, 0x45, 0x92, 0x68, 0x1e, 0xbf, 0xcd, 0x2f, 0xc4, 0x21, 0x1c, 0xfd, 0x90,
-	0x02, 0x13, 0x0e, 0x79, 0xb9, 0x65, 0x38, 0x54, 0x84, 0xd1, 0x8c, 0x86, 0x2b, 0x62, 0x1c, 0xe1,
-	0xde, 0xe2, 0x2a, 0xcd, 0xe1, 0x08, 0x52, 0xce, 0x20, 0x03, 0x57, 0x8e, 0x28, 0x10, 0xc7, 0x7a,
-	0x40, 0xa7, 0xca, 0x35, 0xea, 0x96, 0x61, 0xd5, 0xe7, 0xcd, 0xba, 0x2b, 0x32, 0x61, 0x71, 0x9b,
-	0x63, 0x58, 0x8c, 0xe5, 0x3a, 0xe8, 0x11, 0x18, 0x6f, 0xb9, 0x94, 0xed, 0x35, 0x08, 0x9f, 0xdf,
-	0x91, 0xd0, 0xd7, 0x65, 0x43, 0x06, 0xe0, 0x68, 0x3d, 0xf4, 0x18, 0x4c, 0xf8, 0x05, 0x62, 0x96,
-	0x81, 0xa7, 0x63, 0x61, 0x77, 0x93, 0x11, 0x08, 0x8e, 0xd5, 0x9c, 0x9d, 0x87, 0xd3, 0x29, 0xc3,
-	0x3c, 0x14, 0x6f, 0xfd, 0xbf, 0x0a, 0x9c, 0xe1, 0x52, 0x97, 0x9f, 0x5e, 0xda, 0x4f, 0x8e, 0x91,
-	0x9e, 0x67, 0x42, 0x39, 0xd6, 0x3c, 0x13, 0x5f, 0x87, 0x7c, 0x1a, 0xea, 0xcf, 0x16, 0xe0, 0x8d,
-	0x07, 0x7e, 0x97, 0xe8, 0x27, 0x14, 0x18, 0x25, 0xbb, 0x9e, 0xa3, 0x05, 0x4f, 0xfd, 0xe9, 0x26,
-	0xdd, 0x3a, 0x16, 0x26, 0x30, 0xb7, 0x18, 0x12, 0xe2, 0x1b, 0x37, 0x50, 0xa5, 0x24, 0x08, 0x96,
-	0xfb, 0x43, 0xb9, 0x2d, 0x4f, 0x57, 0x24, 0x3b, 0xc5, 0x09, 0x2e, 0x28, 0x20, 0xb3, 0x4f, 0xc0,
-	0x64, 0x1c, 0xf3, 0xa1, 0xf6, 0xca, 0xe7, 0x15, 0x48, 0x0d, 0xc7, 0x89, 0x4a, 0x30, 0xa5, 0xb5,
-	0x3c, 0x3b, 0x72, 0xaf, 0x27, 0x44, 0x49, 0xe6, 0xf4, 0x3d, 0x1f, 0x07, 0xe2, 0x64, 0x7d, 0x6e,
-	0x80, 0xb5, 0x5a, 0x9a, 0x19, 0x45, 0xc3, 0x05, 0x2e, 0x61, 0x80, 0x4d, 0x80, 0x71, 0x5a, 0x1b,
-	0xf5, 0x5f, 0x2a, 0x70, 0x26, 0xd5, 0x2c, 0xd9, 0x85, 0x2d, 0x2e, 0x7d, 0xdb, 0x17, 0x8e, 0x73,
-	0xdb, 0xab, 0xbf, 0x5c, 0x80, 0xa1, 0x35, 0xc7, 0xa6, 0x47, 0xdb, 0x09, 0x58, 0xe0, 0xb4, 0x88,
-	0x05, 0x2e, 0x97, 0x7d, 0x41, 0x74, 0x36, 0xd3, 0xe4, 0x66, 0xc4, 0x4c, 0x6e, 0xf3, 0xbd, 0x10,
-	0xe9, 0x6c, 0x63, 0xfb, 0x92, 0x02, 0xa3, 0xa2, 0xe6, 0x09, 0x18, 0xd5, 0xbe, 0x23, 0x6a, 0x54,
-	0x7b, 0x77, 0x0f, 0xe3, 0xca, 0xb0, 0xa2, 0x7d, 0x46, 0x81, 0x71, 0x51, 0x63, 0x85, 0x34, 0x36,
-	0x89, 0x83, 0x96, 0x60, 0xc8, 0x6d, 0xb1, 0x85, 0x14, 0x03, 0xba, 0x20, 0x8b, 0x47, 0xce, 0xa6,
-	0xa6, 0xd3, 0xee, 0x57, 0x79, 0x15, 0x29, 0x95, 0x32, 0x2f, 0xc0, 0x7e, 0x63, 0xba, 0xf7, 0x1d,
-	0xdb, 0x4c, 0x84, 0xad, 0xc7, 0xb6, 0x49, 0x30, 0x83, 0x50, 0x3d, 0x8f, 0xfe, 0xf5, 0x75, 0x38,
-	0xa6, 0xe7, 0x51, 0xb0, 0x8b, 0x79, 0xb9, 0xfa, 0x85, 0x81, 0x60, 0xb2, 0x99, 0x52, 0x7f, 0x03,
-	0x46, 0x74, 0x87, 0x68, 0x1e, 0xa9, 0x2d, 0xec, 0x75, 0xd3, 0x39, 0x26, 0x07, 0x94, 0xfc, 0x16,
-	0x38, 0x6c, 0x4c, 0x8f, 0x5c, 0xd9, 0xc1, 0xb3, 0x10, 0x4a, 0x27, 0x99, 0xce, 0x9d, 0xdf, 0x0a,
-	0x03, 0xf6, 0x1d, 0x2b, 0x78, 0x1c, 0xd2, 0x91, 0x30, 0x1b, 0xca, 0x2d, 0x5a, 0x1b, 0xf3, 0x46,
-	0x72, 0xda, 0x86, 0xfe, 0x0e, 0x69, 0x1b, 0x4c, 0x18, 0x6a, 0xb0, 0x65, 0xe8, 0x29, 0xb3, 0x6e,
-	0x64, 0x41, 0xc3, 0x25, 0xe2, 0xbf, 0x5d, 0xec, 0x93, 0xa0, 0xa2, 0x93, 0xe5, 0x5b, 0x74, 0x64,
-	0xd1, 0x29, 0x30, 0xf3, 0xe0, 0x10, 0x8e, 0xf6, 0xa2, 0xf9, 0x40, 0x86, 0xf2, 0xdb, 0x49, 0x45,
-	0xf7, 0xa4, 0x14, 0x20, 0x7c, 0xea, 0xb3, 0x72, 0x82, 0xa0, 0xcf, 0x2b, 0x70, 0xae, 0x96, 0x9e,
-	0xb1, 0x4d, 0x5c, 0x95, 0xe7, 0x7a, 0x98, 0x9d, 0x91, 0x04, 0x6e, 0xa1, 0x28, 0x26, 0x2c, 0x2b,
-	0x4b, 0x1c, 0xce, 0xea, 0x8c, 0xfa, 0xbd, 0xfd, 0xc1, 0xd7, 0x24, 0x2c, 0x1d, 0xe9, 0x76, 0x28,
-	0x25, 0x8f, 0x1d, 0x0a, 0xbd, 0xdd, 0xcf, 0x1f, 0xc6, 0xb7, 0xeb, 0xa5, 0x78, 0xfe, 0xb0, 0x31,
-	0x41, 0x3a, 0x92, 0x33, 0xac, 0x05, 0xa7, 0x5d, 0x4f, 0x33, 0x49, 0xd5, 0x10, 0x97, 0x83, 0xae,
-	0xa7, 0x35, 0x9a, 0x39, 0x12, 0x78, 0xf1, 0x40, 0x0d, 0x49, 0x54, 0x38, 0x0d, 0x3f, 0xfa, 0x6e,
-	0x16, 0x7c, 0x4f, 0x33, 0xd9, 0xe5, 0x29, 0x4f, 0x62, 0x1a, 0x12, 0x3f, 0xbc, 0xbb, 0xbb, 0x08,
-	0xad, 0x97, 0x8e, 0x0f, 0x67, 0x52, 0x42, 0xaf, 0xc0, 0x19, 0x7a, 0x00, 0xce, 0xeb, 0x9e, 0xb1,
-	0x63, 0x78, 0x7b, 0x61, 0x17, 0x0e, 0x9f, 0xb5, 0x8b, 0x69, 0xdb, 0xcb, 0x69, 0xc8, 0x70, 0x3a,
-	0x0d, 0xf5, 0x6f, 0x14, 0x40, 0xc9, 0xbd, 0x8e, 0x4c, 0x18, 0xae, 0xf9, 0x91, 0x13, 0x94, 0x23,
-	0xc9, 0xaa, 0x13, 0x1c, 0x21, 0x41, 0xc0, 0x85, 0x80, 0x02, 0xb2, 0x61, 0xe4, 0xce, 0xb6, 0xe1,
-	0x11, 0xd3, 0x70, 0xbd, 0x23, 0x4a, 0xe2, 0x13, 0xc4, 0x84, 0x7f, 0xd6, 0x47, 0x8c, 0x43, 0x1a,
-	0xea, 0x27, 0xfb, 0x61, 0x38, 0xc8, 0xc6, 0x79, 0xb0, 0x9b, 0x75, 0x0b, 0x90, 0x2e, 0x05, 0x04,
-	0xec, 0xc5, 0x66, 0xca, 0x64, 0xa0, 0x52, 0x02, 0x19, 0x4e, 0x21, 0x80, 0x5e, 0x81, 0x69, 0xc3,
-	0xda, 0x72, 0xb4, 0x20, 0xdc, 0x61, 0xc9, 0x37, 0x94, 0xe5, 0x20, 0xcc, 0xb4, 0xe8, 0x4a, 0x0a,
-	0x3a, 0x9c, 0x4a, 0x04, 0x11, 0x18, 0xe2, 0x09, 0x91, 0xfd, 0x5b, 0x97, 0x5c, 0xb6, 0x7a, 0x2e,
-	0x6b, 0x86, 0xec, 0xdd, 0x97, 0x3d, 0x7d, 0xdc, 0x3c, 0x38, 0x2d, 0xff, 0xdf, 0xbf, 0x90, 0x12,
-	0xfb, 0xbe, 0x94, 0x9f, 0x5e, 0x78, 0xb7, 0xc5, 0x83, 0xd3, 0x46, 0x0b, 0x71, 0x9c, 0xa0, 0xfa,
-	0xbb, 0x0a, 0x0c, 0xf0, 0x18, 0x60, 0xc7, 0x2f, 0x6a, 0xbe, 0x2f, 0x22, 0x6a, 0x3e, 0x9e, 0x67,
-	0x90, 0xac, 0xab, 0x99, 0x29, 0xfd, 0x7f, 0x47, 0x81, 0x11, 0x56, 0xe3, 0x04, 0x64, 0xbf, 0x17,
-	0xa3, 0xb2, 0xdf, 0xa3, 0xb9, 0x47, 0x93, 0x21, 0xf9, 0xfd, 0x6e, 0x9f, 0x18, 0x0b, 0x13, 0xad,
-	0x2a, 0x70, 0x5a, 0x3c, 0x8c, 0x5d, 0x36, 0xb6, 0x08, 0xdd, 0xe2, 0x65, 0x6d, 0xcf, 0x15, 0x4f,
-	0x35, 0x78, 0xd0, 0x99, 0x24, 0x18, 0xa7, 0xb5, 0x41, 0xbf, 0xaa, 0x50, 0x21, 0xc6, 0x73, 0x0c,
-	0xbd, 0xa7, 0xcb, 0xe0, 0xa0, 0x6f, 0x73, 0x2b, 0x1c, 0x19, 0xd7, 0x4d, 0x37, 0x42, 0x69, 0x86,
-	0x95, 0xde, 0x6b, 0x17, 0x8b, 0x29, 0x36, 0xe3, 0x30, 0xad, 0xb5, 0xeb, 0x7d, 0xe4, 0x2f, 0x3a,
-	0x56, 0x61, 0xda, 0x98, 0xdf, 0x63, 0x74, 0x03, 0x06, 0x5c, 0xdd, 0x6e, 0xfa, 0x4f, 0xab, 0xef,
-	0x4b, 0xb3, 0x0d, 0xc6, 0xcd, 0x82, 0xc1, 0x04, 0x57, 0x69, 0x4b, 0xcc, 0x11, 0xcc, 0xbe, 0x04,
-	0x63, 0x72, 0xcf, 0x53, 0x74, 0xdf, 0x72, 0xd4, 0x63, 0xee, 0x90, 0xce, 0x61, 0xb2, 0xae, 0xfc,
-	0x47, 0x7d, 0x30, 0x88, 0x49, 0x5d, 0xa4, 0x4c, 0x3b, 0x40, 0xe7, 0x34, 0xfc, 0xfc, 0xc1, 0x85,
-	0xfc, 0xef, 0xf0, 0xe4, 0xa4, 0x38, 0xcf, 0xdb, 0x96, 0x34, 0x07, 0x72, 0x0a, 0x61, 0x64, 0x05,
-	0x89, 0xa4, 0xfa, 0xf2, 0x3b, 0xd4, 0xf2, 0x81, 0x75, 0x93, 0x3a, 0x0a, 0xfd, 0xa0, 0x02, 0x48,
-	0xd3, 0x75, 0xe2, 0xba, 0x98, 0xb8, 0x74, 0xee, 0xb9, 0xb0, 0xca, 0xb9, 0x6c, 0xbe, 0xa8, 0xd8,
-	0x71, 0x6c, 0xa1, 0xd8, 0x96, 0x00, 0xb9, 0x38, 0x85, 0x78, 0x2f, 0xe9, 0xac, 0x7e, 0x5f, 0x81,
-	0xb1, 0x48, 0xb6, 0xb0, 0x46, 0x68, 0x4b, 0xcf, 0xef, 0xb2, 0xe3, 0xbf, 0xfe, 0xba, 0xd0, 0xa1,
-	0x12, 0xb7, 0xcf, 0xdf, 0x0a, 0xd2, 0x5d, 0x1c, 0x4d, 0x62, 0x31, 0xf5, 0x53, 0x0a, 0x9c, 0xf5,
-	0x07, 0x14, 0x8d, 0x6b, 0x8e, 0xae, 0xc2, 0xb0, 0xd6, 0x34, 0x98, 0xa1, 0x57, 0x36, 0x95, 0xcf,
-	0xaf, 0x55, 0x58, 0x19, 0x0e, 0xa0, 0x91, 0x24, 0xcd, 0x85, 0x03, 0x93, 0x34, 0xbf, 0x59, 0x4a,
-	0x3b, 0x3d, 0x10, 0xca, 0x2e, 0x01, 0x61, 0xee, 0xcc, 0xa9, 0xbe, 0x13, 0x46, 0xaa, 0xd5, 0x1b,
-	0x7c, 0x49, 0x0f, 0x71, 0xe3, 0xa3, 0x7e, 0xbc, 0x0f, 0xc6, 0x45, 0x82, 0x06, 0x83, 0x99, 0x80,
-	0x4e, 0xe0, 0x9c, 0x5b, 0x87, 0x11, 0x37, 0xb8, 0xc3, 0x28, 0x64, 0xf3, 0xa9, 0xe0, 0x5a, 0x22,
-	0x9e, 0x65, 0x2f, 0xbc, 0xbe, 0x08, 0x11, 0xa1, 0x9b, 0x30, 0xf8, 0x32, 0xe5, 0xb9, 0xfe, 0xb7,
-	0xda, 0x15, 0xeb, 0x0b, 0x3e, 0x44, 0xc6, 0xae, 0x5d, 0x2c, 0x50, 0x20, 0x97, 0x3d, 0x4f, 0x64,
-	0x42, 0x60, 0x2f, 0xa1, 0x3a, 0x23, 0x33, 0x1b, 0x24, 0x9d, 0x1f, 0x13, 0xaf, 0x1c, 0xd9, 0x2f,
-	0x1c, 0x10, 0x62, 0x29, 0x42, 0x23, 0x2d, 0x5e, 0x27, 0x29, 0x42, 0x23, 0x7d, 0xce, 0x38, 0xae,
-	0x1f, 0x85, 0x33, 0xa9, 0x93, 0x71, 0xb0, 0x88, 0xad, 0xfe, 0xab, 0x02, 0xf4, 0x57, 0x09, 0xa9,
-	0x9d, 0xc0, 0xce, 0x7c, 0x31, 0x22, 0x81, 0x7d, 0x6b, 0xee, 0x24, 0xa5, 0x59, 0x96, 0xbe, 0xad,
-	0x98, 0xa5, 0xef, 0x89, 0xdc, 0x14, 0x3a, 0x9b, 0xf9, 0xfe, 0xaa, 0x00, 0x43, 0xb4, 0x5a, 0x79,
-	0xb5, 0x8a, 0x1a, 0xd2, 0x56, 0x2e, 0xe4, 0x17, 0x9f, 0x05, 0xba, 0x83, 0x36, 0x31, 0xfd, 0x72,
-	0x0c, 0x91, 0xf8, 0x45, 0x0c, 0xb2, 0x72, 0x04, 0xe4, 0x44, 0xec, 0x09, 0x46, 0xd4, 0xcf, 0x2b,
-	0x83, 0x03, 0x42, 0xe8, 0x8e, 0xa4, 0x93, 0xf6, 0xe7, 0xf7, 0xdd, 0x4d, 0x27, 0xda, 0x41, 0x3d,
-	0x55, 0x3f, 0xae, 0xc0, 0xa9, 0x58, 0xab, 0x2e, 0x94, 0xc6, 0x63, 0x61, 0x80, 0xea, 0xd7, 0x14,
-	0xfa, 0x8d, 0xa5, 0x8c, 0xa0, 0x8b, 0x1e, 0x85, 0x09, 0xc0, 0x0a, 0x1d, 0x13, 0x80, 0x5d, 0x84,
-	0x7e, 0x2a, 0x19, 0xc9, 0x3e, 0x3b, 0x54, 0x60, 0xc2, 0xac, 0x14, 0xe9, 0x30, 0xa1, 0x4b, 0x39,
-	0xe0, 0xc9, 0x96, 0xe0, 0x9d, 0x5d, 0xb1, 0xe2, 0xe0, 0xba, 0xb1, 0x14, 0x41, 0x81, 0x63, 0x28,
-	0xd5, 0xdf, 0x56, 0x60, 0x98, 0x0e, 0xf3, 0x04, 0x98, 0xe3, 0xb7, 0x47, 0x99, 0xe3, 0xbb, 0xf2,
-	0xee, 0xa9, 0x0c, 0x9e, 0xf8, 0xd7, 0x05, 0x60, 0x19, 0x8c, 0x85, 0x7f, 0x91, 0xe4, 0x39, 0xa4,
-	0x64, 0xf8, 0x3c, 0x5d, 0x11, 0x8e, 0x47, 0x31, 0xa3, 0xb4, 0xe4, 0x7c, 0xf4, 0xd6, 0x88, 0x6f,
-	0x51, 0x44, 0xb8, 0x48, 0xf1, 0x2f, 0xba, 0x0b, 0xe3, 0xee, 0xb6, 0x6d, 0x7b, 0xe5, 0xf0, 0xe3,
-	0xc9, 0x7d, 0x01, 0xc1, 0x1e, 0x8b, 0xfb, 0x43, 0xe1, 0x57, 0xb9, 0x55, 0x19, 0x37, 0x8e, 0x92,
-	0x42, 0x73, 0x00, 0x9b, 0xa6, 0xad, 0xdf, 0xe6, 0xae, 0x4d, 0xfc, 0x71, 0x30, 0x73, 0x9e, 0x58,
-	0x08, 0x4a, 0xb1, 0x54, 0xa3, 0x27, 0x2f, 0xae, 0xaf, 0x29, 0x7c, 0xa6, 0x0f, 0xf1, 0x8d, 0x9e,
-	0xa0, 0x23, 0xdc, 0x5b, 0x60, 0xd0, 0x61, 0x3a, 0x82, 0x58, 0xb1, 0xe0, 0xe3, 0xe3, 0x9a, 0x03,
-	0x16, 0x50, 0xba, 0x3d, 0xb8, 0xe2, 0xd3, 0x1f, 0x5e, 0x38, 0xc8, 0xea, 0x8a, 0xfa, 0xcb, 0x62,
-	0x98, 0x41, 0x12, 0xec, 0x26, 0x8c, 0x33, 0xcd, 0x22, 0x96, 0x7d, 0xfb, 0xed, 0x5d, 0x7e, 0x23,
-	0x72, 0xd3, 0xd0, 0x2d, 0x37, 0x52, 0x8c, 0xa3, 0x04, 0xd0, 0x23, 0x30, 0xee, 0x8f, 0x8e, 0x7b,
-	0xc7, 0x16, 0xc2, 0x17, 0x9a, 0x6b, 0x32, 0x00, 0x47, 0xeb, 0xa9, 0x9f, 0x2e, 0xc0, 0x25, 0xde,
-	0x77, 0x66, 0x79, 0x29, 0x93, 0x26, 0xb1, 0x6a, 0xc4, 0xd2, 0xf7, 0x98, 0x9c, 0x5d, 0xb3, 0xeb,
-	0xe8, 0x15, 0x18, 0xbc, 0x43, 0x48, 0x2d, 0xb8, 0xc2, 0x78, 0x36, 0x7f, 0x0e, 0xf1, 0x0c, 0x12,
-	0xcf, 0x32, 0xf4, 0xfc, 0x36, 0x98, 0xff, 0x8f, 0x05, 0x49, 0x4a, 0xbc, 0xe9, 0xd8, 0x9b, 0x81,
-	0x38, 0x78, 0xf4, 0xc4, 0xd7, 0x18, 0x7a, 0x4e, 0x9c, 0xff, 0x8f, 0x05, 0x49, 0x75, 0x0d, 0xee,
-	0xeb, 0xa2, 0xe9, 0x61, 0xc4, 0xfe, 0x83, 0x30, 0xf2, 0xd1, 0x1f, 0x06, 0xe3, 0x9f, 0x28, 0xf0,
-	0x26, 0x09, 0xe5, 0xe2, 0x2e, 0xd5, 0x44, 0x4a, 0x5a, 0x53, 0xd3, 0xa9, 0xae, 0xcf, 0x62, 0xd4,
-	0x1d, 0x2a, 0x6b, 0xef, 0xc7, 0x15, 0x18, 0xe2, 0x1e, 0x79, 0x3e, 0xfb, 0x7d, 0xb1, 0xc7, 0x29,
-	0xcf, 0xec, 0x92, 0x9f, 0xcd, 0xcc, 0x1f, 0x1b, 0xff, 0xed, 0x62, 0x9f, 0xbe, 0xfa, 0x6f, 0x07,
-	0xe0, 0x9b, 0xbb, 0x47, 0x84, 0xbe, 0xa6, 0xc8, 0xd9, 0xc6, 0xb9, 0x8d, 0xbc, 0x71, 0xbc, 0x9d,
-	0x0f, 0xac, 0x41, 0xc2, 0xc0, 0xf0, 0x6c, 0x22, 0x21, 0xf9, 0x11, 0x19, 0x9a, 0xc2, 0x81, 0xa1,
-	0x7f, 0xae, 0xc0, 0x18, 0x3d, 0x96, 0x02, 0xe6, 0xc2, 0x97, 0xa9, 0x79, 0xcc, 0x23, 0x5d, 0x95,
-	0x48, 0xc6, 0xe2, 0x5a, 0xc9, 0x20, 0x1c, 0xe9, 0x1b, 0xda, 0x88, 0x5e, 0xff, 0x71, 0x15, 0xf1,
-	0x72, 0x9a, 0x5c, 0x72, 0x98, 0x74, 0xff, 0xb3, 0x26, 0x4c, 0x44, 0x67, 0xfe, 0x38, 0xcd, 0x64,
-	0xb3, 0x4f, 0xc2, 0x54, 0x62, 0xf4, 0x87, 0x32, 0xc8, 0x7c, 0x69, 0x10, 0x8a, 0xd2, 0x54, 0xa7,
-	0x85, 0xa7, 0x41, 0x9f, 0x55, 0x60, 0x54, 0xb3, 0x2c, 0xe1, 0xd8, 0xe4, 0xef, 0xdf, 0x5a, 0x8f,
-	0xab, 0x9a, 0x46, 0x6a, 0x6e, 0x3e, 0x24, 0x13, 0xf3, 0xdc, 0x91, 0x20, 0x58, 0xee, 0x4d, 0x07,
-	0xef, 0xdc, 0xc2, 0x89, 0x79, 0xe7, 0xa2, 0x0f, 0xf8, 0x07, 0x71, 0x5f, 0xfe, 0x40, 0x15, 0x07,
-	0xcc, 0x0d, 0x3b, 0xd7, 0x33, 0xac, 0x92, 0xdf, 0xaf, 0xb0, 0x43, 0x36, 0x8c, 0x22, 0x24, 0xce,
-	0xa4, 0x5c, 0x4e, 0x96, 0x07, 0x86, 0x28, 0x0a, 0xce, 0xee, 0xb0, 0x08, 0x47, 0xc9, 0xa3, 0x1f,
-	0x55, 0x60, 0xec, 0xae, 0x6d, 0x69, 0xa6, 0xf0, 0xb3, 0x16, 0xd7, 0x34, 0xef, 0x3b, 0x9e, 0x79,
-	0x09, 0xc8, 0x70, 0xdf, 0x4a, 0xb9, 0x04, 0x47, 0xba, 0xc1, 0x32, 0xe6, 0xb3, 0xb7, 0xd5, 0x83,
-	0xa1, 0x3c, 0x2d, 0xbf, 0xab, 0x9e, 0x7d, 0x02, 0x26, 0xe3, 0x7b, 0xf0, 0x50, 0xdf, 0xd3, 0x3a,
-	0x3c, 0x70, 0x88, 0xfe, 0x76, 0x79, 0xf4, 0xa9, 0xbf, 0xd1, 0x1f, 0x39, 0x4a, 0x33, 0xb7, 0x47,
-	0x17, 0xb6, 0xf2, 0x57, 0x63, 0x1f, 0x33, 0x67, 0xd1, 0xc6, 0x71, 0x6d, 0xd8, 0xa3, 0xfd, 0xa2,
-	0xfb, 0x4e, 0xee, 0x8b, 0x7e, 0xad, 0x7d, 0x52, 0x3d, 0xef, 0xcc, 0x05, 0x6e, 0x0b, 0x10, 0x0b,
-	0x16, 0x26, 0x6b, 0x62, 0x81, 0x3a, 0x0d, 0xd7, 0xf0, 0xc3, 0x4d, 0x4b, 0x22, 0xdd, 0x33, 0xbc,
-	0x18, 0xfb, 0x70, 0x75, 0x39, 0x72, 0x58, 0xac, 0xdb, 0x4d, 0xdb, 0xb4, 0xeb, 0x7b, 0xf3, 0x77,
-	0x34, 0x87, 0x60, 0xbb, 0xe5, 0x09, 0x6c, 0xdd, 0x0a, 0x88, 0x1f, 0x1e, 0x80, 0x2b, 0x12, 0xba,
-	0xd4, 0xa8, 0x9c, 0x87, 0x79, 0xab, 0xf0, 0x6a, 0x3c, 0x78, 0x66, 0x21, 0xbf, 0x93, 0xe9, 0x41,
-	0xfd, 0xca, 0x17, 0x4c, 0x13, 0xb5, 0x15, 0x80, 0x86, 0xb6, 0x2b, 0x22, 0xb9, 0x89, 0xd3, 0xa2,
-	0x76, 0x2c, 0x1d, 0x5c, 0x09, 0xc8, 0xf0, 0xee, 0x3d, 0xe7, 0xdb, 0x39, 0x43, 0xc0, 0x11, 0x49,
-	0x80, 0xd2, 0x88, 0x7a, 0x8e, 0x16, 0x3a, 0xdb, 0x80, 0x53, 0xb1, 0x9e, 0x1f, 0xeb, 0x3d, 0xe3,
-	0x97, 0x86, 0x7c, 0x05, 0x59, 0x84, 0x98, 0xfb, 0x25, 0x05, 0xce, 0x93, 0x2c, 0x81, 0x53, 0x68,
-	0xcb, 0xcf, 0x1d, 0x97, 0x40, 0x2b, 0x12, 0x6e, 0x65, 0x81, 0x71, 0x76, 0xcf, 0xd0, 0x1e, 0x80,
-	0x1b, 0x7c, 0xd3, 0xbd, 0xc4, 0xad, 0x48, 0x65, 0x12, 0xdc, 0x0e, 0x13, 0xfe, 0xc6, 0x12, 0x31,
-	0xf4, 0x53, 0x0a, 0x4c, 0x9b, 0x29, 0x1c, 0x4e, 0x70, 0xcc, 0xea, 0x31, 0x9c, 0x2d, 0xdc, 0x43,
-	0x25, 0x0d, 0x82, 0x53, 0xbb, 0x82, 0x7e, 0x26, 0x33, 0xc4, 0x30, 0x97, 0x4c, 0xd6, 0x8f, 0xe3,
-	0x1b, 0xcc, 0x11, 0x6d, 0xf8, 0xd3, 0x0a, 0xa0, 0x5a, 0x42, 0xf9, 0x16, 0xce, 0x89, 0x4f, 0x1f,
-	0xb9, 0x89, 0x81, 0xbb, 0x18, 0x25, 0xcb, 0x71, 0x4a, 0x27, 0xd8, 0x3a, 0x7b, 0x29, 0x3c, 0x5f,
-	0x3c, 0x01, 0xee, 0x75, 0x9d, 0xd3, 0x8e, 0x13, 0xbe, 0xce, 0x69, 0x10, 0x9c, 0xda, 0x15, 0xf5,
-	0xb7, 0x46, 0xb8, 0x2d, 0x98, 0xf9, 0x80, 0xbc, 0x08, 0x83, 0x9b, 0x9a, 0x7e, 0x5b, 0xdc, 0xbf,
-	0xe6, 0xf4, 0x4a, 0x5a, 0x60, 0x18, 0xb8, 0x15, 0x86, 0xff, 0x8f, 0x05, 0x56, 0xf4, 0x3c, 0xf4,
-	0xd5, 0x2c, 0xff, 0x85, 0xfd, 0xbb, 0x7b, 0xb8, 0x5f, 0x08, 0xe3, 0x94, 0x94, 0x57, 0xab, 0x98,
-	0x22, 0x45, 0x16, 0x0c, 0x5b, 0xc2, 0x74, 0x2a, 0xac, 0x5b, 0x4f, 0xe5, 0x25, 0x10, 0x98, 0x60,
-	0x03, 0xc3, 0xaf, 0x5f, 0x82, 0x03, 0x1a, 0x94, 0x5e, 0xec, 0x7e, 0x33, 0x37, 0xbd, 0xe0, 0x46,
-	0x48, 0x0e, 0xe0, 0x1a, 0xbf, 0x15, 0x22, 0x30, 0xe8, 0x69, 0x86, 0xe5, 0xf9, 0xaf, 0xe5, 0x1f,
-	0xcf, 0x4b, 0x6d, 0x9d, 0x62, 0x09, 0x2d, 0xa4, 0xec, 0xa7, 0x8b, 0x05, 0x72, 0xb4, 0x09, 0x83,
-	0xfc, 0xc5, 0xbc, 0xf8, 0x84, 0x72, 0xdf, 0xaf, 0xf1, 0x47, 0xf8, 0x7c, 0x1b, 0xf0, 0xff, 0xb1,
-	0xc0, 0x8c, 0x5e, 0x82, 0x61, 0xd7, 0x77, 0x47, 0x1b, 0xee, 0x6d, 0xea, 0x02, 0x5f, 0x34, 0xf1,
-	0xfe, 0x57, 0x38, 0xa1, 0x05, 0xf8, 0xd1, 0x26, 0x0c, 0x19, 0x42, 0xa5, 0x1a, 0xc9, 0xbf, 0xed,
-	0x7c, 0x75, 0x89, 0x69, 0x1b, 0xbe, 0xa6, 0xe4, 0x23, 0xce, 0xf2, 0x39, 0x81, 0xaf, 0xa3, 0xcf,
-	0x09, 0x7a, 0x19, 0x80, 0xf8, 0x26, 0x74, 0x77, 0x66, 0x34, 0xff, 0x96, 0x91, 0x0c, 0xf1, 0xbe,
-	0x40, 0x14, 0x14, 0xb9, 0x58, 0x22, 0x82, 0x5e, 0x91, 0x6d, 0x76, 0x63, 0xbd, 0x05, 0x01, 0x49,
-	0x86, 0xb5, 0x09, 0xaf, 0xee, 0x02, 0x73, 0x91, 0x64, 0x4a, 0x53, 0xbf, 0x04, 0x00, 0xe1, 0xb5,
-	0x2e, 0xda, 0x82, 0x61, 0x9f, 0x4a, 0x2f, 0x61, 0x78, 0xae, 0x0b, 0x30, 0xdf, 0x5e, 0xfe, 0x2f,
-	0x1c, 0xe0, 0x46, 0xa5, 0xb4, 0x70, 0x50, 0x61, 0x86, 0xdc, 0xee, 0x42, 0x41, 0xbd, 0x0c, 0xa0,
-	0x87, 0x41, 0x5e, 0xfb, 0xf2, 0xaf, 0x55, 0x10, 0x00, 0x36, 0x5c, 0x2b, 0x29, 0x46, 0xac, 0x44,
-	0x24, 0xc3, 0x2b, 0xbd, 0x3f, 0x97, 0x57, 0xfa, 0xe3, 0x70, 0x4a, 0x78, 0x01, 0x56, 0xd8, 0x2d,
-	0xa3, 0xb7, 0x27, 0x1e, 0x6e, 0x32, 0xff, 0xd0, 0x52, 0x14, 0x84, 0xe3, 0x75, 0xd1, 0xbf, 0x51,
-	0x60, 0x58, 0x17, 0x02, 0x9a, 0xe0, 0x6d, 0xcb, 0xbd, 0x5d, 0xea, 0xcf, 0xf9, 0xf2, 0x1e, 0x17,
-	0xe4, 0x9f, 0xf1, 0xb9, 0xaa, 0x5f, 0x7c, 0x44, 0x62, 0x7c, 0xd0, 0x6b, 0xf4, 0x7b, 0x0a, 0x8c,
-	0x6a, 0xa6, 0x69, 0xeb, 0x9a, 0xc7, 0xc2, 0x03, 0xf2, 0x17, 0xa5, 0xb7, 0x7a, 0x1c, 0xc5, 0x7c,
-	0x88, 0x91, 0x0f, 0xe4, 0xbd, 0x81, 0x25, 0x20, 0x84, 0x1c, 0xd1, 0x58, 0xe4, 0xee, 0xa3, 0x7f,
-	0xaa, 0xc0, 0x9b, 0xf8, 0x33, 0xde, 0x12, 0x95, 0xb9, 0xb6, 0x0c, 0x5d, 0xf3, 0x08, 0x8f, 0x59,
-	0xea, 0x3f, 0x21, 0xe3, 0x3e, 0xf4, 0xc3, 0x87, 0xf6, 0xa1, 0xbf, 0xba, 0xdf, 0x2e, 0xbe, 0xa9,
-	0xd4, 0x05, 0x6e, 0xdc, 0x55, 0x0f, 0xd0, 0x5d, 0x18, 0x37, 0xe5, 0xf8, 0xe3, 0x82, 0xc9, 0xe7,
-	0xba, 0x7e, 0x8d, 0x04, 0x32, 0xe7, 0x06, 0x86, 0x48, 0x11, 0x8e, 0x92, 0x9a, 0xbd, 0x0d, 0xe3,
-	0x91, 0x8d, 0x76, 0xac, 0x86, 0x6b, 0x0b, 0x26, 0xe3, 0xfb, 0xe1, 0x58, 0xf5, 0xbc, 0x9b, 0x30,
-	0x12, 0x08, 0x0b, 0xe8, 0x92, 0x44, 0x28, 0x14, 0xbd, 0x6e, 0x92, 0x3d, 0x4e, 0xb5, 0x18, 0xb1,
-	0xa1, 0x70, 0x23, 0x21, 0x8b, 0xde, 0x28, 0x10, 0xaa, 0x7f, 0x20, 0x6e, 0x55, 0xd7, 0x49, 0xa3,
-	0x69, 0x6a, 0x1e, 0x79, 0xfd, 0xfb, 0x21, 0xa9, 0xff, 0x59, 0xe1, 0xe7, 0x0d, 0x17, 0x6d, 0x90,
-	0x06, 0xa3, 0x0d, 0x9e, 0xfc, 0x8e, 0xc5, 0x7a, 0x54, 0xf2, 0x47, 0x99, 0x5c, 0x09, 0xd1, 0x60,
-	0x19, 0x27, 0xba, 0x03, 0x23, 0xbe, 0x30, 0xe8, 0x5b, 0x6a, 0x96, 0x7a, 0x13, 0xce, 0x02, 0xb9,
-	0x33, 0x38, 0x5a, 0xfd, 0x12, 0x17, 0x87, 0xb4, 0x54, 0x0d, 0x50, 0xb2, 0x0d, 0xba, 0x3f, 0x7c,
-	0xcf, 0xa6, 0x44, 0xd3, 0xd5, 0x24, 0xde, 0xb4, 0xf9, 0x46, 0xd6, 0x42, 0x96, 0x91, 0x55, 0xfd,
-	0xcd, 0x02, 0x4c, 0x0b, 0xd5, 0x73, 0x5e, 0xd7, 0xed, 0x96, 0xe5, 0x85, 0xcf, 0x8c, 0xf9, 0xdb,
-	0x7d, 0x41, 0x84, 0x89, 0x93, 0xfc, 0x61, 0x3f, 0x16, 0x10, 0x74, 0x8b, 0x5b, 0x3f, 0xad, 0xda,
-	0xba, 0x7d, 0x9b, 0x58, 0x21, 0x97, 0x90, 0x83, 0x64, 0x2c, 0xa6, 0x55, 0xc0, 0xe9, 0xed, 0xd0,
-	0x0e, 0xa0, 0x86, 0xb6, 0x1b, 0xc7, 0x96, 0x2f, 0x09, 0x1a, 0xd3, 0x17, 0x57, 0x12, 0xd8, 0x70,
-	0x0a, 0x05, 0x7a, 0x90, 0x52, 0x49, 0xae, 0xe9, 0x91, 0x1a, 0x1f, 0xa2, 0xef, 0xd4, 0xc1, 0x0e,
-	0xd2, 0xf9, 0x28, 0x08, 0xc7, 0xeb, 0xaa, 0x1f, 0x1d, 0x84, 0xf3, 0xd1, 0x49, 0xa4, 0x5f, 0xa8,
-	0xff, 0xbc, 0xfe, 0x49, 0xff, 0xed, 0x18, 0x9f, 0xc8, 0xfb, 0xe3, 0x6f, 0xc7, 0x66, 0x64, 0xa7,
-	0x21, 0xd1, 0x28, 0xf2, 0x8e, 0xec, 0xeb, 0xf0, 0x56, 0x3e, 0xe3, 0x71, 0x74, 0xdf, 0xb1, 0xc6,
-	0x04, 0xf8, 0x84, 0x02, 0xb3, 0xd1, 0xe2, 0x25, 0xc3, 0x32, 0xdc, 0x6d, 0x91, 0xf7, 0xe4, 0xf0,
-	0x4f, 0xd7, 0x58, 0xe6, 0xe4, 0xe5, 0x4c, 0x8c, 0xb8, 0x03, 0x35, 0xf4, 0x7d, 0x0a, 0x5c, 0x88,
-	0xcd, 0x4b, 0x24, 0x0b, 0xcb, 0xe1, 0x5f, 0xb1, 0xb1, 0xe0, 0x2e, 0xcb, 0xd9, 0x28, 0x71, 0x27,
-	0x7a, 0x9d, 0x22, 0x7e, 0x0e, 0xbe, 0x56, 0x22, 0x7e, 0xfe, 0x62, 0x01, 0x06, 0x98, 0xdf, 0xd4,
-	0xeb, 0xe3, 0xc1, 0x11, 0xeb, 0x6a, 0xa6, 0xbf, 0x6b, 0x3d, 0xe6, 0xef, 0xfa, 0x64, 0x7e, 0x12,
-	0x9d, 0x1d, 0x5e, 0xdf, 0x0b, 0x67, 0x59, 0xb5, 0xf9, 0x1a, 0x33, 0xb4, 0xb9, 0xa4, 0x36, 0x5f,
-	0xab, 0x31, 0xf5, 0xf6, 0xe0, 0x3b, 0xb2, 0x4b, 0xd0, 0xd7, 0x72, 0xcc, 0x78, 0x08, 0xd9, 0x0d,
-	0xbc, 0x8c, 0x69, 0xb9, 0xfa, 0xc3, 0x05, 0x98, 0x64, 0xb8, 0x25, 0x16, 0x83, 0x76, 0x60, 0xd8,
-	0x11, 0x6c, 0x46, 0xac, 0xcd, 0x72, 0xee, 0xa1, 0xa5, 0xb0, 0x2e, 0xae, 0xb1, 0xf9, 0xbf, 0x70,
-	0x40, 0x0b, 0x7d, 0x8f, 0x02, 0x93, 0xc4, 0xd2, 0x9d, 0x3d, 0xf6, 0xa8, 0x7b, 0xde, 0xa3, 0x5a,
-	0x73, 0x2f, 0x01, 0xab, 0x17, 0x63, 0xb8, 0x44, 0x6e, 0xc5, 0x58, 0x29, 0x4e, 0xd0, 0x54, 0xff,
-	0x74, 0x00, 0x66, 0xb2, 0x7a, 0x8f, 0x7e, 0x48, 0x81, 0xb3, 0x7a, 0x28, 0xfa, 0xce, 0xb7, 0xbc,
-	0x6d, 0xdb, 0xf1, 0x53, 0x3b, 0xe4, 0xb6, 0xcb, 0x94, 0xe6, 0x83, 0xe9, 0x61, 0xf9, 0x4c, 0x4a,
-	0xa9, 0x14, 0x70, 0x06, 0x65, 0xf4, 0x0a, 0x80, 0xeb, 0x6e, 0xdf, 0x24, 0x7b, 0x4d, 0xcd, 0xf0,
-	0x5d, 0xc8, 0x6e, 0xe6, 0xdf, 0x8f, 0xd5, 0x1b, 0x02, 0x55, 0xd0, 0x29, 0x6e, 0x34, 0x0f, 0xcb,
-	0x25, 0x72, 0xe8, 0x23, 0x0a, 0x8c, 0xdb, 0x72, 0xe0, 0x98, 0x5e, 0x5e, 0x15, 0xa4, 0x46, 0xa0,
-	0xe1, 0x22, 0x7f, 0x14, 0x14, 0x25, 0x49, 0x97, 0x65, 0xca, 0x8d, 0x1f, 0xb1, 0x82, 0x09, 0xaf,
-	0xe4, 0x13, 0xc6, 0x32, 0xce, 0x6b, 0x6e, 0x3e, 0x48, 0x82, 0x93, 0xe4, 0x59, 0xa7, 0x88, 0xa7,
-	0xd7, 0xc2, 0x3d, 0x47, 0x3b, 0x35, 0x98, 0xbf, 0x53, 0x8b, 0xeb, 0xa5, 0x72, 0x04, 0x59, 0xb4,
-	0x53, 0x49, 0x70, 0x92, 0xbc, 0xfa, 0xe1, 0x02, 0x9c, 0x63, 0xcb, 0x7c, 0xb3, 0xb5, 0x49, 0xb8,
-	0x17, 0xd8, 0x3f, 0xb8, 0x48, 0x3f, 0xbf, 0xa3, 0xc0, 0x08, 0x9b, 0x83, 0xd7, 0xc9, 0x63, 0x51,
-	0xd6, 0xd7, 0x0c, 0x4f, 0xeb, 0xdf, 0x56, 0x60, 0x2a, 0x91, 0xcc, 0xaa, 0xab, 0xa7, 0x86, 0x27,
-	0xe6, 0x04, 0xfc, 0xe6, 0x30, 0x81, 0x66, 0x5f, 0x18, 0x61, 0x23, 0x9e, 0x3c, 0x53, 0x7d, 0x16,
-	0xc6, 0x23, 0x8e, 0xd6, 0x52, 0x2c, 0xca, 0xb4, 0x28, 0x9a, 0x72, 0xa8, 0xc9, 0x42, 0xa7, 0x20,
-	0x99, 0xe1, 0x96, 0x4f, 0x72, 0xb6, 0x7f, 0x38, 0x5b, 0x1e, 0x89, 0x2d, 0xef, 0xdf, 0x27, 0xb1,
-	0x08, 0x97, 0x6e, 0x2f, 0xf7, 0x49, 0x2c, 0x54, 0xa6, 0x88, 0x48, 0xcb, 0xff, 0xc7, 0x02, 0x2b,
-	0x7a, 0x2a, 0x1a, 0x6f, 0x76, 0x35, 0x54, 0x32, 0xa7, 0xe3, 0x51, 0x62, 0xd9, 0x96, 0x4c, 0xd4,
-	0x46, 0x98, 0xdf, 0x48, 0xf1, 0xb3, 0x2c, 0x57, 0x9a, 0x9d, 0xf2, 0x6a, 0x95, 0x07, 0x22, 0x0c,
-	0x6e, 0xa2, 0xa2, 0xa6, 0xf7, 0xfe, 0x93, 0x30, 0xbd, 0x3b, 0x30, 0xba, 0x6d, 0x6c, 0x12, 0xc7,
-	0xe2, 0xf2, 0xd4, 0x40, 0x7e, 0x51, 0xf1, 0x46, 0x88, 0x86, 0xdb, 0x23, 0xa4, 0x02, 0x2c, 0x13,
-	0x41, 0x4e, 0x24, 0x6a, 0xf6, 0x60, 0x7e, 0xa9, 0x24, 0xb4, 0x91, 0x87, 0xe3, 0xcc, 0x88, 0x98,
-	0x6d, 0x01, 0x58, 0x41, 0x28, 0xd9, 0x5e, 0x6e, 0xa8, 0xc2, 0x80, 0xb4, 0x5c, 0xe8, 0x08, 0x7f,
-	0x63, 0x89, 0x02, 0x9d, 0xd7, 0x46, 0x98, 0x59, 0x41, 0xd8, 0x3b, 0x9f, 0xec, 0x31, 0xbb, 0x85,
-	0xb0, 0xf3, 0x84, 0x05, 0x58, 0x26, 0x42, 0xc7, 0xd8, 0x08, 0xf2, 0x09, 0x08, 0x7b, 0x66, 0xae,
-	0x31, 0x86, 0x59, 0x09, 0xf8, 0x18, 0xc3, 0xdf, 0x58, 0xa2, 0x80, 0x5e, 0x92, 0x2e, 0x32, 0x21,
-	0xbf, 0xb5, 0xac, 0xab, 0x4b, 0xcc, 0x77, 0x84, 0x46, 0xa3, 0x51, 0xf6, 0x9d, 0x5e, 0x90, 0x0c,
-	0x46, 0x2c, 0xcf, 0x02, 0xe5, 0x1d, 0x09, 0x03, 0x52, 0xf8, 0xbc, 0x63, 0xac, 0xe3, 0xf3, 0x8e,
-	0x12, 0x95, 0xce, 0xa4, 0x27, 0x92, 0x8c, 0x21, 0x8c, 0x87, 0xb7, 0x31, 0xd5, 0x38, 0x10, 0x27,
-	0xeb, 0x73, 0x86, 0x4f, 0x6a, 0xac, 0xed, 0x84, 0xcc, 0xf0, 0x79, 0x19, 0x0e, 0xa0, 0x68, 0x07,
-	0xc6, 0x5c, 0xe9, 0xad, 0xc8, 0xcc, 0xa9, 0x5e, 0xef, 0x32, 0xc5, 0x3b, 0x11, 0xe6, 0x94, 0x29,
-	0x97, 0xe0, 0x08, 0x9d, 0xe8, 0x45, 0xdb, 0xe4, 0xc9, 0x5e, 0xb4, 0xa1, 0x56, 0xd4, 0x0d, 0x7c,
-	0xea, 0x48, 0x02, 0xca, 0x1c, 0xe8, 0x26, 0x4e, 0x97, 0x96, 0xec, 0x36, 0x6d, 0xb7, 0xe5, 0x10,
-	0xe6, 0x7f, 0xca, 0x96, 0x07, 0x85, 0x4b, 0xbb, 0x18, 0x07, 0xe2, 0x64, 0x7d, 0xa6, 0xfb, 0xb9,
-	0x7b, 0xae, 0x47, 0x1a, 0xf4, 0xd8, 0xb2, 0x2d, 0x62, 0x79, 0xee, 0xcc, 0xe9, 0xfc, 0xba, 0x5f,
-	0x35, 0x86, 0x8b, 0x1f, 0x3b, 0xf1, 0x52, 0x9c, 0xa0, 0x49, 0x77, 0x8e, 0x1c, 0x92, 0x66, 0x66,
-	0x3a, 0xff, 0xce, 0x91, 0xc3, 0xdd, 0xf0, 0x9d, 0x23, 0x97, 0xe0, 0x08, 0x1d, 0xf4, 0x08, 0x8c,
-	0xbb, 0x7e, 0x32, 0x75, 0x36, 0x83, 0x67, 0xc2, 0xa8, 0xa1, 0x55, 0x19, 0x80, 0xa3, 0xf5, 0xd0,
-	0x07, 0x61, 0x4c, 0x3e, 0x3b, 0x67, 0xce, 0x1e, 0x75, 0xfc, 0x7c, 0xde, 0x73, 0x19, 0x14, 0x21,
-	0x88, 0x30, 0x9c, 0x95, 0x5e, 0x31, 0xca, 0xdf, 0xf7, 0x39, 0x36, 0x04, 0xae, 0xcf, 0xa6, 0xd6,
-	0xc0, 0x19, 0x2d, 0xd1, 0x8f, 0xa7, 0xdf, 0xdb, 0xcf, 0xb0, 0x2d, 0xbd, 0x76, 0x24, 0xf7, 0xf6,
-	0xcf, 0x1a, 0xde, 0xf6, 0xad, 0x26, 0x0f, 0x71, 0x76, 0xc8, 0x2b, 0x7c, 0xf5, 0x8f, 0x14, 0x80,
-	0xc0, 0x72, 0x73, 0x12, 0x77, 0x26, 0xb5, 0x88, 0x31, 0x6b, 0xa1, 0x27, 0x4b, 0x53, 0x66, 0x7a,
-	0x14, 0xf5, 0xcb, 0x0a, 0x4c, 0x84, 0xd5, 0x4e, 0x40, 0x35, 0xd2, 0xa3, 0xaa, 0xd1, 0x13, 0xbd,
-	0x8d, 0x2b, 0x43, 0x3f, 0xfa, 0x3f, 0x05, 0x79, 0x54, 0x4c, 0xfa, 0xdd, 0x89, 0xf8, 0x20, 0x50,
-	0xd2, 0x37, 0x7a, 0xf1, 0x41, 0x90, 0xc3, 0x80, 0x84, 0xe3, 0x4d, 0xf1, 0x49, 0xf8, 0xce, 0x88,
-	0xfc, 0xd9, 0x43, 0x00, 0x9e, 0x40, 0xd8, 0xf4, 0x49, 0xf3, 0x09, 0x38, 0x48, 0x18, 0x7d, 0x59,
-	0x3e, 0x9e, 0x7a, 0x48, 0x69, 0x12, 0x19, 0x70, 0x67, 0xef, 0x8f, 0x5f, 0x9c, 0x82, 0x51, 0xc9,
-	0xc8, 0x19, 0xf3, 0xa8, 0x50, 0x4e, 0xc2, 0xa3, 0xc2, 0x83, 0x51, 0x3d, 0xc8, 0x69, 0xe9, 0x4f,
-	0x7b, 0x8f, 0x34, 0x83, 0x63, 0x31, 0xcc, 0x96, 0xe9, 0x62, 0x99, 0x0c, 0x15, 0xde, 0x82, 0x3d,
-	0xd6, 0x77, 0x04, 0x7e, 0x2e, 0x9d, 0xf6, 0xd5, 0xc3, 0x00, 0xbe, 0xfc, 0x4f, 0x6a, 0x22, 0x54,
-	0x7c, 0xe0, 0xc3, 0x5d, 0x71, 0x6f, 0x04, 0x30, 0x2c, 0xd5, 0x4b, 0xde, 0xd0, 0x0f, 0x9c, 0xd8,
-	0x0d, 0x3d, 0xdd, 0x06, 0xa6, 0x9f, 0xfd, 0xbf, 0x27, 0xbf, 0xb9, 0x65, 0x1f, 0x4b, 0xb8, 0x0d,
-	0x82, 0x22, 0x17, 0x4b, 0x44, 0x32, 0x1c, 0x6b, 0x86, 0x72, 0x39, 0xd6, 0xb4, 0xe0, 0xb4, 0x43,
-	0x3c, 0x67, 0xaf, 0xb4, 0xa7, 0xb3, 0x1c, 0x2b, 0x8e, 0xc7, 0x34, 0xf8, 0xe1, 0x7c, 0x91, 0x1b,
-	0x71, 0x12, 0x15, 0x4e, 0xc3, 0x1f, 0x11, 0x80, 0x47, 0x3a, 0x0a, 0xc0, 0xef, 0x80, 0x51, 0x8f,
-	0xe8, 0xdb, 0x96, 0xa1, 0x6b, 0x66, 0xa5, 0x2c, 0x02, 0x89, 0x87, 0xb2, 0x5c, 0x08, 0xc2, 0x72,
-	0x3d, 0xb4, 0x00, 0x7d, 0x2d, 0xa3, 0x26, 0x34, 0x80, 0x6f, 0x09, 0xae, 0x0b, 0x2a, 0xe5, 0x7b,
-	0xed, 0xe2, 0x1b, 0x43, 0x4f, 0x95, 0x60, 0x54, 0xd7, 0x9a, 0xb7, 0xeb, 0xd7, 0xbc, 0xbd, 0x26,
-	0x71, 0xe7, 0x36, 0x2a, 0x65, 0x4c, 0x1b, 0xa7, 0x39, 0x1d, 0x8d, 0x1d, 0xc2, 0xe9, 0xe8, 0xd3,
-	0x0a, 0x9c, 0xd6, 0xe2, 0x37, 0x1d, 0xc4, 0x9d, 0x19, 0xcf, 0xcf, 0x2d, 0xd3, 0x6f, 0x4f, 0x16,
-	0x2e, 0x88, 0xf1, 0x9d, 0x9e, 0x4f, 0x92, 0xc3, 0x69, 0x7d, 0x40, 0x0e, 0xa0, 0x86, 0x51, 0x0f,
-	0x12, 0xf1, 0x8b, 0x55, 0x9f, 0xc8, 0x67, 0xb7, 0x59, 0x49, 0x60, 0xc2, 0x29, 0xd8, 0xd1, 0x1d,
-	0x18, 0x95, 0x84, 0x24, 0xa1, 0xc9, 0x94, 0x8f, 0xe2, 0x42, 0x86, 0x6b, 0xbb, 0xf2, 0x1d, 0x87,
-	0x4c, 0x29, 0xb8, 0x6d, 0x95, 0xcc, 0x0c, 0xe2, 0xc6, 0x91, 0x8d, 0x7a, 0x32, 0xff, 0x6d, 0x6b,
-	0x3a, 0x46, 0xdc, 0x81, 0x1a, 0x8b, 0x97, 0x48, 0xc1, 0x92, 0x6e, 0x3e, 0x33, 0x95, 0x3f, 0xe0,
-	0xcb, 0x72, 0x14, 0x15, 0xdf, 0x9a, 0xb1, 0x42, 0x1c, 0x27, 0x88, 0x96, 0x00, 0x89, 0xbb, 0xa2,
-	0x50, 0x39, 0x73, 0x67, 0x10, 0x73, 0x04, 0x60, 0x4b, 0xba, 0x98, 0x80, 0xe2, 0x94, 0x16, 0xc8,
-	0x8b, 0xd8, 0x4a, 0x7a, 0xd0, 0x72, 0xe2, 0xc9, 0x7b, 0x3a, 0x5a, 0x4c, 0xbe, 0x5b, 0x49, 0x64,
-	0x73, 0xe6, 0xca, 0xcd, 0x8d, 0xde, 0xb3, 0x39, 0x0b, 0xf2, 0xdd, 0xe4, 0x74, 0xfe, 0x9c, 0x02,
-	0xe7, 0x1a, 0xe9, 0x59, 0x2b, 0x99, 0xce, 0x93, 0xf3, 0xe2, 0x2a, 0x23, 0x11, 0x26, 0x33, 0x63,
-	0x64, 0x65, 0xc9, 0xc4, 0x59, 0x1d, 0x51, 0xff, 0x50, 0x11, 0xa6, 0xe8, 0x13, 0xf4, 0x8b, 0x3a,
-	0xee, 0x0b, 0x6b, 0xf5, 0x59, 0x98, 0xa9, 0xfa, 0xd1, 0x4e, 0x6b, 0xb1, 0xa4, 0x06, 0xef, 0x86,
-	0x71, 0x7e, 0x15, 0xb4, 0xa2, 0x35, 0x57, 0xc3, 0x7b, 0x83, 0x20, 0x2e, 0x46, 0x49, 0x06, 0xe2,
-	0x68, 0x5d, 0xf5, 0xab, 0x0a, 0x9c, 0x8b, 0x62, 0xb6, 0x1d, 0xe3, 0x6e, 0xef, 0x88, 0xd1, 0xc7,
-	0x14, 0x18, 0xbd, 0x1d, 0xdc, 0x52, 0xf9, 0xa2, 0x5b, 0xae, 0xb7, 0x23, 0x7e, 0xaf, 0x88, 0x23,
-	0x5d, 0x7b, 0x25, 0x33, 0x65, 0x86, 0x40, 0x17, 0xcb, 0xa4, 0xd5, 0xff, 0xae, 0x40, 0xc2, 0x7c,
-	0x80, 0x36, 0x61, 0x88, 0x12, 0x29, 0xaf, 0x56, 0xc5, 0x9e, 0x78, 0x77, 0x3e, 0xa9, 0x92, 0xa1,
-	0xe0, 0x97, 0x22, 0xe2, 0x07, 0xf6, 0x11, 0xa3, 0x1d, 0x1e, 0x88, 0xc0, 0xcf, 0x78, 0x24, 0xb6,
-	0x47, 0x2e, 0xb1, 0x5d, 0xce, 0x9c, 0xc4, 0xd5, 0x7a, 0xb9, 0x04, 0x47, 0xe8, 0xa8, 0xcb, 0x00,
-	0xa1, 0xc9, 0xa7, 0x67, 0x3f, 0xc3, 0xff, 0x70, 0x1a, 0xce, 0xf4, 0xfc, 0x2a, 0xf2, 0x23, 0x0a,
-	0x9c, 0x25, 0x3b, 0x86, 0xee, 0xcd, 0x6f, 0x79, 0xc4, 0xb9, 0x75, 0x6b, 0x65, 0x7d, 0xdb, 0x21,
-	0xee, 0xb6, 0x6d, 0xd6, 0xba, 0xf1, 0xaa, 0x4c, 0x71, 0x01, 0x63, 0xa6, 0x89, 0xc5, 0x54, 0x8c,
-	0x38, 0x83, 0x12, 0x33, 0x77, 0xed, 0x70, 0x43, 0x00, 0xa6, 0x3a, 0x57, 0xcb, 0x71, 0x3d, 0x11,
-	0xc0, 0x90, 0x9b, 0xbb, 0xe2, 0x40, 0x9c, 0xac, 0x1f, 0x47, 0xc2, 0xd2, 0xf9, 0x31, 0xb9, 0x5d,
-	0x49, 0x22, 0x61, 0x40, 0x9c, 0xac, 0x2f, 0x23, 0xe1, 0x2b, 0x45, 0x0f, 0xc5, 0x81, 0x24, 0x92,
-	0x00, 0x88, 0x93, 0xf5, 0x51, 0x0d, 0x2e, 0x3a, 0x44, 0xb7, 0x1b, 0x0d, 0x62, 0xd5, 0xd8, 0xa4,
-	0xac, 0x68, 0x4e, 0xdd, 0xb0, 0x96, 0x1c, 0x8d, 0x55, 0x64, 0xb7, 0x07, 0x0a, 0xcb, 0xbc, 0x7b,
-	0x11, 0x77, 0xa8, 0x87, 0x3b, 0x62, 0x41, 0x0d, 0x38, 0xd5, 0x62, 0xfc, 0xdf, 0x61, 0x01, 0xce,
-	0x76, 0x34, 0x53, 0x5c, 0x11, 0x1c, 0x76, 0xc5, 0xd8, 0x41, 0xbd, 0x11, 0x45, 0x85, 0xe3, 0xb8,
-	0xd1, 0x1e, 0x15, 0xcf, 0x45, 0x77, 0x24, 0x92, 0xc3, 0xb9, 0x48, 0x0a, 0x11, 0x3d, 0x81, 0x0e,
-	0xa7, 0xd1, 0x40, 0x15, 0x38, 0xed, 0x69, 0x4e, 0x9d, 0x78, 0xa5, 0xb5, 0x8d, 0x35, 0xe2, 0xe8,
-	0x94, 0xc7, 0x9a, 0x5c, 0x5a, 0x57, 0x38, 0xaa, 0xf5, 0x24, 0x18, 0xa7, 0xb5, 0x41, 0x1f, 0x84,
-	0x37, 0x47, 0x27, 0x75, 0xd9, 0xbe, 0x43, 0x9c, 0x05, 0xbb, 0x65, 0xd5, 0xa2, 0xc8, 0x81, 0x21,
-	0xbf, 0x7f, 0xbf, 0x5d, 0x7c, 0x33, 0xee, 0xa6, 0x01, 0xee, 0x0e, 0x6f, 0xb2, 0x03, 0x1b, 0xcd,
-	0x66, 0x6a, 0x07, 0x46, 0xb3, 0x3a, 0x90, 0xd1, 0x00, 0x77, 0x87, 0x17, 0x61, 0x38, 0xcb, 0x27,
-	0x86, 0xe7, 0x89, 0x96, 0x28, 0x8e, 0x31, 0x8a, 0xec, 0xfb, 0x5d, 0x4f, 0xad, 0x81, 0x33, 0x5a,
-	0xd2, 0x33, 0xe5, 0x6a, 0xd6, 0xf0, 0x13, 0x64, 0xc6, 0x19, 0x99, 0xb7, 0xee, 0xb7, 0x8b, 0x57,
-	0x71, 0x97, 0x6d, 0x70, 0xd7, 0xd8, 0x53, 0xba, 0x12, 0x4e, 0x44, 0xa2, 0x2b, 0x13, 0x59, 0x5d,
-	0xc9, 0x6e, 0x83, 0xbb, 0xc6, 0x8e, 0xbe, 0x57, 0x81, 0xf3, 0x7a, 0xb3, 0x75, 0xc3, 0x70, 0x3d,
-	0xbb, 0xee, 0x68, 0x8d, 0x32, 0xd1, 0xb5, 0xbd, 0x1b, 0x9a, 0xb9, 0xb5, 0x6c, 0x6c, 0x11, 0xa1,
-	0x74, 0x1c, 0xf6, 0xc3, 0x61, 0x2f, 0x80, 0x4b, 0x6b, 0x1b, 0xe9, 0x48, 0x71, 0x36, 0x3d, 0xf4,
-	0x23, 0x0a, 0x5c, 0x6c, 0xb0, 0x2e, 0x66, 0x74, 0x68, 0x32, 0x57, 0x87, 0x18, 0x17, 0x5b, 0xe9,
-	0x80, 0x17, 0x77, 0xa4, 0xca, 0x26, 0x89, 0x57, 0x98, 0xaf, 0xd7, 0x1d, 0x52, 0x67, 0x58, 0x03,
-	0xee, 0x32, 0x95, 0x7f, 0x92, 0x56, 0xb2, 0x90, 0xe2, 0x6c, 0x7a, 0xe8, 0x25, 0xb8, 0x9c, 0x09,
-	0x2c, 0xd9, 0x2d, 0xcb, 0x63, 0x97, 0x30, 0x7d, 0x0b, 0xea, 0x7e, 0xbb, 0x78, 0x79, 0xa5, 0x63,
-	0x4d, 0x7c, 0x00, 0x26, 0x16, 0x04, 0x25, 0x12, 0x8f, 0xe0, 0x34, 0x93, 0xc4, 0x5e, 0xc8, 0x95,
-	0x17, 0xf8, 0x08, 0x83, 0x10, 0x7c, 0x39, 0x1a, 0x84, 0x60, 0x9a, 0xf5, 0xea, 0xbd, 0x47, 0xd7,
-	0xab, 0x6f, 0x44, 0x1e, 0xe8, 0xe6, 0x45, 0xca, 0xa7, 0x15, 0x10, 0x0f, 0x49, 0xd1, 0xc5, 0x88,
-	0xdb, 0xd1, 0x70, 0xcc, 0xe5, 0xc8, 0x4f, 0xcf, 0x5b, 0x48, 0x4d, 0xcf, 0xfb, 0x16, 0x29, 0x1a,
-	0xf4, 0x48, 0xa8, 0x2c, 0x71, 0xcc, 0x61, 0x38, 0x68, 0xf4, 0x00, 0x8c, 0x04, 0x4a, 0xb5, 0x30,
-	0x76, 0xb2, 0xd4, 0x38, 0xa1, 0xf6, 0x1d, 0xc2, 0xd5, 0xdf, 0x57, 0x00, 0xc2, 0xac, 0xd3, 0xe8,
-	0x3e, 0x3f, 0x6a, 0x0e, 0xef, 0x60, 0x70, 0x63, 0x20, 0x47, 0xce, 0x39, 0xf8, 0x55, 0x04, 0x52,
-	0x61, 0xb0, 0xc5, 0xf2, 0x6c, 0x8a, 0x97, 0x0c, 0xcc, 0x05, 0x66, 0x83, 0x95, 0x60, 0x01, 0x41,
-	0x1b, 0x30, 0xd4, 0x30, 0x2c, 0xf6, 0xe8, 0xa4, 0x3f, 0xd7, 0xa3, 0x13, 0xa6, 0x0f, 0xac, 0x70,
-	0x14, 0xd8, 0xc7, 0xa5, 0xfe, 0x92, 0x02, 0xa7, 0xa2, 0xe1, 0xb9, 0x59, 0xec, 0x1d, 0x11, 0xb5,
-	0x55, 0x64, 0x05, 0x60, 0x4d, 0x45, 0x34, 0x4a, 0xec, 0xc3, 0xa2, 0xb7, 0xd3, 0x3d, 0xdc, 0x3e,
-	0xa4, 0x47, 0x09, 0x3f, 0xe0, 0x22, 0xe0, 0xc7, 0x4e, 0xc3, 0x20, 0x57, 0xb4, 0xa9, 0x1c, 0x9f,
-	0x12, 0x85, 0xeb, 0x66, 0xfe, 0xc4, 0x17, 0x79, 0x42, 0xf3, 0xc8, 0xe9, 0x3f, 0x0b, 0x1d, 0xd3,
-	0x7f, 0x62, 0xe8, 0xd3, 0x1d, 0xa3, 0x17, 0x4f, 0xa4, 0x12, 0xae, 0x70, 0x4f, 0xa4, 0x12, 0xae,
-	0x60, 0x8a, 0x0c, 0x79, 0x11, 0x17, 0x9d, 0xfe, 0xfc, 0x26, 0x20, 0x3e, 0x01, 0x92, 0xa3, 0xce,
-	0x44, 0x47, 0x27, 0x1d, 0x3f, 0xe4, 0xff, 0x40, 0xfe, 0x57, 0x4a, 0x62, 0xca, 0xbb, 0x09, 0xf9,
-	0xef, 0x7f, 0x48, 0x83, 0x99, 0x1f, 0xd2, 0x16, 0x0c, 0x89, 0x4f, 0x41, 0x28, 0x04, 0xef, 0xee,
-	0x21, 0xc9, 0xbd, 0x94, 0x4e, 0x8b, 0x17, 0x60, 0x1f, 0x39, 0xd5, 0x32, 0x1b, 0xda, 0xae, 0xd1,
-	0x68, 0x35, 0x98, 0x16, 0x30, 0x20, 0x57, 0x65, 0xc5, 0xd8, 0x87, 0xb3, 0xaa, 0xfc, 0x71, 0x17,
-	0x93, 0xda, 0xe5, 0xaa, 0xbc, 0x18, 0xfb, 0x70, 0xf4, 0x3c, 0x0c, 0x37, 0xb4, 0xdd, 0x6a, 0xcb,
-	0xa9, 0x13, 0xe1, 0xa0, 0x93, 0x6d, 0x14, 0x6a, 0x79, 0x86, 0x39, 0x67, 0x58, 0x9e, 0xeb, 0x39,
-	0x73, 0x15, 0xcb, 0xbb, 0xe5, 0x54, 0x3d, 0xe6, 0x00, 0xc4, 0x76, 0xdd, 0x8a, 0xc0, 0x82, 0x03,
-	0x7c, 0xc8, 0x84, 0x89, 0x86, 0xb6, 0xbb, 0x61, 0x69, 0x3c, 0x9b, 0x83, 0x90, 0xb2, 0xf3, 0x50,
-	0x60, 0x56, 0xb9, 0x95, 0x08, 0x2e, 0x1c, 0xc3, 0x9d, 0xe2, 0x0c, 0x3a, 0x76, 0x5c, 0xce, 0xa0,
-	0xf3, 0x41, 0xb8, 0x04, 0x6e, 0xd2, 0x3f, 0x9f, 0x1a, 0xa8, 0xb0, 0x63, 0x28, 0x84, 0x17, 0x83,
-	0x50, 0x08, 0x13, 0xf9, 0xbd, 0x17, 0x3b, 0x84, 0x41, 0x68, 0xc1, 0x68, 0x4d, 0xf3, 0x34, 0x5e,
-	0xea, 0xce, 0x9c, 0xca, 0x7f, 0x3b, 0x5d, 0x0e, 0xd0, 0x84, 0x2c, 0x29, 0x2c, 0x73, 0xb1, 0x4c,
-	0x07, 0xdd, 0x82, 0x33, 0xf4, 0x63, 0x35, 0x89, 0x17, 0x56, 0x61, 0x36, 0xb3, 0x49, 0xf6, 0xfd,
-	0xb0, 0xe7, 0x72, 0x37, 0xd3, 0x2a, 0xe0, 0xf4, 0x76, 0x61, 0x50, 0xdd, 0xa9, 0xf4, 0xa0, 0xba,
-	0xe8, 0x93, 0x69, 0x6e, 0x37, 0x88, 0xcd, 0xe9, 0x7b, 0xf2, 0xf3, 0x86, 0xdc, 0xce, 0x37, 0xff,
-	0x5a, 0x81, 0x19, 0xb1, 0xcb, 0x84, 0xab, 0x8c, 0x49, 0x9c, 0x15, 0xcd, 0xd2, 0xea, 0xc4, 0x11,
-	0x76, 0xf2, 0xf5, 0x1e, 0xf8, 0x43, 0x02, 0x67, 0x10, 0xa3, 0xe2, 0x4d, 0xfb, 0xed, 0xe2, 0x95,
-	0x83, 0x6a, 0xe1, 0xcc, 0xbe, 0x21, 0x07, 0x86, 0xdc, 0x3d, 0x57, 0xf7, 0x4c, 0x57, 0xc8, 0xa0,
-	0xd7, 0x7b, 0xe0, 0xac, 0x55, 0x8e, 0x89, 0xb3, 0xd6, 0x30, 0x89, 0x23, 0x2f, 0xc5, 0x3e, 0x21,
-	0xf4, 0x83, 0x0a, 0x4c, 0x89, 0xcb, 0x33, 0x29, 0x06, 0xd0, 0x99, 0xfc, 0x0f, 0x76, 0x4a, 0x71,
-	0x64, 0xbe, 0x7b, 0x0c, 0xb3, 0x26, 0x25, 0xa0, 0x38, 0x49, 0x9d, 0x1e, 0xaa, 0x4d, 0xc7, 0xb0,
-	0x1d, 0xc3, 0xdb, 0x63, 0x8e, 0x48, 0x03, 0x7e, 0x28, 0x7d, 0x5e, 0x86, 0x03, 0x28, 0xaa, 0xc2,
-	0x04, 0xb7, 0xda, 0x54, 0x3d, 0x47, 0xf3, 0x48, 0x7d, 0x4f, 0x78, 0x0b, 0x3d, 0xc0, 0x92, 0x1d,
-	0x47, 0x20, 0xf7, 0xda, 0xc5, 0x33, 0x62, 0x6d, 0xa2, 0x00, 0x1c, 0x43, 0x81, 0xde, 0x1f, 0x73,
-	0xde, 0x9a, 0xc9, 0x9f, 0x08, 0x91, 0xaf, 0xc5, 0x61, 0x5c, 0xb8, 0x7a, 0x0d, 0x6b, 0xd7, 0x43,
-	0x32, 0x9a, 0xd9, 0xc7, 0x60, 0x4c, 0xde, 0x35, 0x87, 0x8a, 0xa6, 0xe7, 0x01, 0x4a, 0x0e, 0xf6,
-	0xb8, 0x03, 0x0e, 0xa9, 0x3f, 0xad, 0xc0, 0x64, 0x5c, 0x76, 0x41, 0xdb, 0x30, 0x24, 0x18, 0x99,
-	0xa0, 0x3a, 0x9f, 0xd7, 0x6b, 0xd9, 0x24, 0xe2, 0x9d, 0x32, 0x17, 0x85, 0x45, 0x11, 0xf6, 0xd1,
-	0xcb, 0x2f, 0x12, 0x0a, 0x1d, 0x5e, 0x24, 0x3c, 0x0e, 0x67, 0xd3, 0x59, 0x1a, 0x55, 0x24, 0x34,
-	0xaa, 0x5f, 0x09, 0xa3, 0x75, 0xa0, 0x48, 0x30, 0xa5, 0x0b, 0x73, 0x98, 0xfa, 0x01, 0x88, 0x27,
-	0x61, 0x43, 0x2f, 0xc1, 0x88, 0xeb, 0x6e, 0x73, 0x3f, 0x33, 0x31, 0xc8, 0x7c, 0x57, 0x3d, 0x7e,
-	0x42, 0x1c, 0xae, 0xfb, 0x04, 0x3f, 0x71, 0x88, 0x7e, 0xe1, 0xb9, 0x2f, 0x7e, 0xf5, 0xf2, 0x1b,
-	0xfe, 0xe0, 0xab, 0x97, 0xdf, 0xf0, 0x95, 0xaf, 0x5e, 0x7e, 0xc3, 0x87, 0xf6, 0x2f, 0x2b, 0x5f,
-	0xdc, 0xbf, 0xac, 0xfc, 0xc1, 0xfe, 0x65, 0xe5, 0x2b, 0xfb, 0x97, 0x95, 0xbf, 0xdc, 0xbf, 0xac,
-	0xfc, 0xc0, 0x7f, 0xbc, 0xfc, 0x86, 0xe7, 0x1f, 0x0a, 0xa9, 0x5f, 0xf3, 0x89, 0x86, 0xff, 0x34,
-	0x6f, 0xd7, 0xa9, 0xd2, 0xea, 0xfa, 0x5a, 0x2b, 0xa3, 0xfe, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xd6, 0x36, 0x3d, 0x82, 0xf8, 0x27, 0x01, 0x00,
+	// 15025 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x70, 0x64, 0xd9,
+	0x59, 0x98, 0x6f, 0xeb, 0xfd, 0x49, 0xa3, 0x19, 0x9d, 0x79, 0xf5, 0x6a, 0x66, 0xa7, 0xc7, 0x77,
+	0xd7, 0xce, 0x2e, 0xb6, 0x35, 0x78, 0xfd, 0x5e, 0xb3, 0x5e, 0x4b, 0x2d, 0xcd, 0x8c, 0x3c, 0xd2,
+	0x8c, 0xfc, 0xb5, 0xb4, 0xb3, 0xb6, 0x61, 0xcd, 0x9d, 0xee, 0xa3, 0xd6, 0xf5, 0x74, 0xdf, 0xdb,
+	0x7b, 0xef, 0xed, 0x19, 0x69, 0xd7, 0x6f, 0x1e, 0xb1, 0xcd, 0x23, 0x40, 0x00, 0x63, 0x03, 0x85,
+	0x81, 0x02, 0x92, 0x90, 0x40, 0x02, 0x21, 0x15, 0xa0, 0x42, 0x05, 0xaa, 0x08, 0x26, 0x05, 0x29,
+	0x82, 0x21, 0x31, 0x01, 0x04, 0x56, 0x1c, 0x48, 0x2a, 0x09, 0x49, 0x85, 0xaa, 0xa4, 0x6a, 0x92,
+	0x22, 0xa9, 0xf3, 0xb8, 0xf7, 0x9e, 0x73, 0x1f, 0xad, 0xd6, 0x6d, 0x49, 0xde, 0x0d, 0xfe, 0x25,
+	0xf5, 0xf9, 0xce, 0xf9, 0xbe, 0x73, 0xcf, 0xf3, 0x3b, 0xdf, 0x13, 0x16, 0x9a, 0x76, 0xb0, 0xd5,
+	0xbd, 0x33, 0x57, 0x77, 0xdb, 0x57, 0x9a, 0x96, 0xd7, 0xa0, 0x0e, 0xf5, 0xe2, 0x7f, 0x3a, 0x77,
+	0x9b, 0x57, 0xac, 0x8e, 0xed, 0x5f, 0xa9, 0xbb, 0x1e, 0xbd, 0x72, 0xef, 0xf5, 0x77, 0x68, 0x60,
+	0xbd, 0xfe, 0x4a, 0x93, 0xc1, 0xac, 0x80, 0x36, 0xe6, 0x3a, 0x9e, 0x1b, 0xb8, 0xe4, 0x89, 0x18,
+	0xc7, 0x5c, 0xd8, 0x34, 0xfe, 0xa7, 0x73, 0xb7, 0x39, 0xc7, 0x70, 0xcc, 0x31, 0x1c, 0x73, 0x12,
+	0xc7, 0xec, 0xeb, 0x54, 0xba, 0x6e, 0xd3, 0xbd, 0xc2, 0x51, 0xdd, 0xe9, 0x6e, 0xf2, 0x5f, 0xfc,
+	0x07, 0xff, 0x4f, 0x90, 0x98, 0x7d, 0xfc, 0xee, 0x5b, 0xfd, 0x39, 0xdb, 0x65, 0x9d, 0xb9, 0x62,
+	0x75, 0x03, 0xd7, 0xaf, 0x5b, 0x2d, 0xdb, 0x69, 0x5e, 0xb9, 0x97, 0xea, 0xcd, 0xac, 0xa9, 0x54,
+	0x95, 0xdd, 0xee, 0x59, 0xc7, 0xbb, 0x63, 0xd5, 0xb3, 0xea, 0x5c, 0x8f, 0xeb, 0xd0, 0xed, 0x80,
+	0x3a, 0xbe, 0xed, 0x3a, 0xfe, 0xeb, 0xd8, 0x97, 0x50, 0xef, 0x9e, 0x3a, 0x36, 0x5a, 0x85, 0x2c,
+	0x4c, 0x6f, 0x8c, 0x31, 0xb5, 0xad, 0xfa, 0x96, 0xed, 0x50, 0x6f, 0x27, 0x6c, 0x7e, 0xc5, 0xa3,
+	0xbe, 0xdb, 0xf5, 0xea, 0xf4, 0x40, 0xad, 0xfc, 0x2b, 0x6d, 0x1a, 0x58, 0x59, 0xb4, 0xae, 0xe4,
+	0xb5, 0xf2, 0xba, 0x4e, 0x60, 0xb7, 0xd3, 0x64, 0xde, 0xbc, 0x5f, 0x03, 0xbf, 0xbe, 0x45, 0xdb,
+	0x56, 0xaa, 0xdd, 0x1b, 0xf2, 0xda, 0x75, 0x03, 0xbb, 0x75, 0xc5, 0x76, 0x02, 0x3f, 0xf0, 0x92,
+	0x8d, 0xcc, 0x4f, 0x19, 0x70, 0x6a, 0x7e, 0x6d, 0xb9, 0xc6, 0x47, 0x70, 0xc5, 0x6d, 0x36, 0x6d,
+	0xa7, 0x49, 0x5e, 0x03, 0x13, 0xf7, 0xa8, 0x77, 0xc7, 0xf5, 0xed, 0x60, 0xa7, 0x6c, 0x5c, 0x36,
+	0x1e, 0x1b, 0x59, 0x38, 0xb1, 0xb7, 0x5b, 0x99, 0x78, 0x26, 0x2c, 0xc4, 0x18, 0x4e, 0x96, 0xe1,
+	0xf4, 0x56, 0x10, 0x74, 0xe6, 0xeb, 0x75, 0xea, 0xfb, 0x51, 0x8d, 0x72, 0x89, 0x37, 0x3b, 0xbf,
+	0xb7, 0x5b, 0x39, 0x7d, 0x7d, 0x7d, 0x7d, 0x2d, 0x01, 0xc6, 0xac, 0x36, 0xe6, 0xcf, 0x19, 0x30,
+	0x13, 0x75, 0x06, 0xe9, 0xf3, 0x5d, 0xea, 0x07, 0x3e, 0x41, 0x38, 0xd7, 0xb6, 0xb6, 0x6f, 0xba,
+	0xce, 0x6a, 0x37, 0xb0, 0x02, 0xdb, 0x69, 0x2e, 0x3b, 0x9b, 0x2d, 0xbb, 0xb9, 0x15, 0xc8, 0xae,
+	0xcd, 0xee, 0xed, 0x56, 0xce, 0xad, 0x66, 0xd6, 0xc0, 0x9c, 0x96, 0xac, 0xd3, 0x6d, 0x6b, 0x3b,
+	0x85, 0x50, 0xe9, 0xf4, 0x6a, 0x1a, 0x8c, 0x59, 0x6d, 0xcc, 0x37, 0xc1, 0x8c, 0xf8, 0x0e, 0xa4,
+	0x7e, 0xe0, 0xd9, 0xf5, 0xc0, 0x76, 0x1d, 0x72, 0x19, 0x86, 0x1d, 0xab, 0x4d, 0x79, 0x0f, 0x27,
+	0x16, 0xa6, 0x3e, 0xbf, 0x5b, 0x79, 0xc5, 0xde, 0x6e, 0x65, 0xf8, 0xa6, 0xd5, 0xa6, 0xc8, 0x21,
+	0xe6, 0xff, 0x2c, 0xc1, 0xc5, 0x54, 0xbb, 0xdb, 0x76, 0xb0, 0x75, 0xab, 0xc3, 0xfe, 0xf3, 0xc9,
+	0x77, 0x1a, 0x30, 0x63, 0x25, 0x2b, 0x70, 0x84, 0x93, 0x4f, 0x2c, 0xcd, 0x1d, 0x7c, 0x83, 0xcf,
+	0xa5, 0xa8, 0x2d, 0x3c, 0x24, 0xfb, 0x95, 0xfe, 0x00, 0x4c, 0x93, 0x26, 0x9f, 0x30, 0x60, 0xcc,
+	0x15, 0x9d, 0x2b, 0x97, 0x2e, 0x0f, 0x3d, 0x36, 0xf9, 0xc4, 0x37, 0x1c, 0x4a, 0x37, 0x94, 0x8f,
+	0x9e, 0x93, 0x7f, 0x97, 0x9c, 0xc0, 0xdb, 0x59, 0x38, 0x29, 0xbb, 0x37, 0x26, 0x4b, 0x31, 0x24,
+	0x3f, 0xfb, 0x24, 0x4c, 0xa9, 0x35, 0xc9, 0x29, 0x18, 0xba, 0x4b, 0xc5, 0x52, 0x9d, 0x40, 0xf6,
+	0x2f, 0x39, 0x03, 0x23, 0xf7, 0xac, 0x56, 0x97, 0xf2, 0x29, 0x9d, 0x40, 0xf1, 0xe3, 0xc9, 0xd2,
+	0x5b, 0x0d, 0xf3, 0x09, 0x18, 0x99, 0x6f, 0x34, 0x5c, 0x87, 0x3c, 0x0e, 0x63, 0xd4, 0xb1, 0xee,
+	0xb4, 0x68, 0x83, 0x37, 0x1c, 0x8f, 0xe9, 0x2d, 0x89, 0x62, 0x0c, 0xe1, 0xe6, 0xf7, 0x95, 0x60,
+	0x94, 0x37, 0xf2, 0xc9, 0xf7, 0x18, 0x70, 0xfa, 0x6e, 0xf7, 0x0e, 0xf5, 0x1c, 0x1a, 0x50, 0x7f,
+	0xd1, 0xf2, 0xb7, 0xee, 0xb8, 0x96, 0xd7, 0x90, 0x13, 0x73, 0xad, 0xc8, 0x88, 0xdc, 0x48, 0xa3,
+	0x13, 0x6b, 0x30, 0x03, 0x80, 0x59, 0xc4, 0xc9, 0x3d, 0x98, 0x72, 0x9a, 0xb6, 0xb3, 0xbd, 0xec,
+	0x34, 0x3d, 0xea, 0xfb, 0xfc, 0xa3, 0x27, 0x9f, 0x78, 0x67, 0x91, 0xce, 0xdc, 0x54, 0xf0, 0x2c,
+	0x9c, 0xda, 0xdb, 0xad, 0x4c, 0xa9, 0x25, 0xa8, 0xd1, 0x31, 0xff, 0xca, 0x80, 0x93, 0xf3, 0x8d,
+	0xb6, 0xed, 0xb3, 0x93, 0x76, 0xad, 0xd5, 0x6d, 0xda, 0x7d, 0x2c, 0x7d, 0xf2, 0x6e, 0x18, 0xad,
+	0xbb, 0xce, 0xa6, 0xdd, 0x94, 0xfd, 0x7c, 0xdd, 0x9c, 0x38, 0xb9, 0xe6, 0xd4, 0x93, 0x8b, 0x77,
+	0x4f, 0x9e, 0x78, 0x73, 0x68, 0xdd, 0x5f, 0x0a, 0x0f, 0xf4, 0x05, 0xd8, 0xdb, 0xad, 0x8c, 0x56,
+	0x39, 0x02, 0x94, 0x88, 0xc8, 0x63, 0x30, 0xde, 0xb0, 0x7d, 0x31, 0x99, 0x43, 0x7c, 0x32, 0xa7,
+	0xf6, 0x76, 0x2b, 0xe3, 0x8b, 0xb2, 0x0c, 0x23, 0x28, 0x59, 0x81, 0x33, 0x6c, 0x04, 0x45, 0xbb,
+	0x1a, 0xad, 0x7b, 0x34, 0x60, 0x5d, 0x2b, 0x0f, 0xf3, 0xee, 0x96, 0xf7, 0x76, 0x2b, 0x67, 0x6e,
+	0x64, 0xc0, 0x31, 0xb3, 0x95, 0x79, 0x15, 0xc6, 0xe7, 0x5b, 0xd4, 0x63, 0x07, 0x02, 0x79, 0x12,
+	0xa6, 0x69, 0xdb, 0xb2, 0x5b, 0x48, 0xeb, 0xd4, 0xbe, 0x47, 0x3d, 0xbf, 0x6c, 0x5c, 0x1e, 0x7a,
+	0x6c, 0x62, 0x81, 0xec, 0xed, 0x56, 0xa6, 0x97, 0x34, 0x08, 0x26, 0x6a, 0x9a, 0x1f, 0x33, 0x60,
+	0x72, 0xbe, 0xdb, 0xb0, 0x03, 0xf1, 0x5d, 0xc4, 0x83, 0x49, 0x8b, 0xfd, 0x5c, 0x73, 0x5b, 0x76,
+	0x7d, 0x47, 0x2e, 0xae, 0xa7, 0x0b, 0x6d, 0xb7, 0x18, 0xcd, 0xc2, 0xc9, 0xbd, 0xdd, 0xca, 0xa4,
+	0x52, 0x80, 0x2a, 0x11, 0x73, 0x0b, 0x54, 0x18, 0x79, 0x0f, 0x4c, 0x89, 0xcf, 0x5d, 0xb5, 0x3a,
+	0x48, 0x37, 0x65, 0x1f, 0x1e, 0x51, 0xe6, 0x2a, 0x24, 0x34, 0x77, 0xeb, 0xce, 0x07, 0x68, 0x3d,
+	0x40, 0xba, 0x49, 0x3d, 0xea, 0xd4, 0xa9, 0x58, 0x36, 0x55, 0xa5, 0x31, 0x6a, 0xa8, 0xcc, 0xbf,
+	0x6d, 0xc0, 0xc3, 0xf3, 0xdd, 0x60, 0xcb, 0xf5, 0xec, 0x17, 0xa8, 0x17, 0x0f, 0x77, 0x84, 0x81,
+	0xbc, 0x03, 0xa6, 0xad, 0xa8, 0xc2, 0xcd, 0x78, 0x39, 0x9d, 0x93, 0xcb, 0x69, 0x7a, 0x5e, 0x83,
+	0x62, 0xa2, 0x36, 0x79, 0x02, 0xc0, 0x8f, 0xe7, 0x96, 0x9f, 0x01, 0x0b, 0x44, 0xb6, 0x05, 0x65,
+	0x56, 0x95, 0x5a, 0xe6, 0x9f, 0xb0, 0xab, 0xf0, 0x9e, 0x65, 0xb7, 0xac, 0x3b, 0x76, 0xcb, 0x0e,
+	0x76, 0xde, 0xeb, 0x3a, 0xb4, 0x8f, 0xd5, 0xbc, 0x01, 0xe7, 0xbb, 0x8e, 0x25, 0xda, 0xb5, 0xe8,
+	0xaa, 0x58, 0xbf, 0xeb, 0x3b, 0x1d, 0x2a, 0x4e, 0xc9, 0x89, 0x85, 0x0b, 0x7b, 0xbb, 0x95, 0xf3,
+	0x1b, 0xd9, 0x55, 0x30, 0xaf, 0x2d, 0xbb, 0xf5, 0x14, 0xd0, 0x33, 0x6e, 0xab, 0xdb, 0x96, 0x58,
+	0x87, 0x38, 0x56, 0x7e, 0xeb, 0x6d, 0x64, 0xd6, 0xc0, 0x9c, 0x96, 0xe6, 0x4f, 0x96, 0x60, 0x74,
+	0xc1, 0xaa, 0xdf, 0xed, 0x76, 0xc8, 0x6b, 0x61, 0xbc, 0xe3, 0xb9, 0xf7, 0xec, 0x06, 0xf5, 0xe4,
+	0xb7, 0x9d, 0x92, 0xdf, 0x36, 0xbe, 0x26, 0xcb, 0x31, 0xaa, 0x41, 0x6c, 0x98, 0x0e, 0xff, 0xaf,
+	0x0e, 0xb0, 0x73, 0xf9, 0x4e, 0x58, 0xd3, 0x10, 0x61, 0x02, 0x31, 0x31, 0x61, 0xd4, 0xa3, 0x4d,
+	0x76, 0xd5, 0x0d, 0xf1, 0x6e, 0xf1, 0xdd, 0x8e, 0xbc, 0x04, 0x25, 0x84, 0xbc, 0x1f, 0xa6, 0xeb,
+	0x1e, 0x6d, 0x50, 0x27, 0xb0, 0xad, 0x96, 0xcf, 0x16, 0xe7, 0x48, 0xff, 0x8b, 0x93, 0x77, 0xa2,
+	0xaa, 0x35, 0xc7, 0x04, 0x3a, 0xf3, 0xf3, 0x25, 0x98, 0x12, 0x03, 0xb5, 0xd0, 0xad, 0xdf, 0xa5,
+	0x01, 0xf9, 0x46, 0x18, 0x67, 0xfc, 0x5d, 0xc3, 0x0a, 0x2c, 0xb9, 0x11, 0xbe, 0x36, 0xf7, 0xd3,
+	0xf9, 0x1e, 0x64, 0xb5, 0x63, 0xea, 0xab, 0x34, 0xb0, 0xe2, 0xf5, 0x17, 0x97, 0x61, 0x84, 0x95,
+	0x6c, 0xc2, 0xb0, 0xdf, 0xa1, 0x75, 0x39, 0xb0, 0x8b, 0x45, 0xb6, 0xba, 0xda, 0xe3, 0x5a, 0x87,
+	0xd6, 0xe3, 0xe5, 0xca, 0x7e, 0x21, 0xc7, 0x4f, 0x1c, 0x18, 0xf5, 0x03, 0x2b, 0xe8, 0xfa, 0x7c,
+	0x7c, 0x27, 0x9f, 0xb8, 0x3a, 0x30, 0x25, 0x8e, 0x6d, 0x61, 0x5a, 0xd2, 0x1a, 0x15, 0xbf, 0x51,
+	0x52, 0x31, 0xff, 0xad, 0x01, 0xa7, 0xd4, 0xea, 0x2b, 0xb6, 0x1f, 0x90, 0xaf, 0x4f, 0x0d, 0xe7,
+	0x5c, 0x7f, 0xc3, 0xc9, 0x5a, 0xf3, 0xc1, 0x8c, 0x56, 0x6b, 0x58, 0xa2, 0x0c, 0x25, 0x85, 0x11,
+	0x3b, 0xa0, 0xed, 0x90, 0x4b, 0x79, 0xe7, 0xa0, 0x5f, 0xb8, 0x70, 0x42, 0x12, 0x1b, 0x59, 0x66,
+	0x68, 0x51, 0x60, 0x37, 0xbf, 0x11, 0xce, 0xa8, 0xb5, 0xc2, 0x75, 0xcd, 0x8e, 0x8c, 0x60, 0xa7,
+	0x93, 0x3a, 0x32, 0xd8, 0x16, 0x44, 0x0e, 0x21, 0xaf, 0x8e, 0xd6, 0xb8, 0x38, 0x99, 0xa2, 0xb1,
+	0xd3, 0xd7, 0xb9, 0xf9, 0xd1, 0x21, 0x7d, 0xec, 0xd8, 0x34, 0x92, 0x7b, 0x89, 0x9d, 0x3b, 0xf9,
+	0xc4, 0xf5, 0x41, 0x3f, 0x30, 0xec, 0xfa, 0x4b, 0xe5, 0x0c, 0x78, 0x0c, 0xc6, 0x7d, 0x4a, 0x1b,
+	0xca, 0xbd, 0xcc, 0x6f, 0xf3, 0x9a, 0x2c, 0xc3, 0x08, 0x7a, 0xf4, 0x27, 0xc1, 0xe7, 0x86, 0x81,
+	0xa4, 0x57, 0xbb, 0x3a, 0x18, 0xa2, 0xa4, 0x6c, 0x0c, 0x3c, 0x18, 0x72, 0xe3, 0x24, 0x10, 0x93,
+	0x17, 0xe0, 0x44, 0xcb, 0xf2, 0x83, 0x5b, 0x1d, 0xea, 0x59, 0x41, 0xb8, 0x66, 0x26, 0x9f, 0x98,
+	0x2f, 0x32, 0xe9, 0x2b, 0x2a, 0xa2, 0x85, 0x99, 0xbd, 0xdd, 0xca, 0x09, 0xad, 0x08, 0x75, 0x52,
+	0xe4, 0x03, 0x30, 0xc1, 0x0a, 0x96, 0x3c, 0xcf, 0xf5, 0xe4, 0x79, 0xf1, 0x54, 0x51, 0xba, 0x1c,
+	0x89, 0x78, 0x47, 0x46, 0x3f, 0x31, 0x46, 0x4f, 0xde, 0x05, 0xc4, 0xbd, 0xc3, 0x5f, 0xf2, 0x8d,
+	0x6b, 0xd4, 0x09, 0x3f, 0x96, 0x4d, 0xff, 0xd0, 0xc2, 0xac, 0x5c, 0x97, 0xe4, 0x56, 0xaa, 0x06,
+	0x66, 0xb4, 0x22, 0x77, 0x81, 0x44, 0x0f, 0x5d, 0x71, 0xdb, 0xef, 0xb3, 0x34, 0xa2, 0x4a, 0x72,
+	0x69, 0x9c, 0x63, 0xc4, 0xae, 0xa5, 0x50, 0x60, 0x06, 0x5a, 0xf3, 0xd7, 0x4b, 0x30, 0x29, 0x96,
+	0x88, 0x78, 0x8c, 0x1c, 0xfd, 0x5d, 0x41, 0xb5, 0xbb, 0xa2, 0x5a, 0x7c, 0xfb, 0xf3, 0x0e, 0xe7,
+	0x5e, 0x15, 0xed, 0xc4, 0x55, 0xb1, 0x34, 0x28, 0xa1, 0xde, 0x37, 0xc5, 0xef, 0x1b, 0x70, 0x52,
+	0xa9, 0x7d, 0x0c, 0x17, 0x45, 0x43, 0xbf, 0x28, 0x9e, 0x1e, 0xf0, 0xfb, 0x72, 0xee, 0x09, 0x57,
+	0xfb, 0x2c, 0x7e, 0x86, 0x3f, 0x01, 0x70, 0x87, 0x1f, 0x27, 0x0a, 0x6b, 0x1b, 0x4d, 0xf9, 0x42,
+	0x04, 0x41, 0xa5, 0x96, 0x76, 0x28, 0x96, 0x7a, 0x1d, 0x8a, 0xe6, 0x7f, 0x18, 0x82, 0x99, 0xd4,
+	0xb0, 0xa7, 0xcf, 0x11, 0xe3, 0x2b, 0x74, 0x8e, 0x94, 0xbe, 0x12, 0xe7, 0xc8, 0x50, 0xa1, 0x73,
+	0xa4, 0xff, 0x8b, 0xc8, 0x03, 0xd2, 0xb6, 0x9b, 0xa2, 0x59, 0x2d, 0xb0, 0xbc, 0x60, 0xdd, 0x6e,
+	0x53, 0x79, 0xe2, 0x7c, 0x4d, 0x7f, 0x4b, 0x96, 0xb5, 0x10, 0x07, 0xcf, 0x6a, 0x0a, 0x13, 0x66,
+	0x60, 0x37, 0xbf, 0xa9, 0x04, 0x63, 0x0b, 0x96, 0xcf, 0x7b, 0xfa, 0x21, 0x98, 0x92, 0xa8, 0x97,
+	0xdb, 0x56, 0x93, 0x0e, 0x22, 0x8e, 0x90, 0x28, 0x57, 0x15, 0x74, 0xe2, 0x45, 0xa7, 0x96, 0xa0,
+	0x46, 0x8e, 0xec, 0xc0, 0x64, 0x3b, 0x7e, 0xbd, 0x94, 0x4b, 0x83, 0xb0, 0x96, 0x2a, 0x75, 0x86,
+	0x4d, 0x3c, 0x5b, 0x95, 0x02, 0x54, 0x69, 0x99, 0xcf, 0xc1, 0xe9, 0x8c, 0x1e, 0xf7, 0xf1, 0x70,
+	0x7b, 0x15, 0x8c, 0xb1, 0xb7, 0x77, 0xcc, 0x86, 0x4d, 0x32, 0xd9, 0xcf, 0x33, 0xa2, 0x08, 0x43,
+	0x98, 0xf9, 0x66, 0x20, 0x3a, 0x7e, 0x46, 0xb5, 0x1f, 0x01, 0xdf, 0x08, 0x40, 0x75, 0x1e, 0xdd,
+	0x40, 0x2c, 0xa5, 0xa7, 0x61, 0xa4, 0xb3, 0x65, 0xf9, 0x61, 0x8b, 0xc7, 0xc3, 0xa3, 0x62, 0x8d,
+	0x15, 0x3e, 0xd8, 0xad, 0x94, 0x55, 0x46, 0x44, 0x36, 0xe2, 0x30, 0x14, 0xed, 0xd8, 0x0a, 0x63,
+	0x8b, 0xbc, 0xea, 0xb6, 0x3b, 0x2d, 0xca, 0xa0, 0x7c, 0x85, 0x95, 0x8a, 0xad, 0xb0, 0x95, 0x14,
+	0x26, 0xcc, 0xc0, 0x1e, 0xd2, 0x5c, 0x76, 0xec, 0xc0, 0xb6, 0x22, 0x9a, 0x43, 0xc5, 0x69, 0xea,
+	0x98, 0x30, 0x03, 0x3b, 0xf9, 0x94, 0x01, 0xb3, 0x7a, 0xf1, 0x55, 0xdb, 0xb1, 0xfd, 0x2d, 0xda,
+	0x58, 0xb7, 0xe5, 0x36, 0x3c, 0x18, 0xf1, 0x4b, 0x7b, 0xbb, 0x95, 0xd9, 0x95, 0x5c, 0x8c, 0xd8,
+	0x83, 0x1a, 0xf9, 0x0e, 0x03, 0x2e, 0x24, 0xc6, 0xc5, 0xb3, 0x9b, 0x4d, 0xea, 0xd1, 0x46, 0xc1,
+	0x0d, 0x5e, 0xd9, 0xdb, 0xad, 0x5c, 0x58, 0xc9, 0x47, 0x89, 0xbd, 0xe8, 0x91, 0x1f, 0x35, 0xe0,
+	0x5c, 0x87, 0x3a, 0x0d, 0xdb, 0x69, 0xde, 0x76, 0xbd, 0xbb, 0x4c, 0x94, 0xe4, 0xb6, 0x5a, 0x6e,
+	0x37, 0xf0, 0xcb, 0xa3, 0xfc, 0x0e, 0x5b, 0x2e, 0xb2, 0xe7, 0xd6, 0xb2, 0x30, 0x2e, 0x5c, 0x92,
+	0x4b, 0xf4, 0x5c, 0x26, 0xd8, 0xc7, 0x9c, 0x8e, 0x98, 0xbf, 0x66, 0xc0, 0x50, 0x15, 0x97, 0xc9,
+	0x6b, 0xb4, 0x2d, 0x72, 0x5e, 0xdd, 0x22, 0x0f, 0x76, 0x2b, 0x63, 0x55, 0x5c, 0x56, 0x36, 0xe3,
+	0x77, 0x18, 0x30, 0x53, 0x77, 0x9d, 0xc0, 0x62, 0x63, 0x87, 0x82, 0x57, 0x0e, 0xef, 0xe5, 0x42,
+	0x8f, 0xe1, 0x6a, 0x02, 0x59, 0x2c, 0xec, 0x4e, 0x42, 0x7c, 0x4c, 0x53, 0x36, 0x7f, 0xcc, 0x80,
+	0x33, 0x55, 0xab, 0x23, 0x45, 0x41, 0x8b, 0x74, 0xd3, 0x76, 0xec, 0xfe, 0x24, 0xfb, 0x64, 0x0b,
+	0x46, 0xb9, 0xb4, 0xd9, 0x1f, 0xe4, 0x2d, 0x1f, 0xd3, 0x7e, 0x86, 0xe3, 0x12, 0x72, 0x10, 0xf1,
+	0x3f, 0x4a, 0xfc, 0xe6, 0x53, 0x70, 0x2a, 0x59, 0x8f, 0x54, 0x42, 0x9e, 0x46, 0x08, 0x1f, 0x27,
+	0x92, 0xec, 0xc8, 0x93, 0xe3, 0x3f, 0xf0, 0xb9, 0xca, 0x2b, 0x3e, 0xfa, 0x47, 0x97, 0x5f, 0x61,
+	0x7e, 0xd1, 0x80, 0xa9, 0x6a, 0xcb, 0xed, 0x36, 0xd6, 0x3c, 0x77, 0xd3, 0x6e, 0xd1, 0x97, 0x87,
+	0x94, 0x43, 0xed, 0x71, 0x1e, 0xeb, 0xca, 0xa5, 0x0e, 0x6a, 0xc5, 0x97, 0x89, 0xd4, 0x41, 0xed,
+	0x72, 0x0e, 0x37, 0xf9, 0x3e, 0x38, 0xab, 0xd6, 0x8a, 0x45, 0xa6, 0x97, 0x61, 0xf8, 0xae, 0xed,
+	0x34, 0x92, 0x0b, 0xf3, 0x86, 0xed, 0x34, 0x90, 0x43, 0xa2, 0xa5, 0x5b, 0xca, 0xbd, 0xb3, 0xfe,
+	0x72, 0x42, 0x1f, 0x36, 0xce, 0xac, 0x3e, 0x06, 0xe3, 0x75, 0x6b, 0xa1, 0xeb, 0x34, 0x5a, 0xd1,
+	0xaa, 0x67, 0x43, 0x50, 0x9d, 0x17, 0x65, 0x18, 0x41, 0xc9, 0x0b, 0x00, 0xb1, 0x76, 0x62, 0x10,
+	0x26, 0x20, 0x56, 0x7c, 0xd4, 0x68, 0x10, 0xd8, 0x4e, 0xd3, 0x8f, 0xd7, 0x55, 0x0c, 0x43, 0x85,
+	0x1a, 0xf9, 0x10, 0x9c, 0x50, 0x39, 0x12, 0x21, 0x26, 0x2d, 0x38, 0x0d, 0x1a, 0xeb, 0x73, 0x56,
+	0x12, 0x3e, 0xa1, 0x96, 0xfa, 0xa8, 0x53, 0x23, 0x3b, 0x11, 0xff, 0x25, 0x84, 0xb4, 0xc3, 0xc5,
+	0x5f, 0x14, 0x2a, 0xeb, 0x73, 0x46, 0x12, 0x9f, 0xd2, 0x84, 0xc6, 0x1a, 0xa9, 0x0c, 0xc1, 0xcc,
+	0xc8, 0x51, 0x09, 0x66, 0x28, 0x8c, 0x09, 0xd1, 0x54, 0x78, 0xdd, 0x3c, 0x59, 0xe4, 0x03, 0x85,
+	0x94, 0x2b, 0x56, 0xb7, 0x89, 0xdf, 0x3e, 0x86, 0xb8, 0x99, 0x3a, 0x8b, 0x31, 0xd6, 0x35, 0xda,
+	0xa2, 0xf5, 0xc0, 0xf5, 0xca, 0x63, 0xc5, 0xd5, 0x59, 0x35, 0x05, 0x8f, 0xe0, 0x62, 0xd5, 0x12,
+	0xd4, 0xe8, 0x44, 0x92, 0xbb, 0xf1, 0x5c, 0xc9, 0x5d, 0x17, 0x26, 0xef, 0x29, 0xa2, 0xf8, 0x09,
+	0x3e, 0x08, 0xef, 0x28, 0xd2, 0xb1, 0x58, 0x2e, 0xbf, 0x70, 0x5a, 0x12, 0x9a, 0x54, 0x65, 0xf8,
+	0x2a, 0x1d, 0x72, 0x07, 0xc6, 0xee, 0x08, 0x1e, 0xb4, 0x0c, 0x7c, 0x2c, 0xde, 0x3e, 0x00, 0x6b,
+	0x2d, 0xf8, 0x5c, 0xf9, 0x03, 0x43, 0xc4, 0xe4, 0x39, 0x18, 0x6d, 0xd9, 0x6d, 0x3b, 0xf0, 0xcb,
+	0x93, 0x97, 0x8d, 0xa2, 0x53, 0xbb, 0xc2, 0x31, 0x88, 0xcb, 0x4a, 0xfc, 0x8f, 0x12, 0x2b, 0xf9,
+	0xb4, 0x01, 0xa7, 0xe5, 0x32, 0x8c, 0x2e, 0x2d, 0x9b, 0xfa, 0xe5, 0xa9, 0xcb, 0x43, 0x45, 0x65,
+	0x98, 0x59, 0x17, 0xf4, 0xc2, 0x05, 0x39, 0x9a, 0xa7, 0x57, 0xd3, 0xc4, 0x30, 0xab, 0x07, 0xe6,
+	0xa7, 0x66, 0x60, 0xa6, 0xda, 0xea, 0xfa, 0x01, 0xf5, 0xe6, 0xa5, 0x25, 0x0b, 0xf5, 0xc8, 0xc7,
+	0x0d, 0x38, 0xc7, 0xff, 0x5d, 0x74, 0xef, 0x3b, 0x8b, 0xb4, 0x65, 0xed, 0xcc, 0x6f, 0xb2, 0x1a,
+	0x8d, 0xc6, 0xc1, 0x2e, 0x8f, 0xc5, 0xae, 0x7c, 0x26, 0x73, 0x8d, 0x4d, 0x2d, 0x13, 0x23, 0xe6,
+	0x50, 0x22, 0xdf, 0x66, 0xc0, 0x43, 0x19, 0xa0, 0x45, 0xda, 0xa2, 0x41, 0xc8, 0xfc, 0x1f, 0xb4,
+	0x1f, 0x0f, 0xef, 0xed, 0x56, 0x1e, 0xaa, 0xe5, 0x21, 0xc5, 0x7c, 0x7a, 0xcc, 0x24, 0x61, 0x36,
+	0x03, 0x7a, 0xd5, 0xb2, 0x5b, 0x5d, 0x2f, 0x7c, 0x17, 0x1c, 0xb4, 0x3b, 0x9c, 0x3d, 0xaf, 0xe5,
+	0x62, 0xc5, 0x1e, 0x14, 0xc9, 0x47, 0xe0, 0x6c, 0x04, 0xdd, 0x70, 0x1c, 0x4a, 0x1b, 0xda, 0x2b,
+	0xe1, 0xa0, 0x5d, 0x79, 0x68, 0x6f, 0xb7, 0x72, 0xb6, 0x96, 0x85, 0x10, 0xb3, 0xe9, 0x90, 0x26,
+	0x3c, 0x1c, 0x03, 0x02, 0xbb, 0x65, 0xbf, 0x20, 0x1e, 0x32, 0x5b, 0x1e, 0xf5, 0xb7, 0xdc, 0x56,
+	0x83, 0x1f, 0xc5, 0xc6, 0xc2, 0x2b, 0xf7, 0x76, 0x2b, 0x0f, 0xd7, 0x7a, 0x55, 0xc4, 0xde, 0x78,
+	0x48, 0x03, 0xa6, 0xfc, 0xba, 0xe5, 0x2c, 0x3b, 0x01, 0xf5, 0xee, 0x59, 0xad, 0xf2, 0x68, 0xa1,
+	0x0f, 0x14, 0x07, 0xa0, 0x82, 0x07, 0x35, 0xac, 0xe4, 0xad, 0x30, 0x4e, 0xb7, 0x3b, 0x96, 0xd3,
+	0xa0, 0xe2, 0xd0, 0x9d, 0x58, 0xb8, 0xc8, 0xae, 0xfa, 0x25, 0x59, 0xf6, 0x60, 0xb7, 0x32, 0x15,
+	0xfe, 0xbf, 0xea, 0x36, 0x28, 0x46, 0xb5, 0xc9, 0x07, 0xe1, 0x0c, 0x37, 0xb5, 0x69, 0x50, 0x7e,
+	0x85, 0xf8, 0xe1, 0x5b, 0x71, 0xbc, 0x50, 0x3f, 0xb9, 0x1a, 0x7e, 0x35, 0x03, 0x1f, 0x66, 0x52,
+	0x61, 0xd3, 0xd0, 0xb6, 0xb6, 0xaf, 0x79, 0x56, 0x9d, 0x6e, 0x76, 0x5b, 0xeb, 0xd4, 0x6b, 0xdb,
+	0x8e, 0x10, 0x96, 0x30, 0xcd, 0x72, 0x83, 0x1d, 0xd4, 0xcc, 0xb0, 0x87, 0x4f, 0xc3, 0x6a, 0xaf,
+	0x8a, 0xd8, 0x1b, 0x0f, 0x79, 0x23, 0x4c, 0xd9, 0x4d, 0xc7, 0xf5, 0xe8, 0xba, 0x65, 0x3b, 0x81,
+	0x5f, 0x06, 0xce, 0x64, 0xf3, 0x61, 0x5d, 0x56, 0xca, 0x51, 0xab, 0x45, 0xee, 0x01, 0x71, 0xe8,
+	0xfd, 0x35, 0xb7, 0xc1, 0x97, 0xc0, 0x46, 0x87, 0x2f, 0xe4, 0xf2, 0x64, 0xa1, 0xa1, 0xe1, 0x4f,
+	0xe9, 0x9b, 0x29, 0x6c, 0x98, 0x41, 0x81, 0x5c, 0x05, 0xd2, 0xb6, 0xb6, 0x97, 0xda, 0x9d, 0x60,
+	0x67, 0xa1, 0xdb, 0xba, 0x2b, 0x4f, 0x8d, 0x29, 0x3e, 0x16, 0x42, 0xd0, 0x94, 0x82, 0x62, 0x46,
+	0x0b, 0x62, 0xc1, 0x05, 0xf1, 0x3d, 0x8b, 0x16, 0x6d, 0xbb, 0x8e, 0x4f, 0x03, 0x5f, 0x59, 0xa4,
+	0xe5, 0x13, 0xdc, 0xe0, 0x82, 0x3f, 0x6c, 0x97, 0xf3, 0xab, 0x61, 0x2f, 0x1c, 0xba, 0xc9, 0xd9,
+	0xf4, 0x3e, 0x26, 0x67, 0x6f, 0x81, 0x13, 0x7e, 0x60, 0x79, 0x41, 0xb7, 0x23, 0xa7, 0xe1, 0x24,
+	0x9f, 0x06, 0x2e, 0x87, 0xac, 0xa9, 0x00, 0xd4, 0xeb, 0xb1, 0xe9, 0x13, 0xc2, 0x66, 0xd9, 0xee,
+	0x54, 0x3c, 0x7d, 0x35, 0xa5, 0x1c, 0xb5, 0x5a, 0x4c, 0xc3, 0xdf, 0xb6, 0xb6, 0xa3, 0xed, 0xbb,
+	0x66, 0x79, 0x56, 0xab, 0x45, 0x5b, 0xb6, 0xdf, 0x2e, 0xcf, 0xf0, 0x9e, 0x72, 0x0d, 0xff, 0x6a,
+	0x76, 0x15, 0xcc, 0x6b, 0x2b, 0x6d, 0xd0, 0x16, 0x3d, 0xcb, 0xd6, 0x50, 0x12, 0xcd, 0x06, 0x2d,
+	0x09, 0xc6, 0xac, 0x36, 0xe4, 0x87, 0x0d, 0xa8, 0xf0, 0xfb, 0xcf, 0x6a, 0xb1, 0xcd, 0x71, 0xcd,
+	0x73, 0xbb, 0x1d, 0x26, 0x01, 0x76, 0x37, 0x37, 0xc3, 0x85, 0x53, 0x3e, 0x5d, 0x68, 0xb9, 0x3d,
+	0xb2, 0xb7, 0x5b, 0xa9, 0x2c, 0xf7, 0x46, 0x8d, 0xfb, 0xd1, 0x26, 0xdf, 0x6d, 0xc0, 0x05, 0xb9,
+	0x71, 0x33, 0xfb, 0x76, 0xa6, 0x50, 0xdf, 0xf8, 0x8a, 0x5b, 0xcd, 0x47, 0x8b, 0xbd, 0x68, 0x92,
+	0xef, 0x35, 0xe0, 0xa2, 0x93, 0x00, 0x22, 0xf5, 0x29, 0x97, 0xad, 0xba, 0xdd, 0xa0, 0x7c, 0xb6,
+	0x50, 0xa7, 0x2e, 0xef, 0xed, 0x56, 0x2e, 0xde, 0xec, 0x81, 0x17, 0x7b, 0x52, 0x35, 0xff, 0xc7,
+	0x30, 0x94, 0x53, 0xcc, 0x48, 0x68, 0x13, 0xb8, 0xef, 0x75, 0x63, 0x1c, 0xd2, 0x75, 0xd3, 0x81,
+	0xcb, 0x51, 0x85, 0x6b, 0x9d, 0x6e, 0x26, 0xad, 0x12, 0xa7, 0xf5, 0xe8, 0xde, 0x6e, 0xe5, 0x72,
+	0x6d, 0x9f, 0xba, 0xb8, 0x2f, 0xb6, 0xfc, 0xab, 0x7c, 0xe8, 0x98, 0xae, 0xf2, 0x0f, 0xc2, 0x19,
+	0x05, 0xe0, 0x51, 0xab, 0xb1, 0x33, 0x00, 0x2b, 0xc1, 0x6f, 0xb0, 0x5a, 0x06, 0x3e, 0xcc, 0xa4,
+	0x92, 0x7b, 0x7f, 0x8e, 0x1c, 0xc7, 0xfd, 0x69, 0xee, 0x0e, 0xc1, 0x44, 0xd5, 0x75, 0x1a, 0x42,
+	0xc4, 0xf5, 0x7a, 0xcd, 0x80, 0xe1, 0x61, 0xf5, 0x19, 0xf4, 0x60, 0xb7, 0x72, 0x22, 0xaa, 0xa8,
+	0xbc, 0x8b, 0xde, 0x16, 0xa9, 0x0a, 0x85, 0x70, 0xe1, 0x95, 0xba, 0x8e, 0xef, 0xc1, 0x6e, 0xe5,
+	0x64, 0xd4, 0x4c, 0x57, 0xfb, 0xb1, 0xcb, 0x91, 0x49, 0x3c, 0xd7, 0x3d, 0xcb, 0xf1, 0xed, 0x01,
+	0x64, 0xcc, 0x91, 0x6e, 0x67, 0x25, 0x85, 0x0d, 0x33, 0x28, 0x90, 0x0f, 0xc0, 0x34, 0x2b, 0xdd,
+	0xe8, 0x34, 0xac, 0x80, 0x16, 0x14, 0x2d, 0x47, 0xe6, 0x68, 0x2b, 0x1a, 0x26, 0x4c, 0x60, 0x16,
+	0x06, 0x1f, 0x96, 0xef, 0x3a, 0xe5, 0x91, 0xa4, 0xc1, 0x87, 0xe5, 0x0b, 0x83, 0x0f, 0xcb, 0x17,
+	0x26, 0xa9, 0x6d, 0xea, 0xfb, 0x4c, 0x81, 0x33, 0xca, 0x2b, 0x46, 0x6f, 0xe4, 0x55, 0x51, 0x8c,
+	0x21, 0x9c, 0xbc, 0x16, 0x46, 0xea, 0x6e, 0x83, 0xfa, 0xe5, 0x31, 0x7e, 0x87, 0xb1, 0xeb, 0x7c,
+	0xa4, 0xca, 0x0a, 0x1e, 0xec, 0x56, 0x26, 0xb8, 0x26, 0x8c, 0xfd, 0x42, 0x51, 0xc9, 0xfc, 0x11,
+	0x26, 0x0f, 0x4b, 0x08, 0x39, 0xfb, 0x30, 0x54, 0x39, 0x3e, 0x9b, 0x0f, 0xf3, 0xd3, 0x4c, 0x18,
+	0xe9, 0x3a, 0x81, 0xe7, 0xb6, 0xd6, 0x5a, 0x96, 0x43, 0xc9, 0xb7, 0x1a, 0x70, 0x6a, 0xcb, 0x6e,
+	0x6e, 0xa9, 0x26, 0x79, 0x65, 0xa3, 0xb8, 0xdc, 0xf0, 0x7a, 0x02, 0xd7, 0xc2, 0x99, 0xbd, 0xdd,
+	0xca, 0xa9, 0x64, 0x29, 0xa6, 0x68, 0x9a, 0x7f, 0x5a, 0x82, 0xf3, 0x6a, 0xcf, 0xe6, 0x63, 0x6f,
+	0x07, 0xf2, 0xfb, 0x06, 0x40, 0xdb, 0x76, 0xe6, 0x5b, 0x2d, 0xf7, 0x3e, 0xb7, 0x23, 0x66, 0x6f,
+	0xd9, 0xf7, 0x15, 0x95, 0x57, 0x67, 0x50, 0x98, 0x5b, 0x8d, 0xb0, 0x0b, 0x1d, 0xf3, 0xb3, 0xa1,
+	0x1c, 0x2c, 0x06, 0x3c, 0xd8, 0xad, 0x54, 0xd2, 0x2e, 0x16, 0x73, 0x28, 0xfd, 0x18, 0x98, 0xac,
+	0xf2, 0xe3, 0x7f, 0xd2, 0xb3, 0x8a, 0x50, 0x36, 0xc7, 0x1f, 0x32, 0xdb, 0x86, 0x93, 0x09, 0xc2,
+	0x19, 0x36, 0xd6, 0x8b, 0xaa, 0x8d, 0xf5, 0x3e, 0x87, 0xd4, 0x5c, 0xe8, 0x55, 0x31, 0xf7, 0xee,
+	0xae, 0xe5, 0x04, 0x6c, 0xa4, 0x15, 0x9b, 0xec, 0x3f, 0x2e, 0xc1, 0x19, 0x39, 0x00, 0x2d, 0xf6,
+	0xda, 0xec, 0xb4, 0xdc, 0x9d, 0x36, 0x75, 0x8e, 0xc3, 0xee, 0x2e, 0xdc, 0x04, 0xa5, 0xdc, 0x4d,
+	0xd0, 0x4e, 0x6d, 0x82, 0xa1, 0x22, 0x9b, 0x20, 0x3a, 0x2b, 0xf6, 0x91, 0xb1, 0x21, 0x9c, 0xb3,
+	0x1d, 0xd6, 0xd1, 0x6b, 0x7c, 0xc1, 0xc4, 0xd6, 0xb1, 0xfc, 0x7c, 0x1a, 0x17, 0x62, 0x84, 0xe5,
+	0xcc, 0x1a, 0x98, 0xd3, 0xd2, 0xfc, 0x73, 0x03, 0xca, 0x59, 0xe3, 0x7b, 0x0c, 0x62, 0xf1, 0xb6,
+	0x2e, 0x16, 0xbf, 0x3e, 0xc0, 0xde, 0xd0, 0xba, 0x9e, 0x23, 0x1e, 0xff, 0xb3, 0x12, 0x9c, 0x8b,
+	0xab, 0x2f, 0x3b, 0x7e, 0x60, 0xb5, 0x5a, 0x82, 0xe1, 0x3b, 0xfa, 0xb5, 0xd4, 0xd1, 0xb4, 0x1b,
+	0x37, 0x07, 0xfb, 0x54, 0xb5, 0xef, 0xb9, 0x26, 0x3a, 0xdb, 0x09, 0x13, 0x9d, 0xb5, 0x43, 0xa4,
+	0xd9, 0xdb, 0x5a, 0xe7, 0x3f, 0x1b, 0x30, 0x9b, 0xdd, 0xf0, 0x18, 0x16, 0x95, 0xab, 0x2f, 0xaa,
+	0x77, 0x1d, 0xde, 0x57, 0xe7, 0x2c, 0xab, 0x9f, 0x2b, 0xe5, 0x7d, 0x2d, 0x57, 0x91, 0x6c, 0xc2,
+	0x49, 0x8f, 0x36, 0x6d, 0x3f, 0x90, 0x0f, 0x8f, 0x83, 0x99, 0xcb, 0x87, 0xaa, 0xd1, 0x93, 0xa8,
+	0xe3, 0xc0, 0x24, 0x52, 0x72, 0x13, 0xc6, 0x98, 0xc0, 0x9a, 0xe1, 0x2f, 0xf5, 0x8f, 0x3f, 0x62,
+	0x22, 0x6a, 0xa2, 0x2d, 0x86, 0x48, 0xc8, 0xd7, 0xc3, 0x89, 0x46, 0xb4, 0xa3, 0x18, 0xd6, 0xa1,
+	0xfe, 0xb1, 0xf2, 0xd7, 0xf6, 0xa2, 0xda, 0x1a, 0x75, 0x64, 0xe6, 0xff, 0x31, 0xe0, 0x62, 0xaf,
+	0xb5, 0x45, 0x9e, 0x07, 0xa8, 0x87, 0x5c, 0xa1, 0x2f, 0x2f, 0xcf, 0xa7, 0x0a, 0xce, 0xa5, 0xc0,
+	0x12, 0x6f, 0xd0, 0xa8, 0xc8, 0x47, 0x85, 0x48, 0x86, 0xe1, 0x66, 0xe9, 0x88, 0x0c, 0x37, 0xcd,
+	0xff, 0x62, 0xa8, 0x47, 0x91, 0x3a, 0xb7, 0x2f, 0xb7, 0xa3, 0x48, 0xed, 0x7b, 0xae, 0xca, 0xf5,
+	0x0b, 0x25, 0xb8, 0x9c, 0xdd, 0x44, 0xb9, 0xcf, 0xdf, 0x09, 0xa3, 0x1d, 0xe1, 0xd2, 0x22, 0xac,
+	0xfb, 0x1f, 0x63, 0x27, 0x8b, 0x70, 0x38, 0x79, 0xb0, 0x5b, 0x99, 0xcd, 0x3a, 0xe8, 0x05, 0x14,
+	0x65, 0x3b, 0x62, 0x27, 0x74, 0x43, 0x82, 0x69, 0x7f, 0x43, 0x9f, 0x87, 0x8b, 0x75, 0x87, 0xb6,
+	0xfa, 0x56, 0x07, 0x7d, 0xcc, 0x80, 0x69, 0x6d, 0x45, 0xfb, 0xe5, 0x91, 0xcb, 0x43, 0x45, 0x6d,
+	0xe6, 0xb4, 0xad, 0x12, 0x73, 0x03, 0x5a, 0xb1, 0x8f, 0x09, 0x82, 0x89, 0x63, 0x56, 0x1d, 0xd5,
+	0x97, 0xdd, 0x31, 0xab, 0x76, 0x3e, 0xe7, 0x98, 0xfd, 0xa1, 0x52, 0xde, 0xd7, 0xf2, 0x63, 0xf6,
+	0x3e, 0x4c, 0x84, 0x6c, 0x64, 0x78, 0x5c, 0x5c, 0x1d, 0xb4, 0x4f, 0x02, 0xdd, 0xc2, 0x8c, 0xec,
+	0xcf, 0x44, 0x58, 0xe2, 0x63, 0x4c, 0x8b, 0x7c, 0xb3, 0x01, 0x10, 0x4f, 0x8c, 0xdc, 0x54, 0xeb,
+	0x87, 0x37, 0x1c, 0x0a, 0x5b, 0x33, 0xcd, 0xb6, 0x74, 0xfc, 0x1b, 0x15, 0xba, 0xe6, 0xbf, 0x19,
+	0x06, 0x92, 0xee, 0x7b, 0x7f, 0x9a, 0xff, 0x7d, 0x98, 0xdc, 0x0e, 0x9c, 0xf2, 0x18, 0xb7, 0x58,
+	0xb7, 0x5b, 0x34, 0x14, 0x80, 0x15, 0x93, 0x7c, 0xf0, 0x67, 0x15, 0x26, 0x70, 0x61, 0x0a, 0x3b,
+	0x33, 0xbf, 0xeb, 0x78, 0x76, 0xdb, 0xf2, 0x76, 0xf8, 0xa3, 0x78, 0x5c, 0xa8, 0x25, 0xd7, 0x44,
+	0x11, 0x86, 0x30, 0xf2, 0x41, 0x98, 0x68, 0xd9, 0x9b, 0xb4, 0xbe, 0x53, 0x6f, 0x51, 0xa9, 0xf5,
+	0xb8, 0x75, 0x38, 0x73, 0xbe, 0x12, 0xa2, 0x95, 0xc6, 0xa4, 0xe1, 0x4f, 0x8c, 0x09, 0x32, 0x19,
+	0xed, 0x7d, 0x6e, 0xde, 0xd4, 0xa2, 0xbe, 0x5f, 0xeb, 0x76, 0x3a, 0xae, 0x17, 0xd0, 0x06, 0xd7,
+	0x8d, 0x8c, 0x0b, 0x19, 0xed, 0xed, 0x34, 0x18, 0xb3, 0xda, 0x90, 0xa7, 0x00, 0xac, 0x6e, 0xe0,
+	0x0a, 0xdf, 0xd2, 0xf2, 0x38, 0x7f, 0xb5, 0x33, 0x55, 0x1c, 0xcc, 0x47, 0xa5, 0x0f, 0x76, 0x2b,
+	0x93, 0x52, 0xf6, 0xc7, 0xa7, 0x46, 0x69, 0x40, 0xde, 0x07, 0x67, 0xea, 0x02, 0xc4, 0x6c, 0xc3,
+	0xac, 0xc0, 0x96, 0x2f, 0xe2, 0x09, 0x8e, 0xe8, 0x6f, 0x30, 0x81, 0x4f, 0x35, 0x03, 0x9e, 0x44,
+	0x99, 0x89, 0xc4, 0xfc, 0x54, 0x09, 0x2e, 0xf4, 0x18, 0x20, 0x82, 0x30, 0x11, 0xcd, 0x9f, 0x5c,
+	0x66, 0x6f, 0x14, 0x9b, 0x45, 0x16, 0x3e, 0xd8, 0xad, 0x3c, 0xd2, 0x03, 0x41, 0x8d, 0xad, 0x73,
+	0xda, 0xdc, 0xc1, 0x18, 0x0d, 0x59, 0x86, 0xd1, 0x46, 0xac, 0xc6, 0x9c, 0x58, 0x78, 0x3d, 0xbb,
+	0x0a, 0x84, 0xc2, 0xa1, 0x5f, 0x6c, 0x12, 0x01, 0x59, 0x81, 0x31, 0x61, 0x1e, 0x4b, 0xe5, 0xb5,
+	0xf2, 0x04, 0x17, 0x99, 0x88, 0xa2, 0x7e, 0x91, 0x85, 0x28, 0xcc, 0xff, 0x65, 0xc0, 0x58, 0x95,
+	0x29, 0x2a, 0x6e, 0xd6, 0x98, 0x5d, 0xab, 0x12, 0xdc, 0x40, 0x1e, 0xb1, 0x05, 0xcf, 0x1c, 0x8e,
+	0x51, 0x79, 0xda, 0x87, 0xee, 0x98, 0x51, 0x01, 0xaa, 0xb4, 0xc8, 0xf3, 0x6c, 0xcc, 0xef, 0x7b,
+	0x76, 0xc0, 0x08, 0x0f, 0x62, 0x2f, 0x25, 0x08, 0x63, 0x88, 0x4b, 0xac, 0xf6, 0xe8, 0x27, 0xc6,
+	0x54, 0xcc, 0x35, 0x20, 0xb2, 0xb6, 0x2a, 0xe3, 0x78, 0x12, 0x86, 0xdb, 0x6e, 0x23, 0x9c, 0xf7,
+	0x57, 0x87, 0x87, 0x07, 0x53, 0x00, 0x3e, 0xd8, 0xad, 0x9c, 0x4b, 0xb7, 0x60, 0x10, 0xe4, 0x6d,
+	0xcc, 0x9b, 0x70, 0x4a, 0xc2, 0x23, 0x82, 0xcc, 0x4f, 0xb6, 0xee, 0xb6, 0xdb, 0xae, 0x53, 0xeb,
+	0x6e, 0x6e, 0xda, 0xdb, 0x54, 0xf3, 0x93, 0xad, 0x6a, 0x10, 0x4c, 0xd4, 0x34, 0x7f, 0xd0, 0x80,
+	0x21, 0x36, 0x2f, 0x26, 0x8c, 0x36, 0xdc, 0xb6, 0x65, 0x3b, 0xb2, 0x57, 0xdc, 0xe0, 0x60, 0x91,
+	0x97, 0xa0, 0x84, 0x90, 0x0e, 0x4c, 0x84, 0x1c, 0xd9, 0x40, 0x16, 0xfe, 0x8b, 0x37, 0x6b, 0x91,
+	0x83, 0x54, 0x74, 0x4d, 0x84, 0x25, 0x3e, 0xc6, 0x44, 0x4c, 0x0b, 0x66, 0x16, 0x6f, 0xd6, 0x96,
+	0x9d, 0x7a, 0xab, 0xdb, 0xa0, 0x4b, 0xdb, 0xfc, 0x0f, 0x3b, 0xe7, 0x6c, 0x51, 0x22, 0xbf, 0x93,
+	0x9f, 0x73, 0xb2, 0x12, 0x86, 0x30, 0x56, 0x8d, 0x8a, 0x16, 0xe5, 0x52, 0x5c, 0x4d, 0x22, 0xc1,
+	0x10, 0x66, 0x7e, 0xb1, 0x04, 0x93, 0x4a, 0x87, 0x48, 0x0b, 0xc6, 0xc4, 0xe7, 0xfa, 0x83, 0x84,
+	0x06, 0x48, 0xf5, 0x5a, 0x50, 0x17, 0x03, 0xea, 0x63, 0x48, 0x42, 0x3d, 0xb3, 0x4b, 0x3d, 0xce,
+	0xec, 0x39, 0xcd, 0xfb, 0x56, 0x6c, 0xc9, 0xe9, 0x7c, 0xcf, 0x5b, 0x72, 0x51, 0x5e, 0x4f, 0xc2,
+	0xc4, 0x7e, 0x3c, 0x71, 0x35, 0x6d, 0xc2, 0xc8, 0x0b, 0xae, 0x43, 0xfd, 0xf2, 0xc8, 0x61, 0x7e,
+	0x20, 0x37, 0x8c, 0x64, 0x2e, 0xbe, 0x3e, 0x0a, 0xf4, 0xe6, 0x8f, 0x1a, 0x00, 0x8b, 0x56, 0x60,
+	0x09, 0x2b, 0x9c, 0x3e, 0x0c, 0x3d, 0x2f, 0x6a, 0xb7, 0xea, 0x78, 0xca, 0xc9, 0x6f, 0xd8, 0xb7,
+	0x5f, 0x08, 0x3f, 0x3f, 0xe2, 0xd6, 0x05, 0xf6, 0x9a, 0xfd, 0x02, 0x45, 0x0e, 0x67, 0x9a, 0x4f,
+	0xea, 0xd4, 0xbd, 0x9d, 0x0e, 0xbb, 0x58, 0x84, 0x88, 0x87, 0xef, 0xd0, 0xa5, 0xb0, 0x10, 0x63,
+	0xb8, 0xf9, 0x7a, 0xd0, 0x9f, 0x5c, 0x7d, 0xd8, 0xa1, 0xff, 0x95, 0x01, 0xe7, 0x17, 0xbb, 0x56,
+	0x6b, 0xbe, 0xc3, 0x16, 0xaa, 0xd5, 0xba, 0xea, 0x0a, 0x73, 0x0e, 0xf6, 0x0e, 0x79, 0x2d, 0x8c,
+	0x87, 0x4c, 0x4e, 0xd2, 0x0b, 0x38, 0x3c, 0x28, 0x31, 0xaa, 0x41, 0x2c, 0xe6, 0x0d, 0x21, 0xd9,
+	0xee, 0xd2, 0x00, 0x6c, 0x77, 0x48, 0x22, 0x2c, 0xc1, 0x08, 0xad, 0x10, 0x7e, 0xf1, 0x09, 0x62,
+	0x41, 0x40, 0xec, 0x3a, 0x9d, 0xaf, 0xd7, 0xdd, 0x2e, 0x53, 0xd5, 0x0e, 0xa9, 0xc2, 0xaf, 0xac,
+	0x1a, 0x98, 0xd3, 0xd2, 0xfc, 0xbc, 0x01, 0xc3, 0x4b, 0xeb, 0xd5, 0x45, 0xf2, 0xf5, 0x30, 0x1c,
+	0x1d, 0x19, 0x05, 0xad, 0xb6, 0x18, 0x1e, 0x21, 0xa7, 0x13, 0xf3, 0xbd, 0xca, 0x0e, 0x1c, 0x8e,
+	0x95, 0xdc, 0x81, 0x51, 0x7a, 0x8f, 0xb2, 0xae, 0x96, 0x0e, 0x05, 0x3f, 0x3f, 0xd2, 0x96, 0x38,
+	0x46, 0x94, 0x98, 0xcd, 0x6f, 0x37, 0x00, 0xe2, 0x2a, 0xe4, 0xc3, 0x59, 0xb7, 0xd3, 0x8d, 0x43,
+	0x94, 0x3e, 0xf7, 0xbe, 0xa2, 0xcc, 0x5f, 0x19, 0x81, 0x87, 0x58, 0x77, 0xe4, 0x52, 0xb5, 0x5d,
+	0xe7, 0x06, 0xdd, 0xf9, 0xaa, 0xc7, 0xc3, 0x57, 0x3d, 0x1e, 0x0e, 0xd1, 0xe3, 0xe1, 0x7d, 0xf0,
+	0x10, 0x5b, 0x72, 0x12, 0x4c, 0xb9, 0xf9, 0xd7, 0x9a, 0x47, 0x3b, 0x96, 0x47, 0x1b, 0xfc, 0x3d,
+	0x30, 0x2e, 0x0c, 0xda, 0xe6, 0xf3, 0x2a, 0x61, 0x7e, 0x7b, 0xf3, 0x69, 0x38, 0x15, 0xaf, 0xdd,
+	0xf9, 0x00, 0xa9, 0x1f, 0xb0, 0xf3, 0x58, 0x7f, 0x64, 0x4e, 0x84, 0x1c, 0x53, 0xfa, 0x61, 0xa8,
+	0x23, 0x90, 0xbb, 0xf2, 0x40, 0x08, 0x1e, 0x18, 0x70, 0x6a, 0x69, 0xbb, 0x63, 0x7b, 0x3c, 0x56,
+	0x83, 0x70, 0x39, 0x62, 0x6a, 0xc0, 0xd0, 0x33, 0xc9, 0xd0, 0xd5, 0x80, 0x49, 0xef, 0x24, 0xb2,
+	0x09, 0xd3, 0x94, 0x37, 0xe7, 0xcf, 0x48, 0x2b, 0x28, 0xb2, 0x3f, 0x44, 0x80, 0x12, 0x0d, 0x0b,
+	0x26, 0xb0, 0x92, 0x1a, 0x4c, 0xd7, 0x5b, 0x96, 0xef, 0xdb, 0x9b, 0x76, 0x3d, 0xf6, 0xa8, 0x9b,
+	0x58, 0x78, 0x0d, 0x67, 0xda, 0x34, 0xc8, 0x83, 0xdd, 0xca, 0x59, 0xd9, 0x4f, 0x1d, 0x80, 0x09,
+	0x14, 0xe6, 0x67, 0x4a, 0x70, 0x62, 0x69, 0xbb, 0xe3, 0xfa, 0x5d, 0x8f, 0xf2, 0xaa, 0xc7, 0x20,
+	0x18, 0x7b, 0x1c, 0xc6, 0xb6, 0x2c, 0x66, 0xad, 0xee, 0x95, 0x4b, 0xfa, 0xd8, 0x5e, 0x17, 0xc5,
+	0x18, 0xc2, 0xc9, 0x8b, 0x00, 0x2c, 0xd4, 0x56, 0xa3, 0xcb, 0x4f, 0xd7, 0xa1, 0xe2, 0xa7, 0xab,
+	0xf6, 0x8d, 0xb5, 0x08, 0xa5, 0xe4, 0x89, 0xa2, 0xdf, 0xa8, 0x90, 0x33, 0xff, 0xc0, 0x80, 0x19,
+	0xad, 0xdd, 0x31, 0xc8, 0x7b, 0x36, 0x75, 0x79, 0xcf, 0xfc, 0xc0, 0xdf, 0x9a, 0x23, 0xe6, 0xf9,
+	0x44, 0x09, 0xce, 0xe7, 0x8c, 0x49, 0xca, 0xf6, 0xdb, 0x38, 0x26, 0xdb, 0xef, 0x2e, 0x4c, 0x06,
+	0x6e, 0x4b, 0x3a, 0x7e, 0x86, 0x23, 0x50, 0xe8, 0x0e, 0x5f, 0x8f, 0xd0, 0xc4, 0x96, 0xdd, 0x71,
+	0x99, 0x8f, 0x2a, 0x1d, 0xe6, 0x2c, 0x35, 0x11, 0x89, 0x95, 0x5f, 0x52, 0x1a, 0xf9, 0xfe, 0x63,
+	0x2a, 0x99, 0xbf, 0x55, 0x82, 0x73, 0x11, 0xee, 0xf0, 0x98, 0x63, 0x52, 0xf0, 0x7e, 0x64, 0x53,
+	0x17, 0x35, 0xaf, 0x94, 0xf1, 0xb4, 0x93, 0x66, 0xa7, 0xeb, 0x75, 0x5c, 0x3f, 0x64, 0xa4, 0xc5,
+	0x8b, 0x43, 0x14, 0x61, 0x08, 0x23, 0x37, 0x61, 0xc4, 0x67, 0xf4, 0xca, 0xc3, 0x45, 0x46, 0x83,
+	0xbf, 0x05, 0x78, 0x7f, 0x51, 0xa0, 0x21, 0x2f, 0xaa, 0x67, 0xf8, 0x48, 0x71, 0xe9, 0x27, 0xfb,
+	0x92, 0x46, 0xc4, 0x4a, 0xc7, 0xba, 0x93, 0x5e, 0xd2, 0x46, 0x73, 0x05, 0x4e, 0x49, 0x03, 0x67,
+	0xb1, 0x6c, 0x98, 0x77, 0xcf, 0x5b, 0xb5, 0x95, 0xf1, 0x68, 0xc2, 0x26, 0xe7, 0x4c, 0xb2, 0x7e,
+	0xbc, 0x62, 0x4c, 0x1f, 0xc6, 0xaf, 0xc9, 0x4e, 0x92, 0x59, 0x28, 0xd9, 0xe1, 0x5c, 0x80, 0xc4,
+	0x51, 0x5a, 0x5e, 0xc4, 0x92, 0xdd, 0x87, 0x77, 0x90, 0x7a, 0x2d, 0x0d, 0xf5, 0xbe, 0x96, 0xcc,
+	0x2f, 0x97, 0xe0, 0x4c, 0x48, 0x35, 0xfc, 0xc6, 0x45, 0xa9, 0x6e, 0xdf, 0xe7, 0x55, 0xb5, 0xbf,
+	0xac, 0xf2, 0x16, 0x0c, 0xf3, 0x03, 0xb0, 0x90, 0x1a, 0x3e, 0x42, 0xc8, 0xba, 0x83, 0x1c, 0x11,
+	0xf9, 0x20, 0x8c, 0xb6, 0xd8, 0x13, 0x25, 0x74, 0xdb, 0x29, 0x24, 0xd9, 0xcd, 0xfa, 0x5c, 0xf1,
+	0xf2, 0x91, 0xe1, 0xec, 0x22, 0x4d, 0xaa, 0x28, 0x44, 0x49, 0x73, 0xf6, 0x6d, 0x30, 0xa9, 0x54,
+	0x3b, 0x50, 0x2c, 0xbb, 0x1f, 0x2c, 0x41, 0xf9, 0x3a, 0x6d, 0xb5, 0x33, 0x6d, 0x27, 0x2a, 0x30,
+	0x52, 0xdf, 0xb2, 0x3c, 0x11, 0x26, 0x71, 0x4a, 0x2c, 0xf2, 0x2a, 0x2b, 0x40, 0x51, 0xce, 0x5e,
+	0x2c, 0x9a, 0xa3, 0xe2, 0x3b, 0x94, 0x91, 0x8c, 0xe3, 0x67, 0xbe, 0x3f, 0x0a, 0xb0, 0x19, 0x7f,
+	0xb8, 0x56, 0x81, 0x5d, 0x2f, 0xef, 0xaa, 0xdd, 0xba, 0x99, 0xe5, 0xa2, 0xc8, 0xa2, 0x0e, 0xb8,
+	0x75, 0x1b, 0x69, 0xc7, 0xf5, 0xed, 0xc0, 0xf5, 0x76, 0xe4, 0xa4, 0x15, 0xba, 0x5a, 0x6e, 0x55,
+	0x97, 0x63, 0x44, 0x42, 0xff, 0xa8, 0x15, 0xa1, 0x4e, 0xca, 0xfc, 0x19, 0x03, 0x26, 0xaf, 0xdb,
+	0x77, 0xa8, 0x27, 0x6c, 0xb8, 0xb9, 0x88, 0x45, 0x0b, 0xf8, 0x37, 0x99, 0x15, 0xec, 0x8f, 0x6c,
+	0xc3, 0x84, 0xbc, 0x87, 0x23, 0x0f, 0xd4, 0x6b, 0xc5, 0x0c, 0x8e, 0x22, 0xd2, 0xf2, 0x7e, 0x53,
+	0x36, 0x7e, 0x58, 0xe2, 0x63, 0x4c, 0xcc, 0x7c, 0x11, 0x4e, 0x67, 0x34, 0x62, 0x13, 0xc9, 0xcd,
+	0x98, 0xe5, 0xa6, 0x09, 0x4f, 0x2b, 0x36, 0x91, 0xbc, 0x9c, 0x3c, 0x04, 0x43, 0xd4, 0x69, 0xc8,
+	0x1d, 0x33, 0xb6, 0xb7, 0x5b, 0x19, 0x5a, 0x72, 0x1a, 0xc8, 0xca, 0xd8, 0x21, 0xde, 0x72, 0x35,
+	0x8e, 0x8d, 0x1f, 0xe2, 0x2b, 0xb2, 0x0c, 0x23, 0x28, 0x37, 0x11, 0x4b, 0x5a, 0x43, 0xb1, 0xa7,
+	0xc9, 0xa9, 0xcd, 0xc4, 0xd9, 0x32, 0x88, 0x11, 0x56, 0xf2, 0x9c, 0x5a, 0x28, 0xcb, 0x01, 0x49,
+	0x9d, 0x78, 0x98, 0xa2, 0x6b, 0xfe, 0xd2, 0x30, 0x3c, 0x7c, 0x9d, 0x05, 0x79, 0x73, 0x9d, 0xc0,
+	0x6a, 0xad, 0xb9, 0x8d, 0xd8, 0x40, 0x56, 0x5e, 0x59, 0xdf, 0x62, 0xc0, 0xf9, 0x7a, 0xa7, 0x2b,
+	0xed, 0x95, 0xa5, 0x8d, 0xe9, 0x1a, 0xf5, 0x6c, 0xb7, 0xa8, 0xd3, 0x0e, 0x37, 0xed, 0xae, 0xae,
+	0x6d, 0x64, 0xa1, 0xc4, 0x3c, 0x5a, 0xdc, 0x77, 0xa8, 0xe1, 0xde, 0x77, 0x78, 0xe7, 0x6a, 0x01,
+	0x1f, 0xcd, 0x17, 0xe2, 0x49, 0x28, 0xe8, 0x3b, 0xb4, 0x98, 0x89, 0x11, 0x73, 0x28, 0x31, 0x8b,
+	0x5a, 0x69, 0x97, 0x8d, 0xd4, 0x6a, 0xd8, 0x0e, 0xf5, 0x7d, 0xe1, 0x78, 0x30, 0x80, 0x73, 0xcc,
+	0x72, 0x16, 0x42, 0xcc, 0xa6, 0x43, 0x9e, 0x03, 0xf0, 0x77, 0x9c, 0xba, 0x1c, 0xff, 0x62, 0x96,
+	0xac, 0x82, 0x45, 0x8e, 0xb0, 0xa0, 0x82, 0x91, 0x3d, 0xb4, 0x82, 0x68, 0x51, 0x8e, 0x72, 0x6b,
+	0x64, 0xfe, 0xd0, 0x8a, 0xd7, 0x50, 0x0c, 0x67, 0xa2, 0x93, 0xe9, 0x65, 0x67, 0xad, 0x65, 0xd5,
+	0xa9, 0x30, 0xcc, 0xf4, 0xc9, 0x15, 0x98, 0xf0, 0x23, 0x95, 0x8e, 0x38, 0x11, 0xe2, 0xfd, 0x19,
+	0x02, 0x30, 0xae, 0xc3, 0x2d, 0xf6, 0x6d, 0x47, 0x5e, 0x76, 0x57, 0x5d, 0x4f, 0x20, 0x92, 0xfb,
+	0x4e, 0x58, 0xec, 0xa7, 0xc1, 0x98, 0xd5, 0xc6, 0xfc, 0x59, 0x03, 0xce, 0xe8, 0xdd, 0x91, 0x36,
+	0x11, 0xdf, 0x6f, 0xc0, 0x19, 0xcd, 0xb1, 0x5e, 0x82, 0x07, 0x89, 0xf5, 0xb5, 0x96, 0x81, 0x4f,
+	0xd8, 0x08, 0x67, 0x41, 0x30, 0x93, 0xbe, 0xf9, 0xf7, 0x0d, 0x18, 0x93, 0x71, 0x3f, 0x99, 0x3d,
+	0xab, 0x26, 0x7e, 0x8f, 0xae, 0xb6, 0x84, 0x08, 0x7e, 0x87, 0x1b, 0x78, 0xc8, 0xab, 0x49, 0xde,
+	0x32, 0x85, 0xe4, 0xb7, 0x92, 0x70, 0x7c, 0xcf, 0x69, 0x86, 0x1e, 0xb2, 0x0c, 0x15, 0x62, 0xe6,
+	0xe7, 0x0c, 0x98, 0x49, 0xb5, 0xea, 0x83, 0x1d, 0x3d, 0x46, 0x93, 0xd7, 0x2f, 0x0c, 0xb3, 0x25,
+	0x19, 0xb0, 0xf3, 0xbe, 0x25, 0x24, 0xe3, 0xc7, 0xf0, 0xfe, 0x7d, 0x0d, 0x4c, 0xd8, 0xed, 0x76,
+	0x37, 0xe0, 0x5a, 0xc8, 0x91, 0x58, 0xdc, 0xbc, 0x1c, 0x16, 0x62, 0x0c, 0x27, 0x8e, 0xe4, 0xb4,
+	0xc4, 0x2d, 0xb8, 0x52, 0x6c, 0xe6, 0xd4, 0x0f, 0x9c, 0x63, 0x5c, 0x91, 0x60, 0x87, 0xb2, 0x18,
+	0xb1, 0x6f, 0x35, 0x00, 0xfc, 0xc0, 0xb3, 0x9d, 0x26, 0x2b, 0x94, 0xdc, 0x18, 0x1e, 0x02, 0xd9,
+	0x5a, 0x84, 0x54, 0x10, 0x8f, 0x63, 0x81, 0x46, 0x00, 0x54, 0x28, 0x93, 0x79, 0xc9, 0x84, 0x8a,
+	0x2b, 0xf3, 0x75, 0x09, 0x76, 0xfb, 0xe1, 0x0c, 0x53, 0x5a, 0x41, 0x28, 0xe6, 0x52, 0x67, 0xdf,
+	0x02, 0x13, 0x11, 0xbd, 0xfd, 0x98, 0xba, 0x29, 0x85, 0xa9, 0x9b, 0x7d, 0x0a, 0x4e, 0x26, 0xba,
+	0x7b, 0x20, 0x9e, 0xf0, 0x0f, 0x0d, 0x20, 0xfa, 0xd7, 0x1f, 0x83, 0xe4, 0xa0, 0xa9, 0x4b, 0x0e,
+	0x16, 0x06, 0x9f, 0xb2, 0x1c, 0xd1, 0xc1, 0x4f, 0x11, 0xe0, 0x61, 0x91, 0xa3, 0x30, 0xe1, 0xf2,
+	0xe6, 0x67, 0x8c, 0x4a, 0x1c, 0x0d, 0x40, 0xee, 0xdc, 0x01, 0x18, 0x95, 0x1b, 0x09, 0x5c, 0x31,
+	0xa3, 0x92, 0x84, 0x60, 0x8a, 0x2e, 0xf9, 0xa4, 0x01, 0xa7, 0x2c, 0x3d, 0x2c, 0x72, 0x38, 0x32,
+	0x85, 0x82, 0xb5, 0x25, 0x42, 0x2c, 0xc7, 0x7d, 0x49, 0x00, 0x7c, 0x4c, 0x91, 0x65, 0x2e, 0x6f,
+	0x56, 0xc7, 0x66, 0x81, 0x7d, 0xd9, 0xcb, 0x33, 0x8c, 0x1e, 0xcb, 0xa5, 0x21, 0xf3, 0x6b, 0xcb,
+	0x51, 0x39, 0x6a, 0xb5, 0xa2, 0xf8, 0xc3, 0xd5, 0xd8, 0xf2, 0x78, 0x90, 0xf8, 0xc3, 0x72, 0x0c,
+	0xe3, 0xf8, 0xc3, 0x72, 0xe8, 0x54, 0x22, 0xc4, 0x01, 0x70, 0xed, 0x46, 0x5d, 0x92, 0x1c, 0x2d,
+	0xae, 0x44, 0xb9, 0xb5, 0xbc, 0x58, 0x95, 0x14, 0x39, 0xfb, 0x10, 0xff, 0x46, 0x85, 0x02, 0x73,
+	0x48, 0x3f, 0x21, 0xcf, 0x6e, 0x49, 0x73, 0x8c, 0x4f, 0xd1, 0x7b, 0x8b, 0xae, 0x97, 0xc4, 0x9a,
+	0x9c, 0x43, 0x15, 0xb9, 0x38, 0x77, 0xa2, 0x60, 0x12, 0x1a, 0x0c, 0xf5, 0x7e, 0x70, 0x1e, 0xc0,
+	0xd7, 0xb4, 0x58, 0xb2, 0x83, 0xe3, 0xc5, 0x79, 0x80, 0x5a, 0x06, 0x3e, 0xe9, 0xa5, 0x94, 0x01,
+	0xc1, 0x4c, 0xfa, 0x8c, 0xaf, 0x3d, 0x79, 0xdf, 0x0a, 0xea, 0x5b, 0x55, 0xab, 0xbe, 0xc5, 0x95,
+	0x98, 0xc2, 0xb5, 0xb6, 0xe0, 0xba, 0xbe, 0xad, 0xa3, 0x5a, 0x38, 0xcd, 0x8c, 0x60, 0x13, 0x85,
+	0x98, 0x24, 0x48, 0x5c, 0xa6, 0xb4, 0x14, 0xb9, 0x01, 0xca, 0x50, 0x9c, 0xa5, 0x48, 0x25, 0x1a,
+	0x10, 0x2f, 0xa3, 0xf0, 0x17, 0x46, 0x44, 0x98, 0xd7, 0x9d, 0x78, 0x1b, 0xce, 0x3b, 0xae, 0xb3,
+	0xd3, 0x76, 0xbb, 0x3e, 0x8b, 0x3e, 0x4d, 0x9d, 0x20, 0x14, 0x85, 0x4f, 0xf2, 0x6b, 0x94, 0x7b,
+	0xdd, 0x2d, 0xf5, 0xaa, 0x88, 0xbd, 0xf1, 0x90, 0x67, 0x61, 0x9c, 0x2b, 0xfa, 0xd6, 0xd7, 0x57,
+	0xca, 0x53, 0x07, 0x39, 0xa3, 0x23, 0x76, 0x99, 0x7f, 0xc2, 0x92, 0xc4, 0x81, 0x11, 0x36, 0x72,
+	0x17, 0xc6, 0x5a, 0x22, 0xb9, 0x43, 0xf9, 0x44, 0xf1, 0x43, 0x31, 0x99, 0x28, 0x42, 0x3c, 0xa0,
+	0xe5, 0x0f, 0x0c, 0x29, 0x30, 0xe7, 0xc1, 0x06, 0xdd, 0xb4, 0xba, 0xad, 0xe0, 0xa6, 0x1b, 0x20,
+	0x77, 0x71, 0x8b, 0x24, 0x9e, 0xa1, 0x43, 0xf6, 0x34, 0x8f, 0xc7, 0xc7, 0x9d, 0x07, 0x17, 0xf7,
+	0xa9, 0x8b, 0xfb, 0x62, 0x23, 0x3b, 0xf0, 0x88, 0xac, 0xc3, 0x7d, 0xea, 0xea, 0x5b, 0x6c, 0x94,
+	0xd3, 0x44, 0x4f, 0x72, 0xa2, 0xcc, 0x56, 0xea, 0x91, 0xc5, 0xfd, 0xab, 0x63, 0x3f, 0x38, 0xb9,
+	0x9b, 0x12, 0x4d, 0xa8, 0x80, 0xca, 0xa7, 0x8a, 0x8f, 0x71, 0x52, 0x9d, 0x24, 0xec, 0xe9, 0x92,
+	0xa5, 0x98, 0xa2, 0x49, 0x7e, 0xd2, 0x80, 0xb2, 0x1f, 0x78, 0xdd, 0x7a, 0xd0, 0xf5, 0x68, 0x23,
+	0xb1, 0x42, 0x67, 0x2e, 0x1b, 0x45, 0x19, 0xb8, 0x5a, 0x0e, 0x4e, 0x1e, 0x1a, 0xa0, 0x9c, 0x07,
+	0xc5, 0xdc, 0xbe, 0x90, 0x1f, 0x33, 0xe0, 0xbc, 0x0e, 0x64, 0x6f, 0x7a, 0xd1, 0x4f, 0x52, 0x5c,
+	0xc9, 0x52, 0xcb, 0x46, 0x29, 0x5e, 0xf0, 0x39, 0x40, 0xcc, 0xeb, 0x48, 0x52, 0xb5, 0x7e, 0xfa,
+	0x98, 0x55, 0xeb, 0xb3, 0xef, 0x04, 0x92, 0xbe, 0x3e, 0xf6, 0xe3, 0x03, 0xc7, 0x55, 0x3e, 0xf0,
+	0xb3, 0x23, 0x70, 0x81, 0xdd, 0x4a, 0xf1, 0xeb, 0x67, 0xd5, 0x72, 0xac, 0xe6, 0x4b, 0x93, 0x63,
+	0xfa, 0x19, 0x03, 0xce, 0x6f, 0x65, 0x8b, 0x76, 0xe4, 0xfb, 0xeb, 0xdd, 0x85, 0x44, 0x70, 0xbd,
+	0xa4, 0x45, 0xe2, 0xc0, 0xee, 0x59, 0x05, 0xf3, 0x3a, 0x45, 0xde, 0x09, 0xa7, 0x98, 0x1b, 0x77,
+	0x75, 0x79, 0x11, 0x57, 0x2d, 0xff, 0x6e, 0x2d, 0xb4, 0xf4, 0x19, 0x11, 0xfb, 0xf5, 0x66, 0x02,
+	0x86, 0xa9, 0xda, 0xcc, 0xef, 0xb5, 0xe3, 0x36, 0x96, 0xee, 0x89, 0x24, 0x28, 0x83, 0xd9, 0xdc,
+	0x72, 0x6b, 0x83, 0xb5, 0x14, 0x36, 0xcc, 0xa0, 0xc0, 0x65, 0x53, 0xac, 0x33, 0xab, 0xae, 0x63,
+	0x07, 0xae, 0xc7, 0x83, 0x5d, 0x0c, 0x24, 0xa2, 0xe1, 0xb2, 0xa9, 0x9b, 0x99, 0x18, 0x31, 0x87,
+	0x92, 0xf9, 0xdf, 0x0d, 0x38, 0xc9, 0x96, 0xc5, 0x9a, 0xe7, 0x6e, 0xef, 0xbc, 0x14, 0x17, 0xe4,
+	0xe3, 0xd2, 0xe8, 0x51, 0xc8, 0x76, 0xce, 0x2a, 0x06, 0x8f, 0x13, 0xbc, 0xcf, 0xb1, 0x8d, 0xa3,
+	0x2a, 0x56, 0x1e, 0xca, 0x17, 0x2b, 0x9b, 0x9f, 0x2e, 0x89, 0x97, 0x4b, 0x28, 0xd6, 0x7d, 0x49,
+	0xee, 0xc3, 0xb7, 0xc0, 0x09, 0x56, 0xb6, 0x6a, 0x6d, 0xaf, 0x2d, 0x3e, 0xe3, 0xb6, 0x42, 0x77,
+	0x6e, 0x2e, 0x6b, 0xbf, 0xa1, 0x02, 0x50, 0xaf, 0x47, 0x9e, 0x64, 0x96, 0x81, 0x3c, 0x66, 0x9c,
+	0x7c, 0x33, 0x5f, 0x16, 0x96, 0x81, 0xbc, 0xe8, 0xc1, 0x6e, 0x65, 0x26, 0x56, 0xf1, 0xca, 0x42,
+	0x0c, 0x1b, 0x98, 0xbf, 0x74, 0x0e, 0x38, 0xf2, 0x16, 0x0d, 0x5e, 0x8a, 0x63, 0xf2, 0x7a, 0x98,
+	0xac, 0x77, 0xba, 0xd5, 0xab, 0xb5, 0x77, 0x77, 0x5d, 0x2e, 0x0b, 0xe1, 0xb9, 0x82, 0xd8, 0xe9,
+	0x5d, 0x5d, 0xdb, 0x08, 0x8b, 0x51, 0xad, 0xc3, 0x4e, 0x87, 0x7a, 0xa7, 0x2b, 0xcf, 0xdb, 0x35,
+	0xd5, 0xe1, 0x85, 0x9f, 0x0e, 0xd5, 0xb5, 0x0d, 0x0d, 0x86, 0xa9, 0xda, 0xe4, 0x23, 0x30, 0x45,
+	0xe5, 0xc6, 0xbd, 0xce, 0xd2, 0x0b, 0x89, 0x73, 0x61, 0xb9, 0xe8, 0xc7, 0x47, 0x43, 0x1b, 0x9e,
+	0x06, 0xe2, 0x05, 0xb8, 0xa4, 0x90, 0x40, 0x8d, 0x20, 0xb3, 0xbb, 0x09, 0x7f, 0xb3, 0x59, 0x76,
+	0x1b, 0xc9, 0x83, 0x62, 0x44, 0xd8, 0xdd, 0x2c, 0xe5, 0x55, 0xc2, 0xfc, 0xf6, 0xe4, 0xa7, 0x0d,
+	0x38, 0x17, 0x41, 0x6d, 0xc7, 0x6e, 0x77, 0xdb, 0x48, 0xeb, 0x2d, 0xcb, 0x6e, 0xcb, 0x77, 0xdf,
+	0xed, 0x43, 0xfb, 0x50, 0x1d, 0xbd, 0x38, 0xac, 0xb2, 0x61, 0x98, 0xd3, 0x25, 0xf2, 0x39, 0x03,
+	0x2e, 0x87, 0xa0, 0x35, 0x8f, 0xfa, 0x3e, 0xd3, 0x4a, 0x44, 0xc1, 0x04, 0xe4, 0x90, 0x8c, 0x15,
+	0x3a, 0x3b, 0x39, 0x03, 0xbc, 0xb4, 0x0f, 0x6e, 0xdc, 0x97, 0xba, 0xba, 0x5c, 0x6a, 0xee, 0x66,
+	0x50, 0x1e, 0x3f, 0xd2, 0xe5, 0xc2, 0x48, 0xa0, 0x46, 0x90, 0xfc, 0xac, 0x01, 0xe7, 0xd5, 0x02,
+	0x75, 0xb5, 0x88, 0x17, 0xe2, 0xb3, 0x87, 0xd6, 0x99, 0x04, 0x7e, 0xc1, 0xe1, 0xe5, 0x00, 0x31,
+	0xaf, 0x57, 0xec, 0xd8, 0x6e, 0xf3, 0x85, 0x29, 0x5e, 0x91, 0x23, 0xe2, 0xd8, 0x16, 0x6b, 0xd5,
+	0xc7, 0x10, 0xc6, 0xe4, 0x27, 0x1d, 0xb7, 0xb1, 0x66, 0x37, 0x7c, 0x1e, 0xcf, 0x8e, 0xbf, 0xf5,
+	0x86, 0xc4, 0x70, 0xac, 0xb9, 0x8d, 0xb5, 0xe5, 0x45, 0x51, 0x8e, 0x5a, 0x2d, 0x66, 0x01, 0xcd,
+	0xd4, 0x57, 0xb5, 0xfb, 0x56, 0xe7, 0x56, 0x18, 0x20, 0x89, 0xcb, 0x22, 0xae, 0x46, 0xa5, 0xa8,
+	0xd4, 0x60, 0xf3, 0xc7, 0xce, 0x1d, 0xa4, 0x22, 0x04, 0x79, 0x79, 0xfa, 0x90, 0xe6, 0x2f, 0x44,
+	0x28, 0x3a, 0x7c, 0x43, 0x21, 0x81, 0x1a, 0x41, 0xa6, 0x39, 0x9b, 0xf6, 0x77, 0xfc, 0x80, 0xb6,
+	0xa3, 0x3e, 0x9c, 0x3c, 0xec, 0x3e, 0x70, 0x99, 0x78, 0x4d, 0x23, 0x82, 0x09, 0xa2, 0x3c, 0xd4,
+	0x54, 0xdb, 0x6a, 0xd2, 0x6b, 0x55, 0xa6, 0x8b, 0x8c, 0xc2, 0xc3, 0xac, 0x51, 0xaf, 0xce, 0x3c,
+	0xaf, 0x4e, 0xf1, 0x99, 0x12, 0xa1, 0xa6, 0xf2, 0xab, 0x61, 0x2f, 0x1c, 0xe4, 0x39, 0x98, 0x95,
+	0xe0, 0x15, 0xf7, 0x7e, 0x8a, 0x82, 0x88, 0xe8, 0xc4, 0x2d, 0x28, 0x97, 0x73, 0x6b, 0x61, 0x0f,
+	0x0c, 0x4c, 0x4b, 0xe4, 0x53, 0x8f, 0xeb, 0x04, 0x45, 0x74, 0xd0, 0xb5, 0x6e, 0xab, 0xe5, 0x97,
+	0x49, 0xec, 0x33, 0x54, 0x4b, 0x83, 0x31, 0xab, 0x0d, 0x79, 0x2a, 0xf2, 0x2b, 0xde, 0x61, 0x05,
+	0xef, 0x5e, 0xab, 0xf1, 0x97, 0xc8, 0x88, 0x90, 0x94, 0xa0, 0x0e, 0xc2, 0x64, 0x5d, 0x76, 0x9b,
+	0x87, 0x45, 0x0b, 0x5d, 0xcf, 0x0f, 0x78, 0x9c, 0xa5, 0x11, 0x71, 0x9b, 0xa3, 0x0a, 0x40, 0xbd,
+	0x1e, 0x73, 0xd1, 0xf0, 0x69, 0xbd, 0xee, 0xb6, 0x3b, 0xf2, 0x9d, 0xcc, 0x83, 0x21, 0x8d, 0xcb,
+	0x19, 0xd4, 0x20, 0x98, 0xa8, 0x49, 0x76, 0xe0, 0x74, 0x14, 0x4e, 0x79, 0xc5, 0x6d, 0xb2, 0xb0,
+	0x58, 0x8c, 0x39, 0x3e, 0x57, 0x24, 0x46, 0x84, 0x18, 0xae, 0x6a, 0x1a, 0x1d, 0x66, 0xd1, 0x60,
+	0xb9, 0xdd, 0x12, 0xc5, 0x57, 0x6d, 0xa6, 0xc4, 0x3f, 0xcf, 0x3f, 0x9b, 0x0b, 0xbb, 0xaa, 0x19,
+	0x70, 0xcc, 0x6c, 0x45, 0x6e, 0xc1, 0xd9, 0x8e, 0xe7, 0x06, 0xb4, 0x1e, 0xdc, 0xa0, 0x9e, 0x43,
+	0x5b, 0xf2, 0x03, 0xfd, 0x72, 0x99, 0x8f, 0x05, 0xd7, 0x87, 0xae, 0x65, 0x55, 0xc0, 0xec, 0x76,
+	0xe4, 0xb3, 0x06, 0x5c, 0xf2, 0x03, 0x8f, 0x5a, 0x6d, 0xdb, 0x69, 0x56, 0x5d, 0xc7, 0xa1, 0xfc,
+	0x60, 0x5a, 0x6e, 0xc4, 0x2e, 0x77, 0x0f, 0x15, 0xba, 0x45, 0xcc, 0xbd, 0xdd, 0xca, 0xa5, 0x5a,
+	0x4f, 0xcc, 0xb8, 0x0f, 0x65, 0x66, 0xec, 0xd8, 0xa6, 0x6d, 0xd7, 0xdb, 0x61, 0x27, 0x52, 0x79,
+	0xb6, 0xf8, 0x7b, 0x77, 0x35, 0xc2, 0x22, 0xb6, 0xbf, 0xa6, 0xc9, 0x8d, 0x81, 0xa8, 0x90, 0x63,
+	0x43, 0xcd, 0xce, 0x5b, 0x19, 0xd1, 0x4c, 0xd9, 0x34, 0x17, 0xf8, 0xcc, 0xf1, 0xa1, 0x5e, 0xcd,
+	0xaa, 0x80, 0xd9, 0xed, 0x88, 0x0f, 0x33, 0x7c, 0x87, 0xca, 0x9b, 0xfc, 0x5a, 0x75, 0xbe, 0x49,
+	0xcb, 0x17, 0x0b, 0x0d, 0x2e, 0xe3, 0xfd, 0x67, 0x96, 0x93, 0xc8, 0x30, 0x8d, 0x3f, 0x26, 0x6a,
+	0x6d, 0xc7, 0x44, 0x1f, 0x1e, 0x94, 0xa8, 0xb5, 0x9d, 0x22, 0xaa, 0x14, 0x99, 0xbb, 0x25, 0x38,
+	0x9b, 0x79, 0x4b, 0xb2, 0xc3, 0x43, 0x0c, 0xf1, 0x7c, 0x98, 0x0b, 0x4e, 0xaa, 0x3d, 0xf9, 0xe1,
+	0xb1, 0xaa, 0x83, 0x30, 0x59, 0x97, 0xf1, 0xb0, 0x9c, 0xda, 0xd5, 0x5a, 0xdc, 0xbe, 0x14, 0xf3,
+	0xb0, 0xcb, 0x09, 0x18, 0xa6, 0x6a, 0x93, 0xaa, 0x1c, 0x8f, 0xab, 0xb5, 0x65, 0xf6, 0x0c, 0xf4,
+	0xaf, 0x7a, 0x34, 0x7c, 0x1d, 0xc4, 0xdf, 0xa7, 0x02, 0x31, 0x5d, 0x9f, 0x7d, 0x05, 0xfb, 0xa1,
+	0xf6, 0x62, 0x38, 0xfe, 0x8a, 0x9b, 0x3a, 0x08, 0x93, 0x75, 0xc3, 0x77, 0xba, 0xd6, 0x85, 0x91,
+	0xf8, 0x2b, 0x6e, 0x26, 0x60, 0x98, 0xaa, 0x6d, 0xfe, 0xd1, 0x30, 0x3c, 0xd2, 0x07, 0x67, 0x49,
+	0xda, 0xd9, 0xc3, 0x7d, 0xf0, 0x33, 0xaf, 0xbf, 0xe9, 0xe9, 0xe4, 0x4c, 0xcf, 0xc1, 0xe9, 0xf5,
+	0x3b, 0x9d, 0x7e, 0xde, 0x74, 0x1e, 0x9c, 0x64, 0xff, 0xd3, 0xdf, 0xce, 0x9e, 0xfe, 0x82, 0xa3,
+	0xba, 0xef, 0x72, 0xe9, 0xe4, 0x2c, 0x97, 0x82, 0xa3, 0xda, 0xc7, 0xf2, 0xfa, 0xe3, 0x61, 0x78,
+	0xb4, 0x1f, 0x2e, 0xb7, 0xe0, 0xfa, 0xca, 0x38, 0x5b, 0x8e, 0x74, 0x7d, 0xe5, 0x39, 0x84, 0x1f,
+	0xe1, 0xfa, 0xea, 0x79, 0x7c, 0x1e, 0xcd, 0xfa, 0xca, 0x1b, 0xd5, 0xa3, 0x5a, 0x5f, 0x79, 0xa3,
+	0xda, 0xc7, 0xfa, 0xfa, 0xcb, 0xe4, 0xfd, 0x10, 0xb1, 0xda, 0xcb, 0x30, 0x54, 0xef, 0x74, 0x0b,
+	0x1e, 0x52, 0xdc, 0xca, 0xb0, 0xba, 0xb6, 0x81, 0x0c, 0x07, 0x41, 0x18, 0x15, 0xeb, 0xa7, 0xe0,
+	0x11, 0xc4, 0x2d, 0x47, 0xc5, 0x92, 0x44, 0x89, 0x89, 0x0d, 0x15, 0xed, 0x6c, 0xd1, 0x36, 0xf5,
+	0xac, 0x56, 0x2d, 0x70, 0x3d, 0xab, 0x59, 0xf4, 0xb4, 0x11, 0x1a, 0x94, 0x04, 0x2e, 0x4c, 0x61,
+	0x67, 0x03, 0xd2, 0xb1, 0x1b, 0xe5, 0xe1, 0xe2, 0x03, 0xb2, 0xb6, 0xbc, 0x88, 0x0c, 0x87, 0xf9,
+	0x2b, 0xe3, 0xa0, 0x24, 0x2a, 0x60, 0xf2, 0xac, 0x99, 0x7a, 0x32, 0xa8, 0xe7, 0x20, 0xf6, 0x50,
+	0xa9, 0x08, 0xa1, 0x62, 0xc9, 0xa7, 0x8a, 0x31, 0x4d, 0x96, 0x7c, 0xd4, 0x10, 0x42, 0xbe, 0x48,
+	0x9b, 0x27, 0x87, 0xf5, 0xda, 0x21, 0xe9, 0xbd, 0x63, 0x69, 0x61, 0x04, 0x40, 0x9d, 0x20, 0x93,
+	0xa8, 0x9c, 0xbd, 0x9b, 0xa5, 0x9b, 0x28, 0x0f, 0x17, 0x8f, 0xf0, 0xd0, 0x43, 0xd9, 0x21, 0x38,
+	0xc8, 0xcc, 0x0a, 0x98, 0xdd, 0x91, 0x68, 0x94, 0x22, 0x71, 0x6d, 0x79, 0x64, 0xb0, 0x51, 0x4a,
+	0xc8, 0x7d, 0xe3, 0x51, 0x8a, 0x00, 0xa8, 0x13, 0x64, 0x0e, 0xec, 0x77, 0x43, 0x19, 0x79, 0x79,
+	0xb4, 0xb8, 0x9a, 0x3d, 0x21, 0x68, 0x17, 0xf6, 0x5e, 0x51, 0x21, 0xc6, 0x44, 0xc8, 0x16, 0x8c,
+	0xdd, 0x15, 0x67, 0x45, 0x79, 0xac, 0xb8, 0x9d, 0xb6, 0x76, 0xdc, 0x08, 0xb1, 0x8a, 0x2c, 0xc2,
+	0x10, 0xbd, 0xea, 0x4b, 0x30, 0xbe, 0x8f, 0x8b, 0xdb, 0x67, 0x0d, 0x38, 0x7b, 0x8f, 0x7a, 0x81,
+	0x5d, 0x4f, 0x6a, 0x86, 0x26, 0x8a, 0x4b, 0x28, 0x9e, 0xc9, 0x42, 0x28, 0x96, 0x49, 0x26, 0x08,
+	0xb3, 0xbb, 0x40, 0x9e, 0x81, 0x61, 0x1a, 0xd4, 0x1b, 0x32, 0x98, 0xf7, 0x5b, 0x8b, 0xfa, 0xfc,
+	0x0a, 0xbf, 0x17, 0xf6, 0x1f, 0x72, 0x7c, 0xe6, 0x9f, 0x19, 0x90, 0x92, 0x4d, 0x93, 0xef, 0x32,
+	0x60, 0x6a, 0x93, 0x5a, 0x41, 0xd7, 0xa3, 0xd7, 0xa4, 0x4d, 0x28, 0x33, 0x58, 0x79, 0xe6, 0x30,
+	0x44, 0xe2, 0x73, 0x57, 0x15, 0xc4, 0xc2, 0x58, 0x25, 0x4a, 0x3e, 0xa2, 0x82, 0x50, 0xeb, 0xc1,
+	0xec, 0xd3, 0x30, 0x93, 0x6a, 0x78, 0x20, 0x35, 0xe5, 0x3f, 0x33, 0x20, 0x2b, 0xcf, 0x3d, 0x79,
+	0x0e, 0x46, 0x2c, 0x96, 0x71, 0x5f, 0x9e, 0x92, 0x6f, 0x2b, 0x66, 0x37, 0xd5, 0x50, 0x43, 0x0d,
+	0xf1, 0x9f, 0x28, 0xd0, 0xb2, 0xd8, 0xe8, 0x96, 0xa6, 0x97, 0x5e, 0x8d, 0x63, 0x5c, 0x70, 0x75,
+	0xda, 0x7c, 0x0a, 0x8a, 0x19, 0x2d, 0xcc, 0x4f, 0x18, 0x40, 0xd2, 0xe9, 0x6a, 0x88, 0x07, 0xe3,
+	0x72, 0xfd, 0x86, 0xb3, 0xb4, 0x58, 0xd0, 0x9b, 0x4e, 0x73, 0x0d, 0x8d, 0x8d, 0xf0, 0x64, 0x81,
+	0x8f, 0x11, 0x1d, 0x16, 0x6f, 0x2d, 0x4e, 0x89, 0x48, 0xde, 0x04, 0x93, 0x0d, 0xea, 0xd7, 0x3d,
+	0xbb, 0x13, 0xc4, 0x8e, 0xa4, 0x91, 0x43, 0xda, 0x62, 0x0c, 0x42, 0xb5, 0x1e, 0x8b, 0xac, 0x11,
+	0x58, 0xfe, 0xdd, 0xe5, 0x45, 0xf9, 0xd8, 0xe3, 0x57, 0xf3, 0x3a, 0x2f, 0x41, 0x09, 0x89, 0x63,
+	0xcf, 0x0e, 0xf5, 0x11, 0x7b, 0x96, 0xb9, 0xa8, 0x0e, 0x1c, 0x68, 0x97, 0xec, 0x1f, 0x64, 0xd7,
+	0xfc, 0x89, 0x12, 0x9c, 0x64, 0x55, 0x98, 0x4f, 0x7e, 0x40, 0x1d, 0xee, 0x36, 0x55, 0x70, 0x10,
+	0x9a, 0x70, 0x22, 0xd0, 0xbc, 0x9e, 0x0f, 0xee, 0x54, 0x1b, 0x59, 0x7a, 0xe9, 0xbe, 0xce, 0x3a,
+	0x5e, 0xf2, 0xb6, 0xd0, 0x6f, 0x4d, 0x3c, 0x8b, 0x1f, 0x09, 0x97, 0x2a, 0x77, 0x46, 0x7b, 0x20,
+	0x5d, 0xc8, 0xa3, 0x3c, 0x9a, 0x9a, 0x8b, 0xda, 0x5b, 0xe0, 0x84, 0xf4, 0x90, 0x10, 0x41, 0x84,
+	0xe5, 0xb3, 0x98, 0x5f, 0x2b, 0x57, 0x55, 0x00, 0xea, 0xf5, 0xcc, 0xdf, 0x2d, 0x81, 0x9e, 0xad,
+	0xb3, 0xe8, 0x28, 0xa5, 0x23, 0x28, 0x97, 0x8e, 0x2c, 0x82, 0xb2, 0xc8, 0x57, 0xcf, 0xcd, 0xb9,
+	0xa5, 0x9e, 0x5d, 0xcd, 0x55, 0xcd, 0xcb, 0x31, 0xaa, 0x11, 0x0f, 0xeb, 0xf0, 0x81, 0x87, 0xf5,
+	0x4d, 0xd2, 0xf2, 0x77, 0x44, 0x8b, 0x63, 0x1d, 0x5a, 0xfe, 0xce, 0x68, 0x0d, 0x15, 0x2f, 0xbb,
+	0x79, 0x90, 0xf9, 0x6e, 0xd8, 0xbc, 0xc8, 0x18, 0xdb, 0xfe, 0xba, 0x1b, 0x58, 0xad, 0xb2, 0x11,
+	0x0b, 0x5d, 0x57, 0x55, 0x00, 0xea, 0xf5, 0xcc, 0x9b, 0xf0, 0xca, 0x15, 0xd7, 0x6a, 0x2c, 0x58,
+	0x2d, 0xb6, 0x74, 0x3d, 0x69, 0x96, 0xe7, 0xf3, 0x9b, 0x99, 0xc9, 0x19, 0xdd, 0xba, 0xdb, 0x62,
+	0xf7, 0xa6, 0x15, 0x05, 0x1b, 0xe6, 0x8a, 0xc8, 0xf0, 0xde, 0x94, 0xc1, 0x7a, 0x31, 0x84, 0x9b,
+	0xbf, 0x69, 0xc0, 0x98, 0xcc, 0x81, 0xd3, 0x87, 0x63, 0x29, 0xf3, 0xfd, 0xe5, 0x99, 0x43, 0x07,
+	0xe0, 0x4a, 0x6b, 0x5b, 0xae, 0x1b, 0x68, 0xc9, 0xb3, 0x44, 0xfa, 0x39, 0xf6, 0x2f, 0x0a, 0xf4,
+	0xdc, 0x1e, 0xd5, 0xab, 0x6f, 0xd9, 0x01, 0xe5, 0x66, 0x37, 0x72, 0xe1, 0x0b, 0x7b, 0x54, 0xa5,
+	0x1c, 0xb5, 0x5a, 0xe6, 0x7f, 0x1b, 0x81, 0xcb, 0x12, 0x71, 0x8a, 0x55, 0x8b, 0xce, 0xdc, 0x9d,
+	0x28, 0xc1, 0x10, 0x4f, 0x90, 0x10, 0xca, 0x54, 0x8b, 0xbd, 0x92, 0xcf, 0x2b, 0x29, 0x84, 0x54,
+	0x74, 0x98, 0x45, 0x43, 0x84, 0x6f, 0xe7, 0xc5, 0xd7, 0xa9, 0xd5, 0x0a, 0xb6, 0x42, 0xda, 0xa5,
+	0x41, 0xc2, 0xb7, 0xa7, 0xf1, 0x61, 0x26, 0x15, 0x6e, 0xd2, 0x21, 0x01, 0x55, 0x8f, 0x5a, 0xaa,
+	0x3d, 0xc9, 0x00, 0xee, 0x46, 0xab, 0x99, 0x18, 0x31, 0x87, 0x12, 0x17, 0x37, 0x5a, 0xdb, 0x5c,
+	0x7a, 0x81, 0x34, 0xf0, 0x6c, 0x9e, 0x04, 0x2d, 0xd2, 0x55, 0xac, 0xea, 0x20, 0x4c, 0xd6, 0x65,
+	0x2a, 0x07, 0x6e, 0x22, 0x13, 0xc7, 0x03, 0x1d, 0x89, 0xa3, 0x42, 0xdd, 0xd4, 0x20, 0x98, 0xa8,
+	0x49, 0xbe, 0xc9, 0x80, 0x33, 0xb6, 0xea, 0x4c, 0x13, 0x7e, 0xfd, 0xe8, 0x20, 0x79, 0x25, 0xc4,
+	0x32, 0xce, 0x40, 0x8b, 0x99, 0xc4, 0x98, 0xf6, 0x41, 0x7a, 0x44, 0xeb, 0x6b, 0x40, 0x04, 0x8b,
+	0xe3, 0x73, 0xba, 0x98, 0x01, 0xc7, 0xcc, 0x56, 0xe6, 0xc7, 0x4a, 0x30, 0x75, 0xc0, 0x84, 0xb6,
+	0x5d, 0x85, 0xe7, 0x18, 0xc0, 0x6f, 0x51, 0xa5, 0xda, 0x07, 0xdb, 0x41, 0x9e, 0x85, 0xe9, 0x2e,
+	0x1f, 0x88, 0x30, 0x94, 0x9a, 0xdc, 0xd3, 0x5f, 0xcb, 0x66, 0x6e, 0x43, 0x83, 0xb0, 0x18, 0x9f,
+	0x2a, 0x7a, 0x1d, 0x8a, 0x09, 0x3c, 0xe6, 0xaf, 0x96, 0x80, 0xa8, 0xd5, 0xaf, 0xb6, 0xac, 0x7b,
+	0xae, 0xc7, 0x0c, 0x08, 0xa7, 0xea, 0x6a, 0x0a, 0x31, 0xc1, 0x60, 0x3d, 0x3b, 0xe8, 0xc7, 0x0a,
+	0xf4, 0x73, 0x6a, 0x6e, 0x30, 0xc1, 0x08, 0x87, 0xee, 0xd6, 0x53, 0x2a, 0xe8, 0x41, 0xe2, 0x37,
+	0x6a, 0x7d, 0x9a, 0xfd, 0x16, 0x03, 0x66, 0x52, 0x98, 0x32, 0x38, 0xe3, 0xf7, 0xea, 0x41, 0xd4,
+	0x0f, 0x25, 0x59, 0xa8, 0xca, 0x5f, 0xff, 0xc8, 0x08, 0x9c, 0xce, 0x98, 0x51, 0x6e, 0x62, 0x43,
+	0x13, 0xdc, 0xe5, 0x20, 0x26, 0x36, 0x29, 0x4e, 0x35, 0x32, 0xb1, 0x49, 0x42, 0x30, 0x45, 0x97,
+	0x3c, 0x03, 0x43, 0x75, 0xcf, 0x96, 0x8b, 0xf6, 0x2d, 0x85, 0x86, 0x00, 0x97, 0x17, 0x26, 0x25,
+	0x45, 0x96, 0x7d, 0x16, 0x19, 0x42, 0x76, 0x17, 0xab, 0xd7, 0x48, 0xc8, 0xb0, 0xf2, 0xbb, 0x58,
+	0xbd, 0x6d, 0x7c, 0xd4, 0xeb, 0x91, 0x67, 0xa1, 0x2c, 0x5f, 0xaa, 0xb2, 0x8b, 0x55, 0xd7, 0xf1,
+	0x03, 0x76, 0xe2, 0x07, 0x92, 0xa7, 0xe0, 0xd6, 0xaf, 0x37, 0x72, 0xea, 0x60, 0x6e, 0x6b, 0xf2,
+	0x61, 0x98, 0xd6, 0x4e, 0x8f, 0x30, 0xac, 0x59, 0x41, 0x97, 0x19, 0x15, 0x93, 0x38, 0x2b, 0xf5,
+	0x32, 0x4c, 0x50, 0x23, 0x7f, 0x8b, 0x89, 0xa2, 0xa2, 0xf5, 0x22, 0x96, 0x7c, 0x98, 0xcd, 0xf1,
+	0xea, 0xe1, 0xec, 0x20, 0x25, 0xd5, 0x6e, 0x92, 0x10, 0xa6, 0x69, 0x9b, 0xdf, 0x3b, 0x0a, 0x6a,
+	0x76, 0x6f, 0xb2, 0x3a, 0x88, 0x5c, 0x32, 0x5e, 0x03, 0xa1, 0x6c, 0x72, 0x15, 0x86, 0x9a, 0x9d,
+	0x6e, 0xb9, 0x34, 0x18, 0xba, 0x6b, 0x0c, 0x5d, 0xb3, 0xd3, 0x25, 0xcf, 0x44, 0xa2, 0xce, 0x62,
+	0xc2, 0xc8, 0xc8, 0x55, 0x32, 0x21, 0xee, 0x0c, 0x8f, 0xf7, 0xe1, 0xdc, 0xe3, 0xbd, 0x0d, 0x63,
+	0xbe, 0x94, 0x83, 0x8e, 0x14, 0x8f, 0x43, 0xa9, 0x8c, 0xb4, 0x94, 0x7b, 0x0a, 0x09, 0x8d, 0xfc,
+	0x81, 0x21, 0x0d, 0xf6, 0x10, 0xec, 0xf2, 0x7b, 0x49, 0x46, 0x59, 0xe2, 0x0f, 0xc1, 0x0d, 0x5e,
+	0x82, 0x12, 0x92, 0x62, 0xe6, 0xc6, 0xfa, 0x61, 0xe6, 0xc8, 0x0f, 0x26, 0xcf, 0xef, 0xf1, 0xcb,
+	0x43, 0x45, 0x2d, 0x7c, 0x95, 0xcf, 0xf9, 0xff, 0xec, 0xe0, 0xfe, 0x9b, 0xf1, 0xe5, 0xa7, 0x4c,
+	0x16, 0x79, 0x04, 0x46, 0x78, 0x14, 0x25, 0xc9, 0x07, 0x44, 0xc2, 0x0d, 0x1e, 0x47, 0x07, 0x05,
+	0x8c, 0xd4, 0x64, 0xec, 0xc1, 0x62, 0x8b, 0x9e, 0xdb, 0x36, 0x4a, 0x7a, 0x4a, 0xa0, 0xc2, 0xcb,
+	0x9a, 0x4f, 0x64, 0xd6, 0x1b, 0x62, 0x83, 0xc5, 0x61, 0x75, 0x58, 0x93, 0x82, 0x42, 0x74, 0x61,
+	0x82, 0x25, 0x50, 0x60, 0x88, 0x8b, 0x65, 0x07, 0x99, 0x54, 0x1f, 0xf5, 0x3b, 0x22, 0x92, 0xae,
+	0xf4, 0xbe, 0x36, 0x8a, 0x0b, 0x01, 0x15, 0xa4, 0xf3, 0x11, 0x42, 0x61, 0xa8, 0x10, 0xff, 0x46,
+	0x85, 0x18, 0x23, 0x1d, 0xd8, 0x6d, 0x7a, 0xdb, 0x76, 0x1a, 0xee, 0xfd, 0x72, 0xe9, 0x50, 0x48,
+	0xaf, 0x47, 0x08, 0x05, 0xe9, 0xf8, 0x37, 0x2a, 0xc4, 0xd8, 0x95, 0xc4, 0xb3, 0x79, 0x38, 0x3c,
+	0x2b, 0xb3, 0xec, 0x9b, 0xc8, 0xb9, 0x2e, 0xed, 0x8e, 0xf9, 0x95, 0x54, 0xcd, 0xa9, 0x83, 0xb9,
+	0xad, 0xcd, 0x9f, 0x36, 0xe0, 0x6c, 0xe6, 0x50, 0x90, 0x6b, 0x30, 0x13, 0x9b, 0xc3, 0xaa, 0x4c,
+	0xc2, 0x78, 0x7c, 0xc6, 0xdf, 0x48, 0x56, 0xc0, 0x74, 0x1b, 0x91, 0xeb, 0x2e, 0xc5, 0x84, 0x48,
+	0x5b, 0x5a, 0xf5, 0xa9, 0xa5, 0x82, 0x31, 0xab, 0x8d, 0xf9, 0xb3, 0x7a, 0x6f, 0xe3, 0xd1, 0x62,
+	0x5b, 0xe3, 0x0e, 0x6d, 0xda, 0x4e, 0x72, 0x6b, 0x2c, 0xb0, 0x42, 0x14, 0x30, 0xf2, 0xb0, 0x1a,
+	0x2b, 0x23, 0x3a, 0xde, 0x0f, 0x1e, 0x2f, 0x83, 0xd5, 0xbc, 0x4f, 0xe9, 0xdd, 0x86, 0xb5, 0x23,
+	0xc2, 0xc6, 0xc8, 0x9a, 0xb7, 0x65, 0x19, 0x46, 0x50, 0x26, 0xe2, 0x3c, 0xbf, 0x6a, 0x39, 0x5d,
+	0xab, 0x25, 0x3c, 0xea, 0xd7, 0x5c, 0xb7, 0x25, 0xc7, 0xbe, 0x57, 0x42, 0x7f, 0xe3, 0xa5, 0x92,
+	0xd0, 0xff, 0xfd, 0x70, 0x3e, 0xc7, 0xa8, 0x87, 0x2c, 0xc2, 0x94, 0x7f, 0xdf, 0xea, 0x2c, 0xd0,
+	0x2d, 0xeb, 0x9e, 0x2d, 0xa3, 0x75, 0x09, 0xdb, 0xef, 0xa9, 0x9a, 0x52, 0xfe, 0x20, 0xf1, 0x1b,
+	0xb5, 0x56, 0x66, 0x00, 0x20, 0x7d, 0x04, 0x98, 0xfb, 0xd8, 0x26, 0x8c, 0x5b, 0x2d, 0xea, 0x05,
+	0x71, 0x48, 0xcb, 0xaf, 0x2b, 0x24, 0xfc, 0x95, 0x38, 0xc4, 0xb4, 0x84, 0xbf, 0x30, 0xc2, 0x6d,
+	0xfe, 0x5d, 0x03, 0xce, 0x65, 0xc7, 0x67, 0xea, 0xe3, 0xad, 0xd5, 0x86, 0x49, 0x2f, 0x6e, 0x26,
+	0x4f, 0x82, 0x37, 0x2b, 0xc7, 0xdd, 0x9c, 0xe2, 0xcd, 0xc3, 0x5e, 0x97, 0x55, 0xcf, 0xf5, 0xc3,
+	0xed, 0x90, 0x4c, 0xa5, 0x11, 0x89, 0xda, 0x94, 0x9e, 0xa0, 0x8a, 0x9f, 0xa7, 0xb5, 0x61, 0xd4,
+	0xfd, 0x8e, 0x55, 0xa7, 0x8d, 0x63, 0x4e, 0xda, 0x7f, 0x08, 0xb9, 0x24, 0xb2, 0xfb, 0x7e, 0xb4,
+	0x69, 0x6d, 0x72, 0x68, 0xee, 0x9f, 0xd6, 0x26, 0xbb, 0xe1, 0xcb, 0x24, 0xdf, 0x42, 0x76, 0xe7,
+	0x73, 0xbc, 0xe9, 0xff, 0xeb, 0x68, 0xde, 0xd7, 0x1e, 0x30, 0xf3, 0xff, 0xbd, 0x23, 0xcc, 0xfc,
+	0x3f, 0xfd, 0xd5, 0xac, 0xff, 0x19, 0x59, 0xff, 0x13, 0x99, 0xe8, 0x47, 0x8f, 0x29, 0x13, 0xfd,
+	0xf3, 0x30, 0xda, 0xb1, 0x3c, 0x66, 0x28, 0x3d, 0x56, 0x9c, 0xf9, 0x51, 0x17, 0x5a, 0x7c, 0x0a,
+	0x46, 0x5b, 0x72, 0x8d, 0x13, 0x40, 0x49, 0x28, 0x23, 0x22, 0xcb, 0xf8, 0x51, 0x85, 0x3c, 0x8c,
+	0x73, 0xe0, 0x4f, 0x1c, 0x45, 0x0e, 0x7c, 0x96, 0x5a, 0xe0, 0x62, 0xaf, 0x63, 0x89, 0x4b, 0x65,
+	0xea, 0x89, 0x6d, 0x38, 0x88, 0x54, 0x26, 0x75, 0xda, 0x46, 0x52, 0x99, 0x24, 0x04, 0x53, 0x74,
+	0xc9, 0xbb, 0x80, 0xb8, 0x77, 0x84, 0xb1, 0xd0, 0x35, 0x46, 0x43, 0x70, 0x45, 0x25, 0xee, 0x00,
+	0x11, 0x65, 0xdb, 0xbc, 0x95, 0xaa, 0x81, 0x19, 0xad, 0xcc, 0x5f, 0x2a, 0x01, 0xdc, 0xa4, 0x01,
+	0x4b, 0x8c, 0xc1, 0xee, 0xf8, 0x8b, 0x9a, 0x3e, 0x62, 0xfc, 0x2b, 0x17, 0xe4, 0xf2, 0x22, 0x0c,
+	0x77, 0xdc, 0x86, 0xb8, 0x67, 0x64, 0x47, 0xb8, 0xff, 0x07, 0x2f, 0x65, 0x91, 0xd7, 0xb8, 0x25,
+	0x95, 0x7c, 0x95, 0x73, 0x6d, 0x06, 0xd7, 0xd8, 0xa0, 0x28, 0x67, 0x27, 0xa4, 0x0c, 0x94, 0xe0,
+	0x97, 0x47, 0xe2, 0x13, 0x32, 0xd4, 0xdd, 0x60, 0x04, 0x25, 0x4f, 0x02, 0xd8, 0x9d, 0xab, 0x56,
+	0xdb, 0x6e, 0xd9, 0x72, 0xbb, 0x4e, 0x70, 0x31, 0x3b, 0x2c, 0xaf, 0x85, 0xa5, 0x0f, 0x76, 0x2b,
+	0xe3, 0xf2, 0xd7, 0x0e, 0x2a, 0xb5, 0x59, 0x20, 0xbb, 0x53, 0xf1, 0xe0, 0xc9, 0xa5, 0x12, 0xf6,
+	0x5c, 0x44, 0x18, 0xce, 0xed, 0xb9, 0x48, 0x26, 0xd0, 0xbb, 0xe7, 0x43, 0x97, 0x87, 0x7a, 0xf4,
+	0xfc, 0xf5, 0x30, 0x49, 0x45, 0x1c, 0xa5, 0xe5, 0x45, 0x0c, 0x79, 0x5d, 0xfe, 0x46, 0x5c, 0x8a,
+	0x8b, 0x51, 0xad, 0x63, 0xfe, 0xd5, 0x10, 0x4c, 0xdd, 0x6c, 0xda, 0xce, 0x76, 0x18, 0x30, 0x2a,
+	0xd2, 0xe6, 0x1b, 0x47, 0xa3, 0xcd, 0x7f, 0x16, 0xca, 0x2d, 0x55, 0x77, 0x26, 0x18, 0x27, 0xcb,
+	0x69, 0x46, 0x23, 0xc0, 0x1f, 0x47, 0x2b, 0x39, 0x75, 0x30, 0xb7, 0x35, 0x09, 0x60, 0xb4, 0x1e,
+	0x66, 0x7d, 0x2c, 0x1c, 0x04, 0x49, 0x1d, 0x8b, 0x39, 0x35, 0x1e, 0x48, 0x74, 0xe6, 0xc9, 0xe5,
+	0x29, 0x69, 0x31, 0x8d, 0xce, 0x59, 0xba, 0x2d, 0xe2, 0xe1, 0xac, 0x7b, 0xd6, 0xe6, 0xa6, 0x5d,
+	0x97, 0x6e, 0x84, 0x62, 0x25, 0xae, 0x30, 0x43, 0x95, 0xa5, 0xac, 0x0a, 0x0f, 0x76, 0x2b, 0x57,
+	0x32, 0xc3, 0x13, 0xf1, 0xd9, 0xcc, 0x6c, 0x82, 0xd9, 0xa4, 0x58, 0x60, 0xca, 0x03, 0x38, 0x9f,
+	0x6b, 0x41, 0x88, 0x7e, 0xb9, 0x04, 0x53, 0x6c, 0xb9, 0xb1, 0x77, 0x53, 0x8b, 0x25, 0xec, 0x78,
+	0x3c, 0x19, 0x7b, 0x31, 0xd2, 0x5b, 0xa6, 0xe2, 0x2f, 0xae, 0xc0, 0x99, 0x4d, 0xd7, 0xab, 0xd3,
+	0xf5, 0xea, 0xda, 0xba, 0x2b, 0x2d, 0xda, 0x16, 0x6f, 0xd6, 0xca, 0xa5, 0x58, 0x8f, 0x72, 0x35,
+	0x03, 0x8e, 0x99, 0xad, 0x98, 0x6b, 0x41, 0x5c, 0xbe, 0xd1, 0x11, 0x5e, 0x10, 0x0c, 0xdd, 0x50,
+	0xec, 0xc5, 0x71, 0x35, 0xab, 0x02, 0x66, 0xb7, 0x63, 0x1e, 0x4a, 0x52, 0x61, 0x73, 0xd5, 0xf5,
+	0xee, 0x5b, 0x5e, 0x43, 0x47, 0x3b, 0x1c, 0x27, 0xc3, 0x5f, 0xcc, 0xaf, 0x86, 0xbd, 0x70, 0x30,
+	0x43, 0x0e, 0x3d, 0xb2, 0x25, 0x8b, 0xf0, 0xe8, 0xc9, 0xa4, 0x82, 0x32, 0xc2, 0x23, 0x7b, 0x22,
+	0xb0, 0x32, 0xe6, 0x6a, 0xe6, 0x45, 0x15, 0xe5, 0xbb, 0x96, 0xb3, 0x4c, 0x71, 0x73, 0x04, 0x4f,
+	0x43, 0x15, 0x58, 0xcd, 0xf2, 0x50, 0x8c, 0x6a, 0xdd, 0x6a, 0x22, 0x2b, 0xe3, 0x59, 0x55, 0xec,
+	0x26, 0xf5, 0x43, 0x19, 0xb7, 0xc8, 0xaa, 0xc2, 0x4b, 0x50, 0x42, 0x88, 0x05, 0x27, 0x3a, 0xdd,
+	0x96, 0x0c, 0xd2, 0xc4, 0x9e, 0x3e, 0x42, 0x16, 0xf9, 0x58, 0x56, 0xca, 0x40, 0x3e, 0xfb, 0x99,
+	0x79, 0x03, 0xd7, 0x54, 0x14, 0xa8, 0x63, 0x34, 0x7f, 0x68, 0x14, 0x94, 0x98, 0x3d, 0x07, 0xe0,
+	0x42, 0x7f, 0xdc, 0x60, 0x49, 0x92, 0x6c, 0xea, 0x04, 0x89, 0xf0, 0x17, 0xe2, 0xfa, 0xd8, 0x28,
+	0x14, 0x4c, 0xa8, 0x43, 0x9d, 0xe5, 0x45, 0xe9, 0x33, 0x53, 0xcd, 0x40, 0x2e, 0xfd, 0x8a, 0x32,
+	0x20, 0x98, 0xd9, 0x19, 0xfe, 0x3d, 0xbc, 0x7c, 0x79, 0x51, 0x15, 0x31, 0x54, 0x65, 0x19, 0x46,
+	0x50, 0x76, 0xf2, 0x36, 0x3d, 0xb7, 0xdb, 0xf1, 0xab, 0xdc, 0x35, 0x56, 0x4c, 0x0a, 0x3f, 0x79,
+	0xaf, 0xc5, 0xc5, 0xa8, 0xd6, 0x61, 0x12, 0x59, 0xf1, 0x73, 0xcd, 0xa3, 0x9b, 0xf6, 0x76, 0x79,
+	0x24, 0x96, 0xc8, 0x5e, 0x53, 0xca, 0x51, 0xab, 0xc5, 0x83, 0xc2, 0xf9, 0x7e, 0x97, 0x7a, 0x1b,
+	0xb8, 0x22, 0x33, 0x4f, 0x8b, 0xa0, 0x70, 0x61, 0x21, 0xc6, 0x70, 0xf2, 0x3d, 0x06, 0x4c, 0xb3,
+	0xd8, 0x38, 0xb6, 0xc7, 0x58, 0x18, 0xcb, 0x6e, 0xfb, 0xe5, 0xb1, 0xe2, 0x81, 0xda, 0xe2, 0x89,
+	0x9e, 0x43, 0x0d, 0xa9, 0x38, 0x20, 0x23, 0xfb, 0x10, 0x1d, 0x88, 0x89, 0x1e, 0xb0, 0xa1, 0xf2,
+	0xed, 0xa6, 0x63, 0x3b, 0xcd, 0xf9, 0x56, 0xd3, 0x97, 0xe9, 0xb5, 0x84, 0x20, 0x33, 0x2e, 0x46,
+	0xb5, 0x0e, 0x53, 0x0e, 0x75, 0x7d, 0x76, 0xec, 0xb5, 0xa9, 0x18, 0xdf, 0x89, 0xd8, 0x80, 0x66,
+	0x43, 0x05, 0xa0, 0x5e, 0x8f, 0xa9, 0xaa, 0xc3, 0x02, 0x39, 0xca, 0xc0, 0x5b, 0x72, 0x7e, 0x63,
+	0x43, 0x83, 0x60, 0xa2, 0xe6, 0xec, 0x3c, 0x9c, 0xce, 0xf8, 0xcc, 0x03, 0x9d, 0xad, 0xff, 0xd7,
+	0x80, 0xb3, 0x82, 0xeb, 0x0a, 0x73, 0x56, 0x87, 0x19, 0x37, 0xb2, 0x93, 0x57, 0x18, 0x47, 0x9a,
+	0xbc, 0xe2, 0x2b, 0x90, 0xa4, 0xc3, 0xfc, 0xa9, 0x12, 0xbc, 0x72, 0xdf, 0x7d, 0x49, 0x7e, 0xd8,
+	0x80, 0x49, 0xba, 0x1d, 0x78, 0x56, 0x14, 0x3f, 0x80, 0x2d, 0xd2, 0xcd, 0x23, 0x39, 0x04, 0xe6,
+	0x96, 0x62, 0x42, 0x62, 0xe1, 0x46, 0x4f, 0x29, 0x05, 0x82, 0x6a, 0x7f, 0xd8, 0x69, 0x2b, 0x72,
+	0x20, 0xa9, 0x96, 0x76, 0xf2, 0x14, 0x94, 0x90, 0xd9, 0x77, 0xb0, 0xec, 0x10, 0x3a, 0xe6, 0x03,
+	0xad, 0x95, 0x9f, 0x34, 0x20, 0x33, 0xc6, 0x27, 0xf3, 0xcd, 0x62, 0x02, 0x2a, 0x4d, 0x59, 0x28,
+	0x59, 0x49, 0x6e, 0x49, 0x3e, 0x9f, 0x04, 0x62, 0xba, 0xbe, 0x90, 0xea, 0x32, 0xb9, 0xa6, 0x8e,
+	0x46, 0x30, 0x5c, 0x52, 0xaa, 0x9b, 0x02, 0x63, 0x56, 0x1b, 0xf3, 0x1f, 0x18, 0x70, 0x36, 0x53,
+	0x2c, 0xd9, 0x87, 0x2c, 0x2e, 0x7b, 0xd9, 0x97, 0x8e, 0x72, 0xd9, 0x9b, 0xbf, 0x58, 0x02, 0x16,
+	0xdd, 0x82, 0x5d, 0x6d, 0xc7, 0x20, 0x81, 0xb3, 0x34, 0x09, 0x5c, 0x21, 0xf9, 0x82, 0xec, 0x6c,
+	0xae, 0xc8, 0xcd, 0x4e, 0x88, 0xdc, 0xe6, 0x07, 0x21, 0xd2, 0x5b, 0xc6, 0xf6, 0xdb, 0x06, 0x4c,
+	0xca, 0x9a, 0xc7, 0x20, 0x54, 0xfb, 0x46, 0x5d, 0xa8, 0xf6, 0xf6, 0x01, 0xbe, 0x2b, 0x47, 0x8a,
+	0xf6, 0x59, 0x03, 0x4e, 0xc8, 0x1a, 0xab, 0xb4, 0x7d, 0x87, 0x7a, 0xe4, 0x2a, 0x8c, 0xf9, 0x5d,
+	0x3e, 0x91, 0xf2, 0x83, 0x2e, 0x28, 0x1f, 0x34, 0xe7, 0xdd, 0xb1, 0xea, 0xac, 0xfb, 0x35, 0x51,
+	0x45, 0xc9, 0xcf, 0x2c, 0x0a, 0x30, 0x6c, 0xcc, 0xd6, 0xbe, 0xe7, 0xb6, 0x52, 0xb1, 0xf0, 0xd1,
+	0x6d, 0x51, 0xe4, 0x10, 0xf6, 0xce, 0x63, 0x7f, 0xc3, 0x37, 0x1c, 0x7f, 0xe7, 0x31, 0xb0, 0x8f,
+	0xa2, 0xdc, 0xfc, 0x99, 0x91, 0x68, 0xb0, 0xf9, 0xa3, 0xfe, 0x3a, 0x4c, 0xd4, 0x3d, 0x6a, 0x05,
+	0xb4, 0xb1, 0xb0, 0xd3, 0x4f, 0xe7, 0x38, 0x1f, 0x50, 0x0d, 0x5b, 0x60, 0xdc, 0x98, 0x5d, 0xb9,
+	0xaa, 0xd5, 0x68, 0x29, 0xe6, 0x4e, 0x72, 0x2d, 0x46, 0xbf, 0x0e, 0x46, 0xdc, 0xfb, 0x4e, 0xe4,
+	0x71, 0xd2, 0x93, 0x30, 0xff, 0x94, 0x5b, 0xac, 0x36, 0x8a, 0x46, 0x6a, 0x2e, 0x88, 0xe1, 0x1e,
+	0xb9, 0x20, 0x5a, 0x30, 0xd6, 0xe6, 0xd3, 0x30, 0x50, 0xba, 0x5e, 0x6d, 0x42, 0xe3, 0x29, 0x12,
+	0xbf, 0x59, 0x7c, 0x08, 0xf1, 0x0f, 0x63, 0x9d, 0x9c, 0x50, 0xa2, 0xa3, 0xb2, 0x4e, 0x91, 0x98,
+	0x07, 0x63, 0x38, 0x4b, 0x27, 0xa9, 0x26, 0x19, 0x19, 0x2b, 0x2e, 0x27, 0x95, 0xdd, 0x53, 0xf2,
+	0x8a, 0x88, 0xa1, 0xcf, 0x4b, 0x34, 0xc2, 0xc2, 0xc3, 0x9d, 0x6f, 0x64, 0xa7, 0x81, 0x93, 0xfa,
+	0xf7, 0x42, 0xde, 0xde, 0x39, 0x99, 0xe5, 0x16, 0x2a, 0x72, 0xc0, 0xf2, 0x52, 0xcf, 0x61, 0x5e,
+	0x67, 0xcc, 0x6f, 0x1b, 0x8e, 0x76, 0x93, 0x94, 0x74, 0x64, 0xcb, 0xa1, 0x8c, 0x22, 0x72, 0x28,
+	0xf2, 0x86, 0x30, 0x29, 0x99, 0x58, 0xae, 0x0f, 0x27, 0x93, 0x92, 0x4d, 0x49, 0xd2, 0x5a, 0x22,
+	0xb2, 0x2e, 0x9c, 0xf6, 0x03, 0x16, 0x5f, 0xdd, 0x96, 0x0a, 0x47, 0x3f, 0xb0, 0xda, 0x9d, 0x02,
+	0x59, 0xc1, 0x44, 0xf4, 0x87, 0x34, 0x2a, 0xcc, 0xc2, 0xcf, 0xb2, 0x0e, 0x97, 0x79, 0x39, 0xbb,
+	0x8c, 0xf9, 0xf8, 0x28, 0xc4, 0x0f, 0x6e, 0x43, 0x2f, 0xe3, 0xf5, 0x65, 0xe3, 0xc3, 0x5c, 0x4a,
+	0xe4, 0x45, 0x38, 0xcb, 0x2e, 0xc0, 0xf9, 0x7a, 0x60, 0xdf, 0xb3, 0x83, 0x9d, 0xb8, 0x0b, 0x07,
+	0x4f, 0x05, 0xc6, 0x5f, 0xdb, 0x2b, 0x59, 0xc8, 0x30, 0x9b, 0x86, 0xf9, 0x97, 0x06, 0x90, 0xf4,
+	0x5a, 0x27, 0x2d, 0x18, 0x6f, 0x84, 0xe1, 0x18, 0x8c, 0x43, 0x49, 0xd5, 0x13, 0x5d, 0x21, 0x51,
+	0x14, 0x87, 0x88, 0x02, 0x71, 0x61, 0xe2, 0xfe, 0x96, 0x1d, 0xd0, 0x96, 0xed, 0x07, 0x87, 0x94,
+	0x19, 0x28, 0x0a, 0x34, 0x7f, 0x3b, 0x44, 0x8c, 0x31, 0x0d, 0xf3, 0xdb, 0x87, 0x61, 0x3c, 0x4a,
+	0xf1, 0xb9, 0xbf, 0xed, 0x76, 0x17, 0x48, 0x5d, 0x89, 0x32, 0x38, 0x88, 0xcc, 0x94, 0xf3, 0x40,
+	0xd5, 0x14, 0x32, 0xcc, 0x20, 0x40, 0x5e, 0x64, 0x56, 0xb7, 0x9b, 0x9e, 0x15, 0xc5, 0x50, 0xac,
+	0x86, 0x82, 0xb2, 0x02, 0x84, 0xf9, 0x2b, 0x7a, 0x39, 0x03, 0x1d, 0x66, 0x12, 0x21, 0x14, 0xc6,
+	0x44, 0x96, 0xe5, 0x50, 0xeb, 0x52, 0x48, 0x56, 0x2f, 0x78, 0xcd, 0xf8, 0x78, 0x0f, 0x79, 0xcf,
+	0x10, 0xb7, 0x88, 0x78, 0x2b, 0xfe, 0x0f, 0x15, 0x52, 0xe5, 0x91, 0xe2, 0xae, 0x78, 0xb7, 0x75,
+	0x54, 0x32, 0xe2, 0xad, 0x5e, 0x88, 0x49, 0x82, 0xe6, 0xcf, 0x97, 0x60, 0x44, 0x04, 0x16, 0x3b,
+	0x7a, 0x56, 0xf3, 0xfd, 0x1a, 0xab, 0xf9, 0x54, 0x91, 0x8f, 0xe4, 0x5d, 0xcd, 0x65, 0x34, 0x9b,
+	0x09, 0x46, 0xf3, 0xe9, 0xe2, 0x24, 0x7a, 0xb3, 0x99, 0xbf, 0x69, 0xc0, 0x04, 0xaf, 0x77, 0x0c,
+	0x4c, 0xe6, 0x73, 0x3a, 0x93, 0xf9, 0xb6, 0xc2, 0xdf, 0x94, 0xc3, 0x62, 0xfe, 0xa7, 0x12, 0x10,
+	0x0e, 0xd7, 0x6f, 0xc6, 0x2b, 0x2a, 0xf3, 0x21, 0xce, 0x87, 0xe8, 0x60, 0xc9, 0x64, 0x40, 0xfe,
+	0xa9, 0x01, 0xc3, 0x5d, 0x9f, 0x36, 0x64, 0x3f, 0xd7, 0x0a, 0xf7, 0x53, 0xeb, 0xc7, 0xdc, 0x86,
+	0x4f, 0x1b, 0xe2, 0xd9, 0x8b, 0xe1, 0x8c, 0xb3, 0xa2, 0x07, 0xbb, 0x95, 0x4a, 0x86, 0x2c, 0x3a,
+	0xce, 0xc1, 0xed, 0x07, 0x1f, 0xff, 0x93, 0x9e, 0x55, 0xc4, 0x2b, 0x8f, 0xf5, 0x78, 0xb6, 0x09,
+	0x13, 0x11, 0x99, 0x8c, 0x37, 0xf0, 0xa2, 0x6e, 0x8e, 0x77, 0x40, 0xcb, 0x33, 0xf5, 0xcd, 0xfc,
+	0x2f, 0x87, 0xe4, 0xba, 0xe1, 0xfc, 0xf2, 0x32, 0x9c, 0x96, 0x2e, 0xd4, 0x2c, 0x65, 0x38, 0x3b,
+	0xb7, 0x16, 0x99, 0xc5, 0x8f, 0x70, 0xea, 0x11, 0xe1, 0x89, 0xd2, 0x60, 0xcc, 0x6a, 0x43, 0x7e,
+	0xd9, 0x60, 0x9c, 0x69, 0xe0, 0xd9, 0xf5, 0x81, 0x34, 0xfc, 0x51, 0xdf, 0xe6, 0x56, 0x05, 0x32,
+	0x31, 0xf2, 0x1b, 0x31, 0x8b, 0xca, 0x4b, 0x0f, 0x69, 0xf0, 0xc3, 0x1e, 0x93, 0xeb, 0x30, 0xe2,
+	0xd7, 0xdd, 0x4e, 0xe8, 0x84, 0xff, 0x48, 0x96, 0xc0, 0x37, 0x29, 0xeb, 0x8d, 0x16, 0x73, 0x8d,
+	0xb5, 0x44, 0x81, 0x60, 0xf6, 0x03, 0x30, 0xa5, 0xf6, 0xfc, 0x48, 0x27, 0xf3, 0x93, 0xa3, 0x30,
+	0xa9, 0x1c, 0x16, 0x87, 0xca, 0x4b, 0xfe, 0x23, 0x03, 0x86, 0xb7, 0x58, 0x70, 0xc6, 0x52, 0x71,
+	0x4b, 0x2d, 0xa5, 0x6f, 0x73, 0x2c, 0xee, 0x62, 0x62, 0x17, 0xb1, 0xa2, 0xc3, 0xda, 0x45, 0xac,
+	0xab, 0xbc, 0xcf, 0xfc, 0x00, 0x18, 0x3a, 0x9c, 0x3e, 0x1f, 0xc3, 0xce, 0x27, 0x01, 0xf7, 0xfd,
+	0x63, 0x2b, 0x2b, 0xbc, 0xeb, 0xaf, 0x1e, 0xce, 0xb9, 0xa5, 0xf9, 0x10, 0x72, 0xfc, 0x18, 0x51,
+	0x62, 0xe7, 0x4d, 0x34, 0x21, 0x47, 0xb9, 0x44, 0x8f, 0xef, 0x60, 0xfb, 0xbd, 0x21, 0x18, 0x45,
+	0xda, 0x94, 0x89, 0x26, 0xf7, 0x11, 0xaa, 0xd9, 0x61, 0xd6, 0xf5, 0x52, 0x71, 0xef, 0x65, 0x35,
+	0x95, 0x18, 0x4b, 0xb5, 0x1e, 0x9f, 0x07, 0x6a, 0xe2, 0x75, 0xe2, 0x44, 0xe9, 0xf7, 0x86, 0x8a,
+	0xcf, 0xae, 0xf8, 0xb0, 0x7e, 0x12, 0xee, 0x91, 0xef, 0x36, 0x80, 0x58, 0xf5, 0x3a, 0x73, 0x19,
+	0xa5, 0x3e, 0x3b, 0x87, 0xc4, 0x6b, 0x5c, 0x2c, 0xad, 0x62, 0xb9, 0x04, 0x92, 0xd8, 0xe2, 0xb3,
+	0x24, 0x05, 0xf2, 0x31, 0x83, 0xf8, 0x20, 0x49, 0x00, 0xff, 0x95, 0x01, 0x53, 0x5a, 0x8e, 0xc5,
+	0x76, 0xac, 0x2c, 0x2c, 0x6e, 0x93, 0x18, 0xfa, 0xcc, 0x5e, 0xe8, 0x51, 0x49, 0x28, 0x20, 0x6f,
+	0x45, 0x49, 0x82, 0x0e, 0x27, 0x1d, 0xa3, 0xf9, 0x69, 0x03, 0xce, 0x85, 0x1f, 0xa4, 0x67, 0x83,
+	0x60, 0xba, 0x33, 0xab, 0x63, 0x73, 0x4d, 0x96, 0xaa, 0x0b, 0x9c, 0x5f, 0x5b, 0xe6, 0x65, 0x18,
+	0x41, 0xb5, 0xd4, 0xf6, 0xa5, 0x7d, 0x53, 0xdb, 0xbf, 0x4a, 0x49, 0xd6, 0x3f, 0x12, 0xf3, 0x50,
+	0x11, 0x61, 0x61, 0x02, 0x6f, 0xbe, 0x19, 0x26, 0x6a, 0xb5, 0xeb, 0x62, 0x4a, 0x0f, 0xa0, 0xd2,
+	0x36, 0x3f, 0x39, 0x04, 0x27, 0x64, 0x5a, 0x1b, 0x9b, 0xcb, 0xb8, 0x8f, 0x81, 0x91, 0x5f, 0x87,
+	0x09, 0x3f, 0x52, 0xd2, 0x96, 0xf2, 0xef, 0xec, 0x48, 0xef, 0x9a, 0xcc, 0x4d, 0x1a, 0x01, 0x30,
+	0x46, 0x44, 0x6e, 0xc0, 0xe8, 0xf3, 0xec, 0x1c, 0x0d, 0xf7, 0x6a, 0x5f, 0x6c, 0x40, 0xb4, 0x11,
+	0xf9, 0x11, 0xec, 0xa3, 0x44, 0x41, 0x7c, 0x7e, 0xb0, 0xf3, 0x57, 0xee, 0x20, 0x01, 0x8e, 0xb5,
+	0x91, 0x0d, 0x9f, 0xcd, 0x62, 0x61, 0x84, 0xbf, 0x30, 0x22, 0xc4, 0x13, 0x2b, 0x6b, 0x2d, 0x5e,
+	0x26, 0x89, 0x95, 0xb5, 0x3e, 0xe7, 0x3c, 0x13, 0xde, 0x06, 0x67, 0x33, 0x07, 0x63, 0x7f, 0x19,
+	0x82, 0xf9, 0x0f, 0x4b, 0x30, 0xcc, 0xd2, 0x23, 0x1f, 0xc3, 0xca, 0x7c, 0x4e, 0x7b, 0x62, 0x7e,
+	0x5d, 0xe1, 0xd4, 0xce, 0x79, 0x2f, 0xcc, 0xcd, 0xc4, 0x0b, 0xf3, 0x1d, 0x85, 0x29, 0xf4, 0x7e,
+	0x60, 0xfe, 0x59, 0x09, 0xc6, 0x58, 0x35, 0x66, 0x15, 0xd2, 0x56, 0x96, 0x72, 0xa9, 0xb8, 0x7c,
+	0x40, 0xa2, 0xdb, 0x6f, 0x11, 0xb3, 0x9d, 0x63, 0xcb, 0x74, 0x59, 0xe5, 0xa1, 0x41, 0x76, 0x8e,
+	0x46, 0x4e, 0x46, 0xec, 0xe1, 0x44, 0xc3, 0x6c, 0x5c, 0x18, 0x11, 0x22, 0xf7, 0x15, 0xa1, 0xdb,
+	0xf0, 0xe5, 0xa1, 0xc3, 0x25, 0xda, 0x43, 0xfe, 0x66, 0x7e, 0xd2, 0x80, 0x93, 0x89, 0x56, 0x7d,
+	0x48, 0xc5, 0x8e, 0xe4, 0x00, 0x34, 0xbf, 0x6c, 0xc0, 0xd9, 0x44, 0x5f, 0xa4, 0x44, 0x6a, 0xff,
+	0x1e, 0xc5, 0x69, 0x13, 0x4b, 0x3d, 0xd3, 0x26, 0x5e, 0x84, 0x61, 0xc6, 0x19, 0xa9, 0x46, 0x89,
+	0x8c, 0x61, 0x42, 0x5e, 0x4a, 0xea, 0x30, 0x5d, 0xf7, 0x68, 0x83, 0x3a, 0x81, 0x6d, 0xb5, 0x7c,
+	0xf6, 0x71, 0xc3, 0xfd, 0xbf, 0xc8, 0x22, 0x7b, 0x8a, 0xaa, 0x86, 0x02, 0x13, 0x28, 0xcd, 0xdf,
+	0x30, 0x60, 0x9c, 0x7d, 0xe6, 0x31, 0x1c, 0x8e, 0xdf, 0xa0, 0x1f, 0x8e, 0x6f, 0x2d, 0xba, 0xa6,
+	0x72, 0xce, 0xc4, 0x3f, 0x2f, 0x01, 0xcf, 0xfb, 0x2e, 0x0d, 0x28, 0x15, 0xd3, 0x48, 0x23, 0xc7,
+	0xa8, 0xf3, 0xb2, 0xb4, 0xac, 0x4c, 0x68, 0xdd, 0x14, 0xeb, 0xca, 0xd7, 0x6a, 0xc6, 0x93, 0x1a,
+	0x73, 0x91, 0x61, 0x40, 0xf9, 0x02, 0x9c, 0xf0, 0x59, 0x64, 0x8c, 0xc5, 0x78, 0xf3, 0x14, 0xd6,
+	0xb0, 0xf2, 0x10, 0x1b, 0xe1, 0xa7, 0x08, 0x5b, 0x95, 0x9a, 0x8a, 0x1b, 0x75, 0x52, 0xcc, 0x3a,
+	0xec, 0x4e, 0xcb, 0xad, 0xdf, 0x15, 0xb6, 0x9b, 0x22, 0xa4, 0x02, 0xb7, 0x0e, 0x5b, 0x88, 0x4a,
+	0x51, 0xa9, 0x31, 0x90, 0x99, 0xea, 0x97, 0x0d, 0x31, 0xd2, 0x07, 0xd8, 0xa3, 0xc7, 0x68, 0xe9,
+	0xfb, 0x6a, 0x18, 0xf5, 0xf8, 0x1b, 0xa1, 0x3c, 0xa4, 0x6f, 0x3e, 0xf1, 0x72, 0x40, 0x09, 0x65,
+	0xcb, 0x43, 0x3c, 0x7c, 0x86, 0x63, 0x8d, 0xaa, 0xfa, 0x5c, 0x31, 0x7f, 0x51, 0x7e, 0x66, 0x8d,
+	0xb6, 0x68, 0x3d, 0x70, 0x59, 0x9c, 0xb6, 0x13, 0xfc, 0x65, 0x11, 0x16, 0xc8, 0x3d, 0xf2, 0x86,
+	0x3e, 0xf7, 0x88, 0xda, 0x34, 0xf6, 0x3b, 0xd0, 0x8a, 0x51, 0x27, 0xc0, 0x4c, 0x97, 0xc2, 0xaf,
+	0x13, 0xe6, 0xff, 0xa5, 0xd8, 0xaf, 0x7d, 0x4d, 0x05, 0xa0, 0x5e, 0xcf, 0xfc, 0x4c, 0x09, 0x1e,
+	0x16, 0x7d, 0xe7, 0xa2, 0xe5, 0x45, 0xca, 0x5c, 0xbe, 0xa8, 0x53, 0xdf, 0xe1, 0x7c, 0x76, 0xc3,
+	0x65, 0x42, 0xfd, 0xd1, 0xfb, 0x94, 0x36, 0x22, 0x1d, 0xed, 0xed, 0xc2, 0x97, 0x67, 0x1e, 0x89,
+	0xdb, 0x1c, 0xbd, 0x30, 0x77, 0x11, 0xff, 0xa3, 0x24, 0xc9, 0x88, 0x77, 0x3c, 0xf7, 0x4e, 0xc4,
+	0x0e, 0x1e, 0x3e, 0xf1, 0x35, 0x8e, 0x5e, 0x10, 0x17, 0xff, 0xa3, 0x24, 0x69, 0xae, 0xc1, 0x23,
+	0x7d, 0x34, 0x3d, 0x08, 0xdb, 0xbf, 0x1f, 0x46, 0xf1, 0xf5, 0x07, 0xc1, 0xf8, 0x07, 0x06, 0x3c,
+	0xaa, 0xa0, 0x5c, 0xda, 0x66, 0x2f, 0x11, 0xe6, 0x45, 0x5c, 0x67, 0x6f, 0x7d, 0x1e, 0xd9, 0xf3,
+	0x40, 0xb9, 0xce, 0x3f, 0x69, 0xc0, 0x98, 0x30, 0x39, 0x0e, 0x8f, 0xdf, 0xe7, 0x06, 0x1c, 0xf2,
+	0xdc, 0x2e, 0x85, 0x39, 0x20, 0xc3, 0x6f, 0x13, 0xbf, 0x7d, 0x0c, 0xe9, 0x9b, 0xff, 0x62, 0x04,
+	0xbe, 0xa6, 0x7f, 0x44, 0xe4, 0xcb, 0x06, 0x4c, 0x84, 0xef, 0xb7, 0x50, 0x09, 0xd8, 0x3e, 0xda,
+	0xce, 0x47, 0xc2, 0x29, 0x29, 0x60, 0xb8, 0x1d, 0x72, 0x0a, 0x51, 0xf9, 0x21, 0xc9, 0xbd, 0xe2,
+	0x0f, 0x23, 0x7f, 0xcf, 0x80, 0x29, 0x76, 0x2d, 0x45, 0x87, 0x8b, 0x98, 0xa6, 0xce, 0x11, 0x7f,
+	0xe9, 0x4d, 0x85, 0x64, 0x22, 0x1a, 0xa0, 0x0a, 0x42, 0xad, 0x6f, 0x64, 0x43, 0xb7, 0x6f, 0x10,
+	0x4f, 0xc4, 0x4b, 0x59, 0x7c, 0x89, 0xa2, 0x0a, 0x8d, 0x2c, 0xe5, 0xf2, 0x6c, 0x17, 0x66, 0x5b,
+	0x30, 0xad, 0x8f, 0xfc, 0x91, 0xca, 0xe3, 0x9e, 0x86, 0x99, 0xd4, 0xd7, 0x1f, 0x48, 0x20, 0xf3,
+	0xdb, 0xa3, 0x50, 0x51, 0x86, 0x3a, 0x2b, 0xa8, 0x17, 0x8b, 0x81, 0x30, 0x69, 0x39, 0x8e, 0xb4,
+	0xdc, 0x0c, 0xd7, 0x6f, 0x63, 0xc0, 0x59, 0xcd, 0x22, 0x35, 0x37, 0x1f, 0x93, 0x49, 0x98, 0x26,
+	0x2a, 0x10, 0x54, 0x7b, 0xd3, 0xc3, 0xfd, 0xa0, 0x74, 0x6c, 0xee, 0x07, 0xe4, 0x43, 0xe1, 0x45,
+	0x3c, 0x54, 0x3c, 0xbc, 0xcf, 0x3e, 0x63, 0xc3, 0xef, 0xf5, 0x1c, 0xa9, 0xe4, 0x77, 0x1a, 0xfc,
+	0x92, 0x8d, 0x63, 0xaf, 0x95, 0x87, 0x8b, 0x5b, 0x91, 0xef, 0x1b, 0xd8, 0x2d, 0xba, 0xbb, 0xe3,
+	0x22, 0xd4, 0xc9, 0x93, 0xef, 0x33, 0x60, 0xea, 0x05, 0xd7, 0xb1, 0x5a, 0xd2, 0x91, 0x44, 0xea,
+	0xa1, 0xdf, 0x7f, 0x34, 0xe3, 0x12, 0x91, 0x11, 0xc6, 0xe3, 0x6a, 0x09, 0x6a, 0xdd, 0x60, 0x0c,
+	0x93, 0x88, 0x48, 0x31, 0x1a, 0xf3, 0xd3, 0x6a, 0x34, 0x0a, 0x66, 0xc4, 0x9a, 0x5c, 0x83, 0x07,
+	0xda, 0x4f, 0xeb, 0xf0, 0x9a, 0x03, 0xf4, 0xb7, 0xcf, 0xab, 0xcf, 0xfc, 0xd5, 0x61, 0x78, 0x74,
+	0x7f, 0xb4, 0xe2, 0x39, 0xb0, 0x8f, 0xac, 0xfc, 0x73, 0x89, 0xcd, 0x2c, 0x8e, 0x68, 0xfb, 0xa8,
+	0x16, 0xec, 0xe1, 0xee, 0xe8, 0xa1, 0xe3, 0xdb, 0xd1, 0x2f, 0xb5, 0x2d, 0x35, 0xf0, 0xca, 0x5c,
+	0x80, 0xb3, 0xca, 0x84, 0xc5, 0x29, 0xee, 0x78, 0x78, 0x63, 0xdb, 0xb7, 0xc3, 0x20, 0xfd, 0x0a,
+	0x4b, 0xf7, 0x8c, 0x28, 0xc6, 0x10, 0x6e, 0xae, 0x68, 0x97, 0xc5, 0xba, 0xdb, 0x71, 0x5b, 0x6e,
+	0x73, 0x67, 0xfe, 0xbe, 0xe5, 0x51, 0x74, 0xbb, 0x81, 0xc4, 0xd6, 0x2f, 0x83, 0xf8, 0xb1, 0x11,
+	0xb8, 0xac, 0xa0, 0xcb, 0x8c, 0x65, 0x7c, 0x00, 0x7c, 0x6c, 0x69, 0xeb, 0x21, 0x87, 0x4b, 0xc5,
+	0xad, 0xe8, 0xf7, 0xeb, 0x57, 0xb1, 0x10, 0xc4, 0x64, 0xd7, 0x00, 0x68, 0x5b, 0xdb, 0x32, 0xfe,
+	0x65, 0x79, 0xe8, 0x50, 0x6e, 0xd2, 0xec, 0x0e, 0xae, 0x46, 0x64, 0x44, 0xf7, 0x9e, 0x0d, 0xe5,
+	0x9c, 0x31, 0xe0, 0x90, 0x38, 0x40, 0xe5, 0x8b, 0x06, 0x8e, 0xb1, 0x3c, 0xdb, 0x86, 0x93, 0x89,
+	0x9e, 0x1f, 0xa9, 0x9e, 0xf1, 0xb7, 0xc7, 0x60, 0x4a, 0x19, 0x4a, 0x9f, 0xfc, 0x82, 0x01, 0x0f,
+	0xd1, 0x3c, 0x86, 0x53, 0xbe, 0x96, 0x9f, 0x3d, 0x2a, 0x86, 0x56, 0xa6, 0x29, 0xcc, 0x03, 0x63,
+	0x7e, 0xcf, 0x58, 0xb4, 0x1f, 0x3f, 0xda, 0xd3, 0x83, 0x44, 0xfb, 0xc9, 0x3c, 0x24, 0x84, 0x1c,
+	0x26, 0xfe, 0x8d, 0x0a, 0x31, 0x16, 0x17, 0xe6, 0x4c, 0x2b, 0xe3, 0x84, 0x93, 0x27, 0x66, 0xed,
+	0x08, 0xee, 0x16, 0x61, 0x82, 0x97, 0x05, 0xc1, 0xcc, 0xae, 0x90, 0x9f, 0xc8, 0x0d, 0xcc, 0x2e,
+	0x38, 0x93, 0xf5, 0xa3, 0xd8, 0x83, 0x05, 0x62, 0xb4, 0x7f, 0xc6, 0x00, 0xd2, 0x48, 0x3d, 0xbe,
+	0xa5, 0xf5, 0xf5, 0xbb, 0x0f, 0x5d, 0xc4, 0x20, 0x6c, 0x28, 0xd3, 0xe5, 0x98, 0xd1, 0x09, 0x3e,
+	0xcf, 0x41, 0xc6, 0x99, 0x5f, 0x1e, 0x3f, 0x94, 0x79, 0xce, 0xba, 0x4e, 0xc4, 0x3c, 0x67, 0x41,
+	0x30, 0xb3, 0x2b, 0xe6, 0xaf, 0x4f, 0x08, 0x59, 0x30, 0xb7, 0x87, 0x7a, 0x0e, 0x46, 0xef, 0x58,
+	0xf5, 0xbb, 0x52, 0xff, 0x5a, 0xd0, 0xec, 0x72, 0x81, 0x63, 0x10, 0x52, 0x18, 0xf1, 0x3f, 0x4a,
+	0xac, 0xe4, 0xbd, 0x30, 0xd4, 0x70, 0xc2, 0x10, 0x22, 0x6f, 0x1f, 0x40, 0xbf, 0x10, 0x07, 0x77,
+	0x62, 0xfe, 0xb6, 0x0c, 0x29, 0x71, 0x60, 0xdc, 0x91, 0xa2, 0x53, 0x29, 0xdd, 0x7a, 0x67, 0x51,
+	0x02, 0x91, 0x08, 0x36, 0x12, 0xfc, 0x86, 0x25, 0x18, 0xd1, 0x60, 0xf4, 0x12, 0xfa, 0xcd, 0xc2,
+	0xf4, 0x22, 0x8d, 0x90, 0x6a, 0xb2, 0x92, 0xd4, 0x0a, 0x51, 0x16, 0xbb, 0xdd, 0x76, 0x82, 0x30,
+	0x1c, 0xc8, 0x53, 0x45, 0xa9, 0xad, 0x33, 0x2c, 0xb1, 0x84, 0x94, 0xff, 0xf4, 0x51, 0x22, 0x27,
+	0x77, 0x60, 0x54, 0x84, 0x04, 0x29, 0x8f, 0x0d, 0xa6, 0x5f, 0x13, 0x51, 0x46, 0xc4, 0x32, 0x10,
+	0xff, 0xa3, 0xc4, 0x4c, 0x3e, 0xc0, 0x24, 0xec, 0xd2, 0xde, 0x76, 0x7c, 0xb0, 0xa1, 0x8b, 0x8c,
+	0x6d, 0x65, 0x80, 0x03, 0xf1, 0x0b, 0x23, 0xfc, 0xe4, 0x0e, 0x8c, 0xd9, 0xf2, 0x49, 0x35, 0x51,
+	0x7c, 0xd9, 0x85, 0xcf, 0x25, 0xfe, 0xda, 0x90, 0x3f, 0x30, 0x44, 0x9c, 0x67, 0x73, 0x02, 0x5f,
+	0x41, 0x9b, 0x13, 0xf2, 0x3c, 0x00, 0x0d, 0x45, 0xe8, 0x7e, 0x79, 0xb2, 0xf8, 0x92, 0x51, 0x04,
+	0xf1, 0x21, 0x43, 0x14, 0x15, 0xf9, 0xa8, 0x10, 0x21, 0x2f, 0xaa, 0x32, 0xbb, 0xa9, 0xc1, 0xa2,
+	0x1c, 0xa5, 0xe3, 0x76, 0xc5, 0xaa, 0xbb, 0x10, 0xe4, 0x2b, 0xa2, 0x34, 0xf3, 0x2f, 0xa6, 0x00,
+	0x62, 0xb5, 0x2e, 0x8b, 0x33, 0x16, 0x52, 0x19, 0x24, 0xce, 0xd8, 0x35, 0x09, 0x16, 0xcb, 0x2b,
+	0xfc, 0x85, 0x11, 0x6e, 0xe6, 0x6a, 0x99, 0x0e, 0xa2, 0x17, 0xe7, 0x15, 0xef, 0x2f, 0x80, 0xde,
+	0xf3, 0x00, 0xf5, 0x38, 0x34, 0xf6, 0x50, 0xf1, 0xb9, 0x8a, 0xc2, 0x66, 0xc7, 0x73, 0x15, 0x15,
+	0xf9, 0xa8, 0x10, 0xc9, 0x31, 0x95, 0x1c, 0x2e, 0x64, 0x2a, 0xf9, 0x14, 0x9c, 0x94, 0x16, 0xb1,
+	0xcb, 0x5c, 0xcb, 0x18, 0xec, 0x48, 0xcf, 0x74, 0x6e, 0x00, 0x5f, 0xd5, 0x41, 0x98, 0xac, 0x4b,
+	0xfe, 0xb9, 0xc1, 0x62, 0x00, 0x08, 0x06, 0xad, 0x3c, 0x5a, 0x3c, 0x0e, 0x47, 0x3c, 0xfb, 0x73,
+	0x21, 0xbf, 0x27, 0x18, 0xf9, 0x67, 0xc2, 0x53, 0x35, 0x2c, 0x3e, 0x24, 0x36, 0x3e, 0xea, 0x35,
+	0xf9, 0x2d, 0x26, 0x23, 0x68, 0xf1, 0xe8, 0x81, 0x3c, 0xa8, 0xaa, 0x70, 0x99, 0xbf, 0x35, 0xe0,
+	0x57, 0xcc, 0xc7, 0x18, 0xc5, 0x87, 0xbc, 0x27, 0x92, 0x04, 0xc4, 0x90, 0x43, 0xfa, 0x16, 0xb5,
+	0xfb, 0xe4, 0xef, 0x18, 0xf0, 0xa8, 0x88, 0x53, 0x50, 0xa5, 0x5e, 0x60, 0x6f, 0xda, 0x75, 0x2b,
+	0xa0, 0x22, 0xd2, 0x73, 0xe8, 0x23, 0x2b, 0x9c, 0x84, 0xc6, 0x0f, 0xec, 0x24, 0xf4, 0xd8, 0xde,
+	0x6e, 0xe5, 0xd1, 0x6a, 0x1f, 0xb8, 0xb1, 0xaf, 0x1e, 0x30, 0xf5, 0x6b, 0x4b, 0xcd, 0xda, 0x50,
+	0x9e, 0x28, 0xae, 0x7e, 0xd5, 0xd2, 0x3f, 0x08, 0x01, 0x83, 0x56, 0x84, 0x3a, 0x29, 0xf2, 0x8f,
+	0x0d, 0x18, 0xe9, 0xfa, 0x56, 0x93, 0xca, 0x93, 0x7e, 0x79, 0xc0, 0xf9, 0xde, 0x60, 0xb8, 0xc4,
+	0x4c, 0xd7, 0x42, 0xa9, 0x25, 0x2f, 0x3b, 0xa4, 0x39, 0x16, 0x9d, 0x9d, 0xbd, 0x0b, 0x27, 0xb4,
+	0xfd, 0x71, 0xa4, 0xf2, 0x76, 0x07, 0x4e, 0x25, 0x97, 0xf1, 0x91, 0xd2, 0xdb, 0x02, 0x88, 0x87,
+	0xf1, 0x48, 0x1f, 0xc2, 0x37, 0x60, 0x22, 0xe2, 0xa6, 0xc8, 0xc3, 0x0a, 0xa1, 0x98, 0x37, 0xbd,
+	0x41, 0x77, 0x04, 0xd5, 0x8a, 0x26, 0x64, 0x12, 0x52, 0x54, 0x1e, 0x14, 0x58, 0x22, 0x34, 0x7f,
+	0x47, 0xaa, 0x9d, 0xd7, 0x69, 0xbb, 0xd3, 0xb2, 0x02, 0xfa, 0xf2, 0x37, 0xd4, 0x32, 0xff, 0xa3,
+	0x21, 0x2e, 0x64, 0xc1, 0xfb, 0x11, 0x0b, 0x26, 0xdb, 0x22, 0xa7, 0x2a, 0x0f, 0x21, 0x6c, 0x14,
+	0x0f, 0x5e, 0xbc, 0x1a, 0xa3, 0x41, 0x15, 0x27, 0xb9, 0x0f, 0x13, 0x21, 0xb7, 0x1c, 0x8a, 0xb2,
+	0xae, 0x0e, 0xc6, 0xbd, 0x46, 0x8c, 0x79, 0xc4, 0x7b, 0x84, 0x25, 0x3e, 0xc6, 0xb4, 0x4c, 0x0b,
+	0x48, 0xba, 0x0d, 0x13, 0xc4, 0x85, 0x1e, 0xcd, 0x86, 0x9e, 0x05, 0x2d, 0xe5, 0xd5, 0x1c, 0x4a,
+	0xa1, 0x4b, 0x79, 0x52, 0x68, 0xf3, 0xd7, 0x4a, 0x70, 0x46, 0xbe, 0xcd, 0xe7, 0xeb, 0x75, 0xb7,
+	0xeb, 0x04, 0x71, 0xa0, 0x09, 0x11, 0xbd, 0x45, 0x12, 0xe1, 0xfc, 0xb6, 0x08, 0xed, 0x82, 0x12,
+	0xc2, 0xc2, 0x24, 0x71, 0x36, 0xad, 0xb1, 0xee, 0xde, 0xa5, 0x4e, 0x7c, 0x8c, 0xaa, 0x61, 0x92,
+	0x96, 0xb2, 0x2a, 0x60, 0x76, 0x3b, 0x72, 0x0f, 0x48, 0xdb, 0xda, 0x4e, 0x62, 0x2b, 0x96, 0x5b,
+	0x93, 0x3f, 0xa8, 0x57, 0x53, 0xd8, 0x30, 0x83, 0x02, 0xe3, 0x34, 0x18, 0xab, 0xdb, 0x09, 0x68,
+	0x43, 0x7c, 0x62, 0x68, 0xf5, 0xc2, 0x39, 0x8d, 0x79, 0x1d, 0x84, 0xc9, 0xba, 0xe6, 0xb7, 0x8c,
+	0xc2, 0x43, 0xfa, 0x20, 0xb2, 0x1d, 0x1a, 0x06, 0x58, 0x79, 0x3a, 0xf4, 0x1e, 0x16, 0x03, 0xf9,
+	0x78, 0xd2, 0x7b, 0xb8, 0xac, 0x5a, 0x55, 0xc9, 0x46, 0x9a, 0x27, 0xf1, 0x57, 0x20, 0x5a, 0x4a,
+	0x4e, 0x78, 0x8c, 0xa1, 0x23, 0x8d, 0x0a, 0xf3, 0x29, 0x03, 0x66, 0xf5, 0xe2, 0xab, 0xb6, 0x63,
+	0xfb, 0x5b, 0x32, 0x9d, 0xd6, 0xc1, 0x9d, 0x97, 0x79, 0x42, 0xfe, 0x95, 0x5c, 0x8c, 0xd8, 0x83,
+	0x1a, 0xf9, 0x0e, 0x03, 0x2e, 0x24, 0xc6, 0x45, 0x4b, 0xee, 0x75, 0x70, 0x3f, 0x66, 0x1e, 0xde,
+	0x6b, 0x25, 0x1f, 0x25, 0xf6, 0xa2, 0xd7, 0x2b, 0xe6, 0xf3, 0xe8, 0x4b, 0x25, 0xe6, 0x33, 0x73,
+	0x39, 0xe5, 0x86, 0x65, 0x2f, 0x0f, 0x97, 0x53, 0xde, 0xd5, 0xa3, 0x75, 0x39, 0x15, 0x24, 0x7a,
+	0x5b, 0x04, 0xbf, 0x07, 0xce, 0xf1, 0x6a, 0xf3, 0x0d, 0x2e, 0x89, 0xf4, 0x69, 0x63, 0xbe, 0xd1,
+	0xe0, 0xef, 0xff, 0xfd, 0x95, 0x88, 0x0f, 0xc3, 0x50, 0xd7, 0x6b, 0x25, 0x03, 0x93, 0xb3, 0xd8,
+	0x5b, 0xac, 0xdc, 0xfc, 0xd7, 0x25, 0x28, 0x73, 0xdc, 0x0a, 0xb7, 0xeb, 0xd7, 0xba, 0xed, 0xb6,
+	0xe5, 0xed, 0xb0, 0xc8, 0xe7, 0x81, 0x92, 0x6b, 0x2c, 0x52, 0x68, 0x8b, 0x3c, 0x63, 0x02, 0x46,
+	0x3e, 0x0c, 0xe7, 0xa8, 0xe5, 0xb5, 0x6c, 0xea, 0x07, 0x3a, 0x9b, 0x5c, 0xe0, 0xfc, 0xe1, 0x09,
+	0xa2, 0x96, 0x32, 0xb1, 0x61, 0x0e, 0x15, 0xe6, 0xbb, 0xc1, 0x13, 0xbf, 0x30, 0xe9, 0xa6, 0x12,
+	0x71, 0x72, 0x49, 0x96, 0x61, 0x04, 0x3d, 0xb6, 0x8c, 0x81, 0x3f, 0x30, 0x04, 0xa7, 0xc4, 0x98,
+	0xc6, 0xc7, 0x36, 0xb9, 0x07, 0xe3, 0x9e, 0x3c, 0xba, 0xe5, 0x7a, 0x5f, 0x29, 0xbc, 0x5c, 0x32,
+	0xae, 0x03, 0xf1, 0xd1, 0xe1, 0x2f, 0x8c, 0x68, 0x91, 0x6f, 0x65, 0x09, 0x79, 0x9c, 0xba, 0xb7,
+	0xc3, 0x43, 0xa5, 0xcc, 0x07, 0x4c, 0x54, 0x33, 0x48, 0x6e, 0x89, 0xa5, 0x04, 0x2e, 0x99, 0x06,
+	0x39, 0x51, 0x8a, 0x29, 0x9a, 0x4c, 0x55, 0x3c, 0x55, 0x57, 0x16, 0x59, 0x79, 0x68, 0xd0, 0x51,
+	0x48, 0xaf, 0x58, 0x61, 0x54, 0xa0, 0x02, 0x50, 0xa3, 0x69, 0xfe, 0xe1, 0x08, 0x94, 0xf3, 0x86,
+	0x90, 0x45, 0xa0, 0x3b, 0xa7, 0xd4, 0x66, 0xa1, 0xb8, 0x5c, 0x2f, 0x4c, 0x05, 0x55, 0x58, 0x22,
+	0x59, 0x9d, 0x8f, 0xe6, 0x88, 0x2f, 0xef, 0x6a, 0x26, 0x05, 0xcc, 0xa1, 0x4c, 0x5e, 0x04, 0xf0,
+	0xfd, 0xad, 0x1b, 0x74, 0xa7, 0x63, 0xd9, 0xa1, 0xf1, 0xe4, 0x8d, 0xe2, 0x07, 0x4d, 0xed, 0xba,
+	0x44, 0x15, 0x75, 0x4a, 0xa8, 0x8b, 0xe2, 0x72, 0x85, 0x1c, 0x9b, 0xb3, 0x13, 0xae, 0x1a, 0x13,
+	0x6e, 0x10, 0x7f, 0x9a, 0xcc, 0xe0, 0x72, 0xe2, 0xb1, 0xab, 0x83, 0x74, 0x92, 0x6c, 0x5a, 0x66,
+	0xfc, 0x24, 0xef, 0x24, 0x6f, 0xd7, 0xd5, 0x62, 0x5c, 0x76, 0x0e, 0x23, 0x26, 0x04, 0x67, 0x69,
+	0x70, 0x9a, 0x3c, 0xef, 0x14, 0x0d, 0xea, 0x8d, 0x78, 0xe1, 0xb3, 0x4e, 0x8d, 0x16, 0xef, 0x14,
+	0x4b, 0xd2, 0xab, 0x21, 0xd3, 0x3b, 0x95, 0x06, 0xa7, 0xc9, 0xb3, 0xe4, 0x6e, 0xe7, 0xf9, 0x34,
+	0x33, 0xd9, 0x9f, 0xb0, 0x7f, 0xfc, 0x6b, 0x17, 0xc4, 0x8f, 0x85, 0x67, 0xe0, 0x63, 0xf0, 0x32,
+	0x09, 0xcf, 0xc0, 0xfb, 0x9a, 0xe3, 0x63, 0xf0, 0x1b, 0xcc, 0xa7, 0x2c, 0x99, 0xfc, 0xb2, 0x2f,
+	0x27, 0xdb, 0x63, 0x33, 0x7f, 0x7f, 0x55, 0x9c, 0x70, 0x7b, 0x28, 0x0e, 0x9e, 0x95, 0x4c, 0xb6,
+	0x6d, 0xde, 0x86, 0x13, 0x9a, 0x8b, 0x81, 0x12, 0x66, 0x3a, 0x2b, 0x40, 0xb6, 0x1a, 0x45, 0xba,
+	0xd4, 0x2b, 0xfe, 0x75, 0xbc, 0xe4, 0xd3, 0x27, 0xdb, 0x5f, 0x9f, 0x25, 0x4f, 0xe4, 0x92, 0x0f,
+	0x35, 0xa9, 0x3c, 0x78, 0xb5, 0x3f, 0x88, 0x26, 0x95, 0x47, 0xc1, 0x96, 0xc1, 0xe6, 0xc5, 0xff,
+	0x28, 0xb1, 0x92, 0x77, 0xea, 0xa1, 0xe4, 0x6f, 0xc6, 0xd2, 0x83, 0x33, 0xc9, 0x00, 0xf0, 0x7c,
+	0x49, 0xa6, 0x6a, 0x13, 0x14, 0xba, 0x58, 0x71, 0x97, 0x15, 0x4a, 0xcb, 0xc7, 0xf4, 0xb0, 0x63,
+	0x9a, 0x0e, 0x56, 0x57, 0x3a, 0x0d, 0x1f, 0x87, 0xd2, 0xc9, 0x83, 0xc9, 0x2d, 0xfb, 0x0e, 0xf5,
+	0x1c, 0xc1, 0xd4, 0x8d, 0x14, 0x7f, 0x03, 0x5c, 0x8f, 0xd1, 0x08, 0x41, 0x93, 0x52, 0x80, 0x2a,
+	0x11, 0xe2, 0x69, 0x09, 0x31, 0x46, 0x8b, 0x73, 0x25, 0xb1, 0x76, 0x28, 0xfe, 0xce, 0x9c, 0x64,
+	0x18, 0x0e, 0x80, 0x13, 0x45, 0x89, 0x1f, 0x44, 0x37, 0x1b, 0xc7, 0x9a, 0x17, 0x4c, 0x47, 0xfc,
+	0x1b, 0x15, 0x0a, 0x6c, 0x5c, 0xdb, 0x71, 0x22, 0xa6, 0xf2, 0x78, 0xf1, 0x71, 0x55, 0xf2, 0x39,
+	0x49, 0x01, 0x5e, 0x5c, 0x80, 0x2a, 0x11, 0xf6, 0x8d, 0xed, 0x28, 0x55, 0x50, 0x79, 0xa2, 0xf8,
+	0x37, 0xc6, 0x09, 0x87, 0xc4, 0x37, 0xc6, 0xbf, 0x51, 0xa1, 0xc0, 0xf4, 0xd0, 0x91, 0x0a, 0x1f,
+	0x8a, 0x8b, 0x41, 0xfb, 0x52, 0xdf, 0xbf, 0x29, 0x96, 0x06, 0x4e, 0xf2, 0x7d, 0x7a, 0x41, 0x91,
+	0x04, 0xf2, 0x14, 0x4a, 0xec, 0xec, 0x48, 0x49, 0x06, 0x63, 0xc7, 0xa6, 0xa9, 0x9e, 0x8e, 0x4d,
+	0x55, 0x98, 0x11, 0x6e, 0x8c, 0xd2, 0x39, 0x98, 0x1f, 0x08, 0x27, 0x62, 0x3d, 0x64, 0x2d, 0x09,
+	0xc4, 0x74, 0x7d, 0x71, 0xe0, 0xd3, 0x06, 0x6f, 0x3b, 0xad, 0x1e, 0xf8, 0xa2, 0x0c, 0x23, 0x28,
+	0xb9, 0x07, 0x53, 0xbe, 0xe2, 0x25, 0x55, 0x3e, 0x39, 0xa8, 0x16, 0x5f, 0xe0, 0x11, 0x2f, 0x07,
+	0xb5, 0x04, 0x35, 0x3a, 0xba, 0x8a, 0xf9, 0xd4, 0xf1, 0xaa, 0x98, 0x59, 0x56, 0x16, 0xd5, 0x01,
+	0x62, 0xe6, 0x50, 0x62, 0xc5, 0xed, 0xeb, 0x20, 0xc1, 0xa6, 0x96, 0x6e, 0x77, 0x5c, 0xbf, 0xeb,
+	0x51, 0x6e, 0x79, 0xcd, 0xa7, 0x87, 0xc4, 0x53, 0xbb, 0x94, 0x04, 0x62, 0xba, 0x3e, 0x7f, 0x80,
+	0xfa, 0x3b, 0x7e, 0x40, 0xdb, 0xec, 0xda, 0x72, 0x1d, 0xca, 0x0c, 0x49, 0x4e, 0x17, 0x7f, 0x80,
+	0xd6, 0x12, 0xb8, 0xc4, 0xb5, 0x93, 0x2c, 0xc5, 0x14, 0x4d, 0xb6, 0x72, 0xd4, 0x68, 0x73, 0xe5,
+	0x33, 0xc5, 0x57, 0x8e, 0x1a, 0xc9, 0x4e, 0xbe, 0x39, 0x95, 0x12, 0xd4, 0xe8, 0x30, 0xaf, 0x3a,
+	0x69, 0x81, 0x47, 0x3d, 0x3e, 0x82, 0x67, 0xe3, 0x80, 0xe0, 0x35, 0x15, 0x80, 0x7a, 0x3d, 0xf2,
+	0x11, 0x98, 0x52, 0xef, 0xce, 0xf2, 0xb9, 0xc3, 0x4e, 0x8d, 0x23, 0x7a, 0xae, 0x82, 0x34, 0x82,
+	0x04, 0xe1, 0x9c, 0xe2, 0xbf, 0xab, 0xee, 0xef, 0xf3, 0xfc, 0x13, 0xc4, 0x7b, 0x36, 0xb3, 0x06,
+	0xe6, 0xb4, 0x24, 0x3f, 0x94, 0x6d, 0xb1, 0x52, 0x2e, 0x1e, 0x38, 0x2c, 0x65, 0x96, 0x72, 0xdb,
+	0x0e, 0xb6, 0x6e, 0xf1, 0x47, 0x91, 0x7f, 0x50, 0xe3, 0x15, 0xf3, 0xf7, 0x98, 0xee, 0x28, 0x14,
+	0xc9, 0x1d, 0x87, 0x32, 0xac, 0xa1, 0x49, 0x29, 0x17, 0x06, 0x12, 0x21, 0xe6, 0x66, 0x3e, 0x33,
+	0xbf, 0x60, 0xc0, 0x74, 0x5c, 0xed, 0x18, 0x9e, 0x46, 0x75, 0xfd, 0x69, 0xf4, 0x8e, 0xc1, 0xbe,
+	0x2b, 0xe7, 0x7d, 0xf4, 0xbf, 0x4b, 0xea, 0x57, 0x71, 0xee, 0xf7, 0x9e, 0x66, 0x7d, 0xc3, 0x48,
+	0x5f, 0x1f, 0xc4, 0xfa, 0x46, 0x0d, 0x80, 0x13, 0x7f, 0x6f, 0x86, 0x35, 0xce, 0x87, 0x35, 0xfe,
+	0x73, 0x80, 0x30, 0x6c, 0x11, 0xb3, 0x19, 0x92, 0x16, 0x03, 0xb0, 0x1f, 0x33, 0xfa, 0xbc, 0x7a,
+	0x3d, 0x0d, 0x90, 0xad, 0x4c, 0xfb, 0xe0, 0xde, 0x76, 0x4f, 0x3f, 0x3f, 0x03, 0x93, 0x8a, 0xf4,
+	0x3a, 0x61, 0x4b, 0x64, 0x1c, 0x87, 0x2d, 0x51, 0x00, 0x93, 0xf5, 0x28, 0x07, 0x76, 0x38, 0xec,
+	0x03, 0xd2, 0x8c, 0xae, 0xc5, 0x38, 0xbb, 0xb6, 0x8f, 0x2a, 0x19, 0xc6, 0xbc, 0x45, 0x6b, 0x6c,
+	0xe8, 0x10, 0x2c, 0xbc, 0x7a, 0xad, 0xab, 0x37, 0x02, 0x84, 0xfc, 0x3f, 0x6d, 0xc8, 0x2c, 0x30,
+	0x91, 0xf7, 0xc2, 0xb2, 0x7f, 0x3d, 0x82, 0xa1, 0x52, 0x2f, 0x6d, 0x9b, 0x32, 0x72, 0x7c, 0xb6,
+	0x29, 0xcf, 0x03, 0xb0, 0x82, 0x25, 0xcf, 0x8b, 0x33, 0x80, 0x3f, 0x55, 0x94, 0x30, 0xc7, 0x12,
+	0x2f, 0x83, 0xa8, 0xc8, 0x47, 0x85, 0x48, 0x8e, 0x49, 0xd9, 0x58, 0x21, 0x93, 0xb2, 0x2e, 0x9c,
+	0xf6, 0x68, 0xe0, 0xed, 0x54, 0x77, 0xea, 0x3c, 0x7d, 0x9a, 0x17, 0xf0, 0x17, 0xfc, 0x78, 0xb1,
+	0xa0, 0xcc, 0x98, 0x46, 0x85, 0x59, 0xf8, 0x35, 0x06, 0x78, 0xa2, 0x27, 0x03, 0xfc, 0x26, 0x98,
+	0x0c, 0x68, 0x7d, 0xcb, 0x61, 0x06, 0xe9, 0xcb, 0x8b, 0x32, 0x47, 0x48, 0xcc, 0xcb, 0xc5, 0x20,
+	0x54, 0xeb, 0x91, 0x05, 0x18, 0xea, 0xda, 0x0d, 0xf9, 0x02, 0xf8, 0xda, 0x48, 0x0f, 0xb4, 0xbc,
+	0xf8, 0x60, 0xb7, 0xf2, 0xca, 0xd8, 0x7e, 0x27, 0xfa, 0xaa, 0x2b, 0x9d, 0xbb, 0xcd, 0x2b, 0x01,
+	0xf3, 0xee, 0x9f, 0xdb, 0x58, 0x5e, 0x44, 0xd6, 0x38, 0xcb, 0xdc, 0x6e, 0xea, 0x00, 0xe6, 0x76,
+	0x9f, 0x31, 0xe0, 0xb4, 0x95, 0x54, 0x61, 0x51, 0xbf, 0x7c, 0xa2, 0xf8, 0x69, 0x99, 0xad, 0x16,
+	0x5b, 0xb8, 0x20, 0xbf, 0xef, 0xf4, 0x7c, 0x9a, 0x1c, 0x66, 0xf5, 0x81, 0xc9, 0x6d, 0xda, 0x76,
+	0x53, 0xac, 0x81, 0x78, 0xd6, 0xa7, 0x8b, 0xc9, 0x6d, 0x56, 0x53, 0x98, 0x30, 0x03, 0x3b, 0xb9,
+	0x0f, 0x93, 0x0a, 0x93, 0x54, 0x3e, 0x39, 0x00, 0x4f, 0x9c, 0x50, 0x69, 0x88, 0xd7, 0xae, 0x52,
+	0x80, 0x2a, 0xa5, 0x48, 0x8d, 0xae, 0x88, 0x19, 0xa4, 0x2a, 0x99, 0x7f, 0xf5, 0xa9, 0xe2, 0x6a,
+	0xf4, 0x6c, 0x8c, 0xd8, 0x83, 0x1a, 0x0f, 0x85, 0xcc, 0xc0, 0xca, 0xdb, 0xbc, 0x3c, 0x53, 0x3c,
+	0xd4, 0xd1, 0x8a, 0x8e, 0x4a, 0x2c, 0xcd, 0x44, 0x21, 0x26, 0x09, 0x92, 0xab, 0x40, 0xa4, 0xc2,
+	0x2a, 0x7e, 0x9c, 0xf9, 0x65, 0xc2, 0xd5, 0x89, 0x7c, 0x4a, 0x97, 0x52, 0x50, 0xcc, 0x68, 0x41,
+	0x02, 0x4d, 0x56, 0x32, 0xc0, 0x2b, 0x27, 0x99, 0x97, 0xaf, 0xa7, 0xc4, 0xe4, 0x9b, 0x0d, 0x98,
+	0xb6, 0xd5, 0xbc, 0x27, 0xbe, 0x7c, 0xdc, 0x5c, 0x2f, 0x66, 0x71, 0xae, 0x62, 0x92, 0xe4, 0xb9,
+	0x2c, 0x58, 0x87, 0x60, 0x82, 0x26, 0xf9, 0x31, 0x03, 0xce, 0xb7, 0xb3, 0x13, 0x52, 0x97, 0xcf,
+	0x16, 0x57, 0x5c, 0xe5, 0xe4, 0xb8, 0xe6, 0x62, 0x8c, 0xbc, 0x04, 0xd8, 0x98, 0xd7, 0x11, 0xf3,
+	0x77, 0x0d, 0x29, 0x8a, 0x3e, 0x46, 0x83, 0xb7, 0xa3, 0xb6, 0x44, 0x30, 0x6f, 0x43, 0xb9, 0x16,
+	0x06, 0x32, 0x6f, 0x24, 0xf2, 0x15, 0xbd, 0x1d, 0x4e, 0x08, 0x55, 0xd0, 0xaa, 0xd5, 0xb9, 0x19,
+	0xeb, 0x0d, 0xa2, 0x88, 0x30, 0x55, 0x15, 0x88, 0x7a, 0x5d, 0xf3, 0x4b, 0x06, 0x9c, 0xd7, 0x31,
+	0xbb, 0x9e, 0xfd, 0xc2, 0xe0, 0x88, 0xc9, 0x27, 0x0c, 0x98, 0xbc, 0x1b, 0x69, 0xa9, 0x42, 0xd6,
+	0xad, 0x90, 0xd7, 0x54, 0xd8, 0x2b, 0xea, 0x29, 0x6a, 0xaf, 0x74, 0x12, 0xec, 0x18, 0xe8, 0xa3,
+	0x4a, 0xda, 0xfc, 0x0b, 0x03, 0x52, 0xe2, 0x03, 0xe6, 0xbc, 0xc1, 0x88, 0xb0, 0xd4, 0x7b, 0x46,
+	0x71, 0xe7, 0x8d, 0xaa, 0x40, 0x21, 0x94, 0x22, 0xf2, 0x07, 0x86, 0x88, 0x99, 0x40, 0xc2, 0x51,
+	0x92, 0x19, 0xca, 0xe5, 0x51, 0x88, 0x6d, 0x57, 0x93, 0x22, 0x8a, 0x67, 0xbd, 0x5a, 0x82, 0x1a,
+	0x1d, 0x73, 0x05, 0x20, 0x16, 0xf9, 0x0c, 0x6c, 0x40, 0xfa, 0xef, 0x4e, 0xc3, 0xd9, 0x81, 0xfd,
+	0x81, 0x3f, 0x6e, 0xc0, 0x39, 0x7a, 0xcf, 0xae, 0x07, 0xf3, 0x9b, 0x01, 0xf5, 0x6e, 0xdd, 0x5a,
+	0x5d, 0xdf, 0xf2, 0xa8, 0xbf, 0xe5, 0xb6, 0x1a, 0xfd, 0x98, 0xcb, 0x66, 0xd8, 0xf6, 0x09, 0x4b,
+	0x92, 0x4c, 0x8c, 0x98, 0x43, 0x89, 0x8b, 0xbb, 0xee, 0x09, 0x41, 0x00, 0x5a, 0x01, 0x5d, 0xe8,
+	0x7a, 0x7e, 0x20, 0x43, 0x77, 0x0a, 0x71, 0x57, 0x12, 0x88, 0xe9, 0xfa, 0x49, 0x24, 0x3c, 0x53,
+	0x2f, 0xe7, 0xdb, 0x8d, 0x34, 0x12, 0x0e, 0xc4, 0x74, 0x7d, 0x15, 0x89, 0x98, 0x29, 0x76, 0x29,
+	0x8e, 0xa4, 0x91, 0x44, 0x40, 0x4c, 0xd7, 0x27, 0x0d, 0xb8, 0xe8, 0xd1, 0xba, 0xdb, 0x6e, 0x53,
+	0xa7, 0xc1, 0x07, 0x65, 0xd5, 0xf2, 0x9a, 0xb6, 0x73, 0xd5, 0xb3, 0x78, 0x45, 0xae, 0x3d, 0x30,
+	0x78, 0x52, 0xfd, 0x8b, 0xd8, 0xa3, 0x1e, 0xf6, 0xc4, 0x42, 0xda, 0x70, 0xb2, 0xcb, 0xcf, 0x7f,
+	0x8f, 0x87, 0xf6, 0xbb, 0x67, 0xb5, 0xca, 0x63, 0x85, 0x66, 0x8c, 0x5f, 0xd4, 0x1b, 0x3a, 0x2a,
+	0x4c, 0xe2, 0x26, 0x3b, 0x70, 0x3a, 0xea, 0x8e, 0x42, 0x72, 0xbc, 0x10, 0x49, 0xc9, 0xa2, 0xa7,
+	0xd0, 0x61, 0x16, 0x0d, 0x16, 0xb2, 0x3d, 0xb0, 0xbc, 0x26, 0x0d, 0xaa, 0x6b, 0x1b, 0x6b, 0xd4,
+	0xab, 0xb3, 0x33, 0xb6, 0x25, 0xb8, 0x75, 0x43, 0xa0, 0x5a, 0x4f, 0x83, 0x31, 0xab, 0x0d, 0xf9,
+	0x08, 0xbc, 0x4a, 0x1f, 0xd4, 0x15, 0xf7, 0x3e, 0xf5, 0x16, 0xdc, 0xae, 0xd3, 0xd0, 0x91, 0x03,
+	0x47, 0xfe, 0xf8, 0xde, 0x6e, 0xe5, 0x55, 0xd8, 0x4f, 0x03, 0xec, 0x0f, 0x6f, 0xba, 0x03, 0x1b,
+	0x9d, 0x4e, 0x66, 0x07, 0x26, 0xf3, 0x3a, 0x90, 0xd3, 0x00, 0xfb, 0xc3, 0xcb, 0x44, 0x8b, 0x62,
+	0x60, 0x56, 0x69, 0xdb, 0xf5, 0x76, 0x14, 0x8a, 0x53, 0x9c, 0x22, 0xdf, 0xbf, 0xeb, 0x99, 0x35,
+	0x30, 0xa7, 0x25, 0xbb, 0x53, 0x1e, 0xcb, 0xfb, 0xfc, 0x14, 0x99, 0x13, 0x9c, 0xcc, 0x6b, 0xf7,
+	0x76, 0x2b, 0x8f, 0x61, 0x9f, 0x6d, 0xb0, 0x6f, 0xec, 0x19, 0x5d, 0x89, 0x07, 0x22, 0xd5, 0x95,
+	0xe9, 0xbc, 0xae, 0xe4, 0xb7, 0xc1, 0xbe, 0xb1, 0x93, 0x6f, 0x33, 0xe0, 0xa1, 0x7a, 0xa7, 0x7b,
+	0xdd, 0xf6, 0x03, 0xb7, 0xe9, 0x59, 0xed, 0x45, 0x5a, 0xb7, 0x76, 0xae, 0x5b, 0xad, 0x4d, 0x96,
+	0x44, 0xa0, 0x7c, 0xb2, 0xd0, 0xc6, 0xe1, 0xbe, 0xef, 0xd5, 0xb5, 0x8d, 0x6c, 0xa4, 0x98, 0x4f,
+	0x8f, 0x7c, 0xaf, 0x01, 0x17, 0xdb, 0xbc, 0x8b, 0x39, 0x1d, 0x3a, 0x55, 0xa8, 0x43, 0xfc, 0x14,
+	0x5b, 0xed, 0x81, 0x17, 0x7b, 0x52, 0xe5, 0x83, 0x24, 0x2a, 0xcc, 0x37, 0x9b, 0x1e, 0x6d, 0x72,
+	0xac, 0xd1, 0xe9, 0x32, 0x53, 0x7c, 0x90, 0x56, 0xf3, 0x90, 0x62, 0x3e, 0x3d, 0xf2, 0x01, 0xb8,
+	0x94, 0x0b, 0xac, 0x32, 0x0b, 0x24, 0xae, 0x84, 0x19, 0x5a, 0x30, 0xf7, 0x76, 0x2b, 0x97, 0x56,
+	0x7b, 0xd6, 0xc4, 0x7d, 0x30, 0xf1, 0xf0, 0x3f, 0x5a, 0x24, 0x8e, 0xd3, 0x9c, 0x13, 0x7b, 0x5f,
+	0xa1, 0x94, 0xff, 0x87, 0x18, 0x7e, 0xe3, 0x0b, 0x7a, 0xf8, 0x8d, 0x33, 0xbc, 0x57, 0xef, 0x39,
+	0xbc, 0x5e, 0x7d, 0x35, 0xe6, 0x46, 0x3f, 0xae, 0x46, 0x9f, 0x31, 0x40, 0xba, 0x50, 0x33, 0x8b,
+	0x1d, 0xc5, 0xec, 0x68, 0x3c, 0x61, 0x72, 0x14, 0x66, 0xde, 0x2f, 0x65, 0x66, 0xde, 0x7f, 0xb5,
+	0x12, 0x07, 0x7d, 0x22, 0x7e, 0x2c, 0x09, 0xcc, 0x71, 0x20, 0x74, 0x96, 0xf5, 0x2e, 0x7a, 0x54,
+	0x4b, 0x61, 0x27, 0xcf, 0x7a, 0x17, 0xbf, 0xbe, 0x63, 0x38, 0x0b, 0x50, 0x2f, 0x31, 0x30, 0x4a,
+	0xcc, 0x58, 0x59, 0xc4, 0x8b, 0x12, 0x1d, 0x8c, 0x34, 0x06, 0x6a, 0xcc, 0xa8, 0xfd, 0xdd, 0x5d,
+	0x98, 0x57, 0x4b, 0x97, 0xa7, 0xd0, 0x96, 0x2e, 0x2a, 0xdc, 0x04, 0x66, 0x83, 0x97, 0xa0, 0x84,
+	0x90, 0x0d, 0x18, 0x6b, 0xdb, 0x0e, 0xf7, 0x26, 0x1a, 0x2e, 0xe4, 0x4d, 0xc4, 0xdf, 0x03, 0xab,
+	0x02, 0x05, 0x86, 0xb8, 0xcc, 0x5f, 0x30, 0xe0, 0xa4, 0x1e, 0x98, 0x9e, 0x47, 0x9d, 0x92, 0xf1,
+	0x8a, 0xa5, 0x11, 0x36, 0x6f, 0x2a, 0xe3, 0xb0, 0x62, 0x08, 0xd3, 0xb5, 0xd3, 0x03, 0x68, 0x1f,
+	0xb2, 0xe3, 0xe3, 0xef, 0xa3, 0x08, 0xf8, 0xfe, 0xd3, 0x30, 0x2a, 0x1e, 0xda, 0x8c, 0x8f, 0xcf,
+	0x88, 0x3f, 0x77, 0xa3, 0x78, 0x4e, 0xab, 0x22, 0x41, 0xa9, 0xd4, 0xcc, 0xde, 0xa5, 0x9e, 0x99,
+	0xbd, 0x11, 0x86, 0xea, 0x9e, 0x3d, 0x88, 0x25, 0x52, 0x15, 0x97, 0x85, 0x25, 0x52, 0x15, 0x97,
+	0x91, 0x21, 0x63, 0x22, 0x20, 0xc5, 0x44, 0x67, 0xb8, 0xb8, 0x08, 0x48, 0x0c, 0x80, 0x62, 0xa8,
+	0x33, 0xdd, 0xd3, 0x48, 0x27, 0x4c, 0x76, 0x31, 0x52, 0xdc, 0xfd, 0x4c, 0x0e, 0x79, 0x3f, 0xc9,
+	0x2e, 0xc2, 0x8d, 0x34, 0x9a, 0xbb, 0x91, 0x36, 0x61, 0x4c, 0x6e, 0x85, 0xf2, 0x58, 0xf1, 0x17,
+	0xb4, 0xb4, 0x7c, 0x54, 0x32, 0x65, 0x8a, 0x02, 0x0c, 0x91, 0xb3, 0x57, 0x66, 0xdb, 0xda, 0x66,
+	0xae, 0x78, 0xfc, 0x15, 0x30, 0xa2, 0x56, 0xe5, 0xc5, 0x18, 0xc2, 0x79, 0x55, 0xe1, 0xb5, 0x57,
+	0x9e, 0x48, 0x54, 0x15, 0xc5, 0x18, 0xc2, 0xc9, 0x7b, 0x61, 0xbc, 0x6d, 0x6d, 0xd7, 0xba, 0x5e,
+	0x93, 0x96, 0x61, 0x1f, 0xa1, 0x50, 0x37, 0xb0, 0x5b, 0x73, 0x4c, 0x33, 0x14, 0x78, 0x73, 0xcb,
+	0x4e, 0x70, 0xcb, 0xab, 0x05, 0xdc, 0x00, 0x88, 0xaf, 0xba, 0x55, 0x89, 0x05, 0x23, 0x7c, 0xa4,
+	0x05, 0xd3, 0x6d, 0x6b, 0x7b, 0xc3, 0xb1, 0x44, 0x1e, 0x13, 0xc9, 0x65, 0x17, 0xa1, 0xc0, 0xa5,
+	0x72, 0xab, 0x1a, 0x2e, 0x4c, 0xe0, 0xce, 0x30, 0x06, 0x9d, 0x3a, 0x2a, 0x63, 0xd0, 0xf9, 0x28,
+	0x50, 0x88, 0x10, 0xe9, 0x3f, 0x94, 0x19, 0xa2, 0xb3, 0x67, 0x10, 0x90, 0xe7, 0xa2, 0x20, 0x20,
+	0xd3, 0xc5, 0xad, 0x17, 0x7b, 0x04, 0x00, 0xe9, 0xc2, 0x64, 0xc3, 0x0a, 0x2c, 0x51, 0xca, 0x64,
+	0xee, 0x85, 0xb5, 0xd3, 0x8b, 0x11, 0x9a, 0xf8, 0x48, 0x8a, 0xcb, 0x7c, 0x54, 0xe9, 0x30, 0x3f,
+	0x48, 0xb6, 0x59, 0x5b, 0x34, 0x88, 0xab, 0x70, 0x99, 0xd9, 0x29, 0xbe, 0x7f, 0xb8, 0x1f, 0xe4,
+	0x8d, 0xac, 0x0a, 0x98, 0xdd, 0x2e, 0x0e, 0x27, 0x3d, 0x93, 0x1d, 0x4e, 0x9a, 0x7c, 0x7b, 0x96,
+	0xd9, 0x0d, 0xb9, 0x6c, 0x14, 0xbd, 0x19, 0xc4, 0xd9, 0x50, 0xd8, 0xf8, 0xe6, 0x9f, 0x18, 0x50,
+	0x96, 0xab, 0x4c, 0x9a, 0xca, 0xb4, 0xa8, 0xb7, 0x6a, 0x39, 0x56, 0x93, 0x7a, 0xe5, 0xd3, 0xc5,
+	0xe3, 0x3a, 0xad, 0xe6, 0xe0, 0x8c, 0xa2, 0xb3, 0x3c, 0xba, 0xb7, 0x5b, 0xb9, 0xbc, 0x5f, 0x2d,
+	0xcc, 0xed, 0x1b, 0xf1, 0x60, 0xcc, 0xdf, 0xf1, 0xeb, 0x41, 0xcb, 0x97, 0x3c, 0xe8, 0xb5, 0x01,
+	0x4e, 0xd6, 0x9a, 0xc0, 0x24, 0x8e, 0xd6, 0x38, 0x3f, 0xb3, 0x28, 0xc5, 0x90, 0x10, 0x8b, 0xea,
+	0x32, 0x23, 0x95, 0x67, 0x4a, 0xf4, 0xab, 0xb3, 0xc5, 0xfd, 0x65, 0xaa, 0x49, 0x64, 0xa1, 0x79,
+	0x0c, 0x97, 0x26, 0xa5, 0xa0, 0x98, 0xa6, 0xce, 0x2e, 0xd5, 0x8e, 0x67, 0xbb, 0x1e, 0x53, 0xfa,
+	0x9d, 0xe3, 0x87, 0xa7, 0x4c, 0x22, 0x21, 0xca, 0x30, 0x82, 0x92, 0x1a, 0x4c, 0x0b, 0xa9, 0x4d,
+	0x2d, 0xf0, 0xac, 0x80, 0x36, 0x77, 0xa4, 0xb5, 0xd0, 0x6b, 0xd8, 0x09, 0xb2, 0xa1, 0x41, 0x1e,
+	0xec, 0x56, 0xce, 0xca, 0xb9, 0xd1, 0x01, 0x98, 0x40, 0x41, 0x3e, 0x98, 0x30, 0xde, 0x2a, 0x17,
+	0xcf, 0x71, 0x2c, 0xe6, 0xe2, 0x20, 0x26, 0x5c, 0x83, 0x06, 0x74, 0x1c, 0x20, 0x0d, 0xd3, 0xec,
+	0x93, 0x30, 0xa5, 0xae, 0x9a, 0x83, 0xb4, 0x35, 0x03, 0x20, 0xe9, 0x8f, 0x3d, 0xea, 0x50, 0x5b,
+	0xe6, 0x8f, 0x1b, 0x70, 0x2a, 0xc9, 0xbb, 0x90, 0x2d, 0x18, 0x93, 0x07, 0x59, 0xd9, 0x28, 0x6e,
+	0x8b, 0x20, 0x8f, 0x48, 0x19, 0xf3, 0x9b, 0xb3, 0xc2, 0xb2, 0x08, 0x43, 0xf4, 0xaa, 0x47, 0x42,
+	0xa9, 0x87, 0x47, 0xc2, 0x53, 0x70, 0x2e, 0xfb, 0x48, 0x63, 0x0f, 0x09, 0x16, 0x92, 0xe4, 0xbe,
+	0x14, 0x5a, 0x47, 0x0f, 0x09, 0xfe, 0xe8, 0x42, 0x01, 0x33, 0x3f, 0x04, 0xc9, 0xfc, 0xaa, 0xe4,
+	0x03, 0x30, 0xe1, 0xfb, 0x5b, 0xc2, 0xce, 0xac, 0x6c, 0x0c, 0xa0, 0xea, 0x09, 0x53, 0x41, 0x89,
+	0xb7, 0x4f, 0xf4, 0x13, 0x63, 0xf4, 0x0b, 0xcf, 0x7e, 0xfe, 0x4b, 0x97, 0x5e, 0xf1, 0x3b, 0x5f,
+	0xba, 0xf4, 0x8a, 0x2f, 0x7e, 0xe9, 0xd2, 0x2b, 0x3e, 0xba, 0x77, 0xc9, 0xf8, 0xfc, 0xde, 0x25,
+	0xe3, 0x77, 0xf6, 0x2e, 0x19, 0x5f, 0xdc, 0xbb, 0x64, 0xfc, 0xe9, 0xde, 0x25, 0xe3, 0xbb, 0xfe,
+	0xfd, 0xa5, 0x57, 0xbc, 0xf7, 0x89, 0x98, 0xfa, 0x95, 0x90, 0x68, 0xfc, 0x0f, 0x53, 0xf0, 0x33,
+	0xea, 0xe1, 0xab, 0x95, 0x53, 0xff, 0x7f, 0x03, 0x00, 0xc4, 0xe6, 0x06, 0xbe, 0x28, 0x30, 0x01,
+	0x00,
 }
 
 func (m *APIServerLogging) Marshal() (dAtA []byte, err error) {
@@ -13394,6 +13498,22 @@ func (m *MaintenanceTimeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Weekdays) > 0 {
+		for iNdEx := len(m.Weekdays) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Weekdays[iNdEx])
+			copy(dAtA[i:], m.Weekdays[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Weekdays[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Location != nil {
+		i -= len(*m.Location)
+		copy(dAtA[i:], *m.Location)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Location)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	i -= len(m.End)
 	copy(dAtA[i:], m.End)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.End)))
@@ -14899,6 +15019,16 @@ func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -14969,6 +15099,63 @@ func (m *QuotaList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuotaProjectStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaProjectStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaProjectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Used) > 0 {
+		keysForUsed := make([]string, 0, len(m.Used))
+		for k := range m.Used {
+			keysForUsed = append(keysForUsed, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+		for iNdEx := len(keysForUsed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Used[k8s_io_api_core_v1.ResourceName(keysForUsed[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForUsed[iNdEx])
+			copy(dAtA[i:], keysForUsed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QuotaSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15036,6 +15223,104 @@ func (m *QuotaSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuotaStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projects) > 0 {
+		for iNdEx := len(m.Projects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Used) > 0 {
+		keysForUsed := make([]string, 0, len(m.Used))
+		for k := range m.Used {
+			keysForUsed = append(keysForUsed, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+		for iNdEx := len(keysForUsed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Used[k8s_io_api_core_v1.ResourceName(keysForUsed[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForUsed[iNdEx])
+			copy(dAtA[i:], keysForUsed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Hard) > 0 {
+		keysForHard := make([]string, 0, len(m.Hard))
+		for k := range m.Hard {
+			keysForHard = append(keysForHard, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+		for iNdEx := len(keysForHard) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hard[k8s_io_api_core_v1.ResourceName(keysForHard[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHard[iNdEx])
+			copy(dAtA[i:], keysForHard[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHard[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Region) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -16657,6 +16942,35 @@ func (m *SeedStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Usage) > 0 {
+		keysForUsage := make([]string, 0, len(m.Usage))
+		for k := range m.Usage {
+			keysForUsage = append(keysForUsage, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsage)
+		for iNdEx := len(keysForUsage) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Usage[k8s_io_api_core_v1.ResourceName(keysForUsage[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForUsage[iNdEx])
+			copy(dAtA[i:], keysForUsage[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsage[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.LastOperation != nil {
 		{
 			size, err := m.LastOperation.MarshalToSizedBuffer(dAtA[:i])
@@ -17182,6 +17496,65 @@ func (m *ShootAdvertisedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ShootCertificatesSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShootCertificatesSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootCertificatesSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdateTime != nil {
+		{
+			size, err := m.LastUpdateTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Expiring) > 0 {
+		for iNdEx := len(m.Expiring) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Expiring[iNdEx])
+			copy(dAtA[i:], m.Expiring[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expiring[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EarliestExpirationTime != nil {
+		{
+			size, err := m.EarliestExpirationTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Total))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *ShootCredentials) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -17202,6 +17575,18 @@ func (m *ShootCredentials) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Certificates != nil {
+		{
+			size, err := m.Certificates.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.EncryptionAtRest != nil {
 		{
 			size, err := m.EncryptionAtRest.MarshalToSizedBuffer(dAtA[:i])
@@ -21710,6 +22095,16 @@ func (m *MaintenanceTimeWindow) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.End)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Location != nil {
+		l = len(*m.Location)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Weekdays) > 0 {
+		for _, s := range m.Weekdays {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -22310,6 +22705,8 @@ func (m *Quota) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -22330,6 +22727,26 @@ func (m *QuotaList) Size() (n int) {
 	return n
 }
 
+func (m *QuotaProjectStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Used) > 0 {
+		for k, v := range m.Used {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *QuotaSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22353,6 +22770,40 @@ func (m *QuotaSpec) Size() (n int) {
 	return n
 }
 
+func (m *QuotaStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	if len(m.Hard) > 0 {
+		for k, v := range m.Hard {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Used) > 0 {
+		for k, v := range m.Used {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Projects) > 0 {
+		for _, e := range m.Projects {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Region) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22985,6 +23436,15 @@ func (m *SeedStatus) Size() (n int) {
 		l = m.LastOperation.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Usage) > 0 {
+		for k, v := range m.Usage {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -23135,6 +23595,30 @@ func (m *ShootAdvertisedAddress) Size() (n int) {
 	return n
 }
 
+func (m *ShootCertificatesSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Total))
+	if m.EarliestExpirationTime != nil {
+		l = m.EarliestExpirationTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Expiring) > 0 {
+		for _, s := range m.Expiring {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.LastUpdateTime != nil {
+		l = m.LastUpdateTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ShootCredentials) Size() (n int) {
 	if m == nil {
 		return 0
@@ -23149,6 +23633,10 @@ func (m *ShootCredentials) Size() (n int) {
 		l = m.EncryptionAtRest.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Certificates != nil {
+		l = m.Certificates.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -25529,6 +26017,8 @@ func (this *MaintenanceTimeWindow) String() string {
 	s := strings.Join([]string{`&MaintenanceTimeWindow{`,
 		`Begin:` + fmt.Sprintf("%v", this.Begin) + `,`,
 		`End:` + fmt.Sprintf("%v", this.End) + `,`,
+		`Location:` + valueToStringGenerated(this.Location) + `,`,
+		`Weekdays:` + fmt.Sprintf("%v", this.Weekdays) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25939,6 +26429,7 @@ func (this *Quota) String() string {
 	s := strings.Join([]string{`&Quota{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "QuotaSpec", "QuotaSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "QuotaStatus", "QuotaStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25959,6 +26450,27 @@ func (this *QuotaList) String() string {
 	}, "")
 	return s
 }
+func (this *QuotaProjectStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForUsed := make([]string, 0, len(this.Used))
+	for k := range this.Used {
+		keysForUsed = append(keysForUsed, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+	mapStringForUsed := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForUsed {
+		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForUsed += "}"
+	s := strings.Join([]string{`&QuotaProjectStatus{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Used:` + mapStringForUsed + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *QuotaSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -25981,6 +26493,44 @@ func (this *QuotaSpec) String() string {
 	}, "")
 	return s
 }
+func (this *QuotaStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForProjects := "[]QuotaProjectStatus{"
+	for _, f := range this.Projects {
+		repeatedStringForProjects += strings.Replace(strings.Replace(f.String(), "QuotaProjectStatus", "QuotaProjectStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForProjects += "}"
+	keysForHard := make([]string, 0, len(this.Hard))
+	for k := range this.Hard {
+		keysForHard = append(keysForHard, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+	mapStringForHard := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForHard {
+		mapStringForHard += fmt.Sprintf("%v: %v,", k, this.Hard[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForHard += "}"
+	keysForUsed := make([]string, 0, len(this.Used))
+	for k := range this.Used {
+		keysForUsed = append(keysForUsed, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+	mapStringForUsed := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForUsed {
+		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForUsed += "}"
+	s := strings.Join([]string{`&QuotaStatus{`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`Hard:` + mapStringForHard + `,`,
+		`Used:` + mapStringForUsed + `,`,
+		`Projects:` + repeatedStringForProjects + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Region) String() string {
 	if this == nil {
 		return "nil"
@@ -26482,6 +27032,16 @@ func (this *SeedStatus) String() string {
 		mapStringForAllocatable += fmt.Sprintf("%v: %v,", k, this.Allocatable[k8s_io_api_core_v1.ResourceName(k)])
 	}
 	mapStringForAllocatable += "}"
+	keysForUsage := make([]string, 0, len(this.Usage))
+	for k := range this.Usage {
+		keysForUsage = append(keysForUsage, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsage)
+	mapStringForUsage := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForUsage {
+		mapStringForUsage += fmt.Sprintf("%v: %v,", k, this.Usage[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForUsage += "}"
 	s := strings.Join([]string{`&SeedStatus{`,
 		`Gardener:` + strings.Replace(this.Gardener.String(), "Gardener", "Gardener", 1) + `,`,
 		`KubernetesVersion:` + valueToStringGenerated(this.KubernetesVersion) + `,`,
@@ -26492,6 +27052,7 @@ func (this *SeedStatus) String() string {
 		`Allocatable:` + mapStringForAllocatable + `,`,
 		`ClientCertificateExpirationTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ClientCertificateExpirationTimestamp), "Time", "v11.Time", 1) + `,`,
 		`LastOperation:` + strings.Replace(this.LastOperation.String(), "LastOperation", "LastOperation", 1) + `,`,
+		`Usage:` + mapStringForUsage + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26601,6 +27162,19 @@ func (this *ShootAdvertisedAddress) String() string {
 	}, "")
 	return s
 }
+func (this *ShootCertificatesSummary) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShootCertificatesSummary{`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`EarliestExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.EarliestExpirationTime), "Time", "v11.Time", 1) + `,`,
+		`Expiring:` + fmt.Sprintf("%v", this.Expiring) + `,`,
+		`LastUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v11.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ShootCredentials) String() string {
 	if this == nil {
 		return "nil"
@@ -26608,6 +27182,7 @@ func (this *ShootCredentials) String() string {
 	s := strings.Join([]string{`&ShootCredentials{`,
 		`Rotation:` + strings.Replace(this.Rotation.String(), "ShootCredentialsRotation", "ShootCredentialsRotation", 1) + `,`,
 		`EncryptionAtRest:` + strings.Replace(this.EncryptionAtRest.String(), "EncryptionAtRest", "EncryptionAtRest", 1) + `,`,
+		`Certificates:` + strings.Replace(this.Certificates.String(), "ShootCertificatesSummary", "ShootCertificatesSummary", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -46038,61 +46613,44 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 			}
 			m.End = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ManualWorkerPoolRollout) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ManualWorkerPoolRollout: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManualWorkerPoolRollout: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			s := string(dAtA[iNdEx:postIndex])
+			m.Location = &s
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingWorkersRollouts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weekdays", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46102,25 +46660,107 @@ func (m *ManualWorkerPoolRollout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingWorkersRollouts = append(m.PendingWorkersRollouts, PendingWorkersRollout{})
-			if err := m.PendingWorkersRollouts[len(m.PendingWorkersRollouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Weekdays = append(m.Weekdays, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManualWorkerPoolRollout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManualWorkerPoolRollout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManualWorkerPoolRollout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWorkersRollouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingWorkersRollouts = append(m.PendingWorkersRollouts, PendingWorkersRollout{})
+			if err := m.PendingWorkersRollouts[len(m.PendingWorkersRollouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -50599,6 +51239,39 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -50737,6 +51410,217 @@ func (m *QuotaList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuotaProjectStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaProjectStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Used == nil {
+				m.Used = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Used[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -50913,11 +51797,242 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Metrics[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			m.Metrics[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hard == nil {
+				m.Hard = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Hard[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -50944,7 +52059,137 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Used == nil {
+				m.Used = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Used[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projects = append(m.Projects, QuotaProjectStatus{})
+			if err := m.Projects[len(m.Projects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -56077,11 +57322,140 @@ func (m *SeedStatus) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Capacity[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			m.Capacity[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocatable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allocatable == nil {
+				m.Allocatable = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Allocatable[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allocatable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertificateExpirationTimestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -56108,8 +57482,80 @@ func (m *SeedStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allocatable == nil {
-				m.Allocatable = make(k8s_io_api_core_v1.ResourceList)
+			if m.ClientCertificateExpirationTimestamp == nil {
+				m.ClientCertificateExpirationTimestamp = &v11.Time{}
+			}
+			if err := m.ClientCertificateExpirationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastOperation == nil {
+				m.LastOperation = &LastOperation{}
+			}
+			if err := m.LastOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = make(k8s_io_api_core_v1.ResourceList)
 			}
 			var mapkey k8s_io_api_core_v1.ResourceName
 			mapvalue := &resource.Quantity{}
@@ -56206,79 +57652,7 @@ func (m *SeedStatus) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Allocatable[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertificateExpirationTimestamp", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ClientCertificateExpirationTimestamp == nil {
-				m.ClientCertificateExpirationTimestamp = &v11.Time{}
-			}
-			if err := m.ClientCertificateExpirationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastOperation == nil {
-				m.LastOperation = &LastOperation{}
-			}
-			if err := m.LastOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Usage[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -57028,16 +58402,124 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
+			if m.LastCompletionTime == nil {
+				m.LastCompletionTime = &v11.Time{}
+			}
+			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastInitiationTime == nil {
+				m.LastInitiationTime = &v11.Time{}
+			}
+			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationFinishedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastInitiationFinishedTime == nil {
+				m.LastInitiationFinishedTime = &v11.Time{}
+			}
+			if err := m.LastInitiationFinishedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTriggeredTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCompletionTriggeredTime == nil {
+				m.LastCompletionTriggeredTime = &v11.Time{}
 			}
-			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastCompletionTriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWorkersRollouts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -57064,16 +58546,64 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
-			}
-			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingWorkersRollouts = append(m.PendingWorkersRollouts, PendingWorkersRollout{})
+			if err := m.PendingWorkersRollouts[len(m.PendingWorkersRollouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Shoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Shoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Shoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationFinishedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -57100,16 +58630,13 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastInitiationFinishedTime == nil {
-				m.LastInitiationFinishedTime = &v11.Time{}
-			}
-			if err := m.LastInitiationFinishedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTriggeredTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -57136,16 +58663,13 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionTriggeredTime == nil {
-				m.LastCompletionTriggeredTime = &v11.Time{}
-			}
-			if err := m.LastCompletionTriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingWorkersRollouts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -57172,8 +58696,7 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingWorkersRollouts = append(m.PendingWorkersRollouts, PendingWorkersRollout{})
-			if err := m.PendingWorkersRollouts[len(m.PendingWorkersRollouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -57198,7 +58721,7 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Shoot) Unmarshal(dAtA []byte) error {
+func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -57221,17 +58744,17 @@ func (m *Shoot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Shoot: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootAdvertisedAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Shoot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootAdvertisedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -57241,63 +58764,29 @@ func (m *Shoot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -57307,24 +58796,23 @@ func (m *Shoot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -57347,7 +58835,7 @@ func (m *Shoot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
+func (m *ShootCertificatesSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -57370,17 +58858,36 @@ func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShootAdvertisedAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootCertificatesSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShootAdvertisedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootCertificatesSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestExpirationTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -57390,27 +58897,31 @@ func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.EarliestExpirationTime == nil {
+				m.EarliestExpirationTime = &v11.Time{}
+			}
+			if err := m.EarliestExpirationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiring", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -57438,7 +58949,43 @@ func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Expiring = append(m.Expiring, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpdateTime == nil {
+				m.LastUpdateTime = &v11.Time{}
+			}
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -57562,6 +59109,42 @@ func (m *ShootCredentials) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Certificates == nil {
+				m.Certificates = &ShootCertificatesSummary{}
+			}
+			if err := m.Certificates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19,6 +19,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
 
@@ -464,6 +465,19 @@ func ShootConfinesSpecUpdateRollout(maintenance *gardencorev1beta1.Maintenance)
 	return maintenance != nil && maintenance.ConfineSpecUpdateRollout != nil && *maintenance.ConfineSpecUpdateRollout
 }
 
+// MaintenanceTimeWindowParseOptions returns the options for parsing the given maintenance time window, i.e., its
+// location and weekdays.
+func MaintenanceTimeWindowParseOptions(timeWindow *gardencorev1beta1.MaintenanceTimeWindow) []timewindow.ParseOption {
+	if timeWindow == nil {
+		return nil
+	}
+
+	return []timewindow.ParseOption{
+		timewindow.InLocation(ptr.Deref(timeWindow.Location, "")),
+		timewindow.OnWeekdays(timeWindow.Weekdays...),
+	}
+}
+
 // KubernetesDashboardEnabled returns true if the kubernetes-dashboard addon is enabled in the Shoot manifest.
 func KubernetesDashboardEnabled(addons *gardencorev1beta1.Addons) bool {
 	return addons != nil && addons.KubernetesDashboard != nil && addons.KubernetesDashboard.Enabled
@@ -22,6 +22,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	. "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
 )
 
 var _ = Describe("Helper", func() {
@@ -1151,6 +1152,26 @@ var _ = Describe("Helper", func() {
 		),
 	)
 
+	Describe("#MaintenanceTimeWindowParseOptions", func() {
+		It("should return no options if the time window is nil", func() {
+			Expect(MaintenanceTimeWindowParseOptions(nil)).To(BeEmpty())
+		})
+
+		It("should return options which apply the location and the weekdays", func() {
+			timeWindow := &gardencorev1beta1.MaintenanceTimeWindow{
+				Begin:    "220000+0000",
+				End:      "230000+0000",
+				Location: ptr.To("Europe/Berlin"),
+				Weekdays: []string{"Thu", "Tue"},
+			}
+
+			window, err := timewindow.ParseMaintenanceTimeWindow(timeWindow.Begin, timeWindow.End, MaintenanceTimeWindowParseOptions(timeWindow)...)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(window.Location().String()).To(Equal("Europe/Berlin"))
+			Expect(window.Weekdays()).To(Equal([]time.Weekday{time.Tuesday, time.Thursday}))
+		})
+	})
+
 	DescribeTable("#KubernetesDashboardEnabled",
 		func(addons *gardencorev1beta1.Addons, matcher gomegatypes.GomegaMatcher) {
 			Expect(KubernetesDashboardEnabled(addons)).To(matcher)
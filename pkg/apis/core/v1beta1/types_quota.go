@@ -22,6 +22,9 @@ type Quota struct {
 	// Spec defines the Quota constraints.
 	// +optional
 	Spec QuotaSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the current usage of the Quota.
+	// +optional
+	Status QuotaStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -47,3 +50,30 @@ type QuotaSpec struct {
 	// Scope is the scope of the Quota object, either 'project', 'secret' or 'workloadidentity'. This field is immutable.
 	Scope corev1.ObjectReference `json:"scope" protobuf:"bytes,3,opt,name=scope"` // TODO: When graduating the API to v1 consider reworking this field as described in https://github.com/gardener/gardener/issues/9773#issuecomment-2293340267
 }
+
+// QuotaStatus is the status of a Quota.
+type QuotaStatus struct {
+	// ObservedGeneration is the most recent generation observed for this Quota.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,1,opt,name=observedGeneration"`
+	// Hard is the set of enforced constraints, i.e., the metrics of the Quota spec.
+	// +optional
+	Hard corev1.ResourceList `json:"hard,omitempty" protobuf:"bytes,2,rep,name=hard,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// Used is the amount of resources currently allocated by all Shoots referring to the Quota. For Quotas with scope
+	// 'project', the usage is enforced per project, see Projects.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty" protobuf:"bytes,3,rep,name=used,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// Projects contains the usage per project namespace. It is only maintained for Quotas with scope 'project'.
+	// +optional
+	// +listType=atomic
+	Projects []QuotaProjectStatus `json:"projects,omitempty" protobuf:"bytes,4,rep,name=projects"`
+}
+
+// QuotaProjectStatus contains the usage of a Quota in a project namespace.
+type QuotaProjectStatus struct {
+	// Namespace is the namespace of the project.
+	Namespace string `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	// Used is the amount of resources currently allocated by the Shoots in this project namespace.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty" protobuf:"bytes,2,rep,name=used,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+}
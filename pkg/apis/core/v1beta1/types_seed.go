@@ -125,6 +125,10 @@ type SeedStatus struct {
 	// LastOperation holds information about the last operation on the Seed.
 	// +optional
 	LastOperation *LastOperation `json:"lastOperation,omitempty" protobuf:"bytes,9,opt,name=lastOperation"`
+	// Usage represents the resources of a seed that are used by shoot control planes, i.e., the aggregated resource
+	// requests of the pods in the shoot namespaces.
+	// +optional
+	Usage corev1.ResourceList `json:"usage,omitempty" protobuf:"bytes,10,rep,name=usage"`
 }
 
 // Backup contains the object store configuration for backups for shoot (currently only etcd).
@@ -286,6 +286,25 @@ type ShootCredentials struct {
 	// EncryptionAtRest contains information about Shoot data encryption at rest.
 	// +optional
 	EncryptionAtRest *EncryptionAtRest `json:"encryptionAtRest,omitempty" protobuf:"bytes,2,opt,name=encryptionAtRest"`
+	// Certificates contains a summary of the certificates managed for the Shoot cluster.
+	// +optional
+	Certificates *ShootCertificatesSummary `json:"certificates,omitempty" protobuf:"bytes,3,opt,name=certificates"`
+}
+
+// ShootCertificatesSummary contains a summary of the certificates managed for the Shoot cluster.
+type ShootCertificatesSummary struct {
+	// Total is the number of certificates managed for the Shoot cluster.
+	Total int32 `json:"total" protobuf:"varint,1,opt,name=total"`
+	// EarliestExpirationTime is the expiration time of the certificate which expires first.
+	// +optional
+	EarliestExpirationTime *metav1.Time `json:"earliestExpirationTime,omitempty" protobuf:"bytes,2,opt,name=earliestExpirationTime"`
+	// Expiring contains the names of the certificates which expire soon while no rotation is scheduled for them.
+	// +optional
+	// +listType=atomic
+	Expiring []string `json:"expiring,omitempty" protobuf:"bytes,3,rep,name=expiring"`
+	// LastUpdateTime is the time when the summary was last updated.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty" protobuf:"bytes,4,opt,name=lastUpdateTime"`
 }
 
 // ShootCredentialsRotation contains information about the rotation of credentials.
@@ -1641,6 +1660,16 @@ type MaintenanceTimeWindow struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Pattern=`([0-1][0-9]|2[0-3])[0-5][0-9][0-5][0-9]\+[0-1][0-4]00`
 	End string `json:"end" protobuf:"bytes,2,opt,name=end"`
+	// Location is the IANA time zone name in which the time window is evaluated, e.g. "Europe/Berlin". If set, Begin and
+	// End are wall clock times in this location and must use the zone offset "+0000". The time window then follows the
+	// daylight saving time transitions of the location.
+	// +optional
+	Location *string `json:"location,omitempty" protobuf:"bytes,3,opt,name=location"`
+	// Weekdays are the days of the week on which the time window begins, e.g. ["Tue", "Thu"]. Valid values are "Mon",
+	// "Tue", "Wed", "Thu", "Fri", "Sat", and "Sun". If not set, the time window begins on every day.
+	// +optional
+	// +listType=atomic
+	Weekdays []string `json:"weekdays,omitempty" protobuf:"bytes,4,rep,name=weekdays"`
 }
 
 // Monitoring contains information about the monitoring configuration for the shoot.
@@ -2059,6 +2088,9 @@ const (
 	// ShootCACertificateValiditiesAcceptable is a constant for a condition type indicating that the validities of all
 	// CA certificates is long enough.
 	ShootCACertificateValiditiesAcceptable ConditionType = "CACertificateValiditiesAcceptable"
+	// ShootCertificateExpirationsAcceptable is a constant for a condition type indicating that no certificate managed for
+	// the shoot is about to expire without a scheduled rotation.
+	ShootCertificateExpirationsAcceptable ConditionType = "CertificateExpirationsAcceptable"
 	// ShootCRDsWithProblematicConversionWebhooks is a constant for a condition type indicating that the Shoot cluster has
 	// CRDs with conversion webhooks and multiple stored versions which can break the reconciliation flow of the cluster.
 	ShootCRDsWithProblematicConversionWebhooks ConditionType = "CRDsWithProblematicConversionWebhooks"
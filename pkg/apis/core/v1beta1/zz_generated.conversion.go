@@ -1376,6 +1376,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QuotaProjectStatus)(nil), (*core.QuotaProjectStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QuotaProjectStatus_To_core_QuotaProjectStatus(a.(*QuotaProjectStatus), b.(*core.QuotaProjectStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.QuotaProjectStatus)(nil), (*QuotaProjectStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_QuotaProjectStatus_To_v1beta1_QuotaProjectStatus(a.(*core.QuotaProjectStatus), b.(*QuotaProjectStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*QuotaSpec)(nil), (*core.QuotaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_QuotaSpec_To_core_QuotaSpec(a.(*QuotaSpec), b.(*core.QuotaSpec), scope)
 	}); err != nil {
@@ -1386,6 +1396,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QuotaStatus)(nil), (*core.QuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(a.(*QuotaStatus), b.(*core.QuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.QuotaStatus)(nil), (*QuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(a.(*core.QuotaStatus), b.(*QuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Region)(nil), (*core.Region)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Region_To_core_Region(a.(*Region), b.(*core.Region), scope)
 	}); err != nil {
@@ -1756,6 +1776,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootCertificatesSummary)(nil), (*core.ShootCertificatesSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootCertificatesSummary_To_core_ShootCertificatesSummary(a.(*ShootCertificatesSummary), b.(*core.ShootCertificatesSummary), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootCertificatesSummary)(nil), (*ShootCertificatesSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootCertificatesSummary_To_v1beta1_ShootCertificatesSummary(a.(*core.ShootCertificatesSummary), b.(*ShootCertificatesSummary), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootCredentials)(nil), (*core.ShootCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootCredentials_To_core_ShootCredentials(a.(*ShootCredentials), b.(*core.ShootCredentials), scope)
 	}); err != nil {
@@ -5022,6 +5052,8 @@ func Convert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in *cor
 func autoConvert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in *MaintenanceTimeWindow, out *core.MaintenanceTimeWindow, s conversion.Scope) error {
 	out.Begin = in.Begin
 	out.End = in.End
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.Weekdays = *(*[]string)(unsafe.Pointer(&in.Weekdays))
 	return nil
 }
 
@@ -5033,6 +5065,8 @@ func Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in *Mai
 func autoConvert_core_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in *core.MaintenanceTimeWindow, out *MaintenanceTimeWindow, s conversion.Scope) error {
 	out.Begin = in.Begin
 	out.End = in.End
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.Weekdays = *(*[]string)(unsafe.Pointer(&in.Weekdays))
 	return nil
 }
 
@@ -5741,6 +5775,9 @@ func autoConvert_v1beta1_Quota_To_core_Quota(in *Quota, out *core.Quota, s conve
 	if err := Convert_v1beta1_QuotaSpec_To_core_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5754,6 +5791,9 @@ func autoConvert_core_Quota_To_v1beta1_Quota(in *core.Quota, out *Quota, s conve
 	if err := Convert_core_QuotaSpec_To_v1beta1_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5784,6 +5824,28 @@ func Convert_core_QuotaList_To_v1beta1_QuotaList(in *core.QuotaList, out *QuotaL
 	return autoConvert_core_QuotaList_To_v1beta1_QuotaList(in, out, s)
 }
 
+func autoConvert_v1beta1_QuotaProjectStatus_To_core_QuotaProjectStatus(in *QuotaProjectStatus, out *core.QuotaProjectStatus, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_v1beta1_QuotaProjectStatus_To_core_QuotaProjectStatus is an autogenerated conversion function.
+func Convert_v1beta1_QuotaProjectStatus_To_core_QuotaProjectStatus(in *QuotaProjectStatus, out *core.QuotaProjectStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaProjectStatus_To_core_QuotaProjectStatus(in, out, s)
+}
+
+func autoConvert_core_QuotaProjectStatus_To_v1beta1_QuotaProjectStatus(in *core.QuotaProjectStatus, out *QuotaProjectStatus, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_core_QuotaProjectStatus_To_v1beta1_QuotaProjectStatus is an autogenerated conversion function.
+func Convert_core_QuotaProjectStatus_To_v1beta1_QuotaProjectStatus(in *core.QuotaProjectStatus, out *QuotaProjectStatus, s conversion.Scope) error {
+	return autoConvert_core_QuotaProjectStatus_To_v1beta1_QuotaProjectStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_QuotaSpec_To_core_QuotaSpec(in *QuotaSpec, out *core.QuotaSpec, s conversion.Scope) error {
 	out.ClusterLifetimeDays = (*int32)(unsafe.Pointer(in.ClusterLifetimeDays))
 	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
@@ -5808,6 +5870,32 @@ func Convert_core_QuotaSpec_To_v1beta1_QuotaSpec(in *core.QuotaSpec, out *QuotaS
 	return autoConvert_core_QuotaSpec_To_v1beta1_QuotaSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_QuotaStatus_To_core_QuotaStatus(in *QuotaStatus, out *core.QuotaStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Hard = *(*v1.ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	out.Projects = *(*[]core.QuotaProjectStatus)(unsafe.Pointer(&in.Projects))
+	return nil
+}
+
+// Convert_v1beta1_QuotaStatus_To_core_QuotaStatus is an autogenerated conversion function.
+func Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(in *QuotaStatus, out *core.QuotaStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaStatus_To_core_QuotaStatus(in, out, s)
+}
+
+func autoConvert_core_QuotaStatus_To_v1beta1_QuotaStatus(in *core.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Hard = *(*v1.ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	out.Projects = *(*[]QuotaProjectStatus)(unsafe.Pointer(&in.Projects))
+	return nil
+}
+
+// Convert_core_QuotaStatus_To_v1beta1_QuotaStatus is an autogenerated conversion function.
+func Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(in *core.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	return autoConvert_core_QuotaStatus_To_v1beta1_QuotaStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_Region_To_core_Region(in *Region, out *core.Region, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Zones = *(*[]core.AvailabilityZone)(unsafe.Pointer(&in.Zones))
@@ -6532,6 +6620,7 @@ func autoConvert_v1beta1_SeedStatus_To_core_SeedStatus(in *SeedStatus, out *core
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.Usage = *(*v1.ResourceList)(unsafe.Pointer(&in.Usage))
 	return nil
 }
 
@@ -6550,6 +6639,7 @@ func autoConvert_core_SeedStatus_To_v1beta1_SeedStatus(in *core.SeedStatus, out
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.Usage = *(*v1.ResourceList)(unsafe.Pointer(&in.Usage))
 	return nil
 }
 
@@ -6760,9 +6850,36 @@ func Convert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in *c
 	return autoConvert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootCertificatesSummary_To_core_ShootCertificatesSummary(in *ShootCertificatesSummary, out *core.ShootCertificatesSummary, s conversion.Scope) error {
+	out.Total = in.Total
+	out.EarliestExpirationTime = (*metav1.Time)(unsafe.Pointer(in.EarliestExpirationTime))
+	out.Expiring = *(*[]string)(unsafe.Pointer(&in.Expiring))
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_v1beta1_ShootCertificatesSummary_To_core_ShootCertificatesSummary is an autogenerated conversion function.
+func Convert_v1beta1_ShootCertificatesSummary_To_core_ShootCertificatesSummary(in *ShootCertificatesSummary, out *core.ShootCertificatesSummary, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootCertificatesSummary_To_core_ShootCertificatesSummary(in, out, s)
+}
+
+func autoConvert_core_ShootCertificatesSummary_To_v1beta1_ShootCertificatesSummary(in *core.ShootCertificatesSummary, out *ShootCertificatesSummary, s conversion.Scope) error {
+	out.Total = in.Total
+	out.EarliestExpirationTime = (*metav1.Time)(unsafe.Pointer(in.EarliestExpirationTime))
+	out.Expiring = *(*[]string)(unsafe.Pointer(&in.Expiring))
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_core_ShootCertificatesSummary_To_v1beta1_ShootCertificatesSummary is an autogenerated conversion function.
+func Convert_core_ShootCertificatesSummary_To_v1beta1_ShootCertificatesSummary(in *core.ShootCertificatesSummary, out *ShootCertificatesSummary, s conversion.Scope) error {
+	return autoConvert_core_ShootCertificatesSummary_To_v1beta1_ShootCertificatesSummary(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootCredentials_To_core_ShootCredentials(in *ShootCredentials, out *core.ShootCredentials, s conversion.Scope) error {
 	out.Rotation = (*core.ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
 	out.EncryptionAtRest = (*core.EncryptionAtRest)(unsafe.Pointer(in.EncryptionAtRest))
+	out.Certificates = (*core.ShootCertificatesSummary)(unsafe.Pointer(in.Certificates))
 	return nil
 }
 
@@ -6774,6 +6891,7 @@ func Convert_v1beta1_ShootCredentials_To_core_ShootCredentials(in *ShootCredenti
 func autoConvert_core_ShootCredentials_To_v1beta1_ShootCredentials(in *core.ShootCredentials, out *ShootCredentials, s conversion.Scope) error {
 	out.Rotation = (*ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
 	out.EncryptionAtRest = (*EncryptionAtRest)(unsafe.Pointer(in.EncryptionAtRest))
+	out.Certificates = (*ShootCertificatesSummary)(unsafe.Pointer(in.Certificates))
 	return nil
 }
 
@@ -9,6 +9,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -34,6 +35,44 @@ func ValidateQuotaUpdate(newQuota, oldQuota *core.Quota) field.ErrorList {
 	return allErrs
 }
 
+// ValidateQuotaStatusUpdate validates the status field of a Quota object.
+func ValidateQuotaStatusUpdate(newStatus, _ core.QuotaStatus) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		fldPath = field.NewPath("status")
+	)
+
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(newStatus.ObservedGeneration, fldPath.Child("observedGeneration"))...)
+	allErrs = append(allErrs, validateQuotaResourceList(newStatus.Hard, fldPath.Child("hard"))...)
+	allErrs = append(allErrs, validateQuotaResourceList(newStatus.Used, fldPath.Child("used"))...)
+
+	namespaces := sets.New[string]()
+	for i, project := range newStatus.Projects {
+		idxPath := fldPath.Child("projects").Index(i)
+
+		if len(project.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("namespace"), "must provide a namespace"))
+		} else if namespaces.Has(project.Namespace) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("namespace"), project.Namespace))
+		}
+		namespaces.Insert(project.Namespace)
+
+		allErrs = append(allErrs, validateQuotaResourceList(project.Used, idxPath.Child("used"))...)
+	}
+
+	return allErrs
+}
+
+func validateQuotaResourceList(resources corev1.ResourceList, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for k, v := range resources {
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(k.String(), v, fldPath.Key(string(k)))...)
+	}
+
+	return allErrs
+}
+
 // ValidateQuotaSpec validates the specification of a Quota object.
 func ValidateQuotaSpec(quotaSpec *core.QuotaSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
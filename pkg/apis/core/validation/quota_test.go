@@ -152,4 +152,54 @@ var _ = Describe("Quota Validation Tests ", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 	})
+
+	Describe("#ValidateQuotaStatusUpdate", func() {
+		var status core.QuotaStatus
+
+		BeforeEach(func() {
+			status = core.QuotaStatus{
+				ObservedGeneration: 1,
+				Hard:               corev1.ResourceList{core.QuotaMetricCPU: resource.MustParse("10")},
+				Used:               corev1.ResourceList{core.QuotaMetricCPU: resource.MustParse("4")},
+				Projects: []core.QuotaProjectStatus{
+					{Namespace: "garden-foo", Used: corev1.ResourceList{core.QuotaMetricCPU: resource.MustParse("4")}},
+				},
+			}
+		})
+
+		It("should not return any errors", func() {
+			Expect(ValidateQuotaStatusUpdate(status, core.QuotaStatus{})).To(BeEmpty())
+		})
+
+		It("should forbid negative quantities", func() {
+			status.Used[core.QuotaMetricMemory] = resource.MustParse("-1Gi")
+			status.Projects[0].Used[core.QuotaMetricCPU] = resource.MustParse("-1")
+
+			Expect(ValidateQuotaStatusUpdate(status, core.QuotaStatus{})).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.used[memory]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.projects[0].used[cpu]"),
+				})),
+			))
+		})
+
+		It("should forbid empty and duplicate project namespaces", func() {
+			status.Projects = append(status.Projects, core.QuotaProjectStatus{Namespace: "garden-foo"}, core.QuotaProjectStatus{})
+
+			Expect(ValidateQuotaStatusUpdate(status, core.QuotaStatus{})).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("status.projects[1].namespace"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.projects[2].namespace"),
+				})),
+			))
+		})
+	})
 })
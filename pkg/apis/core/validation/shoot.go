@@ -157,6 +157,8 @@ var (
 	)
 	availableUpdateStrategies = sets.New(core.AutoRollingUpdate, core.AutoInPlaceUpdate, core.ManualInPlaceUpdate)
 
+	availableMaintenanceTimeWindowWeekdays = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
 	// asymmetric algorithms from https://datatracker.ietf.org/doc/html/rfc7518#section-3.1
 	availableOIDCSigningAlgs = sets.New(
 		"RS256",
@@ -1941,7 +1943,12 @@ func validateMaintenance(maintenance *core.Maintenance, fldPath *field.Path, wor
 	}
 
 	if maintenance.TimeWindow != nil {
-		maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End)
+		timeWindowErrs := ValidateMaintenanceTimeWindowLocationAndWeekdays(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End, maintenance.TimeWindow.Location, maintenance.TimeWindow.Weekdays, fldPath.Child("timeWindow"))
+		if len(timeWindowErrs) > 0 {
+			return append(allErrs, timeWindowErrs...)
+		}
+
+		maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End, timewindow.InLocation(ptr.Deref(maintenance.TimeWindow.Location, "")), timewindow.OnWeekdays(maintenance.TimeWindow.Weekdays...))
 		if err != nil {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow", "begin/end"), maintenance.TimeWindow, err.Error()))
 		} else {
@@ -1960,6 +1967,40 @@ func validateMaintenance(maintenance *core.Maintenance, fldPath *field.Path, wor
 	return allErrs
 }
 
+// ValidateMaintenanceTimeWindowLocationAndWeekdays validates the location and the weekdays of a maintenance time window.
+// If a location is set, the begin and end values must use the zone offset "+0000" since they are wall clock times in
+// this location.
+func ValidateMaintenanceTimeWindowLocationAndWeekdays(begin, end string, location *string, weekdays []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if location != nil {
+		if _, err := time.LoadLocation(*location); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("location"), *location, fmt.Sprintf("not a valid location: %v", err)))
+		}
+
+		if !strings.HasSuffix(begin, "+0000") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("begin"), begin, "must use the zone offset +0000 if a location is specified"))
+		}
+		if !strings.HasSuffix(end, "+0000") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("end"), end, "must use the zone offset +0000 if a location is specified"))
+		}
+	}
+
+	seenWeekdays := sets.New[string]()
+	for i, weekday := range weekdays {
+		idxPath := fldPath.Child("weekdays").Index(i)
+
+		if _, err := timewindow.ParseWeekdays(weekday); err != nil {
+			allErrs = append(allErrs, field.NotSupported(idxPath, weekday, availableMaintenanceTimeWindowWeekdays))
+		} else if seenWeekdays.Has(weekday) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, weekday))
+		}
+		seenWeekdays.Insert(weekday)
+	}
+
+	return allErrs
+}
+
 func validateProvider(shootNamespace string, provider core.Provider, kubernetes core.Kubernetes, networking *core.Networking, workerless bool, fldPath *field.Path, inTemplate bool) field.ErrorList {
 	var (
 		allErrs = field.ErrorList{}
@@ -4743,6 +4743,53 @@ var _ = Describe("Shoot Validation Tests", func() {
 					"Detail": ContainSubstring("this field should not be set for workerless Shoot cluster"),
 				}))))
 			})
+
+			It("should allow time windows with location and weekdays", func() {
+				shoot.Spec.Maintenance.TimeWindow.Begin = "020000+0000"
+				shoot.Spec.Maintenance.TimeWindow.End = "040000+0000"
+				shoot.Spec.Maintenance.TimeWindow.Location = ptr.To("Europe/Berlin")
+				shoot.Spec.Maintenance.TimeWindow.Weekdays = []string{"Tue", "Thu"}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid invalid locations and zone offsets other than +0000 if a location is set", func() {
+				shoot.Spec.Maintenance.TimeWindow.Begin = "020000+0100"
+				shoot.Spec.Maintenance.TimeWindow.End = "040000+0000"
+				shoot.Spec.Maintenance.TimeWindow.Location = ptr.To("Europe/Foo")
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.maintenance.timeWindow.location"),
+						"Detail": ContainSubstring("not a valid location"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.maintenance.timeWindow.begin"),
+						"Detail": Equal("must use the zone offset +0000 if a location is specified"),
+					})),
+				))
+			})
+
+			It("should forbid unknown and duplicate weekdays", func() {
+				shoot.Spec.Maintenance.TimeWindow.Weekdays = []string{"Tue", "Tuesday", "Tue"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.maintenance.timeWindow.weekdays[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.maintenance.timeWindow.weekdays[2]"),
+					})),
+				))
+			})
 		})
 
 		It("should forbid updating the spec for shoots with deletion timestamp", func() {
@@ -611,6 +611,11 @@ type GardenerSchedulerConfig struct {
 	// +kubebuilder:default=info
 	// +optional
 	LogLevel *string `json:"logLevel,omitempty"`
+	// EnablePreviewHandler enables the handler for previewing scheduling decisions. It is served via HTTPS on the
+	// `preview` port of the gardener-scheduler service and requires authentication and authorization.
+	// Defaults to false.
+	// +optional
+	EnablePreviewHandler *bool `json:"enablePreviewHandler,omitempty"`
 }
 
 // GardenerDashboardConfig contains configuration settings for the gardener-dashboard.
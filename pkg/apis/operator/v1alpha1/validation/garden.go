@@ -312,6 +312,9 @@ func validateVirtualCluster(dns *operatorv1alpha1.DNSManagement, virtualCluster
 		allErrs = append(allErrs, validateETCDAutoscaling(virtualCluster.ETCD.Events.Autoscaling, corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("60M")}, fldPath.Child("etcd", "events", "autoscaling"))...)
 	}
 
+	timeWindow := virtualCluster.Maintenance.TimeWindow
+	allErrs = append(allErrs, gardencorevalidation.ValidateMaintenanceTimeWindowLocationAndWeekdays(timeWindow.Begin, timeWindow.End, timeWindow.Location, timeWindow.Weekdays, fldPath.Child("maintenance", "timeWindow"))...)
+
 	if err := kubernetesversion.CheckIfSupported(virtualCluster.Kubernetes.Version); err != nil {
 		allErrs = append(allErrs, field.NotSupported(fldPath.Child("kubernetes", "version"), virtualCluster.Kubernetes.Version, kubernetesversion.SupportedVersions))
 	}
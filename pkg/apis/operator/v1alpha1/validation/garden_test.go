@@ -1502,6 +1502,39 @@ var _ = Describe("Validation Tests", func() {
 		})
 
 		Context("virtual cluster", func() {
+			Context("maintenance", func() {
+				It("should allow a time window with location and weekdays", func() {
+					garden.Spec.VirtualCluster.Maintenance.TimeWindow = gardencorev1beta1.MaintenanceTimeWindow{
+						Begin:    "220000+0000",
+						End:      "230000+0000",
+						Location: ptr.To("Europe/Berlin"),
+						Weekdays: []string{"Sat", "Sun"},
+					}
+
+					Expect(ValidateGarden(garden, extensions)).To(BeEmpty())
+				})
+
+				It("should complain about an invalid location and unknown weekdays", func() {
+					garden.Spec.VirtualCluster.Maintenance.TimeWindow = gardencorev1beta1.MaintenanceTimeWindow{
+						Begin:    "220000+0000",
+						End:      "230000+0000",
+						Location: ptr.To("Mars/Olympus_Mons"),
+						Weekdays: []string{"Caturday"},
+					}
+
+					Expect(ValidateGarden(garden, extensions)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.virtualCluster.maintenance.timeWindow.location"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.virtualCluster.maintenance.timeWindow.weekdays[0]"),
+						})),
+					))
+				})
+			})
+
 			Context("DNS", func() {
 				It("should complain about invalid domain name in 'domain'", func() {
 					garden.Spec.VirtualCluster.DNS.Domains = []operatorv1alpha1.DNSDomain{{Name: ",,,", Provider: ptr.To("primary")}}
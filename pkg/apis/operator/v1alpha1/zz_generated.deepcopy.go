@@ -1192,6 +1192,11 @@ func (in *GardenerSchedulerConfig) DeepCopyInto(out *GardenerSchedulerConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.EnablePreviewHandler != nil {
+		in, out := &in.EnablePreviewHandler, &out.EnablePreviewHandler
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1357,7 +1362,7 @@ func (in *Kubernetes) DeepCopy() *Kubernetes {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Maintenance) DeepCopyInto(out *Maintenance) {
 	*out = *in
-	out.TimeWindow = in.TimeWindow
+	in.TimeWindow.DeepCopyInto(&out.TimeWindow)
 	return
 }
 
@@ -1757,7 +1762,7 @@ func (in *VirtualCluster) DeepCopyInto(out *VirtualCluster) {
 	}
 	in.Gardener.DeepCopyInto(&out.Gardener)
 	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
-	out.Maintenance = in.Maintenance
+	in.Maintenance.DeepCopyInto(&out.Maintenance)
 	in.Networking.DeepCopyInto(&out.Networking)
 	return
 }
@@ -45,6 +45,13 @@ const (
 	// FinalizeDeletionAfter is an annotation on an object part of a ManagedResource that whose value states the
 	// duration after which a deletion should be finalized (i.e., removal of `.metadata.finalizers[]`).
 	FinalizeDeletionAfter = "resources.gardener.cloud/finalize-deletion-after"
+	// ApplyPhase is an annotation on an object part of a ManagedResource whose value is a non-negative integer stating
+	// the phase in which the object is applied. Objects of a phase are only applied once all objects of the previous
+	// phases are healthy. Objects without this annotation are applied in phase 0.
+	ApplyPhase = "resources.gardener.cloud/apply-phase"
+	// DryRun is an annotation on a ManagedResource. If set to true then the controller does not apply the resources
+	// but only computes which objects would be created, updated, or deleted, and stores the result in the status.
+	DryRun = "resources.gardener.cloud/dry-run"
 	// BrotliCompressionSuffix is the common suffix used for Brotli compression.
 	BrotliCompressionSuffix = ".br"
 	// CompressedDataKey is the name of a data key containing Brotli compressed YAML manifests.
@@ -257,8 +264,26 @@ type ManagedResourceSpec struct {
 	// resource, should also be deleted when the corresponding StatefulSet is deleted (defaults to false).
 	// +optional
 	DeletePersistentVolumeClaims *bool `json:"deletePersistentVolumeClaims,omitempty"`
+	// ApplyMode specifies how the resources are applied to the target cluster. With `Update` (default), the desired
+	// state is merged with the current state of the objects and then updated. With `ServerSideApply`, the resources are
+	// applied via server-side apply. Conflicting fields of other field managers are taken over and reported in the
+	// `ResourcesConflicting` condition.
+	// +kubebuilder:validation:Enum=Update;ServerSideApply
+	// +optional
+	ApplyMode *ApplyMode `json:"applyMode,omitempty"`
 }
 
+// ApplyMode is a mode for applying the resources of a ManagedResource.
+type ApplyMode string
+
+const (
+	// ApplyModeUpdate is the apply mode in which the desired state is merged with the current state of the objects which
+	// are then updated.
+	ApplyModeUpdate ApplyMode = "Update"
+	// ApplyModeServerSideApply is the apply mode in which the objects are applied via server-side apply.
+	ApplyModeServerSideApply ApplyMode = "ServerSideApply"
+)
+
 // ManagedResourceStatus is the status of a managed resource.
 type ManagedResourceStatus struct {
 	Conditions []gardencorev1beta1.Condition `json:"conditions,omitempty"`
@@ -270,6 +295,63 @@ type ManagedResourceStatus struct {
 	// SecretsDataChecksum is the checksum of referenced secrets data.
 	// +optional
 	SecretsDataChecksum *string `json:"secretsDataChecksum,omitempty"`
+	// DryRun is the result of the last reconciliation in dry-run mode (see annotation `resources.gardener.cloud/dry-run`).
+	// It is removed once the ManagedResource is reconciled without dry-run mode.
+	// +optional
+	DryRun *DryRunResult `json:"dryRun,omitempty"`
+	// DriftedObjects is a list of objects whose actual state in the target cluster differs from the desired state, e.g.,
+	// because they were changed by someone else than the gardener-resource-manager. It is only maintained if drift
+	// detection is enabled for the gardener-resource-manager.
+	// +optional
+	DriftedObjects []DriftedObject `json:"driftedObjects,omitempty"`
+}
+
+// DryRunResult is a summary of the changes which would be performed when applying the resources of a ManagedResource.
+type DryRunResult struct {
+	// LastUpdateTime is the time when the result was computed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+	// SecretsDataChecksum is the checksum of the referenced secrets data the result was computed for.
+	// +optional
+	SecretsDataChecksum *string `json:"secretsDataChecksum,omitempty"`
+	// Create is a list of objects which would be created.
+	// +optional
+	Create []DryRunObject `json:"create,omitempty"`
+	// Update is a list of objects which would be updated.
+	// +optional
+	Update []DryRunObject `json:"update,omitempty"`
+	// Delete is a list of objects which would be deleted.
+	// +optional
+	Delete []corev1.ObjectReference `json:"delete,omitempty"`
+}
+
+// DryRunObject describes the changes which would be performed for an object.
+type DryRunObject struct {
+	corev1.ObjectReference `json:",inline"`
+
+	// ChangedFields is a list of paths of the fields which would be changed by an update, e.g. `spec.replicas`.
+	// +optional
+	ChangedFields []string `json:"changedFields,omitempty"`
+	// Error is the error returned by the server-side dry-run request, if any.
+	// +optional
+	Error *string `json:"error,omitempty"`
+}
+
+// DriftedObject describes how the actual state of an object differs from its desired state.
+type DriftedObject struct {
+	corev1.ObjectReference `json:",inline"`
+
+	// DetectionTime is the time when the drift was detected first.
+	DetectionTime metav1.Time `json:"detectionTime"`
+	// Missing is true if the object was deleted from the target cluster.
+	// +optional
+	Missing bool `json:"missing,omitempty"`
+	// ChangedFields is a list of paths of the fields which differ from the desired state, e.g. `spec.replicas`.
+	// +optional
+	ChangedFields []string `json:"changedFields,omitempty"`
+	// Managers is a list of the field managers other than the gardener-resource-manager which manage fields of the
+	// object, i.e., the actors which potentially changed it.
+	// +optional
+	Managers []string `json:"managers,omitempty"`
 }
 
 // ObjectReference is a reference to another object.
@@ -289,6 +371,12 @@ const (
 	ResourcesHealthy gardencorev1beta1.ConditionType = "ResourcesHealthy"
 	// ResourcesProgressing is a condition type that indicates whether some resources are still progressing to be rolled out.
 	ResourcesProgressing gardencorev1beta1.ConditionType = "ResourcesProgressing"
+	// ResourcesConflicting is a condition type that indicates whether fields of the resources were changed by other field
+	// managers and have been taken over. It is only maintained for ManagedResources using the `ServerSideApply` apply mode.
+	ResourcesConflicting gardencorev1beta1.ConditionType = "ResourcesConflicting"
+	// ResourcesDrifted is a condition type that indicates whether the actual state of the resources differs from their
+	// desired state. It is only maintained if drift detection is enabled for the gardener-resource-manager.
+	ResourcesDrifted gardencorev1beta1.ConditionType = "ResourcesDrifted"
 )
 
 // These are well-known reasons for Conditions.
@@ -305,6 +393,9 @@ const (
 	// ConditionApplyProgressing indicates that the `ResourcesApplied` condition is `Progressing`,
 	// because the resources are currently being reconciled.
 	ConditionApplyProgressing = "ApplyProgressing"
+	// ConditionApplyPhasePending indicates that the `ResourcesApplied` condition is `Progressing`,
+	// because the objects of an apply phase are not yet healthy and the objects of the next phases are not yet applied.
+	ConditionApplyPhasePending = "ApplyPhasePending"
 	// ConditionDeletionFailed indicates that the `ResourcesApplied` condition is `False`,
 	// because deleting the resources failed.
 	ConditionDeletionFailed = "DeletionFailed"
@@ -317,6 +408,18 @@ const (
 	// ConditionManagedResourceIgnored indicates that the ManagedResource's conditions are not checked,
 	// because the ManagedResource is marked to be ignored.
 	ConditionManagedResourceIgnored = "ManagedResourceIgnored"
+	// ConditionFieldManagerConflict indicates that the `ResourcesConflicting` condition is `True`,
+	// because fields of some resources were changed by other field managers and have been taken over.
+	ConditionFieldManagerConflict = "FieldManagerConflict"
+	// ConditionNoConflicts indicates that the `ResourcesConflicting` condition is `False`,
+	// because all fields of the resources are owned by the gardener-resource-manager.
+	ConditionNoConflicts = "NoConflicts"
+	// ConditionObjectsDrifted indicates that the `ResourcesDrifted` condition is `True`,
+	// because the actual state of some resources differs from their desired state.
+	ConditionObjectsDrifted = "ObjectsDrifted"
+	// ConditionNoDrift indicates that the `ResourcesDrifted` condition is `False`,
+	// because the actual state of all resources matches their desired state.
+	ConditionNoDrift = "NoDrift"
 	// ConditionChecksPending indicates that the `ResourcesProgressing` condition is `Unknown`,
 	// because the condition checks have not been completely executed yet for the current set of resources.
 	ConditionChecksPending = "ChecksPending"
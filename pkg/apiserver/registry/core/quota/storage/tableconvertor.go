@@ -7,6 +7,8 @@ package storage
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metatable "k8s.io/apimachinery/pkg/api/meta/table"
@@ -16,6 +18,7 @@ import (
 	"k8s.io/apiserver/pkg/registry/rest"
 
 	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/core/helper"
 )
 
 var swaggerMetadataDescriptions = metav1.ObjectMeta{}.SwaggerDoc()
@@ -30,6 +33,7 @@ func newTableConvertor() rest.TableConvertor {
 			{Name: "Name", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["name"]},
 			{Name: "Scope", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["scope"]},
 			{Name: "Cluster Lifetime", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["clusterLifetimeDays"]},
+			{Name: "Usage", Type: "string", Description: "The used and the hard amount of each metric of the Quota. For Quotas with scope Project, only the hard amount per project is shown."},
 			{Name: "Age", Type: "date", Description: swaggerMetadataDescriptions["creationTimestamp"]},
 		},
 	}
@@ -66,6 +70,7 @@ func (c *convertor) ConvertToTable(_ context.Context, obj runtime.Object, _ runt
 		} else {
 			cells = append(cells, "<unspecified>")
 		}
+		cells = append(cells, usage(quota))
 		cells = append(cells, metatable.ConvertToHumanReadableDateType(quota.CreationTimestamp))
 
 		return cells, nil
@@ -73,3 +78,34 @@ func (c *convertor) ConvertToTable(_ context.Context, obj runtime.Object, _ runt
 
 	return table, err
 }
+
+func usage(quota *core.Quota) string {
+	if len(quota.Spec.Metrics) == 0 {
+		return "<none>"
+	}
+
+	var usage []string
+
+	// Quotas with scope project are enforced per project, so the usage summed over all projects must not be compared
+	// with the hard amount. The usage per project is available in the status.
+	if scope, err := helper.QuotaScope(quota.Spec.Scope); err == nil && scope == "project" {
+		for metric, hard := range quota.Spec.Metrics {
+			usage = append(usage, fmt.Sprintf("%s: %s per project", metric, hard.String()))
+		}
+		slices.Sort(usage)
+
+		return strings.Join(usage, ", ")
+	}
+
+	if quota.Status.Used == nil {
+		return "<unknown>"
+	}
+
+	for metric, hard := range quota.Spec.Metrics {
+		used := quota.Status.Used[metric]
+		usage = append(usage, fmt.Sprintf("%s: %s/%s", metric, used.String(), hard.String()))
+	}
+	slices.Sort(usage)
+
+	return strings.Join(usage, ", ")
+}
@@ -7,6 +7,7 @@ package quota
 import (
 	"context"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/storage/names"
@@ -28,7 +29,11 @@ func (quotaStrategy) NamespaceScoped() bool {
 	return true
 }
 
-func (quotaStrategy) PrepareForCreate(_ context.Context, _ runtime.Object) {
+func (quotaStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	quota := obj.(*core.Quota)
+
+	quota.Generation = 1
+	quota.Status = core.QuotaStatus{}
 }
 
 func (quotaStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
@@ -44,8 +49,27 @@ func (quotaStrategy) AllowCreateOnUpdate() bool {
 }
 
 func (quotaStrategy) PrepareForUpdate(_ context.Context, newObj, oldObj runtime.Object) {
-	_ = oldObj.(*core.Quota)
-	_ = newObj.(*core.Quota)
+	oldQuota := oldObj.(*core.Quota)
+	newQuota := newObj.(*core.Quota)
+	newQuota.Status = oldQuota.Status
+
+	if mustIncreaseGeneration(oldQuota, newQuota) {
+		newQuota.Generation = oldQuota.Generation + 1
+	}
+}
+
+func mustIncreaseGeneration(oldQuota, newQuota *core.Quota) bool {
+	// The specification changes.
+	if !apiequality.Semantic.DeepEqual(oldQuota.Spec, newQuota.Spec) {
+		return true
+	}
+
+	// The deletion timestamp was set.
+	if oldQuota.DeletionTimestamp == nil && newQuota.DeletionTimestamp != nil {
+		return true
+	}
+
+	return false
 }
 
 func (quotaStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
@@ -66,3 +90,20 @@ func (quotaStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []str
 func (quotaStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
 	return nil
 }
+
+type quotaStatusStrategy struct {
+	quotaStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of Quotas.
+var StatusStrategy = quotaStatusStrategy{Strategy}
+
+func (quotaStatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newQuota := obj.(*core.Quota)
+	oldQuota := old.(*core.Quota)
+	newQuota.Spec = oldQuota.Spec
+}
+
+func (quotaStatusStrategy) ValidateUpdate(_ context.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateQuotaStatusUpdate(obj.(*core.Quota).Status, old.(*core.Quota).Status)
+}
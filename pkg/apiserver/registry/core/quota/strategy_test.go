@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package quota_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	quotaregistry "github.com/gardener/gardener/pkg/apiserver/registry/core/quota"
+)
+
+var _ = Describe("Strategy", func() {
+	var (
+		ctx = context.TODO()
+
+		status = core.QuotaStatus{
+			ObservedGeneration: 1,
+			Used:               corev1.ResourceList{core.QuotaMetricCPU: resource.MustParse("2")},
+		}
+	)
+
+	Describe("#PrepareForCreate", func() {
+		It("should reset the status and set the generation", func() {
+			quota := &core.Quota{Status: status}
+
+			quotaregistry.Strategy.PrepareForCreate(ctx, quota)
+
+			Expect(quota.Generation).To(Equal(int64(1)))
+			Expect(quota.Status).To(Equal(core.QuotaStatus{}))
+		})
+	})
+
+	Describe("#PrepareForUpdate", func() {
+		var oldQuota, newQuota *core.Quota
+
+		BeforeEach(func() {
+			oldQuota = &core.Quota{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Status:     status,
+			}
+			newQuota = oldQuota.DeepCopy()
+			newQuota.Status = core.QuotaStatus{}
+		})
+
+		It("should keep the status and the generation if the spec is unchanged", func() {
+			quotaregistry.Strategy.PrepareForUpdate(ctx, newQuota, oldQuota)
+
+			Expect(newQuota.Generation).To(Equal(int64(1)))
+			Expect(newQuota.Status).To(Equal(status))
+		})
+
+		It("should increase the generation if the spec changes", func() {
+			newQuota.Spec.ClusterLifetimeDays = ptr.To[int32](7)
+
+			quotaregistry.Strategy.PrepareForUpdate(ctx, newQuota, oldQuota)
+
+			Expect(newQuota.Generation).To(Equal(int64(2)))
+			Expect(newQuota.Status).To(Equal(status))
+		})
+
+		It("should increase the generation if the deletion timestamp is set", func() {
+			newQuota.DeletionTimestamp = &metav1.Time{}
+
+			quotaregistry.Strategy.PrepareForUpdate(ctx, newQuota, oldQuota)
+
+			Expect(newQuota.Generation).To(Equal(int64(2)))
+		})
+	})
+
+	Describe("StatusStrategy", func() {
+		Describe("#PrepareForUpdate", func() {
+			It("should keep the spec", func() {
+				oldQuota := &core.Quota{Spec: core.QuotaSpec{ClusterLifetimeDays: ptr.To[int32](7)}}
+				newQuota := &core.Quota{Status: status}
+
+				quotaregistry.StatusStrategy.PrepareForUpdate(ctx, newQuota, oldQuota)
+
+				Expect(newQuota.Spec).To(Equal(oldQuota.Spec))
+				Expect(newQuota.Status).To(Equal(status))
+			})
+		})
+	})
+})
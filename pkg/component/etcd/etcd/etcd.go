@@ -801,10 +801,24 @@ func (e *etcd) reconcileVerticalPodAutoscaler(ctx context.Context, vpa *vpaautos
 			metav1.SetMetaDataLabel(&vpa.ObjectMeta, v1beta1constants.LabelVPAEvictionRequirementsController, v1beta1constants.EvictionRequirementManagedByController)
 			metav1.SetMetaDataAnnotation(&vpa.ObjectMeta, v1beta1constants.AnnotationVPAEvictionRequirementDownscaleRestriction, v1beta1constants.EvictionRequirementInMaintenanceWindowOnly)
 			metav1.SetMetaDataAnnotation(&vpa.ObjectMeta, v1beta1constants.AnnotationShootMaintenanceWindow, e.values.MaintenanceTimeWindow.Begin+","+e.values.MaintenanceTimeWindow.End)
+
+			if location := e.values.MaintenanceTimeWindow.Location; location != nil {
+				metav1.SetMetaDataAnnotation(&vpa.ObjectMeta, v1beta1constants.AnnotationShootMaintenanceWindowLocation, *location)
+			} else {
+				delete(vpa.GetAnnotations(), v1beta1constants.AnnotationShootMaintenanceWindowLocation)
+			}
+
+			if weekdays := e.values.MaintenanceTimeWindow.Weekdays; len(weekdays) > 0 {
+				metav1.SetMetaDataAnnotation(&vpa.ObjectMeta, v1beta1constants.AnnotationShootMaintenanceWindowWeekdays, strings.Join(weekdays, ","))
+			} else {
+				delete(vpa.GetAnnotations(), v1beta1constants.AnnotationShootMaintenanceWindowWeekdays)
+			}
 		} else {
 			delete(vpa.GetLabels(), v1beta1constants.LabelVPAEvictionRequirementsController)
 			delete(vpa.GetAnnotations(), v1beta1constants.AnnotationVPAEvictionRequirementDownscaleRestriction)
 			delete(vpa.GetLabels(), v1beta1constants.AnnotationShootMaintenanceWindow)
+			delete(vpa.GetAnnotations(), v1beta1constants.AnnotationShootMaintenanceWindowLocation)
+			delete(vpa.GetAnnotations(), v1beta1constants.AnnotationShootMaintenanceWindowWeekdays)
 		}
 
 		vpa.Spec = vpaautoscalingv1.VerticalPodAutoscalerSpec{
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -438,6 +439,12 @@ var _ = Describe("Etcd", func() {
 				metav1.SetMetaDataLabel(&vpa.ObjectMeta, v1beta1constants.LabelVPAEvictionRequirementsController, v1beta1constants.EvictionRequirementManagedByController)
 				metav1.SetMetaDataAnnotation(&vpa.ObjectMeta, v1beta1constants.AnnotationVPAEvictionRequirementDownscaleRestriction, v1beta1constants.EvictionRequirementInMaintenanceWindowOnly)
 				metav1.SetMetaDataAnnotation(&vpa.ObjectMeta, v1beta1constants.AnnotationShootMaintenanceWindow, maintenanceTimeWindow.Begin+","+maintenanceTimeWindow.End)
+				if maintenanceTimeWindow.Location != nil {
+					metav1.SetMetaDataAnnotation(&vpa.ObjectMeta, v1beta1constants.AnnotationShootMaintenanceWindowLocation, *maintenanceTimeWindow.Location)
+				}
+				if len(maintenanceTimeWindow.Weekdays) > 0 {
+					metav1.SetMetaDataAnnotation(&vpa.ObjectMeta, v1beta1constants.AnnotationShootMaintenanceWindowWeekdays, strings.Join(maintenanceTimeWindow.Weekdays, ","))
+				}
 			case v1beta1constants.EvictionRequirementNever:
 				metav1.SetMetaDataLabel(&vpa.ObjectMeta, v1beta1constants.LabelVPAEvictionRequirementsController, v1beta1constants.EvictionRequirementManagedByController)
 				metav1.SetMetaDataAnnotation(&vpa.ObjectMeta, v1beta1constants.AnnotationVPAEvictionRequirementDownscaleRestriction, v1beta1constants.EvictionRequirementNever)
@@ -1229,6 +1236,51 @@ var _ = Describe("Etcd", func() {
 			})
 		}
 
+		It("should successfully deploy (important etcd) with a maintenance time window in a time zone restricted to weekdays", func() {
+			oldTimeNow := TimeNow
+			defer func() { TimeNow = oldTimeNow }()
+			TimeNow = func() time.Time { return now }
+
+			oldMaintenanceTimeWindow := maintenanceTimeWindow
+			defer func() { maintenanceTimeWindow = oldMaintenanceTimeWindow }()
+			maintenanceTimeWindow.Location = ptr.To("Europe/Berlin")
+			maintenanceTimeWindow.Weekdays = []string{"Tue", "Thu"}
+
+			class := ClassImportant
+			evictionRequirement := v1beta1constants.EvictionRequirementInMaintenanceWindowOnly
+
+			etcd = New(log, c, testNamespace, sm, Values{
+				Role:                    testRole,
+				Class:                   class,
+				Replicas:                replicas,
+				StorageCapacity:         storageCapacity,
+				StorageClassName:        &storageClassName,
+				DefragmentationSchedule: &defragmentationSchedule,
+				CARotationPhase:         "",
+				MaintenanceTimeWindow:   maintenanceTimeWindow,
+				PriorityClassName:       priorityClassName,
+				EvictionRequirement:     ptr.To(evictionRequirement),
+			})
+
+			gomock.InOrder(
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidcorev1alpha1.Etcd{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidcorev1alpha1.Etcd{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&druidcorev1alpha1.Etcd{}), gomock.Any()),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: vpaName}, gomock.AssignableToTypeOf(&vpaautoscalingv1.VerticalPodAutoscaler{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+				c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&vpaautoscalingv1.VerticalPodAutoscaler{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ ...client.CreateOption) {
+					Expect(obj.GetAnnotations()).To(HaveKeyWithValue(v1beta1constants.AnnotationShootMaintenanceWindowLocation, "Europe/Berlin"))
+					Expect(obj.GetAnnotations()).To(HaveKeyWithValue(v1beta1constants.AnnotationShootMaintenanceWindowWeekdays, "Tue,Thu"))
+					Expect(obj).To(DeepEqual(expectedVPAFor(class, role, evictionRequirement, nil)))
+				}),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{}), gomock.Any()),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{}), gomock.Any()),
+			)
+
+			Expect(etcd.Deploy(ctx)).To(Succeed())
+		})
+
 		When("backup is configured", func() {
 			var backupConfig = &BackupConfig{
 				Provider:                     "prov",
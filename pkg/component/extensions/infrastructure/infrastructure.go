@@ -21,6 +21,7 @@ import (
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 const (
@@ -138,6 +139,9 @@ func (i *infrastructure) deploy(ctx context.Context, operation string) (extensio
 			// If that is the case health checks for the infrastructure will fail so we request a reconciliation to correct the current state.
 			metav1.SetMetaDataAnnotation(&i.infrastructure.ObjectMeta, v1beta1constants.GardenerOperation, operation)
 			metav1.SetMetaDataAnnotation(&i.infrastructure.ObjectMeta, v1beta1constants.GardenerTimestamp, TimeNow().UTC().Format(time.RFC3339Nano))
+			// The trace context is only injected together with the operation annotation, otherwise every deployment
+			// would patch the object and trigger a watch event for the extension.
+			tracing.InjectIntoObject(ctx, i.infrastructure)
 		}
 
 		i.infrastructure.Spec = extensionsv1alpha1.InfrastructureSpec{
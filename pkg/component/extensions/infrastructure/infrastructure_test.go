@@ -12,6 +12,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -180,6 +181,28 @@ var _ = Describe("#Interface", func() {
 			Expect(actual).To(DeepEqual(expected))
 		})
 
+		It("should inject the trace context only together with the operation annotation", func() {
+			defer test.WithVars(
+				&infrastructure.TimeNow, mockNow.Do,
+			)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(ctx, "test")
+			defer span.End()
+
+			Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+			actual := &extensionsv1alpha1.Infrastructure{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(expected), actual)).To(Succeed())
+			Expect(actual.Annotations).NotTo(HaveKey("gardener.cloud/traceparent"))
+
+			values.AnnotateOperation = true
+			Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(expected), actual)).To(Succeed())
+			Expect(actual.Annotations).To(HaveKey("gardener.cloud/traceparent"))
+		})
+
 		It("should deploy the Infrastructure with operation annotation if it is in error state", func() {
 			defer test.WithVars(
 				&infrastructure.TimeNow, mockNow.Do,
@@ -37,6 +37,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/features"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -156,6 +157,13 @@ type OriginalValues struct {
 	PrimaryIPFamily gardencorev1beta1.IPFamily
 	// KubeProxyConfig is the configuration for kube-proxy.
 	KubeProxyConfig *gardencorev1beta1.KubeProxyConfig
+	// NodeAgentHealthCheck is the configuration for the health check controller of gardener-node-agent.
+	NodeAgentHealthCheck *nodeagentconfigv1alpha1.HealthCheckControllerConfig
+	// NodeAgentRollback is the configuration for rolling back failed operating system configs in gardener-node-agent.
+	NodeAgentRollback *nodeagentconfigv1alpha1.OperatingSystemConfigRollbackConfig
+	// NodeAgentDriftDetection is the configuration for detecting drift of the files and units managed by
+	// gardener-node-agent.
+	NodeAgentDriftDetection *nodeagentconfigv1alpha1.DriftDetectionConfig
 }
 
 // New creates a new instance of Interface.
@@ -774,6 +782,9 @@ func (o *operatingSystemConfig) newDeployer(version int, osc *extensionsv1alpha1
 		nodeLocalDNSEnabled:                     o.values.NodeLocalDNSEnabled,
 		primaryIPFamily:                         o.values.PrimaryIPFamily,
 		taints:                                  taints,
+		nodeAgentHealthCheck:                    o.values.NodeAgentHealthCheck,
+		nodeAgentRollback:                       o.values.NodeAgentRollback,
+		nodeAgentDriftDetection:                 o.values.NodeAgentDriftDetection,
 		caRotationLastInitiationTime:            caRotationLastInitiationTime,
 		serviceAccountKeyRotationLastInitiationTime: serviceAccountKeyRotationLastInitiationTime,
 	}, nil
@@ -845,6 +856,9 @@ type deployer struct {
 	nodeMonitorGracePeriod                      metav1.Duration
 	primaryIPFamily                             gardencorev1beta1.IPFamily
 	taints                                      []corev1.Taint
+	nodeAgentHealthCheck                        *nodeagentconfigv1alpha1.HealthCheckControllerConfig
+	nodeAgentRollback                           *nodeagentconfigv1alpha1.OperatingSystemConfigRollbackConfig
+	nodeAgentDriftDetection                     *nodeagentconfigv1alpha1.DriftDetectionConfig
 	caRotationLastInitiationTime                *metav1.Time
 	serviceAccountKeyRotationLastInitiationTime *metav1.Time
 }
@@ -889,6 +903,9 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 		Sysctls:                                 d.worker.Sysctls,
 		PreferIPv6:                              d.primaryIPFamily == gardencorev1beta1.IPFamilyIPv6,
 		Taints:                                  d.taints,
+		NodeAgentHealthCheck:                    d.nodeAgentHealthCheck,
+		NodeAgentRollback:                       d.nodeAgentRollback,
+		NodeAgentDriftDetection:                 d.nodeAgentDriftDetection,
 	}
 
 	switch d.purpose {
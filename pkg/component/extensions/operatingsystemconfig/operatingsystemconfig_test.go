@@ -100,6 +100,9 @@ var _ = Describe("OperatingSystemConfig", func() {
 			workerKubernetesVersion                 = "4.5.6"
 			valitailEnabled                         = false
 			openTelemetryCollectorLogShipperEnabled = false
+			nodeAgentHealthCheck                    = &nodeagentconfigv1alpha1.HealthCheckControllerConfig{SyncPeriod: &metav1.Duration{Duration: time.Minute}}
+			nodeAgentRollback                       = &nodeagentconfigv1alpha1.OperatingSystemConfigRollbackConfig{GracePeriod: &metav1.Duration{Duration: time.Minute}}
+			nodeAgentDriftDetection                 = &nodeagentconfigv1alpha1.DriftDetectionConfig{SyncPeriod: &metav1.Duration{Duration: time.Minute}}
 
 			//nolint:unparam
 			initConfigFn = func(worker gardencorev1beta1.Worker, nodeAgentImage string, config *nodeagentconfigv1alpha1.NodeAgentConfiguration) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
@@ -137,6 +140,9 @@ var _ = Describe("OperatingSystemConfig", func() {
 						{Path: strconv.FormatBool(cctx.ValitailEnabled)},
 						{Path: strconv.FormatBool(cctx.OpenTelemetryCollectorLogShipperEnabled)},
 						{Path: fmt.Sprintf("%+v", cctx.Taints)},
+						{Path: fmt.Sprintf("%+v", cctx.NodeAgentHealthCheck)},
+						{Path: fmt.Sprintf("%+v", cctx.NodeAgentRollback)},
+						{Path: fmt.Sprintf("%+v", cctx.NodeAgentDriftDetection)},
 					},
 					nil
 			}
@@ -224,6 +230,9 @@ var _ = Describe("OperatingSystemConfig", func() {
 					SSHPublicKeys:                           sshPublicKeys,
 					ValitailEnabled:                         valitailEnabled,
 					OpenTelemetryCollectorLogShipperEnabled: openTelemetryCollectorLogShipperEnabled,
+					NodeAgentHealthCheck:                    nodeAgentHealthCheck,
+					NodeAgentRollback:                       nodeAgentRollback,
+					NodeAgentDriftDetection:                 nodeAgentDriftDetection,
 				}
 
 				if worker.ControlPlane != nil {
@@ -457,6 +466,9 @@ var _ = Describe("OperatingSystemConfig", func() {
 					SSHPublicKeys:                           sshPublicKeys,
 					ValitailEnabled:                         valitailEnabled,
 					OpenTelemetryCollectorLogShipperEnabled: openTelemetryCollectorLogShipperEnabled,
+					NodeAgentHealthCheck:                    nodeAgentHealthCheck,
+					NodeAgentRollback:                       nodeAgentRollback,
+					NodeAgentDriftDetection:                 nodeAgentDriftDetection,
 				},
 			}
 
@@ -714,6 +726,9 @@ var _ = Describe("OperatingSystemConfig", func() {
 							SSHPublicKeys:                           sshPublicKeys,
 							ValitailEnabled:                         valitailEnabled,
 							OpenTelemetryCollectorLogShipperEnabled: openTelemetryCollectorLogShipperEnabled,
+							NodeAgentHealthCheck:                    nodeAgentHealthCheck,
+							NodeAgentRollback:                       nodeAgentRollback,
+							NodeAgentDriftDetection:                 nodeAgentDriftDetection,
 						},
 						CredentialsRotationStatus: &gardencorev1beta1.ShootCredentialsRotation{
 							CertificateAuthorities: &gardencorev1beta1.CARotation{
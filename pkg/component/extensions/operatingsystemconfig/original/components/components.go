@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
@@ -47,4 +48,7 @@ type Context struct {
 	Sysctls                                 map[string]string
 	PreferIPv6                              bool
 	Taints                                  []corev1.Taint
+	NodeAgentHealthCheck                    *nodeagentconfigv1alpha1.HealthCheckControllerConfig
+	NodeAgentRollback                       *nodeagentconfigv1alpha1.OperatingSystemConfigRollbackConfig
+	NodeAgentDriftDetection                 *nodeagentconfigv1alpha1.DriftDetectionConfig
 }
@@ -74,7 +74,12 @@ func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []ex
 		})
 	}
 
-	files, err := Files(ComponentConfig(ctx.Key, ctx.KubernetesVersion, ctx.APIServerURL, caBundle, additionalTokenSyncConfigs))
+	config := ComponentConfig(ctx.Key, ctx.KubernetesVersion, ctx.APIServerURL, caBundle, additionalTokenSyncConfigs)
+	config.Controllers.HealthCheck = ctx.NodeAgentHealthCheck
+	config.Controllers.OperatingSystemConfig.Rollback = ctx.NodeAgentRollback
+	config.Controllers.OperatingSystemConfig.DriftDetection = ctx.NodeAgentDriftDetection
+
+	files, err := Files(config)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed generating files: %w", err)
 	}
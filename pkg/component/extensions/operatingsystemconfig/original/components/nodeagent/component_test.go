@@ -87,6 +87,44 @@ WantedBy=multi-user.target`),
 				},
 			})))
 		})
+
+		It("should render the configured controller configurations", func() {
+			key := "key"
+			healthCheck := &nodeagentconfigv1alpha1.HealthCheckControllerConfig{
+				SyncPeriod: &metav1.Duration{Duration: time.Minute},
+				HealthCheckers: []nodeagentconfigv1alpha1.HealthCheckerConfig{{
+					Name: "disk-pressure",
+					Type: nodeagentconfigv1alpha1.HealthCheckerTypeDiskPressure,
+				}},
+			}
+			rollback := &nodeagentconfigv1alpha1.OperatingSystemConfigRollbackConfig{
+				GracePeriod: &metav1.Duration{Duration: time.Minute},
+			}
+			driftDetection := &nodeagentconfigv1alpha1.DriftDetectionConfig{
+				DefaultPolicy: ptr.To(nodeagentconfigv1alpha1.DriftPolicyRemediate),
+			}
+
+			expectedConfig := ComponentConfig(key, kubernetesVersion, apiServerURL, caBundle, nil)
+			expectedConfig.Controllers.HealthCheck = healthCheck
+			expectedConfig.Controllers.OperatingSystemConfig.Rollback = rollback
+			expectedConfig.Controllers.OperatingSystemConfig.DriftDetection = driftDetection
+			expectedFiles, err := Files(expectedConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, files, err := component.Config(components.Context{
+				Key:                     key,
+				KubernetesVersion:       kubernetesVersion,
+				APIServerURL:            apiServerURL,
+				CABundle:                string(caBundle),
+				Images:                  map[string]*imagevectorutils.Image{"gardener-node-agent": {Repository: ptr.To("gardener-node-agent"), Tag: ptr.To("v1")}},
+				NodeAgentHealthCheck:    healthCheck,
+				NodeAgentRollback:       rollback,
+				NodeAgentDriftDetection: driftDetection,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(ContainElements(expectedFiles))
+		})
 	})
 
 	Describe("#UnitContent", func() {
@@ -328,6 +328,9 @@ type Values struct {
 	// operating system configs on nodes. When this is provided, the respective controller is enabled in
 	// resource-manager.
 	NodeAgentReconciliationMaxDelay *metav1.Duration
+	// NodeAgentRolloutEnabled specifies whether the controller for staged rollouts of operating system configs to the
+	// nodes of worker pools should be enabled.
+	NodeAgentRolloutEnabled bool
 	// NodeAgentAuthorizerEnabled specifies if node-agent-authorizer webhook should be enabled.
 	NodeAgentAuthorizerEnabled bool
 	// NodeAgentAuthorizerAuthorizeWithSelectors specifies if node-agent-authorizer should allow authorization to use field selectors.
@@ -685,6 +688,10 @@ func (r *resourceManager) ensureConfigMap(ctx context.Context, configMap *corev1
 		config.Controllers.NodeAgentReconciliationDelay.MaxDelay = r.values.NodeAgentReconciliationMaxDelay
 	}
 
+	if r.values.NodeAgentRolloutEnabled {
+		config.Controllers.NodeAgentRollout.Enabled = true
+	}
+
 	if r.values.ResponsibilityMode == ForTarget || r.values.ResponsibilityMode == ForSourceAndTarget {
 		config.Webhooks.SystemComponentsConfig = resourcemanagerconfigv1alpha1.SystemComponentsConfigWebhookConfig{
 			Enabled: true,
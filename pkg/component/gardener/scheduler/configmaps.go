@@ -72,6 +72,11 @@ func (g *gardenerScheduler) configMapSchedulerConfig() (*corev1.ConfigMap, error
 		FeatureGates: g.values.FeatureGates,
 	}
 
+	if g.values.EnablePreviewHandler {
+		schedulerConfig.Server.Preview = &schedulerconfigv1alpha1.Server{Port: previewPort}
+		schedulerConfig.Schedulers.Shoot.EnablePreviewHandler = ptr.To(true)
+	}
+
 	data, err := runtime.Encode(schedulerCodec, schedulerConfig)
 	if err != nil {
 		return nil, err
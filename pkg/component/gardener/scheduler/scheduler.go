@@ -27,6 +27,7 @@ const (
 
 	probePort   = 10251
 	metricsPort = 19251
+	previewPort = 10253
 
 	// ManagedResourceNameRuntime is the name of the ManagedResource for the runtime resources.
 	ManagedResourceNameRuntime = "gardener-scheduler-runtime"
@@ -46,6 +47,8 @@ type Values struct {
 	LogLevel string
 	// FeatureGates is the set of feature gates.
 	FeatureGates map[string]bool
+	// EnablePreviewHandler specifies whether the handler for previewing scheduling decisions is served.
+	EnablePreviewHandler bool
 }
 
 // New creates a new instance of DeployWaiter for the gardener-scheduler.
@@ -13,6 +13,8 @@ import (
 	"github.com/onsi/gomega/types"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	coordinationv1beta1 "k8s.io/api/coordination/v1beta1"
 	corev1 "k8s.io/api/core/v1"
@@ -260,6 +262,16 @@ var _ = Describe("GardenerScheduler", func() {
 					},
 					Verbs: []string{"create"},
 				},
+				{
+					APIGroups: []string{authenticationv1.GroupName},
+					Resources: []string{"tokenreviews"},
+					Verbs:     []string{"create"},
+				},
+				{
+					APIGroups: []string{authorizationv1.GroupName},
+					Resources: []string{"subjectaccessreviews"},
+					Verbs:     []string{"create"},
+				},
 				{
 					APIGroups: []string{coordinationv1beta1.GroupName},
 					Resources: []string{
@@ -398,6 +410,31 @@ var _ = Describe("GardenerScheduler", func() {
 				Expect(managedResourceSecretVirtual.Labels["resources.gardener.cloud/garbage-collectable-reference"]).To(Equal("true"))
 				Expect(managedResourceRuntime).To(consistOf(expectedRuntimeObject...))
 			})
+
+			It("should successfully deploy the configuration and port of the preview handler", func() {
+				values.EnablePreviewHandler = true
+				deployer = New(fakeClient, namespace, fakeSecretManager, values)
+
+				Expect(deployer.Deploy(ctx)).To(Succeed())
+
+				serviceRuntime.Spec.Ports = append(serviceRuntime.Spec.Ports, corev1.ServicePort{
+					Name:       "preview",
+					Port:       10253,
+					Protocol:   corev1.ProtocolTCP,
+					TargetPort: intstr.FromInt32(10253),
+				})
+				configMap := configMap(namespace, values)
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceRuntime), managedResourceRuntime)).To(Succeed())
+				Expect(managedResourceRuntime).To(consistOf(
+					configMap,
+					serviceRuntime,
+					serviceMonitor,
+					vpa,
+					deployment(namespace, configMap.Name, values),
+					podDisruptionBudget,
+				))
+			})
 		})
 
 		Context("secrets", func() {
@@ -745,6 +782,13 @@ func configMap(namespace string, testValues Values) *corev1.ConfigMap {
 		FeatureGates: testValues.FeatureGates,
 	}
 
+	if testValues.EnablePreviewHandler {
+		schedulerConfig.Server.Preview = &schedulerconfigv1alpha1.Server{
+			Port: 10253,
+		}
+		schedulerConfig.Schedulers.Shoot.EnablePreviewHandler = ptr.To(true)
+	}
+
 	data, err := json.Marshal(schedulerConfig)
 	utilruntime.Must(err)
 	data, err = yaml.JSONToYAML(data)
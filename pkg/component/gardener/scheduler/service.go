@@ -18,6 +18,7 @@ import (
 const (
 	serviceName     = DeploymentName
 	portNameMetrics = "metrics"
+	portNamePreview = "preview"
 )
 
 func (g *gardenerScheduler) service() *corev1.Service {
@@ -39,6 +40,15 @@ func (g *gardenerScheduler) service() *corev1.Service {
 		},
 	}
 
+	if g.values.EnablePreviewHandler {
+		service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+			Name:       portNamePreview,
+			Port:       int32(previewPort),
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromInt32(previewPort),
+		})
+	}
+
 	utilruntime.Must(gardenerutils.InjectNetworkPolicyAnnotationsForGardenScrapeTargets(service, networkingv1.NetworkPolicyPort{
 		Port:     ptr.To(intstr.FromInt32(metricsPort)),
 		Protocol: ptr.To(corev1.ProtocolTCP),
@@ -377,6 +377,14 @@ func (s *shootSystem) shootInfoData() map[string]string {
 		"maintenanceEnd":    s.values.Object.Spec.Maintenance.TimeWindow.End,
 	}
 
+	if location := s.values.Object.Spec.Maintenance.TimeWindow.Location; location != nil {
+		data["maintenanceLocation"] = *location
+	}
+
+	if weekdays := s.values.Object.Spec.Maintenance.TimeWindow.Weekdays; len(weekdays) > 0 {
+		data["maintenanceWeekdays"] = strings.Join(weekdays, ",")
+	}
+
 	if domain := s.values.ExternalClusterDomain; domain != nil {
 		data["domain"] = *domain
 	}
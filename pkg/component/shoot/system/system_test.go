@@ -186,6 +186,23 @@ var _ = Describe("ShootSystem", func() {
 				Expect(managedResource).To(contain(configMap))
 			})
 
+			When("maintenance time window has a location and weekdays", func() {
+				BeforeEach(func() {
+					values.Object = shootObj.DeepCopy()
+					values.Object.Spec.Maintenance.TimeWindow.Location = ptr.To("Europe/Berlin")
+					values.Object.Spec.Maintenance.TimeWindow.Weekdays = []string{"Tue", "Thu"}
+				})
+
+				It("should add the location and the weekdays", func() {
+					configMap.Data["podNetwork"] = podCIDRs[0].String()
+					configMap.Data["podNetworks"] = podCIDRs[0].String() + "," + podCIDRs[1].String()
+					configMap.Data["maintenanceLocation"] = "Europe/Berlin"
+					configMap.Data["maintenanceWeekdays"] = "Tue,Thu"
+
+					Expect(managedResource).To(contain(configMap))
+				})
+			})
+
 			When("shoot is workerless", func() {
 				BeforeEach(func() {
 					values.IsWorkerless = true
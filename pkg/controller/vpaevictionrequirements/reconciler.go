@@ -84,7 +84,17 @@ func (r *Reconciler) reconcileVPAForDownscaleInMaintenanceOnly(ctx context.Conte
 		return reconcile.Result{}, nil
 	}
 
-	maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(splitWindowAnnotation[0], splitWindowAnnotation[1])
+	var weekdays []string
+	if value := vpa.GetAnnotations()[constants.AnnotationShootMaintenanceWindowWeekdays]; value != "" {
+		weekdays = strings.Split(value, ",")
+	}
+
+	maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(
+		splitWindowAnnotation[0],
+		splitWindowAnnotation[1],
+		timewindow.InLocation(vpa.GetAnnotations()[constants.AnnotationShootMaintenanceWindowLocation]),
+		timewindow.OnWeekdays(weekdays...),
+	)
 	if err != nil {
 		log.Error(err, "Error during parsing the maintenance window from start and end time", "begin", splitWindowAnnotation[0], "end", splitWindowAnnotation[1])
 		// No need to retry reconciling this VPA until it has been updated with a fixed annotation, therefore not returning the error
@@ -104,7 +114,7 @@ func (r *Reconciler) reconcileVPAForDownscaleInMaintenanceOnly(ctx context.Conte
 		}
 
 		// requeue when the maintenance window ends, such that we can add the EvictionRequirement again
-		endTime := maintenanceTimeWindow.AdjustedEnd(r.Clock.Now())
+		endTime := maintenanceTimeWindow.CurrentEnd(r.Clock.Now())
 		requeueAfter := endTime.Sub(r.Clock.Now())
 		log.Info("Requeuing to the end of the maintenance window", "requeueAfter", requeueAfter)
 		return reconcile.Result{RequeueAfter: requeueAfter}, nil
@@ -120,10 +130,7 @@ func (r *Reconciler) reconcileVPAForDownscaleInMaintenanceOnly(ctx context.Conte
 	}
 
 	// requeue when the next maintenance window begins, such that we can remove the EvictionRequirement
-	nextWindowBegin := maintenanceTimeWindow.AdjustedBegin(r.Clock.Now())
-	if nextWindowBegin.Before(r.Clock.Now()) {
-		nextWindowBegin = nextWindowBegin.AddDate(0, 0, 1)
-	}
+	nextWindowBegin := maintenanceTimeWindow.NextBegin(r.Clock.Now())
 	requeueAfter := nextWindowBegin.Sub(r.Clock.Now())
 	log.Info("Requeuing to the begin of the next maintenance window", "requeueAfter", requeueAfter)
 	return reconcile.Result{RequeueAfter: requeueAfter}, nil
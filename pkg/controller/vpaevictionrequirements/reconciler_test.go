@@ -127,6 +127,24 @@ var _ = Describe("Reconciler", func() {
 			})
 		})
 
+		When("the Shoot is outside its maintenance window which has a location and weekdays", func() {
+			BeforeEach(func() {
+				// The fake time is Tuesday, 21:59:39 in Europe/Berlin.
+				metav1.SetMetaDataAnnotation(&vpa.ObjectMeta, constants.AnnotationShootMaintenanceWindow, "235939+0000,005939+0000")
+				metav1.SetMetaDataAnnotation(&vpa.ObjectMeta, constants.AnnotationShootMaintenanceWindowLocation, "Europe/Berlin")
+				metav1.SetMetaDataAnnotation(&vpa.ObjectMeta, constants.AnnotationShootMaintenanceWindowWeekdays, "Thu")
+			})
+
+			It("should add an EvictionRequirement that prevents downscaling and requeue at the beginning of the next Shoot maintenance window", func() {
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(50 * time.Hour))
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(vpa), vpa)).To(Succeed())
+				Expect(vpa.Spec.UpdatePolicy.EvictionRequirements).To(ConsistOf(upscaleOnlyRequirement))
+			})
+		})
+
 		When("the Shoot is inside its maintenance window", func() {
 			BeforeEach(func() {
 				maintenanceWindowBegin = fakeClock.Now().Format("150405-0700")
@@ -184,6 +184,11 @@ func SetDefaults_QuotaControllerConfiguration(obj *QuotaControllerConfiguration)
 	if obj.ConcurrentSyncs == nil {
 		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
 	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{
+			Duration: 10 * time.Minute,
+		}
+	}
 }
 
 // SetDefaults_SecretBindingControllerConfiguration sets defaults for the SecretBindingControllerConfiguration.
@@ -297,6 +302,25 @@ func SetDefaults_ShootMigrationControllerConfiguration(obj *ShootMigrationContro
 	}
 }
 
+// SetDefaults_ShootRebalancingControllerConfiguration sets defaults for the ShootRebalancingControllerConfiguration.
+func SetDefaults_ShootRebalancingControllerConfiguration(obj *ShootRebalancingControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 30 * time.Minute}
+	}
+	if obj.Mode == nil {
+		obj.Mode = ptr.To(ShootRebalancingModePropose)
+	}
+	if obj.ResourceUtilizationThreshold == nil {
+		obj.ResourceUtilizationThreshold = ptr.To[int32](80)
+	}
+	if obj.MaxConcurrentMigrationsPerSeed == nil {
+		obj.MaxConcurrentMigrationsPerSeed = ptr.To[int32](1)
+	}
+}
+
 // SetDefaults_ManagedSeedSetControllerConfiguration sets defaults for the ManagedSeedSetControllerConfiguration.
 func SetDefaults_ManagedSeedSetControllerConfiguration(obj *ManagedSeedSetControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -379,6 +403,9 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.ShootMigration == nil {
 		obj.ShootMigration = &ShootMigrationControllerConfiguration{}
 	}
+	if obj.ShootRebalancing == nil {
+		obj.ShootRebalancing = &ShootRebalancingControllerConfiguration{}
+	}
 
 	if obj.ManagedSeedSet == nil {
 		obj.ManagedSeedSet = &ManagedSeedSetControllerConfiguration{
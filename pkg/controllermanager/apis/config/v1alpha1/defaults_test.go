@@ -423,6 +423,7 @@ var _ = Describe("Defaults", func() {
 		It("should default QuotaControllerConfiguration correctly", func() {
 			expected := &QuotaControllerConfiguration{
 				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:      &metav1.Duration{Duration: 10 * time.Minute},
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
@@ -434,6 +435,7 @@ var _ = Describe("Defaults", func() {
 				Controllers: ControllerManagerControllerConfiguration{
 					Quota: &QuotaControllerConfiguration{
 						ConcurrentSyncs: ptr.To(10),
+						SyncPeriod:      &metav1.Duration{Duration: time.Hour},
 					},
 				},
 			}
@@ -799,6 +801,41 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootRebalancingControllerConfiguration defaulting", func() {
+		It("should default ShootRebalancingControllerConfiguration correctly", func() {
+			expected := &ShootRebalancingControllerConfiguration{
+				ConcurrentSyncs:                ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:                     &metav1.Duration{Duration: 30 * time.Minute},
+				Mode:                           ptr.To(ShootRebalancingModePropose),
+				ResourceUtilizationThreshold:   ptr.To[int32](80),
+				MaxConcurrentMigrationsPerSeed: ptr.To[int32](1),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootRebalancing).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootRebalancing: &ShootRebalancingControllerConfiguration{
+						ConcurrentSyncs:                ptr.To(10),
+						SyncPeriod:                     &metav1.Duration{Duration: time.Hour},
+						Mode:                           ptr.To(ShootRebalancingModeMigrate),
+						Policies:                       []ShootRebalancingPolicy{ShootRebalancingPolicySeedTainted},
+						ResourceUtilizationThreshold:   ptr.To[int32](90),
+						MaxConcurrentMigrationsPerSeed: ptr.To[int32](3),
+						SchedulerConfigFile:            ptr.To("/etc/gardener-scheduler/config/schedulerconfiguration.yaml"),
+					},
+				},
+			}
+			expected := obj.Controllers.ShootRebalancing.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootRebalancing).To(Equal(expected))
+		})
+	})
+
 	Describe("ManagedSeedSetControllerConfiguration defaulting", func() {
 		It("should default ManagedSeedSetControllerConfiguration correctly if nil", func() {
 			expected := &ManagedSeedSetControllerConfiguration{
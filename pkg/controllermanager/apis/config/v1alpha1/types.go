@@ -113,6 +113,10 @@ type ControllerManagerControllerConfiguration struct {
 	// ShootMigration defines the configuration of the ShootMigration controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
 	// +optional
 	ShootMigration *ShootMigrationControllerConfiguration `json:"shootMigration,omitempty"`
+	// ShootRebalancing defines the configuration of the ShootRebalancing controller. The controller is only enabled if
+	// at least one policy is configured.
+	// +optional
+	ShootRebalancing *ShootRebalancingControllerConfiguration `json:"shootRebalancing,omitempty"`
 	// ManagedSeedSet defines the configuration of the ManagedSeedSet controller.
 	// +optional
 	ManagedSeedSet *ManagedSeedSetControllerConfiguration `json:"managedSeedSet,omitempty"`
@@ -242,6 +246,10 @@ type QuotaControllerConfiguration struct {
 	// events.
 	// +optional
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the existing resources are reconciled
+	// (how often the usage of Quotas is recomputed).
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
 }
 
 // SecretBindingControllerConfiguration defines the configuration of the
@@ -419,6 +427,67 @@ type ShootMigrationControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// ShootRebalancingControllerConfiguration defines the configuration of the
+// ShootRebalancing controller.
+type ShootRebalancingControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often shoots are evaluated against the policies.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Mode is the rebalancing mode. In mode `Propose`, the controller only reports the proposed migrations via events.
+	// In mode `Migrate`, it sets the `.spec.seedName` of the shoot during its maintenance time window. Defaults to
+	// `Propose`.
+	// +optional
+	Mode *ShootRebalancingMode `json:"mode,omitempty"`
+	// Policies are the policies which trigger the migration of a shoot control plane to another seed.
+	// +optional
+	Policies []ShootRebalancingPolicy `json:"policies,omitempty"`
+	// ResourceUtilizationThreshold is the CPU and memory utilization (in percent) of a seed above which its shoots are
+	// migrated if the `SeedOverUtilized` policy is configured. Destination seeds must not exceed this threshold.
+	// Defaults to `80`.
+	// +optional
+	ResourceUtilizationThreshold *int32 `json:"resourceUtilizationThreshold,omitempty"`
+	// MaxConcurrentMigrationsPerSeed is the maximum number of shoot control planes which are migrated from or to a
+	// seed at the same time. Defaults to `1`.
+	// +optional
+	MaxConcurrentMigrationsPerSeed *int32 `json:"maxConcurrentMigrationsPerSeed,omitempty"`
+	// SchedulerConfigFile is the path to the component configuration file of the gardener-scheduler. The destination
+	// seeds are determined with its shoot scheduler configuration (`.schedulers.shoot`), i.e., exactly like the
+	// gardener-scheduler would choose them. The `concurrentSyncs` and `enablePreviewHandler` fields are ignored, and
+	// the resource utilization threshold of this controller takes precedence. If not set, the default shoot scheduler
+	// configuration is used.
+	// +optional
+	SchedulerConfigFile *string `json:"schedulerConfigFile,omitempty"`
+}
+
+// ShootRebalancingMode is the mode of the ShootRebalancing controller.
+type ShootRebalancingMode string
+
+const (
+	// ShootRebalancingModePropose only reports the proposed migrations via events.
+	ShootRebalancingModePropose ShootRebalancingMode = "Propose"
+	// ShootRebalancingModeMigrate migrates the shoot control planes during their maintenance time windows.
+	ShootRebalancingModeMigrate ShootRebalancingMode = "Migrate"
+)
+
+// ShootRebalancingPolicy is a policy which triggers the migration of a shoot control plane to another seed.
+type ShootRebalancingPolicy string
+
+const (
+	// ShootRebalancingPolicySeedOverUtilized migrates shoots away from seeds whose CPU or memory utilization exceeds
+	// the configured threshold.
+	ShootRebalancingPolicySeedOverUtilized ShootRebalancingPolicy = "SeedOverUtilized"
+	// ShootRebalancingPolicySeedTainted migrates shoots away from seeds with taints the shoot does not tolerate, or
+	// which are no longer visible for scheduling.
+	ShootRebalancingPolicySeedTainted ShootRebalancingPolicy = "SeedTainted"
+	// ShootRebalancingPolicySeedSelectorMismatch migrates shoots away from seeds which do not match the seed selector
+	// of the shoot anymore.
+	ShootRebalancingPolicySeedSelectorMismatch ShootRebalancingPolicy = "SeedSelectorMismatch"
+)
+
 // ManagedSeedSetControllerConfiguration defines the configuration of the
 // ManagedSeedSet controller.
 type ManagedSeedSetControllerConfiguration struct {
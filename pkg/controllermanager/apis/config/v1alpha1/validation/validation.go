@@ -51,6 +51,11 @@ func validateControllerManagerControllerConfiguration(conf controllermanagerconf
 		allErrs = append(allErrs, validateShootStateControllerConfiguration(conf.ShootState, shootStateFldPath)...)
 	}
 
+	shootRebalancingFldPath := fldPath.Child("shootRebalancing")
+	if conf.ShootRebalancing != nil {
+		allErrs = append(allErrs, validateShootRebalancingControllerConfiguration(conf.ShootRebalancing, shootRebalancingFldPath)...)
+	}
+
 	return allErrs
 }
 
@@ -77,3 +82,58 @@ func validateShootStateControllerConfiguration(conf *controllermanagerconfigv1al
 	}
 	return allErrs
 }
+
+var (
+	supportedShootRebalancingModes = sets.New(
+		controllermanagerconfigv1alpha1.ShootRebalancingModePropose,
+		controllermanagerconfigv1alpha1.ShootRebalancingModeMigrate,
+	)
+	supportedShootRebalancingPolicies = sets.New(
+		controllermanagerconfigv1alpha1.ShootRebalancingPolicySeedOverUtilized,
+		controllermanagerconfigv1alpha1.ShootRebalancingPolicySeedTainted,
+		controllermanagerconfigv1alpha1.ShootRebalancingPolicySeedSelectorMismatch,
+	)
+)
+
+func validateShootRebalancingControllerConfiguration(conf *controllermanagerconfigv1alpha1.ShootRebalancingControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.ConcurrentSyncs != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*conf.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	}
+
+	if conf.SyncPeriod != nil && conf.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), conf.SyncPeriod.Duration.String(), "must be positive"))
+	}
+
+	if conf.Mode != nil && !supportedShootRebalancingModes.Has(*conf.Mode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), *conf.Mode, sets.List(supportedShootRebalancingModes)))
+	}
+
+	policies := sets.New[controllermanagerconfigv1alpha1.ShootRebalancingPolicy]()
+	for i, policy := range conf.Policies {
+		idxPath := fldPath.Child("policies").Index(i)
+
+		if !supportedShootRebalancingPolicies.Has(policy) {
+			allErrs = append(allErrs, field.NotSupported(idxPath, policy, sets.List(supportedShootRebalancingPolicies)))
+		}
+		if policies.Has(policy) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, policy))
+		}
+		policies.Insert(policy)
+	}
+
+	if conf.ResourceUtilizationThreshold != nil && (*conf.ResourceUtilizationThreshold < 1 || *conf.ResourceUtilizationThreshold > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("resourceUtilizationThreshold"), *conf.ResourceUtilizationThreshold, "must be between 1 and 100"))
+	}
+
+	if conf.MaxConcurrentMigrationsPerSeed != nil && *conf.MaxConcurrentMigrationsPerSeed < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrentMigrationsPerSeed"), *conf.MaxConcurrentMigrationsPerSeed, "must be at least 1"))
+	}
+
+	if conf.SchedulerConfigFile != nil && len(*conf.SchedulerConfigFile) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("schedulerConfigFile"), *conf.SchedulerConfigFile, "must not be empty"))
+	}
+
+	return allErrs
+}
@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -193,4 +195,61 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 			})
 		})
 	})
+
+	Context("ShootRebalancingControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.ShootRebalancing = &controllermanagerconfigv1alpha1.ShootRebalancingControllerConfiguration{
+				SyncPeriod:                     &metav1.Duration{Duration: time.Hour},
+				Mode:                           ptr.To(controllermanagerconfigv1alpha1.ShootRebalancingModeMigrate),
+				Policies:                       []controllermanagerconfigv1alpha1.ShootRebalancingPolicy{"SeedOverUtilized", "SeedTainted", "SeedSelectorMismatch"},
+				ResourceUtilizationThreshold:   ptr.To[int32](80),
+				MaxConcurrentMigrationsPerSeed: ptr.To[int32](2),
+				SchedulerConfigFile:            ptr.To("/etc/gardener-scheduler/config/schedulerconfiguration.yaml"),
+			}
+		})
+
+		It("should allow a valid configuration", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid invalid values", func() {
+			conf.Controllers.ShootRebalancing.SyncPeriod = &metav1.Duration{}
+			conf.Controllers.ShootRebalancing.Mode = ptr.To(controllermanagerconfigv1alpha1.ShootRebalancingMode("Foo"))
+			conf.Controllers.ShootRebalancing.Policies = []controllermanagerconfigv1alpha1.ShootRebalancingPolicy{"SeedTainted", "Bar", "SeedTainted"}
+			conf.Controllers.ShootRebalancing.ResourceUtilizationThreshold = ptr.To[int32](101)
+			conf.Controllers.ShootRebalancing.MaxConcurrentMigrationsPerSeed = ptr.To[int32](0)
+			conf.Controllers.ShootRebalancing.SchedulerConfigFile = ptr.To("")
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootRebalancing.syncPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.shootRebalancing.mode"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.shootRebalancing.policies[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("controllers.shootRebalancing.policies[2]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootRebalancing.resourceUtilizationThreshold"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootRebalancing.maxConcurrentMigrationsPerSeed"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootRebalancing.schedulerConfigFile"),
+				})),
+			))
+		})
+	})
 })
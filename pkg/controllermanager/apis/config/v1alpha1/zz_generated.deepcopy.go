@@ -281,6 +281,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ShootMigrationControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootRebalancing != nil {
+		in, out := &in.ShootRebalancing, &out.ShootRebalancing
+		*out = new(ShootRebalancingControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ManagedSeedSet != nil {
 		in, out := &in.ManagedSeedSet, &out.ManagedSeedSet
 		*out = new(ManagedSeedSetControllerConfiguration)
@@ -519,6 +524,11 @@ func (in *QuotaControllerConfiguration) DeepCopyInto(out *QuotaControllerConfigu
 		*out = new(int)
 		**out = **in
 	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -839,6 +849,57 @@ func (in *ShootQuotaControllerConfiguration) DeepCopy() *ShootQuotaControllerCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootRebalancingControllerConfiguration) DeepCopyInto(out *ShootRebalancingControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(ShootRebalancingMode)
+		**out = **in
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]ShootRebalancingPolicy, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceUtilizationThreshold != nil {
+		in, out := &in.ResourceUtilizationThreshold, &out.ResourceUtilizationThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxConcurrentMigrationsPerSeed != nil {
+		in, out := &in.MaxConcurrentMigrationsPerSeed, &out.MaxConcurrentMigrationsPerSeed
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SchedulerConfigFile != nil {
+		in, out := &in.SchedulerConfigFile, &out.SchedulerConfigFile
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootRebalancingControllerConfiguration.
+func (in *ShootRebalancingControllerConfiguration) DeepCopy() *ShootRebalancingControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootRebalancingControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootReferenceControllerConfiguration) DeepCopyInto(out *ShootReferenceControllerConfiguration) {
 	*out = *in
@@ -95,6 +95,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.ShootMigration != nil {
 		SetDefaults_ShootMigrationControllerConfiguration(in.Controllers.ShootMigration)
 	}
+	if in.Controllers.ShootRebalancing != nil {
+		SetDefaults_ShootRebalancingControllerConfiguration(in.Controllers.ShootRebalancing)
+	}
 	if in.Controllers.ManagedSeedSet != nil {
 		SetDefaults_ManagedSeedSetControllerConfiguration(in.Controllers.ManagedSeedSet)
 	}
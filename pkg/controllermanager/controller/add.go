@@ -111,9 +111,7 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *controllermanag
 		return fmt.Errorf("failed adding Project controller: %w", err)
 	}
 
-	if err := (&quota.Reconciler{
-		Config: *cfg.Controllers.Quota,
-	}).AddToManager(mgr); err != nil {
+	if err := quota.AddToManager(mgr, *cfg); err != nil {
 		return fmt.Errorf("failed adding Quota controller: %w", err)
 	}
 
@@ -5,34 +5,28 @@
 package quota
 
 import (
-	"k8s.io/utils/ptr"
-	"sigs.k8s.io/controller-runtime/pkg/builder"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"fmt"
+
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
-	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
-	"github.com/gardener/gardener/pkg/controllerutils"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/quota/quota"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/quota/usage"
 )
 
-// ControllerName is the name of this controller.
-const ControllerName = "quota"
-
-// AddToManager adds Reconciler to the given manager.
-func (r *Reconciler) AddToManager(mgr manager.Manager) error {
-	if r.Client == nil {
-		r.Client = mgr.GetClient()
+// AddToManager adds all Quota controllers to the given manager.
+func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.ControllerManagerConfiguration) error {
+	if err := (&quota.Reconciler{
+		Config: *cfg.Controllers.Quota,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding main reconciler: %w", err)
 	}
-	if r.Recorder == nil {
-		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
+
+	if err := (&usage.Reconciler{
+		Config: *cfg.Controllers.Quota,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding usage reconciler: %w", err)
 	}
 
-	return builder.
-		ControllerManagedBy(mgr).
-		Named(ControllerName).
-		For(&gardencorev1beta1.Quota{}).
-		WithOptions(controller.Options{
-			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
-			ReconciliationTimeout:   controllerutils.DefaultReconciliationTimeout,
-		}).
-		Complete(r)
+	return nil
 }
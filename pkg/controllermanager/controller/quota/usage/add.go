@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "quota-usage"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&gardencorev1beta1.Quota{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+			ReconciliationTimeout:   controllerutils.DefaultReconciliationTimeout,
+		}).
+		Watches(
+			&gardencorev1beta1.Shoot{},
+			handler.EnqueueRequestsFromMapFunc(r.MapShootToQuotas(mgr.GetLogger().WithValues("controller", ControllerName))),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&gardencorev1beta1.SecretBinding{},
+			handler.EnqueueRequestsFromMapFunc(MapBindingToQuotas),
+		).
+		Watches(
+			&securityv1alpha1.CredentialsBinding{},
+			handler.EnqueueRequestsFromMapFunc(MapBindingToQuotas),
+		).
+		Complete(r)
+}
+
+// MapShootToQuotas is a handler.MapFunc for mapping a Shoot to the Quotas referenced by its SecretBinding or
+// CredentialsBinding.
+func (r *Reconciler) MapShootToQuotas(log logr.Logger) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		shoot, ok := obj.(*gardencorev1beta1.Shoot)
+		if !ok {
+			return nil
+		}
+
+		var binding client.Object
+		switch {
+		case shoot.Spec.SecretBindingName != nil:
+			binding = &gardencorev1beta1.SecretBinding{ObjectMeta: metav1.ObjectMeta{Namespace: shoot.Namespace, Name: *shoot.Spec.SecretBindingName}}
+		case shoot.Spec.CredentialsBindingName != nil:
+			binding = &securityv1alpha1.CredentialsBinding{ObjectMeta: metav1.ObjectMeta{Namespace: shoot.Namespace, Name: *shoot.Spec.CredentialsBindingName}}
+		default:
+			return nil
+		}
+
+		if err := r.Client.Get(ctx, client.ObjectKeyFromObject(binding), binding); err != nil {
+			log.Error(err, "Failed to get binding of Shoot", "shoot", client.ObjectKeyFromObject(shoot))
+			return nil
+		}
+
+		return MapBindingToQuotas(ctx, binding)
+	}
+}
+
+// MapBindingToQuotas is a handler.MapFunc for mapping a SecretBinding or CredentialsBinding to the Quotas it references.
+func MapBindingToQuotas(_ context.Context, obj client.Object) []reconcile.Request {
+	var quotaRefs []corev1.ObjectReference
+	switch binding := obj.(type) {
+	case *gardencorev1beta1.SecretBinding:
+		quotaRefs = binding.Quotas
+	case *securityv1alpha1.CredentialsBinding:
+		quotaRefs = binding.Quotas
+	}
+
+	requests := make([]reconcile.Request, 0, len(quotaRefs))
+	for _, quotaRef := range quotaRefs {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: quotaRef.Namespace, Name: quotaRef.Name}})
+	}
+	return requests
+}
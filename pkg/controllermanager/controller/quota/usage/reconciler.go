@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/metrics"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler reconciles Quotas and maintains the resources allocated by the Shoots referring to them in their status.
+type Reconciler struct {
+	Client   client.Client
+	Config   controllermanagerconfigv1alpha1.QuotaControllerConfiguration
+	Recorder record.EventRecorder
+}
+
+// Reconcile computes the resources allocated by all Shoots referring to the Quota and updates its status.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	quota := &gardencorev1beta1.Quota{}
+	if err := r.Client.Get(ctx, request.NamespacedName, quota); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			deleteMetrics(request.Namespace, request.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if quota.DeletionTimestamp != nil {
+		deleteMetrics(quota.Namespace, quota.Name)
+		return reconcile.Result{}, nil
+	}
+
+	scope, err := gardencorehelper.QuotaScope(quota.Spec.Scope)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	shoots, err := r.shootsReferringToQuota(ctx, quota)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed determining shoots referring to quota: %w", err)
+	}
+
+	var (
+		used             = emptyResourceList(quota.Spec.Metrics)
+		usedPerNamespace = map[string]corev1.ResourceList{}
+	)
+
+	for _, shoot := range shoots {
+		resources, err := r.computeShootResources(ctx, shoot)
+		if err != nil {
+			log.Error(err, "Failed computing resources of Shoot, skipping it", "shoot", client.ObjectKeyFromObject(shoot))
+			r.Recorder.Eventf(quota, corev1.EventTypeWarning, "ShootResourcesUnknown", "Failed computing resources of Shoot %s: %v", client.ObjectKeyFromObject(shoot), err)
+			continue
+		}
+
+		addResources(used, resources)
+
+		if scope == "project" {
+			if _, ok := usedPerNamespace[shoot.Namespace]; !ok {
+				usedPerNamespace[shoot.Namespace] = emptyResourceList(quota.Spec.Metrics)
+			}
+			addResources(usedPerNamespace[shoot.Namespace], resources)
+		}
+	}
+
+	var projects []gardencorev1beta1.QuotaProjectStatus
+	for namespace, resources := range usedPerNamespace {
+		projects = append(projects, gardencorev1beta1.QuotaProjectStatus{Namespace: namespace, Used: resources})
+	}
+	slices.SortFunc(projects, func(a, b gardencorev1beta1.QuotaProjectStatus) int {
+		return strings.Compare(a.Namespace, b.Namespace)
+	})
+
+	patch := client.MergeFrom(quota.DeepCopy())
+	quota.Status.ObservedGeneration = quota.Generation
+	quota.Status.Hard = quota.Spec.Metrics.DeepCopy()
+	quota.Status.Used = used
+	quota.Status.Projects = projects
+	if err := r.Client.Status().Patch(ctx, quota, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating quota status: %w", err)
+	}
+
+	updateMetrics(quota, scope)
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// shootsReferringToQuota returns all Shoots which use a SecretBinding or CredentialsBinding referring to the given Quota.
+func (r *Reconciler) shootsReferringToQuota(ctx context.Context, quota *gardencorev1beta1.Quota) ([]*gardencorev1beta1.Shoot, error) {
+	var (
+		secretBindingNames      = map[string]sets.Set[string]{}
+		credentialsBindingNames = map[string]sets.Set[string]{}
+	)
+
+	secretBindingList := &gardencorev1beta1.SecretBindingList{}
+	if err := r.Client.List(ctx, secretBindingList); err != nil {
+		return nil, err
+	}
+	for _, binding := range secretBindingList.Items {
+		if refersToQuota(binding.Quotas, quota) {
+			if _, ok := secretBindingNames[binding.Namespace]; !ok {
+				secretBindingNames[binding.Namespace] = sets.New[string]()
+			}
+			secretBindingNames[binding.Namespace].Insert(binding.Name)
+		}
+	}
+
+	credentialsBindingList := &securityv1alpha1.CredentialsBindingList{}
+	if err := r.Client.List(ctx, credentialsBindingList); err != nil {
+		return nil, err
+	}
+	for _, binding := range credentialsBindingList.Items {
+		if refersToQuota(binding.Quotas, quota) {
+			if _, ok := credentialsBindingNames[binding.Namespace]; !ok {
+				credentialsBindingNames[binding.Namespace] = sets.New[string]()
+			}
+			credentialsBindingNames[binding.Namespace].Insert(binding.Name)
+		}
+	}
+
+	namespaces := sets.KeySet(secretBindingNames).Union(sets.KeySet(credentialsBindingNames))
+
+	var shoots []*gardencorev1beta1.Shoot
+	for _, namespace := range sets.List(namespaces) {
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := r.Client.List(ctx, shootList, client.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+
+		for _, shoot := range shootList.Items {
+			if secretBindingNames[namespace].Has(ptr.Deref(shoot.Spec.SecretBindingName, "")) ||
+				credentialsBindingNames[namespace].Has(ptr.Deref(shoot.Spec.CredentialsBindingName, "")) {
+				shoots = append(shoots, shoot.DeepCopy())
+			}
+		}
+	}
+
+	return shoots, nil
+}
+
+func (r *Reconciler) computeShootResources(ctx context.Context, shoot *gardencorev1beta1.Shoot) (corev1.ResourceList, error) {
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return nil, fmt.Errorf("could not find referenced cloud profile: %w", err)
+	}
+
+	return gardenerutils.ComputeShootQuotaResources(shoot, &cloudProfile.Spec)
+}
+
+func refersToQuota(quotaRefs []corev1.ObjectReference, quota *gardencorev1beta1.Quota) bool {
+	return slices.ContainsFunc(quotaRefs, func(ref corev1.ObjectReference) bool {
+		return ref.Namespace == quota.Namespace && ref.Name == quota.Name
+	})
+}
+
+// emptyResourceList returns a resource list with zero quantities for all metrics constrained by the Quota.
+func emptyResourceList(hard corev1.ResourceList) corev1.ResourceList {
+	resources := make(corev1.ResourceList, len(hard))
+	for metric := range hard {
+		resources[metric] = resource.Quantity{}
+	}
+	return resources
+}
+
+// addResources adds the given resources to the resource list. Only metrics which are already part of the resource list
+// are considered.
+func addResources(resources, add corev1.ResourceList) {
+	for metric, quantity := range resources {
+		resources[metric] = gardenerutils.SumQuantities(quantity, add[metric])
+	}
+}
+
+// updateMetrics exposes the hard and used amounts of the Quota. Quotas with scope project are enforced per project, hence
+// their usage is reported per project namespace instead of the sum over all projects.
+func updateMetrics(quota *gardencorev1beta1.Quota, scope string) {
+	deleteMetrics(quota.Namespace, quota.Name)
+
+	for metric, quantity := range quota.Status.Hard {
+		metrics.QuotaHard.WithLabelValues(quota.Namespace, quota.Name, string(metric)).Set(quantity.AsApproximateFloat64())
+	}
+
+	if scope != "project" {
+		for metric, quantity := range quota.Status.Used {
+			metrics.QuotaUsed.WithLabelValues(quota.Namespace, quota.Name, string(metric), "").Set(quantity.AsApproximateFloat64())
+		}
+		return
+	}
+
+	for _, project := range quota.Status.Projects {
+		for metric, quantity := range project.Used {
+			metrics.QuotaUsed.WithLabelValues(quota.Namespace, quota.Name, string(metric), project.Namespace).Set(quantity.AsApproximateFloat64())
+		}
+	}
+}
+
+func deleteMetrics(namespace, name string) {
+	labels := prometheus.Labels{"namespace": namespace, "name": name}
+	metrics.QuotaHard.DeletePartialMatch(labels)
+	metrics.QuotaUsed.DeletePartialMatch(labels)
+}
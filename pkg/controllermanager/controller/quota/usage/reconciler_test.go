@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/quota/usage"
+	"github.com/gardener/gardener/pkg/controllermanager/metrics"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+		syncPeriod = 10 * time.Minute
+
+		quota              *gardencorev1beta1.Quota
+		secretBinding      *gardencorev1beta1.SecretBinding
+		credentialsBinding *securityv1alpha1.CredentialsBinding
+		otherSecretBinding *gardencorev1beta1.SecretBinding
+		cloudProfile       *gardencorev1beta1.CloudProfile
+		request            reconcile.Request
+	)
+
+	newShoot := func(namespace, name string, machineType string, maximum int32) *gardencorev1beta1.Shoot {
+		return &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfile: &gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: cloudProfile.Name},
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{
+						Name:    "worker",
+						Machine: gardencorev1beta1.Machine{Type: machineType},
+						Maximum: maximum,
+						Volume:  &gardencorev1beta1.Volume{Type: ptr.To("standard"), VolumeSize: "50Gi"},
+					}},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Quota{}).
+			Build()
+		recorder = record.NewFakeRecorder(10)
+		reconciler = &Reconciler{
+			Client:   fakeClient,
+			Config:   controllermanagerconfigv1alpha1.QuotaControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: syncPeriod}},
+			Recorder: recorder,
+		}
+
+		quota = &gardencorev1beta1.Quota{
+			ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: "garden", Generation: 2},
+			Spec: gardencorev1beta1.QuotaSpec{
+				Metrics: corev1.ResourceList{
+					"cpu":          resource.MustParse("100"),
+					"memory":       resource.MustParse("1Ti"),
+					"loadbalancer": resource.MustParse("10"),
+				},
+				Scope: corev1.ObjectReference{APIVersion: "v1", Kind: "Secret"},
+			},
+		}
+		quotaRef := corev1.ObjectReference{Name: quota.Name, Namespace: quota.Namespace}
+		secretBinding = &gardencorev1beta1.SecretBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "secretbinding", Namespace: "garden-a"},
+			Quotas:     []corev1.ObjectReference{quotaRef},
+		}
+		credentialsBinding = &securityv1alpha1.CredentialsBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "credentialsbinding", Namespace: "garden-b"},
+			Quotas:     []corev1.ObjectReference{quotaRef},
+		}
+		otherSecretBinding = &gardencorev1beta1.SecretBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "garden-a"},
+		}
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{{
+					Name:   "m1",
+					CPU:    resource.MustParse("4"),
+					GPU:    resource.MustParse("0"),
+					Memory: resource.MustParse("16Gi"),
+				}},
+				VolumeTypes: []gardencorev1beta1.VolumeType{{Name: "standard", Class: "standard"}},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(quota)}
+
+		for _, obj := range []client.Object{secretBinding, credentialsBinding, otherSecretBinding, cloudProfile} {
+			Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+		}
+
+		shootA := newShoot("garden-a", "shoot-a", "m1", 2)
+		shootA.Spec.SecretBindingName = ptr.To(secretBinding.Name)
+		shootB := newShoot("garden-b", "shoot-b", "m1", 1)
+		shootB.Spec.CredentialsBindingName = ptr.To(credentialsBinding.Name)
+		shootOther := newShoot("garden-a", "shoot-other", "m1", 5)
+		shootOther.Spec.SecretBindingName = ptr.To(otherSecretBinding.Name)
+
+		for _, obj := range []client.Object{shootA, shootB, shootOther} {
+			Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+		}
+	})
+
+	JustBeforeEach(func() {
+		Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+	})
+
+	expectUsed := func(used corev1.ResourceList, expected map[corev1.ResourceName]string) {
+		ExpectWithOffset(1, used).To(HaveLen(len(expected)))
+		for metric, value := range expected {
+			quantity := used[metric]
+			ExpectWithOffset(1, quantity.Cmp(resource.MustParse(value))).To(BeZero(), "metric %s is %s, expected %s", metric, quantity.String(), value)
+		}
+	}
+
+	It("should do nothing if the quota does not exist", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: "foo", Namespace: "garden"}})).To(Equal(reconcile.Result{}))
+	})
+
+	It("should maintain the usage of all shoots referring to the quota", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+		Expect(quota.Status.ObservedGeneration).To(Equal(int64(2)))
+		Expect(quota.Status.Hard).To(Equal(quota.Spec.Metrics))
+		expectUsed(quota.Status.Used, map[corev1.ResourceName]string{
+			"cpu":          "12",
+			"memory":       "48Gi",
+			"loadbalancer": "2",
+		})
+		Expect(quota.Status.Projects).To(BeEmpty())
+
+		Expect(testutil.ToFloat64(metrics.QuotaHard.WithLabelValues("garden", "quota", "cpu"))).To(Equal(float64(100)))
+		Expect(testutil.ToFloat64(metrics.QuotaUsed.WithLabelValues("garden", "quota", "cpu", ""))).To(Equal(float64(12)))
+	})
+
+	It("should skip shoots whose resources cannot be computed", func() {
+		shoot := newShoot("garden-a", "shoot-broken", "unknown", 1)
+		shoot.Spec.SecretBindingName = ptr.To(secretBinding.Name)
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+		expectUsed(quota.Status.Used, map[corev1.ResourceName]string{
+			"cpu":          "12",
+			"memory":       "48Gi",
+			"loadbalancer": "2",
+		})
+		Expect(recorder.Events).To(Receive(ContainSubstring("garden-a/shoot-broken")))
+	})
+
+	Context("scope is project", func() {
+		BeforeEach(func() {
+			quota.Spec.Scope = corev1.ObjectReference{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Project"}
+		})
+
+		It("should maintain the usage per project", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+			expectUsed(quota.Status.Used, map[corev1.ResourceName]string{
+				"cpu":          "12",
+				"memory":       "48Gi",
+				"loadbalancer": "2",
+			})
+			Expect(quota.Status.Projects).To(HaveLen(2))
+			Expect(quota.Status.Projects[0].Namespace).To(Equal("garden-a"))
+			expectUsed(quota.Status.Projects[0].Used, map[corev1.ResourceName]string{
+				"cpu":          "8",
+				"memory":       "32Gi",
+				"loadbalancer": "1",
+			})
+			Expect(quota.Status.Projects[1].Namespace).To(Equal("garden-b"))
+			expectUsed(quota.Status.Projects[1].Used, map[corev1.ResourceName]string{
+				"cpu":          "4",
+				"memory":       "16Gi",
+				"loadbalancer": "1",
+			})
+
+			Expect(testutil.ToFloat64(metrics.QuotaUsed.WithLabelValues("garden", "quota", "cpu", "garden-a"))).To(Equal(float64(8)))
+			Expect(testutil.ToFloat64(metrics.QuotaUsed.WithLabelValues("garden", "quota", "cpu", "garden-b"))).To(Equal(float64(4)))
+			Expect(testutil.CollectAndCount(metrics.QuotaUsed)).To(Equal(6))
+		})
+	})
+
+	Describe("#MapBindingToQuotas", func() {
+		It("should map the binding to the referenced quotas", func() {
+			Expect(MapBindingToQuotas(ctx, secretBinding)).To(ConsistOf(request))
+			Expect(MapBindingToQuotas(ctx, credentialsBinding)).To(ConsistOf(request))
+			Expect(MapBindingToQuotas(ctx, otherSecretBinding)).To(BeEmpty())
+		})
+	})
+
+	Describe("#MapShootToQuotas", func() {
+		It("should map the shoot to the quotas referenced by its binding", func() {
+			shoot := &gardencorev1beta1.Shoot{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "garden-b", Name: "shoot-b"}, shoot)).To(Succeed())
+
+			Expect(reconciler.MapShootToQuotas(GinkgoLogr)(ctx, shoot)).To(ConsistOf(request))
+		})
+	})
+})
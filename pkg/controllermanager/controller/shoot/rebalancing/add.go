@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+	schedulervalidation "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1/validation"
+	schedulershoot "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "shoot-rebalancing"
+
+var schedulerConfigDecoder runtime.Decoder
+
+func init() {
+	configScheme := runtime.NewScheme()
+	utilruntime.Must(schedulerconfigv1alpha1.AddToScheme(configScheme))
+	schedulerConfigDecoder = serializer.NewCodecFactory(configScheme).UniversalDecoder()
+}
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
+	}
+	if r.APIReader == nil {
+		r.APIReader = mgr.GetAPIReader()
+	}
+	if r.Scheduler == nil {
+		schedulerConfig, err := ShootSchedulerConfiguration(r.Config.SchedulerConfigFile)
+		if err != nil {
+			return err
+		}
+
+		r.Scheduler = &schedulershoot.Reconciler{
+			Client:          r.Client,
+			Config:          schedulerConfig,
+			GardenNamespace: v1beta1constants.GardenNamespace,
+		}
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&gardencorev1beta1.Shoot{}, builder.WithPredicates(r.ShootPredicate())).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+			ReconciliationTimeout:   controllerutils.DefaultReconciliationTimeout,
+		}).
+		Watches(
+			&gardencorev1beta1.Seed{},
+			handler.EnqueueRequestsFromMapFunc(r.MapSeedToShoots(mgr.GetLogger().WithValues("controller", ControllerName))),
+			builder.WithPredicates(r.SeedPredicate()),
+		).
+		Complete(r)
+}
+
+// ShootPredicate reacts on Shoot events that might change the result of the rebalancing policies. All other shoots
+// are evaluated periodically.
+func (r *Reconciler) ShootPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool { return true },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			shoot, ok := e.ObjectNew.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			oldShoot, ok := e.ObjectOld.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(oldShoot.Spec.SeedSelector, shoot.Spec.SeedSelector) ||
+				!apiequality.Semantic.DeepEqual(oldShoot.Spec.Tolerations, shoot.Spec.Tolerations) ||
+				!apiequality.Semantic.DeepEqual(oldShoot.Spec.Maintenance, shoot.Spec.Maintenance)
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
+// SeedPredicate reacts on Seed events that might change the result of the rebalancing policies for the shoots
+// running on the seed.
+func (r *Reconciler) SeedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			seed, ok := e.ObjectNew.(*gardencorev1beta1.Seed)
+			if !ok {
+				return false
+			}
+
+			oldSeed, ok := e.ObjectOld.(*gardencorev1beta1.Seed)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(oldSeed.Labels, seed.Labels) ||
+				!apiequality.Semantic.DeepEqual(oldSeed.Spec.Taints, seed.Spec.Taints) ||
+				!apiequality.Semantic.DeepEqual(oldSeed.Spec.Settings, seed.Spec.Settings)
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
+// MapSeedToShoots is a handler.MapFunc for mapping a Seed to all Shoots whose control planes run on it.
+func (r *Reconciler) MapSeedToShoots(log logr.Logger) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := r.Client.List(ctx, shootList, client.MatchingFields{core.ShootStatusSeedName: obj.GetName()}); err != nil {
+			log.Error(err, "Failed to list shoots for seed", "seed", obj.GetName())
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(shootList.Items))
+		for _, shoot := range shootList.Items {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&shoot)})
+		}
+		return requests
+	}
+}
+
+// ShootSchedulerConfiguration reads the component configuration of the gardener-scheduler from the given file and
+// returns its (defaulted) shoot scheduler configuration. This way, the controller uses the same configuration as the
+// gardener-scheduler instead of a copy which might diverge. If no file is given, the default configuration is
+// returned.
+func ShootSchedulerConfiguration(configFile *string) (*schedulerconfigv1alpha1.ShootSchedulerConfiguration, error) {
+	config := &schedulerconfigv1alpha1.SchedulerConfiguration{}
+
+	if configFile == nil {
+		schedulerconfigv1alpha1.SetObjectDefaults_SchedulerConfiguration(config)
+		return config.Schedulers.Shoot, nil
+	}
+
+	data, err := os.ReadFile(*configFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading gardener-scheduler config file: %w", err)
+	}
+	if err := runtime.DecodeInto(schedulerConfigDecoder, data, config); err != nil {
+		return nil, fmt.Errorf("error decoding gardener-scheduler config: %w", err)
+	}
+	if errs := schedulervalidation.ValidateConfiguration(config); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid gardener-scheduler config: %w", errs.ToAggregate())
+	}
+
+	return config.Schedulers.Shoot, nil
+}
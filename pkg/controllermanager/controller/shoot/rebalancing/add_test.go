@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/rebalancing"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+var _ = Describe("Add", func() {
+	var reconciler *Reconciler
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+	})
+
+	Describe("#ShootPredicate", func() {
+		var (
+			predicate predicate.Predicate
+			shoot     *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			predicate = reconciler.ShootPredicate()
+			shoot = &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed")}}
+		})
+
+		It("should return true for create events", func() {
+			Expect(predicate.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false if nothing relevant changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Labels = map[string]string{"foo": "bar"}
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeFalse())
+		})
+
+		It("should return true if the seed selector changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Spec.SeedSelector = &gardencorev1beta1.SeedSelector{}
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeTrue())
+		})
+
+		It("should return true if the tolerations changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Spec.Tolerations = []gardencorev1beta1.Toleration{{Key: "foo"}}
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeTrue())
+		})
+
+		It("should return false for delete and generic events", func() {
+			Expect(predicate.Delete(event.DeleteEvent{Object: shoot})).To(BeFalse())
+			Expect(predicate.Generic(event.GenericEvent{Object: shoot})).To(BeFalse())
+		})
+	})
+
+	Describe("#SeedPredicate", func() {
+		var (
+			predicate predicate.Predicate
+			seed      *gardencorev1beta1.Seed
+		)
+
+		BeforeEach(func() {
+			predicate = reconciler.SeedPredicate()
+			seed = &gardencorev1beta1.Seed{}
+		})
+
+		It("should return false if nothing relevant changed", func() {
+			newSeed := seed.DeepCopy()
+			newSeed.Status.Conditions = []gardencorev1beta1.Condition{{Type: "foo"}}
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: newSeed})).To(BeFalse())
+		})
+
+		It("should return true if the taints changed", func() {
+			newSeed := seed.DeepCopy()
+			newSeed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: newSeed})).To(BeTrue())
+		})
+
+		It("should return true if the labels changed", func() {
+			newSeed := seed.DeepCopy()
+			newSeed.Labels = map[string]string{"foo": "bar"}
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: newSeed})).To(BeTrue())
+		})
+	})
+
+	Describe("#MapSeedToShoots", func() {
+		It("should map the seed to all shoots running on it", func() {
+			ctx := context.Background()
+			reconciler.Client = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Shoot{}, core.ShootStatusSeedName, func(obj client.Object) []string {
+					return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Status.SeedName, "")}
+				}).
+				Build()
+
+			for name, seedName := range map[string]string{"shoot-1": "seed", "shoot-2": "other", "shoot-3": "seed"} {
+				Expect(reconciler.Client.Create(ctx, &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-dev"},
+					Status:     gardencorev1beta1.ShootStatus{SeedName: ptr.To(seedName)},
+				})).To(Succeed())
+			}
+
+			Expect(reconciler.MapSeedToShoots(logr.Discard())(ctx, &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}})).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden-dev", Name: "shoot-1"}},
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden-dev", Name: "shoot-3"}},
+			))
+		})
+	})
+
+	Describe("#ShootSchedulerConfiguration", func() {
+		var configFile string
+
+		BeforeEach(func() {
+			configFile = filepath.Join(GinkgoT().TempDir(), "schedulerconfiguration.yaml")
+		})
+
+		writeConfig := func(data string) {
+			Expect(os.WriteFile(configFile, []byte(data), 0600)).To(Succeed())
+		}
+
+		It("should return the default configuration if no file is given", func() {
+			Expect(ShootSchedulerConfiguration(nil)).To(Equal(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				ConcurrentSyncs: 5,
+				Strategy:        schedulerconfigv1alpha1.Default,
+			}))
+		})
+
+		It("should return the shoot scheduler configuration of the given file", func() {
+			writeConfig(`apiVersion: scheduler.config.gardener.cloud/v1alpha1
+kind: SchedulerConfiguration
+schedulers:
+  shoot:
+    candidateDeterminationStrategy: MinimalDistance
+`)
+
+			Expect(ShootSchedulerConfiguration(&configFile)).To(Equal(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				ConcurrentSyncs: 5,
+				Strategy:        schedulerconfigv1alpha1.MinimalDistance,
+			}))
+		})
+
+		It("should fail if the file does not exist", func() {
+			_, err := ShootSchedulerConfiguration(&configFile)
+			Expect(err).To(MatchError(ContainSubstring("error reading gardener-scheduler config file")))
+		})
+
+		It("should fail if the configuration is invalid", func() {
+			writeConfig(`apiVersion: scheduler.config.gardener.cloud/v1alpha1
+kind: SchedulerConfiguration
+schedulers:
+  shoot:
+    candidateDeterminationStrategy: Foo
+`)
+
+			_, err := ShootSchedulerConfiguration(&configFile)
+			Expect(err).To(MatchError(ContainSubstring("invalid gardener-scheduler config")))
+		})
+	})
+})
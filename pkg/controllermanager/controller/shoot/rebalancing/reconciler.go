@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	schedulershoot "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// EventRebalancingProposed is the event reason for a proposed migration of a shoot control plane.
+	EventRebalancingProposed = "RebalancingProposed"
+	// EventRebalancingMigrationTriggered is the event reason for a triggered migration of a shoot control plane.
+	EventRebalancingMigrationTriggered = "RebalancingMigrationTriggered"
+	// EventRebalancingFailed is the event reason for a failed attempt to determine a destination seed.
+	EventRebalancingFailed = "RebalancingFailed"
+)
+
+// Scheduler determines the destination seed for a shoot.
+type Scheduler interface {
+	Schedule(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, opts ...schedulershoot.ScheduleOption) (*schedulershoot.SchedulingResult, error)
+}
+
+// Reconciler evaluates the rebalancing policies for shoots and proposes or triggers the migration of their control
+// planes to other seeds.
+type Reconciler struct {
+	Client    client.Client
+	APIReader client.Reader
+	Config    controllermanagerconfigv1alpha1.ShootRebalancingControllerConfiguration
+	Clock     clock.Clock
+	Recorder  record.EventRecorder
+	Scheduler Scheduler
+
+	// migrationLock serializes the evaluation of the migration budgets and the subsequent bindings of the shoots, so
+	// that concurrent reconciliations cannot exceed the budgets.
+	migrationLock sync.Mutex
+}
+
+// Reconcile evaluates the rebalancing policies for shoots and proposes or triggers the migration of their control
+// planes to other seeds.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		log.V(1).Info("Skipping Shoot because it is marked for deletion")
+		return reconcile.Result{}, nil
+	}
+
+	if reason := skipReason(shoot); reason != "" {
+		log.V(1).Info("Skipping Shoot", "reason", reason)
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: *shoot.Spec.SeedName}, seed); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reading seed %s: %w", *shoot.Spec.SeedName, err)
+	}
+
+	reason, err := r.rebalancingReason(shoot, seed)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if reason == "" {
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+	log = log.WithValues("seed", seed.Name, "reason", reason)
+
+	migrate := ptr.Deref(r.Config.Mode, controllermanagerconfigv1alpha1.ShootRebalancingModePropose) == controllermanagerconfigv1alpha1.ShootRebalancingModeMigrate
+	if migrate && !gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(shoot, r.Clock) {
+		requeueAfter := gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot).RandomDurationUntilNext(r.Clock.Now(), false)
+		log.V(1).Info("Shoot control plane needs to be migrated, waiting for the next maintenance time window", "duration", requeueAfter.Round(time.Minute))
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	result, err := r.Scheduler.Schedule(ctx, log, shoot,
+		schedulershoot.WithExcludedSeeds(seed.Name),
+		schedulershoot.WithResourceUtilizationThreshold(r.Config.ResourceUtilizationThreshold),
+	)
+	if err != nil {
+		log.Info("No destination seed found for migrating the shoot control plane", "error", err.Error())
+		r.Recorder.Eventf(shoot, corev1.EventTypeWarning, EventRebalancingFailed, "Control plane should be migrated away from seed %q (%s), but no destination seed was found: %v", seed.Name, reason, err)
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+	destination := result.Seed.Name
+
+	if !migrate {
+		log.Info("Proposing migration of shoot control plane", "destination", destination)
+		r.Recorder.Eventf(shoot, corev1.EventTypeNormal, EventRebalancingProposed, "Proposing migration of control plane from seed %q to seed %q: %s", seed.Name, destination, reason)
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	return r.migrate(ctx, log, shoot, destination, reason)
+}
+
+func (r *Reconciler) migrate(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, destination, reason string) (reconcile.Result, error) {
+	source := *shoot.Spec.SeedName
+
+	r.migrationLock.Lock()
+	defer r.migrationLock.Unlock()
+
+	for _, seedName := range []string{source, destination} {
+		migrations, err := r.ongoingMigrations(ctx, seedName)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if migrations >= int(ptr.Deref(r.Config.MaxConcurrentMigrationsPerSeed, 1)) {
+			log.Info("Maximum number of concurrent migrations reached for seed, retrying later", "destination", destination, "budgetSeed", seedName, "migrations", migrations)
+			return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+		}
+	}
+
+	log.Info("Triggering migration of shoot control plane", "destination", destination)
+	shoot.Spec.SeedName = &destination
+	if err := r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to bind shoot to destination seed %s: %w", destination, err)
+	}
+
+	r.Recorder.Eventf(shoot, corev1.EventTypeNormal, EventRebalancingMigrationTriggered, "Triggered migration of control plane from seed %q to seed %q: %s", source, destination, reason)
+	return reconcile.Result{}, nil
+}
+
+// ongoingMigrations returns the number of shoots whose control planes are currently migrated from or to the given
+// seed. The shoots are read from the API server (not from the cache) so that bindings of previous reconciliations are
+// always taken into account.
+func (r *Reconciler) ongoingMigrations(ctx context.Context, seedName string) (int, error) {
+	migratingShoots := sets.New[client.ObjectKey]()
+
+	for _, field := range []string{core.ShootSeedName, core.ShootStatusSeedName} {
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := r.APIReader.List(ctx, shootList, client.MatchingFields{field: seedName}); err != nil {
+			return 0, fmt.Errorf("failed listing shoots for seed %s: %w", seedName, err)
+		}
+
+		for _, shoot := range shootList.Items {
+			if isMigrationInFlight(&shoot) {
+				migratingShoots.Insert(client.ObjectKeyFromObject(&shoot))
+			}
+		}
+	}
+
+	return migratingShoots.Len(), nil
+}
+
+// isMigrationInFlight returns true if the control plane of the given shoot is being migrated, i.e., if it is not yet
+// migrated away from the source seed (`.status.seedName` differs from `.spec.seedName`) or not yet restored on the
+// destination seed (last operation is a `Restore` which did not succeed yet).
+func isMigrationInFlight(shoot *gardencorev1beta1.Shoot) bool {
+	if v1beta1helper.ShouldPrepareShootForMigration(shoot) {
+		return true
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	return lastOperation != nil &&
+		lastOperation.Type == gardencorev1beta1.LastOperationTypeRestore &&
+		lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded
+}
+
+// skipReason returns why the given shoot is not considered for rebalancing, or an empty string.
+func skipReason(shoot *gardencorev1beta1.Shoot) string {
+	switch {
+	case shoot.Spec.SeedName == nil || shoot.Status.SeedName == nil:
+		return "shoot is not yet scheduled"
+	case v1beta1helper.ShouldPrepareShootForMigration(shoot):
+		return "shoot control plane is already being migrated"
+	case v1beta1helper.IsShootSelfHosted(shoot.Spec.Provider.Workers):
+		return "shoot is self-hosted"
+	case shoot.Status.LastOperation == nil || shoot.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded:
+		return "last operation of shoot did not succeed"
+	}
+	return ""
+}
+
+// rebalancingReason evaluates the configured policies and returns why the shoot control plane should be migrated away
+// from the given seed, or an empty string.
+func (r *Reconciler) rebalancingReason(shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed) (string, error) {
+	for _, policy := range r.Config.Policies {
+		switch policy {
+		case controllermanagerconfigv1alpha1.ShootRebalancingPolicySeedOverUtilized:
+			if r.Config.ResourceUtilizationThreshold == nil {
+				continue
+			}
+			if err := schedulershoot.CheckSeedResourceUtilization(seed, *r.Config.ResourceUtilizationThreshold); err != nil {
+				return err.Error(), nil
+			}
+
+		case controllermanagerconfigv1alpha1.ShootRebalancingPolicySeedTainted:
+			if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, shoot.Spec.Tolerations) {
+				return "shoot does not tolerate the seed's taints", nil
+			}
+			if seed.Spec.Settings == nil || seed.Spec.Settings.Scheduling == nil || !seed.Spec.Settings.Scheduling.Visible {
+				return "seed is not visible for scheduling", nil
+			}
+
+		case controllermanagerconfigv1alpha1.ShootRebalancingPolicySeedSelectorMismatch:
+			if shoot.Spec.SeedSelector == nil {
+				continue
+			}
+			selector, err := metav1.LabelSelectorAsSelector(&shoot.Spec.SeedSelector.LabelSelector)
+			if err != nil {
+				return "", fmt.Errorf("failed converting seed selector of shoot: %w", err)
+			}
+			if !selector.Matches(labels.Set(seed.Labels)) {
+				return "seed does not match the seed selector of the shoot", nil
+			}
+
+		default:
+			return "", fmt.Errorf("unknown rebalancing policy %q", policy)
+		}
+	}
+
+	return "", nil
+}
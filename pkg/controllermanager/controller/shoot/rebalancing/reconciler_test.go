@@ -0,0 +1,314 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/rebalancing"
+	schedulershoot "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+)
+
+type fakeScheduler struct {
+	seed *gardencorev1beta1.Seed
+	err  error
+	opts []schedulershoot.ScheduleOption
+}
+
+func (f *fakeScheduler) Schedule(_ context.Context, _ logr.Logger, _ *gardencorev1beta1.Shoot, opts ...schedulershoot.ScheduleOption) (*schedulershoot.SchedulingResult, error) {
+	f.opts = opts
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &schedulershoot.SchedulingResult{Seed: f.seed}, nil
+}
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *record.FakeRecorder
+		scheduler  *fakeScheduler
+		reconciler *Reconciler
+
+		bindings     []string
+		bindingsLock sync.Mutex
+		bindingDelay time.Duration
+
+		sourceSeed      *gardencorev1beta1.Seed
+		destinationSeed *gardencorev1beta1.Seed
+		shoot           *gardencorev1beta1.Shoot
+		request         reconcile.Request
+
+		newSeed = func(name string) *gardencorev1beta1.Seed {
+			return &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: gardencorev1beta1.SeedSpec{
+					Settings: &gardencorev1beta1.SeedSettings{Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true}},
+				},
+			}
+		}
+		newShoot = func(name, specSeedName, statusSeedName string) *gardencorev1beta1.Shoot {
+			return &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-dev"},
+				Spec: gardencorev1beta1.ShootSpec{
+					SeedName: &specSeedName,
+					Maintenance: &gardencorev1beta1.Maintenance{
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+					},
+				},
+				Status: gardencorev1beta1.ShootStatus{
+					SeedName:      &statusSeedName,
+					LastOperation: &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded},
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		bindings = nil
+		bindingDelay = 0
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Spec.SeedName, "")}
+			}).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootStatusSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Status.SeedName, "")}
+			}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					if subResourceName == "binding" {
+						time.Sleep(bindingDelay)
+						bindingsLock.Lock()
+						defer bindingsLock.Unlock()
+						bindings = append(bindings, *obj.(*gardencorev1beta1.Shoot).Spec.SeedName)
+						return c.Update(ctx, obj)
+					}
+					return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+				},
+			}).
+			Build()
+
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 1, 1, 22, 30, 0, 0, time.UTC))
+		recorder = record.NewFakeRecorder(10)
+
+		sourceSeed = newSeed("source")
+		sourceSeed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "deprecated"}}
+		destinationSeed = newSeed("destination")
+		scheduler = &fakeScheduler{seed: destinationSeed}
+
+		reconciler = &Reconciler{
+			Client:    fakeClient,
+			APIReader: fakeClient,
+			Config: controllermanagerconfigv1alpha1.ShootRebalancingControllerConfiguration{
+				SyncPeriod:                     &metav1.Duration{Duration: 30 * time.Minute},
+				Mode:                           ptr.To(controllermanagerconfigv1alpha1.ShootRebalancingModeMigrate),
+				Policies:                       []controllermanagerconfigv1alpha1.ShootRebalancingPolicy{controllermanagerconfigv1alpha1.ShootRebalancingPolicySeedTainted},
+				ResourceUtilizationThreshold:   ptr.To[int32](80),
+				MaxConcurrentMigrationsPerSeed: ptr.To[int32](1),
+			},
+			Clock:     fakeClock,
+			Recorder:  recorder,
+			Scheduler: scheduler,
+		}
+
+		shoot = newShoot("shoot", "source", "source")
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+	})
+
+	reconcileShoot := func() (reconcile.Result, error) {
+		Expect(fakeClient.Create(ctx, sourceSeed)).To(Succeed())
+		Expect(fakeClient.Create(ctx, destinationSeed)).To(Succeed())
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		return reconciler.Reconcile(ctx, request)
+	}
+
+	It("should migrate the shoot during its maintenance time window", func() {
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{}))
+
+		Expect(bindings).To(ConsistOf("destination"))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Spec.SeedName).To(Equal(ptr.To("destination")))
+		Expect(scheduler.opts).To(HaveLen(2))
+		Expect(recorder.Events).To(Receive(Equal(`Normal RebalancingMigrationTriggered Triggered migration of control plane from seed "source" to seed "destination": shoot does not tolerate the seed's taints`)))
+	})
+
+	It("should wait for the maintenance time window", func() {
+		fakeClock.SetTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+		result, err := reconcileShoot()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">=", 10*time.Hour))
+		Expect(result.RequeueAfter).To(BeNumerically("<", 11*time.Hour))
+
+		Expect(bindings).To(BeEmpty())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should only propose the migration in mode Propose", func() {
+		reconciler.Config.Mode = ptr.To(controllermanagerconfigv1alpha1.ShootRebalancingModePropose)
+		fakeClock.SetTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Minute}))
+
+		Expect(bindings).To(BeEmpty())
+		Expect(recorder.Events).To(Receive(Equal(`Normal RebalancingProposed Proposing migration of control plane from seed "source" to seed "destination": shoot does not tolerate the seed's taints`)))
+	})
+
+	It("should not migrate the shoot if no policy applies", func() {
+		shoot.Spec.Tolerations = []gardencorev1beta1.Toleration{{Key: "deprecated"}}
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Minute}))
+
+		Expect(bindings).To(BeEmpty())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should report if no destination seed is found", func() {
+		scheduler.err = errors.New("no matching seed candidate found")
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Minute}))
+
+		Expect(bindings).To(BeEmpty())
+		Expect(recorder.Events).To(Receive(ContainSubstring("Warning RebalancingFailed")))
+	})
+
+	It("should not migrate shoots which are already being migrated", func() {
+		shoot.Spec.SeedName = ptr.To("destination")
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Minute}))
+		Expect(bindings).To(BeEmpty())
+	})
+
+	It("should not migrate shoots whose last operation did not succeed", func() {
+		shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateFailed
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Minute}))
+		Expect(bindings).To(BeEmpty())
+	})
+
+	Context("migration budget", func() {
+		It("should not migrate the shoot if the budget of the source seed is exhausted", func() {
+			Expect(fakeClient.Create(ctx, newShoot("other", "third", "source"))).To(Succeed())
+
+			Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Minute}))
+			Expect(bindings).To(BeEmpty())
+		})
+
+		It("should not migrate the shoot if the budget of the destination seed is exhausted", func() {
+			Expect(fakeClient.Create(ctx, newShoot("other", "destination", "third"))).To(Succeed())
+
+			Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Minute}))
+			Expect(bindings).To(BeEmpty())
+		})
+
+		It("should not migrate the shoot if the budget of the destination seed is exhausted by a restoring shoot", func() {
+			restoringShoot := newShoot("other", "destination", "destination")
+			restoringShoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore, State: gardencorev1beta1.LastOperationStateProcessing}
+			Expect(fakeClient.Create(ctx, restoringShoot)).To(Succeed())
+
+			Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Minute}))
+			Expect(bindings).To(BeEmpty())
+		})
+
+		It("should not count shoots whose restoration succeeded", func() {
+			restoredShoot := newShoot("other", "destination", "destination")
+			restoredShoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore, State: gardencorev1beta1.LastOperationStateSucceeded}
+			Expect(fakeClient.Create(ctx, restoredShoot)).To(Succeed())
+
+			Expect(reconcileShoot()).To(Equal(reconcile.Result{}))
+			Expect(bindings).To(ConsistOf("destination"))
+		})
+
+		It("should not exceed the budget when shoots are reconciled concurrently", func() {
+			otherShoot := newShoot("other", "source", "source")
+			Expect(fakeClient.Create(ctx, sourceSeed)).To(Succeed())
+			Expect(fakeClient.Create(ctx, destinationSeed)).To(Succeed())
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+			Expect(fakeClient.Create(ctx, otherShoot)).To(Succeed())
+			// Delay the bindings so that both reconciliations evaluate the budgets before the first binding if they are
+			// not serialized.
+			bindingDelay = 100 * time.Millisecond
+
+			var wg sync.WaitGroup
+			for _, req := range []reconcile.Request{request, {NamespacedName: client.ObjectKeyFromObject(otherShoot)}} {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+
+					_, err := reconciler.Reconcile(ctx, req)
+					Expect(err).NotTo(HaveOccurred())
+				}()
+			}
+			wg.Wait()
+
+			Expect(bindings).To(ConsistOf("destination"))
+		})
+
+		It("should migrate the shoot if the budget allows it", func() {
+			reconciler.Config.MaxConcurrentMigrationsPerSeed = ptr.To[int32](2)
+			Expect(fakeClient.Create(ctx, newShoot("other", "third", "source"))).To(Succeed())
+
+			Expect(reconcileShoot()).To(Equal(reconcile.Result{}))
+			Expect(bindings).To(ConsistOf("destination"))
+		})
+	})
+
+	Context("policies", func() {
+		BeforeEach(func() {
+			sourceSeed.Spec.Taints = nil
+			reconciler.Config.Mode = ptr.To(controllermanagerconfigv1alpha1.ShootRebalancingModePropose)
+		})
+
+		It("should propose the migration if the seed is not visible for scheduling", func() {
+			sourceSeed.Spec.Settings.Scheduling.Visible = false
+
+			Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Minute}))
+			Expect(recorder.Events).To(Receive(HaveSuffix("seed is not visible for scheduling")))
+		})
+
+		It("should propose the migration if the seed is over-utilized", func() {
+			reconciler.Config.Policies = []controllermanagerconfigv1alpha1.ShootRebalancingPolicy{controllermanagerconfigv1alpha1.ShootRebalancingPolicySeedOverUtilized}
+			sourceSeed.Status.Allocatable = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10")}
+			sourceSeed.Status.Usage = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("9")}
+
+			Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Minute}))
+			Expect(recorder.Events).To(Receive(HaveSuffix("seed exceeds the cpu utilization threshold (90.0% > 80%)")))
+		})
+
+		It("should propose the migration if the seed does not match the seed selector of the shoot", func() {
+			reconciler.Config.Policies = []controllermanagerconfigv1alpha1.ShootRebalancingPolicy{controllermanagerconfigv1alpha1.ShootRebalancingPolicySeedSelectorMismatch}
+			shoot.Spec.SeedSelector = &gardencorev1beta1.SeedSelector{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}}
+
+			Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Minute}))
+			Expect(recorder.Events).To(Receive(HaveSuffix("seed does not match the seed selector of the shoot")))
+		})
+	})
+})
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Namespace is the metric namespace for the gardener-controller-manager.
+const Namespace = "gardener_controller_manager"
+
+var (
+	// Factory is used for registering metrics in the controller-runtime metrics registry.
+	factory = promauto.With(runtimemetrics.Registry)
+	// QuotaHard defines the gauge quota_hard.
+	QuotaHard = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "quota_hard",
+			Help:      "Amount of a metric which is constrained by a Quota.",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"metric",
+		},
+	)
+	// QuotaUsed defines the gauge quota_used.
+	QuotaUsed = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "quota_used",
+			Help:      "Amount of a metric which is currently allocated by all Shoots referring to a Quota. For Quotas with scope Project, the amount is reported per project namespace.",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"metric",
+			"project",
+		},
+	)
+)
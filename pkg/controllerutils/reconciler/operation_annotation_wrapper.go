@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 type operationAnnotationWrapper struct {
@@ -23,6 +24,8 @@ type operationAnnotationWrapper struct {
 
 // OperationAnnotationWrapper is a wrapper for an reconciler that
 // removes the Gardener operation annotation before `Reconcile` is called.
+// If the object carries a trace context in the GardenerTraceParent annotation
+// together with the operation annotation, it is passed to `Reconcile` via the context.
 //
 // This is useful in conjunction with the HasOperationAnnotation predicate.
 func OperationAnnotationWrapper(mgr manager.Manager, newObjFunc func() client.Object, reconciler reconcile.Reconciler) reconcile.Reconciler {
@@ -45,6 +48,10 @@ func (o *operationAnnotationWrapper) Reconcile(ctx context.Context, request reco
 	}
 
 	if annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationReconcile {
+		// The trace context belongs to the operation which requested the reconciliation, hence it is only extracted
+		// together with the operation annotation.
+		ctx = tracing.ExtractFromObject(ctx, obj)
+
 		withOpAnnotation := obj.DeepCopyObject().(client.Object)
 		delete(annotations, v1beta1constants.GardenerOperation)
 		obj.SetAnnotations(annotations)
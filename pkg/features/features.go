@@ -80,6 +80,13 @@ const (
 	// owner: @vitanovs @ialidzhikov
 	// alpha: v1.133.0
 	VPAInPlaceUpdates featuregate.Feature = "VPAInPlaceUpdates"
+
+	// StagedOperatingSystemConfigRollout enables the staged rollout of operating system config changes which do not
+	// require rolling the nodes. Only `maxUnavailable` nodes per worker pool apply a new operating system config at the
+	// same time.
+	// owner: @vpnachev
+	// alpha: v1.133.0
+	StagedOperatingSystemConfigRollout featuregate.Feature = "StagedOperatingSystemConfigRollout"
 )
 
 // DefaultFeatureGate is the central feature gate map used by all gardener components.
@@ -107,16 +114,17 @@ var DefaultFeatureGate = utilfeature.DefaultMutableFeatureGate
 
 // AllFeatureGates is the list of all feature gates.
 var AllFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
-	DefaultSeccompProfile:      {Default: false, PreRelease: featuregate.Alpha},
-	ShootCredentialsBinding:    {Default: true, PreRelease: featuregate.Beta},
-	NewWorkerPoolHash:          {Default: true, PreRelease: featuregate.Beta},
-	InPlaceNodeUpdates:         {Default: false, PreRelease: featuregate.Alpha},
-	IstioTLSTermination:        {Default: false, PreRelease: featuregate.Alpha},
-	CloudProfileCapabilities:   {Default: false, PreRelease: featuregate.Alpha},
-	DoNotCopyBackupCredentials: {Default: true, PreRelease: featuregate.Beta},
-	OpenTelemetryCollector:     {Default: false, PreRelease: featuregate.Alpha},
-	UseUnifiedHTTPProxyPort:    {Default: false, PreRelease: featuregate.Alpha},
-	VPAInPlaceUpdates:          {Default: false, PreRelease: featuregate.Alpha},
+	DefaultSeccompProfile:              {Default: false, PreRelease: featuregate.Alpha},
+	ShootCredentialsBinding:            {Default: true, PreRelease: featuregate.Beta},
+	NewWorkerPoolHash:                  {Default: true, PreRelease: featuregate.Beta},
+	InPlaceNodeUpdates:                 {Default: false, PreRelease: featuregate.Alpha},
+	IstioTLSTermination:                {Default: false, PreRelease: featuregate.Alpha},
+	CloudProfileCapabilities:           {Default: false, PreRelease: featuregate.Alpha},
+	DoNotCopyBackupCredentials:         {Default: true, PreRelease: featuregate.Beta},
+	OpenTelemetryCollector:             {Default: false, PreRelease: featuregate.Alpha},
+	UseUnifiedHTTPProxyPort:            {Default: false, PreRelease: featuregate.Alpha},
+	VPAInPlaceUpdates:                  {Default: false, PreRelease: featuregate.Alpha},
+	StagedOperatingSystemConfigRollout: {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // GetFeatures returns a feature gate map with the respective specifications. Non-existing feature gates are ignored.
@@ -0,0 +1,542 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	containerd "github.com/containerd/containerd/v2/client"
+	"github.com/containerd/containerd/v2/core/mount"
+	"github.com/containerd/containerd/v2/defaults"
+	"github.com/containerd/containerd/v2/pkg/namespaces"
+	"github.com/containerd/errdefs"
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	bootstrapetcd "github.com/gardener/gardener/pkg/component/etcd/bootstrap"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+var (
+	// DrainNodeInterval is the interval at which we try to evict the pods from the node.
+	// Exposed for testing.
+	DrainNodeInterval = 5 * time.Second
+
+	// RemoveContainers removes the containers (and their tasks) of the Kubernetes namespace of containerd, or of all
+	// containerd namespaces if allNamespaces is true.
+	// Exposed for testing.
+	RemoveContainers = removeContainers
+	// UnmountRecursive unmounts the given target and all mounts below it.
+	// Exposed for testing.
+	UnmountRecursive = mount.UnmountRecursive
+)
+
+const (
+	pathSystemdUnits    = "/etc/systemd/system"
+	pathContainerdState = "/var/lib/containerd"
+	pathContainerdRun   = "/run/containerd"
+
+	// containerdNamespaceKubernetes is the containerd namespace used by the CRI plugin, i.e., for the containers of pods.
+	containerdNamespaceKubernetes = "k8s.io"
+)
+
+// DrainNode cordons the node of this machine and evicts all pods running on it, except for mirror pods of static pods
+// and pods managed by DaemonSets. It gives up if not all pods were evicted within the given timeout (e.g., because
+// PodDisruptionBudgets do not allow it). Nothing is done if there is no node object for this machine.
+func (b *GardenadmBotanist) DrainNode(ctx context.Context, timeout time.Duration) error {
+	c := b.SeedClientSet.Client()
+
+	node, err := nodeagent.FetchNodeByHostName(ctx, c, b.HostName)
+	if err != nil {
+		return fmt.Errorf("failed fetching node object by hostname %q: %w", b.HostName, err)
+	}
+	if node == nil {
+		b.Logger.Info("No node object found for this machine, nothing to drain", "hostName", b.HostName)
+		return nil
+	}
+
+	if !node.Spec.Unschedulable {
+		patch := client.MergeFrom(node.DeepCopy())
+		node.Spec.Unschedulable = true
+		if err := c.Patch(ctx, node, patch); err != nil {
+			return fmt.Errorf("failed cordoning node %q: %w", node.Name, err)
+		}
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return retry.Until(timeoutCtx, DrainNodeInterval, func(ctx context.Context) (bool, error) {
+		podList := &corev1.PodList{}
+		if err := c.List(ctx, podList, client.MatchingFields{"spec.nodeName": node.Name}); err != nil {
+			return retry.SevereError(fmt.Errorf("failed listing pods on node %q: %w", node.Name, err))
+		}
+
+		var remainingPods []string
+		for _, pod := range podList.Items {
+			if !mustEvictPod(pod) {
+				continue
+			}
+			remainingPods = append(remainingPods, client.ObjectKeyFromObject(&pod).String())
+
+			if pod.DeletionTimestamp != nil {
+				continue
+			}
+
+			if err := c.SubResource("eviction").Create(ctx, &pod, &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace}}); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				if apierrors.IsTooManyRequests(err) {
+					b.Logger.Info("Eviction of pod is currently not allowed, retrying", "pod", client.ObjectKeyFromObject(&pod), "reason", err.Error())
+					continue
+				}
+				return retry.SevereError(fmt.Errorf("failed evicting pod %s: %w", client.ObjectKeyFromObject(&pod), err))
+			}
+			b.Logger.Info("Evicted pod", "pod", client.ObjectKeyFromObject(&pod))
+		}
+
+		if len(remainingPods) > 0 {
+			return retry.MinorError(fmt.Errorf("pods are still running on node %q: %s", node.Name, strings.Join(remainingPods, ", ")))
+		}
+
+		return retry.Ok()
+	})
+}
+
+func mustEvictPod(pod corev1.Pod) bool {
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+
+	return !slices.ContainsFunc(pod.OwnerReferences, func(ownerReference metav1.OwnerReference) bool {
+		return ownerReference.Kind == "DaemonSet"
+	})
+}
+
+// DeleteNode deletes the node object of this machine. Nothing is done if there is no node object for this machine.
+// This should only be called after kubelet was stopped, otherwise it would register the node again.
+func (b *GardenadmBotanist) DeleteNode(ctx context.Context) error {
+	node, err := nodeagent.FetchNodeByHostName(ctx, b.SeedClientSet.Client(), b.HostName)
+	if err != nil {
+		return fmt.Errorf("failed fetching node object by hostname %q: %w", b.HostName, err)
+	}
+	if node == nil {
+		return nil
+	}
+
+	return kubernetesutils.DeleteObject(ctx, b.SeedClientSet.Client(), node)
+}
+
+// StopGardenerNodeAgentAndKubelet stops and disables the gardener-node-init, gardener-node-agent, and kubelet units.
+// gardener-node-agent is stopped first, so that it does not restart any of the other units or rewrite files that are
+// removed afterward.
+func (b *GardenadmBotanist) StopGardenerNodeAgentAndKubelet(ctx context.Context) error {
+	return b.stopAndDisableUnits(ctx, nodeagentconfigv1alpha1.InitUnitName, nodeagentconfigv1alpha1.UnitName, kubelet.UnitName)
+}
+
+func (b *GardenadmBotanist) stopAndDisableUnits(ctx context.Context, unitNames ...string) error {
+	existingUnits, err := b.existingUnits(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, unitName := range unitNames {
+		if !slices.Contains(existingUnits, unitName) {
+			continue
+		}
+
+		if err := b.DBus.Disable(ctx, unitName); err != nil {
+			return fmt.Errorf("failed disabling unit %q: %w", unitName, err)
+		}
+
+		if err := b.DBus.Stop(ctx, nil, nil, unitName); err != nil {
+			return fmt.Errorf("failed stopping unit %q: %w", unitName, err)
+		}
+	}
+
+	return nil
+}
+
+func (b *GardenadmBotanist) existingUnits(ctx context.Context) ([]string, error) {
+	unitStatuses, err := b.DBus.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed listing systemd units: %w", err)
+	}
+
+	unitNames := make([]string, 0, len(unitStatuses))
+	for _, status := range unitStatuses {
+		unitNames = append(unitNames, status.Name)
+	}
+
+	return unitNames, nil
+}
+
+// CheckControlPlaneNodeCanBeReset returns an error if this machine is one of multiple control plane nodes. Resetting
+// such a node removes the data directories of its etcd members without removing the members from the etcd clusters,
+// which reduces the fault tolerance of etcd or even breaks its quorum. If the cluster is not reachable, the check is
+// based on the static pods of this machine.
+func (b *GardenadmBotanist) CheckControlPlaneNodeCanBeReset(ctx context.Context) error {
+	if b.SeedClientSet == nil {
+		manifests, err := b.staticPodManifests()
+		if err != nil {
+			return err
+		}
+		if len(manifests) > 0 {
+			return fmt.Errorf("this machine runs static pods of the control plane, but the cluster is not reachable to check whether it is the only control plane node")
+		}
+		return nil
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := b.SeedClientSet.Client().List(ctx, nodeList, client.HasLabels{labelKeyNodeRoleControlPlane}); err != nil {
+		return fmt.Errorf("failed listing control plane nodes: %w", err)
+	}
+
+	if len(nodeList.Items) > 1 && slices.ContainsFunc(nodeList.Items, func(node corev1.Node) bool {
+		return node.Labels[corev1.LabelHostname] == b.HostName
+	}) {
+		return fmt.Errorf("this machine is one of %d control plane nodes, resetting it removes the data of its etcd members without removing them from the etcd clusters", len(nodeList.Items))
+	}
+
+	return nil
+}
+
+// RemoveStaticPods removes the manifests of the static pods written by gardenadm (see the staticpod package) as well as
+// the host path directories they use for their configuration and data (e.g., the etcd data directories).
+func (b *GardenadmBotanist) RemoveStaticPods(_ context.Context) error {
+	manifests, err := b.staticPodManifests()
+	if err != nil {
+		return err
+	}
+
+	// The data directories of the bootstrap etcds are not referenced by any static pod manifest anymore after the
+	// transition to the etcds managed by etcd-druid failed in the middle.
+	directories := []string{
+		filepath.Join(string(filepath.Separator), "var", "lib", bootstrapetcd.Name(v1beta1constants.ETCDRoleMain)),
+		filepath.Join(string(filepath.Separator), "var", "lib", bootstrapetcd.Name(v1beta1constants.ETCDRoleEvents)),
+	}
+
+	for manifestPath, pod := range manifests {
+		directories = append(directories, staticPodHostPathDirectories(pod)...)
+
+		b.Logger.Info("Removing static pod manifest", "path", manifestPath)
+		if err := b.FS.Remove(manifestPath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("failed removing static pod manifest %q: %w", manifestPath, err)
+		}
+	}
+
+	return b.removeAll(directories...)
+}
+
+// staticPodManifests returns the static pods written by gardenadm (see the staticpod package) by the paths of their
+// manifests.
+func (b *GardenadmBotanist) staticPodManifests() (map[string]*corev1.Pod, error) {
+	entries, err := b.FS.ReadDir(kubelet.FilePathKubernetesManifests)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading static pod manifests directory %q: %w", kubelet.FilePathKubernetesManifests, err)
+	}
+
+	manifests := make(map[string]*corev1.Pod)
+	for _, entry := range entries {
+		if entry.IsDir() || (filepath.Ext(entry.Name()) != ".yaml" && filepath.Ext(entry.Name()) != ".yml") {
+			continue
+		}
+
+		manifestPath := filepath.Join(kubelet.FilePathKubernetesManifests, entry.Name())
+		content, err := b.FS.ReadFile(manifestPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading static pod manifest %q: %w", manifestPath, err)
+		}
+
+		pod := &corev1.Pod{}
+		if err := yaml.Unmarshal(content, pod); err != nil {
+			b.Logger.Info("Skipping file in static pod manifests directory which is not a pod manifest", "path", manifestPath, "error", err.Error())
+			continue
+		}
+
+		if pod.Labels[staticpod.LabelKeyIsStaticPod] == staticpod.LabelValueIsStaticPod {
+			manifests[manifestPath] = pod
+		}
+	}
+
+	return manifests, nil
+}
+
+// staticPodHostPathDirectories returns the directories below /var/lib used by the given static pod. The staticpod
+// translator writes the content of ConfigMaps and Secrets to /var/lib/<pod-name>/<volume-name>, and volume claim
+// templates of StatefulSets are translated to /var/lib/<volume-claim-template-name>/data.
+func staticPodHostPathDirectories(pod *corev1.Pod) []string {
+	varLib := filepath.Join(string(filepath.Separator), "var", "lib")
+
+	directories := []string{filepath.Join(varLib, pod.Name)}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.HostPath == nil {
+			continue
+		}
+
+		relativePath, err := filepath.Rel(varLib, filepath.Clean(volume.HostPath.Path))
+		if err != nil || relativePath == "." || strings.HasPrefix(relativePath, "..") {
+			continue
+		}
+
+		directory := filepath.Join(varLib, strings.Split(relativePath, string(filepath.Separator))[0])
+		if slices.Contains([]string{kubelet.PathKubeletDirectory, pathContainerdState, nodeagentconfigv1alpha1.BaseDir, GardenadmBaseDir}, directory) ||
+			slices.Contains(directories, directory) {
+			continue
+		}
+		directories = append(directories, directory)
+	}
+
+	return directories
+}
+
+// ResetContainerd removes the containers of the Kubernetes namespace of containerd (like 'kubeadm reset'), stops
+// containerd and removes the configuration generated by gardener-node-agent. Other containerd namespaces (e.g., of
+// docker or buildkit) are not touched. If removeState is true, the containers of all containerd namespaces and the
+// complete state of containerd (including all images) are removed as well. containerd is not disabled since it is
+// usually provided by the operating system.
+func (b *GardenadmBotanist) ResetContainerd(ctx context.Context, removeState bool) error {
+	if err := RemoveContainers(ctx, b.Logger, removeState); err != nil {
+		return fmt.Errorf("failed removing containers: %w", err)
+	}
+
+	existingUnits, err := b.existingUnits(ctx)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(existingUnits, v1beta1constants.OperatingSystemConfigUnitNameContainerDService) {
+		if err := b.DBus.Stop(ctx, nil, nil, v1beta1constants.OperatingSystemConfigUnitNameContainerDService); err != nil {
+			return fmt.Errorf("failed stopping unit %q: %w", v1beta1constants.OperatingSystemConfigUnitNameContainerDService, err)
+		}
+	}
+
+	// The following directories are generated by gardener-node-agent when configuring registry mirrors and plugins.
+	paths := []string{"/etc/containerd/certs.d", "/etc/containerd/conf.d"}
+	if removeState {
+		paths = append(paths, pathContainerdState, pathContainerdRun)
+	}
+
+	return b.removeAll(paths...)
+}
+
+// RemoveKubeletState unmounts all volumes below the kubelet directory and removes it. It also removes the state of the
+// container network plugins.
+func (b *GardenadmBotanist) RemoveKubeletState(_ context.Context) error {
+	if err := UnmountRecursive(kubelet.PathKubeletDirectory, 0); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed unmounting volumes below %q: %w", kubelet.PathKubeletDirectory, err)
+	}
+
+	return b.removeAll(
+		kubelet.PathKubeletDirectory,
+		"/var/lib/cni",
+		"/etc/cni/net.d",
+	)
+}
+
+// RemoveOperatingSystemConfigFilesAndUnits removes the files and units of the operating system config last applied by
+// gardener-node-agent. Units created by gardener-node-agent are stopped and disabled before their unit files are
+// removed. Only the drop-ins are removed for units provided by the operating system. Additionally, the files and units
+// written when bootstrapping gardener-node-agent and kubelet are removed even if there is no last applied operating
+// system config (e.g., when bootstrapping the machine failed).
+func (b *GardenadmBotanist) RemoveOperatingSystemConfigFilesAndUnits(ctx context.Context) error {
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+
+	content, err := b.FS.ReadFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		if !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("failed reading last applied operating system config %q: %w", nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
+		}
+		b.Logger.Info("No last applied operating system config found, only removing well-known files and units", "path", nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath)
+	} else if err := yaml.Unmarshal(content, osc); err != nil {
+		return fmt.Errorf("failed decoding last applied operating system config %q: %w", nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	units := osc.Spec.Units
+	for _, unitName := range []string{nodeagentconfigv1alpha1.InitUnitName, nodeagentconfigv1alpha1.UnitName, kubelet.UnitName} {
+		if !slices.ContainsFunc(units, func(unit extensionsv1alpha1.Unit) bool { return unit.Name == unitName }) {
+			units = append(units, extensionsv1alpha1.Unit{Name: unitName, Content: ptr.To("")})
+		}
+	}
+
+	for _, unit := range units {
+		if err := b.removeUnit(ctx, unit); err != nil {
+			return err
+		}
+	}
+
+	paths := []string{
+		PathKubeconfig,
+		path.Join(nodeagentconfigv1alpha1.BinaryDir, "gardener-node-agent"),
+	}
+	for _, file := range osc.Spec.Files {
+		paths = append(paths, file.Path)
+	}
+
+	for _, p := range paths {
+		if err := b.FS.Remove(p); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("failed removing file %q: %w", p, err)
+		}
+	}
+
+	return b.DBus.DaemonReload(ctx)
+}
+
+func (b *GardenadmBotanist) removeUnit(ctx context.Context, unit extensionsv1alpha1.Unit) error {
+	unitFilePath := path.Join(pathSystemdUnits, unit.Name)
+
+	// The unit has been created by gardener-node-agent if it has content. Otherwise, it might be a default OS unit which
+	// was enabled/disabled or where drop-ins were added.
+	if unit.Content != nil {
+		if err := b.stopAndDisableUnits(ctx, unit.Name); err != nil {
+			return err
+		}
+
+		if err := b.FS.Remove(unitFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("failed removing unit file %q: %w", unitFilePath, err)
+		}
+	}
+
+	dropInFolder := unitFilePath + ".d"
+	for _, dropIn := range unit.DropIns {
+		dropInFilePath := path.Join(dropInFolder, dropIn.Name)
+		if err := b.FS.Remove(dropInFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("failed removing drop-in file %q of unit %q: %w", dropInFilePath, unit.Name, err)
+		}
+	}
+
+	if exists, err := b.FS.DirExists(dropInFolder); err != nil {
+		return fmt.Errorf("failed checking whether drop-in folder %q exists: %w", dropInFolder, err)
+	} else if exists {
+		if empty, err := b.FS.IsEmpty(dropInFolder); err != nil {
+			return fmt.Errorf("failed checking whether drop-in folder %q is empty: %w", dropInFolder, err)
+		} else if empty {
+			if err := b.FS.RemoveAll(dropInFolder); err != nil {
+				return fmt.Errorf("failed removing drop-in folder %q of unit %q: %w", dropInFolder, unit.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RemoveGardenerNodeAgentState removes the state of gardener-node-agent (e.g., its credentials and the last applied
+// operating system config) and starts containerd again, so that the machine can be bootstrapped again. The directory
+// used by gardenadm itself is kept since it contains the configuration and the shoot UID which are needed when the
+// machine is bootstrapped again.
+func (b *GardenadmBotanist) RemoveGardenerNodeAgentState(ctx context.Context) error {
+	if err := b.removeAll(nodeagentconfigv1alpha1.BaseDir); err != nil {
+		return err
+	}
+
+	existingUnits, err := b.existingUnits(ctx)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(existingUnits, v1beta1constants.OperatingSystemConfigUnitNameContainerDService) {
+		if err := b.DBus.Start(ctx, nil, nil, v1beta1constants.OperatingSystemConfigUnitNameContainerDService); err != nil {
+			return fmt.Errorf("failed starting unit %q: %w", v1beta1constants.OperatingSystemConfigUnitNameContainerDService, err)
+		}
+	}
+
+	return nil
+}
+
+func (b *GardenadmBotanist) removeAll(paths ...string) error {
+	for _, p := range paths {
+		b.Logger.V(1).Info("Removing path", "path", p)
+		if err := b.FS.RemoveAll(p); err != nil {
+			return fmt.Errorf("failed removing %q: %w", p, err)
+		}
+	}
+
+	return nil
+}
+
+func removeContainers(ctx context.Context, log logr.Logger, allNamespaces bool) error {
+	address := os.Getenv("CONTAINERD_ADDRESS")
+	if address == "" {
+		address = defaults.DefaultAddress
+	}
+
+	if _, err := os.Stat(address); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			log.Info("containerd socket does not exist, assuming that there are no containers", "address", address)
+			return nil
+		}
+		return fmt.Errorf("failed checking whether containerd socket %q exists: %w", address, err)
+	}
+
+	c, err := containerd.New(address)
+	if err != nil {
+		return fmt.Errorf("error creating containerd client: %w", err)
+	}
+	defer func() { _ = c.Close() }()
+
+	namespaceList := []string{containerdNamespaceKubernetes}
+	if allNamespaces {
+		if namespaceList, err = c.NamespaceService().List(ctx); err != nil {
+			return fmt.Errorf("failed listing containerd namespaces: %w", err)
+		}
+	}
+
+	for _, namespace := range namespaceList {
+		namespaceCtx := namespaces.WithNamespace(ctx, namespace)
+
+		containers, err := c.Containers(namespaceCtx)
+		if err != nil {
+			return fmt.Errorf("failed listing containers in containerd namespace %q: %w", namespace, err)
+		}
+
+		for _, container := range containers {
+			log.Info("Removing container", "namespace", namespace, "id", container.ID())
+
+			if task, err := container.Task(namespaceCtx, nil); err == nil {
+				if _, err := task.Delete(namespaceCtx, containerd.WithProcessKill); err != nil && !errdefs.IsNotFound(err) {
+					return fmt.Errorf("failed deleting task of container %q in containerd namespace %q: %w", container.ID(), namespace, err)
+				}
+			} else if !errdefs.IsNotFound(err) {
+				return fmt.Errorf("failed fetching task of container %q in containerd namespace %q: %w", container.ID(), namespace, err)
+			}
+
+			if err := container.Delete(namespaceCtx, containerd.WithSnapshotCleanup); err != nil && !errdefs.IsNotFound(err) {
+				return fmt.Errorf("failed deleting container %q in containerd namespace %q: %w", container.ID(), namespace, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,476 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"time"
+
+	systemddbus "github.com/coreos/go-systemd/v22/dbus"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reset", func() {
+	var (
+		ctx context.Context
+
+		fakeClient client.Client
+		fakeDBus   *fakedbus.DBus
+		fakeFS     afero.Afero
+
+		b *GardenadmBotanist
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithIndex(&corev1.Pod{}, "spec.nodeName", func(obj client.Object) []string {
+				return []string{obj.(*corev1.Pod).Spec.NodeName}
+			}).
+			Build()
+		fakeDBus = fakedbus.New()
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		b = &GardenadmBotanist{
+			Botanist: &botanistpkg.Botanist{
+				Operation: &operation.Operation{
+					Logger: logr.Discard(),
+					SeedClientSet: fakekubernetes.
+						NewClientSetBuilder().
+						WithClient(fakeClient).
+						WithRESTConfig(&rest.Config{}).
+						Build(),
+				},
+			},
+			FS:       fakeFS,
+			DBus:     fakeDBus,
+			HostName: "machine-0",
+		}
+	})
+
+	Describe("#DrainNode", func() {
+		var node *corev1.Node
+
+		BeforeEach(func() {
+			DeferCleanup(test.WithVar(&DrainNodeInterval, 10*time.Millisecond))
+
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-0", Labels: map[string]string{"kubernetes.io/hostname": "machine-0"}}}
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+		})
+
+		It("should do nothing if there is no node for this machine", func() {
+			Expect(fakeClient.Delete(ctx, node)).To(Succeed())
+
+			Expect(b.DrainNode(ctx, time.Second)).To(Succeed())
+		})
+
+		It("should cordon the node and evict the pods", func() {
+			var (
+				pod                  = newPod("pod", node.Name)
+				podOnOtherNode       = newPod("pod-on-other-node", "node-1")
+				mirrorPod            = newPod("mirror-pod", node.Name)
+				daemonSetPod         = newPod("daemonset-pod", node.Name)
+				succeededPod         = newPod("succeeded-pod", node.Name)
+				anotherPodOnThisNode = newPod("another-pod", node.Name)
+			)
+			mirrorPod.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: "hash"}
+			daemonSetPod.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "ds", UID: "1234"}}
+			succeededPod.Status.Phase = corev1.PodSucceeded
+
+			for _, p := range []*corev1.Pod{pod, podOnOtherNode, mirrorPod, daemonSetPod, succeededPod, anotherPodOnThisNode} {
+				Expect(fakeClient.Create(ctx, p)).To(Succeed())
+			}
+
+			Expect(b.DrainNode(ctx, time.Second)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Unschedulable).To(BeTrue())
+
+			for _, p := range []*corev1.Pod{pod, anotherPodOnThisNode} {
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(p), p)).To(BeNotFoundError())
+			}
+			for _, p := range []*corev1.Pod{podOnOtherNode, mirrorPod, daemonSetPod, succeededPod} {
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(p), p)).To(Succeed())
+			}
+		})
+
+		It("should time out if pods cannot be evicted", func() {
+			pod := newPod("pod", node.Name)
+			pod.Finalizers = []string{"test"}
+			Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+
+			Expect(b.DrainNode(ctx, 100*time.Millisecond)).To(MatchError(ContainSubstring("pods are still running on node \"node-0\": kube-system/pod")))
+		})
+	})
+
+	Describe("#DeleteNode", func() {
+		It("should delete the node of this machine", func() {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-0", Labels: map[string]string{"kubernetes.io/hostname": "machine-0"}}}
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+			Expect(b.DeleteNode(ctx)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(BeNotFoundError())
+		})
+
+		It("should do nothing if there is no node for this machine", func() {
+			Expect(b.DeleteNode(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#StopGardenerNodeAgentAndKubelet", func() {
+		It("should stop and disable the existing units", func() {
+			fakeDBus.AddUnitsToList(systemddbus.UnitStatus{Name: "gardener-node-agent.service"}, systemddbus.UnitStatus{Name: "kubelet.service"})
+
+			Expect(b.StopGardenerNodeAgentAndKubelet(ctx)).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionList},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"gardener-node-agent.service"}},
+				{Action: fakedbus.ActionStop, UnitNames: []string{"gardener-node-agent.service"}},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"kubelet.service"}},
+				{Action: fakedbus.ActionStop, UnitNames: []string{"kubelet.service"}},
+			}))
+		})
+	})
+
+	Describe("#CheckControlPlaneNodeCanBeReset", func() {
+		newNode := func(name, hostName string, controlPlane bool) *corev1.Node {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"kubernetes.io/hostname": hostName}}}
+			if controlPlane {
+				node.Labels["node-role.kubernetes.io/control-plane"] = ""
+			}
+			return node
+		}
+
+		It("should succeed for the only control plane node", func() {
+			Expect(fakeClient.Create(ctx, newNode("node-0", "machine-0", true))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newNode("node-1", "machine-1", false))).To(Succeed())
+
+			Expect(b.CheckControlPlaneNodeCanBeReset(ctx)).To(Succeed())
+		})
+
+		It("should succeed for a worker node of a cluster with multiple control plane nodes", func() {
+			Expect(fakeClient.Create(ctx, newNode("node-0", "machine-0", false))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newNode("node-1", "machine-1", true))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newNode("node-2", "machine-2", true))).To(Succeed())
+
+			Expect(b.CheckControlPlaneNodeCanBeReset(ctx)).To(Succeed())
+		})
+
+		It("should fail for one of multiple control plane nodes", func() {
+			Expect(fakeClient.Create(ctx, newNode("node-0", "machine-0", true))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newNode("node-1", "machine-1", true))).To(Succeed())
+
+			Expect(b.CheckControlPlaneNodeCanBeReset(ctx)).To(MatchError(ContainSubstring("this machine is one of 2 control plane nodes")))
+		})
+
+		When("the cluster is not reachable", func() {
+			BeforeEach(func() {
+				b.SeedClientSet = nil
+			})
+
+			It("should succeed if the machine does not run static pods of the control plane", func() {
+				Expect(fakeFS.WriteFile("/etc/kubernetes/manifests/other.yaml", []byte("apiVersion: v1\nkind: Pod\nmetadata:\n  name: other\n"), 0640)).To(Succeed())
+
+				Expect(b.CheckControlPlaneNodeCanBeReset(ctx)).To(Succeed())
+			})
+
+			It("should fail if the machine runs static pods of the control plane", func() {
+				Expect(fakeFS.WriteFile("/etc/kubernetes/manifests/etcd-main.yaml", []byte("apiVersion: v1\nkind: Pod\nmetadata:\n  name: etcd-main\n  labels:\n    static-pod: \"true\"\n"), 0640)).To(Succeed())
+
+				Expect(b.CheckControlPlaneNodeCanBeReset(ctx)).To(MatchError(ContainSubstring("the cluster is not reachable")))
+			})
+		})
+	})
+
+	Describe("#RemoveStaticPods", func() {
+		It("should do nothing if the manifests directory does not exist", func() {
+			Expect(b.RemoveStaticPods(ctx)).To(Succeed())
+		})
+
+		It("should remove the static pods written by gardenadm and their directories", func() {
+			Expect(fakeFS.WriteFile("/etc/kubernetes/manifests/etcd-main.yaml", []byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: etcd-main
+  labels:
+    static-pod: "true"
+spec:
+  volumes:
+  - name: main-etcd
+    hostPath:
+      path: /var/lib/main-etcd/data
+  - name: ca
+    hostPath:
+      path: /var/lib/etcd-main/ca
+  - name: kubelet
+    hostPath:
+      path: /var/lib/kubelet
+  - name: certs
+    hostPath:
+      path: /etc/ssl/certs
+`), 0640)).To(Succeed())
+			Expect(fakeFS.WriteFile("/etc/kubernetes/manifests/other.yaml", []byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: other
+spec:
+  volumes:
+  - name: data
+    hostPath:
+      path: /var/lib/other
+`), 0640)).To(Succeed())
+			Expect(fakeFS.WriteFile("/etc/kubernetes/manifests/README", []byte("not a manifest"), 0640)).To(Succeed())
+
+			for _, p := range []string{
+				"/var/lib/main-etcd/data/new.etcd/member",
+				"/var/lib/etcd-main/ca/bundle.crt",
+				"/var/lib/etcd-bootstrap-main/data/new.etcd/member",
+				"/var/lib/kubelet/config",
+				"/var/lib/other/data",
+				"/etc/ssl/certs/ca.crt",
+			} {
+				Expect(fakeFS.WriteFile(p, nil, 0600)).To(Succeed())
+			}
+
+			Expect(b.RemoveStaticPods(ctx)).To(Succeed())
+
+			for _, p := range []string{
+				"/etc/kubernetes/manifests/etcd-main.yaml",
+				"/var/lib/main-etcd",
+				"/var/lib/etcd-main",
+				"/var/lib/etcd-bootstrap-main",
+			} {
+				Expect(fakeFS.Exists(p)).To(BeFalse(), p)
+			}
+			for _, p := range []string{
+				"/etc/kubernetes/manifests/other.yaml",
+				"/etc/kubernetes/manifests/README",
+				"/var/lib/kubelet/config",
+				"/var/lib/other/data",
+				"/etc/ssl/certs/ca.crt",
+			} {
+				Expect(fakeFS.Exists(p)).To(BeTrue(), p)
+			}
+		})
+	})
+
+	Describe("#ResetContainerd", func() {
+		var (
+			removedContainers    bool
+			removedAllNamespaces bool
+		)
+
+		BeforeEach(func() {
+			removedContainers, removedAllNamespaces = false, false
+			DeferCleanup(test.WithVar(&RemoveContainers, func(_ context.Context, _ logr.Logger, allNamespaces bool) error {
+				removedContainers, removedAllNamespaces = true, allNamespaces
+				return nil
+			}))
+
+			fakeDBus.AddUnitsToList(systemddbus.UnitStatus{Name: "containerd.service"})
+			for _, p := range []string{
+				"/var/lib/containerd/io.containerd.content.v1.content/blob",
+				"/run/containerd/containerd.sock",
+				"/etc/containerd/certs.d/registry/hosts.toml",
+				"/etc/containerd/conf.d/plugin.toml",
+				"/etc/containerd/config.toml",
+			} {
+				Expect(fakeFS.WriteFile(p, nil, 0600)).To(Succeed())
+			}
+		})
+
+		It("should remove the Kubernetes containers, stop containerd and remove the generated configuration", func() {
+			Expect(b.ResetContainerd(ctx, false)).To(Succeed())
+
+			Expect(removedContainers).To(BeTrue())
+			Expect(removedAllNamespaces).To(BeFalse())
+			Expect(fakeDBus.Actions).To(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionStop, UnitNames: []string{"containerd.service"}}))
+			for _, p := range []string{"/etc/containerd/certs.d", "/etc/containerd/conf.d"} {
+				Expect(fakeFS.Exists(p)).To(BeFalse(), p)
+			}
+			for _, p := range []string{"/var/lib/containerd/io.containerd.content.v1.content/blob", "/run/containerd/containerd.sock", "/etc/containerd/config.toml"} {
+				Expect(fakeFS.Exists(p)).To(BeTrue(), p)
+			}
+		})
+
+		It("should remove the containers of all namespaces and the state of containerd if requested", func() {
+			Expect(b.ResetContainerd(ctx, true)).To(Succeed())
+
+			Expect(removedContainers).To(BeTrue())
+			Expect(removedAllNamespaces).To(BeTrue())
+			Expect(fakeDBus.Actions).To(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionStop, UnitNames: []string{"containerd.service"}}))
+			for _, p := range []string{"/var/lib/containerd", "/run/containerd", "/etc/containerd/certs.d", "/etc/containerd/conf.d"} {
+				Expect(fakeFS.Exists(p)).To(BeFalse(), p)
+			}
+			Expect(fakeFS.Exists("/etc/containerd/config.toml")).To(BeTrue())
+		})
+	})
+
+	Describe("#RemoveKubeletState", func() {
+		It("should unmount the volumes and remove the kubelet directory", func() {
+			var unmountedTarget string
+			DeferCleanup(test.WithVar(&UnmountRecursive, func(target string, _ int) error {
+				unmountedTarget = target
+				return nil
+			}))
+
+			for _, p := range []string{"/var/lib/kubelet/pods/foo/volumes/bar", "/var/lib/cni/networks/foo", "/etc/cni/net.d/10-calico.conflist"} {
+				Expect(fakeFS.WriteFile(p, nil, 0600)).To(Succeed())
+			}
+
+			Expect(b.RemoveKubeletState(ctx)).To(Succeed())
+
+			Expect(unmountedTarget).To(Equal("/var/lib/kubelet"))
+			for _, p := range []string{"/var/lib/kubelet", "/var/lib/cni", "/etc/cni/net.d"} {
+				Expect(fakeFS.Exists(p)).To(BeFalse(), p)
+			}
+		})
+	})
+
+	Describe("#RemoveOperatingSystemConfigFilesAndUnits", func() {
+		BeforeEach(func() {
+			for _, p := range []string{
+				"/etc/systemd/system/gardener-node-agent.service",
+				"/etc/systemd/system/gardener-node-init.service",
+				"/etc/systemd/system/kubelet.service",
+				"/etc/systemd/system/kubelet.service.d/10-drop-in.conf",
+				"/etc/kubernetes/admin.conf",
+				"/opt/bin/gardener-node-agent",
+			} {
+				Expect(fakeFS.WriteFile(p, nil, 0600)).To(Succeed())
+			}
+		})
+
+		It("should remove the well-known files and units if there is no last applied operating system config", func() {
+			fakeDBus.AddUnitsToList(systemddbus.UnitStatus{Name: "kubelet.service"})
+
+			Expect(b.RemoveOperatingSystemConfigFilesAndUnits(ctx)).To(Succeed())
+
+			for _, p := range []string{
+				"/etc/systemd/system/gardener-node-agent.service",
+				"/etc/systemd/system/gardener-node-init.service",
+				"/etc/systemd/system/kubelet.service",
+				"/etc/kubernetes/admin.conf",
+				"/opt/bin/gardener-node-agent",
+			} {
+				Expect(fakeFS.Exists(p)).To(BeFalse(), p)
+			}
+			// drop-ins are only removed if they are part of the last applied operating system config
+			Expect(fakeFS.Exists("/etc/systemd/system/kubelet.service.d/10-drop-in.conf")).To(BeTrue())
+
+			Expect(fakeDBus.Actions).To(ContainElements(
+				fakedbus.SystemdAction{Action: fakedbus.ActionStop, UnitNames: []string{"kubelet.service"}},
+				fakedbus.SystemdAction{Action: fakedbus.ActionDaemonReload},
+			))
+		})
+
+		It("should remove the files and units of the last applied operating system config", func() {
+			fakeDBus.AddUnitsToList(systemddbus.UnitStatus{Name: "valitail.service"}, systemddbus.UnitStatus{Name: "containerd.service"})
+
+			Expect(fakeFS.WriteFile("/var/lib/gardener-node-agent/last-applied-osc.yaml", []byte(`apiVersion: extensions.gardener.cloud/v1alpha1
+kind: OperatingSystemConfig
+spec:
+  files:
+  - path: /etc/valitail/config
+    content:
+      inline:
+        data: foo
+  - path: /etc/kubernetes/manifests/kube-apiserver.yaml
+    content:
+      inline:
+        data: bar
+  units:
+  - name: valitail.service
+    content: foo
+  - name: containerd.service
+    dropIns:
+    - name: 30-env.conf
+      content: bar
+  - name: kubelet.service
+    content: baz
+    dropIns:
+    - name: 10-drop-in.conf
+      content: bar
+`), 0600)).To(Succeed())
+			for _, p := range []string{
+				"/etc/valitail/config",
+				"/etc/kubernetes/manifests/kube-apiserver.yaml",
+				"/etc/systemd/system/valitail.service",
+				"/etc/systemd/system/containerd.service.d/30-env.conf",
+				"/etc/systemd/system/containerd.service.d/10-other.conf",
+				"/usr/lib/systemd/system/containerd.service",
+			} {
+				Expect(fakeFS.WriteFile(p, nil, 0600)).To(Succeed())
+			}
+
+			Expect(b.RemoveOperatingSystemConfigFilesAndUnits(ctx)).To(Succeed())
+
+			for _, p := range []string{
+				"/etc/valitail/config",
+				"/etc/kubernetes/manifests/kube-apiserver.yaml",
+				"/etc/systemd/system/valitail.service",
+				"/etc/systemd/system/containerd.service.d/30-env.conf",
+				"/etc/systemd/system/kubelet.service",
+				"/etc/systemd/system/kubelet.service.d",
+			} {
+				Expect(fakeFS.Exists(p)).To(BeFalse(), p)
+			}
+			for _, p := range []string{
+				"/etc/systemd/system/containerd.service.d/10-other.conf",
+				"/usr/lib/systemd/system/containerd.service",
+			} {
+				Expect(fakeFS.Exists(p)).To(BeTrue(), p)
+			}
+
+			Expect(fakeDBus.Actions).To(ContainElements(
+				fakedbus.SystemdAction{Action: fakedbus.ActionDisable, UnitNames: []string{"valitail.service"}},
+				fakedbus.SystemdAction{Action: fakedbus.ActionStop, UnitNames: []string{"valitail.service"}},
+			))
+			Expect(fakeDBus.Actions).NotTo(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionStop, UnitNames: []string{"containerd.service"}}))
+		})
+	})
+
+	Describe("#RemoveGardenerNodeAgentState", func() {
+		It("should remove the gardener-node-agent directory and start containerd again", func() {
+			fakeDBus.AddUnitsToList(systemddbus.UnitStatus{Name: "containerd.service"})
+			for _, p := range []string{"/var/lib/gardener-node-agent/credentials/kubeconfig", "/var/lib/gardenadm/shoot-uid"} {
+				Expect(fakeFS.WriteFile(p, nil, 0600)).To(Succeed())
+			}
+
+			Expect(b.RemoveGardenerNodeAgentState(ctx)).To(Succeed())
+
+			Expect(fakeFS.Exists("/var/lib/gardener-node-agent")).To(BeFalse())
+			Expect(fakeFS.Exists("/var/lib/gardenadm/shoot-uid")).To(BeTrue())
+			Expect(fakeDBus.Actions).To(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionStart, UnitNames: []string{"containerd.service"}}))
+		})
+	})
+})
+
+func newPod(name, nodeName string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "kube-system"},
+		Spec:       corev1.PodSpec{NodeName: nodeName, Containers: []corev1.Container{{Name: "c", Image: "image"}}},
+	}
+}
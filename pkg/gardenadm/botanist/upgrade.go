@@ -0,0 +1,546 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/afero"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentcomponent "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+var (
+	// IntervalWaitOperatingSystemConfigRollout is the interval at which we check whether a control plane node has
+	// applied the new operating system config.
+	// Exposed for testing.
+	IntervalWaitOperatingSystemConfigRollout = 5 * time.Second
+	// TimeoutWaitOperatingSystemConfigRollout is the timeout for a single control plane node to apply the new operating
+	// system config and to become ready again.
+	// Exposed for testing.
+	TimeoutWaitOperatingSystemConfigRollout = 10 * time.Minute
+)
+
+const (
+	// UpgradeFlowName is the name of the flow executed by `gardenadm upgrade apply`.
+	UpgradeFlowName = "upgrade"
+	// UpgradeStateConfigMapName is the name of the ConfigMap in the kube-system namespace which holds the state of an
+	// interrupted `gardenadm upgrade apply` execution.
+	UpgradeStateConfigMapName = "gardenadm-upgrade-state"
+
+	labelKeyNodeRoleControlPlane = "node-role.kubernetes.io/control-plane"
+)
+
+// ComponentVersion contains the current and the target version of a component of a self-hosted shoot cluster.
+type ComponentVersion struct {
+	// Name is the name of the component.
+	Name string
+	// Node is the name of the node the component is running on. It is empty for components which are not bound to a
+	// specific node.
+	Node string
+	// Current is the version which is currently running. It is empty if it could not be determined.
+	Current string
+	// Target is the version after the upgrade. It is empty if the component is not upgraded by gardenadm.
+	Target string
+}
+
+// UpToDate returns true if the component already runs the target version or if it is not upgraded by gardenadm.
+func (c ComponentVersion) UpToDate() bool {
+	return c.Target == "" || c.Current == c.Target
+}
+
+// UpgradePlan contains the current and the target versions of a self-hosted shoot cluster.
+type UpgradePlan struct {
+	// CurrentKubernetesVersion is the lowest Kubernetes version of the kube-apiserver static pods.
+	CurrentKubernetesVersion string
+	// TargetKubernetesVersion is the Kubernetes version specified in the Shoot manifest.
+	TargetKubernetesVersion string
+	// CurrentGardenerVersion is the version of gardener-node-agent running on this machine. It is empty if it could not
+	// be determined.
+	CurrentGardenerVersion string
+	// TargetGardenerVersion is the version of gardenadm.
+	TargetGardenerVersion string
+	// GardenletVersion is the version of gardenlet. It is empty if the cluster is not connected to Gardener.
+	GardenletVersion string
+	// Components contains the versions of the individual components.
+	Components []ComponentVersion
+	// Interrupted is true if a previous `gardenadm upgrade apply` execution was interrupted.
+	Interrupted bool
+}
+
+// UpToDate returns true if all components already run their target versions.
+func (p *UpgradePlan) UpToDate() bool {
+	for _, component := range p.Components {
+		if !component.UpToDate() {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks whether the upgrade from the current to the target versions respects the supported version skew.
+func (p *UpgradePlan) Validate() error {
+	var errs []error
+
+	if err := validateVersionUpdate("Kubernetes", p.CurrentKubernetesVersion, p.TargetKubernetesVersion); err != nil {
+		errs = append(errs, err)
+	}
+
+	if p.CurrentGardenerVersion != "" {
+		if err := validateVersionUpdate("Gardener", p.CurrentGardenerVersion, p.TargetGardenerVersion); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if p.GardenletVersion != "" {
+		// gardenlet deploys the components of the cluster with its own version, hence, gardenadm must not roll out
+		// components which are newer than gardenlet. Upgrading gardenlet is done via the garden cluster.
+		if newer, err := isNewer(p.TargetGardenerVersion, p.GardenletVersion); err != nil {
+			errs = append(errs, fmt.Errorf("failed comparing Gardener version with gardenlet version: %w", err))
+		} else if newer {
+			errs = append(errs, fmt.Errorf("gardener version %s must not be newer than the version %s of the connected gardenlet, upgrade gardenlet via the garden cluster first", p.TargetGardenerVersion, p.GardenletVersion))
+		}
+	}
+
+	targetKubernetesVersion, err := semver.NewVersion(p.TargetKubernetesVersion)
+	if err != nil {
+		return errors.Join(append(errs, fmt.Errorf("failed parsing target Kubernetes version %q: %w", p.TargetKubernetesVersion, err))...)
+	}
+
+	for _, component := range p.Components {
+		if component.Name != "kubelet" || component.UpToDate() {
+			continue
+		}
+
+		if err := validateKubeletVersionSkew(component, targetKubernetesVersion); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateVersionUpdate(name, current, target string) error {
+	currentVersion, err := semver.NewVersion(current)
+	if err != nil {
+		return fmt.Errorf("failed parsing current %s version %q: %w", name, current, err)
+	}
+	targetVersion, err := semver.NewVersion(target)
+	if err != nil {
+		return fmt.Errorf("failed parsing target %s version %q: %w", name, target, err)
+	}
+
+	if targetVersion.LessThan(currentVersion) {
+		return fmt.Errorf("%s version downgrade from %s to %s is not supported", strings.ToLower(name), current, target)
+	}
+
+	if targetVersion.Major() != currentVersion.Major() || targetVersion.Minor() > currentVersion.Minor()+1 {
+		return fmt.Errorf("%s version upgrade from %s to %s cannot skip a minor version", strings.ToLower(name), current, target)
+	}
+
+	return nil
+}
+
+func validateKubeletVersionSkew(component ComponentVersion, controlPlaneVersion *semver.Version) error {
+	kubeletVersion, err := semver.NewVersion(component.Current)
+	if err != nil {
+		return fmt.Errorf("failed parsing kubelet version %q of node %s: %w", component.Current, component.Node, err)
+	}
+
+	if kubeletVersion.Major() != controlPlaneVersion.Major() || kubeletVersion.Minor() > controlPlaneVersion.Minor() {
+		return fmt.Errorf("kubelet version %s of node %s must not be newer than control plane version %s", component.Current, component.Node, controlPlaneVersion)
+	}
+
+	if kubeletVersion.Minor()+3 < controlPlaneVersion.Minor() {
+		return fmt.Errorf("kubelet version %s of node %s must be at most three minor versions behind control plane version %s", component.Current, component.Node, controlPlaneVersion)
+	}
+
+	return nil
+}
+
+func isNewer(version, other string) (bool, error) {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false, fmt.Errorf("failed parsing version %q: %w", version, err)
+	}
+	o, err := semver.NewVersion(other)
+	if err != nil {
+		return false, fmt.Errorf("failed parsing version %q: %w", other, err)
+	}
+	return v.GreaterThan(o), nil
+}
+
+// ComputeUpgradePlan compares the versions of the static control plane pods, kubelets, gardener-node-agent, and
+// gardenlet (if the cluster is connected to Gardener) with the Kubernetes version of the Shoot manifest and the given
+// Gardener version.
+func (b *GardenadmBotanist) ComputeUpgradePlan(ctx context.Context, targetGardenerVersion string) (*UpgradePlan, error) {
+	c := b.SeedClientSet.Client()
+
+	plan := &UpgradePlan{
+		TargetKubernetesVersion: "v" + b.Shoot.KubernetesVersion.String(),
+		TargetGardenerVersion:   targetGardenerVersion,
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := c.List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	nodeNameToKubeletVersion := make(map[string]string, len(nodeList.Items))
+	for _, node := range nodeList.Items {
+		nodeNameToKubeletVersion[node.Name] = node.Status.NodeInfo.KubeletVersion
+	}
+
+	staticPodList := &corev1.PodList{}
+	if err := c.List(ctx, staticPodList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{staticpod.LabelKeyIsStaticPod: staticpod.LabelValueIsStaticPod}); err != nil {
+		return nil, fmt.Errorf("failed listing static pods: %w", err)
+	}
+
+	var currentKubernetesVersion *semver.Version
+	for _, pod := range staticPodList.Items {
+		name := strings.TrimSuffix(pod.Name, "-"+pod.Spec.NodeName)
+		if !slices.Contains([]string{v1beta1constants.DeploymentNameKubeAPIServer, v1beta1constants.DeploymentNameKubeControllerManager, v1beta1constants.DeploymentNameKubeScheduler}, name) || len(pod.Spec.Containers) == 0 {
+			continue
+		}
+
+		current := imageTag(pod.Spec.Containers[0].Image)
+		if current == "" {
+			// The image is referenced by digest only. The static pods are rolled out together with the kubelet as part of
+			// the same operating system config, hence, the kubelet version of the node is used instead.
+			current = nodeNameToKubeletVersion[pod.Spec.NodeName]
+		}
+		if current == "" {
+			b.Logger.Info("Skipping static pod since its version cannot be determined", "pod", client.ObjectKeyFromObject(&pod))
+			continue
+		}
+
+		plan.Components = append(plan.Components, ComponentVersion{Name: name, Node: pod.Spec.NodeName, Current: current, Target: plan.TargetKubernetesVersion})
+
+		if name != v1beta1constants.DeploymentNameKubeAPIServer {
+			continue
+		}
+
+		version, err := semver.NewVersion(current)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing version of static pod %s: %w", pod.Name, err)
+		}
+		if currentKubernetesVersion == nil || version.LessThan(currentKubernetesVersion) {
+			currentKubernetesVersion = version
+		}
+	}
+
+	if currentKubernetesVersion == nil {
+		return nil, fmt.Errorf("no kube-apiserver static pods found in namespace %s", metav1.NamespaceSystem)
+	}
+	plan.CurrentKubernetesVersion = "v" + currentKubernetesVersion.String()
+
+	for _, node := range nodeList.Items {
+		target, err := b.kubeletTargetVersion(node)
+		if err != nil {
+			return nil, err
+		}
+		plan.Components = append(plan.Components, ComponentVersion{Name: "kubelet", Node: node.Name, Current: node.Status.NodeInfo.KubeletVersion, Target: target})
+	}
+
+	nodeAgentVersion, err := b.currentGardenerNodeAgentVersion()
+	if err != nil {
+		return nil, err
+	}
+	plan.CurrentGardenerVersion = nodeAgentVersion
+	plan.Components = append(plan.Components, ComponentVersion{Name: "gardener-node-agent", Current: nodeAgentVersion, Target: targetGardenerVersion})
+
+	gardenlet := &appsv1.Deployment{}
+	if err := c.Get(ctx, client.ObjectKey{Name: v1beta1constants.DeploymentNameGardenlet, Namespace: metav1.NamespaceSystem}, gardenlet); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed reading gardenlet deployment: %w", err)
+		}
+	} else if len(gardenlet.Spec.Template.Spec.Containers) > 0 {
+		plan.GardenletVersion = imageTag(gardenlet.Spec.Template.Spec.Containers[0].Image)
+		plan.Components = append(plan.Components, ComponentVersion{Name: v1beta1constants.DeploymentNameGardenlet, Current: plan.GardenletVersion})
+	}
+
+	slices.SortStableFunc(plan.Components, func(a, b ComponentVersion) int {
+		if n := strings.Compare(a.Name, b.Name); n != 0 {
+			return n
+		}
+		return strings.Compare(a.Node, b.Node)
+	})
+
+	taskState, err := b.UpgradeStateStore().Load(ctx, UpgradeFlowName)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading state of previous upgrade: %w", err)
+	}
+	plan.Interrupted = len(taskState) > 0
+
+	return plan, nil
+}
+
+func (b *GardenadmBotanist) kubeletTargetVersion(node corev1.Node) (string, error) {
+	var workerKubernetes *gardencorev1beta1.WorkerKubernetes
+	if poolName, ok := node.Labels[v1beta1constants.LabelWorkerPool]; ok {
+		for _, worker := range b.Shoot.GetInfo().Spec.Provider.Workers {
+			if worker.Name == poolName {
+				workerKubernetes = worker.Kubernetes
+				break
+			}
+		}
+	}
+
+	version, err := v1beta1helper.CalculateEffectiveKubernetesVersion(b.Shoot.KubernetesVersion, workerKubernetes)
+	if err != nil {
+		return "", fmt.Errorf("failed calculating Kubernetes version for node %s: %w", node.Name, err)
+	}
+	return "v" + version.String(), nil
+}
+
+func (b *GardenadmBotanist) currentGardenerNodeAgentVersion() (string, error) {
+	content, err := b.FS.ReadFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed reading last applied operating system config %q: %w", nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := yaml.Unmarshal(content, osc); err != nil {
+		return "", fmt.Errorf("failed decoding last applied operating system config %q: %w", nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	for _, file := range osc.Spec.Files {
+		if file.Path == nodeagentcomponent.PathBinary && file.Content.ImageRef != nil {
+			return imageTag(file.Content.ImageRef.Image), nil
+		}
+	}
+
+	return "", nil
+}
+
+// imageTag returns the tag of the given image reference, or an empty string if it does not have one.
+func imageTag(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[i+1:]
+	}
+	return ""
+}
+
+// UpgradeStateStore returns the flow.StateStore which persists the progress of `gardenadm upgrade apply` so that an
+// interrupted execution can be resumed.
+func (b *GardenadmBotanist) UpgradeStateStore() flow.StateStore {
+	return flow.NewConfigMapStateStore(b.SeedClientSet.Client(), metav1.NamespaceSystem, UpgradeStateConfigMapName)
+}
+
+// CheckUpgradePreconditions checks whether the cluster is in a state which allows upgrading it, i.e., all control
+// plane nodes are ready and all static control plane pods are running and ready. It returns one error per failed
+// check.
+func (b *GardenadmBotanist) CheckUpgradePreconditions(ctx context.Context) ([]error, error) {
+	var (
+		c      = b.SeedClientSet.Client()
+		failed []error
+	)
+
+	nodeList := &corev1.NodeList{}
+	if err := c.List(ctx, nodeList, client.HasLabels{labelKeyNodeRoleControlPlane}); err != nil {
+		return nil, fmt.Errorf("failed listing control plane nodes: %w", err)
+	}
+
+	if len(nodeList.Items) == 0 {
+		failed = append(failed, fmt.Errorf("no control plane nodes found"))
+	}
+
+	for _, node := range nodeList.Items {
+		if err := health.CheckNode(&node); err != nil {
+			failed = append(failed, fmt.Errorf("control plane node %s is not healthy: %w", node.Name, err))
+		}
+		if node.Spec.Unschedulable {
+			failed = append(failed, fmt.Errorf("control plane node %s is cordoned", node.Name))
+		}
+	}
+
+	staticPodList := &corev1.PodList{}
+	if err := c.List(ctx, staticPodList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{staticpod.LabelKeyIsStaticPod: staticpod.LabelValueIsStaticPod}); err != nil {
+		return nil, fmt.Errorf("failed listing static pods: %w", err)
+	}
+
+	for _, pod := range staticPodList.Items {
+		if err := health.CheckPod(&pod); err != nil {
+			failed = append(failed, fmt.Errorf("static pod %s is not healthy: %w", pod.Name, err))
+		} else if !health.IsPodReady(&pod) {
+			failed = append(failed, fmt.Errorf("static pod %s is not ready", pod.Name))
+		}
+	}
+
+	return failed, nil
+}
+
+// RollOutOperatingSystemConfigToControlPlaneNodes permits the control plane nodes one after another to apply the
+// current operating system config of the control plane worker pool. It waits until the node has applied it, is ready
+// again, and until its static pods have been updated before continuing with the next node. Nodes which have already
+// applied the operating system config are skipped, hence, an interrupted rollout can be resumed.
+func (b *GardenadmBotanist) RollOutOperatingSystemConfigToControlPlaneNodes(ctx context.Context) error {
+	c := b.SeedClientSet.Client()
+
+	controlPlaneWorkerPool := v1beta1helper.ControlPlaneWorkerPoolForShoot(b.Shoot.GetInfo().Spec.Provider.Workers)
+	if controlPlaneWorkerPool == nil {
+		return fmt.Errorf("failed fetching the control plane worker pool for the shoot")
+	}
+
+	oscData, ok := b.Shoot.Components.Extensions.OperatingSystemConfig.WorkerPoolNameToOperatingSystemConfigsMap()[controlPlaneWorkerPool.Name]
+	if !ok {
+		return fmt.Errorf("failed fetching the generated OperatingSystemConfig data for the control plane worker pool %q", controlPlaneWorkerPool.Name)
+	}
+
+	// The ManagedResource contains the Secret with the operating system config for gardener-node-agent. We have to wait
+	// until it was applied, otherwise we would read the checksum of the previous operating system config.
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitOperatingSystemConfigRollout)
+	defer cancel()
+	if err := managedresources.WaitUntilHealthy(timeoutCtx, c, b.Shoot.ControlPlaneNamespace, botanistpkg.GardenerNodeAgentManagedResourceName); err != nil {
+		return fmt.Errorf("failed waiting for ManagedResource %q to become healthy: %w", botanistpkg.GardenerNodeAgentManagedResourceName, err)
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: oscData.Original.GardenerNodeAgentSecretName, Namespace: metav1.NamespaceSystem}}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		return fmt.Errorf("failed reading operating system config secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	checksum := secret.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig]
+	if checksum == "" {
+		return fmt.Errorf("operating system config secret %s has no checksum annotation", client.ObjectKeyFromObject(secret))
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := c.List(ctx, nodeList, client.HasLabels{labelKeyNodeRoleControlPlane}); err != nil {
+		return fmt.Errorf("failed listing control plane nodes: %w", err)
+	}
+	slices.SortFunc(nodeList.Items, func(a, b corev1.Node) int { return strings.Compare(a.Name, b.Name) })
+
+	for _, node := range nodeList.Items {
+		if err := b.rollOutOperatingSystemConfigToNode(ctx, &node, checksum); err != nil {
+			return fmt.Errorf("failed rolling out operating system config to node %s: %w", node.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// FinishOperatingSystemConfigRolloutToControlPlaneNodes hands the rollout of operating system configs of the control
+// plane worker pool back to gardener-resource-manager after RollOutOperatingSystemConfigToControlPlaneNodes. It
+// redeploys the Secret with the operating system config for gardener-node-agent without the annotations for the
+// rollout coordinated by gardenadm, i.e., they are only kept if the StagedOperatingSystemConfigRollout feature gate is
+// enabled, and waits until it was applied.
+func (b *GardenadmBotanist) FinishOperatingSystemConfigRolloutToControlPlaneNodes(ctx context.Context) error {
+	b.StagedOperatingSystemConfigRolloutWorkerPools = nil
+
+	if err := b.DeployManagedResourceForGardenerNodeAgent(ctx); err != nil {
+		return fmt.Errorf("failed deploying ManagedResource containing Secret with OperatingSystemConfig for gardener-node-agent: %w", err)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitOperatingSystemConfigRollout)
+	defer cancel()
+	if err := managedresources.WaitUntilHealthy(timeoutCtx, b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, botanistpkg.GardenerNodeAgentManagedResourceName); err != nil {
+		return fmt.Errorf("failed waiting for ManagedResource %q to become healthy: %w", botanistpkg.GardenerNodeAgentManagedResourceName, err)
+	}
+
+	return nil
+}
+
+func (b *GardenadmBotanist) rollOutOperatingSystemConfigToNode(ctx context.Context, node *corev1.Node, checksum string) error {
+	c := b.SeedClientSet.Client()
+	log := b.Logger.WithValues("node", node.Name)
+
+	if node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] == checksum && b.staticPodsUpToDate(ctx, node.Name) == nil {
+		log.Info("Node has already applied the operating system config, skipping it")
+		return nil
+	}
+
+	if node.Annotations[v1beta1constants.AnnotationNodeAgentRolloutPermittedChecksum] != checksum {
+		log.Info("Permitting node to apply operating system config", "checksum", checksum)
+
+		patch := client.MergeFromWithOptions(node.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, v1beta1constants.AnnotationNodeAgentRolloutPermittedChecksum, checksum)
+		if err := c.Patch(ctx, node, patch); err != nil {
+			return fmt.Errorf("failed permitting node to apply operating system config: %w", err)
+		}
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitOperatingSystemConfigRollout)
+	defer cancel()
+
+	return retry.Until(timeoutCtx, IntervalWaitOperatingSystemConfigRollout, func(ctx context.Context) (bool, error) {
+		// kube-apiserver might restart while the node applies the operating system config, hence, we tolerate errors
+		// here by using retry.MinorError.
+		if err := c.Get(ctx, client.ObjectKeyFromObject(node), node); err != nil {
+			return retry.MinorError(fmt.Errorf("failed reading node: %w", err))
+		}
+
+		if applied := node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]; applied != checksum {
+			log.Info("Waiting for node to apply the operating system config", "checksum", checksum)
+			return retry.MinorError(fmt.Errorf("node has not yet applied the operating system config with checksum %s", checksum))
+		}
+
+		if err := health.CheckNode(node); err != nil {
+			log.Info("Waiting for node to become ready", "reason", err.Error())
+			return retry.MinorError(fmt.Errorf("node is not ready: %w", err))
+		}
+
+		if err := b.staticPodsUpToDate(ctx, node.Name); err != nil {
+			log.Info("Waiting for static pods on node to be updated", "reason", err.Error())
+			return retry.MinorError(err)
+		}
+
+		log.Info("Node has applied the operating system config")
+		return retry.Ok()
+	})
+}
+
+func (b *GardenadmBotanist) staticPodsUpToDate(ctx context.Context, nodeName string) error {
+	staticPodList := &corev1.PodList{}
+	if err := b.SeedClientSet.Client().List(ctx, staticPodList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{staticpod.LabelKeyIsStaticPod: staticpod.LabelValueIsStaticPod}); err != nil {
+		return fmt.Errorf("failed listing static pods: %w", err)
+	}
+
+	for name, hash := range b.staticPodNameToHash {
+		idx := slices.IndexFunc(staticPodList.Items, func(pod corev1.Pod) bool {
+			return pod.Spec.NodeName == nodeName && pod.Name == name+"-"+nodeName
+		})
+		if idx == -1 {
+			return fmt.Errorf("static pod %s does not exist yet", name)
+		}
+
+		pod := staticPodList.Items[idx]
+		if pod.Annotations[staticpod.AnnotationKeyHash] != hash {
+			return fmt.Errorf("static pod %s has not been updated yet", pod.Name)
+		}
+		if !health.IsPodReady(&pod) {
+			return fmt.Errorf("static pod %s is not ready yet", pod.Name)
+		}
+	}
+
+	return nil
+}
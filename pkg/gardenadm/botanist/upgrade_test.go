@@ -0,0 +1,508 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"go.uber.org/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
+	mockoperatingsystemconfig "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/mock"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Upgrade", func() {
+	Describe("UpgradePlan", func() {
+		var plan *UpgradePlan
+
+		BeforeEach(func() {
+			plan = &UpgradePlan{
+				CurrentKubernetesVersion: "v1.32.3",
+				TargetKubernetesVersion:  "v1.33.0",
+				CurrentGardenerVersion:   "v1.120.0",
+				TargetGardenerVersion:    "v1.121.0",
+				Components: []ComponentVersion{
+					{Name: "kube-apiserver", Node: "node-0", Current: "v1.32.3", Target: "v1.33.0"},
+					{Name: "kubelet", Node: "node-0", Current: "v1.32.3", Target: "v1.33.0"},
+				},
+			}
+		})
+
+		Describe("#UpToDate", func() {
+			It("should return false if a component is not up to date", func() {
+				Expect(plan.UpToDate()).To(BeFalse())
+			})
+
+			It("should return true if all components are up to date or not managed by gardenadm", func() {
+				plan.Components = []ComponentVersion{
+					{Name: "kube-apiserver", Node: "node-0", Current: "v1.33.0", Target: "v1.33.0"},
+					{Name: "gardenlet", Current: "v1.120.0"},
+				}
+
+				Expect(plan.UpToDate()).To(BeTrue())
+			})
+		})
+
+		Describe("#Validate", func() {
+			It("should succeed for a valid upgrade", func() {
+				Expect(plan.Validate()).To(Succeed())
+			})
+
+			It("should succeed if the current Gardener version is unknown", func() {
+				plan.CurrentGardenerVersion = ""
+
+				Expect(plan.Validate()).To(Succeed())
+			})
+
+			It("should fail for a Kubernetes downgrade", func() {
+				plan.TargetKubernetesVersion = "v1.32.0"
+
+				Expect(plan.Validate()).To(MatchError(ContainSubstring("kubernetes version downgrade from v1.32.3 to v1.32.0 is not supported")))
+			})
+
+			It("should fail if a Kubernetes minor version is skipped", func() {
+				plan.TargetKubernetesVersion = "v1.34.0"
+
+				Expect(plan.Validate()).To(MatchError(ContainSubstring("kubernetes version upgrade from v1.32.3 to v1.34.0 cannot skip a minor version")))
+			})
+
+			It("should fail for a Gardener downgrade", func() {
+				plan.TargetGardenerVersion = "v1.119.0"
+
+				Expect(plan.Validate()).To(MatchError(ContainSubstring("gardener version downgrade from v1.120.0 to v1.119.0 is not supported")))
+			})
+
+			It("should fail if the target Gardener version is newer than the connected gardenlet", func() {
+				plan.GardenletVersion = "v1.120.0"
+
+				Expect(plan.Validate()).To(MatchError(ContainSubstring("must not be newer than the version v1.120.0 of the connected gardenlet")))
+			})
+
+			It("should fail if a kubelet is newer than the control plane", func() {
+				plan.Components[1].Current = "v1.34.0"
+				plan.Components[1].Target = "v1.33.0"
+
+				Expect(plan.Validate()).To(MatchError(ContainSubstring("kubelet version v1.34.0 of node node-0 must not be newer than control plane version")))
+			})
+
+			It("should fail if a kubelet is too old", func() {
+				plan.Components[1].Current = "v1.29.5"
+
+				Expect(plan.Validate()).To(MatchError(ContainSubstring("kubelet version v1.29.5 of node node-0 must be at most three minor versions behind control plane version")))
+			})
+		})
+	})
+
+	Context("with botanist", func() {
+		var (
+			ctx context.Context
+
+			fakeClient client.Client
+			fakeFS     afero.Afero
+
+			b *GardenadmBotanist
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+
+			b = &GardenadmBotanist{
+				Botanist: &botanistpkg.Botanist{
+					Operation: &operation.Operation{
+						Logger:        logr.Discard(),
+						SeedClientSet: fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+						Shoot: &shootpkg.Shoot{
+							ControlPlaneNamespace: "kube-system",
+							KubernetesVersion:     semver.MustParse("1.33.0"),
+						},
+					},
+				},
+				FS:       fakeFS,
+				HostName: "machine-0",
+			}
+
+			b.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{
+							{Name: "control-plane", ControlPlane: &gardencorev1beta1.WorkerControlPlane{}},
+							{Name: "worker", Kubernetes: &gardencorev1beta1.WorkerKubernetes{Version: ptr.To("1.32.3")}},
+						},
+					},
+				},
+			})
+		})
+
+		newStaticPod := func(component, nodeName, image string) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      component + "-" + nodeName,
+					Namespace: "kube-system",
+					Labels:    map[string]string{staticpod.LabelKeyIsStaticPod: staticpod.LabelValueIsStaticPod},
+				},
+				Spec: corev1.PodSpec{
+					NodeName:   nodeName,
+					Containers: []corev1.Container{{Name: component, Image: image}},
+				},
+				Status: corev1.PodStatus{
+					Phase:      corev1.PodRunning,
+					Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+				},
+			}
+		}
+
+		newNode := func(name, kubeletVersion string, labels map[string]string) *corev1.Node {
+			return &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+				Status: corev1.NodeStatus{
+					NodeInfo:   corev1.NodeSystemInfo{KubeletVersion: kubeletVersion},
+					Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+				},
+			}
+		}
+
+		controlPlaneLabels := map[string]string{
+			"node-role.kubernetes.io/control-plane": "",
+			v1beta1constants.LabelWorkerPool:        "control-plane",
+		}
+
+		Describe("#ComputeUpgradePlan", func() {
+			BeforeEach(func() {
+				for _, obj := range []client.Object{
+					newStaticPod("kube-apiserver", "node-0", "registry.local/kube-apiserver:v1.32.3"),
+					newStaticPod("kube-apiserver", "node-1", "registry.local/kube-apiserver:v1.32.2@sha256:abc"),
+					newStaticPod("kube-controller-manager", "node-0", "registry.local:5000/kube-controller-manager:v1.32.3"),
+					newStaticPod("etcd-main", "node-0", "registry.local/etcd:v3.5.21"),
+					newNode("node-0", "v1.32.3", controlPlaneLabels),
+					newNode("node-1", "v1.32.2", controlPlaneLabels),
+					newNode("node-2", "v1.32.3", map[string]string{v1beta1constants.LabelWorkerPool: "worker"}),
+				} {
+					Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+				}
+
+				Expect(fakeFS.WriteFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, []byte(`apiVersion: extensions.gardener.cloud/v1alpha1
+kind: OperatingSystemConfig
+spec:
+  files:
+  - path: /opt/bin/gardener-node-agent
+    content:
+      imageRef:
+        image: registry.local/gardener-node-agent:v1.120.0
+        filePathInImage: /gardener-node-agent
+`), 0600)).To(Succeed())
+			})
+
+			It("should compute the plan", func() {
+				plan, err := b.ComputeUpgradePlan(ctx, "v1.121.0")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(plan).To(Equal(&UpgradePlan{
+					CurrentKubernetesVersion: "v1.32.2",
+					TargetKubernetesVersion:  "v1.33.0",
+					CurrentGardenerVersion:   "v1.120.0",
+					TargetGardenerVersion:    "v1.121.0",
+					Components: []ComponentVersion{
+						{Name: "gardener-node-agent", Current: "v1.120.0", Target: "v1.121.0"},
+						{Name: "kube-apiserver", Node: "node-0", Current: "v1.32.3", Target: "v1.33.0"},
+						{Name: "kube-apiserver", Node: "node-1", Current: "v1.32.2", Target: "v1.33.0"},
+						{Name: "kube-controller-manager", Node: "node-0", Current: "v1.32.3", Target: "v1.33.0"},
+						{Name: "kubelet", Node: "node-0", Current: "v1.32.3", Target: "v1.33.0"},
+						{Name: "kubelet", Node: "node-1", Current: "v1.32.2", Target: "v1.33.0"},
+						{Name: "kubelet", Node: "node-2", Current: "v1.32.3", Target: "v1.32.3"},
+					},
+				}))
+			})
+
+			It("should add the gardenlet version if the cluster is connected", func() {
+				Expect(fakeClient.Create(ctx, &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "gardenlet", Namespace: "kube-system"},
+					Spec: appsv1.DeploymentSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "gardenlet", Image: "registry.local/gardenlet:v1.121.0"}}},
+						},
+					},
+				})).To(Succeed())
+
+				plan, err := b.ComputeUpgradePlan(ctx, "v1.121.0")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(plan.GardenletVersion).To(Equal("v1.121.0"))
+				Expect(plan.Components).To(ContainElement(ComponentVersion{Name: "gardenlet", Current: "v1.121.0"}))
+			})
+
+			It("should leave the Gardener version empty if no operating system config was applied yet", func() {
+				Expect(fakeFS.Remove(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath)).To(Succeed())
+
+				plan, err := b.ComputeUpgradePlan(ctx, "v1.121.0")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(plan.CurrentGardenerVersion).To(BeEmpty())
+				Expect(plan.Components).To(ContainElement(ComponentVersion{Name: "gardener-node-agent", Target: "v1.121.0"}))
+			})
+
+			It("should mark the plan as interrupted if there is a state of a previous upgrade", func() {
+				Expect(b.UpgradeStateStore().Store(ctx, UpgradeFlowName, flow.TaskState{"some-task": "some-checksum"})).To(Succeed())
+
+				plan, err := b.ComputeUpgradePlan(ctx, "v1.121.0")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(plan.Interrupted).To(BeTrue())
+			})
+
+			It("should fall back to the kubelet version of the node for images referenced by digest only", func() {
+				pod := &corev1.Pod{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "kube-apiserver-node-1", Namespace: "kube-system"}, pod)).To(Succeed())
+				pod.Spec.Containers[0].Image = "registry.local/kube-apiserver@sha256:abc"
+				Expect(fakeClient.Update(ctx, pod)).To(Succeed())
+
+				plan, err := b.ComputeUpgradePlan(ctx, "v1.121.0")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(plan.CurrentKubernetesVersion).To(Equal("v1.32.2"))
+				Expect(plan.Components).To(ContainElement(ComponentVersion{Name: "kube-apiserver", Node: "node-1", Current: "v1.32.2", Target: "v1.33.0"}))
+			})
+
+			It("should skip static pods whose version cannot be determined", func() {
+				Expect(fakeClient.Create(ctx, newStaticPod("kube-apiserver", "node-3", "registry.local/kube-apiserver@sha256:def"))).To(Succeed())
+
+				plan, err := b.ComputeUpgradePlan(ctx, "v1.121.0")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(plan.CurrentKubernetesVersion).To(Equal("v1.32.2"))
+				Expect(plan.Components).NotTo(ContainElement(HaveField("Node", "node-3")))
+			})
+
+			It("should fail if there are no kube-apiserver static pods", func() {
+				Expect(fakeClient.DeleteAllOf(ctx, &corev1.Pod{}, client.InNamespace("kube-system"))).To(Succeed())
+
+				_, err := b.ComputeUpgradePlan(ctx, "v1.121.0")
+				Expect(err).To(MatchError("no kube-apiserver static pods found in namespace kube-system"))
+			})
+		})
+
+		Describe("#CheckUpgradePreconditions", func() {
+			It("should fail if there are no control plane nodes", func() {
+				Expect(b.CheckUpgradePreconditions(ctx)).To(ConsistOf(MatchError("no control plane nodes found")))
+			})
+
+			It("should succeed if all nodes and static pods are healthy", func() {
+				Expect(fakeClient.Create(ctx, newNode("node-0", "v1.32.3", controlPlaneLabels))).To(Succeed())
+				Expect(fakeClient.Create(ctx, newStaticPod("kube-apiserver", "node-0", "kube-apiserver:v1.32.3"))).To(Succeed())
+
+				Expect(b.CheckUpgradePreconditions(ctx)).To(BeEmpty())
+			})
+
+			It("should report unhealthy and cordoned nodes as well as static pods which are not ready", func() {
+				node0 := newNode("node-0", "v1.32.3", controlPlaneLabels)
+				node0.Status.Conditions[0].Status = corev1.ConditionFalse
+				node1 := newNode("node-1", "v1.32.3", controlPlaneLabels)
+				node1.Spec.Unschedulable = true
+				pod0 := newStaticPod("kube-apiserver", "node-0", "kube-apiserver:v1.32.3")
+				pod0.Status.Phase = corev1.PodFailed
+				pod1 := newStaticPod("kube-apiserver", "node-1", "kube-apiserver:v1.32.3")
+				pod1.Status.Conditions = nil
+
+				for _, obj := range []client.Object{node0, node1, pod0, pod1} {
+					Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+				}
+
+				Expect(b.CheckUpgradePreconditions(ctx)).To(ConsistOf(
+					MatchError(ContainSubstring("control plane node node-0 is not healthy")),
+					MatchError("control plane node node-1 is cordoned"),
+					MatchError(ContainSubstring("static pod kube-apiserver-node-0 is not healthy")),
+					MatchError("static pod kube-apiserver-node-1 is not ready"),
+				))
+			})
+		})
+
+		Describe("#RollOutOperatingSystemConfigToControlPlaneNodes", func() {
+			const (
+				secretName = "gardener-node-agent-control-plane-abc"
+				checksum   = "new-checksum"
+			)
+
+			var (
+				ctrl                  *gomock.Controller
+				operatingSystemConfig *mockoperatingsystemconfig.MockInterface
+			)
+
+			BeforeEach(func() {
+				DeferCleanup(test.WithVars(
+					&IntervalWaitOperatingSystemConfigRollout, 10*time.Millisecond,
+					&TimeoutWaitOperatingSystemConfigRollout, 500*time.Millisecond,
+				))
+
+				ctrl = gomock.NewController(GinkgoT())
+				operatingSystemConfig = mockoperatingsystemconfig.NewMockInterface(ctrl)
+				operatingSystemConfig.EXPECT().WorkerPoolNameToOperatingSystemConfigsMap().Return(map[string]*operatingsystemconfig.OperatingSystemConfigs{
+					"control-plane": {Original: operatingsystemconfig.Data{GardenerNodeAgentSecretName: secretName}},
+				}).AnyTimes()
+				b.Shoot.Components = &shootpkg.Components{Extensions: &shootpkg.Extensions{OperatingSystemConfig: operatingSystemConfig}}
+
+				Expect(fakeClient.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot-gardener-node-agent", Namespace: "kube-system"},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						Conditions: []gardencorev1beta1.Condition{
+							{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionTrue},
+							{Type: resourcesv1alpha1.ResourcesHealthy, Status: gardencorev1beta1.ConditionTrue},
+						},
+					},
+				})).To(Succeed())
+				Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+					Name:        secretName,
+					Namespace:   "kube-system",
+					Annotations: map[string]string{nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig: checksum},
+				}})).To(Succeed())
+			})
+
+			It("should skip nodes which have already applied the operating system config", func() {
+				node := newNode("node-0", "v1.33.0", controlPlaneLabels)
+				node.Annotations = map[string]string{nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig: checksum}
+				Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+				Expect(b.RollOutOperatingSystemConfigToControlPlaneNodes(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				Expect(node.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationNodeAgentRolloutPermittedChecksum))
+			})
+
+			It("should permit the nodes one after another and wait until they applied the operating system config", func() {
+				node0 := newNode("node-0", "v1.32.3", controlPlaneLabels)
+				node1 := newNode("node-1", "v1.32.3", controlPlaneLabels)
+				Expect(fakeClient.Create(ctx, node0)).To(Succeed())
+				Expect(fakeClient.Create(ctx, node1)).To(Succeed())
+
+				DeferCleanup(test.WithVar(&TimeoutWaitOperatingSystemConfigRollout, 10*time.Second))
+
+				errChan := make(chan error, 1)
+				go func() {
+					defer GinkgoRecover()
+					errChan <- b.RollOutOperatingSystemConfigToControlPlaneNodes(ctx)
+				}()
+
+				Eventually(func(g Gomega) map[string]string {
+					g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node0), node0)).To(Succeed())
+					return node0.Annotations
+				}).Should(HaveKeyWithValue(v1beta1constants.AnnotationNodeAgentRolloutPermittedChecksum, checksum))
+
+				Consistently(func(g Gomega) map[string]string {
+					g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node1), node1)).To(Succeed())
+					return node1.Annotations
+				}).WithTimeout(100 * time.Millisecond).ShouldNot(HaveKey(v1beta1constants.AnnotationNodeAgentRolloutPermittedChecksum))
+
+				for _, node := range []*corev1.Node{node0, node1} {
+					Eventually(func(g Gomega) map[string]string {
+						g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+						return node.Annotations
+					}).Should(HaveKeyWithValue(v1beta1constants.AnnotationNodeAgentRolloutPermittedChecksum, checksum))
+
+					patch := client.MergeFrom(node.DeepCopy())
+					metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, checksum)
+					Expect(fakeClient.Patch(ctx, node, patch)).To(Succeed())
+				}
+
+				Eventually(errChan).Should(Receive(BeNil()))
+			})
+
+			It("should time out if a node does not apply the operating system config", func() {
+				Expect(fakeClient.Create(ctx, newNode("node-0", "v1.32.3", controlPlaneLabels))).To(Succeed())
+
+				Expect(b.RollOutOperatingSystemConfigToControlPlaneNodes(ctx)).To(MatchError(ContainSubstring("failed rolling out operating system config to node node-0")))
+			})
+
+			It("should fail if the operating system config secret has no checksum", func() {
+				secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: "kube-system"}}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+				secret.Annotations = nil
+				Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+
+				Expect(b.RollOutOperatingSystemConfigToControlPlaneNodes(ctx)).To(MatchError(ContainSubstring("has no checksum annotation")))
+			})
+		})
+
+		Describe("#FinishOperatingSystemConfigRolloutToControlPlaneNodes", func() {
+			BeforeEach(func() {
+				DeferCleanup(test.WithVars(
+					&TimeoutWaitOperatingSystemConfigRollout, 500*time.Millisecond,
+					&botanistpkg.NodeAgentOSCSecretFn, func(_ context.Context, _ client.Client, _ *extensionsv1alpha1.OperatingSystemConfig, secretName, _ string) (*corev1.Secret, error) {
+						return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: "kube-system"}}, nil
+					},
+					&botanistpkg.NodeAgentRBACResourcesDataFn, func() (map[string][]byte, error) { return nil, nil },
+				))
+
+				ctrl := gomock.NewController(GinkgoT())
+				operatingSystemConfig := mockoperatingsystemconfig.NewMockInterface(ctrl)
+				operatingSystemConfig.EXPECT().WorkerPoolNameToOperatingSystemConfigsMap().Return(map[string]*operatingsystemconfig.OperatingSystemConfigs{
+					"control-plane": {Original: operatingsystemconfig.Data{GardenerNodeAgentSecretName: "gardener-node-agent-control-plane", Object: &extensionsv1alpha1.OperatingSystemConfig{}}},
+					"worker":        {Original: operatingsystemconfig.Data{GardenerNodeAgentSecretName: "gardener-node-agent-worker", Object: &extensionsv1alpha1.OperatingSystemConfig{}}},
+				}).AnyTimes()
+				b.Shoot.Components = &shootpkg.Components{Extensions: &shootpkg.Extensions{OperatingSystemConfig: operatingSystemConfig}}
+				b.StagedOperatingSystemConfigRolloutWorkerPools = sets.New("control-plane")
+
+				Expect(fakeClient.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot-gardener-node-agent", Namespace: "kube-system"},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						Conditions: []gardencorev1beta1.Condition{
+							{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionTrue},
+							{Type: resourcesv1alpha1.ResourcesHealthy, Status: gardencorev1beta1.ConditionTrue},
+						},
+					},
+				})).To(Succeed())
+			})
+
+			It("should redeploy the operating system config secret without the annotations for the rollout coordinated by gardenadm", func() {
+				Expect(b.FinishOperatingSystemConfigRolloutToControlPlaneNodes(ctx)).To(Succeed())
+				Expect(b.StagedOperatingSystemConfigRolloutWorkerPools).To(BeEmpty())
+
+				managedResourceSecretList := &corev1.SecretList{}
+				Expect(fakeClient.List(ctx, managedResourceSecretList, client.InNamespace("kube-system"), client.MatchingLabels{"managed-resource": "shoot-gardener-node-agent"})).To(Succeed())
+				idx := slices.IndexFunc(managedResourceSecretList.Items, func(secret corev1.Secret) bool {
+					return strings.HasPrefix(secret.Name, "managedresource-shoot-gardener-node-agent-control-plane")
+				})
+				Expect(idx).NotTo(Equal(-1))
+
+				raw, err := test.BrotliDecompression(managedResourceSecretList.Items[idx].Data["data.yaml.br"])
+				Expect(err).NotTo(HaveOccurred())
+				oscSecret := &corev1.Secret{}
+				Expect(runtime.DecodeInto(kubernetes.ShootCodec.UniversalDecoder(), raw, oscSecret)).To(Succeed())
+				Expect(oscSecret.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationNodeAgentRolloutCoordinatedExternally))
+				Expect(oscSecret.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationNodeAgentRolloutMaxUnavailable))
+			})
+		})
+	})
+})
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+
+	// Force skips the confirmation prompt and the check whether this machine is one of multiple control plane nodes.
+	Force bool
+	// SkipDrain skips draining the node and removing it from the cluster, e.g., when the control plane is not reachable.
+	SkipDrain bool
+	// DrainTimeout is the timeout for draining the node.
+	DrainTimeout time.Duration
+	// RemoveContainerdState removes the containers of all containerd namespaces and the complete state of containerd
+	// instead of only the containers of the Kubernetes namespace.
+	RemoveContainerdState bool
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(_ []string) error { return nil }
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if o.DrainTimeout <= 0 {
+		return fmt.Errorf("drain timeout must be greater than 0")
+	}
+
+	return nil
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error { return nil }
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.BoolVarP(&o.Force, "force", "f", false, "Reset the node without prompting for confirmation, even if it is one of multiple control plane nodes")
+	fs.BoolVar(&o.SkipDrain, "skip-drain", false, "Skip draining the node and removing it from the cluster (e.g., when the control plane is not reachable)")
+	fs.DurationVar(&o.DrainTimeout, "drain-timeout", 5*time.Minute, "Timeout for evicting all pods from the node")
+	fs.BoolVar(&o.RemoveContainerdState, "remove-containerd-state", false, "Remove the containers of all containerd namespaces and the complete state of containerd (/var/lib/containerd, /run/containerd) including all images, i.e., also containers and images of other tools using containerd (e.g., docker)")
+}
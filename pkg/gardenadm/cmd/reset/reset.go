@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var (
+	// NewBotanist creates a new GardenadmBotanist.
+	// Exposed for testing.
+	NewBotanist = botanist.NewGardenadmBotanistWithoutResources
+	// CreateClientSet creates a client set for the cluster.
+	// Exposed for testing.
+	CreateClientSet = func(ctx context.Context, b *botanist.GardenadmBotanist) (kubernetes.Interface, error) {
+		return b.CreateClientSet(ctx)
+	}
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "reset",
+		Short: "Revert the changes made to this node by 'gardenadm init' or 'gardenadm join'",
+		Long: `Revert the changes made to this node by 'gardenadm init' or 'gardenadm join'.
+
+This command drains the node and removes it from the cluster. Afterward, it stops gardener-node-agent and kubelet and
+removes the static pods of the control plane including the etcd data directories, the containers in the Kubernetes
+namespace (k8s.io) of containerd, as well as the files and units written by gardener-node-agent. The machine can be
+bootstrapped again afterward.
+
+Containers in other containerd namespaces (e.g., of docker or buildkit) and the state of containerd (e.g., images) are
+kept. Use --remove-containerd-state to remove the containers of all containerd namespaces and the complete state of
+containerd (/var/lib/containerd and /run/containerd). This destroys all other workloads using containerd on this
+machine.
+
+Draining the node requires access to the cluster. By default, the kubeconfig at /etc/kubernetes/admin.conf is used,
+which is only available on control plane nodes. Use the KUBECONFIG environment variable to specify another kubeconfig.
+If the cluster is not reachable, draining the node is skipped and the node object must be removed manually.
+
+Resetting one of multiple control plane nodes removes the data of its etcd members without removing them from the
+etcd clusters, which reduces the fault tolerance of etcd or even breaks its quorum. Hence, this command refuses to reset
+such a node (or a node running static pods of the control plane if the cluster is not reachable) unless --force is
+given. Remove the etcd members of the node first.
+
+The configuration directory in /var/lib/gardenadm is kept, so that 'gardenadm init' can be executed again with the
+same configuration.`,
+		Example: `# Reset this node after asking for confirmation
+gardenadm reset
+
+# Reset this node without asking for confirmation
+gardenadm reset --force
+
+# Reset this node without draining it, e.g., after bootstrapping the first control plane node failed
+gardenadm reset --force --skip-drain
+
+# Reset this node and remove all containers and the complete state of containerd
+gardenadm reset --remove-containerd-state`,
+
+		Args: cobra.NoArgs,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	if !opts.Force {
+		confirmed, err := confirm(opts)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			fmt.Fprintf(opts.Out, "Aborted resetting the node.\n")
+			return nil
+		}
+	}
+
+	b, err := NewBotanist(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	drainNode := !opts.SkipDrain
+	if drainNode {
+		clientSet, err := CreateClientSet(ctx, b)
+		if err != nil {
+			b.Logger.Info("Cluster is not reachable, skipping draining the node and removing it from the cluster", "error", err.Error())
+			drainNode = false
+		} else {
+			b.SeedClientSet = clientSet
+		}
+	}
+
+	if !opts.Force {
+		if err := b.CheckControlPlaneNodeCanBeReset(ctx); err != nil {
+			return fmt.Errorf("refusing to reset this node: %w (remove the etcd members of this node first, or use --force to reset it anyway)", err)
+		}
+	}
+
+	var (
+		g = flow.NewGraph("reset")
+
+		drain = g.Add(flow.Task{
+			Name: "Draining node",
+			Fn: func(ctx context.Context) error {
+				return b.DrainNode(ctx, opts.DrainTimeout)
+			},
+			SkipIf: !drainNode,
+		})
+		stopGardenerNodeAgentAndKubelet = g.Add(flow.Task{
+			Name:         "Stopping gardener-node-agent and kubelet",
+			Fn:           b.StopGardenerNodeAgentAndKubelet,
+			Dependencies: flow.NewTaskIDs(drain),
+		})
+		deleteNode = g.Add(flow.Task{
+			Name:         "Removing node from the cluster",
+			Fn:           b.DeleteNode,
+			SkipIf:       !drainNode,
+			Dependencies: flow.NewTaskIDs(stopGardenerNodeAgentAndKubelet),
+		})
+		// On control plane nodes, the static pods (and containerd) serve the API server, hence they must only be removed
+		// after the node was removed from the cluster.
+		removeStaticPods = g.Add(flow.Task{
+			Name:         "Removing static pods and their data directories",
+			Fn:           b.RemoveStaticPods,
+			Dependencies: flow.NewTaskIDs(stopGardenerNodeAgentAndKubelet, deleteNode),
+		})
+		resetContainerd = g.Add(flow.Task{
+			Name: "Removing containers and configuration of containerd",
+			Fn: func(ctx context.Context) error {
+				return b.ResetContainerd(ctx, opts.RemoveContainerdState)
+			},
+			Dependencies: flow.NewTaskIDs(removeStaticPods),
+		})
+		removeKubeletState = g.Add(flow.Task{
+			Name:         "Removing state of kubelet",
+			Fn:           b.RemoveKubeletState,
+			Dependencies: flow.NewTaskIDs(resetContainerd),
+		})
+		removeOperatingSystemConfigFilesAndUnits = g.Add(flow.Task{
+			Name:         "Removing files and units of the operating system config",
+			Fn:           b.RemoveOperatingSystemConfigFilesAndUnits,
+			Dependencies: flow.NewTaskIDs(removeKubeletState),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Removing state of gardener-node-agent",
+			Fn:           b.RemoveGardenerNodeAgentState,
+			Dependencies: flow.NewTaskIDs(removeOperatingSystemConfigFilesAndUnits),
+		})
+	)
+
+	if err := g.Compile().Run(ctx, flow.Opts{
+		Log: opts.Log,
+	}); err != nil {
+		return flow.Errors(err)
+	}
+
+	fmt.Fprintf(opts.Out, `
+Your node has successfully been reset!
+`)
+
+	if !drainNode {
+		fmt.Fprintf(opts.Out, `
+The node has not been drained and removed from the cluster. If the cluster is
+still running, remove the node object by running the following command on a
+control plane node:
+
+  kubectl delete node <node-name>
+`)
+	}
+
+	fmt.Fprintf(opts.Out, `
+The machine can now be bootstrapped again with 'gardenadm init' or 'gardenadm join'.
+`)
+
+	return nil
+}
+
+func confirm(opts *Options) (bool, error) {
+	fmt.Fprintf(opts.Out, "This node will be drained and removed from the cluster, and all of its Kubernetes state will be deleted.\nAre you sure you want to proceed? [y/N]: ")
+
+	answer, err := bufio.NewReader(opts.In).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("failed reading confirmation: %w", err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset_test
+
+import (
+	"context"
+	"fmt"
+
+	systemddbus "github.com/coreos/go-systemd/v22/dbus"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/reset"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reset", func() {
+	var (
+		ctx = context.Background()
+
+		globalOpts *cmd.Options
+		stdIn      *Buffer
+		stdOut     *Buffer
+		command    *cobra.Command
+
+		fakeClient client.Client
+		fakeDBus   *fakedbus.DBus
+		fakeFS     afero.Afero
+
+		node *corev1.Node
+
+		botanistCreated                bool
+		clientSetRequested             bool
+		clientSetErr                   error
+		removedAllContainerdNamespaces bool
+	)
+
+	BeforeEach(func() {
+		globalOpts = &cmd.Options{Log: logr.Discard()}
+		globalOpts.IOStreams, stdIn, stdOut, _ = clitest.NewTestIOStreams()
+		command = NewCommand(globalOpts)
+		command.SetContext(ctx)
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithIndex(&corev1.Pod{}, "spec.nodeName", func(obj client.Object) []string {
+				return []string{obj.(*corev1.Pod).Spec.NodeName}
+			}).
+			Build()
+		fakeDBus = fakedbus.New()
+		fakeDBus.AddUnitsToList(
+			systemddbus.UnitStatus{Name: "gardener-node-agent.service"},
+			systemddbus.UnitStatus{Name: "kubelet.service"},
+			systemddbus.UnitStatus{Name: "containerd.service"},
+		)
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-0", Labels: map[string]string{"kubernetes.io/hostname": "machine-0"}}}
+		Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+		for _, p := range []string{
+			"/etc/systemd/system/gardener-node-agent.service",
+			"/etc/systemd/system/kubelet.service",
+			"/var/lib/gardener-node-agent/credentials/kubeconfig",
+			"/var/lib/kubelet/kubeconfig-real",
+			"/var/lib/containerd/io.containerd.content.v1.content/blob",
+		} {
+			Expect(fakeFS.WriteFile(p, nil, 0600)).To(Succeed())
+		}
+
+		botanistCreated, clientSetRequested, clientSetErr, removedAllContainerdNamespaces = false, false, nil, false
+
+		DeferCleanup(test.WithVars(
+			&NewBotanist, func(log logr.Logger) (*botanist.GardenadmBotanist, error) {
+				botanistCreated = true
+				return &botanist.GardenadmBotanist{
+					Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{Logger: log}},
+					HostName: "machine-0",
+					DBus:     fakeDBus,
+					FS:       fakeFS,
+				}, nil
+			},
+			&CreateClientSet, func(context.Context, *botanist.GardenadmBotanist) (kubernetes.Interface, error) {
+				clientSetRequested = true
+				if clientSetErr != nil {
+					return nil, clientSetErr
+				}
+				return fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(), nil
+			},
+			&botanist.RemoveContainers, func(_ context.Context, _ logr.Logger, allNamespaces bool) error {
+				removedAllContainerdNamespaces = allNamespaces
+				return nil
+			},
+			&botanist.UnmountRecursive, func(string, int) error { return nil },
+		))
+	})
+
+	Describe("#RunE", func() {
+		It("should abort if the reset is not confirmed", func() {
+			_, err := stdIn.Write([]byte("n\n"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say(`Are you sure you want to proceed\? \[y/N\]: Aborted resetting the node.`))
+			Expect(botanistCreated).To(BeFalse())
+			Expect(fakeFS.Exists("/var/lib/kubelet/kubeconfig-real")).To(BeTrue())
+		})
+
+		It("should drain and remove the node and reset the machine after confirmation", func() {
+			_, err := stdIn.Write([]byte("y\n"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(BeNotFoundError())
+			for _, p := range []string{
+				"/etc/systemd/system/gardener-node-agent.service",
+				"/etc/systemd/system/kubelet.service",
+				"/var/lib/gardener-node-agent",
+				"/var/lib/kubelet",
+			} {
+				Expect(fakeFS.Exists(p)).To(BeFalse(), p)
+			}
+			Expect(fakeFS.Exists("/var/lib/containerd")).To(BeTrue())
+			Expect(removedAllContainerdNamespaces).To(BeFalse())
+			Expect(fakeDBus.Actions).To(ContainElements(
+				fakedbus.SystemdAction{Action: fakedbus.ActionStop, UnitNames: []string{"gardener-node-agent.service"}},
+				fakedbus.SystemdAction{Action: fakedbus.ActionStop, UnitNames: []string{"kubelet.service"}},
+				fakedbus.SystemdAction{Action: fakedbus.ActionStop, UnitNames: []string{"containerd.service"}},
+				fakedbus.SystemdAction{Action: fakedbus.ActionStart, UnitNames: []string{"containerd.service"}},
+			))
+
+			Eventually(stdOut).Should(Say("Your node has successfully been reset!"))
+			Expect(string(stdOut.Contents())).NotTo(ContainSubstring("kubectl delete node"))
+		})
+
+		It("should remove the complete state of containerd if requested", func() {
+			Expect(command.Flags().Set("force", "true")).To(Succeed())
+			Expect(command.Flags().Set("remove-containerd-state", "true")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Expect(removedAllContainerdNamespaces).To(BeTrue())
+			Expect(fakeFS.Exists("/var/lib/containerd")).To(BeFalse())
+			Expect(fakeFS.Exists("/var/lib/kubelet")).To(BeFalse())
+		})
+
+		It("should refuse to reset one of multiple control plane nodes", func() {
+			_, err := stdIn.Write([]byte("y\n"))
+			Expect(err).NotTo(HaveOccurred())
+
+			metav1.SetMetaDataLabel(&node.ObjectMeta, "node-role.kubernetes.io/control-plane", "")
+			Expect(fakeClient.Update(ctx, node)).To(Succeed())
+			Expect(fakeClient.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{"node-role.kubernetes.io/control-plane": ""}}})).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(MatchError(ContainSubstring("refusing to reset this node: this machine is one of 2 control plane nodes")))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Unschedulable).To(BeFalse())
+			Expect(fakeFS.Exists("/var/lib/kubelet/kubeconfig-real")).To(BeTrue())
+		})
+
+		It("should reset one of multiple control plane nodes if forced", func() {
+			Expect(command.Flags().Set("force", "true")).To(Succeed())
+
+			metav1.SetMetaDataLabel(&node.ObjectMeta, "node-role.kubernetes.io/control-plane", "")
+			Expect(fakeClient.Update(ctx, node)).To(Succeed())
+			Expect(fakeClient.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{"node-role.kubernetes.io/control-plane": ""}}})).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(BeNotFoundError())
+			Expect(fakeFS.Exists("/var/lib/kubelet")).To(BeFalse())
+		})
+
+		It("should reset the machine without draining it if the cluster is not reachable", func() {
+			Expect(command.Flags().Set("force", "true")).To(Succeed())
+			clientSetErr = fmt.Errorf("fake")
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Expect(clientSetRequested).To(BeTrue())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Unschedulable).To(BeFalse())
+			Expect(fakeFS.Exists("/var/lib/kubelet")).To(BeFalse())
+
+			Eventually(stdOut).Should(Say("Your node has successfully been reset!"))
+			Eventually(stdOut).Should(Say("kubectl delete node <node-name>"))
+		})
+
+		It("should not try to reach the cluster if draining is skipped", func() {
+			Expect(command.Flags().Set("force", "true")).To(Succeed())
+			Expect(command.Flags().Set("skip-drain", "true")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Expect(clientSetRequested).To(BeFalse())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(fakeFS.Exists("/var/lib/kubelet")).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,345 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	gardenerextensions "github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	upgradeutils "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/utils"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// RunFlow runs the flow which upgrades the self-hosted shoot cluster.
+// Exposed for testing.
+var RunFlow = runFlow
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Upgrade the self-hosted shoot cluster to the target Kubernetes and Gardener versions",
+		Long: `Upgrade the self-hosted shoot cluster to the target Kubernetes and Gardener versions.
+
+The command shows the upgrade plan (see 'gardenadm upgrade plan'), validates the version skew, and runs the pre-flight
+checks. After confirmation, it redeploys the control plane components with the target versions and rolls the new
+operating system config (including the static control plane pods, kubelet, and gardener-node-agent) out to the control
+plane nodes one after another. Each node has to apply it and become ready again before the next node is permitted to
+apply it.
+
+The progress is persisted in the cluster. If the upgrade is interrupted, running the command again resumes it.`,
+
+		Example: `# Upgrade the cluster after asking for confirmation
+gardenadm upgrade apply
+
+# Upgrade the cluster without asking for confirmation
+gardenadm upgrade apply --force
+
+# Resume an interrupted upgrade which left a control plane node unhealthy
+gardenadm upgrade apply --ignore-preflight-errors`,
+
+		Args: cobra.NoArgs,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	b, err := upgradeutils.NewBotanist(ctx, opts.Log, opts.ConfigDir)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	// The control plane nodes must apply the new operating system config one after another. Hence, the operating system
+	// config secret for gardener-node-agent of the control plane worker pool must be annotated for a staged rollout
+	// coordinated by gardenadm instead of gardener-resource-manager, see
+	// GardenadmBotanist.RollOutOperatingSystemConfigToControlPlaneNodes. The annotations are removed again by
+	// GardenadmBotanist.FinishOperatingSystemConfigRolloutToControlPlaneNodes.
+	if controlPlaneWorkerPool := v1beta1helper.ControlPlaneWorkerPoolForShoot(b.Shoot.GetInfo().Spec.Provider.Workers); controlPlaneWorkerPool != nil {
+		b.StagedOperatingSystemConfigRolloutWorkerPools = sets.New(controlPlaneWorkerPool.Name)
+	}
+
+	plan, err := b.ComputeUpgradePlan(ctx, upgradeutils.TargetGardenerVersion())
+	if err != nil {
+		return fmt.Errorf("failed computing upgrade plan: %w", err)
+	}
+
+	if err := upgradeutils.PrintPlan(opts.Out, plan); err != nil {
+		return fmt.Errorf("failed printing upgrade plan: %w", err)
+	}
+
+	if err := upgradeutils.Verify(ctx, opts.Out, b, plan, opts.IgnorePreflightErrors); err != nil {
+		return err
+	}
+
+	if plan.UpToDate() && !plan.Interrupted {
+		fmt.Fprintf(opts.Out, "\nAll components are up to date, there is nothing to upgrade.\n")
+		return nil
+	}
+
+	if plan.Interrupted {
+		fmt.Fprintf(opts.Out, "\nA previous upgrade has been interrupted, it will be resumed.\n")
+	}
+
+	if !opts.Force {
+		confirmed, err := confirm(opts)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			fmt.Fprintf(opts.Out, "Aborted upgrading the cluster.\n")
+			return nil
+		}
+	}
+
+	if err := RunFlow(ctx, opts, b, plan); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.Out, `
+Your self-hosted shoot cluster has successfully been upgraded to Kubernetes version %s
+and Gardener version %s!
+
+Worker nodes pick up the new operating system config on their own. You can watch
+their progress by running:
+
+  kubectl get nodes -L %s
+`, plan.TargetKubernetesVersion, plan.TargetGardenerVersion, v1beta1constants.LabelWorkerKubernetesVersion)
+
+	return nil
+}
+
+func runFlow(ctx context.Context, opts *Options, b *botanist.GardenadmBotanist, plan *botanist.UpgradePlan) error {
+	var (
+		g                = flow.NewGraph(botanist.UpgradeFlowName)
+		kubeProxyEnabled = v1beta1helper.KubeProxyEnabled(b.Shoot.GetInfo().Spec.Kubernetes.KubeProxy)
+
+		// Tasks with this input checksum are skipped when an interrupted upgrade to the same versions is resumed, if
+		// they already succeeded before. Tasks which compute in-memory state needed by subsequent tasks must always be
+		// executed.
+		targetVersionsChecksum = func() string {
+			return utils.ComputeSHA256Hex([]byte(plan.TargetKubernetesVersion + "/" + plan.TargetGardenerVersion))
+		}
+
+		reconcileCustomResourceDefinitions = g.Add(flow.Task{
+			Name:          "Reconciling CustomResourceDefinitions",
+			Fn:            b.ReconcileCustomResourceDefinitions,
+			InputChecksum: targetVersionsChecksum,
+		})
+		ensureCustomResourceDefinitionsReady = g.Add(flow.Task{
+			Name:         "Ensuring CustomResourceDefinitions are ready",
+			Fn:           flow.TaskFn(b.EnsureCustomResourceDefinitionsReady).RetryUntilTimeout(time.Second, time.Minute),
+			Dependencies: flow.NewTaskIDs(reconcileCustomResourceDefinitions),
+		})
+		reconcileClusterResource = g.Add(flow.Task{
+			Name: "Reconciling extensions.gardener.cloud/v1alpha1.Cluster resource",
+			Fn: func(ctx context.Context) error {
+				return gardenerextensions.SyncClusterResourceToSeed(ctx, b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, b.Shoot.GetInfo(), b.Shoot.CloudProfile, b.Seed.GetInfo())
+			},
+			Dependencies: flow.NewTaskIDs(ensureCustomResourceDefinitionsReady),
+		})
+		initializeSecretsManagement = g.Add(flow.Task{
+			Name:         "Initializing internal state of Gardener secrets manager",
+			Fn:           b.InitializeSecretsManagement,
+			Dependencies: flow.NewTaskIDs(reconcileClusterResource),
+		})
+		deployGardenerResourceManager = g.Add(flow.Task{
+			Name: "Deploying gardener-resource-manager",
+			Fn: func(ctx context.Context) error {
+				b.Shoot.Components.ControlPlane.ResourceManager.SetBootstrapControlPlaneNode(false)
+				return b.Shoot.Components.ControlPlane.ResourceManager.Deploy(ctx)
+			},
+			InputChecksum: targetVersionsChecksum,
+			Dependencies:  flow.NewTaskIDs(initializeSecretsManagement),
+		})
+		waitUntilGardenerResourceManagerReady = g.Add(flow.Task{
+			Name:          "Waiting until gardener-resource-manager reports readiness",
+			Fn:            b.Shoot.Components.ControlPlane.ResourceManager.Wait,
+			InputChecksum: targetVersionsChecksum,
+			Dependencies:  flow.NewTaskIDs(deployGardenerResourceManager),
+		})
+		deployExtensionControllers = g.Add(flow.Task{
+			Name: "Deploying extension controllers",
+			Fn: func(ctx context.Context) error {
+				return b.ReconcileExtensionControllerInstallations(ctx, false)
+			},
+			InputChecksum: targetVersionsChecksum,
+			Dependencies:  flow.NewTaskIDs(waitUntilGardenerResourceManagerReady),
+		})
+		waitUntilExtensionControllersReady = g.Add(flow.Task{
+			Name:          "Waiting until extension controllers report readiness",
+			Fn:            b.WaitUntilExtensionControllerInstallationsHealthy,
+			InputChecksum: targetVersionsChecksum,
+			Dependencies:  flow.NewTaskIDs(deployExtensionControllers),
+		})
+		deployCloudProviderSecret = g.Add(flow.Task{
+			Name:         "Deploying cloud provider account secret",
+			Fn:           b.DeployCloudProviderSecret,
+			SkipIf:       b.Shoot.Credentials == nil,
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement),
+		})
+		deployInfrastructure = g.Add(flow.Task{
+			Name:         "Deploying Shoot infrastructure",
+			Fn:           b.DeployInfrastructure,
+			SkipIf:       !b.Shoot.HasManagedInfrastructure(),
+			Dependencies: flow.NewTaskIDs(deployCloudProviderSecret, waitUntilExtensionControllersReady),
+		})
+		waitUntilInfrastructureReady = g.Add(flow.Task{
+			Name:         "Waiting until Shoot infrastructure has been reconciled",
+			Fn:           b.WaitForInfrastructure,
+			SkipIf:       !b.Shoot.HasManagedInfrastructure(),
+			Dependencies: flow.NewTaskIDs(deployInfrastructure),
+		})
+		deployControlPlane = g.Add(flow.Task{
+			Name:         "Deploying shoot control plane components",
+			Fn:           b.DeployControlPlane,
+			Dependencies: flow.NewTaskIDs(waitUntilExtensionControllersReady, waitUntilInfrastructureReady),
+		})
+		waitUntilControlPlaneReady = g.Add(flow.Task{
+			Name:         "Waiting until shoot control plane has been reconciled",
+			Fn:           b.Shoot.Components.Extensions.ControlPlane.Wait,
+			Dependencies: flow.NewTaskIDs(deployControlPlane),
+		})
+		deployEtcdDruid = g.Add(flow.Task{
+			Name:          "Deploying ETCD Druid",
+			Fn:            b.DeployEtcdDruid,
+			InputChecksum: targetVersionsChecksum,
+			Dependencies:  flow.NewTaskIDs(waitUntilGardenerResourceManagerReady),
+		})
+		deployEtcds = g.Add(flow.Task{
+			Name:          "Deploying main and events ETCDs",
+			Fn:            b.DeployEtcd,
+			InputChecksum: targetVersionsChecksum,
+			Dependencies:  flow.NewTaskIDs(deployEtcdDruid),
+		})
+		waitUntilEtcdsReady = g.Add(flow.Task{
+			Name:         "Waiting until main and event ETCDs have been reconciled",
+			Fn:           b.WaitUntilEtcdsReconciled,
+			Dependencies: flow.NewTaskIDs(deployEtcds),
+		})
+		deployControlPlaneDeployments = g.Add(flow.Task{
+			Name:         "Deploying control plane components as Deployments/StatefulSets and updating gardener-node-agent Secret",
+			Fn:           b.DeployControlPlaneDeployments,
+			Dependencies: flow.NewTaskIDs(waitUntilControlPlaneReady, waitUntilEtcdsReady),
+		})
+		rollOutOperatingSystemConfig = g.Add(flow.Task{
+			Name:         "Rolling out operating system config to control plane nodes one after another",
+			Fn:           b.RollOutOperatingSystemConfigToControlPlaneNodes,
+			Dependencies: flow.NewTaskIDs(deployControlPlaneDeployments),
+		})
+		finishOperatingSystemConfigRollout = g.Add(flow.Task{
+			Name:         "Handing rollout of operating system config of control plane nodes back to gardener-resource-manager",
+			Fn:           b.FinishOperatingSystemConfigRolloutToControlPlaneNodes,
+			Dependencies: flow.NewTaskIDs(rollOutOperatingSystemConfig),
+		})
+		waitUntilControlPlaneDeploymentsReady = g.Add(flow.Task{
+			Name:         "Waiting until control plane components (static pods) are ready",
+			Fn:           b.WaitUntilControlPlaneDeploymentsReady,
+			Dependencies: flow.NewTaskIDs(finishOperatingSystemConfigRollout),
+		})
+		_ = g.Add(flow.Task{
+			Name:          "Deploying shoot system resources",
+			Fn:            b.DeployShootSystem,
+			InputChecksum: targetVersionsChecksum,
+			Dependencies:  flow.NewTaskIDs(waitUntilControlPlaneDeploymentsReady),
+		})
+		_ = g.Add(flow.Task{
+			Name:          "Deploying kube-proxy system component",
+			Fn:            b.DeployKubeProxy,
+			SkipIf:        !kubeProxyEnabled,
+			InputChecksum: targetVersionsChecksum,
+			Dependencies:  flow.NewTaskIDs(waitUntilControlPlaneDeploymentsReady),
+		})
+		deployNetwork = g.Add(flow.Task{
+			Name:          "Deploying shoot network plugin",
+			Fn:            b.DeployNetwork,
+			InputChecksum: targetVersionsChecksum,
+			Dependencies:  flow.NewTaskIDs(waitUntilControlPlaneDeploymentsReady),
+		})
+		waitUntilNetworkReady = g.Add(flow.Task{
+			Name:          "Waiting until shoot network plugin has been reconciled",
+			Fn:            b.Shoot.Components.Extensions.Network.Wait,
+			InputChecksum: targetVersionsChecksum,
+			Dependencies:  flow.NewTaskIDs(deployNetwork),
+		})
+		deployCoreDNS = g.Add(flow.Task{
+			Name:          "Deploying CoreDNS system component",
+			Fn:            b.DeployCoreDNS,
+			InputChecksum: targetVersionsChecksum,
+			Dependencies:  flow.NewTaskIDs(waitUntilNetworkReady),
+		})
+		_ = g.Add(flow.Task{
+			Name:          "Waiting until CoreDNS system component is ready",
+			Fn:            b.Shoot.Components.SystemComponents.CoreDNS.Wait,
+			InputChecksum: targetVersionsChecksum,
+			Dependencies:  flow.NewTaskIDs(deployCoreDNS),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Waiting until gardener-node-agent lease is renewed",
+			Fn:           b.WaitUntilGardenerNodeAgentLeaseIsRenewed,
+			Dependencies: flow.NewTaskIDs(waitUntilControlPlaneDeploymentsReady),
+		})
+	)
+
+	if err := g.Compile().Run(ctx, flow.Opts{
+		Log:        opts.Log,
+		StateStore: b.UpgradeStateStore(),
+	}); err != nil {
+		return flow.Errors(err)
+	}
+
+	return nil
+}
+
+func confirm(opts *Options) (bool, error) {
+	fmt.Fprintf(opts.Out, "\nThe control plane nodes will be upgraded one after another.\nAre you sure you want to proceed? [y/N]: ")
+
+	answer, err := bufio.NewReader(opts.In).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("failed reading confirmation: %w", err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
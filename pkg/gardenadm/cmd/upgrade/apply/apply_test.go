@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apply_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/apply"
+	upgradeutils "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/utils"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/test"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
+)
+
+var _ = Describe("Apply", func() {
+	var (
+		ctx = context.Background()
+
+		globalOpts *cmd.Options
+		stdIn      *Buffer
+		stdOut     *Buffer
+		command    *cobra.Command
+
+		fakeClient client.Client
+		b          *botanist.GardenadmBotanist
+
+		node *corev1.Node
+
+		flowRun    bool
+		flowErr    error
+		flowTarget string
+	)
+
+	BeforeEach(func() {
+		globalOpts = &cmd.Options{Log: logr.Discard()}
+		globalOpts.IOStreams, stdIn, stdOut, _ = clitest.NewTestIOStreams()
+		command = NewCommand(globalOpts)
+		command.SetContext(ctx)
+		Expect(command.Flags().Set("config-dir", "some-path-to-config-dir")).To(Succeed())
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		b = &botanist.GardenadmBotanist{
+			Botanist: &botanistpkg.Botanist{
+				Operation: &operation.Operation{
+					Logger:        logr.Discard(),
+					SeedClientSet: fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+					Shoot:         &shootpkg.Shoot{KubernetesVersion: semver.MustParse("1.33.0")},
+				},
+			},
+			FS: afero.Afero{Fs: afero.NewMemMapFs()},
+		}
+		b.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{
+						{Name: "control-plane", ControlPlane: &gardencorev1beta1.WorkerControlPlane{}},
+						{Name: "worker"},
+					},
+				},
+			},
+		})
+
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-0", Labels: map[string]string{"node-role.kubernetes.io/control-plane": ""}},
+			Status: corev1.NodeStatus{
+				NodeInfo:   corev1.NodeSystemInfo{KubeletVersion: "v1.32.3"},
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+			},
+		}
+		Expect(fakeClient.Create(ctx, node)).To(Succeed())
+		Expect(fakeClient.Create(ctx, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kube-apiserver-node-0",
+				Namespace: "kube-system",
+				Labels:    map[string]string{staticpod.LabelKeyIsStaticPod: staticpod.LabelValueIsStaticPod},
+			},
+			Spec: corev1.PodSpec{
+				NodeName:   "node-0",
+				Containers: []corev1.Container{{Name: "kube-apiserver", Image: "registry.local/kube-apiserver:v1.32.3"}},
+			},
+			Status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+		})).To(Succeed())
+
+		flowRun, flowErr, flowTarget = false, nil, ""
+
+		DeferCleanup(test.WithVars(
+			&upgradeutils.NewBotanist, func(context.Context, logr.Logger, string) (*botanist.GardenadmBotanist, error) {
+				return b, nil
+			},
+			&upgradeutils.TargetGardenerVersion, func() string { return "v1.121.0" },
+			&RunFlow, func(_ context.Context, _ *Options, _ *botanist.GardenadmBotanist, plan *botanist.UpgradePlan) error {
+				flowRun, flowTarget = true, plan.TargetKubernetesVersion
+				return flowErr
+			},
+		))
+	})
+
+	Describe("#RunE", func() {
+		It("should abort if the upgrade is not confirmed", func() {
+			_, err := stdIn.Write([]byte("n\n"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say(`Are you sure you want to proceed\? \[y/N\]: Aborted upgrading the cluster.`))
+			Expect(flowRun).To(BeFalse())
+		})
+
+		It("should run the upgrade after confirmation", func() {
+			_, err := stdIn.Write([]byte("y\n"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Expect(flowRun).To(BeTrue())
+			Expect(flowTarget).To(Equal("v1.33.0"))
+			Expect(b.StagedOperatingSystemConfigRolloutWorkerPools).To(Equal(sets.New("control-plane")))
+			Expect(features.DefaultFeatureGate.Enabled(features.StagedOperatingSystemConfigRollout)).To(BeFalse())
+			Eventually(stdOut).Should(Say("Your self-hosted shoot cluster has successfully been upgraded to Kubernetes version v1.33.0"))
+		})
+
+		It("should return the error of the flow", func() {
+			Expect(command.Flags().Set("force", "true")).To(Succeed())
+			flowErr = fmt.Errorf("fake")
+
+			Expect(command.RunE(command, nil)).To(MatchError("fake"))
+		})
+
+		It("should do nothing if all components are up to date", func() {
+			b.Shoot.KubernetesVersion = semver.MustParse("1.32.3")
+			DeferCleanup(test.WithVar(&upgradeutils.TargetGardenerVersion, func() string { return "" }))
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Expect(flowRun).To(BeFalse())
+			Eventually(stdOut).Should(Say("All components are up to date, there is nothing to upgrade."))
+		})
+
+		It("should resume an interrupted upgrade even if all components are up to date", func() {
+			Expect(command.Flags().Set("force", "true")).To(Succeed())
+			b.Shoot.KubernetesVersion = semver.MustParse("1.32.3")
+			DeferCleanup(test.WithVar(&upgradeutils.TargetGardenerVersion, func() string { return "" }))
+			Expect(b.UpgradeStateStore().Store(ctx, botanist.UpgradeFlowName, flow.TaskState{"some-task": "some-checksum"})).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Expect(flowRun).To(BeTrue())
+			Eventually(stdOut).Should(Say("A previous upgrade has been interrupted, it will be resumed."))
+		})
+
+		It("should fail if the upgrade violates the version skew", func() {
+			b.Shoot.KubernetesVersion = semver.MustParse("1.34.0")
+
+			Expect(command.RunE(command, nil)).To(MatchError(ContainSubstring("upgrade violates the supported version skew")))
+			Expect(flowRun).To(BeFalse())
+		})
+
+		It("should fail if pre-flight checks fail", func() {
+			node.Spec.Unschedulable = true
+			Expect(fakeClient.Update(ctx, node)).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(MatchError("1 pre-flight check(s) failed"))
+			Expect(flowRun).To(BeFalse())
+		})
+
+		It("should continue if failed pre-flight checks should be ignored", func() {
+			Expect(command.Flags().Set("force", "true")).To(Succeed())
+			Expect(command.Flags().Set("ignore-preflight-errors", "true")).To(Succeed())
+			node.Spec.Unschedulable = true
+			Expect(fakeClient.Update(ctx, node)).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Expect(flowRun).To(BeTrue())
+			Eventually(stdOut).Should(Say("Ignoring the failed pre-flight checks as requested."))
+		})
+	})
+})
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	upgradeutils "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/utils"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+	cmd.ManifestOptions
+
+	// Force skips the confirmation prompt.
+	Force bool
+	// IgnorePreflightErrors reports failed pre-flight checks as warnings instead of aborting the upgrade.
+	IgnorePreflightErrors bool
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(args []string) error {
+	return o.ManifestOptions.ParseArgs(args)
+}
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	// `gardenadm init` stores the path of the config directory on the machine's file system. Hence, we can default it
+	// if the user does not explicitly provide us with the config directory.
+	if err := upgradeutils.DefaultConfigDir(&o.ManifestOptions); err != nil {
+		return err
+	}
+
+	return o.ManifestOptions.Validate()
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error {
+	return o.ManifestOptions.Complete()
+}
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	o.ManifestOptions.AddFlags(fs)
+	fs.BoolVarP(&o.Force, "force", "f", false, "Apply the upgrade without prompting for confirmation")
+	fs.BoolVar(&o.IgnorePreflightErrors, "ignore-preflight-errors", false, "Report failed pre-flight checks as warnings instead of aborting the upgrade (e.g., to resume an upgrade which left a node unhealthy)")
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apply_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/apply"
+	upgradeutils "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/utils"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Options", func() {
+	var (
+		options *Options
+	)
+
+	BeforeEach(func() {
+		options = &Options{ManifestOptions: cmd.ManifestOptions{ConfigDir: "some-path-to-config-dir"}}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should return nil", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should succeed when proper values were provided", func() {
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should default the config directory to the one used by 'gardenadm init'", func() {
+			options.ConfigDir = ""
+			DeferCleanup(test.WithVar(&upgradeutils.ReadFile, func(name string) ([]byte, error) {
+				Expect(name).To(Equal(cmd.ConfigDirLocation))
+				return []byte("/path/from/init"), nil
+			}))
+
+			Expect(options.Validate()).To(Succeed())
+			Expect(options.ConfigDir).To(Equal("/path/from/init"))
+		})
+
+		It("should fail when the config directory cannot be defaulted", func() {
+			options.ConfigDir = ""
+			DeferCleanup(test.WithVar(&upgradeutils.ReadFile, func(string) ([]byte, error) {
+				return nil, fmt.Errorf("fake")
+			}))
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("error reading config dir location file")))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should return nil", func() {
+			Expect(options.Complete()).To(Succeed())
+		})
+	})
+})
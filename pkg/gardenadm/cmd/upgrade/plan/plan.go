@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	upgradeutils "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/utils"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Show the versions the self-hosted shoot cluster would be upgraded to and check whether the upgrade is possible",
+		Long: `Show the versions the self-hosted shoot cluster would be upgraded to and check whether the upgrade is possible.
+
+The current versions of the static control plane pods, the kubelets, gardener-node-agent, and gardenlet (if the cluster
+is connected to Gardener) are compared with the Kubernetes version of the Shoot manifest and the version of gardenadm.
+The command fails if the upgrade violates the supported version skew or if the pre-flight checks fail. Nothing is
+changed in the cluster.`,
+
+		Example: `# Show the upgrade plan using the config directory of 'gardenadm init'
+gardenadm upgrade plan
+
+# Show the upgrade plan using the manifests in the given config directory
+gardenadm upgrade plan --config-dir /path/to/manifests`,
+
+		Args: cobra.NoArgs,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	b, err := upgradeutils.NewBotanist(ctx, opts.Log, opts.ConfigDir)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	plan, err := b.ComputeUpgradePlan(ctx, upgradeutils.TargetGardenerVersion())
+	if err != nil {
+		return fmt.Errorf("failed computing upgrade plan: %w", err)
+	}
+
+	if err := upgradeutils.PrintPlan(opts.Out, plan); err != nil {
+		return fmt.Errorf("failed printing upgrade plan: %w", err)
+	}
+
+	if err := upgradeutils.Verify(ctx, opts.Out, b, plan, false); err != nil {
+		return err
+	}
+
+	switch {
+	case plan.Interrupted:
+		fmt.Fprintf(opts.Out, `
+A previous upgrade has been interrupted. Resume it by running:
+
+  gardenadm upgrade apply
+`)
+	case plan.UpToDate():
+		fmt.Fprintf(opts.Out, `
+All components are up to date, there is nothing to upgrade.
+`)
+	default:
+		fmt.Fprintf(opts.Out, `
+You can now apply the upgrade by running:
+
+  gardenadm upgrade apply
+`)
+	}
+
+	return nil
+}
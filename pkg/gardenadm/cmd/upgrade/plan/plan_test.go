@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plan_test
+
+import (
+	"context"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/plan"
+	upgradeutils "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/utils"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/test"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
+)
+
+var _ = Describe("Plan", func() {
+	var (
+		ctx = context.Background()
+
+		globalOpts *cmd.Options
+		stdOut     *Buffer
+		command    *cobra.Command
+
+		fakeClient client.Client
+		b          *botanist.GardenadmBotanist
+
+		node *corev1.Node
+	)
+
+	BeforeEach(func() {
+		globalOpts = &cmd.Options{Log: logr.Discard()}
+		globalOpts.IOStreams, _, stdOut, _ = clitest.NewTestIOStreams()
+		command = NewCommand(globalOpts)
+		command.SetContext(ctx)
+		Expect(command.Flags().Set("config-dir", "some-path-to-config-dir")).To(Succeed())
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		b = &botanist.GardenadmBotanist{
+			Botanist: &botanistpkg.Botanist{
+				Operation: &operation.Operation{
+					Logger:        logr.Discard(),
+					SeedClientSet: fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+					Shoot:         &shootpkg.Shoot{KubernetesVersion: semver.MustParse("1.33.0")},
+				},
+			},
+			FS: afero.Afero{Fs: afero.NewMemMapFs()},
+		}
+		b.Shoot.SetInfo(&gardencorev1beta1.Shoot{})
+
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-0", Labels: map[string]string{"node-role.kubernetes.io/control-plane": ""}},
+			Status: corev1.NodeStatus{
+				NodeInfo:   corev1.NodeSystemInfo{KubeletVersion: "v1.32.3"},
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+			},
+		}
+		Expect(fakeClient.Create(ctx, node)).To(Succeed())
+		Expect(fakeClient.Create(ctx, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kube-apiserver-node-0",
+				Namespace: "kube-system",
+				Labels:    map[string]string{staticpod.LabelKeyIsStaticPod: staticpod.LabelValueIsStaticPod},
+			},
+			Spec: corev1.PodSpec{
+				NodeName:   "node-0",
+				Containers: []corev1.Container{{Name: "kube-apiserver", Image: "registry.local/kube-apiserver:v1.32.3"}},
+			},
+			Status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+		})).To(Succeed())
+
+		DeferCleanup(test.WithVars(
+			&upgradeutils.NewBotanist, func(_ context.Context, _ logr.Logger, configDir string) (*botanist.GardenadmBotanist, error) {
+				Expect(configDir).To(Equal("some-path-to-config-dir"))
+				return b, nil
+			},
+			&upgradeutils.TargetGardenerVersion, func() string { return "v1.121.0" },
+		))
+	})
+
+	Describe("#RunE", func() {
+		It("should print the plan and the next steps", func() {
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say(`Kubernetes version: v1.32.3 -> v1.33.0
+Gardener version:   <unknown> -> v1.121.0`))
+			Eventually(stdOut).Should(Say(`COMPONENT +NODE +CURRENT +TARGET +ACTION
+gardener-node-agent +- +<unknown> +v1.121.0 +upgrade
+kube-apiserver +node-0 +v1.32.3 +v1.33.0 +upgrade
+kubelet +node-0 +v1.32.3 +v1.33.0 +upgrade`))
+			Eventually(stdOut).Should(Say("All pre-flight checks passed."))
+			Eventually(stdOut).Should(Say("You can now apply the upgrade by running:"))
+		})
+
+		It("should tell that an interrupted upgrade can be resumed", func() {
+			Expect(b.UpgradeStateStore().Store(ctx, botanist.UpgradeFlowName, flow.TaskState{"some-task": "some-checksum"})).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say("A previous upgrade has been interrupted. Resume it by running:"))
+		})
+
+		It("should fail if the upgrade violates the version skew", func() {
+			b.Shoot.KubernetesVersion = semver.MustParse("1.34.0")
+
+			Expect(command.RunE(command, nil)).To(MatchError(ContainSubstring("upgrade violates the supported version skew")))
+		})
+
+		It("should fail if pre-flight checks fail", func() {
+			node.Spec.Unschedulable = true
+			Expect(fakeClient.Update(ctx, node)).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(MatchError("1 pre-flight check(s) failed"))
+
+			Eventually(stdOut).Should(Say(`The following pre-flight checks failed:
+  - control plane node node-0 is cordoned`))
+		})
+	})
+})
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/apply"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/plan"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade the control plane of a self-hosted shoot cluster to a newer Kubernetes or Gardener version",
+		Long: `Upgrade the control plane of a self-hosted shoot cluster to a newer Kubernetes or Gardener version.
+
+The target Kubernetes version is taken from the Shoot manifest in the config directory, the target Gardener version is
+the version of gardenadm. Hence, update the Shoot manifest and install the new gardenadm binary on a control plane node
+before running 'gardenadm upgrade plan' and 'gardenadm upgrade apply' on it.`,
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	cmd.AddCommand(plan.NewCommand(globalOpts))
+	cmd.AddCommand(apply.NewCommand(globalOpts))
+
+	return cmd
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/component-base/version"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+var (
+	// NewBotanist creates a new GardenadmBotanist for the running self-hosted shoot cluster based on the manifests in
+	// the given config directory.
+	// Exposed for testing.
+	NewBotanist = func(ctx context.Context, log logr.Logger, configDir string) (*botanist.GardenadmBotanist, error) {
+		b, err := botanist.NewGardenadmBotanistWithoutResources(log)
+		if err != nil {
+			return nil, fmt.Errorf("failed creating gardenadm botanist: %w", err)
+		}
+
+		clientSet, err := b.CreateClientSet(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed creating client set for self-hosted shoot: %w", err)
+		}
+
+		return botanist.NewGardenadmBotanistFromManifests(ctx, log, clientSet, configDir, true)
+	}
+	// TargetGardenerVersion returns the Gardener version to upgrade to, i.e., the version of gardenadm.
+	// Exposed for testing.
+	TargetGardenerVersion = func() string { return version.Get().GitVersion }
+	// ReadFile reads the file with the given name.
+	// Exposed for testing.
+	ReadFile = os.ReadFile
+)
+
+// DefaultConfigDir defaults the config directory to the one which was used by `gardenadm init` if it is not set.
+func DefaultConfigDir(o *cmd.ManifestOptions) error {
+	if len(o.ConfigDir) > 0 {
+		return nil
+	}
+
+	data, err := ReadFile(cmd.ConfigDirLocation)
+	if err != nil {
+		return fmt.Errorf("error reading config dir location file %s: %w", cmd.ConfigDirLocation, err)
+	}
+	o.ConfigDir = string(data)
+
+	return nil
+}
+
+// PrintPlan prints the given upgrade plan as a table.
+func PrintPlan(w io.Writer, plan *botanist.UpgradePlan) error {
+	fmt.Fprintf(w, "Kubernetes version: %s -> %s\n", plan.CurrentKubernetesVersion, plan.TargetKubernetesVersion)
+	fmt.Fprintf(w, "Gardener version:   %s -> %s\n\n", valueOrUnknown(plan.CurrentGardenerVersion), plan.TargetGardenerVersion)
+
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "COMPONENT", Type: "string", Description: "Name of the component"},
+			{Name: "NODE", Type: "string", Description: "Node the component is running on"},
+			{Name: "CURRENT", Type: "string", Description: "Current version of the component"},
+			{Name: "TARGET", Type: "string", Description: "Version of the component after the upgrade"},
+			{Name: "ACTION", Type: "string", Description: "Action performed for the component"},
+		},
+		Rows: make([]metav1.TableRow, 0, len(plan.Components)),
+	}
+
+	for _, component := range plan.Components {
+		node, target, action := component.Node, component.Target, "upgrade"
+		if node == "" {
+			node = "-"
+		}
+
+		switch {
+		case component.Target == "":
+			target, action = "-", "managed by Gardener"
+		case component.UpToDate():
+			action = "none"
+		}
+
+		table.Rows = append(table.Rows, metav1.TableRow{Cells: []any{component.Name, node, valueOrUnknown(component.Current), target, action}})
+	}
+
+	return printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(table, w)
+}
+
+// Verify checks that the given plan respects the supported version skew and runs the pre-flight checks. Failed
+// pre-flight checks are printed to the given writer. They only result in an error if they should not be ignored.
+func Verify(ctx context.Context, w io.Writer, b *botanist.GardenadmBotanist, plan *botanist.UpgradePlan, ignorePreflightErrors bool) error {
+	if err := plan.Validate(); err != nil {
+		return fmt.Errorf("upgrade violates the supported version skew: %w", err)
+	}
+
+	failedChecks, err := b.CheckUpgradePreconditions(ctx)
+	if err != nil {
+		return fmt.Errorf("failed running pre-flight checks: %w", err)
+	}
+
+	if len(failedChecks) == 0 {
+		fmt.Fprintf(w, "\nAll pre-flight checks passed.\n")
+		return nil
+	}
+
+	fmt.Fprintf(w, "\nThe following pre-flight checks failed:\n")
+	for _, err := range failedChecks {
+		fmt.Fprintf(w, "  - %s\n", err)
+	}
+
+	if ignorePreflightErrors {
+		fmt.Fprintf(w, "Ignoring the failed pre-flight checks as requested.\n")
+		return nil
+	}
+
+	return fmt.Errorf("%d pre-flight check(s) failed", len(failedChecks))
+}
+
+func valueOrUnknown(value string) string {
+	if value == "" {
+		return "<unknown>"
+	}
+	return value
+}
@@ -7,6 +7,7 @@ package v1alpha1
 import (
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"k8s.io/utils/ptr"
@@ -62,6 +63,10 @@ func SetDefaults_GardenletConfiguration(obj *GardenletConfiguration) {
 		obj.ETCDConfig = &ETCDConfig{}
 	}
 
+	if obj.ChartCache == nil {
+		obj.ChartCache = &ChartCacheConfiguration{}
+	}
+
 	SetDefaults_ExposureClassHandler(obj.ExposureClassHandlers)
 }
 
@@ -158,6 +163,9 @@ func SetDefaults_GardenletControllerConfiguration(obj *GardenletControllerConfig
 	if obj.ShootCare == nil {
 		obj.ShootCare = &ShootCareControllerConfiguration{}
 	}
+	if obj.ShootCertificates == nil {
+		obj.ShootCertificates = &ShootCertificatesControllerConfiguration{}
+	}
 	if obj.SeedCare == nil {
 		obj.SeedCare = &SeedCareControllerConfiguration{}
 	}
@@ -373,6 +381,19 @@ func SetDefaults_StaleExtensionHealthChecks(obj *StaleExtensionHealthChecks) {
 	}
 }
 
+// SetDefaults_ShootCertificatesControllerConfiguration sets defaults for the shoot certificates controller.
+func SetDefaults_ShootCertificatesControllerConfiguration(obj *ShootCertificatesControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.ExpirationThreshold == nil {
+		obj.ExpirationThreshold = &metav1.Duration{Duration: 30 * 24 * time.Hour}
+	}
+}
+
 // SetDefaults_ShootStateControllerConfiguration sets defaults for the shoot state controller.
 func SetDefaults_ShootStateControllerConfiguration(obj *ShootStateControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -536,3 +557,10 @@ func SetDefaults_BackupCompactionController(obj *BackupCompactionController) {
 		obj.MetricsScrapeWaitDuration = &metav1.Duration{Duration: 60 * time.Second}
 	}
 }
+
+// SetDefaults_ChartCacheConfiguration sets defaults for the cache of Helm charts pulled from OCI registries.
+func SetDefaults_ChartCacheConfiguration(obj *ChartCacheConfiguration) {
+	if obj.MaxSize == nil {
+		obj.MaxSize = ptr.To(resource.MustParse("128Mi"))
+	}
+}
@@ -44,6 +44,7 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.Seed).NotTo(BeNil())
 			Expect(obj.Controllers.Shoot).NotTo(BeNil())
 			Expect(obj.Controllers.ShootCare).NotTo(BeNil())
+			Expect(obj.Controllers.ShootCertificates).NotTo(BeNil())
 			Expect(obj.Controllers.SeedCare).NotTo(BeNil())
 			Expect(obj.Controllers.ShootState).NotTo(BeNil())
 			Expect(obj.Controllers.ManagedSeed).NotTo(BeNil())
@@ -53,6 +54,7 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.SNI).NotTo(BeNil())
 			Expect(obj.Monitoring).NotTo(BeNil())
 			Expect(obj.ETCDConfig).NotTo(BeNil())
+			Expect(obj.ChartCache).NotTo(BeNil())
 		})
 
 		It("should not overwrite already set values for the gardenlet configuration", func() {
@@ -439,6 +441,34 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootCertificatesControllerConfiguration defaulting", func() {
+		It("should default the shoot certificates controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCertificates.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.Controllers.ShootCertificates.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.Controllers.ShootCertificates.ExpirationThreshold).To(PointTo(Equal(metav1.Duration{Duration: 30 * 24 * time.Hour})))
+		})
+
+		It("should not overwrite already set values for the shoot certificates controller configuration", func() {
+			syncPeriod := metav1.Duration{Duration: 2 * time.Hour}
+			expirationThreshold := metav1.Duration{Duration: 7 * 24 * time.Hour}
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootCertificates: &ShootCertificatesControllerConfiguration{
+					ConcurrentSyncs:     ptr.To(10),
+					SyncPeriod:          &syncPeriod,
+					ExpirationThreshold: &expirationThreshold,
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCertificates.ConcurrentSyncs).To(PointTo(Equal(10)))
+			Expect(obj.Controllers.ShootCertificates.SyncPeriod).To(PointTo(Equal(syncPeriod)))
+			Expect(obj.Controllers.ShootCertificates.ExpirationThreshold).To(PointTo(Equal(expirationThreshold)))
+		})
+	})
+
 	Describe("ShootStateControllerConfiguration defaulting", func() {
 		It("should default the shoot state controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -774,6 +804,23 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ChartCacheConfiguration defaulting", func() {
+		It("should default the chart cache configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.ChartCache.MaxSize).To(PointTo(Equal(resource.MustParse("128Mi"))))
+			Expect(obj.ChartCache.Directory).To(BeNil())
+			Expect(obj.ChartCache.DirectoryMaxSize).To(BeNil())
+		})
+
+		It("should not overwrite already set values for the chart cache configuration", func() {
+			obj.ChartCache = &ChartCacheConfiguration{MaxSize: ptr.To(resource.MustParse("1Gi"))}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.ChartCache.MaxSize).To(PointTo(Equal(resource.MustParse("1Gi"))))
+		})
+	})
+
 	Describe("ExposureClassHandler defaulting", func() {
 		It("should default the gardenlets exposure class handlers sni config", func() {
 			obj.ExposureClassHandlers = []ExposureClassHandler{
@@ -6,6 +6,7 @@ package helper
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 )
@@ -64,6 +65,24 @@ func IsMonitoringEnabled(c *gardenletconfigv1alpha1.GardenletConfiguration) bool
 	return true
 }
 
+// GetCertificateKeyAlgorithm returns the configured algorithm of the private keys of server and client certificates.
+// It returns an empty string if it is not configured.
+func GetCertificateKeyAlgorithm(c *gardenletconfigv1alpha1.GardenletConfiguration) string {
+	if c != nil && c.Secrets != nil {
+		return ptr.Deref(c.Secrets.KeyAlgorithm, "")
+	}
+	return ""
+}
+
+// GetNodeAgentConfiguration returns the configuration for gardener-node-agent. It returns an empty configuration if it
+// is not configured.
+func GetNodeAgentConfiguration(c *gardenletconfigv1alpha1.GardenletConfiguration) *gardenletconfigv1alpha1.NodeAgentConfiguration {
+	if c != nil && c.NodeAgent != nil {
+		return c.NodeAgent
+	}
+	return &gardenletconfigv1alpha1.NodeAgentConfiguration{}
+}
+
 // GetManagedResourceProgressingThreshold returns ManagedResourceProgressingThreshold if set otherwise it returns nil.
 func GetManagedResourceProgressingThreshold(c *gardenletconfigv1alpha1.GardenletConfiguration) *metav1.Duration {
 	if c != nil && c.Controllers != nil && c.Controllers.ShootCare != nil && c.Controllers.ShootCare.ManagedResourceProgressingThreshold != nil {
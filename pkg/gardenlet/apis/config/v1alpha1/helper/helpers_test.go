@@ -15,6 +15,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1/helper"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 )
 
 var _ = Describe("helper", func() {
@@ -279,4 +280,37 @@ var _ = Describe("helper", func() {
 			Expect(GetManagedResourceProgressingThreshold(gardenletConfig)).To(Equal(threshold))
 		})
 	})
+
+	Describe("#GetCertificateKeyAlgorithm", func() {
+		It("should return an empty string when nothing is set", func() {
+			Expect(GetCertificateKeyAlgorithm(nil)).To(BeEmpty())
+			Expect(GetCertificateKeyAlgorithm(&gardenletconfigv1alpha1.GardenletConfiguration{})).To(BeEmpty())
+			Expect(GetCertificateKeyAlgorithm(&gardenletconfigv1alpha1.GardenletConfiguration{Secrets: &gardenletconfigv1alpha1.SecretsConfiguration{}})).To(BeEmpty())
+		})
+
+		It("should return the configured key algorithm", func() {
+			gardenletConfig := &gardenletconfigv1alpha1.GardenletConfiguration{
+				Secrets: &gardenletconfigv1alpha1.SecretsConfiguration{KeyAlgorithm: ptr.To("ECDSA-P256")},
+			}
+
+			Expect(GetCertificateKeyAlgorithm(gardenletConfig)).To(Equal("ECDSA-P256"))
+		})
+	})
+
+	Describe("#GetNodeAgentConfiguration", func() {
+		It("should return an empty configuration when nothing is set", func() {
+			Expect(GetNodeAgentConfiguration(nil)).To(Equal(&gardenletconfigv1alpha1.NodeAgentConfiguration{}))
+			Expect(GetNodeAgentConfiguration(&gardenletconfigv1alpha1.GardenletConfiguration{})).To(Equal(&gardenletconfigv1alpha1.NodeAgentConfiguration{}))
+		})
+
+		It("should return the configured node agent configuration", func() {
+			nodeAgentConfig := &gardenletconfigv1alpha1.NodeAgentConfiguration{
+				HealthCheck:    &nodeagentconfigv1alpha1.HealthCheckControllerConfig{SyncPeriod: &metav1.Duration{Duration: time.Minute}},
+				Rollback:       &nodeagentconfigv1alpha1.OperatingSystemConfigRollbackConfig{GracePeriod: &metav1.Duration{Duration: time.Minute}},
+				DriftDetection: &nodeagentconfigv1alpha1.DriftDetectionConfig{SyncPeriod: &metav1.Duration{Duration: time.Minute}},
+			}
+
+			Expect(GetNodeAgentConfiguration(&gardenletconfigv1alpha1.GardenletConfiguration{NodeAgent: nodeAgentConfig})).To(Equal(nodeAgentConfig))
+		})
+	})
 })
@@ -13,6 +13,7 @@ import (
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -85,6 +86,19 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// ChartCache contains configuration for the cache of Helm charts pulled from OCI registries.
+	// +optional
+	ChartCache *ChartCacheConfiguration `json:"chartCache,omitempty"`
+	// ChartVerification contains configuration for verifying the signatures of Helm charts pulled from OCI registries.
+	// +optional
+	ChartVerification *ChartVerificationConfiguration `json:"chartVerification,omitempty"`
+	// Secrets contains configuration for the secrets generated by the gardenlet.
+	// +optional
+	Secrets *SecretsConfiguration `json:"secrets,omitempty"`
+	// NodeAgent contains configuration for the gardener-node-agent instances running on the worker nodes of shoot
+	// clusters.
+	// +optional
+	NodeAgent *NodeAgentConfiguration `json:"nodeAgent,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -186,6 +200,9 @@ type GardenletControllerConfiguration struct {
 	// ShootCare defines the configuration of the ShootCare controller.
 	// +optional
 	ShootCare *ShootCareControllerConfiguration `json:"shootCare,omitempty"`
+	// ShootCertificates defines the configuration of the ShootCertificates controller.
+	// +optional
+	ShootCertificates *ShootCertificatesControllerConfiguration `json:"shootCertificates,omitempty"`
 	// ShootState defines the configuration of the ShootState controller.
 	// +optional
 	ShootState *ShootStateControllerConfiguration `json:"shootState,omitempty"`
@@ -364,6 +381,20 @@ type SeedCareControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
 }
 
+// ShootCertificatesControllerConfiguration defines the configuration of the ShootCertificates controller.
+type ShootCertificatesControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the certificate inventory of Shoot clusters is computed.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// ExpirationThreshold is the duration before the expiration of a certificate after which the certificate is
+	// reported as expiring if no rotation is scheduled for it.
+	// +optional
+	ExpirationThreshold *metav1.Duration `json:"expirationThreshold,omitempty"`
+}
+
 // ShootStateControllerConfiguration defines the configuration of the ShootState controller.
 type ShootStateControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on events.
@@ -752,3 +783,54 @@ type NodeToleration struct {
 	// +optional
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
+
+// ChartCacheConfiguration contains configuration for the cache of Helm charts pulled from OCI registries.
+type ChartCacheConfiguration struct {
+	// MaxSize is the maximum size of all Helm charts kept in memory. When exceeded, the least recently used charts are
+	// evicted. Defaults to 128Mi.
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+	// Directory is an optional directory in which the Helm charts are persisted, so that they do not need to be pulled
+	// again after a restart. Charts are stored by their digest and verified when they are read.
+	// +optional
+	Directory *string `json:"directory,omitempty"`
+	// DirectoryMaxSize is the maximum size of all Helm charts persisted in the directory. When exceeded, the least
+	// recently used charts are removed. If not set, the size of the directory is not limited.
+	// +optional
+	DirectoryMaxSize *resource.Quantity `json:"directoryMaxSize,omitempty"`
+}
+
+// ChartVerificationConfiguration contains configuration for verifying the signatures of Helm charts pulled from OCI
+// registries with the configured public keys.
+type ChartVerificationConfiguration struct {
+	// PublicKeys is a list of PEM-encoded public keys (ECDSA, RSA or Ed25519). Helm charts pulled from OCI registries
+	// must have a cosign signature created with one of the corresponding private keys, otherwise they are rejected.
+	// At least one public key is required.
+	PublicKeys []string `json:"publicKeys"`
+}
+
+// SecretsConfiguration contains configuration for the secrets generated by the gardenlet.
+type SecretsConfiguration struct {
+	// KeyAlgorithm is the algorithm of the private keys of newly generated server and client certificates in the seed
+	// cluster and the control planes of shoot clusters. Possible values are `RSA`, `ECDSA-P256` and `ECDSA-P384`.
+	// Changing the algorithm results in new certificates, CA certificates are not affected. Defaults to `RSA`.
+	// +optional
+	KeyAlgorithm *string `json:"keyAlgorithm,omitempty"`
+}
+
+// NodeAgentConfiguration contains configuration for the gardener-node-agent instances running on the worker nodes of
+// shoot clusters. It is rendered into the component configuration of gardener-node-agent for all shoots of the seed.
+type NodeAgentConfiguration struct {
+	// HealthCheck is the configuration for the health check controller of gardener-node-agent. If not set, only the
+	// built-in health checks for containerd and the kubelet are executed.
+	// +optional
+	HealthCheck *nodeagentconfigv1alpha1.HealthCheckControllerConfig `json:"healthCheck,omitempty"`
+	// Rollback is the configuration for automatically rolling back to the last applied operating system config if the
+	// units restarted while applying a new one do not become healthy. If not set, no rollback is performed.
+	// +optional
+	Rollback *nodeagentconfigv1alpha1.OperatingSystemConfigRollbackConfig `json:"rollback,omitempty"`
+	// DriftDetection is the configuration for periodically detecting modifications of the files and units managed by
+	// gardener-node-agent on the host. If not set, no drift detection is performed.
+	// +optional
+	DriftDetection *nodeagentconfigv1alpha1.DriftDetectionConfig `json:"driftDetection,omitempty"`
+}
@@ -5,8 +5,14 @@
 package validation
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"net"
+	"path/filepath"
 	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -21,6 +27,9 @@ import (
 	gardencorevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	nodeagentvalidation "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1/validation"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
@@ -56,6 +65,9 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		if cfg.Controllers.ShootCare != nil {
 			allErrs = append(allErrs, validateShootCareControllerConfiguration(cfg.Controllers.ShootCare, fldPath.Child("controllers", "shootCare"))...)
 		}
+		if cfg.Controllers.ShootCertificates != nil {
+			allErrs = append(allErrs, validateShootCertificatesControllerConfiguration(cfg.Controllers.ShootCertificates, fldPath.Child("controllers", "shootCertificates"))...)
+		}
 		if cfg.Controllers.ManagedSeed != nil {
 			allErrs = append(allErrs, validateManagedSeedControllerConfiguration(cfg.Controllers.ManagedSeed, fldPath.Child("controllers", "managedSeed"))...)
 		}
@@ -116,6 +128,37 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(ptr.Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	allErrs = append(allErrs, ValidateChartCacheConfiguration(cfg.ChartCache, fldPath.Child("chartCache"))...)
+	allErrs = append(allErrs, ValidateChartVerificationConfiguration(cfg.ChartVerification, fldPath.Child("chartVerification"))...)
+	allErrs = append(allErrs, ValidateSecretsConfiguration(cfg.Secrets, fldPath.Child("secrets"))...)
+	allErrs = append(allErrs, ValidateNodeAgentConfiguration(cfg.NodeAgent, fldPath.Child("nodeAgent"))...)
+
+	return allErrs
+}
+
+// ValidateChartCacheConfiguration validates the configuration of the cache for Helm charts pulled from OCI registries.
+func ValidateChartCacheConfiguration(cfg *gardenletconfigv1alpha1.ChartCacheConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg == nil {
+		return allErrs
+	}
+
+	if cfg.MaxSize != nil {
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateNonnegativeQuantity(*cfg.MaxSize, fldPath.Child("maxSize"))...)
+	}
+
+	if cfg.Directory != nil && !filepath.IsAbs(*cfg.Directory) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("directory"), *cfg.Directory, "must be an absolute path"))
+	}
+
+	if cfg.DirectoryMaxSize != nil {
+		if cfg.Directory == nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("directoryMaxSize"), "can only be set if directory is set"))
+		}
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateNonnegativeQuantity(*cfg.DirectoryMaxSize, fldPath.Child("directoryMaxSize"))...)
+	}
+
 	return allErrs
 }
 
@@ -252,6 +295,22 @@ func validateShootCareControllerConfiguration(cfg *gardenletconfigv1alpha1.Shoot
 	return allErrs
 }
 
+func validateShootCertificatesControllerConfiguration(cfg *gardenletconfigv1alpha1.ShootCertificatesControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.ConcurrentSyncs != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cfg.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	}
+	if cfg.SyncPeriod != nil && cfg.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), cfg.SyncPeriod.Duration.String(), "must be positive"))
+	}
+	if cfg.ExpirationThreshold != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.ExpirationThreshold.Duration), fldPath.Child("expirationThreshold"))...)
+	}
+
+	return allErrs
+}
+
 func validateManagedSeedControllerConfiguration(cfg *gardenletconfigv1alpha1.ManagedSeedControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -343,3 +402,99 @@ func validateExposureClassHandlers(handlers []gardenletconfigv1alpha1.ExposureCl
 
 	return allErrs
 }
+
+// ValidateChartVerificationConfiguration validates the configuration for verifying the signatures of Helm charts pulled
+// from OCI registries.
+func ValidateChartVerificationConfiguration(cfg *gardenletconfigv1alpha1.ChartVerificationConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg == nil {
+		return allErrs
+	}
+
+	if len(cfg.PublicKeys) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("publicKeys"), "at least one public key is required, keyless verification is not supported"))
+	}
+
+	for i, publicKey := range cfg.PublicKeys {
+		idxPath := fldPath.Child("publicKeys").Index(i)
+
+		block, _ := pem.Decode([]byte(publicKey))
+		if block == nil {
+			allErrs = append(allErrs, field.Invalid(idxPath, publicKey, "must be a PEM-encoded public key"))
+			continue
+		}
+
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath, publicKey, fmt.Sprintf("failed parsing public key: %v", err)))
+			continue
+		}
+
+		switch key.(type) {
+		case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		default:
+			allErrs = append(allErrs, field.Invalid(idxPath, publicKey, fmt.Sprintf("unsupported public key type %T, only ECDSA, RSA and Ed25519 keys are supported", key)))
+		}
+	}
+
+	return allErrs
+}
+
+var supportedKeyAlgorithms = sets.New(
+	string(secretsutils.KeyAlgorithmRSA),
+	string(secretsutils.KeyAlgorithmECDSAP256),
+	string(secretsutils.KeyAlgorithmECDSAP384),
+)
+
+// ValidateSecretsConfiguration validates the configuration for the secrets generated by the gardenlet.
+func ValidateSecretsConfiguration(cfg *gardenletconfigv1alpha1.SecretsConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg == nil {
+		return allErrs
+	}
+
+	if cfg.KeyAlgorithm != nil && !supportedKeyAlgorithms.Has(*cfg.KeyAlgorithm) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("keyAlgorithm"), *cfg.KeyAlgorithm, sets.List(supportedKeyAlgorithms)))
+	}
+
+	return allErrs
+}
+
+// ValidateNodeAgentConfiguration validates the configuration for the gardener-node-agent instances running on the
+// worker nodes of shoot clusters.
+func ValidateNodeAgentConfiguration(cfg *gardenletconfigv1alpha1.NodeAgentConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg == nil {
+		return allErrs
+	}
+
+	// gardener-node-agent defaults its configuration when loading it, hence the configuration is validated with its
+	// defaults applied.
+	nodeAgentConfig := &nodeagentconfigv1alpha1.NodeAgentConfiguration{
+		Controllers: nodeagentconfigv1alpha1.ControllerConfiguration{
+			OperatingSystemConfig: nodeagentconfigv1alpha1.OperatingSystemConfigControllerConfig{
+				Rollback:       cfg.Rollback.DeepCopy(),
+				DriftDetection: cfg.DriftDetection.DeepCopy(),
+			},
+			HealthCheck: cfg.HealthCheck.DeepCopy(),
+		},
+	}
+	nodeagentconfigv1alpha1.SetObjectDefaults_NodeAgentConfiguration(nodeAgentConfig)
+
+	if healthCheck := nodeAgentConfig.Controllers.HealthCheck; healthCheck != nil {
+		allErrs = append(allErrs, nodeagentvalidation.ValidateHealthCheckControllerConfiguration(*healthCheck, fldPath.Child("healthCheck"))...)
+	}
+
+	if rollback := nodeAgentConfig.Controllers.OperatingSystemConfig.Rollback; rollback != nil {
+		allErrs = append(allErrs, nodeagentvalidation.ValidateOperatingSystemConfigRollbackConfiguration(*rollback, fldPath.Child("rollback"))...)
+	}
+
+	if driftDetection := nodeAgentConfig.Controllers.OperatingSystemConfig.DriftDetection; driftDetection != nil {
+		allErrs = append(allErrs, nodeagentvalidation.ValidateDriftDetectionConfiguration(*driftDetection, fldPath.Child("driftDetection"))...)
+	}
+
+	return allErrs
+}
@@ -20,6 +20,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1/validation"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 )
 
 var _ = Describe("GardenletConfiguration", func() {
@@ -54,6 +55,11 @@ var _ = Describe("GardenletConfiguration", func() {
 					ManagedResourceProgressingThreshold: &metav1.Duration{Duration: time.Hour},
 					ConditionThresholds:                 []gardenletconfigv1alpha1.ConditionThreshold{{Duration: metav1.Duration{Duration: time.Hour}}},
 				},
+				ShootCertificates: &gardenletconfigv1alpha1.ShootCertificatesControllerConfiguration{
+					ConcurrentSyncs:     &concurrentSyncs,
+					SyncPeriod:          &metav1.Duration{Duration: time.Hour},
+					ExpirationThreshold: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+				},
 				ManagedSeed: &gardenletconfigv1alpha1.ManagedSeedControllerConfiguration{
 					ConcurrentSyncs:  &concurrentSyncs,
 					SyncPeriod:       &metav1.Duration{Duration: 1 * time.Hour},
@@ -384,6 +390,43 @@ var _ = Describe("GardenletConfiguration", func() {
 			})
 		})
 
+		Context("shootCertificates controller", func() {
+			It("should forbid invalid configuration", func() {
+				cfg.Controllers.ShootCertificates.ConcurrentSyncs = ptr.To(-1)
+				cfg.Controllers.ShootCertificates.SyncPeriod = &metav1.Duration{Duration: -1}
+				cfg.Controllers.ShootCertificates.ExpirationThreshold = &metav1.Duration{Duration: -1}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCertificates.concurrentSyncs"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCertificates.syncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCertificates.expirationThreshold"),
+					})),
+				))
+			})
+
+			It("should forbid a zero sync period", func() {
+				cfg.Controllers.ShootCertificates.SyncPeriod = &metav1.Duration{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("controllers.shootCertificates.syncPeriod"),
+						"Detail": Equal("must be positive"),
+					})),
+				))
+			})
+		})
+
 		Context("managed seed controller", func() {
 			It("should forbid invalid configuration", func() {
 				invalidConcurrentSyncs := -1
@@ -757,6 +800,216 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("chartCache", func() {
+			It("should pass with valid chart cache options", func() {
+				cfg.ChartCache = &gardenletconfigv1alpha1.ChartCacheConfiguration{
+					MaxSize:          ptr.To(resource.MustParse("128Mi")),
+					Directory:        ptr.To("/var/cache/charts"),
+					DirectoryMaxSize: ptr.To(resource.MustParse("1Gi")),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should fail with invalid chart cache options", func() {
+				cfg.ChartCache = &gardenletconfigv1alpha1.ChartCacheConfiguration{
+					MaxSize:          ptr.To(resource.MustParse("-1")),
+					Directory:        ptr.To("charts"),
+					DirectoryMaxSize: ptr.To(resource.MustParse("-1")),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("chartCache.maxSize"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("chartCache.directory"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("chartCache.directoryMaxSize"),
+					})),
+				))
+			})
+
+			It("should forbid setting the directory size without a directory", func() {
+				cfg.ChartCache = &gardenletconfigv1alpha1.ChartCacheConfiguration{
+					DirectoryMaxSize: ptr.To(resource.MustParse("1Gi")),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("chartCache.directoryMaxSize"),
+					})),
+				))
+			})
+		})
+
+		Context("chartVerification", func() {
+			It("should pass with valid public keys", func() {
+				cfg.ChartVerification = &gardenletconfigv1alpha1.ChartVerificationConfiguration{
+					PublicKeys: []string{`-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEUc6AAxaVvgvZUnTz3tJd+ynLf8Tx
+NLz4bj3UuLQE2CfUVOv4iaz1r14EotYZE9ogpglrWpzCP4YqR16II0OnjA==
+-----END PUBLIC KEY-----
+`},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should fail without public keys", func() {
+				cfg.ChartVerification = &gardenletconfigv1alpha1.ChartVerificationConfiguration{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("chartVerification.publicKeys"),
+					})),
+				))
+			})
+
+			It("should fail with invalid public keys", func() {
+				cfg.ChartVerification = &gardenletconfigv1alpha1.ChartVerificationConfiguration{
+					PublicKeys: []string{
+						"foo",
+						"-----BEGIN PUBLIC KEY-----\nZm9v\n-----END PUBLIC KEY-----\n",
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("chartVerification.publicKeys[0]"),
+						"Detail": Equal("must be a PEM-encoded public key"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("chartVerification.publicKeys[1]"),
+						"Detail": ContainSubstring("failed parsing public key"),
+					})),
+				))
+			})
+		})
+
+		Context("secrets", func() {
+			It("should pass with a supported key algorithm", func() {
+				cfg.Secrets = &gardenletconfigv1alpha1.SecretsConfiguration{KeyAlgorithm: ptr.To("ECDSA-P256")}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid unsupported key algorithms", func() {
+				cfg.Secrets = &gardenletconfigv1alpha1.SecretsConfiguration{KeyAlgorithm: ptr.To("Ed25519")}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("secrets.keyAlgorithm"),
+					})),
+				))
+			})
+		})
+
+		Context("nodeAgent", func() {
+			It("should pass with a valid health check configuration", func() {
+				cfg.NodeAgent = &gardenletconfigv1alpha1.NodeAgentConfiguration{
+					HealthCheck: &nodeagentconfigv1alpha1.HealthCheckControllerConfig{
+						HealthCheckers: []nodeagentconfigv1alpha1.HealthCheckerConfig{{
+							Name: "disk-pressure",
+							Type: nodeagentconfigv1alpha1.HealthCheckerTypeDiskPressure,
+						}},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid invalid health check configurations", func() {
+				cfg.NodeAgent = &gardenletconfigv1alpha1.NodeAgentConfiguration{
+					HealthCheck: &nodeagentconfigv1alpha1.HealthCheckControllerConfig{
+						SyncPeriod: &metav1.Duration{Duration: time.Second},
+						HealthCheckers: []nodeagentconfigv1alpha1.HealthCheckerConfig{{
+							Name: "foo",
+							Type: "bar",
+						}},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeAgent.healthCheck.syncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("nodeAgent.healthCheck.healthCheckers[0].type"),
+					})),
+				))
+			})
+
+			It("should pass with a valid rollback configuration", func() {
+				cfg.NodeAgent = &gardenletconfigv1alpha1.NodeAgentConfiguration{
+					Rollback: &nodeagentconfigv1alpha1.OperatingSystemConfigRollbackConfig{},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid too short rollback grace periods", func() {
+				cfg.NodeAgent = &gardenletconfigv1alpha1.NodeAgentConfiguration{
+					Rollback: &nodeagentconfigv1alpha1.OperatingSystemConfigRollbackConfig{
+						GracePeriod: &metav1.Duration{Duration: time.Second},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeAgent.rollback.gracePeriod"),
+					})),
+				))
+			})
+
+			It("should pass with a valid drift detection configuration", func() {
+				cfg.NodeAgent = &gardenletconfigv1alpha1.NodeAgentConfiguration{
+					DriftDetection: &nodeagentconfigv1alpha1.DriftDetectionConfig{
+						Policies: []nodeagentconfigv1alpha1.DriftPolicyRule{{
+							Paths:  []string{"/etc/systemd/system/*.service"},
+							Policy: nodeagentconfigv1alpha1.DriftPolicyRemediate,
+						}},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid invalid drift detection configurations", func() {
+				cfg.NodeAgent = &gardenletconfigv1alpha1.NodeAgentConfiguration{
+					DriftDetection: &nodeagentconfigv1alpha1.DriftDetectionConfig{
+						Policies: []nodeagentconfigv1alpha1.DriftPolicyRule{{
+							Paths:  []string{"etc/foo"},
+							Policy: "Foo",
+						}},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("nodeAgent.driftDetection.policies[0].policy"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeAgent.driftDetection.policies[0].paths[0]"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
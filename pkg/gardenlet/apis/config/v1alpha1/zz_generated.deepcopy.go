@@ -11,6 +11,7 @@ package v1alpha1
 
 import (
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	apisconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -131,6 +132,58 @@ func (in *BastionControllerConfiguration) DeepCopy() *BastionControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChartCacheConfiguration) DeepCopyInto(out *ChartCacheConfiguration) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Directory != nil {
+		in, out := &in.Directory, &out.Directory
+		*out = new(string)
+		**out = **in
+	}
+	if in.DirectoryMaxSize != nil {
+		in, out := &in.DirectoryMaxSize, &out.DirectoryMaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartCacheConfiguration.
+func (in *ChartCacheConfiguration) DeepCopy() *ChartCacheConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ChartCacheConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChartVerificationConfiguration) DeepCopyInto(out *ChartVerificationConfiguration) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartVerificationConfiguration.
+func (in *ChartVerificationConfiguration) DeepCopy() *ChartVerificationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ChartVerificationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionThreshold) DeepCopyInto(out *ConditionThreshold) {
 	*out = *in
@@ -501,6 +554,26 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ChartCache != nil {
+		in, out := &in.ChartCache, &out.ChartCache
+		*out = new(ChartCacheConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChartVerification != nil {
+		in, out := &in.ChartVerification, &out.ChartVerification
+		*out = new(ChartVerificationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = new(SecretsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeAgent != nil {
+		in, out := &in.NodeAgent, &out.NodeAgent
+		*out = new(NodeAgentConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -580,6 +653,11 @@ func (in *GardenletControllerConfiguration) DeepCopyInto(out *GardenletControlle
 		*out = new(ShootCareControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootCertificates != nil {
+		in, out := &in.ShootCertificates, &out.ShootCertificates
+		*out = new(ShootCertificatesControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ShootState != nil {
 		in, out := &in.ShootState, &out.ShootState
 		*out = new(ShootStateControllerConfiguration)
@@ -834,6 +912,37 @@ func (in *NetworkPolicyControllerConfiguration) DeepCopy() *NetworkPolicyControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeAgentConfiguration) DeepCopyInto(out *NodeAgentConfiguration) {
+	*out = *in
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(apisconfigv1alpha1.HealthCheckControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(apisconfigv1alpha1.OperatingSystemConfigRollbackConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(apisconfigv1alpha1.DriftDetectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAgentConfiguration.
+func (in *NodeAgentConfiguration) DeepCopy() *NodeAgentConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeAgentConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeToleration) DeepCopyInto(out *NodeToleration) {
 	*out = *in
@@ -970,6 +1079,27 @@ func (in *SNIIngress) DeepCopy() *SNIIngress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretsConfiguration) DeepCopyInto(out *SecretsConfiguration) {
+	*out = *in
+	if in.KeyAlgorithm != nil {
+		in, out := &in.KeyAlgorithm, &out.KeyAlgorithm
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsConfiguration.
+func (in *SecretsConfiguration) DeepCopy() *SecretsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedCareControllerConfiguration) DeepCopyInto(out *SeedCareControllerConfiguration) {
 	*out = *in
@@ -1149,6 +1279,37 @@ func (in *ShootCareControllerConfiguration) DeepCopy() *ShootCareControllerConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootCertificatesControllerConfiguration) DeepCopyInto(out *ShootCertificatesControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ExpirationThreshold != nil {
+		in, out := &in.ExpirationThreshold, &out.ExpirationThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCertificatesControllerConfiguration.
+func (in *ShootCertificatesControllerConfiguration) DeepCopy() *ShootCertificatesControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCertificatesControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootClientConnection) DeepCopyInto(out *ShootClientConnection) {
 	*out = *in
@@ -74,6 +74,9 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 				SetDefaults_StaleExtensionHealthChecks(in.Controllers.ShootCare.StaleExtensionHealthChecks)
 			}
 		}
+		if in.Controllers.ShootCertificates != nil {
+			SetDefaults_ShootCertificatesControllerConfiguration(in.Controllers.ShootCertificates)
+		}
 		if in.Controllers.ShootState != nil {
 			SetDefaults_ShootStateControllerConfiguration(in.Controllers.ShootState)
 		}
@@ -133,4 +136,7 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 			SetDefaults_ShootMonitoringConfig(in.Monitoring.Shoot)
 		}
 	}
+	if in.ChartCache != nil {
+		SetDefaults_ChartCacheConfiguration(in.ChartCache)
+	}
 }
@@ -7,6 +7,7 @@ package controllerinstallation
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -288,6 +289,10 @@ func (r *Reconciler) reconcile(
 		seedSubCtx := context.WithValue(seedCtx, oci.ContextKeyPullSecretNamespace, gardenerutils.ComputeGardenNamespace(seed.Name))
 		archive, err = r.HelmRegistry.Pull(seedSubCtx, controllerDeployment.Helm.OCIRepository)
 		if err != nil {
+			if errors.Is(err, oci.ErrSignatureVerification) {
+				conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "OCIChartSignatureInvalid", fmt.Sprintf("chart signature verification failed: %+v", err))
+				return reconcile.Result{}, err
+			}
 			conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "OCIChartCannotBePulled", fmt.Sprintf("chart pulling process failed: %+v", err))
 			return reconcile.Result{}, err
 		}
@@ -45,8 +45,9 @@ func AddToManager(
 	}
 
 	if err := (&care.Reconciler{
-		Config:   *cfg.Controllers.SeedCare,
-		SeedName: cfg.SeedConfig.Name,
+		Config:    *cfg.Controllers.SeedCare,
+		Resources: cfg.Resources,
+		SeedName:  cfg.SeedConfig.Name,
 	}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
 		return fmt.Errorf("failed adding care reconciler: %w", err)
 	}
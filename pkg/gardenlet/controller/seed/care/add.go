@@ -38,6 +38,9 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster, seedCluste
 	if r.SeedClient == nil {
 		r.SeedClient = seedCluster.GetClient()
 	}
+	if r.SeedAPIReader == nil {
+		r.SeedAPIReader = seedCluster.GetAPIReader()
+	}
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
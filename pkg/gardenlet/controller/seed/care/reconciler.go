@@ -32,10 +32,16 @@ var NewHealthCheck = defaultNewHealthCheck
 type Reconciler struct {
 	GardenClient client.Client
 	SeedClient   client.Client
-	Config       gardenletconfigv1alpha1.SeedCareControllerConfiguration
-	Clock        clock.Clock
-	Namespace    *string
-	SeedName     string
+	// SeedAPIReader is used for listing nodes and pods when computing the resources of the seed, so that gardenlet
+	// does not have to cache all of them.
+	SeedAPIReader client.Reader
+	Config        gardenletconfigv1alpha1.SeedCareControllerConfiguration
+	Clock         clock.Clock
+	Namespace     *string
+	SeedName      string
+	// Resources is the resources configuration of gardenlet. The capacity of resources configured here is not
+	// overwritten with the observed capacity of the seed's nodes.
+	Resources *gardenletconfigv1alpha1.ResourcesConfiguration
 }
 
 // Reconcile reconciles Seed resources and executes health check operations.
@@ -86,6 +92,11 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Reque
 		}
 	}
 
+	// Publish capacity and usage of the seed's resources
+	if err := r.updateResources(ctx, log, seed); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating seed resources: %w", err)
+	}
+
 	// Trigger garbage collection
 	if err := r.performGarbageCollection(ctx, log); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed performing garbage collection: %w", err)
@@ -12,6 +12,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 	testclock "k8s.io/utils/clock/testing"
@@ -26,6 +28,7 @@ import (
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/care"
 	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 const (
@@ -77,7 +80,7 @@ var _ = Describe("Seed Care Control", func() {
 
 		Context("when seed no longer exists", func() {
 			It("should stop reconciling and not requeue", func() {
-				reconciler = &Reconciler{GardenClient: gardenClient, SeedClient: seedClient, Config: controllerConfig, Clock: fakeClock}
+				reconciler = &Reconciler{GardenClient: gardenClient, SeedClient: seedClient, SeedAPIReader: seedClient, Config: controllerConfig, Clock: fakeClock}
 
 				req = reconcile.Request{NamespacedName: client.ObjectKey{Name: "some-other-seed"}}
 				Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{}))
@@ -86,7 +89,7 @@ var _ = Describe("Seed Care Control", func() {
 
 		Context("when health check setup is successful", func() {
 			JustBeforeEach(func() {
-				reconciler = &Reconciler{GardenClient: gardenClient, SeedClient: seedClient, Config: controllerConfig, Clock: fakeClock}
+				reconciler = &Reconciler{GardenClient: gardenClient, SeedClient: seedClient, SeedAPIReader: seedClient, Config: controllerConfig, Clock: fakeClock}
 			})
 
 			Context("when no conditions are returned", func() {
@@ -201,7 +204,7 @@ var _ = Describe("Seed Care Control", func() {
 			})
 
 			JustBeforeEach(func() {
-				reconciler = &Reconciler{GardenClient: gardenClient, SeedClient: seedClient, Config: controllerConfig, Clock: fakeClock}
+				reconciler = &Reconciler{GardenClient: gardenClient, SeedClient: seedClient, SeedAPIReader: seedClient, Config: controllerConfig, Clock: fakeClock}
 				Expect(gardenClient.Create(ctx, seed)).To(Succeed())
 			})
 
@@ -247,6 +250,134 @@ var _ = Describe("Seed Care Control", func() {
 				})))
 			})
 		})
+
+		Describe("seed resources", func() {
+			var req reconcile.Request
+
+			newPod := func(name, namespace, cpu, memory string) *corev1.Pod {
+				return &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{
+						Name: "c",
+						Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse(cpu),
+							corev1.ResourceMemory: resource.MustParse(memory),
+						}},
+					}}},
+				}
+			}
+
+			BeforeEach(func() {
+				req = reconcile.Request{NamespacedName: client.ObjectKey{Name: seedName}}
+
+				controllerConfig = gardenletconfigv1alpha1.SeedCareControllerConfiguration{
+					SyncPeriod: &metav1.Duration{Duration: careSyncPeriod},
+				}
+
+				DeferCleanup(test.WithVars(&NewHealthCheck,
+					healthCheckFunc(func(_ SeedConditions) []gardencorev1beta1.Condition { return nil })))
+
+				for _, obj := range []client.Object{
+					&corev1.Node{
+						ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+						Status: corev1.NodeStatus{
+							Capacity: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("4"),
+								corev1.ResourceMemory: resource.MustParse("16Gi"),
+							},
+							Allocatable: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("3800m"),
+								corev1.ResourceMemory: resource.MustParse("15Gi"),
+							},
+						},
+					},
+					&corev1.Node{
+						ObjectMeta: metav1.ObjectMeta{Name: "node-2"},
+						Status: corev1.NodeStatus{
+							Capacity: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("2"),
+								corev1.ResourceMemory: resource.MustParse("8Gi"),
+							},
+							Allocatable: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("1800m"),
+								corev1.ResourceMemory: resource.MustParse("7Gi"),
+							},
+						},
+					},
+					&corev1.Node{
+						ObjectMeta: metav1.ObjectMeta{Name: "node-3"},
+						Spec:       corev1.NodeSpec{Unschedulable: true},
+						Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("2"),
+							corev1.ResourceMemory: resource.MustParse("8Gi"),
+						}},
+					},
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar", Labels: map[string]string{"gardener.cloud/role": "shoot"}}},
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "garden"}},
+					newPod("kube-apiserver", "shoot--foo--bar", "500m", "1Gi"),
+					newPod("etcd", "shoot--foo--bar", "1", "2Gi"),
+					newPod("gardenlet", "garden", "1", "1Gi"),
+				} {
+					Expect(seedClient.Create(ctx, obj)).To(Succeed())
+				}
+
+				completedPod := newPod("completed", "shoot--foo--bar", "1", "1Gi")
+				completedPod.Status.Phase = corev1.PodSucceeded
+				Expect(seedClient.Create(ctx, completedPod)).To(Succeed())
+			})
+
+			JustBeforeEach(func() {
+				Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+			})
+
+			It("should publish the node capacity, the allocatable node resources and the usage of the shoot namespaces", func() {
+				seed.Status.Capacity = corev1.ResourceList{"shoots": resource.MustParse("10")}
+				Expect(gardenClient.Status().Update(ctx, seed)).To(Succeed())
+				reconciler = &Reconciler{GardenClient: gardenClient, SeedClient: seedClient, SeedAPIReader: seedClient, Config: controllerConfig, Clock: fakeClock}
+
+				Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+				updatedSeed := &gardencorev1beta1.Seed{}
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), updatedSeed)).To(Succeed())
+				Expect(updatedSeed.Status.Capacity).To(DeepEqual(corev1.ResourceList{
+					"shoots":              resource.MustParse("10"),
+					corev1.ResourceCPU:    resource.MustParse("6"),
+					corev1.ResourceMemory: resource.MustParse("24Gi"),
+				}))
+				Expect(updatedSeed.Status.Allocatable).To(DeepEqual(corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("5600m"),
+					corev1.ResourceMemory: resource.MustParse("22Gi"),
+				}))
+				Expect(updatedSeed.Status.Usage).To(DeepEqual(corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1500m"),
+					corev1.ResourceMemory: resource.MustParse("3Gi"),
+				}))
+			})
+
+			It("should not overwrite the capacity of explicitly configured resources", func() {
+				reconciler = &Reconciler{
+					GardenClient:  gardenClient,
+					SeedClient:    seedClient,
+					SeedAPIReader: seedClient,
+					Config:        controllerConfig,
+					Clock:         fakeClock,
+					Resources: &gardenletconfigv1alpha1.ResourcesConfiguration{
+						Capacity: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100")},
+					},
+				}
+
+				Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+				updatedSeed := &gardencorev1beta1.Seed{}
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), updatedSeed)).To(Succeed())
+				Expect(updatedSeed.Status.Capacity).To(DeepEqual(corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("24Gi"),
+				}))
+				Expect(updatedSeed.Status.Allocatable).To(DeepEqual(corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("22Gi"),
+				}))
+			})
+		})
 	})
 })
 
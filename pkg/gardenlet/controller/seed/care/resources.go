@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	resourcehelper "k8s.io/component-helpers/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// updateResources publishes the capacity and the allocatable resources of the seed's nodes and the aggregated resource
+// requests of the pods in the shoot namespaces in the Seed status. Resources whose capacity is configured explicitly in
+// the gardenlet configuration are not overwritten.
+func (r *Reconciler) updateResources(ctx context.Context, log logr.Logger, seed *gardencorev1beta1.Seed) error {
+	nodeList := &corev1.NodeList{}
+	if err := r.SeedAPIReader.List(ctx, nodeList); err != nil {
+		return fmt.Errorf("failed listing nodes: %w", err)
+	}
+	capacity, allocatable := gardenerutils.SeedNodeResources(nodeList.Items)
+
+	usage, err := r.shootControlPlaneUsage(ctx)
+	if err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(seed.DeepCopy())
+
+	for _, resourceName := range gardenerutils.ObservedSeedResourceNames {
+		if r.Resources != nil {
+			if _, ok := r.Resources.Capacity[resourceName]; ok {
+				continue
+			}
+		}
+
+		if quantity, ok := capacity[resourceName]; ok {
+			if seed.Status.Capacity == nil {
+				seed.Status.Capacity = corev1.ResourceList{}
+			}
+			seed.Status.Capacity[resourceName] = quantity
+		}
+		if quantity, ok := allocatable[resourceName]; ok {
+			if seed.Status.Allocatable == nil {
+				seed.Status.Allocatable = corev1.ResourceList{}
+			}
+			seed.Status.Allocatable[resourceName] = quantity
+		}
+	}
+	seed.Status.Usage = usage
+
+	data, err := patch.Data(seed)
+	if err != nil {
+		return fmt.Errorf("failed computing patch for seed resources: %w", err)
+	}
+	if string(data) == "{}" {
+		return nil
+	}
+
+	log.V(1).Info("Updating seed resources in status", "capacity", seed.Status.Capacity, "allocatable", seed.Status.Allocatable, "usage", seed.Status.Usage)
+	return r.GardenClient.Status().Patch(ctx, seed, patch)
+}
+
+// shootControlPlaneUsage returns the aggregated resource requests of all active pods in the shoot namespaces. The pods
+// are listed per shoot namespace via the API reader, i.e., they are neither cached nor listed in other namespaces.
+func (r *Reconciler) shootControlPlaneUsage(ctx context.Context) (corev1.ResourceList, error) {
+	namespaceList := &corev1.NamespaceList{}
+	if err := r.SeedClient.List(ctx, namespaceList, client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}); err != nil {
+		return nil, fmt.Errorf("failed listing shoot namespaces: %w", err)
+	}
+
+	usage := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("0"),
+		corev1.ResourceMemory: resource.MustParse("0"),
+	}
+
+	for _, namespace := range namespaceList.Items {
+		podList := &corev1.PodList{}
+		if err := r.SeedAPIReader.List(ctx, podList, client.InNamespace(namespace.Name)); err != nil {
+			return nil, fmt.Errorf("failed listing pods in namespace %s: %w", namespace.Name, err)
+		}
+
+		for _, pod := range podList.Items {
+			if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+				continue
+			}
+			gardenerutils.AddObservedSeedResources(usage, resourcehelper.PodRequests(&pod, resourcehelper.PodResourcesOptions{}))
+		}
+	}
+	return usage, nil
+}
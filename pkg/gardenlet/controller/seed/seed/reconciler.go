@@ -182,6 +182,19 @@ func (r *Reconciler) updateStatusOperationStart(ctx context.Context, seed *garde
 				allocatable[resourceName] = allocatableQuantity
 			}
 		}
+
+		// Keep the resources observed by the seed care controller if they are not configured explicitly.
+		for _, resourceName := range gardenerutils.ObservedSeedResourceNames {
+			if _, ok := r.Config.Resources.Capacity[resourceName]; ok {
+				continue
+			}
+			if quantity, ok := seed.Status.Capacity[resourceName]; ok {
+				capacity[resourceName] = quantity
+			}
+			if quantity, ok := seed.Status.Allocatable[resourceName]; ok {
+				allocatable[resourceName] = quantity
+			}
+		}
 	}
 
 	if capacity != nil {
@@ -374,6 +374,7 @@ func (r *Reconciler) runDeleteSeedFlow(
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log:              log,
 		ProgressReporter: r.reportProgress(log, seed.GetInfo()),
+		ObjectKey:        seed.GetInfo().Name,
 	}); err != nil {
 		return flow.Errors(err)
 	}
@@ -34,6 +34,7 @@ import (
 	sharedcomponent "github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1/helper"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -133,7 +134,10 @@ func (r *Reconciler) runReconcileSeedFlow(
 		r.SeedClientSet.Client(),
 		r.GardenNamespace,
 		v1beta1constants.SecretManagerIdentityGardenlet,
-		secretsmanager.Config{CASecretAutoRotation: true},
+		secretsmanager.Config{
+			CASecretAutoRotation: true,
+			KeyAlgorithm:         secretsutils.KeyAlgorithm(gardenlethelper.GetCertificateKeyAlgorithm(&r.Config)),
+		},
 	)
 	if err != nil {
 		return err
@@ -518,6 +522,7 @@ func (r *Reconciler) runReconcileSeedFlow(
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log:              log,
 		ProgressReporter: r.reportProgress(log, seed.GetInfo()),
+		ObjectKey:        seed.GetInfo().Name,
 	}); err != nil {
 		return flow.Errors(err)
 	}
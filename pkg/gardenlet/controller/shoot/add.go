@@ -21,6 +21,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/certificates"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/lease"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/state"
@@ -74,6 +75,15 @@ func AddToManager(
 		return fmt.Errorf("failed adding care reconciler: %w", err)
 	}
 
+	if ptr.Deref(cfg.Controllers.ShootCertificates.ConcurrentSyncs, 0) > 0 {
+		if err := (&certificates.Reconciler{
+			Config:   *cfg.Controllers.ShootCertificates,
+			SeedName: cfg.SeedConfig.Name,
+		}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+			return fmt.Errorf("failed adding certificates reconciler: %w", err)
+		}
+	}
+
 	if err := (&status.Reconciler{
 		Config:   *cfg.Controllers.ShootStatus,
 		SeedName: cfg.SeedConfig.Name,
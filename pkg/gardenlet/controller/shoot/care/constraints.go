@@ -210,7 +210,8 @@ func (c *Constraint) CheckIfCACertificateValiditiesAcceptable(ctx context.Contex
 
 	expiringCACertificates := make(map[string]time.Time, len(secretList.Items))
 	for _, secret := range secretList.Items {
-		if secret.Data[secretsutils.DataKeyCertificateCA] == nil || secret.Data[secretsutils.DataKeyPrivateKeyCA] == nil {
+		if secret.Data[secretsutils.DataKeyCertificateCA] == nil ||
+			(secret.Data[secretsutils.DataKeyPrivateKeyCA] == nil && secret.Data[secretsutils.DataKeyPrivateKeyCAReference] == nil) {
 			continue
 		}
 
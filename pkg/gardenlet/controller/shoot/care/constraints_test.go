@@ -732,6 +732,16 @@ var _ = Describe("Constraints", func() {
 				expectFalseCondition(status, reason, message, errorCodes, fmt.Sprintf(`"" (expiring at %s)`, now.String()))
 			})
 
+			It("should return a 'false' condition when there are CA secrets with external private keys not valid long enough", func() {
+				secret := newCASecret(now)
+				secret.Data = map[string][]byte{"ca.crt": []byte(""), "ca.key-ref": []byte("")}
+				Expect(seedClient.Create(ctx, secret)).To(Succeed())
+
+				status, reason, message, errorCodes, err := constraint.CheckIfCACertificateValiditiesAcceptable(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				expectFalseCondition(status, reason, message, errorCodes, fmt.Sprintf(`"" (expiring at %s)`, now.String()))
+			})
+
 			It("should return an error when the valid-until-time label cannot be parsed", func() {
 				secret := newCASecret(now)
 				secret.Labels["valid-until-time"] = "unparsable"
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificates_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/certificates"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler *Reconciler
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{SeedName: "seed"}
+		shoot = &gardencorev1beta1.Shoot{}
+	})
+
+	Describe("#ShootPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.ShootPredicate()
+		})
+
+		Describe("#Create", func() {
+			It("should return true", func() {
+				Expect(p.Create(event.CreateEvent{})).To(BeTrue())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false because new object is no shoot", func() {
+				Expect(p.Update(event.UpdateEvent{})).To(BeFalse())
+			})
+
+			It("should return false because old object is no shoot", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot})).To(BeFalse())
+			})
+
+			It("should return false because neither seed name, CA rotation phase nor last operation changed", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: shoot})).To(BeFalse())
+			})
+
+			It("should return true because seed name changed", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.SeedName = ptr.To("new-seed")
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return true because CA rotation phase changed", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+					CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationPreparing},
+				}}
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return true because last operation succeeded", func() {
+				shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateProcessing}
+				oldShoot := shoot.DeepCopy()
+				shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateSucceeded
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return true because first last operation succeeded", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded}
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return false because last operation was already succeeded", func() {
+				shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded, Progress: 100}
+				oldShoot := shoot.DeepCopy()
+				shoot.Status.LastOperation.Description = "other"
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeFalse())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return true", func() {
+				Expect(p.Delete(event.DeleteEvent{})).To(BeTrue())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return true", func() {
+				Expect(p.Generic(event.GenericEvent{})).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificates
+
+import (
+	"bytes"
+	"crypto/x509"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+// certificate contains the information about a certificate managed by the secrets manager.
+type certificate struct {
+	// name is the name of the certificate, i.e., the value of the name label of the secret.
+	name string
+	// secretName is the name of the secret containing the certificate.
+	secretName string
+	// caChain contains the names of the certificate authorities which signed the certificate, starting with its issuer.
+	caChain []string
+	// isCA states whether the certificate is a certificate authority.
+	isCA bool
+	// notAfter is the time when the certificate expires.
+	notAfter time.Time
+	// rotationPhase is the phase of the certificate authorities rotation the certificate is subject to.
+	rotationPhase gardencorev1beta1.CredentialsRotationPhase
+	// renewAt is the time after which the certificate is automatically renewed by the secrets manager. It is zero if
+	// the certificate is not subject to automatic renewal.
+	renewAt time.Time
+
+	x509 *x509.Certificate
+}
+
+// rotationScheduled returns whether a rotation is scheduled for the certificate, i.e., whether it is replaced as part of
+// an ongoing certificate authorities rotation or whether it is automatically renewed with the next reconciliation.
+func (c certificate) rotationScheduled(now time.Time) bool {
+	if (c.isCA || len(c.caChain) > 0) && c.rotationPhase != "" && c.rotationPhase != gardencorev1beta1.RotationCompleted {
+		return true
+	}
+
+	return !c.isCA && !c.renewAt.IsZero() && now.Before(c.renewAt)
+}
+
+// computeInventory computes the inventory of the certificates contained in the given secrets managed by the secrets
+// manager. Bundle secrets and secrets not containing certificates are skipped.
+func computeInventory(secrets []corev1.Secret, rotationPhase gardencorev1beta1.CredentialsRotationPhase) ([]certificate, error) {
+	var certificates []certificate
+
+	for _, secret := range secrets {
+		if secret.Labels[secretsmanager.LabelKeyBundleFor] != "" {
+			continue
+		}
+
+		var (
+			data = secret.Data[secretsutils.DataKeyCertificate]
+			isCA bool
+		)
+
+		if data == nil {
+			if secret.Data[secretsutils.DataKeyCertificateCA] == nil || secret.Data[secretsutils.DataKeyPrivateKeyCA] == nil {
+				continue
+			}
+			data, isCA = secret.Data[secretsutils.DataKeyCertificateCA], true
+		}
+
+		cert, err := utils.DecodeCertificate(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed decoding certificate of secret %q: %w", secret.Name, err)
+		}
+
+		renewAt, _, err := secretsmanager.AutoRenewalTime(secret)
+		if err != nil {
+			return nil, fmt.Errorf("failed computing renewal time of secret %q: %w", secret.Name, err)
+		}
+
+		certificates = append(certificates, certificate{
+			name:          secret.Labels[secretsmanager.LabelKeyName],
+			secretName:    secret.Name,
+			isCA:          isCA,
+			notAfter:      cert.NotAfter.UTC(),
+			rotationPhase: rotationPhase,
+			renewAt:       renewAt,
+			x509:          cert,
+		})
+	}
+
+	for i := range certificates {
+		certificates[i].caChain = caChain(certificates[i], certificates)
+		if !certificates[i].isCA && len(certificates[i].caChain) == 0 {
+			// Certificates which are not signed by a certificate authority managed for the shoot are not subject to the
+			// certificate authorities rotation.
+			certificates[i].rotationPhase = ""
+		}
+	}
+
+	slices.SortFunc(certificates, func(a, b certificate) int {
+		return strings.Compare(a.secretName, b.secretName)
+	})
+
+	return certificates, nil
+}
+
+// caChain returns the names of the certificate authorities which signed the given certificate, starting with its
+// issuer and ending with the root certificate authority.
+func caChain(cert certificate, certificates []certificate) []string {
+	var (
+		chain   []string
+		visited = map[string]struct{}{cert.secretName: {}}
+	)
+
+	for current := cert; !isSelfSigned(current.x509); {
+		issuer, ok := findIssuer(current.x509, certificates)
+		if !ok {
+			break
+		}
+		if _, ok := visited[issuer.secretName]; ok {
+			break
+		}
+		visited[issuer.secretName] = struct{}{}
+
+		chain = append(chain, issuer.name)
+		current = issuer
+	}
+
+	return chain
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.RawIssuer, cert.RawSubject) &&
+		(len(cert.AuthorityKeyId) == 0 || bytes.Equal(cert.AuthorityKeyId, cert.SubjectKeyId))
+}
+
+func findIssuer(cert *x509.Certificate, certificates []certificate) (certificate, bool) {
+	for _, candidate := range certificates {
+		if !candidate.isCA || !bytes.Equal(cert.RawIssuer, candidate.x509.RawSubject) {
+			continue
+		}
+
+		if len(cert.AuthorityKeyId) == 0 || bytes.Equal(cert.AuthorityKeyId, candidate.x509.SubjectKeyId) {
+			return candidate, true
+		}
+	}
+
+	return certificate{}, false
+}
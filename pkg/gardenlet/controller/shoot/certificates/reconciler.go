@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificates
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/gardenlet/metrics"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+// Reconciler computes an inventory of the certificates managed by the secrets manager for Shoot clusters. It publishes
+// the inventory as metrics and a summary in the Shoot status, and reports certificates which are about to expire
+// without a scheduled rotation.
+type Reconciler struct {
+	GardenClient client.Client
+	SeedClient   client.Client
+	Config       gardenletconfigv1alpha1.ShootCertificatesControllerConfiguration
+	Clock        clock.Clock
+	SeedName     string
+}
+
+// Reconcile computes the certificate inventory of a Shoot cluster.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			deleteMetrics(request.Namespace, request.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// if shoot got deleted or is no longer managed by this gardenlet (e.g., due to migration to another seed) then don't requeue
+	if shoot.DeletionTimestamp != nil || ptr.Deref(shoot.Spec.SeedName, "") != r.SeedName {
+		deleteMetrics(shoot.Namespace, shoot.Name)
+		return reconcile.Result{}, nil
+	}
+
+	if shoot.Status.TechnicalID == "" || shoot.Status.LastOperation == nil {
+		log.Info("Requeuing because shoot was not yet reconciled", "requeueAfter", r.Config.SyncPeriod.Duration)
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	secretList := &corev1.SecretList{}
+	if err := r.SeedClient.List(ctx, secretList, client.InNamespace(shoot.Status.TechnicalID), client.MatchingLabels{
+		secretsmanager.LabelKeyManagedBy: secretsmanager.LabelValueSecretsManager,
+	}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing secrets in control plane namespace %q: %w", shoot.Status.TechnicalID, err)
+	}
+
+	certificates, err := computeInventory(secretList.Items, caRotationPhase(shoot))
+	if err != nil {
+		condition := v1beta1helper.UpdatedConditionUnknownErrorWithClock(r.Clock, v1beta1helper.GetOrInitConditionWithClock(r.Clock, shoot.Status.Constraints, gardencorev1beta1.ShootCertificateExpirationsAcceptable), err)
+		if patchErr := r.patchStatus(ctx, shoot, nil, condition); patchErr != nil {
+			log.Error(patchErr, "Failed patching shoot status")
+		}
+		return reconcile.Result{}, fmt.Errorf("failed computing certificate inventory: %w", err)
+	}
+
+	deleteMetrics(shoot.Namespace, shoot.Name)
+	for _, cert := range certificates {
+		metrics.ShootCertificateExpirationTimestampSeconds.With(prometheus.Labels{
+			"namespace":      shoot.Namespace,
+			"name":           shoot.Name,
+			"certificate":    cert.name,
+			"secret":         cert.secretName,
+			"ca_chain":       strings.Join(cert.caChain, ","),
+			"rotation_phase": string(cert.rotationPhase),
+		}).Set(float64(cert.notAfter.Unix()))
+	}
+
+	summary, condition := r.summarize(shoot, certificates)
+	if err := r.patchStatus(ctx, shoot, summary, condition); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed patching shoot status: %w", err)
+	}
+
+	log.V(1).Info("Computed certificate inventory", "certificates", summary.Total, "expiring", len(summary.Expiring))
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) summarize(shoot *gardencorev1beta1.Shoot, certificates []certificate) (*gardencorev1beta1.ShootCertificatesSummary, gardencorev1beta1.Condition) {
+	var (
+		now       = r.Clock.Now().UTC()
+		threshold = r.Config.ExpirationThreshold.Duration
+		summary   = &gardencorev1beta1.ShootCertificatesSummary{Total: int32(len(certificates))} // #nosec G115 -- There are not more than MaxInt32 certificates.
+		messages  []string
+	)
+
+	for _, cert := range certificates {
+		if summary.EarliestExpirationTime == nil || cert.notAfter.Before(summary.EarliestExpirationTime.Time) {
+			summary.EarliestExpirationTime = &metav1.Time{Time: cert.notAfter}
+		}
+
+		if cert.notAfter.Sub(now) >= threshold || cert.rotationScheduled(now) {
+			continue
+		}
+
+		if !slices.Contains(summary.Expiring, cert.name) {
+			summary.Expiring = append(summary.Expiring, cert.name)
+		}
+		messages = append(messages, fmt.Sprintf("%q (secret %q, expiring at %s)", cert.name, cert.secretName, cert.notAfter))
+	}
+	slices.Sort(summary.Expiring)
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, shoot.Status.Constraints, gardencorev1beta1.ShootCertificateExpirationsAcceptable)
+	if len(messages) > 0 {
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse,
+			"ExpiringCertificates",
+			fmt.Sprintf("Some certificates are expiring in less than %s without a scheduled rotation: %s. Certificate authorities must be rotated, other certificates are renewed with the next reconciliation of the Shoot.", threshold, strings.Join(messages, ", ")),
+		)
+	} else {
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue,
+			"NoExpiringCertificates",
+			fmt.Sprintf("No certificate is expiring in less than %s without a scheduled rotation.", threshold),
+		)
+	}
+
+	return summary, condition
+}
+
+func (r *Reconciler) patchStatus(ctx context.Context, shoot *gardencorev1beta1.Shoot, summary *gardencorev1beta1.ShootCertificatesSummary, condition gardencorev1beta1.Condition) error {
+	var existingSummary *gardencorev1beta1.ShootCertificatesSummary
+	if shoot.Status.Credentials != nil && shoot.Status.Credentials.Certificates != nil {
+		existingSummary = shoot.Status.Credentials.Certificates.DeepCopy()
+		existingSummary.LastUpdateTime = nil
+	}
+
+	constraints := v1beta1helper.MergeConditions(shoot.Status.Constraints, condition)
+	if condition.Status == gardencorev1beta1.ConditionTrue {
+		// Similar to other optional constraints, this constraint is only visible if there is a problem.
+		constraints = v1beta1helper.RemoveConditions(shoot.Status.Constraints, condition.Type)
+	}
+
+	if (summary == nil || apiequality.Semantic.DeepEqual(existingSummary, summary)) && apiequality.Semantic.DeepEqual(shoot.Status.Constraints, constraints) {
+		return nil
+	}
+
+	patch := client.StrategicMergeFrom(shoot.DeepCopy())
+	if summary != nil {
+		if shoot.Status.Credentials == nil {
+			shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{}
+		}
+		shoot.Status.Credentials.Certificates = summary
+		shoot.Status.Credentials.Certificates.LastUpdateTime = &metav1.Time{Time: r.Clock.Now().UTC()}
+	}
+	shoot.Status.Constraints = constraints
+	return r.GardenClient.Status().Patch(ctx, shoot, patch)
+}
+
+func deleteMetrics(namespace, name string) {
+	metrics.ShootCertificateExpirationTimestampSeconds.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "name": name})
+}
+
+func caRotationPhase(shoot *gardencorev1beta1.Shoot) gardencorev1beta1.CredentialsRotationPhase {
+	return v1beta1helper.GetShootCARotationPhase(shoot.Status.Credentials)
+}
@@ -0,0 +1,271 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificates_test
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/certificates"
+	"github.com/gardener/gardener/pkg/gardenlet/metrics"
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		seedName              = "seed"
+		controlPlaneNamespace = "shoot--foo--bar"
+	)
+
+	var (
+		ctx        = context.TODO()
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+		request    reconcile.Request
+
+		gardenClient client.Client
+		seedClient   client.Client
+
+		shoot *gardencorev1beta1.Shoot
+		ca    *secretsutils.Certificate
+	)
+
+	newSecret := func(secretName string, config *secretsutils.CertificateSecretConfig, labels map[string]string) (*corev1.Secret, *secretsutils.Certificate) {
+		config.KeyAlgorithm = secretsutils.KeyAlgorithmECDSAP256
+		cert, err := config.GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      secretName,
+				Namespace: controlPlaneNamespace,
+				Labels: utils.MergeStringMaps(map[string]string{
+					"managed-by":       "secrets-manager",
+					"manager-identity": "gardenlet",
+					"name":             config.Name,
+				}, labels),
+			},
+			Data: cert.SecretData(),
+		}, cert
+	}
+
+	validityLabels := func(issuedAt time.Time, validity time.Duration) map[string]string {
+		return map[string]string{
+			"issued-at-time":   strconv.FormatInt(issuedAt.Unix(), 10),
+			"valid-until-time": strconv.FormatInt(issuedAt.Add(validity).Unix(), 10),
+		}
+	}
+
+	createLeaf := func(secretName string, validity time.Duration, labels map[string]string) *corev1.Secret {
+		secret, _ := newSecret(secretName, &secretsutils.CertificateSecretConfig{
+			Name:       "kube-apiserver",
+			CommonName: "kube-apiserver",
+			CertType:   secretsutils.ServerCert,
+			SigningCA:  ca,
+			Validity:   &validity,
+		}, labels)
+		ExpectWithOffset(1, seedClient.Create(ctx, secret)).To(Succeed())
+		return secret
+	}
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now().Truncate(time.Second))
+
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Config: gardenletconfigv1alpha1.ShootCertificatesControllerConfiguration{
+				SyncPeriod:          &metav1.Duration{Duration: time.Hour},
+				ExpirationThreshold: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+			},
+			Clock:    fakeClock,
+			SeedName: seedName,
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To(seedName)},
+			Status: gardencorev1beta1.ShootStatus{
+				TechnicalID:   controlPlaneNamespace,
+				LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile, State: gardencorev1beta1.LastOperationStateSucceeded},
+			},
+		}
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+
+		caValidity := 20 * 24 * time.Hour
+		var caSecret *corev1.Secret
+		caSecret, ca = newSecret("ca-abc", &secretsutils.CertificateSecretConfig{
+			Name:       "ca",
+			CommonName: "kubernetes",
+			CertType:   secretsutils.CACert,
+			Validity:   &caValidity,
+		}, map[string]string{"persist": "true"})
+		Expect(seedClient.Create(ctx, caSecret)).To(Succeed())
+
+		Expect(seedClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ca-bundle-123", Namespace: controlPlaneNamespace, Labels: map[string]string{"managed-by": "secrets-manager", "name": "ca-bundle", "bundle-for": "ca"}},
+			Data:       map[string][]byte{"bundle.crt": caSecret.Data["ca.crt"]},
+		})).To(Succeed())
+		Expect(seedClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "basic-auth-123", Namespace: controlPlaneNamespace, Labels: map[string]string{"managed-by": "secrets-manager", "name": "basic-auth"}},
+			Data:       map[string][]byte{"username": []byte("admin"), "password": []byte("secret")},
+		})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		metrics.ShootCertificateExpirationTimestampSeconds.Reset()
+	})
+
+	It("should publish the inventory and report an expiring CA without scheduled rotation", func() {
+		leafValidity := 365 * 24 * time.Hour
+		createLeaf("kube-apiserver-abc", leafValidity, validityLabels(fakeClock.Now(), leafValidity))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		summary := shoot.Status.Credentials.Certificates
+		Expect(summary.Total).To(Equal(int32(2)))
+		Expect(summary.EarliestExpirationTime.Time).To(BeTemporally("==", ca.Certificate.NotAfter.Truncate(time.Second)))
+		Expect(summary.Expiring).To(Equal([]string{"ca"}))
+		Expect(summary.LastUpdateTime.Time).To(BeTemporally("==", fakeClock.Now()))
+
+		condition := v1beta1helper.GetCondition(shoot.Status.Constraints, gardencorev1beta1.ShootCertificateExpirationsAcceptable)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("ExpiringCertificates"))
+		Expect(condition.Message).To(ContainSubstring(`"ca" (secret "ca-abc"`))
+
+		Expect(testutil.CollectAndCount(metrics.ShootCertificateExpirationTimestampSeconds)).To(Equal(2))
+		Expect(testutil.ToFloat64(metrics.ShootCertificateExpirationTimestampSeconds.WithLabelValues("garden-foo", "bar", "ca", "ca-abc", "", ""))).To(Equal(float64(ca.Certificate.NotAfter.Unix())))
+		Expect(testutil.ToFloat64(metrics.ShootCertificateExpirationTimestampSeconds.WithLabelValues("garden-foo", "bar", "kube-apiserver", "kube-apiserver-abc", "ca", ""))).To(BeNumerically(">", ca.Certificate.NotAfter.Unix()))
+	})
+
+	It("should not report certificates when the CA rotation is in progress", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(v1beta1helper.GetCondition(shoot.Status.Constraints, gardencorev1beta1.ShootCertificateExpirationsAcceptable)).NotTo(BeNil())
+
+		shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+			CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationPreparing},
+		}}
+		Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.Credentials.Certificates.Total).To(Equal(int32(1)))
+		Expect(shoot.Status.Credentials.Certificates.Expiring).To(BeEmpty())
+		Expect(shoot.Status.Credentials.Rotation.CertificateAuthorities.Phase).To(Equal(gardencorev1beta1.RotationPreparing))
+
+		Expect(v1beta1helper.GetCondition(shoot.Status.Constraints, gardencorev1beta1.ShootCertificateExpirationsAcceptable)).To(BeNil())
+
+		Expect(testutil.ToFloat64(metrics.ShootCertificateExpirationTimestampSeconds.WithLabelValues("garden-foo", "bar", "ca", "ca-abc", "", "Preparing"))).To(Equal(float64(ca.Certificate.NotAfter.Unix())))
+	})
+
+	It("should report leaf certificates whose automatic renewal is overdue", func() {
+		reconciler.Config.ExpirationThreshold = &metav1.Duration{Duration: 15 * 24 * time.Hour}
+
+		leafValidity := 20 * 24 * time.Hour
+		createLeaf("kube-apiserver-abc", leafValidity, validityLabels(fakeClock.Now(), leafValidity))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.Credentials.Certificates.Expiring).To(BeEmpty())
+
+		fakeClock.Step(11 * 24 * time.Hour)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.Credentials.Certificates.Expiring).To(ContainElement("kube-apiserver"))
+		Expect(v1beta1helper.GetCondition(shoot.Status.Constraints, gardencorev1beta1.ShootCertificateExpirationsAcceptable).Status).To(Equal(gardencorev1beta1.ConditionFalse))
+	})
+
+	It("should report leaf certificates which are not subject to automatic renewal", func() {
+		createLeaf("kube-apiserver-abc", 12*time.Hour, nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.Credentials.Certificates.Expiring).To(ConsistOf("ca", "kube-apiserver"))
+	})
+
+	It("should not update the status if nothing changed", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		resourceVersion := shoot.ResourceVersion
+
+		fakeClock.Step(time.Minute)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.ResourceVersion).To(Equal(resourceVersion))
+	})
+
+	It("should requeue if the shoot was not yet reconciled", func() {
+		shoot.Status.LastOperation = nil
+		Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.Credentials).To(BeNil())
+	})
+
+	It("should return an error and set the condition to unknown if a certificate cannot be decoded", func() {
+		Expect(seedClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "broken-123", Namespace: controlPlaneNamespace, Labels: map[string]string{"managed-by": "secrets-manager", "name": "broken"}},
+			Data:       map[string][]byte{"tls.crt": []byte("foo"), "tls.key": []byte("bar")},
+		})).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring(`failed decoding certificate of secret "broken-123"`)))
+
+		Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		condition := v1beta1helper.GetCondition(shoot.Status.Constraints, gardencorev1beta1.ShootCertificateExpirationsAcceptable)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionUnknown))
+	})
+
+	It("should delete the metrics if the shoot is no longer managed by this gardenlet", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(testutil.CollectAndCount(metrics.ShootCertificateExpirationTimestampSeconds)).To(Equal(1))
+
+		Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		shoot.Spec.SeedName = ptr.To("other-seed")
+		Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(testutil.CollectAndCount(metrics.ShootCertificateExpirationTimestampSeconds)).To(Equal(0))
+	})
+
+	It("should delete the metrics if the shoot is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(testutil.CollectAndCount(metrics.ShootCertificateExpirationTimestampSeconds)).To(Equal(1))
+
+		Expect(gardenClient.Delete(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(testutil.CollectAndCount(metrics.ShootCertificateExpirationTimestampSeconds)).To(Equal(0))
+	})
+})
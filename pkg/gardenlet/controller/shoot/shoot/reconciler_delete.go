@@ -723,6 +723,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 	if err := f.Run(ctx, flow.Opts{
 		Log:              o.Logger,
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
+		ObjectKey:        client.ObjectKeyFromObject(o.Shoot.GetInfo()).String(),
 		ErrorCleaner:     o.CleanShootTaskError,
 		ErrorContext:     errorContext,
 	}); err != nil {
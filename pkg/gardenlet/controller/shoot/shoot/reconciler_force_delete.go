@@ -11,6 +11,7 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
@@ -200,6 +201,7 @@ func (r *Reconciler) runForceDeleteShootFlow(ctx context.Context, log logr.Logge
 	if err := f.Run(ctx, flow.Opts{
 		Log:              o.Logger,
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
+		ObjectKey:        client.ObjectKeyFromObject(o.Shoot.GetInfo()).String(),
 		ErrorCleaner:     o.CleanShootTaskError,
 		ErrorContext:     errorContext,
 	}); err != nil {
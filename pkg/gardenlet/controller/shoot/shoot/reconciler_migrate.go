@@ -457,6 +457,7 @@ func (r *Reconciler) runMigrateShootFlow(ctx context.Context, o *operation.Opera
 	if err := f.Run(ctx, flow.Opts{
 		Log:              o.Logger,
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
+		ObjectKey:        client.ObjectKeyFromObject(o.Shoot.GetInfo()).String(),
 		ErrorContext:     errorContext,
 		ErrorCleaner:     o.CleanShootTaskError,
 	}); err != nil {
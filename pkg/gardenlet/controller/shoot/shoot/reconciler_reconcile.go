@@ -116,6 +116,10 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		deployKubeAPIServerTaskTimeout = defaultTimeout
 		shootSSHAccessEnabled          = v1beta1helper.ShootEnablesSSHAccess(o.Shoot.GetInfo())
 		isRestoringHAControlPlane      = botanist.IsRestorePhase() && v1beta1helper.IsHAControlPlaneConfigured(o.Shoot.GetInfo())
+
+		inputChecksum = func(inputs func() []any) flow.InputChecksumFn {
+			return resumableTaskInputChecksum(generation, o.GardenerInfo.Version, inputs)
+		}
 	)
 
 	// During the 'Preparing' phase of different rotation operations, components are deployed twice. Also, the
@@ -275,16 +279,18 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(deployBackupEntryInGarden),
 		})
 		copyEtcdBackups = g.Add(flow.Task{
-			Name:         "Copying etcd backups to new seed's backup bucket",
-			Fn:           botanist.DeployEtcdCopyBackupsTask,
-			SkipIf:       !isCopyOfBackupsRequired,
-			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilBackupEntryInGardenReconciled, waitUntilSourceBackupEntryInGardenReconciled),
+			Name:          "Copying etcd backups to new seed's backup bucket",
+			Fn:            botanist.DeployEtcdCopyBackupsTask,
+			SkipIf:        !isCopyOfBackupsRequired,
+			InputChecksum: inputChecksum(nil),
+			Dependencies:  flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilBackupEntryInGardenReconciled, waitUntilSourceBackupEntryInGardenReconciled),
 		})
 		waitUntilEtcdBackupsCopied = g.Add(flow.Task{
-			Name:         "Waiting until etcd backups are copied",
-			Fn:           botanist.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.Wait,
-			SkipIf:       skipReadiness || !isCopyOfBackupsRequired,
-			Dependencies: flow.NewTaskIDs(copyEtcdBackups),
+			Name:          "Waiting until etcd backups are copied",
+			Fn:            botanist.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.Wait,
+			SkipIf:        skipReadiness || !isCopyOfBackupsRequired,
+			InputChecksum: inputChecksum(nil),
+			Dependencies:  flow.NewTaskIDs(copyEtcdBackups),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Destroying copy etcd backups task resource",
@@ -426,9 +432,10 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(initialValiDeployment, waitUntilGardenerResourceManagerReady),
 		})
 		deployShootNamespaces = g.Add(flow.Task{
-			Name:         "Deploying shoot namespaces system component",
-			Fn:           flow.TaskFn(botanist.Shoot.Components.SystemComponents.Namespaces.Deploy).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager),
+			Name:          "Deploying shoot namespaces system component",
+			Fn:            flow.TaskFn(botanist.Shoot.Components.SystemComponents.Namespaces.Deploy).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			InputChecksum: inputChecksum(nil),
+			Dependencies:  flow.NewTaskIDs(deployGardenerResourceManager),
 		})
 		waitUntilShootNamespacesReady = g.Add(flow.Task{
 			Name:         "Waiting until shoot namespaces have been reconciled",
@@ -621,9 +628,13 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(deleteStaleOperatingSystemConfigResources),
 		})
 		deployNetwork = g.Add(flow.Task{
-			Name:         "Deploying shoot network plugin",
-			Fn:           flow.TaskFn(botanist.DeployNetwork).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			SkipIf:       o.Shoot.IsWorkerless,
+			Name:   "Deploying shoot network plugin",
+			Fn:     flow.TaskFn(botanist.DeployNetwork).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf: o.Shoot.IsWorkerless,
+			InputChecksum: inputChecksum(func() []any {
+				// the networks are only determined after the infrastructure has been reconciled
+				return []any{o.Shoot.Networks, botanist.IsRestorePhase()}
+			}),
 			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilGardenerResourceManagerReady, waitUntilOperatingSystemConfigReady, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
 		waitUntilNetworkIsReady = g.Add(flow.Task{
@@ -741,16 +752,18 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
 		deployKubernetesDashboard = g.Add(flow.Task{
-			Name:         "Deploying addon Kubernetes Dashboard",
-			Fn:           flow.TaskFn(botanist.DeployKubernetesDashboard).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
-			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
+			Name:          "Deploying addon Kubernetes Dashboard",
+			Fn:            flow.TaskFn(botanist.DeployKubernetesDashboard).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:        o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
+			InputChecksum: inputChecksum(nil),
+			Dependencies:  flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
 		deployNginxIngressAddon = g.Add(flow.Task{
-			Name:         "Deploying addon Nginx Ingress Controller",
-			Fn:           flow.TaskFn(botanist.DeployNginxIngressAddon).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
-			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
+			Name:          "Deploying addon Nginx Ingress Controller",
+			Fn:            flow.TaskFn(botanist.DeployNginxIngressAddon).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:        o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
+			InputChecksum: inputChecksum(nil),
+			Dependencies:  flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
 		deployManagedResourceForGardenerNodeAgent = g.Add(flow.Task{
 			Name:         "Deploying managed resources for the gardener-node-agent",
@@ -1032,8 +1045,10 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 	if err := f.Run(ctx, flow.Opts{
 		Log:              o.Logger,
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
+		ObjectKey:        client.ObjectKeyFromObject(o.Shoot.GetInfo()).String(),
 		ErrorContext:     errorContext,
 		ErrorCleaner:     o.CleanShootTaskError,
+		StateStore:       shootstate.NewFlowStateStore(botanist.GardenClient, o.Shoot.GetInfo()),
 	}); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
@@ -1075,6 +1090,25 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 	return nil
 }
 
+// resumableTaskInputChecksum returns the input checksum of tasks which are skipped when a previously interrupted or
+// failed reconciliation is resumed, see flow.Opts.StateStore. It changes whenever the Shoot generation (i.e., its
+// specification), the Gardener version or the inputs of the deployed component change. The inputs function is optional
+// and only needed for inputs which are not part of the Shoot specification. It is called when the task would be
+// started, i.e., it may return data which is populated by preceding tasks.
+// Only tasks whose effects are fully persisted may use it, i.e., tasks which neither generate secrets via the secrets
+// manager (they would be cleaned up otherwise) nor populate in-memory state which is consumed by subsequent tasks.
+// Readiness waits may only use it if the tasks deploying the awaited resources use it as well, otherwise they would be
+// skipped although the resources have just been changed.
+func resumableTaskInputChecksum(generation int64, gardenerVersion string, inputs func() []any) flow.InputChecksumFn {
+	return func() string {
+		data := []any{generation, gardenerVersion}
+		if inputs != nil {
+			data = append(data, inputs()...)
+		}
+		return utils.ComputeChecksum(data)
+	}
+}
+
 func removeTaskAnnotation(ctx context.Context, o *operation.Operation, generation int64, tasksToRemove ...string) error {
 	// Check if shoot generation was changed mid-air, i.e., whether we need to wait for the next reconciliation until we
 	// can safely remove the task annotations to ensure all required tasks are executed.
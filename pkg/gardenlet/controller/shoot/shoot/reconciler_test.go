@@ -6,6 +6,7 @@ package shoot
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -20,6 +21,8 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 )
 
 var _ = Describe("Reconciler", func() {
@@ -243,4 +246,112 @@ var _ = Describe("Reconciler", func() {
 			Expect(shoot.Status.Credentials.Rotation.ServiceAccountKey.LastInitiationFinishedTime.UTC()).To(Equal(fakeClock.Now()))
 		})
 	})
+
+	Describe("#resumableTaskInputChecksum", func() {
+		var (
+			executed []string
+			failWait bool
+			nodes    string
+
+			runFlow func() error
+		)
+
+		BeforeEach(func() {
+			gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+			executed = nil
+			failWait = true
+			shoot.Generation = 1
+			nodes = "10.250.0.0/16"
+
+			runFlow = func() error {
+				var (
+					// networks is populated during the flow, similar to the networks of the Shoot which are determined
+					// after the infrastructure has been reconciled
+					networks *string
+
+					g    = flow.NewGraph("Shoot cluster reconciliation")
+					task = func(name string) flow.TaskFn {
+						return func(_ context.Context) error {
+							executed = append(executed, name)
+							return nil
+						}
+					}
+
+					deployInfrastructure = g.Add(flow.Task{
+						Name: "Deploying Shoot infrastructure",
+						Fn: func(_ context.Context) error {
+							executed = append(executed, "infrastructure")
+							networks = &nodes
+							return nil
+						},
+					})
+					deployShootNamespaces = g.Add(flow.Task{
+						Name:          "Deploying shoot namespaces system component",
+						Fn:            task("namespaces"),
+						InputChecksum: resumableTaskInputChecksum(shoot.Generation, "1.2.3", nil),
+						Dependencies:  flow.NewTaskIDs(deployInfrastructure),
+					})
+					waitUntilShootNamespacesReady = g.Add(flow.Task{
+						Name:         "Waiting until shoot namespaces have been reconciled",
+						Fn:           task("wait-namespaces"),
+						Dependencies: flow.NewTaskIDs(deployShootNamespaces),
+					})
+					deployNetwork = g.Add(flow.Task{
+						Name: "Deploying shoot network plugin",
+						Fn:   task("network"),
+						InputChecksum: resumableTaskInputChecksum(shoot.Generation, "1.2.3", func() []any {
+							return []any{networks}
+						}),
+						Dependencies: flow.NewTaskIDs(waitUntilShootNamespacesReady),
+					})
+					_ = g.Add(flow.Task{
+						Name: "Waiting until shoot network plugin has been reconciled",
+						Fn: func(_ context.Context) error {
+							executed = append(executed, "wait-network")
+							if failWait {
+								return fmt.Errorf("fake error")
+							}
+							return nil
+						},
+						Dependencies: flow.NewTaskIDs(deployNetwork),
+					})
+				)
+
+				return g.Compile().Run(ctx, flow.Opts{StateStore: shootstate.NewFlowStateStore(gardenClient, shoot)})
+			}
+		})
+
+		It("should skip the succeeded deploy tasks when the flow is resumed with unchanged inputs", func() {
+			Expect(runFlow()).To(HaveOccurred())
+			Expect(executed).To(Equal([]string{"infrastructure", "namespaces", "wait-namespaces", "network", "wait-network"}))
+
+			executed = nil
+			failWait = false
+			Expect(runFlow()).To(Succeed())
+			Expect(executed).To(Equal([]string{"infrastructure", "wait-namespaces", "wait-network"}))
+
+			By("Execute all tasks again after the flow has succeeded")
+			executed = nil
+			Expect(runFlow()).To(Succeed())
+			Expect(executed).To(Equal([]string{"infrastructure", "namespaces", "wait-namespaces", "network", "wait-network"}))
+		})
+
+		It("should execute the deploy tasks again when the generation of the Shoot has changed", func() {
+			Expect(runFlow()).To(HaveOccurred())
+
+			executed = nil
+			shoot.Generation++
+			Expect(runFlow()).To(HaveOccurred())
+			Expect(executed).To(Equal([]string{"infrastructure", "namespaces", "wait-namespaces", "network", "wait-network"}))
+		})
+
+		It("should execute a deploy task again when its component inputs populated during the flow have changed", func() {
+			Expect(runFlow()).To(HaveOccurred())
+
+			executed = nil
+			nodes = "10.251.0.0/16"
+			Expect(runFlow()).To(HaveOccurred())
+			Expect(executed).To(Equal([]string{"infrastructure", "wait-namespaces", "network", "wait-network"}))
+		})
+	})
 })
@@ -26,5 +26,6 @@ func GetFeatures() []featuregate.Feature {
 		features.OpenTelemetryCollector,
 		features.UseUnifiedHTTPProxyPort,
 		features.VPAInPlaceUpdates,
+		features.StagedOperatingSystemConfigRollout,
 	}
 }
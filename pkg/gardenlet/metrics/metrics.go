@@ -29,4 +29,20 @@ var (
 			"hibernated",
 		},
 	)
+	// ShootCertificateExpirationTimestampSeconds defines the gauge shoot_certificate_expiration_timestamp_seconds.
+	ShootCertificateExpirationTimestampSeconds = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "shoot_certificate_expiration_timestamp_seconds",
+			Help:      "Expiration time of the certificates managed for shoot clusters as unix timestamp in seconds.",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"certificate",
+			"secret",
+			"ca_chain",
+			"rotation_phase",
+		},
+	)
 )
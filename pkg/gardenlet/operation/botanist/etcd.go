@@ -6,6 +6,7 @@ package botanist
 
 import (
 	"context"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -31,7 +32,7 @@ var NewEtcd = etcd.New
 
 // DefaultEtcd returns a deployer for the etcd.
 func (b *Botanist) DefaultEtcd(role string, class etcd.Class) (etcd.Interface, error) {
-	defragmentationSchedule, err := determineDefragmentationSchedule(b.Shoot.GetInfo(), b.ManagedSeed, class)
+	defragmentationSchedule, err := determineDefragmentationSchedule(b.Shoot.GetInfo(), b.ManagedSeed, class, b.Clock.Now())
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +104,7 @@ func (b *Botanist) DeployEtcd(ctx context.Context) error {
 			return err
 		}
 
-		snapshotSchedule, err := determineBackupSchedule(b.Shoot.GetInfo())
+		snapshotSchedule, err := determineBackupSchedule(b.Shoot.GetInfo(), b.Clock.Now())
 		if err != nil {
 			return err
 		}
@@ -253,18 +254,20 @@ func (b *Botanist) restoreMultiNodeEtcd(ctx context.Context) error {
 	)(ctx)
 }
 
-func determineBackupSchedule(shoot *gardencorev1beta1.Shoot) (string, error) {
+func determineBackupSchedule(shoot *gardencorev1beta1.Shoot, now time.Time) (string, error) {
 	return timewindow.DetermineSchedule(
 		"%d %d * * *",
 		shoot.Spec.Maintenance.TimeWindow.Begin,
 		shoot.Spec.Maintenance.TimeWindow.End,
 		shoot.Status.UID,
 		shoot.CreationTimestamp,
+		now,
 		timewindow.RandomizeWithinFirstHourOfTimeWindow,
+		v1beta1helper.MaintenanceTimeWindowParseOptions(shoot.Spec.Maintenance.TimeWindow)...,
 	)
 }
 
-func determineDefragmentationSchedule(shoot *gardencorev1beta1.Shoot, managedSeed *seedmanagementv1alpha1.ManagedSeed, class etcd.Class) (string, error) {
+func determineDefragmentationSchedule(shoot *gardencorev1beta1.Shoot, managedSeed *seedmanagementv1alpha1.ManagedSeed, class etcd.Class, now time.Time) (string, error) {
 	scheduleFormat := "%d %d */3 * *"
 	if managedSeed != nil && class == etcd.ClassImportant {
 		// defrag important etcds of ManagedSeeds daily in the maintenance window
@@ -277,7 +280,9 @@ func determineDefragmentationSchedule(shoot *gardencorev1beta1.Shoot, managedSee
 		shoot.Spec.Maintenance.TimeWindow.End,
 		shoot.Status.UID,
 		shoot.CreationTimestamp,
+		now,
 		timewindow.RandomizeWithinTimeWindow,
+		v1beta1helper.MaintenanceTimeWindowParseOptions(shoot.Spec.Maintenance.TimeWindow)...,
 	)
 }
 
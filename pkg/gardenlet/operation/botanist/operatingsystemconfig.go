@@ -10,9 +10,12 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/component-base/version"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/imagevector"
@@ -23,6 +26,8 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
 	nodelocaldnsconstants "github.com/gardener/gardener/pkg/component/networking/nodelocaldns/constants"
+	"github.com/gardener/gardener/pkg/features"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -59,6 +64,8 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 		openTelemetryCollectorLogShipperEnabled, openTelemetryIngressHost = true, b.ComputeOpenTelemetryCollectorHost()
 	}
 
+	nodeAgentConfig := gardenlethelper.GetNodeAgentConfiguration(b.Config)
+
 	return operatingsystemconfig.New(
 		b.Logger,
 		b.SeedClientSet.Client(),
@@ -82,6 +89,9 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 				NodeMonitorGracePeriod:                  *b.Shoot.GetInfo().Spec.Kubernetes.KubeControllerManager.NodeMonitorGracePeriod,
 				PrimaryIPFamily:                         b.Shoot.GetInfo().Spec.Networking.IPFamilies[0],
 				KubeProxyConfig:                         b.Shoot.GetInfo().Spec.Kubernetes.KubeProxy,
+				NodeAgentHealthCheck:                    nodeAgentConfig.HealthCheck,
+				NodeAgentRollback:                       nodeAgentConfig.Rollback,
+				NodeAgentDriftDetection:                 nodeAgentConfig.DriftDetection,
 			},
 		},
 		operatingsystemconfig.DefaultInterval,
@@ -268,6 +278,22 @@ func (b *Botanist) generateOperatingSystemConfigSecretForWorker(
 		return nil, fmt.Errorf("failed computing the OperatingSystemConfig secret for gardener-node-agent for pool %q: %w", worker.Name, err)
 	}
 
+	// Changes which require rolling the nodes are rolled out by machine-controller-manager, and in-place updates are
+	// coordinated separately. All other changes are applied by gardener-node-agent right away, hence gardener-resource-manager
+	// permits only `maxUnavailable` nodes of the pool at the same time to apply them. If the rollout is coordinated by the
+	// caller, gardener-resource-manager must not permit any nodes, otherwise the nodes would be permitted twice.
+	if !v1beta1helper.IsUpdateStrategyInPlace(worker.UpdateStrategy) {
+		coordinatedExternally := b.StagedOperatingSystemConfigRolloutWorkerPools.Has(worker.Name)
+
+		if features.DefaultFeatureGate.Enabled(features.StagedOperatingSystemConfigRollout) || coordinatedExternally {
+			maxUnavailable := stagedRolloutMaxUnavailable(worker)
+			metav1.SetMetaDataAnnotation(&oscSecret.ObjectMeta, v1beta1constants.AnnotationNodeAgentRolloutMaxUnavailable, maxUnavailable.String())
+		}
+		if coordinatedExternally {
+			metav1.SetMetaDataAnnotation(&oscSecret.ObjectMeta, v1beta1constants.AnnotationNodeAgentRolloutCoordinatedExternally, "true")
+		}
+	}
+
 	resources, err := managedresources.
 		NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer).
 		AddAllAndSerialize(oscSecret)
@@ -277,3 +303,28 @@ func (b *Botanist) generateOperatingSystemConfigSecretForWorker(
 
 	return resources, nil
 }
+
+// stagedRolloutMaxUnavailable returns how many nodes of the given worker pool may apply a new operating system config
+// at the same time in a staged rollout. Like for rolling updates, this is `maxUnavailable`. However, it defaults to
+// zero since rolling updates surge new machines, hence `maxSurge` is used instead in this case.
+func stagedRolloutMaxUnavailable(worker gardencorev1beta1.Worker) intstr.IntOrString {
+	maxUnavailable := ptr.Deref(worker.MaxUnavailable, gardencorev1beta1.DefaultWorkerMaxUnavailable)
+	if value, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, 100, false); err == nil && value == 0 {
+		return ptr.Deref(worker.MaxSurge, gardencorev1beta1.DefaultWorkerMaxSurge)
+	}
+	return maxUnavailable
+}
+
+// stagedRolloutStages returns how many stages are needed at most to roll out a new operating system config to all
+// nodes of the given worker pool.
+func stagedRolloutStages(worker gardencorev1beta1.Worker) int {
+	maxUnavailable := stagedRolloutMaxUnavailable(worker)
+	perStage, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, int(worker.Maximum), false)
+	if err != nil {
+		perStage = 1
+	}
+	// gardener-resource-manager permits at least one node at the same time
+	perStage = max(perStage, 1)
+
+	return (int(worker.Maximum) + perStage - 1) / perStage
+}
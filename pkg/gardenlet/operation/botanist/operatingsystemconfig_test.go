@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
@@ -18,7 +19,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -30,6 +33,7 @@ import (
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
 	mockoperatingsystemconfig "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/mock"
+	"github.com/gardener/gardener/pkg/features"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
@@ -436,6 +440,76 @@ var _ = Describe("operatingsystemconfig", func() {
 					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(oldSecret1), oldSecret1)).To(BeNotFoundError())
 					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(oldSecret2), oldSecret2)).To(BeNotFoundError())
 				})
+
+				Context("staged rollout", func() {
+					secretAnnotations := func(key string) map[string]string {
+						secretList := &corev1.SecretList{}
+						Expect(fakeClient.List(ctx, secretList, client.InNamespace(namespace), client.MatchingLabels{"managed-resource": "shoot-gardener-node-agent"})).To(Succeed())
+
+						annotations := map[string]string{}
+						for _, secret := range secretList.Items {
+							if secret.Data["data.yaml.br"] == nil || strings.Contains(secret.Name, "-rbac-") {
+								continue
+							}
+
+							raw, err := test.BrotliDecompression(secret.Data["data.yaml.br"])
+							Expect(err).NotTo(HaveOccurred())
+
+							oscSecret := &corev1.Secret{}
+							Expect(runtime.DecodeInto(kubernetes.ShootCodec.UniversalDecoder(), raw, oscSecret)).To(Succeed())
+							annotations[oscSecret.Name] = oscSecret.Annotations[key]
+						}
+
+						return annotations
+					}
+
+					It("should annotate the operating system config secrets for a staged rollout", func() {
+						DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.StagedOperatingSystemConfigRollout, true))
+
+						Expect(botanist.DeployManagedResourceForGardenerNodeAgent(ctx)).To(Succeed())
+
+						Expect(secretAnnotations("node-agent.gardener.cloud/rollout-max-unavailable")).To(Equal(map[string]string{
+							worker1Key: "1",
+							worker2Key: "1",
+						}))
+						Expect(secretAnnotations("node-agent.gardener.cloud/rollout-coordinated-externally")).To(Equal(map[string]string{
+							worker1Key: "",
+							worker2Key: "",
+						}))
+					})
+
+					It("should use maxUnavailable or fall back to maxSurge if it is zero", func() {
+						DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.StagedOperatingSystemConfigRollout, true))
+
+						shoot := botanist.Shoot.GetInfo().DeepCopy()
+						shoot.Spec.Provider.Workers[0].MaxSurge = ptr.To(intstr.FromInt32(3))
+						shoot.Spec.Provider.Workers[0].MaxUnavailable = ptr.To(intstr.FromString("0%"))
+						shoot.Spec.Provider.Workers[1].MaxUnavailable = ptr.To(intstr.FromString("20%"))
+						botanist.Shoot.SetInfo(shoot)
+
+						Expect(botanist.DeployManagedResourceForGardenerNodeAgent(ctx)).To(Succeed())
+
+						Expect(secretAnnotations("node-agent.gardener.cloud/rollout-max-unavailable")).To(Equal(map[string]string{
+							worker1Key: "3",
+							worker2Key: "20%",
+						}))
+					})
+
+					It("should annotate the operating system config secrets of the externally coordinated worker pools", func() {
+						botanist.StagedOperatingSystemConfigRolloutWorkerPools = sets.New(worker1Name)
+
+						Expect(botanist.DeployManagedResourceForGardenerNodeAgent(ctx)).To(Succeed())
+
+						Expect(secretAnnotations("node-agent.gardener.cloud/rollout-max-unavailable")).To(Equal(map[string]string{
+							worker1Key: "1",
+							worker2Key: "",
+						}))
+						Expect(secretAnnotations("node-agent.gardener.cloud/rollout-coordinated-externally")).To(Equal(map[string]string{
+							worker1Key: "true",
+							worker2Key: "",
+						}))
+					})
+				})
 			})
 		})
 	})
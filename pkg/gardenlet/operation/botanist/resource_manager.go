@@ -44,6 +44,7 @@ func (b *Botanist) DefaultResourceManager() (resourcemanager.Interface, error) {
 			LogLevel:                                  logger.InfoLevel,
 			LogFormat:                                 logger.FormatJSON,
 			NodeAgentReconciliationMaxDelay:           b.Shoot.OSCSyncJitterPeriod,
+			NodeAgentRolloutEnabled:                   !b.Shoot.IsWorkerless && features.DefaultFeatureGate.Enabled(features.StagedOperatingSystemConfigRollout),
 			NodeAgentAuthorizerEnabled:                true,
 			NodeAgentAuthorizerAuthorizeWithSelectors: ptr.To(gardenerutils.IsAuthorizeWithSelectorsEnabled(b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer, b.Shoot.KubernetesVersion)),
 			// TODO(shafeeqes): Remove PodTopologySpreadConstraints webhook once the
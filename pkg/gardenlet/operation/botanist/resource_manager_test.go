@@ -146,6 +146,15 @@ var _ = Describe("ResourceManager", func() {
 			Expect(resourceManager.GetValues().NodeAgentAuthorizerAuthorizeWithSelectors).To(PointTo(Equal(true)))
 		})
 
+		It("should successfully set NodeAgentRolloutEnabled=true if StagedOperatingSystemConfigRollout feature gate is enabled", func() {
+			DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.StagedOperatingSystemConfigRollout, true))
+
+			resourceManager, err := botanist.DefaultResourceManager()
+			Expect(resourceManager).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resourceManager.GetValues().NodeAgentRolloutEnabled).To(BeTrue())
+		})
+
 		When("VPAInPlaceUpdates feature gate is enabled", func() {
 			BeforeEach(func() {
 				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.VPAInPlaceUpdates, true))
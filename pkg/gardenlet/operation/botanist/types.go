@@ -5,10 +5,17 @@
 package botanist
 
 import (
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 )
 
 // Botanist is a struct which has methods that perform cloud-independent operations for a Shoot cluster.
 type Botanist struct {
 	*operation.Operation
+
+	// StagedOperatingSystemConfigRolloutWorkerPools contains the names of worker pools whose operating system config
+	// changes are rolled out in a staged manner coordinated by the caller (e.g., `gardenadm upgrade apply`) instead of
+	// gardener-resource-manager, independent of the StagedOperatingSystemConfigRollout feature gate.
+	StagedOperatingSystemConfigRolloutWorkerPools sets.Set[string]
 }
@@ -19,6 +19,7 @@ import (
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/component/extensions/worker"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/features"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
@@ -188,8 +189,28 @@ var (
 	GetTimeoutWaitOperatingSystemConfigUpdated = getTimeoutWaitOperatingSystemConfigUpdated
 )
 
+// maxTimeoutWaitOperatingSystemConfigUpdatedStaged is the maximum timeout when waiting until the operating system config
+// was updated in a staged rollout. It prevents the shoot flow from being blocked for hours for large worker pools. The
+// rollout itself is driven by gardener-resource-manager and continues independently of the shoot flow.
+const maxTimeoutWaitOperatingSystemConfigUpdatedStaged = 30 * time.Minute
+
 func getTimeoutWaitOperatingSystemConfigUpdated(shoot *shootpkg.Shoot) time.Duration {
-	return shoot.OSCSyncJitterPeriod.Duration + controllerutils.DefaultReconciliationTimeout
+	var (
+		timeout = shoot.OSCSyncJitterPeriod.Duration + controllerutils.DefaultReconciliationTimeout
+		stages  = 1
+	)
+
+	// In a staged rollout, only `maxUnavailable` nodes of a worker pool apply the operating system config at the same
+	// time, while the worker pools are rolled out in parallel.
+	if features.DefaultFeatureGate.Enabled(features.StagedOperatingSystemConfigRollout) {
+		for _, worker := range shoot.GetInfo().Spec.Provider.Workers {
+			if !v1beta1helper.IsUpdateStrategyInPlace(worker.UpdateStrategy) {
+				stages = max(stages, stagedRolloutStages(worker))
+			}
+		}
+	}
+
+	return min(time.Duration(stages)*timeout, max(timeout, maxTimeoutWaitOperatingSystemConfigUpdatedStaged))
 }
 
 // WaitUntilOperatingSystemConfigUpdatedForAllWorkerPools waits for a maximum of 6 minutes until all the nodes for all
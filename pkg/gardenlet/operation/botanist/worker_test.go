@@ -16,6 +16,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -27,6 +29,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
 	mockoperatingsystemconfig "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/mock"
 	mockworker "github.com/gardener/gardener/pkg/component/extensions/worker/mock"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
@@ -367,6 +370,39 @@ var _ = Describe("Worker", func() {
 		),
 	)
 
+	Describe("#GetTimeoutWaitOperatingSystemConfigUpdated", func() {
+		var shoot *shootpkg.Shoot
+
+		BeforeEach(func() {
+			shoot = &shootpkg.Shoot{OSCSyncJitterPeriod: &metav1.Duration{Duration: 2 * time.Minute}}
+			shoot.SetInfo(&gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{
+				{Name: "pool1", Maximum: 10},
+				{Name: "pool2", Maximum: 10, MaxUnavailable: ptr.To(intstr.FromString("50%"))},
+				{Name: "pool3", Maximum: 20, UpdateStrategy: ptr.To(gardencorev1beta1.AutoInPlaceUpdate)},
+			}}}})
+		})
+
+		It("should return the timeout for applying the operating system config once", func() {
+			Expect(GetTimeoutWaitOperatingSystemConfigUpdated(shoot)).To(Equal(5 * time.Minute))
+		})
+
+		It("should consider the stages of a staged rollout", func() {
+			DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.StagedOperatingSystemConfigRollout, true))
+
+			shoot.GetInfo().Spec.Provider.Workers[0].MaxSurge = ptr.To(intstr.FromInt32(5))
+			Expect(GetTimeoutWaitOperatingSystemConfigUpdated(shoot)).To(Equal(2 * 5 * time.Minute))
+		})
+
+		It("should cap the timeout of a staged rollout", func() {
+			DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.StagedOperatingSystemConfigRollout, true))
+
+			Expect(GetTimeoutWaitOperatingSystemConfigUpdated(shoot)).To(Equal(30 * time.Minute))
+
+			shoot.OSCSyncJitterPeriod.Duration = time.Hour
+			Expect(GetTimeoutWaitOperatingSystemConfigUpdated(shoot)).To(Equal(time.Hour + 3*time.Minute))
+		})
+	})
+
 	Describe("#WaitUntilOperatingSystemConfigUpdatedForAllWorkerPools", func() {
 		var (
 			seedInterface  *kubernetesmock.MockInterface
@@ -6,8 +6,10 @@ package healthz
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
@@ -32,3 +34,29 @@ func NewAPIServerHealthz(ctx context.Context, restClient rest.Interface) healthz
 		return nil
 	}
 }
+
+// DelegatingChecker is a health checker which delegates to another checker that is only known later, e.g., once the
+// connection to a cluster has been established. It fails as long as no checker is set.
+type DelegatingChecker struct {
+	mutex   sync.RWMutex
+	checker healthz.Checker
+}
+
+// Set sets the checker to delegate to.
+func (d *DelegatingChecker) Set(checker healthz.Checker) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.checker = checker
+}
+
+// Check executes the checker set last. It returns an error if no checker was set yet.
+func (d *DelegatingChecker) Check(req *http.Request) error {
+	d.mutex.RLock()
+	checker := d.checker
+	d.mutex.RUnlock()
+
+	if checker == nil {
+		return errors.New("not initialized yet")
+	}
+	return checker(req)
+}
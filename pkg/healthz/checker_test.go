@@ -57,4 +57,20 @@ var _ = Describe("Checker", func() {
 			Expect(checker(nil)).To(MatchError(ContainSubstring("failed talking to the source cluster's kube-apiserver")))
 		})
 	})
+
+	Describe("DelegatingChecker", func() {
+		It("should fail as long as no checker is set", func() {
+			d := &DelegatingChecker{}
+			Expect(d.Check(nil)).To(MatchError(ContainSubstring("not initialized yet")))
+		})
+
+		It("should delegate to the checker set last", func() {
+			d := &DelegatingChecker{}
+			d.Set(func(_ *http.Request) error { return errors.New("fake") })
+			Expect(d.Check(nil)).To(MatchError("fake"))
+
+			d.Set(func(_ *http.Request) error { return nil })
+			Expect(d.Check(nil)).To(Succeed())
+		})
+	})
 })
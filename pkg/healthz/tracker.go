@@ -0,0 +1,343 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthz
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+)
+
+const (
+	// VerbosePath is the path of the endpoint serving the detailed health status of a component.
+	VerbosePath = "/healthz/verbose"
+
+	// CheckTypeHealthz is the type of checks registered for the liveness probe.
+	CheckTypeHealthz = "healthz"
+	// CheckTypeReadyz is the type of checks registered for the readiness probe.
+	CheckTypeReadyz = "readyz"
+
+	// DefaultControllerSampleInterval is the default interval in which the metrics of the controllers are sampled.
+	DefaultControllerSampleInterval = 30 * time.Second
+	// DefaultControllerStuckThreshold is the default duration after which a controller is considered stuck, i.e., if a
+	// reconciliation runs for longer or if its queue is not empty but no reconciliation finished for longer.
+	DefaultControllerStuckThreshold = 15 * time.Minute
+
+	metricReconcileTotal              = "controller_runtime_reconcile_total"
+	metricWorkqueueDepth              = "workqueue_depth"
+	metricWorkqueueLongestRunning     = "workqueue_longest_running_processor_seconds"
+	labelController                   = "controller"
+	labelResult                       = "result"
+	reconcileResultError              = "error"
+	reasonLongRunningReconcile        = "reconciliation is running for longer than the threshold"
+	reasonNoReconcileForNonEmptyQueue = "queue is not empty, but no reconciliation finished within the threshold"
+)
+
+// Status is the detailed health status of a component.
+type Status struct {
+	// Healthy is true if all health checks pass and no controller is stuck.
+	Healthy bool `json:"healthy"`
+	// Ready is true if all readiness checks pass.
+	Ready bool `json:"ready"`
+	// Checks are the statuses of the registered health and readiness checks.
+	Checks []CheckStatus `json:"checks"`
+	// Controllers are the statuses of the controllers running in the component.
+	Controllers []ControllerStatus `json:"controllers,omitempty"`
+}
+
+// CheckStatus is the status of a health or readiness check.
+type CheckStatus struct {
+	// Name is the name of the check.
+	Name string `json:"name"`
+	// Type is the type of the check, i.e., "healthz" or "readyz".
+	Type string `json:"type"`
+	// Healthy is true if the last execution of the check passed.
+	Healthy bool `json:"healthy"`
+	// LastCheckTime is the time of the last execution of the check.
+	LastCheckTime *time.Time `json:"lastCheckTime,omitempty"`
+	// LastSuccessTime is the time of the last successful execution of the check.
+	LastSuccessTime *time.Time `json:"lastSuccessTime,omitempty"`
+	// LastErrorTime is the time of the last failed execution of the check.
+	LastErrorTime *time.Time `json:"lastErrorTime,omitempty"`
+}
+
+// ControllerStatus is the status of a controller.
+type ControllerStatus struct {
+	// Name is the name of the controller.
+	Name string `json:"name"`
+	// QueueDepth is the number of items waiting in the queue of the controller.
+	QueueDepth int64 `json:"queueDepth"`
+	// LongestRunningReconcile is the duration of the longest currently running reconciliation.
+	LongestRunningReconcile string `json:"longestRunningReconcile,omitempty"`
+	// Reconciles is the total number of finished reconciliations.
+	Reconciles int64 `json:"reconciles"`
+	// ReconcileErrors is the total number of failed reconciliations.
+	ReconcileErrors int64 `json:"reconcileErrors"`
+	// LastReconcileTime is the time at which a finished reconciliation was observed last.
+	LastReconcileTime *time.Time `json:"lastReconcileTime,omitempty"`
+	// LastSuccessfulReconcileTime is the time at which a successfully finished reconciliation was observed last.
+	LastSuccessfulReconcileTime *time.Time `json:"lastSuccessfulReconcileTime,omitempty"`
+	// Stuck is true if the controller seems to not make any progress.
+	Stuck bool `json:"stuck"`
+	// StuckReason is the reason why the controller is considered stuck.
+	StuckReason string `json:"stuckReason,omitempty"`
+}
+
+// Tracker records the results of health and readiness checks and samples the metrics of the controllers of a
+// component. It serves the aggregated, detailed health status as JSON, see VerbosePath. The checks are not executed
+// when the status is served, instead the results of their last execution (i.e., of the last liveness or readiness
+// probe) are reported.
+type Tracker struct {
+	clock    clock.WithTicker
+	gatherer prometheus.Gatherer
+	started  time.Time
+
+	// SampleInterval is the interval in which the metrics of the controllers are sampled.
+	SampleInterval time.Duration
+	// StuckThreshold is the duration after which a controller is considered stuck.
+	StuckThreshold time.Duration
+
+	mu          sync.Mutex
+	checks      []*trackedCheck
+	controllers map[string]*ControllerStatus
+}
+
+type trackedCheck struct {
+	status CheckStatus
+}
+
+// NewTracker returns a new Tracker. The metrics of the controllers are read from the given gatherer, usually the
+// controller-runtime metrics registry.
+func NewTracker(clock clock.WithTicker, gatherer prometheus.Gatherer) *Tracker {
+	return &Tracker{
+		clock:          clock,
+		gatherer:       gatherer,
+		started:        clock.Now(),
+		SampleInterval: DefaultControllerSampleInterval,
+		StuckThreshold: DefaultControllerStuckThreshold,
+		controllers:    map[string]*ControllerStatus{},
+	}
+}
+
+// Healthz returns a healthz.Checker for the liveness probe which records the results of the given checker.
+func (t *Tracker) Healthz(name string, checker healthz.Checker) healthz.Checker {
+	return t.track(name, CheckTypeHealthz, checker)
+}
+
+// Readyz returns a healthz.Checker for the readiness probe which records the results of the given checker.
+func (t *Tracker) Readyz(name string, checker healthz.Checker) healthz.Checker {
+	return t.track(name, CheckTypeReadyz, checker)
+}
+
+func (t *Tracker) track(name, checkType string, checker healthz.Checker) healthz.Checker {
+	check := &trackedCheck{status: CheckStatus{Name: name, Type: checkType}}
+
+	t.mu.Lock()
+	t.checks = append(t.checks, check)
+	t.mu.Unlock()
+
+	return func(req *http.Request) error {
+		err := checker(req)
+		t.record(check, err)
+		return err
+	}
+}
+
+func (t *Tracker) record(check *trackedCheck, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.clock.Now()
+	check.status.LastCheckTime = &now
+	check.status.Healthy = err == nil
+	if err != nil {
+		check.status.LastErrorTime = &now
+	} else {
+		check.status.LastSuccessTime = &now
+	}
+}
+
+// Start samples the metrics of the controllers periodically until the context is cancelled. It implements
+// manager.Runnable.
+func (t *Tracker) Start(ctx context.Context) error {
+	ticker := t.clock.NewTicker(t.SampleInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := t.sampleControllers(); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C():
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. The tracker runs on all replicas.
+func (t *Tracker) NeedLeaderElection() bool {
+	return false
+}
+
+func (t *Tracker) sampleControllers() error {
+	families, err := t.gatherer.Gather()
+	if err != nil {
+		return err
+	}
+
+	type sample struct {
+		depth, reconciles, errors int64
+		longestRunning            float64
+	}
+	samples := map[string]*sample{}
+	get := func(controller string) *sample {
+		if _, ok := samples[controller]; !ok {
+			samples[controller] = &sample{}
+		}
+		return samples[controller]
+	}
+
+	for _, family := range families {
+		switch family.GetName() {
+		case metricReconcileTotal:
+			for _, m := range family.GetMetric() {
+				s := get(labelValue(m, labelController))
+				value := int64(m.GetCounter().GetValue())
+				s.reconciles += value
+				if labelValue(m, labelResult) == reconcileResultError {
+					s.errors += value
+				}
+			}
+		case metricWorkqueueDepth:
+			for _, m := range family.GetMetric() {
+				if controller := labelValue(m, labelController); controller != "" {
+					get(controller).depth += int64(m.GetGauge().GetValue())
+				}
+			}
+		case metricWorkqueueLongestRunning:
+			for _, m := range family.GetMetric() {
+				if controller := labelValue(m, labelController); controller != "" {
+					get(controller).longestRunning = max(get(controller).longestRunning, m.GetGauge().GetValue())
+				}
+			}
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.clock.Now()
+	for name, s := range samples {
+		status, ok := t.controllers[name]
+		if !ok {
+			status = &ControllerStatus{Name: name}
+			t.controllers[name] = status
+		}
+
+		// Reconciliations which finished before the tracker observed the controller for the first time are accounted to
+		// the first sample.
+		if s.reconciles > status.Reconciles {
+			status.LastReconcileTime = &now
+		}
+		if s.reconciles-s.errors > status.Reconciles-status.ReconcileErrors {
+			status.LastSuccessfulReconcileTime = &now
+		}
+
+		status.QueueDepth = s.depth
+		status.Reconciles = s.reconciles
+		status.ReconcileErrors = s.errors
+		status.LongestRunningReconcile = ""
+		if s.longestRunning > 0 {
+			status.LongestRunningReconcile = (time.Duration(s.longestRunning * float64(time.Second))).Round(time.Second).String()
+		}
+
+		status.Stuck, status.StuckReason = false, ""
+		lastProgress := t.started
+		if status.LastReconcileTime != nil {
+			lastProgress = *status.LastReconcileTime
+		}
+
+		switch {
+		case time.Duration(s.longestRunning*float64(time.Second)) > t.StuckThreshold:
+			status.Stuck, status.StuckReason = true, reasonLongRunningReconcile
+		case s.depth > 0 && now.Sub(lastProgress) > t.StuckThreshold:
+			status.Stuck, status.StuckReason = true, reasonNoReconcileForNonEmptyQueue
+		}
+	}
+
+	return nil
+}
+
+func labelValue(m *dto.Metric, name string) string {
+	for _, label := range m.GetLabel() {
+		if label.GetName() == name {
+			return label.GetValue()
+		}
+	}
+	return ""
+}
+
+// Status returns the detailed health status based on the last results of the registered checks. Checks which were not
+// executed yet are considered failing.
+func (t *Tracker) Status() Status {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	status := Status{Healthy: true, Ready: true}
+
+	for _, check := range t.checks {
+		status.Checks = append(status.Checks, check.status)
+		if !check.status.Healthy {
+			switch check.status.Type {
+			case CheckTypeHealthz:
+				status.Healthy = false
+			case CheckTypeReadyz:
+				status.Ready = false
+			}
+		}
+	}
+
+	for _, controller := range t.controllers {
+		status.Controllers = append(status.Controllers, *controller)
+		if controller.Stuck {
+			status.Healthy = false
+		}
+	}
+	slices.SortFunc(status.Controllers, func(a, b ControllerStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return status
+}
+
+// ServeHTTP serves the detailed health status as JSON. The status code is 200 if the component is healthy and ready,
+// otherwise it is 503. Like the metrics, the status is served without authentication, hence, it must not contain
+// sensitive data (e.g., the error messages of failed checks).
+func (t *Tracker) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	status := t.Status()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if status.Healthy && status.Ready {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(status)
+}
@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthz_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/healthz"
+)
+
+var _ = Describe("Tracker", func() {
+	var (
+		fakeClock *testclock.FakeClock
+		registry  *prometheus.Registry
+		tracker   *Tracker
+
+		reconcileTotal *prometheus.CounterVec
+		depth          *prometheus.GaugeVec
+		longestRunning *prometheus.GaugeVec
+
+		req *http.Request
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+		registry = prometheus.NewRegistry()
+		tracker = NewTracker(fakeClock, registry)
+
+		reconcileTotal = prometheus.NewCounterVec(prometheus.CounterOpts{Name: "controller_runtime_reconcile_total"}, []string{"controller", "result"})
+		depth = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "workqueue_depth"}, []string{"name", "controller", "priority"})
+		longestRunning = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "workqueue_longest_running_processor_seconds"}, []string{"name", "controller"})
+		registry.MustRegister(reconcileTotal, depth, longestRunning)
+
+		req = httptest.NewRequest(http.MethodGet, VerbosePath, nil)
+	})
+
+	Describe("checks", func() {
+		It("should record the results of the wrapped checkers", func() {
+			var err error
+			checker := tracker.Healthz("foo", func(_ *http.Request) error { return err })
+
+			Expect(checker(req)).To(Succeed())
+			successTime := fakeClock.Now()
+
+			fakeClock.Step(time.Minute)
+			err = errors.New("fake")
+			Expect(checker(req)).To(MatchError("fake"))
+
+			Expect(tracker.Status().Checks).To(ConsistOf(And(
+				HaveField("Name", "foo"),
+				HaveField("Type", CheckTypeHealthz),
+				HaveField("Healthy", false),
+				HaveField("LastSuccessTime", HaveValue(Equal(successTime))),
+				HaveField("LastErrorTime", HaveValue(Equal(fakeClock.Now()))),
+			)))
+		})
+
+		It("should distinguish liveness and readiness checks", func() {
+			Expect(tracker.Healthz("live", func(_ *http.Request) error { return nil })(req)).To(Succeed())
+			Expect(tracker.Readyz("ready", func(_ *http.Request) error { return errors.New("not ready") })(req)).NotTo(Succeed())
+
+			status := tracker.Status()
+			Expect(status.Healthy).To(BeTrue())
+			Expect(status.Ready).To(BeFalse())
+		})
+
+		It("should report the result of the last execution instead of executing the checks", func() {
+			executions := 0
+			checker := tracker.Healthz("foo", func(_ *http.Request) error {
+				executions++
+				return nil
+			})
+
+			Expect(checker(req)).To(Succeed())
+			Expect(tracker.Status().Checks).To(ConsistOf(HaveField("Healthy", true)))
+			Expect(tracker.Status().Healthy).To(BeTrue())
+			Expect(executions).To(Equal(1))
+		})
+
+		It("should consider checks failing which were not executed yet", func() {
+			tracker.Healthz("live", func(_ *http.Request) error { return nil })
+			tracker.Readyz("ready", func(_ *http.Request) error { return nil })
+
+			status := tracker.Status()
+			Expect(status.Healthy).To(BeFalse())
+			Expect(status.Ready).To(BeFalse())
+			Expect(status.Checks).To(ConsistOf(
+				HaveField("LastCheckTime", BeNil()),
+				HaveField("LastCheckTime", BeNil()),
+			))
+		})
+	})
+
+	Describe("controllers", func() {
+		sample := func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			ExpectWithOffset(1, tracker.Start(ctx)).To(Succeed())
+		}
+
+		It("should report the reconciliations of the controllers", func() {
+			reconcileTotal.WithLabelValues("foo", "success").Add(3)
+			reconcileTotal.WithLabelValues("foo", "error").Add(1)
+			depth.WithLabelValues("foo", "foo", "").Set(2)
+			longestRunning.WithLabelValues("foo", "foo").Set(90)
+			sample()
+			firstSample := fakeClock.Now()
+
+			fakeClock.Step(time.Minute)
+			reconcileTotal.WithLabelValues("foo", "error").Inc()
+			sample()
+
+			Expect(tracker.Status().Controllers).To(ConsistOf(ControllerStatus{
+				Name:                        "foo",
+				QueueDepth:                  2,
+				LongestRunningReconcile:     "1m30s",
+				Reconciles:                  5,
+				ReconcileErrors:             2,
+				LastReconcileTime:           ptr.To(fakeClock.Now()),
+				LastSuccessfulReconcileTime: &firstSample,
+			}))
+		})
+
+		It("should consider controllers with long-running reconciliations stuck", func() {
+			longestRunning.WithLabelValues("foo", "foo").Set((20 * time.Minute).Seconds())
+			sample()
+
+			status := tracker.Status()
+			Expect(status.Healthy).To(BeFalse())
+			Expect(status.Controllers).To(ConsistOf(And(
+				HaveField("Stuck", true),
+				HaveField("StuckReason", ContainSubstring("running for longer than the threshold")),
+			)))
+		})
+
+		It("should consider controllers stuck if their queue is not empty but no reconciliation finishes", func() {
+			depth.WithLabelValues("foo", "foo", "").Set(1)
+			sample()
+			Expect(tracker.Status().Controllers).To(ConsistOf(HaveField("Stuck", false)))
+
+			fakeClock.Step(DefaultControllerStuckThreshold + time.Second)
+			sample()
+			Expect(tracker.Status().Controllers).To(ConsistOf(And(
+				HaveField("Stuck", true),
+				HaveField("StuckReason", ContainSubstring("no reconciliation finished")),
+			)))
+
+			reconcileTotal.WithLabelValues("foo", "success").Inc()
+			sample()
+			Expect(tracker.Status().Controllers).To(ConsistOf(HaveField("Stuck", false)))
+		})
+	})
+
+	Describe("#ServeHTTP", func() {
+		It("should serve the status as JSON", func() {
+			Expect(tracker.Healthz("foo", func(_ *http.Request) error { return nil })(req)).To(Succeed())
+
+			rec := httptest.NewRecorder()
+			tracker.ServeHTTP(rec, req)
+
+			Expect(rec.Code).To(Equal(http.StatusOK))
+			Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+
+			var status Status
+			Expect(json.Unmarshal(rec.Body.Bytes(), &status)).To(Succeed())
+			Expect(status.Healthy).To(BeTrue())
+			Expect(status.Checks).To(ConsistOf(HaveField("Name", "foo")))
+		})
+
+		It("should respond with 503 if a check fails", func() {
+			Expect(tracker.Readyz("foo", func(_ *http.Request) error { return errors.New("fake") })(req)).NotTo(Succeed())
+
+			rec := httptest.NewRecorder()
+			tracker.ServeHTTP(rec, req)
+
+			Expect(rec.Code).To(Equal(http.StatusServiceUnavailable))
+		})
+
+		It("should not serve the error messages of failed checks", func() {
+			Expect(tracker.Readyz("foo", func(_ *http.Request) error { return errors.New("secret-details") })(req)).NotTo(Succeed())
+
+			rec := httptest.NewRecorder()
+			tracker.ServeHTTP(rec, req)
+
+			Expect(rec.Body.String()).To(ContainSubstring(`"lastErrorTime"`))
+			Expect(rec.Body.String()).NotTo(ContainSubstring("secret-details"))
+		})
+	})
+})
@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 )
@@ -35,6 +36,23 @@ func SetDefaults_OperatingSystemConfigControllerConfig(obj *OperatingSystemConfi
 	}
 }
 
+// SetDefaults_OperatingSystemConfigRollbackConfig sets defaults for the OperatingSystemConfigRollbackConfig object.
+func SetDefaults_OperatingSystemConfigRollbackConfig(obj *OperatingSystemConfigRollbackConfig) {
+	if obj.GracePeriod == nil {
+		obj.GracePeriod = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+}
+
+// SetDefaults_DriftDetectionConfig sets defaults for the DriftDetectionConfig object.
+func SetDefaults_DriftDetectionConfig(obj *DriftDetectionConfig) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+	if obj.DefaultPolicy == nil {
+		obj.DefaultPolicy = ptr.To(DriftPolicyReport)
+	}
+}
+
 // SetDefaults_TokenControllerConfig sets defaults for the TokenControllerConfig object.
 func SetDefaults_TokenControllerConfig(obj *TokenControllerConfig) {
 	if obj.SyncPeriod == nil {
@@ -42,6 +60,80 @@ func SetDefaults_TokenControllerConfig(obj *TokenControllerConfig) {
 	}
 }
 
+// SetDefaults_HealthCheckControllerConfig sets defaults for the HealthCheckControllerConfig object.
+func SetDefaults_HealthCheckControllerConfig(obj *HealthCheckControllerConfig) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 30 * time.Second}
+	}
+}
+
+// SetDefaults_HealthCheckerConfig sets defaults for the HealthCheckerConfig object.
+func SetDefaults_HealthCheckerConfig(obj *HealthCheckerConfig) {
+	if obj.FailureThreshold == nil {
+		obj.FailureThreshold = &metav1.Duration{Duration: time.Minute}
+	}
+
+	switch obj.Type {
+	case HealthCheckerTypeFailedSystemdUnits:
+		if obj.FailedSystemdUnits == nil {
+			obj.FailedSystemdUnits = &FailedSystemdUnitsHealthCheckerConfig{}
+		}
+	case HealthCheckerTypeDiskPressure:
+		if obj.DiskPressure == nil {
+			obj.DiskPressure = &DiskPressureHealthCheckerConfig{}
+		}
+	case HealthCheckerTypeClockSkew:
+		if obj.ClockSkew == nil {
+			obj.ClockSkew = &ClockSkewHealthCheckerConfig{}
+		}
+	case HealthCheckerTypeReadOnlyFilesystem:
+		if obj.ReadOnlyFilesystem == nil {
+			obj.ReadOnlyFilesystem = &ReadOnlyFilesystemHealthCheckerConfig{}
+		}
+	}
+}
+
+// SetDefaults_DiskPressureHealthCheckerConfig sets defaults for the DiskPressureHealthCheckerConfig object.
+func SetDefaults_DiskPressureHealthCheckerConfig(obj *DiskPressureHealthCheckerConfig) {
+	if obj.Path == "" {
+		obj.Path = "/var/lib/kubelet"
+	}
+	if obj.MinAvailablePercent == nil {
+		obj.MinAvailablePercent = ptr.To[int32](10)
+	}
+	if obj.MinInodesFreePercent == nil {
+		obj.MinInodesFreePercent = ptr.To[int32](5)
+	}
+}
+
+// SetDefaults_ClockSkewHealthCheckerConfig sets defaults for the ClockSkewHealthCheckerConfig object.
+func SetDefaults_ClockSkewHealthCheckerConfig(obj *ClockSkewHealthCheckerConfig) {
+	if obj.MaxSkew == nil {
+		obj.MaxSkew = &metav1.Duration{Duration: 10 * time.Second}
+	}
+}
+
+// SetDefaults_ReadOnlyFilesystemHealthCheckerConfig sets defaults for the ReadOnlyFilesystemHealthCheckerConfig object.
+func SetDefaults_ReadOnlyFilesystemHealthCheckerConfig(obj *ReadOnlyFilesystemHealthCheckerConfig) {
+	if len(obj.Paths) == 0 {
+		obj.Paths = []string{"/var/lib", "/etc"}
+	}
+}
+
+// SetDefaults_ExecHealthCheckerConfig sets defaults for the ExecHealthCheckerConfig object.
+func SetDefaults_ExecHealthCheckerConfig(obj *ExecHealthCheckerConfig) {
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 10 * time.Second}
+	}
+}
+
+// SetDefaults_HTTPHealthCheckerConfig sets defaults for the HTTPHealthCheckerConfig object.
+func SetDefaults_HTTPHealthCheckerConfig(obj *HTTPHealthCheckerConfig) {
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 10 * time.Second}
+	}
+}
+
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
 func SetDefaults_ClientConnectionConfiguration(obj *componentbaseconfigv1alpha1.ClientConnectionConfiguration) {
 	componentbaseconfigv1alpha1.RecommendedDefaultClientConnectionConfiguration(obj)
@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
@@ -67,6 +68,49 @@ var _ = Describe("Defaults", func() {
 				})
 			})
 
+			Describe("Operating System Config rollback", func() {
+				It("should default the object", func() {
+					obj := &OperatingSystemConfigRollbackConfig{}
+
+					SetDefaults_OperatingSystemConfigRollbackConfig(obj)
+
+					Expect(obj.GracePeriod).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+				})
+
+				It("should not overwrite existing values", func() {
+					obj := &OperatingSystemConfigRollbackConfig{
+						GracePeriod: &metav1.Duration{Duration: time.Minute},
+					}
+
+					SetDefaults_OperatingSystemConfigRollbackConfig(obj)
+
+					Expect(obj.GracePeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+				})
+			})
+
+			Describe("Drift detection", func() {
+				It("should default the object", func() {
+					obj := &DriftDetectionConfig{}
+
+					SetDefaults_DriftDetectionConfig(obj)
+
+					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+					Expect(obj.DefaultPolicy).To(PointTo(Equal(DriftPolicyReport)))
+				})
+
+				It("should not overwrite existing values", func() {
+					obj := &DriftDetectionConfig{
+						SyncPeriod:    &metav1.Duration{Duration: time.Minute},
+						DefaultPolicy: ptr.To(DriftPolicyRemediate),
+					}
+
+					SetDefaults_DriftDetectionConfig(obj)
+
+					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+					Expect(obj.DefaultPolicy).To(PointTo(Equal(DriftPolicyRemediate)))
+				})
+			})
+
 			Describe("Token controller", func() {
 				It("should default the object", func() {
 					obj := &TokenControllerConfig{}
@@ -86,6 +130,82 @@ var _ = Describe("Defaults", func() {
 					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
 				})
 			})
+
+			Describe("Health check controller", func() {
+				It("should default the object", func() {
+					obj := &HealthCheckControllerConfig{}
+
+					SetDefaults_HealthCheckControllerConfig(obj)
+
+					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Second})))
+				})
+
+				It("should not overwrite existing values", func() {
+					obj := &HealthCheckControllerConfig{
+						SyncPeriod: &metav1.Duration{Duration: time.Minute},
+					}
+
+					SetDefaults_HealthCheckControllerConfig(obj)
+
+					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+				})
+
+				It("should default the health checkers", func() {
+					obj := &NodeAgentConfiguration{
+						Controllers: ControllerConfiguration{
+							HealthCheck: &HealthCheckControllerConfig{
+								HealthCheckers: []HealthCheckerConfig{
+									{Name: "units", Type: HealthCheckerTypeFailedSystemdUnits},
+									{Name: "disk", Type: HealthCheckerTypeDiskPressure},
+									{Name: "clock", Type: HealthCheckerTypeClockSkew},
+									{Name: "fs", Type: HealthCheckerTypeReadOnlyFilesystem},
+									{Name: "exec", Type: HealthCheckerTypeExec, Exec: &ExecHealthCheckerConfig{Command: []string{"true"}}},
+									{Name: "http", Type: HealthCheckerTypeHTTP, HTTP: &HTTPHealthCheckerConfig{URL: "http://localhost"}},
+								},
+							},
+						},
+					}
+
+					SetObjectDefaults_NodeAgentConfiguration(obj)
+
+					healthCheckers := obj.Controllers.HealthCheck.HealthCheckers
+					for _, healthChecker := range healthCheckers {
+						Expect(healthChecker.FailureThreshold).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+					}
+					Expect(healthCheckers[0].FailedSystemdUnits).To(Equal(&FailedSystemdUnitsHealthCheckerConfig{}))
+					Expect(healthCheckers[1].DiskPressure).To(Equal(&DiskPressureHealthCheckerConfig{
+						Path:                 "/var/lib/kubelet",
+						MinAvailablePercent:  ptr.To[int32](10),
+						MinInodesFreePercent: ptr.To[int32](5),
+					}))
+					Expect(healthCheckers[2].ClockSkew.MaxSkew).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Second})))
+					Expect(healthCheckers[3].ReadOnlyFilesystem.Paths).To(ConsistOf("/var/lib", "/etc"))
+					Expect(healthCheckers[4].Exec.Timeout).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Second})))
+					Expect(healthCheckers[5].HTTP.Timeout).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Second})))
+				})
+
+				It("should not overwrite existing health checker values", func() {
+					obj := &HealthCheckerConfig{
+						Type:             HealthCheckerTypeDiskPressure,
+						FailureThreshold: &metav1.Duration{Duration: 5 * time.Minute},
+						DiskPressure: &DiskPressureHealthCheckerConfig{
+							Path:                 "/var/lib/containerd",
+							MinAvailablePercent:  ptr.To[int32](20),
+							MinInodesFreePercent: ptr.To[int32](15),
+						},
+					}
+
+					SetDefaults_HealthCheckerConfig(obj)
+					SetDefaults_DiskPressureHealthCheckerConfig(obj.DiskPressure)
+
+					Expect(obj.FailureThreshold).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+					Expect(obj.DiskPressure).To(Equal(&DiskPressureHealthCheckerConfig{
+						Path:                 "/var/lib/containerd",
+						MinAvailablePercent:  ptr.To[int32](20),
+						MinInodesFreePercent: ptr.To[int32](15),
+					}))
+				})
+			})
 		})
 
 		Describe("Server configuration", func() {
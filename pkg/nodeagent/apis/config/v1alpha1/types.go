@@ -30,6 +30,9 @@ const (
 	KubeconfigFilePath = CredentialsDir + "/kubeconfig"
 	// MachineNameFilePath is the file path on the worker node that contains the machine name.
 	MachineNameFilePath = BaseDir + "/machine-name"
+	// LastAppliedOperatingSystemConfigFilePath is the file path on the worker node that contains the last operating
+	// system config applied by gardener-node-agent.
+	LastAppliedOperatingSystemConfigFilePath = BaseDir + "/last-applied-osc.yaml"
 
 	// UnitName is the name of the gardener-node-agent systemd service.
 	UnitName = "gardener-node-agent.service"
@@ -45,6 +48,13 @@ const (
 	// AnnotationKeyChecksumAppliedOperatingSystemConfig is a constant for an annotation key on a Node describing the
 	// checksum of the last applied operating system configuration.
 	AnnotationKeyChecksumAppliedOperatingSystemConfig = "checksum/cloud-config-data"
+
+	// NodeConditionTypeOperatingSystemConfigRolledBack is a constant for a condition type on a Node indicating that
+	// the application of an operating system config failed and was rolled back.
+	NodeConditionTypeOperatingSystemConfigRolledBack = "OperatingSystemConfigRolledBack"
+	// NodeConditionTypeOperatingSystemConfigDrifted is a constant for a condition type on a Node indicating that files
+	// or units managed by gardener-node-agent were modified on the host.
+	NodeConditionTypeOperatingSystemConfigDrifted = "OperatingSystemConfigDrifted"
 )
 
 // OSVersionRegex is a regular expression to match operating system versions.
@@ -104,6 +114,9 @@ type ControllerConfiguration struct {
 	OperatingSystemConfig OperatingSystemConfigControllerConfig `json:"operatingSystemConfig"`
 	// Token is the configuration for the access token controller.
 	Token TokenControllerConfig `json:"token"`
+	// HealthCheck is the configuration for the health check controller.
+	// +optional
+	HealthCheck *HealthCheckControllerConfig `json:"healthCheck,omitempty"`
 }
 
 // OperatingSystemConfigControllerConfig defines the configuration of the operating system config controller.
@@ -117,6 +130,57 @@ type OperatingSystemConfigControllerConfig struct {
 	// KubernetesVersion contains the Kubernetes version of the kubelet, used for annotating the corresponding node
 	// resource with a kubernetes version annotation.
 	KubernetesVersion *semver.Version `json:"kubernetesVersion"`
+	// Rollback is the configuration for automatically rolling back to the last applied operating system config if the
+	// units restarted while applying a new one do not become healthy. If not set, no rollback is performed.
+	// +optional
+	Rollback *OperatingSystemConfigRollbackConfig `json:"rollback,omitempty"`
+	// DriftDetection is the configuration for periodically detecting modifications of the files and units managed by
+	// gardener-node-agent on the host. If not set, no drift detection is performed.
+	// +optional
+	DriftDetection *DriftDetectionConfig `json:"driftDetection,omitempty"`
+}
+
+// OperatingSystemConfigRollbackConfig defines the configuration for rolling back failed operating system configs.
+type OperatingSystemConfigRollbackConfig struct {
+	// GracePeriod is the duration the restarted units (and the kubelet) have to become healthy after a new operating
+	// system config was applied.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// DriftPolicy is the policy for handling drift of a file managed by gardener-node-agent.
+type DriftPolicy string
+
+const (
+	// DriftPolicyIgnore ignores drift of the file.
+	DriftPolicyIgnore DriftPolicy = "Ignore"
+	// DriftPolicyReport reports drift of the file via an event and the OperatingSystemConfigDrifted node condition.
+	DriftPolicyReport DriftPolicy = "Report"
+	// DriftPolicyRemediate restores the desired content and permissions of the file and restarts the affected units.
+	DriftPolicyRemediate DriftPolicy = "Remediate"
+)
+
+// DriftDetectionConfig defines the configuration for detecting drift of the files and units managed by
+// gardener-node-agent.
+type DriftDetectionConfig struct {
+	// SyncPeriod is the duration how often the files and units are checked for drift.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// DefaultPolicy is the policy for files which are not matched by any of the policies.
+	// +optional
+	DefaultPolicy *DriftPolicy `json:"defaultPolicy,omitempty"`
+	// Policies is a list of policies for specific files. The first policy matching a file is used.
+	// +optional
+	Policies []DriftPolicyRule `json:"policies,omitempty"`
+}
+
+// DriftPolicyRule defines the drift policy for a set of files.
+type DriftPolicyRule struct {
+	// Paths is a list of absolute file paths or patterns (see https://pkg.go.dev/path#Match) the policy applies to.
+	// Unit files and their drop-ins are located in /etc/systemd/system.
+	Paths []string `json:"paths"`
+	// Policy is the policy for the matched files.
+	Policy DriftPolicy `json:"policy"`
 }
 
 // TokenControllerConfig defines the configuration of the access token controller.
@@ -138,6 +202,130 @@ type TokenSecretSyncConfig struct {
 	Path string `json:"path"`
 }
 
+// HealthCheckControllerConfig defines the configuration of the health check controller.
+type HealthCheckControllerConfig struct {
+	// SyncPeriod is the duration how often the health checks are executed.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// HealthCheckers is a list of additional health checkers which are executed next to the built-in containerd and
+	// kubelet health checks.
+	// +optional
+	HealthCheckers []HealthCheckerConfig `json:"healthCheckers,omitempty"`
+}
+
+// HealthCheckerType is a type for the health checkers.
+type HealthCheckerType string
+
+const (
+	// HealthCheckerTypeFailedSystemdUnits is a health checker which reports systemd units in the 'failed' state.
+	HealthCheckerTypeFailedSystemdUnits HealthCheckerType = "FailedSystemdUnits"
+	// HealthCheckerTypeDiskPressure is a health checker which reports a lack of free disk space or inodes.
+	HealthCheckerTypeDiskPressure HealthCheckerType = "DiskPressure"
+	// HealthCheckerTypeClockSkew is a health checker which reports a skew between the node's clock and a reference clock.
+	HealthCheckerTypeClockSkew HealthCheckerType = "ClockSkew"
+	// HealthCheckerTypeReadOnlyFilesystem is a health checker which reports file systems which are not writable.
+	HealthCheckerTypeReadOnlyFilesystem HealthCheckerType = "ReadOnlyFilesystem"
+	// HealthCheckerTypeExec is a health checker which executes a command and reports a non-zero exit code.
+	HealthCheckerTypeExec HealthCheckerType = "Exec"
+	// HealthCheckerTypeHTTP is a health checker which probes an HTTP endpoint and reports a non-2xx status code.
+	HealthCheckerTypeHTTP HealthCheckerType = "HTTP"
+)
+
+// HealthCheckerConfig contains the configuration of an additional health checker.
+type HealthCheckerConfig struct {
+	// Name is the unique name of the health checker. It is used for logs and events.
+	Name string `json:"name"`
+	// Type is the type of the health checker.
+	Type HealthCheckerType `json:"type"`
+	// ConditionType is the type of the condition on the Node object which is maintained by this health checker. The
+	// condition status is 'True' while the health check is failing. If not set, the result is only reported via
+	// events.
+	// +optional
+	ConditionType *string `json:"conditionType,omitempty"`
+	// RestartUnit is the name of a systemd unit which is restarted when the health check is failing for longer than
+	// the failure threshold.
+	// +optional
+	RestartUnit *string `json:"restartUnit,omitempty"`
+	// FailureThreshold is the duration the health check must be failing before the RestartUnit is restarted.
+	// +optional
+	FailureThreshold *metav1.Duration `json:"failureThreshold,omitempty"`
+	// FailedSystemdUnits is the configuration for the health checker of type 'FailedSystemdUnits'.
+	// +optional
+	FailedSystemdUnits *FailedSystemdUnitsHealthCheckerConfig `json:"failedSystemdUnits,omitempty"`
+	// DiskPressure is the configuration for the health checker of type 'DiskPressure'.
+	// +optional
+	DiskPressure *DiskPressureHealthCheckerConfig `json:"diskPressure,omitempty"`
+	// ClockSkew is the configuration for the health checker of type 'ClockSkew'.
+	// +optional
+	ClockSkew *ClockSkewHealthCheckerConfig `json:"clockSkew,omitempty"`
+	// ReadOnlyFilesystem is the configuration for the health checker of type 'ReadOnlyFilesystem'.
+	// +optional
+	ReadOnlyFilesystem *ReadOnlyFilesystemHealthCheckerConfig `json:"readOnlyFilesystem,omitempty"`
+	// Exec is the configuration for the health checker of type 'Exec'.
+	// +optional
+	Exec *ExecHealthCheckerConfig `json:"exec,omitempty"`
+	// HTTP is the configuration for the health checker of type 'HTTP'.
+	// +optional
+	HTTP *HTTPHealthCheckerConfig `json:"http,omitempty"`
+}
+
+// FailedSystemdUnitsHealthCheckerConfig contains the configuration for the health checker of type 'FailedSystemdUnits'.
+type FailedSystemdUnitsHealthCheckerConfig struct {
+	// Units is the list of systemd unit names which are considered. If empty, all units are considered.
+	// +optional
+	Units []string `json:"units,omitempty"`
+}
+
+// DiskPressureHealthCheckerConfig contains the configuration for the health checker of type 'DiskPressure'.
+type DiskPressureHealthCheckerConfig struct {
+	// Path is a path on the file system whose volume is checked. Defaults to the kubelet data directory.
+	// +optional
+	Path string `json:"path,omitempty"`
+	// MinAvailablePercent is the minimum percentage of available disk space.
+	// +optional
+	MinAvailablePercent *int32 `json:"minAvailablePercent,omitempty"`
+	// MinInodesFreePercent is the minimum percentage of free inodes.
+	// +optional
+	MinInodesFreePercent *int32 `json:"minInodesFreePercent,omitempty"`
+}
+
+// ClockSkewHealthCheckerConfig contains the configuration for the health checker of type 'ClockSkew'.
+type ClockSkewHealthCheckerConfig struct {
+	// MaxSkew is the maximum tolerated difference between the node's clock and the reference clock.
+	// +optional
+	MaxSkew *metav1.Duration `json:"maxSkew,omitempty"`
+	// ReferenceURL is the URL of an HTTP endpoint whose 'Date' response header is used as reference clock. If not set,
+	// the kube-apiserver is used.
+	// +optional
+	ReferenceURL *string `json:"referenceURL,omitempty"`
+}
+
+// ReadOnlyFilesystemHealthCheckerConfig contains the configuration for the health checker of type 'ReadOnlyFilesystem'.
+type ReadOnlyFilesystemHealthCheckerConfig struct {
+	// Paths is the list of directories which must be writable.
+	// +optional
+	Paths []string `json:"paths,omitempty"`
+}
+
+// ExecHealthCheckerConfig contains the configuration for the health checker of type 'Exec'.
+type ExecHealthCheckerConfig struct {
+	// Command is the command line to execute. The health check fails if the command exits with a non-zero code.
+	Command []string `json:"command"`
+	// Timeout is the timeout for the command.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// HTTPHealthCheckerConfig contains the configuration for the health checker of type 'HTTP'.
+type HTTPHealthCheckerConfig struct {
+	// URL is the URL of the HTTP endpoint. The health check fails if the endpoint does not respond with a 2xx status
+	// code.
+	URL string `json:"url"`
+	// Timeout is the timeout for the HTTP request.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
 // ServerConfiguration contains details for the HTTP(S) servers.
 type ServerConfiguration struct {
 	// HealthProbes is the configuration for serving the healthz and readyz endpoints.
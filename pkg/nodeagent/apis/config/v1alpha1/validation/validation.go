@@ -5,8 +5,13 @@
 package validation
 
 import (
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -49,6 +54,10 @@ func validateControllerConfiguration(conf nodeagentconfigv1alpha1.ControllerConf
 	allErrs = append(allErrs, validateOperatingSystemConfigControllerConfiguration(conf.OperatingSystemConfig, fldPath.Child("operatingSystemConfig"))...)
 	allErrs = append(allErrs, validateTokenControllerConfiguration(conf.Token, fldPath.Child("token"))...)
 
+	if conf.HealthCheck != nil {
+		allErrs = append(allErrs, ValidateHealthCheckControllerConfiguration(*conf.HealthCheck, fldPath.Child("healthCheck"))...)
+	}
+
 	return allErrs
 }
 
@@ -61,6 +70,14 @@ func validateOperatingSystemConfigControllerConfiguration(conf nodeagentconfigv1
 
 	allErrs = append(allErrs, validateSyncPeriod(conf.SyncPeriod, fldPath)...)
 
+	if conf.Rollback != nil {
+		allErrs = append(allErrs, ValidateOperatingSystemConfigRollbackConfiguration(*conf.Rollback, fldPath.Child("rollback"))...)
+	}
+
+	if conf.DriftDetection != nil {
+		allErrs = append(allErrs, ValidateDriftDetectionConfiguration(*conf.DriftDetection, fldPath.Child("driftDetection"))...)
+	}
+
 	if conf.KubernetesVersion == nil {
 		allErrs = append(allErrs, field.Required(fldPath.Child("kubernetesVersion"), "must provide a supported kubernetes version"))
 	} else if err := kubernetesversion.CheckIfSupported(conf.KubernetesVersion.String()); err != nil {
@@ -70,6 +87,56 @@ func validateOperatingSystemConfigControllerConfiguration(conf nodeagentconfigv1
 	return allErrs
 }
 
+// ValidateOperatingSystemConfigRollbackConfiguration validates the given `OperatingSystemConfigRollbackConfig`.
+func ValidateOperatingSystemConfigRollbackConfiguration(conf nodeagentconfigv1alpha1.OperatingSystemConfigRollbackConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.GracePeriod != nil && conf.GracePeriod.Duration < 30*time.Second {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("gracePeriod"), conf.GracePeriod.Duration.String(), "must be at least 30s"))
+	}
+
+	return allErrs
+}
+
+var availableDriftPolicies = sets.New(
+	nodeagentconfigv1alpha1.DriftPolicyIgnore,
+	nodeagentconfigv1alpha1.DriftPolicyReport,
+	nodeagentconfigv1alpha1.DriftPolicyRemediate,
+)
+
+// ValidateDriftDetectionConfiguration validates the given `DriftDetectionConfig`.
+func ValidateDriftDetectionConfiguration(conf nodeagentconfigv1alpha1.DriftDetectionConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateSyncPeriod(conf.SyncPeriod, fldPath)...)
+
+	if conf.DefaultPolicy != nil && !availableDriftPolicies.Has(*conf.DefaultPolicy) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("defaultPolicy"), *conf.DefaultPolicy, sets.List(availableDriftPolicies)))
+	}
+
+	for i, rule := range conf.Policies {
+		idxPath := fldPath.Child("policies").Index(i)
+
+		if !availableDriftPolicies.Has(rule.Policy) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("policy"), rule.Policy, sets.List(availableDriftPolicies)))
+		}
+
+		if len(rule.Paths) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("paths"), "must provide at least one path"))
+		}
+
+		for j, p := range rule.Paths {
+			if !path.IsAbs(p) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("paths").Index(j), p, "must be an absolute path"))
+			} else if _, err := path.Match(p, ""); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("paths").Index(j), p, fmt.Sprintf("must be a valid pattern: %v", err)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func validateTokenControllerConfiguration(conf nodeagentconfigv1alpha1.TokenControllerConfig, fldPath *field.Path) field.ErrorList {
 	var (
 		allErrs = field.ErrorList{}
@@ -98,6 +165,180 @@ func validateTokenControllerConfiguration(conf nodeagentconfigv1alpha1.TokenCont
 	return allErrs
 }
 
+var (
+	availableHealthCheckerTypes = sets.New(
+		nodeagentconfigv1alpha1.HealthCheckerTypeFailedSystemdUnits,
+		nodeagentconfigv1alpha1.HealthCheckerTypeDiskPressure,
+		nodeagentconfigv1alpha1.HealthCheckerTypeClockSkew,
+		nodeagentconfigv1alpha1.HealthCheckerTypeReadOnlyFilesystem,
+		nodeagentconfigv1alpha1.HealthCheckerTypeExec,
+		nodeagentconfigv1alpha1.HealthCheckerTypeHTTP,
+	)
+
+	// forbiddenConditionTypes are the Node condition types which are maintained by the kubelet or by other
+	// components.
+	forbiddenConditionTypes = sets.New(
+		string(corev1.NodeReady),
+		string(corev1.NodeMemoryPressure),
+		string(corev1.NodeDiskPressure),
+		string(corev1.NodePIDPressure),
+		string(corev1.NodeNetworkUnavailable),
+	)
+)
+
+// ValidateHealthCheckControllerConfiguration validates the given `HealthCheckControllerConfig`.
+func ValidateHealthCheckControllerConfiguration(conf nodeagentconfigv1alpha1.HealthCheckControllerConfig, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs        = field.ErrorList{}
+		names          = sets.New[string]()
+		conditionTypes = sets.New[string]()
+	)
+
+	allErrs = append(allErrs, validateSyncPeriod(conf.SyncPeriod, fldPath)...)
+
+	for i, cfg := range conf.HealthCheckers {
+		idxPath := fldPath.Child("healthCheckers").Index(i)
+
+		if cfg.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name for the health checker"))
+		} else {
+			if names.Has(cfg.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), cfg.Name))
+			}
+			names.Insert(cfg.Name)
+		}
+
+		if cfg.ConditionType != nil {
+			conditionTypePath := idxPath.Child("conditionType")
+
+			switch {
+			case *cfg.ConditionType == "":
+				allErrs = append(allErrs, field.Invalid(conditionTypePath, *cfg.ConditionType, "must not be empty"))
+			case forbiddenConditionTypes.Has(*cfg.ConditionType):
+				allErrs = append(allErrs, field.Forbidden(conditionTypePath, fmt.Sprintf("condition type %q is maintained by the kubelet", *cfg.ConditionType)))
+			case conditionTypes.Has(*cfg.ConditionType):
+				allErrs = append(allErrs, field.Duplicate(conditionTypePath, *cfg.ConditionType))
+			}
+			conditionTypes.Insert(*cfg.ConditionType)
+		}
+
+		if cfg.RestartUnit != nil && *cfg.RestartUnit == "" {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("restartUnit"), *cfg.RestartUnit, "must not be empty"))
+		}
+
+		if cfg.FailureThreshold != nil && cfg.FailureThreshold.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("failureThreshold"), cfg.FailureThreshold.Duration.String(), "must not be negative"))
+		}
+
+		allErrs = append(allErrs, validateHealthCheckerTypeConfiguration(cfg, idxPath)...)
+	}
+
+	return allErrs
+}
+
+func validateHealthCheckerTypeConfiguration(cfg nodeagentconfigv1alpha1.HealthCheckerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !availableHealthCheckerTypes.Has(cfg.Type) {
+		return append(allErrs, field.NotSupported(fldPath.Child("type"), cfg.Type, sets.List(availableHealthCheckerTypes)))
+	}
+
+	typeConfigs := map[nodeagentconfigv1alpha1.HealthCheckerType]struct {
+		name string
+		set  bool
+	}{
+		nodeagentconfigv1alpha1.HealthCheckerTypeFailedSystemdUnits: {"failedSystemdUnits", cfg.FailedSystemdUnits != nil},
+		nodeagentconfigv1alpha1.HealthCheckerTypeDiskPressure:       {"diskPressure", cfg.DiskPressure != nil},
+		nodeagentconfigv1alpha1.HealthCheckerTypeClockSkew:          {"clockSkew", cfg.ClockSkew != nil},
+		nodeagentconfigv1alpha1.HealthCheckerTypeReadOnlyFilesystem: {"readOnlyFilesystem", cfg.ReadOnlyFilesystem != nil},
+		nodeagentconfigv1alpha1.HealthCheckerTypeExec:               {"exec", cfg.Exec != nil},
+		nodeagentconfigv1alpha1.HealthCheckerTypeHTTP:               {"http", cfg.HTTP != nil},
+	}
+
+	for _, t := range sets.List(availableHealthCheckerTypes) {
+		typeConfig := typeConfigs[t]
+		if t == cfg.Type && !typeConfig.set {
+			allErrs = append(allErrs, field.Required(fldPath.Child(typeConfig.name), fmt.Sprintf("must provide the configuration for health checker type %q", cfg.Type)))
+		}
+		if t != cfg.Type && typeConfig.set {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(typeConfig.name), fmt.Sprintf("must not be set for health checker type %q", cfg.Type)))
+		}
+	}
+
+	if cfg.DiskPressure != nil {
+		diskPressurePath := fldPath.Child("diskPressure")
+
+		if cfg.DiskPressure.Path != "" && !filepath.IsAbs(cfg.DiskPressure.Path) {
+			allErrs = append(allErrs, field.Invalid(diskPressurePath.Child("path"), cfg.DiskPressure.Path, "must be an absolute path"))
+		}
+		allErrs = append(allErrs, validatePercentage(cfg.DiskPressure.MinAvailablePercent, diskPressurePath.Child("minAvailablePercent"))...)
+		allErrs = append(allErrs, validatePercentage(cfg.DiskPressure.MinInodesFreePercent, diskPressurePath.Child("minInodesFreePercent"))...)
+	}
+
+	if cfg.ClockSkew != nil {
+		clockSkewPath := fldPath.Child("clockSkew")
+
+		if cfg.ClockSkew.MaxSkew != nil && cfg.ClockSkew.MaxSkew.Duration < time.Second {
+			allErrs = append(allErrs, field.Invalid(clockSkewPath.Child("maxSkew"), cfg.ClockSkew.MaxSkew.Duration.String(), "must be at least 1s"))
+		}
+		if cfg.ClockSkew.ReferenceURL != nil {
+			allErrs = append(allErrs, validateURL(*cfg.ClockSkew.ReferenceURL, clockSkewPath.Child("referenceURL"))...)
+		}
+	}
+
+	if cfg.ReadOnlyFilesystem != nil {
+		for i, path := range cfg.ReadOnlyFilesystem.Paths {
+			if !filepath.IsAbs(path) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("readOnlyFilesystem", "paths").Index(i), path, "must be an absolute path"))
+			}
+		}
+	}
+
+	if cfg.Exec != nil {
+		if len(cfg.Exec.Command) == 0 || cfg.Exec.Command[0] == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("exec", "command"), "must provide the command to execute"))
+		}
+		allErrs = append(allErrs, validateTimeout(cfg.Exec.Timeout, fldPath.Child("exec", "timeout"))...)
+	}
+
+	if cfg.HTTP != nil {
+		allErrs = append(allErrs, validateURL(cfg.HTTP.URL, fldPath.Child("http", "url"))...)
+		allErrs = append(allErrs, validateTimeout(cfg.HTTP.Timeout, fldPath.Child("http", "timeout"))...)
+	}
+
+	return allErrs
+}
+
+func validatePercentage(val *int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if val != nil && (*val < 0 || *val > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *val, "must be between 0 and 100"))
+	}
+
+	return allErrs
+}
+
+func validateTimeout(val *metav1.Duration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if val != nil && val.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, val.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
+
+func validateURL(val string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if u, err := url.Parse(val); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath, val, "must be a valid http or https URL"))
+	}
+
+	return allErrs
+}
+
 func validateSyncPeriod(val *metav1.Duration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -14,6 +14,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1/validation"
@@ -107,6 +108,80 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 				})),
 			))
 		})
+		It("should fail because rollback grace period is too small", func() {
+			config.Controllers.OperatingSystemConfig.Rollback = &OperatingSystemConfigRollbackConfig{GracePeriod: &metav1.Duration{Duration: 10 * time.Second}}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.rollback.gracePeriod"),
+				})),
+			))
+		})
+	})
+
+	Context("Drift detection", func() {
+		BeforeEach(func() {
+			config.Controllers.OperatingSystemConfig.DriftDetection = &DriftDetectionConfig{
+				SyncPeriod:    &metav1.Duration{Duration: time.Minute},
+				DefaultPolicy: ptr.To(DriftPolicyReport),
+				Policies: []DriftPolicyRule{
+					{Paths: []string{"/etc/containerd/*", "/etc/systemd/system/containerd.service.d/*"}, Policy: DriftPolicyRemediate},
+					{Paths: []string{"/var/lib/kubelet/config/kubelet"}, Policy: DriftPolicyIgnore},
+				},
+			}
+		})
+
+		It("should pass with a valid configuration", func() {
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because the sync period is too small", func() {
+			config.Controllers.OperatingSystemConfig.DriftDetection.SyncPeriod = &metav1.Duration{Duration: time.Second}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.driftDetection.syncPeriod"),
+				})),
+			))
+		})
+
+		It("should fail because the policies are not supported", func() {
+			config.Controllers.OperatingSystemConfig.DriftDetection.DefaultPolicy = ptr.To(DriftPolicy("Foo"))
+			config.Controllers.OperatingSystemConfig.DriftDetection.Policies[0].Policy = "Bar"
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.operatingSystemConfig.driftDetection.defaultPolicy"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.operatingSystemConfig.driftDetection.policies[0].policy"),
+				})),
+			))
+		})
+
+		It("should fail because the paths are invalid", func() {
+			config.Controllers.OperatingSystemConfig.DriftDetection.Policies[0].Paths = []string{"etc/containerd/*", "/etc/[containerd"}
+			config.Controllers.OperatingSystemConfig.DriftDetection.Policies[1].Paths = nil
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.driftDetection.policies[0].paths[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.driftDetection.policies[0].paths[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.operatingSystemConfig.driftDetection.policies[1].paths"),
+				})),
+			))
+		})
 	})
 
 	Context("Token Controller", func() {
@@ -161,4 +236,181 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 			))
 		})
 	})
+
+	Context("Health Check Controller", func() {
+		BeforeEach(func() {
+			config.Controllers.HealthCheck = &HealthCheckControllerConfig{
+				SyncPeriod: &metav1.Duration{Duration: 30 * time.Second},
+				HealthCheckers: []HealthCheckerConfig{
+					{
+						Name:               "systemd-units",
+						Type:               HealthCheckerTypeFailedSystemdUnits,
+						ConditionType:      ptr.To("FailedSystemdUnits"),
+						FailedSystemdUnits: &FailedSystemdUnitsHealthCheckerConfig{},
+					},
+					{
+						Name:        "my-agent",
+						Type:        HealthCheckerTypeHTTP,
+						RestartUnit: ptr.To("my-agent.service"),
+						HTTP:        &HTTPHealthCheckerConfig{URL: "http://127.0.0.1:8080/healthz"},
+					},
+				},
+			}
+		})
+
+		It("should pass for a valid configuration", func() {
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because sync period is too small", func() {
+			config.Controllers.HealthCheck.SyncPeriod.Duration = 10 * time.Second
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.syncPeriod"),
+				})),
+			))
+		})
+
+		It("should fail because names and condition types are duplicated or invalid", func() {
+			config.Controllers.HealthCheck.HealthCheckers = append(config.Controllers.HealthCheck.HealthCheckers,
+				HealthCheckerConfig{
+					Name:               "systemd-units",
+					Type:               HealthCheckerTypeFailedSystemdUnits,
+					ConditionType:      ptr.To("FailedSystemdUnits"),
+					RestartUnit:        ptr.To(""),
+					FailedSystemdUnits: &FailedSystemdUnitsHealthCheckerConfig{},
+				},
+				HealthCheckerConfig{
+					Type:          HealthCheckerTypeDiskPressure,
+					ConditionType: ptr.To("DiskPressure"),
+					DiskPressure:  &DiskPressureHealthCheckerConfig{},
+				},
+			)
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("controllers.healthCheck.healthCheckers[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("controllers.healthCheck.healthCheckers[2].conditionType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.healthCheckers[2].restartUnit"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.healthCheck.healthCheckers[3].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("controllers.healthCheck.healthCheckers[3].conditionType"),
+				})),
+			))
+		})
+
+		It("should fail because the type is not supported", func() {
+			config.Controllers.HealthCheck.HealthCheckers[0].Type = "Foo"
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.healthCheck.healthCheckers[0].type"),
+				})),
+			))
+		})
+
+		It("should fail because the type configuration is missing or does not match the type", func() {
+			config.Controllers.HealthCheck.HealthCheckers[1].HTTP = nil
+			config.Controllers.HealthCheck.HealthCheckers[1].Exec = &ExecHealthCheckerConfig{Command: []string{"/bin/true"}}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.healthCheck.healthCheckers[1].http"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("controllers.healthCheck.healthCheckers[1].exec"),
+				})),
+			))
+		})
+
+		It("should fail because the type configurations are invalid", func() {
+			config.Controllers.HealthCheck.HealthCheckers = []HealthCheckerConfig{
+				{
+					Name: "disk",
+					Type: HealthCheckerTypeDiskPressure,
+					DiskPressure: &DiskPressureHealthCheckerConfig{
+						Path:                 "var/lib",
+						MinAvailablePercent:  ptr.To[int32](101),
+						MinInodesFreePercent: ptr.To[int32](-1),
+					},
+				},
+				{
+					Name:      "clock",
+					Type:      HealthCheckerTypeClockSkew,
+					ClockSkew: &ClockSkewHealthCheckerConfig{MaxSkew: &metav1.Duration{Duration: time.Millisecond}, ReferenceURL: ptr.To("ftp://foo")},
+				},
+				{
+					Name:               "fs",
+					Type:               HealthCheckerTypeReadOnlyFilesystem,
+					ReadOnlyFilesystem: &ReadOnlyFilesystemHealthCheckerConfig{Paths: []string{"/var/lib", "etc"}},
+				},
+				{
+					Name: "exec",
+					Type: HealthCheckerTypeExec,
+					Exec: &ExecHealthCheckerConfig{Timeout: &metav1.Duration{}},
+				},
+				{
+					Name: "http",
+					Type: HealthCheckerTypeHTTP,
+					HTTP: &HTTPHealthCheckerConfig{URL: "localhost:8080"},
+				},
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.healthCheckers[0].diskPressure.path"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.healthCheckers[0].diskPressure.minAvailablePercent"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.healthCheckers[0].diskPressure.minInodesFreePercent"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.healthCheckers[1].clockSkew.maxSkew"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.healthCheckers[1].clockSkew.referenceURL"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.healthCheckers[2].readOnlyFilesystem.paths[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.healthCheck.healthCheckers[3].exec.command"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.healthCheckers[3].exec.timeout"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.healthCheckers[4].http.url"),
+				})),
+			))
+		})
+	})
 })
@@ -58,11 +58,42 @@ func (in *BootstrapConfiguration) DeepCopy() *BootstrapConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClockSkewHealthCheckerConfig) DeepCopyInto(out *ClockSkewHealthCheckerConfig) {
+	*out = *in
+	if in.MaxSkew != nil {
+		in, out := &in.MaxSkew, &out.MaxSkew
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ReferenceURL != nil {
+		in, out := &in.ReferenceURL, &out.ReferenceURL
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClockSkewHealthCheckerConfig.
+func (in *ClockSkewHealthCheckerConfig) DeepCopy() *ClockSkewHealthCheckerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClockSkewHealthCheckerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
 	in.OperatingSystemConfig.DeepCopyInto(&out.OperatingSystemConfig)
 	in.Token.DeepCopyInto(&out.Token)
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(HealthCheckControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -76,6 +107,243 @@ func (in *ControllerConfiguration) DeepCopy() *ControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskPressureHealthCheckerConfig) DeepCopyInto(out *DiskPressureHealthCheckerConfig) {
+	*out = *in
+	if in.MinAvailablePercent != nil {
+		in, out := &in.MinAvailablePercent, &out.MinAvailablePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinInodesFreePercent != nil {
+		in, out := &in.MinInodesFreePercent, &out.MinInodesFreePercent
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskPressureHealthCheckerConfig.
+func (in *DiskPressureHealthCheckerConfig) DeepCopy() *DiskPressureHealthCheckerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskPressureHealthCheckerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetectionConfig) DeepCopyInto(out *DriftDetectionConfig) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DefaultPolicy != nil {
+		in, out := &in.DefaultPolicy, &out.DefaultPolicy
+		*out = new(DriftPolicy)
+		**out = **in
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]DriftPolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetectionConfig.
+func (in *DriftDetectionConfig) DeepCopy() *DriftDetectionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetectionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftPolicyRule) DeepCopyInto(out *DriftPolicyRule) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftPolicyRule.
+func (in *DriftPolicyRule) DeepCopy() *DriftPolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftPolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecHealthCheckerConfig) DeepCopyInto(out *ExecHealthCheckerConfig) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecHealthCheckerConfig.
+func (in *ExecHealthCheckerConfig) DeepCopy() *ExecHealthCheckerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecHealthCheckerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailedSystemdUnitsHealthCheckerConfig) DeepCopyInto(out *FailedSystemdUnitsHealthCheckerConfig) {
+	*out = *in
+	if in.Units != nil {
+		in, out := &in.Units, &out.Units
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailedSystemdUnitsHealthCheckerConfig.
+func (in *FailedSystemdUnitsHealthCheckerConfig) DeepCopy() *FailedSystemdUnitsHealthCheckerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FailedSystemdUnitsHealthCheckerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHealthCheckerConfig) DeepCopyInto(out *HTTPHealthCheckerConfig) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHealthCheckerConfig.
+func (in *HTTPHealthCheckerConfig) DeepCopy() *HTTPHealthCheckerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHealthCheckerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckControllerConfig) DeepCopyInto(out *HealthCheckControllerConfig) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HealthCheckers != nil {
+		in, out := &in.HealthCheckers, &out.HealthCheckers
+		*out = make([]HealthCheckerConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckControllerConfig.
+func (in *HealthCheckControllerConfig) DeepCopy() *HealthCheckControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckerConfig) DeepCopyInto(out *HealthCheckerConfig) {
+	*out = *in
+	if in.ConditionType != nil {
+		in, out := &in.ConditionType, &out.ConditionType
+		*out = new(string)
+		**out = **in
+	}
+	if in.RestartUnit != nil {
+		in, out := &in.RestartUnit, &out.RestartUnit
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FailedSystemdUnits != nil {
+		in, out := &in.FailedSystemdUnits, &out.FailedSystemdUnits
+		*out = new(FailedSystemdUnitsHealthCheckerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DiskPressure != nil {
+		in, out := &in.DiskPressure, &out.DiskPressure
+		*out = new(DiskPressureHealthCheckerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClockSkew != nil {
+		in, out := &in.ClockSkew, &out.ClockSkew
+		*out = new(ClockSkewHealthCheckerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadOnlyFilesystem != nil {
+		in, out := &in.ReadOnlyFilesystem, &out.ReadOnlyFilesystem
+		*out = new(ReadOnlyFilesystemHealthCheckerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(ExecHealthCheckerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPHealthCheckerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckerConfig.
+func (in *HealthCheckerConfig) DeepCopy() *HealthCheckerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeAgentConfiguration) DeepCopyInto(out *NodeAgentConfiguration) {
 	*out = *in
@@ -135,6 +403,16 @@ func (in *OperatingSystemConfigControllerConfig) DeepCopyInto(out *OperatingSyst
 		*out = new(v3.Version)
 		**out = **in
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(OperatingSystemConfigRollbackConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(DriftDetectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -148,6 +426,48 @@ func (in *OperatingSystemConfigControllerConfig) DeepCopy() *OperatingSystemConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatingSystemConfigRollbackConfig) DeepCopyInto(out *OperatingSystemConfigRollbackConfig) {
+	*out = *in
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatingSystemConfigRollbackConfig.
+func (in *OperatingSystemConfigRollbackConfig) DeepCopy() *OperatingSystemConfigRollbackConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatingSystemConfigRollbackConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReadOnlyFilesystemHealthCheckerConfig) DeepCopyInto(out *ReadOnlyFilesystemHealthCheckerConfig) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadOnlyFilesystemHealthCheckerConfig.
+func (in *ReadOnlyFilesystemHealthCheckerConfig) DeepCopy() *ReadOnlyFilesystemHealthCheckerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ReadOnlyFilesystemHealthCheckerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
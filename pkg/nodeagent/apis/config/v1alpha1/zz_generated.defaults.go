@@ -26,5 +26,33 @@ func SetObjectDefaults_NodeAgentConfiguration(in *NodeAgentConfiguration) {
 	SetDefaults_ClientConnectionConfiguration(&in.ClientConnection)
 	SetDefaults_ServerConfiguration(&in.Server)
 	SetDefaults_OperatingSystemConfigControllerConfig(&in.Controllers.OperatingSystemConfig)
+	if in.Controllers.OperatingSystemConfig.Rollback != nil {
+		SetDefaults_OperatingSystemConfigRollbackConfig(in.Controllers.OperatingSystemConfig.Rollback)
+	}
+	if in.Controllers.OperatingSystemConfig.DriftDetection != nil {
+		SetDefaults_DriftDetectionConfig(in.Controllers.OperatingSystemConfig.DriftDetection)
+	}
 	SetDefaults_TokenControllerConfig(&in.Controllers.Token)
+	if in.Controllers.HealthCheck != nil {
+		SetDefaults_HealthCheckControllerConfig(in.Controllers.HealthCheck)
+		for i := range in.Controllers.HealthCheck.HealthCheckers {
+			a := &in.Controllers.HealthCheck.HealthCheckers[i]
+			SetDefaults_HealthCheckerConfig(a)
+			if a.DiskPressure != nil {
+				SetDefaults_DiskPressureHealthCheckerConfig(a.DiskPressure)
+			}
+			if a.ClockSkew != nil {
+				SetDefaults_ClockSkewHealthCheckerConfig(a.ClockSkew)
+			}
+			if a.ReadOnlyFilesystem != nil {
+				SetDefaults_ReadOnlyFilesystemHealthCheckerConfig(a.ReadOnlyFilesystem)
+			}
+			if a.Exec != nil {
+				SetDefaults_ExecHealthCheckerConfig(a.Exec)
+			}
+			if a.HTTP != nil {
+				SetDefaults_HTTPHealthCheckerConfig(a.HTTP)
+			}
+		}
+	}
 }
@@ -72,7 +72,9 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 		}
 	}
 
-	if err := (&healthcheck.Reconciler{}).AddToManager(mgr, nodePredicate); err != nil {
+	if err := (&healthcheck.Reconciler{
+		Config: cfg.Controllers.HealthCheck,
+	}).AddToManager(mgr, nodePredicate); err != nil {
 		return fmt.Errorf("failed adding health-check controller: %w", err)
 	}
 
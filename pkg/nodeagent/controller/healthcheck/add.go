@@ -7,18 +7,23 @@ package healthcheck
 import (
 	"fmt"
 	"net"
+	"net/http"
 	"os"
+	"time"
 
 	containerd "github.com/containerd/containerd/v2/client"
 	"github.com/containerd/containerd/v2/defaults"
 	"github.com/containerd/containerd/v2/pkg/namespaces"
+	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
 )
 
@@ -47,10 +52,17 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, nodePredicate predicate.P
 		if err := r.setDefaultHealthChecks(); err != nil {
 			return err
 		}
+
+		if err := r.addConfiguredHealthChecks(mgr); err != nil {
+			return err
+		}
 	}
 
 	if r.HealthCheckIntervalSeconds == 0 {
 		r.HealthCheckIntervalSeconds = defaultIntervalSeconds
+		if r.Config != nil && r.Config.SyncPeriod != nil {
+			r.HealthCheckIntervalSeconds = int32(r.Config.SyncPeriod.Seconds())
+		}
 	}
 
 	return builder.
@@ -85,3 +97,55 @@ func (r *Reconciler) setDefaultHealthChecks() error {
 	r.HealthCheckers = []HealthChecker{containerdHealthChecker, kubeletHealthChecker}
 	return nil
 }
+
+func (r *Reconciler) addConfiguredHealthChecks(mgr manager.Manager) error {
+	if r.Config == nil {
+		return nil
+	}
+
+	clock := clock.RealClock{}
+
+	for _, config := range r.Config.HealthCheckers {
+		probe, err := newProbe(mgr, clock, r.DBus, config)
+		if err != nil {
+			return fmt.Errorf("failed creating health checker %s: %w", config.Name, err)
+		}
+
+		r.HealthCheckers = append(r.HealthCheckers, NewProbeHealthChecker(r.Client, clock, r.DBus, r.Recorder, config, probe))
+	}
+
+	return nil
+}
+
+func newProbe(mgr manager.Manager, clock clock.Clock, dbus dbus.DBus, config nodeagentconfigv1alpha1.HealthCheckerConfig) (Probe, error) {
+	switch config.Type {
+	case nodeagentconfigv1alpha1.HealthCheckerTypeFailedSystemdUnits:
+		return NewFailedSystemdUnitsProbe(dbus, config.FailedSystemdUnits.Units...), nil
+
+	case nodeagentconfigv1alpha1.HealthCheckerTypeDiskPressure:
+		return NewDiskPressureProbe(StatFS, config.DiskPressure.Path, ptr.Deref(config.DiskPressure.MinAvailablePercent, 0), ptr.Deref(config.DiskPressure.MinInodesFreePercent, 0)), nil
+
+	case nodeagentconfigv1alpha1.HealthCheckerTypeClockSkew:
+		var (
+			httpClient   = &http.Client{Timeout: 10 * time.Second}
+			referenceURL = ptr.Deref(config.ClockSkew.ReferenceURL, "")
+		)
+
+		if referenceURL == "" {
+			httpClient, referenceURL = mgr.GetHTTPClient(), mgr.GetConfig().Host
+		}
+
+		return NewClockSkewProbe(clock, httpClient, referenceURL, config.ClockSkew.MaxSkew.Duration), nil
+
+	case nodeagentconfigv1alpha1.HealthCheckerTypeReadOnlyFilesystem:
+		return NewReadOnlyFilesystemProbe(afero.Afero{Fs: afero.NewOsFs()}, config.ReadOnlyFilesystem.Paths...), nil
+
+	case nodeagentconfigv1alpha1.HealthCheckerTypeExec:
+		return NewExecProbe(config.Exec.Command, config.Exec.Timeout.Duration), nil
+
+	case nodeagentconfigv1alpha1.HealthCheckerTypeHTTP:
+		return NewHTTPProbe(&http.Client{Timeout: config.HTTP.Timeout.Duration}, config.HTTP.URL), nil
+	}
+
+	return nil, fmt.Errorf("unsupported health checker type %q", config.Type)
+}
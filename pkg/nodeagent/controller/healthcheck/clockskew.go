@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+type clockSkewProbe struct {
+	clock        clock.Clock
+	httpClient   *http.Client
+	referenceURL string
+	maxSkew      time.Duration
+}
+
+// NewClockSkewProbe creates a new probe which fails if the node's clock differs from the reference clock by more than
+// the given maximum skew. The reference clock is read from the 'Date' header of the response of the given URL.
+func NewClockSkewProbe(clock clock.Clock, httpClient *http.Client, referenceURL string, maxSkew time.Duration) Probe {
+	return &clockSkewProbe{
+		clock:        clock,
+		httpClient:   httpClient,
+		referenceURL: referenceURL,
+		maxSkew:      maxSkew,
+	}
+}
+
+// Probe compares the node's clock with the reference clock.
+func (c *clockSkewProbe) Probe(ctx context.Context) (ProbeResult, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, c.referenceURL, nil)
+	if err != nil {
+		return ProbeResult{}, fmt.Errorf("failed creating request to reference clock: %w", err)
+	}
+
+	start := c.clock.Now()
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return ProbeResult{}, fmt.Errorf("failed reading reference clock: %w", err)
+	}
+	defer response.Body.Close()
+	end := c.clock.Now()
+
+	// The response status does not matter, e.g. a forbidden response carries a 'Date' header as well.
+	referenceTime, err := http.ParseTime(response.Header.Get("Date"))
+	if err != nil {
+		return ProbeResult{}, fmt.Errorf("failed parsing 'Date' header of reference clock: %w", err)
+	}
+
+	// The 'Date' header has a resolution of one second, hence the local time is truncated as well. The request
+	// duration is compensated by assuming that the reference clock was read in the middle of the request.
+	localTime := start.Add(end.Sub(start) / 2).Truncate(time.Second)
+	skew := localTime.Sub(referenceTime)
+
+	if skew.Abs() > c.maxSkew {
+		return ProbeResult{Reason: "ClockSkewed", Message: fmt.Sprintf("The node's clock differs by %s from the reference clock (maximum: %s)", skew, c.maxSkew)}, nil
+	}
+
+	return ProbeResult{Healthy: true, Reason: "ClockSynchronized", Message: "The node's clock is in sync with the reference clock"}, nil
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"syscall"
+)
+
+// FilesystemStats contains the usage statistics of a file system.
+type FilesystemStats struct {
+	// Blocks is the total number of data blocks.
+	Blocks uint64
+	// BlocksAvailable is the number of data blocks available to unprivileged users.
+	BlocksAvailable uint64
+	// Inodes is the total number of inodes.
+	Inodes uint64
+	// InodesFree is the number of free inodes.
+	InodesFree uint64
+}
+
+// StatFSFunc returns the usage statistics of the file system containing the given path.
+type StatFSFunc func(path string) (FilesystemStats, error)
+
+// StatFS returns the usage statistics of the file system containing the given path based on statfs(2).
+func StatFS(path string) (FilesystemStats, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return FilesystemStats{}, err
+	}
+
+	return FilesystemStats{
+		Blocks:          stat.Blocks,
+		BlocksAvailable: stat.Bavail,
+		Inodes:          stat.Files,
+		InodesFree:      stat.Ffree,
+	}, nil
+}
+
+type diskPressureProbe struct {
+	statFS               StatFSFunc
+	path                 string
+	minAvailablePercent  uint64
+	minInodesFreePercent uint64
+}
+
+// NewDiskPressureProbe creates a new probe which fails if the percentage of available disk space or free inodes of
+// the file system containing the given path falls below the given thresholds.
+func NewDiskPressureProbe(statFS StatFSFunc, path string, minAvailablePercent, minInodesFreePercent int32) Probe {
+	return &diskPressureProbe{
+		statFS:               statFS,
+		path:                 path,
+		minAvailablePercent:  uint64(max(minAvailablePercent, 0)),  // #nosec G115 -- negative values are capped.
+		minInodesFreePercent: uint64(max(minInodesFreePercent, 0)), // #nosec G115 -- negative values are capped.
+	}
+}
+
+// Probe checks the usage statistics of the file system.
+func (d *diskPressureProbe) Probe(_ context.Context) (ProbeResult, error) {
+	stats, err := d.statFS(d.path)
+	if err != nil {
+		return ProbeResult{}, fmt.Errorf("failed getting file system statistics for %s: %w", d.path, err)
+	}
+
+	if stats.Blocks > 0 {
+		if availablePercent := stats.BlocksAvailable * 100 / stats.Blocks; availablePercent < d.minAvailablePercent {
+			return ProbeResult{Reason: "DiskPressure", Message: fmt.Sprintf("Only %d%% of disk space is available on the volume of %s (minimum: %d%%)", availablePercent, d.path, d.minAvailablePercent)}, nil
+		}
+	}
+
+	// Some file systems (e.g., btrfs) allocate inodes dynamically and report zero inodes.
+	if stats.Inodes > 0 {
+		if inodesFreePercent := stats.InodesFree * 100 / stats.Inodes; inodesFreePercent < d.minInodesFreePercent {
+			return ProbeResult{Reason: "InodePressure", Message: fmt.Sprintf("Only %d%% of inodes are free on the volume of %s (minimum: %d%%)", inodesFreePercent, d.path, d.minInodesFreePercent)}, nil
+		}
+	}
+
+	return ProbeResult{Healthy: true, Reason: "NoDiskPressure", Message: fmt.Sprintf("Sufficient disk space and inodes are available on the volume of %s", d.path)}, nil
+}
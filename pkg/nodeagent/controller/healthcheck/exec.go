@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+type execProbe struct {
+	command []string
+	timeout time.Duration
+}
+
+// NewExecProbe creates a new probe which executes the given command and fails if it exits with a non-zero code.
+func NewExecProbe(command []string, timeout time.Duration) Probe {
+	return &execProbe{
+		command: command,
+		timeout: timeout,
+	}
+}
+
+// Probe executes the command.
+func (e *execProbe) Probe(ctx context.Context) (ProbeResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+
+	// #nosec G204 -- The command is provided by the component configuration of gardener-node-agent.
+	output, err := exec.CommandContext(ctx, e.command[0], e.command[1:]...).CombinedOutput()
+	message := truncate(strings.TrimSpace(string(output)))
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return ProbeResult{Reason: "CommandTimedOut", Message: fmt.Sprintf("Command did not finish within %s", e.timeout)}, nil
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return ProbeResult{Reason: "CommandFailed", Message: fmt.Sprintf("Command exited with code %d: %s", exitErr.ExitCode(), message)}, nil
+	}
+	if err != nil {
+		return ProbeResult{}, fmt.Errorf("failed executing command %s: %w", e.command[0], err)
+	}
+
+	return ProbeResult{Healthy: true, Reason: "CommandSucceeded", Message: message}, nil
+}
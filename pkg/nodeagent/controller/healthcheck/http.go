@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type httpProbe struct {
+	httpClient *http.Client
+	url        string
+}
+
+// NewHTTPProbe creates a new probe which fails if the given URL cannot be reached or does not respond with a 2xx
+// status code.
+func NewHTTPProbe(httpClient *http.Client, url string) Probe {
+	return &httpProbe{
+		httpClient: httpClient,
+		url:        url,
+	}
+}
+
+// Probe sends a GET request to the URL.
+func (h *httpProbe) Probe(ctx context.Context) (ProbeResult, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, h.url, nil)
+	if err != nil {
+		return ProbeResult{}, fmt.Errorf("failed creating request to %s: %w", h.url, err)
+	}
+
+	response, err := h.httpClient.Do(request)
+	if err != nil {
+		return ProbeResult{Reason: "EndpointUnreachable", Message: truncate(err.Error())}, nil
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return ProbeResult{Reason: "EndpointUnhealthy", Message: fmt.Sprintf("Endpoint %s responded with status code %d", h.url, response.StatusCode)}, nil
+	}
+
+	return ProbeResult{Healthy: true, Reason: "EndpointHealthy", Message: fmt.Sprintf("Endpoint %s responded with status code %d", h.url, response.StatusCode)}, nil
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+)
+
+const (
+	// ReasonProbeError is the reason of the node condition if a probe could not be executed.
+	ReasonProbeError = "ProbeError"
+
+	// maxMessageLength is the maximum length of messages in probe results.
+	maxMessageLength = 1024
+)
+
+// ProbeResult is the result of a Probe.
+type ProbeResult struct {
+	// Healthy is true if the probed aspect of the node is healthy.
+	Healthy bool
+	// Reason is a brief CamelCase reason for the result.
+	Reason string
+	// Message is a human-readable message describing the result.
+	Message string
+}
+
+// Probe checks a single aspect of the node's health.
+type Probe interface {
+	// Probe executes the probe. An error is returned if the probe could not be executed, i.e., if the health is unknown.
+	Probe(ctx context.Context) (ProbeResult, error)
+}
+
+// probeHealthChecker is a HealthChecker which executes a Probe. It maintains a node condition reflecting the probe
+// result and restarts a systemd unit if the probe keeps failing, if configured.
+type probeHealthChecker struct {
+	client client.Client
+
+	name             string
+	probe            Probe
+	conditionType    corev1.NodeConditionType
+	restartUnit      string
+	failureThreshold time.Duration
+	firstFailure     *time.Time
+	clock            clock.Clock
+	dbus             dbus.DBus
+	recorder         record.EventRecorder
+}
+
+// NewProbeHealthChecker creates a new instance of a health check executing the given probe.
+func NewProbeHealthChecker(client client.Client, clock clock.Clock, dbus dbus.DBus, recorder record.EventRecorder, config nodeagentconfigv1alpha1.HealthCheckerConfig, probe Probe) HealthChecker {
+	return &probeHealthChecker{
+		client:           client,
+		name:             config.Name,
+		probe:            probe,
+		conditionType:    corev1.NodeConditionType(ptr.Deref(config.ConditionType, "")),
+		restartUnit:      ptr.Deref(config.RestartUnit, ""),
+		failureThreshold: ptr.Deref(config.FailureThreshold, metav1.Duration{Duration: maxFailureDuration}).Duration,
+		clock:            clock,
+		dbus:             dbus,
+		recorder:         recorder,
+	}
+}
+
+// Name returns the name of this health check.
+func (p *probeHealthChecker) Name() string {
+	return p.name
+}
+
+// Check executes the probe and acts on its result.
+func (p *probeHealthChecker) Check(ctx context.Context, node *corev1.Node) error {
+	log := logf.FromContext(ctx).WithName(p.Name())
+
+	result, err := p.probe.Probe(ctx)
+	if err != nil {
+		log.Error(err, "Unable to execute probe, health is unknown")
+		return p.updateCondition(ctx, node, corev1.ConditionUnknown, ReasonProbeError, err.Error())
+	}
+
+	if result.Healthy {
+		if p.firstFailure != nil {
+			log.Info("Health check succeeds again")
+			p.recorder.Eventf(node, corev1.EventTypeNormal, p.name, "Health check %s succeeds again: %s", p.name, result.Message)
+			p.firstFailure = nil
+		}
+		return p.updateCondition(ctx, node, corev1.ConditionFalse, result.Reason, result.Message)
+	}
+
+	if p.firstFailure == nil {
+		now := p.clock.Now()
+		p.firstFailure = &now
+
+		log.Info("Health check failed", "reason", result.Reason, "message", result.Message)
+		p.recorder.Eventf(node, corev1.EventTypeWarning, p.name, "Health check %s failed: %s", p.name, result.Message)
+	}
+
+	if err := p.updateCondition(ctx, node, corev1.ConditionTrue, result.Reason, result.Message); err != nil {
+		return err
+	}
+
+	if p.restartUnit == "" || p.clock.Since(*p.firstFailure) < p.failureThreshold {
+		return nil
+	}
+
+	log.Info("Health check is failing for too long, restarting unit", "failureThreshold", p.failureThreshold, "unitName", p.restartUnit)
+	p.recorder.Eventf(node, corev1.EventTypeWarning, p.name, "Health check %s is failing for more than %s, restarting unit %s: %s", p.name, p.failureThreshold, p.restartUnit, result.Message)
+	if err := p.dbus.Restart(ctx, p.recorder, node, p.restartUnit); err != nil {
+		return fmt.Errorf("failed restarting unit %s: %w", p.restartUnit, err)
+	}
+
+	p.firstFailure = nil
+	return nil
+}
+
+// updateCondition patches the node condition maintained by this health check if its status, reason or message
+// changed.
+func (p *probeHealthChecker) updateCondition(ctx context.Context, node *corev1.Node, status corev1.ConditionStatus, reason, message string) error {
+	if p.conditionType == "" {
+		return nil
+	}
+
+	return nodeagent.PatchNodeCondition(ctx, p.client, p.clock, node, p.conditionType, status, reason, message)
+}
+
+func truncate(s string) string {
+	if len(s) <= maxMessageLength {
+		return s
+	}
+	return s[:maxMessageLength] + "..."
+}
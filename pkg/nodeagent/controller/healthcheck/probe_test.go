@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+)
+
+type fakeProbe struct {
+	result ProbeResult
+	err    error
+}
+
+func (f *fakeProbe) Probe(_ context.Context) (ProbeResult, error) {
+	return f.result, f.err
+}
+
+var _ = Describe("ProbeHealthChecker", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		fakeDBus   *fakedbus.DBus
+		clock      *testclock.FakeClock
+		recorder   *record.FakeRecorder
+
+		node   *corev1.Node
+		probe  *fakeProbe
+		config nodeagentconfigv1alpha1.HealthCheckerConfig
+
+		healthChecker HealthChecker
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&corev1.Node{}).Build()
+		fakeDBus = fakedbus.New()
+		clock = testclock.NewFakeClock(time.Now().Round(time.Second))
+		recorder = record.NewFakeRecorder(10)
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+		Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+		probe = &fakeProbe{result: ProbeResult{Healthy: true, Reason: "AllGood", Message: "all good"}}
+		config = nodeagentconfigv1alpha1.HealthCheckerConfig{
+			Name:             "my-check",
+			ConditionType:    ptr.To("MyCheckFailing"),
+			RestartUnit:      ptr.To("my.service"),
+			FailureThreshold: &metav1.Duration{Duration: time.Minute},
+		}
+	})
+
+	JustBeforeEach(func() {
+		healthChecker = NewProbeHealthChecker(fakeClient, clock, fakeDBus, recorder, config, probe)
+	})
+
+	check := func() {
+		GinkgoHelper()
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		Expect(healthChecker.Check(ctx, node)).To(Succeed())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+	}
+
+	matchCondition := func(status corev1.ConditionStatus, reason, message string, lastTransitionTime time.Time) OmegaMatcher {
+		return ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Type":               Equal(corev1.NodeConditionType("MyCheckFailing")),
+			"Status":             Equal(status),
+			"Reason":             Equal(reason),
+			"Message":            Equal(message),
+			"LastTransitionTime": WithTransform(func(t metav1.Time) time.Time { return t.Time }, BeTemporally("==", lastTransitionTime)),
+		}))
+	}
+
+	It("should return the configured name", func() {
+		Expect(healthChecker.Name()).To(Equal("my-check"))
+	})
+
+	It("should set the condition to False if the probe succeeds", func() {
+		check()
+
+		Expect(node.Status.Conditions).To(matchCondition(corev1.ConditionFalse, "AllGood", "all good", clock.Now()))
+		Expect(fakeDBus.Actions).To(BeEmpty())
+	})
+
+	It("should set the condition to Unknown if the probe cannot be executed", func() {
+		probe.err = errors.New("fake")
+
+		check()
+
+		Expect(node.Status.Conditions).To(matchCondition(corev1.ConditionUnknown, ReasonProbeError, "fake", clock.Now()))
+	})
+
+	Context("without condition type", func() {
+		BeforeEach(func() {
+			config.ConditionType = nil
+		})
+
+		It("should not maintain a condition", func() {
+			probe.result = ProbeResult{Reason: "Broken", Message: "broken"}
+
+			check()
+
+			Expect(node.Status.Conditions).To(BeEmpty())
+			Expect(recorder.Events).To(Receive(ContainSubstring("Health check my-check failed: broken")))
+		})
+	})
+
+	It("should keep the transition time if only the message changes", func() {
+		probe.result = ProbeResult{Reason: "Broken", Message: "broken"}
+		check()
+		failureTime := clock.Now()
+
+		clock.Step(10 * time.Second)
+		probe.result.Message = "still broken"
+		check()
+
+		Expect(node.Status.Conditions).To(matchCondition(corev1.ConditionTrue, "Broken", "still broken", failureTime))
+	})
+
+	It("should restart the unit if the probe keeps failing for longer than the failure threshold", func() {
+		probe.result = ProbeResult{Reason: "Broken", Message: "broken"}
+
+		check()
+		Expect(node.Status.Conditions).To(matchCondition(corev1.ConditionTrue, "Broken", "broken", clock.Now()))
+		Expect(fakeDBus.Actions).To(BeEmpty())
+
+		clock.Step(30 * time.Second)
+		check()
+		Expect(fakeDBus.Actions).To(BeEmpty())
+
+		clock.Step(30 * time.Second)
+		check()
+		Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{"my.service"}}))
+
+		By("Start counting again after the restart")
+		clock.Step(30 * time.Second)
+		check()
+		Expect(fakeDBus.Actions).To(HaveLen(1))
+
+		By("Recover")
+		probe.result = ProbeResult{Healthy: true, Reason: "AllGood", Message: "all good"}
+		check()
+		Expect(node.Status.Conditions).To(matchCondition(corev1.ConditionFalse, "AllGood", "all good", clock.Now()))
+	})
+
+	Context("without failure threshold", func() {
+		BeforeEach(func() {
+			config.FailureThreshold = &metav1.Duration{}
+		})
+
+		It("should return an error if the unit cannot be restarted", func() {
+			probe.result = ProbeResult{Reason: "Broken", Message: "broken"}
+			fakeDBus.InjectRestartFailure(errors.New("fake"), "my.service")
+
+			Expect(healthChecker.Check(ctx, node)).To(MatchError(ContainSubstring("failed restarting unit my.service")))
+		})
+	})
+})
@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	systemddbus "github.com/coreos/go-systemd/v22/dbus"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+)
+
+var _ = Describe("Probes", func() {
+	var ctx = context.Background()
+
+	Describe("#NewFailedSystemdUnitsProbe", func() {
+		var fakeDBus *fakedbus.DBus
+
+		BeforeEach(func() {
+			fakeDBus = fakedbus.New()
+			fakeDBus.AddUnitsToList(
+				systemddbus.UnitStatus{Name: "kubelet.service", ActiveState: "active"},
+				systemddbus.UnitStatus{Name: "foo.service", ActiveState: "failed"},
+				systemddbus.UnitStatus{Name: "bar.service", ActiveState: "failed"},
+			)
+		})
+
+		It("should report all failed units", func() {
+			result, err := NewFailedSystemdUnitsProbe(fakeDBus).Probe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ProbeResult{Reason: "FailedUnits", Message: "Systemd units are in failed state: bar.service, foo.service"}))
+		})
+
+		It("should only consider the given units", func() {
+			result, err := NewFailedSystemdUnitsProbe(fakeDBus, "kubelet.service", "foo.service").Probe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Message).To(Equal("Systemd units are in failed state: foo.service"))
+		})
+
+		It("should succeed if the given units are not failed", func() {
+			result, err := NewFailedSystemdUnitsProbe(fakeDBus, "kubelet.service").Probe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Healthy).To(BeTrue())
+		})
+	})
+
+	Describe("#NewDiskPressureProbe", func() {
+		var stats FilesystemStats
+
+		statFS := func(path string) (FilesystemStats, error) {
+			Expect(path).To(Equal("/var/lib/kubelet"))
+			return stats, nil
+		}
+
+		BeforeEach(func() {
+			stats = FilesystemStats{Blocks: 1000, BlocksAvailable: 500, Inodes: 1000, InodesFree: 500}
+		})
+
+		It("should succeed if enough disk space and inodes are available", func() {
+			result, err := NewDiskPressureProbe(statFS, "/var/lib/kubelet", 10, 5).Probe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Healthy).To(BeTrue())
+		})
+
+		It("should fail if not enough disk space is available", func() {
+			stats.BlocksAvailable = 50
+
+			result, err := NewDiskPressureProbe(statFS, "/var/lib/kubelet", 10, 5).Probe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Healthy).To(BeFalse())
+			Expect(result.Reason).To(Equal("DiskPressure"))
+			Expect(result.Message).To(Equal("Only 5% of disk space is available on the volume of /var/lib/kubelet (minimum: 10%)"))
+		})
+
+		It("should fail if not enough inodes are free", func() {
+			stats.InodesFree = 10
+
+			result, err := NewDiskPressureProbe(statFS, "/var/lib/kubelet", 10, 5).Probe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Reason).To(Equal("InodePressure"))
+		})
+
+		It("should ignore inodes if the file system does not report them", func() {
+			stats.Inodes, stats.InodesFree = 0, 0
+
+			result, err := NewDiskPressureProbe(statFS, "/var/lib/kubelet", 10, 5).Probe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Healthy).To(BeTrue())
+		})
+
+		It("should return an error if the statistics cannot be read", func() {
+			_, err := NewDiskPressureProbe(func(string) (FilesystemStats, error) { return FilesystemStats{}, errors.New("fake") }, "/var/lib/kubelet", 10, 5).Probe(ctx)
+			Expect(err).To(MatchError(ContainSubstring("fake")))
+		})
+
+		It("should read the statistics of a real file system", func() {
+			stats, err := StatFS(GinkgoT().TempDir())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stats.Blocks).To(BeNumerically(">", 0))
+		})
+	})
+
+	Describe("#NewClockSkewProbe", func() {
+		var (
+			server        *httptest.Server
+			referenceTime time.Time
+		)
+
+		BeforeEach(func() {
+			referenceTime = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Date", referenceTime.Format(http.TimeFormat))
+				w.WriteHeader(http.StatusForbidden)
+			}))
+			DeferCleanup(server.Close)
+		})
+
+		It("should succeed if the clocks are in sync", func() {
+			clock := testclock.NewFakeClock(referenceTime.Add(800 * time.Millisecond))
+
+			result, err := NewClockSkewProbe(clock, server.Client(), server.URL, 5*time.Second).Probe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Healthy).To(BeTrue())
+		})
+
+		It("should fail if the clocks are skewed", func() {
+			clock := testclock.NewFakeClock(referenceTime.Add(-time.Minute))
+
+			result, err := NewClockSkewProbe(clock, server.Client(), server.URL, 5*time.Second).Probe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Healthy).To(BeFalse())
+			Expect(result.Reason).To(Equal("ClockSkewed"))
+			Expect(result.Message).To(Equal("The node's clock differs by -1m0s from the reference clock (maximum: 5s)"))
+		})
+
+		It("should return an error if the reference clock cannot be read", func() {
+			server.Close()
+
+			_, err := NewClockSkewProbe(testclock.NewFakeClock(referenceTime), server.Client(), server.URL, 5*time.Second).Probe(ctx)
+			Expect(err).To(MatchError(ContainSubstring("failed reading reference clock")))
+		})
+	})
+
+	Describe("#NewReadOnlyFilesystemProbe", func() {
+		var fs afero.Afero
+
+		BeforeEach(func() {
+			fs = afero.Afero{Fs: afero.NewMemMapFs()}
+			Expect(fs.MkdirAll("/var/lib", 0755)).To(Succeed())
+		})
+
+		It("should succeed if the directories are writable and ignore missing directories", func() {
+			result, err := NewReadOnlyFilesystemProbe(fs, "/var/lib", "/does/not/exist").Probe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Healthy).To(BeTrue())
+
+			files, err := fs.ReadDir("/var/lib")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(BeEmpty())
+		})
+
+		It("should fail if a directory is not writable", func() {
+			result, err := NewReadOnlyFilesystemProbe(afero.Afero{Fs: afero.NewReadOnlyFs(fs)}, "/var/lib").Probe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Healthy).To(BeFalse())
+			Expect(result.Reason).To(Equal("ReadOnlyFilesystem"))
+			Expect(result.Message).To(HavePrefix("File systems are not writable: /var/lib ("))
+		})
+	})
+
+	Describe("#NewExecProbe", func() {
+		It("should succeed if the command succeeds", func() {
+			result, err := NewExecProbe([]string{"sh", "-c", "echo ok"}, time.Second).Probe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ProbeResult{Healthy: true, Reason: "CommandSucceeded", Message: "ok"}))
+		})
+
+		It("should fail if the command exits with a non-zero code", func() {
+			result, err := NewExecProbe([]string{"sh", "-c", "echo broken; exit 3"}, time.Second).Probe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ProbeResult{Reason: "CommandFailed", Message: "Command exited with code 3: broken"}))
+		})
+
+		It("should fail if the command times out", func() {
+			result, err := NewExecProbe([]string{"sleep", "5"}, 10*time.Millisecond).Probe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Reason).To(Equal("CommandTimedOut"))
+		})
+
+		It("should return an error if the command cannot be executed", func() {
+			_, err := NewExecProbe([]string{"/does/not/exist"}, time.Second).Probe(ctx)
+			Expect(err).To(MatchError(ContainSubstring("failed executing command")))
+		})
+	})
+
+	Describe("#NewHTTPProbe", func() {
+		var statusCode int
+
+		It("should probe the endpoint", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(statusCode)
+			}))
+			defer server.Close()
+			probe := NewHTTPProbe(server.Client(), server.URL)
+
+			statusCode = http.StatusOK
+			result, err := probe.Probe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Healthy).To(BeTrue())
+
+			statusCode = http.StatusServiceUnavailable
+			result, err = probe.Probe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Healthy).To(BeFalse())
+			Expect(result.Reason).To(Equal("EndpointUnhealthy"))
+
+			server.Close()
+			result, err = probe.Probe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Reason).To(Equal("EndpointUnreachable"))
+		})
+	})
+})
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+type readOnlyFilesystemProbe struct {
+	fs    afero.Afero
+	paths []string
+}
+
+// NewReadOnlyFilesystemProbe creates a new probe which fails if a file cannot be written to one of the given
+// directories. Directories which do not exist are ignored.
+func NewReadOnlyFilesystemProbe(fs afero.Afero, paths ...string) Probe {
+	return &readOnlyFilesystemProbe{
+		fs:    fs,
+		paths: paths,
+	}
+}
+
+// Probe writes and removes a temporary file in each directory.
+func (r *readOnlyFilesystemProbe) Probe(_ context.Context) (ProbeResult, error) {
+	var notWritable []string
+
+	for _, path := range r.paths {
+		if err := r.probeWritable(path); err != nil {
+			notWritable = append(notWritable, fmt.Sprintf("%s (%v)", path, err))
+		}
+	}
+
+	if len(notWritable) > 0 {
+		return ProbeResult{Reason: "ReadOnlyFilesystem", Message: "File systems are not writable: " + strings.Join(notWritable, ", ")}, nil
+	}
+
+	return ProbeResult{Healthy: true, Reason: "FilesystemsWritable", Message: "File systems are writable"}, nil
+}
+
+func (r *readOnlyFilesystemProbe) probeWritable(path string) error {
+	file, err := r.fs.TempFile(path, ".gardener-node-agent-write-probe-")
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return r.fs.Remove(file.Name())
+}
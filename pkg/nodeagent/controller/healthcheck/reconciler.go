@@ -14,15 +14,18 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
-// Reconciler checks for containerd and kubelet health and restarts them if required.
+// Reconciler checks for containerd and kubelet health and restarts them if required. Additional health checks can be
+// configured.
 type Reconciler struct {
 	Client                     client.Client
 	Recorder                   record.EventRecorder
 	DBus                       dbus.DBus
+	Config                     *nodeagentconfigv1alpha1.HealthCheckControllerConfig
 	HealthCheckers             []HealthChecker
 	HealthCheckIntervalSeconds int32
 }
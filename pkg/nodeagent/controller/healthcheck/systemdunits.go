@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+)
+
+type failedSystemdUnitsProbe struct {
+	dbus  dbus.DBus
+	units sets.Set[string]
+}
+
+// NewFailedSystemdUnitsProbe creates a new probe which fails if systemd units are in the 'failed' state. If units are
+// given, only these units are considered.
+func NewFailedSystemdUnitsProbe(dbus dbus.DBus, units ...string) Probe {
+	return &failedSystemdUnitsProbe{
+		dbus:  dbus,
+		units: sets.New(units...),
+	}
+}
+
+// Probe lists the systemd units and checks their active state.
+func (f *failedSystemdUnitsProbe) Probe(ctx context.Context) (ProbeResult, error) {
+	units, err := f.dbus.List(ctx)
+	if err != nil {
+		return ProbeResult{}, fmt.Errorf("failed listing systemd units: %w", err)
+	}
+
+	var failedUnits []string
+	for _, unit := range units {
+		if unit.ActiveState != "failed" {
+			continue
+		}
+		if f.units.Len() > 0 && !f.units.Has(unit.Name) {
+			continue
+		}
+		failedUnits = append(failedUnits, unit.Name)
+	}
+
+	if len(failedUnits) == 0 {
+		return ProbeResult{Healthy: true, Reason: "NoFailedUnits", Message: "No systemd units are in failed state"}, nil
+	}
+
+	slices.Sort(failedUnits)
+	return ProbeResult{Reason: "FailedUnits", Message: "Systemd units are in failed state: " + strings.Join(failedUnits, ", ")}, nil
+}
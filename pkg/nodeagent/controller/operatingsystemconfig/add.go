@@ -16,6 +16,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -41,6 +42,9 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 	if r.Client == nil {
 		r.Client = mgr.GetClient()
 	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
 	if r.Recorder == nil {
 		r.Recorder = mgr.GetEventRecorderFor(ControllerName)
 	}
@@ -65,7 +69,7 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 		Watches(
 			&corev1.Node{},
 			handler.EnqueueRequestsFromMapFunc(r.NodeToSecretMapper()),
-			builder.WithPredicates(r.NodeReadyForUpdate()),
+			builder.WithPredicates(predicate.Or(r.NodeReadyForUpdate(), r.NodeRolloutPermitted())),
 		).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: 1,
@@ -190,6 +194,19 @@ func (r *Reconciler) NodeReadyForUpdate() predicate.Predicate {
 	}
 }
 
+// NodeRolloutPermitted returns a predicate that returns true for Update events if the checksum of the operating system
+// config which the node is permitted to apply in a staged rollout has changed. It returns false for all other events.
+func (r *Reconciler) NodeRolloutPermitted() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.ObjectOld.GetAnnotations()[v1beta1constants.AnnotationNodeAgentRolloutPermittedChecksum] != e.ObjectNew.GetAnnotations()[v1beta1constants.AnnotationNodeAgentRolloutPermittedChecksum]
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
 func nodeHasInPlaceUpdateConditionWithReasonReadyForUpdate(conditions []corev1.NodeCondition) bool {
 	for _, condition := range conditions {
 		if condition.Type == machinev1alpha1.NodeInPlaceUpdate && condition.Reason == machinev1alpha1.ReadyForUpdate {
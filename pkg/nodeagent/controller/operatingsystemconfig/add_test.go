@@ -382,4 +382,39 @@ var _ = Describe("Add", func() {
 			})
 		})
 	})
+
+	Describe("#NodeRolloutPermitted", func() {
+		var (
+			p    predicate.Predicate
+			node *corev1.Node
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).NodeRolloutPermitted()
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"node-agent.gardener.cloud/rollout-permitted-checksum": "old"}}}
+		})
+
+		It("should return false for create events", func() {
+			Expect(p.Create(event.CreateEvent{Object: node})).To(BeFalse())
+		})
+
+		It("should return false if the permitted checksum did not change", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: node})).To(BeFalse())
+		})
+
+		It("should return true if the permitted checksum changed", func() {
+			newNode := node.DeepCopy()
+			newNode.Annotations["node-agent.gardener.cloud/rollout-permitted-checksum"] = "new"
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: newNode})).To(BeTrue())
+		})
+
+		It("should return false for delete events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: node})).To(BeFalse())
+		})
+
+		It("should return false for generic events", func() {
+			Expect(p.Generic(event.GenericEvent{Object: node})).To(BeFalse())
+		})
+	})
 })
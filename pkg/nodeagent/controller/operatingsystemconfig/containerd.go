@@ -5,6 +5,7 @@
 package operatingsystemconfig
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
@@ -249,18 +250,25 @@ func addRegistryToContainerdFunc(ctx context.Context, log logr.Logger, registryC
 		log.Info("Probing endpoints for image registry succeeded", "upstream", registryConfig.Upstream)
 	}
 
-	hostsTomlFilePath := path.Join(baseDir, "hosts.toml")
-	f, err := fs.OpenFile(hostsTomlFilePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	hostsToml, err := renderContainerdHosts(registryConfig)
 	if err != nil {
-		return fmt.Errorf("unable to open hosts.toml: %w", err)
+		return err
 	}
 
-	defer func() {
-		if err := f.Close(); err != nil {
-			log.Error(err, "Failed closing file", "file", f.Name())
-		}
-	}()
+	if err := fs.WriteFile(hostsTomlFilePath(registryConfig.Upstream), hostsToml, 0644); err != nil {
+		return fmt.Errorf("unable to write hosts.toml: %w", err)
+	}
+	log.Info("Configured registry config", "upstream", registryConfig.Upstream)
+	return nil
+}
 
+// hostsTomlFilePath returns the path of the hosts.toml file of the given upstream.
+func hostsTomlFilePath(upstream string) string {
+	return path.Join(certsDir, upstream, "hosts.toml")
+}
+
+// renderContainerdHosts returns the content of the hosts.toml file for the given registry config.
+func renderContainerdHosts(registryConfig extensionsv1alpha1.RegistryConfig) ([]byte, error) {
 	var (
 		values = map[string]any{
 			"server":      ptr.Deref(registryConfig.Server, ""),
@@ -290,11 +298,12 @@ func addRegistryToContainerdFunc(ctx context.Context, log logr.Logger, registryC
 		values["hostConfigs"] = append(values["hostConfigs"].([]any), hostConfig)
 	}
 
-	if err := tplContainerdHosts.Execute(f, values); err != nil {
-		return err
+	var buf bytes.Buffer
+	if err := tplContainerdHosts.Execute(&buf, values); err != nil {
+		return nil, err
 	}
-	log.Info("Configured registry config", "upstream", registryConfig.Upstream)
-	return nil
+
+	return buf.Bytes(), nil
 }
 
 func (r *Reconciler) cleanupUnusedContainerdRegistries(log logr.Logger, changes *operatingSystemConfigChanges) error {
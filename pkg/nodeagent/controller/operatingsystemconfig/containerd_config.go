@@ -5,6 +5,7 @@
 package operatingsystemconfig
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -114,15 +115,37 @@ func (r *Reconciler) ensureContainerdConfiguration(log logr.Logger, criConfig *e
 		return fmt.Errorf("unable to read containerd config.toml: %w", err)
 	}
 
+	desiredConfig, err := renderContainerdConfiguration(config, criConfig)
+	if err != nil {
+		return err
+	}
+
+	f, err := r.FS.OpenFile(configFile, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("unable to open containerd config.toml: %w", err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error(err, "Failed closing file", "file", f.Name())
+		}
+	}()
+
+	_, err = f.Write(desiredConfig)
+	return err
+}
+
+// renderContainerdConfiguration sets the values managed by gardener-node-agent in the given containerd configuration
+// and returns the resulting configuration.
+func renderContainerdConfiguration(config []byte, criConfig *extensionsv1alpha1.CRIConfig) ([]byte, error) {
 	content := map[string]any{}
 
-	if err = toml.Unmarshal(config, &content); err != nil {
-		return fmt.Errorf("unable to decode containerd default config: %w", err)
+	if err := toml.Unmarshal(config, &content); err != nil {
+		return nil, fmt.Errorf("unable to decode containerd default config: %w", err)
 	}
 
 	configFileVersion, err := getContainerdConfigFileVersion(content)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	type patch struct {
@@ -231,21 +254,16 @@ func (r *Reconciler) ensureContainerdConfiguration(log logr.Logger, criConfig *e
 
 	for _, p := range patches {
 		if err := structuredmap.SetMapEntry(content, p.path, p.setFn); err != nil {
-			return fmt.Errorf("unable setting %q in containerd config.toml: %w", p.name, err)
+			return nil, fmt.Errorf("unable setting %q in containerd config.toml: %w", p.name, err)
 		}
 	}
 
-	f, err := r.FS.OpenFile(configFile, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
-	if err != nil {
-		return fmt.Errorf("unable to open containerd config.toml: %w", err)
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(content); err != nil {
+		return nil, fmt.Errorf("unable to encode containerd config.toml: %w", err)
 	}
-	defer func() {
-		if err := f.Close(); err != nil {
-			log.Error(err, "Failed closing file", "file", f.Name())
-		}
-	}()
 
-	return toml.NewEncoder(f).Encode(content)
+	return buf.Bytes(), nil
 }
 
 func isConfigPathPrefix(path, prefix structuredmap.Path) bool {
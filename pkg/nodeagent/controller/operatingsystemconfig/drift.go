@@ -0,0 +1,313 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+const (
+	reasonDriftDetected = "DriftDetected"
+	reasonNoDrift       = "NoDrift"
+)
+
+// managedFile is a file on the host whose content is managed by gardener-node-agent.
+type managedFile struct {
+	path        string
+	content     []byte
+	permissions os.FileMode
+	// unitNames are the names of the units which must be restarted if the file is restored.
+	unitNames []string
+	// isUnitFile is true if the file is a unit file or a drop-in, i.e., if the systemd daemon must be reloaded if the
+	// file is restored.
+	isUnitFile bool
+}
+
+// detectDrift checks whether the files and units of the given operating system config were modified on the host and
+// handles modifications according to the configured drift policies. Modifications which are only reported are
+// reflected in the OperatingSystemConfigDrifted node condition.
+func (r *Reconciler) detectDrift(ctx context.Context, log logr.Logger, node *corev1.Node, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+	files, err := managedFiles(osc)
+	if err != nil {
+		return err
+	}
+
+	containerdFiles, err := r.managedContainerdFiles(ctx, osc)
+	if err != nil {
+		return err
+	}
+	files = append(files, containerdFiles...)
+
+	var (
+		reported, remediated []string
+		unitNamesToRestart   = sets.New[string]()
+		mustReloadDaemon     bool
+	)
+
+	for _, file := range files {
+		policy := r.driftPolicy(file.path)
+		if policy == nodeagentconfigv1alpha1.DriftPolicyIgnore {
+			continue
+		}
+
+		drift, err := r.fileDrift(file)
+		if err != nil {
+			return err
+		}
+		if drift == "" {
+			continue
+		}
+
+		log.Info("Detected drift of file managed by gardener-node-agent", "path", file.path, "drift", drift, "policy", policy)
+		description := fmt.Sprintf("%s (%s)", file.path, drift)
+
+		if policy == nodeagentconfigv1alpha1.DriftPolicyReport {
+			reported = append(reported, description)
+			continue
+		}
+
+		if err := r.restoreFile(file); err != nil {
+			return err
+		}
+		log.Info("Successfully restored file", "path", file.path)
+
+		remediated = append(remediated, description)
+		unitNamesToRestart.Insert(file.unitNames...)
+		mustReloadDaemon = mustReloadDaemon || file.isUnitFile
+	}
+
+	if mustReloadDaemon {
+		if err := r.DBus.DaemonReload(ctx); err != nil {
+			return fmt.Errorf("failed reloading systemd daemon: %w", err)
+		}
+		log.Info("Successfully reloaded systemd daemon")
+	}
+
+	for _, unitName := range sets.List(unitNamesToRestart) {
+		if err := r.DBus.Restart(ctx, r.Recorder, node, unitName); err != nil {
+			return fmt.Errorf("unable to restart unit %q: %w", unitName, err)
+		}
+		log.Info("Successfully restarted unit", "unitName", unitName)
+	}
+
+	if len(remediated) > 0 {
+		r.Recorder.Eventf(node, corev1.EventTypeNormal, "OSCDriftRemediated", "Restored files modified on the host: %s", strings.Join(remediated, ", "))
+	}
+
+	if len(reported) == 0 {
+		return r.patchNodeCondition(ctx, node, nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigDrifted, corev1.ConditionFalse, reasonNoDrift, "Files and units managed by gardener-node-agent are not modified on the host")
+	}
+
+	message := "Files modified on the host: " + strings.Join(reported, ", ")
+	if !slices.ContainsFunc(node.Status.Conditions, func(c corev1.NodeCondition) bool {
+		return c.Type == nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigDrifted && c.Status == corev1.ConditionTrue && c.Message == message
+	}) {
+		r.Recorder.Event(node, corev1.EventTypeWarning, "OSCDrifted", message)
+	}
+
+	return r.patchNodeCondition(ctx, node, nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigDrifted, corev1.ConditionTrue, reasonDriftDetected, message)
+}
+
+// managedFiles returns the files with inline content, the unit files and the drop-ins of the given operating system
+// config. Files referring to an image cannot be checked for drift since their content is not known.
+func managedFiles(osc *extensionsv1alpha1.OperatingSystemConfig) ([]managedFile, error) {
+	if extensionsv1alpha1helper.HasContainerdConfiguration(osc.Spec.CRIConfig) {
+		// The drop-in is added by ReconcileContainerdConfig which is not called before the drift detection.
+		osc = osc.DeepCopy()
+		addContainerdEnvironmentDropIn(osc)
+	}
+
+	var (
+		files = []managedFile{}
+		units = mergeUnits(osc.Spec.Units, osc.Status.ExtensionUnits)
+	)
+
+	unitNamesToRestart := func(units ...extensionsv1alpha1.Unit) []string {
+		var unitNames []string
+		for _, unit := range units {
+			// gardener-node-agent picks up the restored files when it restarts the next time. Restarting itself would
+			// interrupt the drift detection.
+			if unit.Name != nodeagentconfigv1alpha1.UnitName && getCommandToExecute(unit) != extensionsv1alpha1.CommandStop {
+				unitNames = append(unitNames, unit.Name)
+			}
+		}
+		return unitNames
+	}
+
+	for _, file := range collectAllFiles(osc) {
+		if file.Content.Inline == nil {
+			continue
+		}
+
+		data, err := extensionsv1alpha1helper.Decode(file.Content.Inline.Encoding, []byte(file.Content.Inline.Data))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode data of file %q: %w", file.Path, err)
+		}
+
+		var referencingUnits []extensionsv1alpha1.Unit
+		for _, unit := range units {
+			if slices.Contains(unit.FilePaths, file.Path) {
+				referencingUnits = append(referencingUnits, unit)
+			}
+		}
+
+		files = append(files, managedFile{
+			path:        file.Path,
+			content:     data,
+			permissions: getFilePermissions(file),
+			unitNames:   unitNamesToRestart(referencingUnits...),
+		})
+	}
+
+	for _, unit := range units {
+		unitFilePath := path.Join(etcSystemdSystem, unit.Name)
+
+		if unit.Content != nil {
+			files = append(files, managedFile{
+				path:        unitFilePath,
+				content:     []byte(*unit.Content),
+				permissions: defaultFilePermissions,
+				unitNames:   unitNamesToRestart(unit),
+				isUnitFile:  true,
+			})
+		}
+
+		for _, dropIn := range unit.DropIns {
+			files = append(files, managedFile{
+				path:        path.Join(unitFilePath+".d", dropIn.Name),
+				content:     []byte(dropIn.Content),
+				permissions: defaultFilePermissions,
+				unitNames:   unitNamesToRestart(unit),
+				isUnitFile:  true,
+			})
+		}
+	}
+
+	return files, nil
+}
+
+// managedContainerdFiles returns the containerd config file and the hosts.toml files of the registries which are
+// written by ReconcileContainerdConfig and ReconcileContainerdRegistries. gardener-node-agent only sets some values in
+// the containerd config file, hence its desired content is computed based on its current content.
+func (r *Reconciler) managedContainerdFiles(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig) ([]managedFile, error) {
+	if !extensionsv1alpha1helper.HasContainerdConfiguration(osc.Spec.CRIConfig) {
+		return nil, nil
+	}
+
+	config, err := r.FS.ReadFile(configFile)
+	if err != nil {
+		if !errors.Is(err, afero.ErrFileNotFound) {
+			return nil, fmt.Errorf("failed reading file %q: %w", configFile, err)
+		}
+
+		if config, err = Exec(ctx, "containerd", "config", "default"); err != nil {
+			return nil, fmt.Errorf("failed generating containerd default config: %w", err)
+		}
+	}
+
+	desiredConfig, err := renderContainerdConfiguration(config, osc.Spec.CRIConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []managedFile{{
+		path:        configFile,
+		content:     desiredConfig,
+		permissions: 0644,
+		unitNames:   []string{v1beta1constants.OperatingSystemConfigUnitNameContainerDService},
+	}}
+
+	// containerd reads the hosts.toml files whenever it pulls an image, i.e., it does not need to be restarted.
+	for _, registryConfig := range osc.Spec.CRIConfig.Containerd.Registries {
+		hostsToml, err := renderContainerdHosts(registryConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed rendering hosts.toml for upstream %q: %w", registryConfig.Upstream, err)
+		}
+
+		files = append(files, managedFile{
+			path:        hostsTomlFilePath(registryConfig.Upstream),
+			content:     hostsToml,
+			permissions: 0644,
+		})
+	}
+
+	return files, nil
+}
+
+// driftPolicy returns the policy of the first drift policy rule matching the given path, or the default policy.
+func (r *Reconciler) driftPolicy(filePath string) nodeagentconfigv1alpha1.DriftPolicy {
+	for _, rule := range r.Config.DriftDetection.Policies {
+		for _, pattern := range rule.Paths {
+			if matched, err := path.Match(pattern, filePath); err == nil && matched {
+				return rule.Policy
+			}
+		}
+	}
+
+	return ptr.Deref(r.Config.DriftDetection.DefaultPolicy, nodeagentconfigv1alpha1.DriftPolicyReport)
+}
+
+// fileDrift returns a short description of how the given file differs from its desired state on the host, or an
+// empty string if it does not differ.
+func (r *Reconciler) fileDrift(file managedFile) (string, error) {
+	info, err := r.FS.Stat(file.path)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return "missing", nil
+		}
+		return "", fmt.Errorf("failed checking file %q: %w", file.path, err)
+	}
+
+	content, err := r.FS.ReadFile(file.path)
+	if err != nil {
+		return "", fmt.Errorf("failed reading file %q: %w", file.path, err)
+	}
+
+	if sha256.Sum256(content) != sha256.Sum256(file.content) {
+		return "content", nil
+	}
+
+	if info.Mode().Perm() != file.permissions.Perm() {
+		return "permissions", nil
+	}
+
+	return "", nil
+}
+
+func (r *Reconciler) restoreFile(file managedFile) error {
+	if err := r.FS.MkdirAll(filepath.Dir(file.path), defaultDirPermissions); err != nil {
+		return fmt.Errorf("unable to create directory %q: %w", filepath.Dir(file.path), err)
+	}
+
+	if err := r.FS.WriteFile(file.path, file.content, file.permissions); err != nil {
+		return fmt.Errorf("unable to restore file %q: %w", file.path, err)
+	}
+
+	// WriteFile does not change the permissions of existing files.
+	if err := r.FS.Chmod(file.path, file.permissions); err != nil {
+		return fmt.Errorf("unable to restore permissions of file %q: %w", file.path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Drift", func() {
+	var (
+		ctx        context.Context
+		log        logr.Logger
+		fs         afero.Afero
+		fakeDBus   *fakedbus.DBus
+		c          client.Client
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+		node       *corev1.Node
+		osc        *extensionsv1alpha1.OperatingSystemConfig
+
+		driftConditionType = corev1.NodeConditionType(nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigDrifted)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		log = logr.Discard()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeDBus = fakedbus.New()
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&corev1.Node{}).Build()
+		recorder = record.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			Client:   c,
+			Clock:    clock.RealClock{},
+			FS:       fs,
+			DBus:     fakeDBus,
+			Recorder: recorder,
+			Config: nodeagentconfigv1alpha1.OperatingSystemConfigControllerConfig{
+				DriftDetection: &nodeagentconfigv1alpha1.DriftDetectionConfig{
+					SyncPeriod:    &metav1.Duration{Duration: time.Minute},
+					DefaultPolicy: ptr.To(nodeagentconfigv1alpha1.DriftPolicyReport),
+					Policies: []nodeagentconfigv1alpha1.DriftPolicyRule{
+						{Paths: []string{"/etc/foo/*", "/etc/systemd/system/foo.service", "/etc/systemd/system/foo.service.d/*"}, Policy: nodeagentconfigv1alpha1.DriftPolicyRemediate},
+						{Paths: []string{"/etc/ignored"}, Policy: nodeagentconfigv1alpha1.DriftPolicyIgnore},
+					},
+				},
+			},
+		}
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "test-node"}}
+		Expect(c.Create(ctx, node)).To(Succeed())
+
+		osc = &extensionsv1alpha1.OperatingSystemConfig{
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				Files: []extensionsv1alpha1.File{
+					{Path: "/etc/foo/config", Permissions: ptr.To[uint32](0644), Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: "Zm9v"}}},
+					{Path: "/etc/bar", Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "bar"}}},
+					{Path: "/etc/ignored", Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "ignored"}}},
+					{Path: "/opt/bin/image", Content: extensionsv1alpha1.FileContent{ImageRef: &extensionsv1alpha1.FileContentImageRef{Image: "image", FilePathInImage: "/image"}}},
+				},
+				Units: []extensionsv1alpha1.Unit{
+					{
+						Name:      "foo.service",
+						Content:   ptr.To("[Service]\nExecStart=/bin/foo"),
+						DropIns:   []extensionsv1alpha1.DropIn{{Name: "10-foo.conf", Content: "[Service]\nEnvironment=FOO=bar"}},
+						FilePaths: []string{"/etc/foo/config"},
+					},
+					{
+						Name:    "bar.service",
+						Enable:  ptr.To(false),
+						Content: ptr.To("[Service]\nExecStart=/bin/bar"),
+					},
+				},
+			},
+		}
+
+		Expect(fs.WriteFile("/etc/foo/config", []byte("foo"), 0644)).To(Succeed())
+		Expect(fs.WriteFile("/etc/bar", []byte("bar"), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/etc/systemd/system/foo.service", []byte("[Service]\nExecStart=/bin/foo"), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/etc/systemd/system/foo.service.d/10-foo.conf", []byte("[Service]\nEnvironment=FOO=bar"), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/etc/systemd/system/bar.service", []byte("[Service]\nExecStart=/bin/bar"), 0600)).To(Succeed())
+	})
+
+	Describe("#detectDrift", func() {
+		It("should report no drift if the files are not modified", func() {
+			Expect(reconciler.detectDrift(ctx, log, node, osc)).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(BeEmpty())
+			Expect(recorder.Events).To(BeEmpty())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(ConsistOf(And(
+				HaveField("Type", driftConditionType),
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("Reason", "NoDrift"),
+			)))
+		})
+
+		It("should report drift of files with the report policy", func() {
+			Expect(fs.WriteFile("/etc/bar", []byte("modified"), 0600)).To(Succeed())
+			Expect(fs.Chmod("/etc/systemd/system/bar.service", 0644)).To(Succeed())
+
+			Expect(reconciler.detectDrift(ctx, log, node, osc)).To(Succeed())
+
+			test.AssertFileOnDisk(fs, "/etc/bar", "modified", 0600)
+			Expect(fakeDBus.Actions).To(BeEmpty())
+			Expect(recorder.Events).To(Receive(Equal("Warning OSCDrifted Files modified on the host: /etc/bar (content), /etc/systemd/system/bar.service (permissions)")))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(ConsistOf(And(
+				HaveField("Type", driftConditionType),
+				HaveField("Status", corev1.ConditionTrue),
+				HaveField("Reason", "DriftDetected"),
+				HaveField("Message", "Files modified on the host: /etc/bar (content), /etc/systemd/system/bar.service (permissions)"),
+			)))
+
+			By("Detect the same drift again")
+			Expect(reconciler.detectDrift(ctx, log, node, osc)).To(Succeed())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should restore files with the remediate policy and restart the affected units", func() {
+			Expect(fs.WriteFile("/etc/foo/config", []byte("modified"), 0644)).To(Succeed())
+			Expect(fs.Remove("/etc/systemd/system/foo.service.d/10-foo.conf")).To(Succeed())
+
+			Expect(reconciler.detectDrift(ctx, log, node, osc)).To(Succeed())
+
+			test.AssertFileOnDisk(fs, "/etc/foo/config", "foo", 0644)
+			test.AssertFileOnDisk(fs, "/etc/systemd/system/foo.service.d/10-foo.conf", "[Service]\nEnvironment=FOO=bar", 0600)
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionDaemonReload},
+				{Action: fakedbus.ActionRestart, UnitNames: []string{"foo.service"}},
+			}))
+			Expect(recorder.Events).To(Receive(Equal("Normal OSCDriftRemediated Restored files modified on the host: /etc/foo/config (content), /etc/systemd/system/foo.service.d/10-foo.conf (missing)")))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(ConsistOf(HaveField("Status", corev1.ConditionFalse)))
+		})
+
+		It("should use the default policy for files not matched by any policy", func() {
+			reconciler.Config.DriftDetection.DefaultPolicy = ptr.To(nodeagentconfigv1alpha1.DriftPolicyRemediate)
+			Expect(fs.Chmod("/etc/bar", 0666)).To(Succeed())
+
+			Expect(reconciler.detectDrift(ctx, log, node, osc)).To(Succeed())
+
+			test.AssertFileOnDisk(fs, "/etc/bar", "bar", 0600)
+			Expect(fakeDBus.Actions).To(BeEmpty())
+		})
+
+		Context("containerd configuration", func() {
+			var (
+				desiredConfig    []byte
+				hostsTomlPath    = "/etc/containerd/certs.d/docker.io/hosts.toml"
+				desiredHostsToml []byte
+			)
+
+			BeforeEach(func() {
+				osc.Spec.CRIConfig = &extensionsv1alpha1.CRIConfig{
+					Name: extensionsv1alpha1.CRINameContainerD,
+					Containerd: &extensionsv1alpha1.ContainerdConfig{
+						SandboxImage: "pause:1.0",
+						Registries: []extensionsv1alpha1.RegistryConfig{{
+							Upstream: "docker.io",
+							Server:   ptr.To("https://registry-1.docker.io"),
+							Hosts:    []extensionsv1alpha1.RegistryHost{{URL: "https://mirror.example.com"}},
+						}},
+					},
+				}
+				reconciler.Config.DriftDetection.Policies = append(reconciler.Config.DriftDetection.Policies, nodeagentconfigv1alpha1.DriftPolicyRule{
+					Paths:  []string{"/etc/containerd/config.toml"},
+					Policy: nodeagentconfigv1alpha1.DriftPolicyRemediate,
+				})
+
+				Expect(fs.WriteFile("/etc/containerd/config.toml", []byte("version = 2\n"), 0644)).To(Succeed())
+				oscWithDropIn := osc.DeepCopy()
+				Expect(reconciler.ReconcileContainerdConfig(ctx, log, oscWithDropIn)).To(Succeed())
+				Expect(addRegistryToContainerdFunc(ctx, log, osc.Spec.CRIConfig.Containerd.Registries[0], false, fs)).To(Succeed())
+
+				dropIn := oscWithDropIn.Spec.Units[len(oscWithDropIn.Spec.Units)-1].DropIns[0]
+				Expect(fs.WriteFile("/etc/systemd/system/containerd.service.d/"+dropIn.Name, []byte(dropIn.Content), 0600)).To(Succeed())
+
+				var err error
+				desiredConfig, err = fs.ReadFile("/etc/containerd/config.toml")
+				Expect(err).NotTo(HaveOccurred())
+				desiredHostsToml, err = fs.ReadFile(hostsTomlPath)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should report no drift if the containerd files are not modified", func() {
+				Expect(reconciler.detectDrift(ctx, log, node, osc)).To(Succeed())
+
+				Expect(fakeDBus.Actions).To(BeEmpty())
+				Expect(recorder.Events).To(BeEmpty())
+			})
+
+			It("should restore the containerd config file and report drift of the registry files", func() {
+				Expect(fs.WriteFile("/etc/containerd/config.toml", []byte("version = 2\n[plugins.\"io.containerd.grpc.v1.cri\"]\nsandbox_image = \"modified\"\n"), 0644)).To(Succeed())
+				Expect(fs.WriteFile(hostsTomlPath, []byte("modified"), 0644)).To(Succeed())
+
+				Expect(reconciler.detectDrift(ctx, log, node, osc)).To(Succeed())
+
+				test.AssertFileOnDisk(fs, "/etc/containerd/config.toml", string(desiredConfig), 0644)
+				test.AssertFileOnDisk(fs, hostsTomlPath, "modified", 0644)
+				Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+					{Action: fakedbus.ActionRestart, UnitNames: []string{"containerd.service"}},
+				}))
+				Expect(recorder.Events).To(Receive(Equal("Normal OSCDriftRemediated Restored files modified on the host: /etc/containerd/config.toml (content)")))
+				Expect(recorder.Events).To(Receive(Equal("Warning OSCDrifted Files modified on the host: " + hostsTomlPath + " (content)")))
+
+				By("Restore the registry file")
+				Expect(fs.WriteFile(hostsTomlPath, desiredHostsToml, 0644)).To(Succeed())
+				Expect(reconciler.detectDrift(ctx, log, node, osc)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				Expect(node.Status.Conditions).To(ConsistOf(HaveField("Status", corev1.ConditionFalse)))
+			})
+		})
+	})
+})
@@ -34,6 +34,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -59,7 +60,7 @@ import (
 )
 
 const (
-	lastAppliedOperatingSystemConfigFilePath         = nodeagentconfigv1alpha1.BaseDir + "/last-applied-osc.yaml"
+	lastAppliedOperatingSystemConfigFilePath         = nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath
 	lastComputedOperatingSystemConfigChangesFilePath = nodeagentconfigv1alpha1.BaseDir + "/last-computed-osc-changes.yaml"
 
 	annotationUpdatingOperatingSystemVersion = "node-agent.gardener.cloud/updating-operating-system-version"
@@ -96,6 +97,7 @@ func init() {
 // node.
 type Reconciler struct {
 	Client        client.Client
+	Clock         clock.PassiveClock
 	Config        nodeagentconfigv1alpha1.OperatingSystemConfigControllerConfig
 	ConfigDir     string
 	Recorder      record.EventRecorder
@@ -164,6 +166,26 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 	}
 
+	if rolledBack, err := r.skipRolledBackOperatingSystemConfig(ctx, log, node, oscChecksum); err != nil || rolledBack {
+		return reconcile.Result{}, err
+	}
+
+	if waitForRolloutPermission(secret, node, osc, oscChecksum) {
+		log.Info("Operating system config is rolled out in stages, waiting for the permission to apply it", "checksum", oscChecksum)
+		return reconcile.Result{}, nil
+	}
+
+	upToDate := node != nil && node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] == oscChecksum
+	if upToDate && r.Config.DriftDetection != nil {
+		// The drift detection must run before the containerd configuration is reconciled since the containerd config
+		// file would be overwritten otherwise, i.e., its drift could neither be reported nor remediated.
+		log.Info("Configuration on this node is up to date, checking files and units for drift")
+		if err := r.detectDrift(ctx, log, node, osc); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed detecting drift: %w", err)
+		}
+		return reconcile.Result{RequeueAfter: r.Config.DriftDetection.SyncPeriod.Duration}, nil
+	}
+
 	log.Info("Applying containerd configuration")
 	if err := r.ReconcileContainerdConfig(ctx, log, osc); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed reconciling containerd configuration: %w", err)
@@ -180,7 +202,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed calculating the OSC changes: %w", err)
 	}
 
-	if node != nil && node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] == oscChecksum {
+	if upToDate {
 		log.Info("Configuration on this node is up to date, nothing to be done")
 		return reconcile.Result{}, nil
 	}
@@ -219,45 +241,41 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		)
 	}
 
-	log.Info("Applying new or changed inline files")
-	if err := r.applyChangedInlineFiles(log, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed inline files: %w", err)
-	}
+	var (
+		rollbackEnabled = r.rollbackEnabled(oscChanges)
+		unitNames       = unitsToVerify(oscChanges)
+	)
 
-	log.Info("Applying containerd registries")
-	waitForRegistries, err := r.ReconcileContainerdRegistries(ctx, log, oscChanges)
+	waitForRegistries, err := r.applyChanges(ctx, log, node, oscChanges)
 	if err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed reconciling containerd registries: %w", err)
-	}
-
-	log.Info("Applying new or changed imageRef files")
-	if err := r.applyChangedImageRefFiles(ctx, log, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed imageRef files: %w", err)
+		return reconcile.Result{}, err
 	}
 
-	log.Info("Applying new or changed units", "changedUnits", len(oscChanges.Units.Changed))
-	if err := r.applyChangedUnits(ctx, log, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed units: %w", err)
+	if isInPlaceKubeletUpdate(oscChanges) {
+		if err := r.completeKubeletInPlaceUpdate(ctx, log, oscChanges, node); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed completing kubelet in-place update: %w", err)
+		}
 	}
 
-	log.Info("Removing no longer needed units", "deletedUnits", len(oscChanges.Units.Deleted))
-	if err := r.removeDeletedUnits(ctx, log, node, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed removing deleted units: %w", err)
-	}
+	if rollbackEnabled {
+		verification, err := r.startUnitHealthVerification(oscChecksum, unitNames)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed starting verification of started units: %w", err)
+		}
 
-	log.Info("Reloading systemd daemon")
-	if err := r.DBus.DaemonReload(ctx); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed reloading systemd daemon: %w", err)
-	}
+		log.Info("Verifying health of started units", "unitNames", verification.UnitNames, "deadline", verification.Deadline)
+		requeue, unhealthyErr := r.verifyUnitsHealthy(ctx, verification)
+		if requeue {
+			log.Info("Started units are not healthy yet, requeuing", "requeueAfter", UnitHealthCheckRetryInterval)
+			return reconcile.Result{RequeueAfter: UnitHealthCheckRetryInterval}, nil
+		}
 
-	log.Info("Executing unit commands (start/stop)", "unitCommands", len(oscChanges.Units.Commands))
-	if err := r.executeUnitCommands(ctx, log, node, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed executing unit commands: %w", err)
-	}
+		if err := r.completeUnitHealthVerification(); err != nil {
+			return reconcile.Result{}, err
+		}
 
-	if isInPlaceKubeletUpdate(oscChanges) {
-		if err := r.completeKubeletInPlaceUpdate(ctx, log, oscChanges, node); err != nil {
-			return reconcile.Result{}, fmt.Errorf("failed completing kubelet in-place update: %w", err)
+		if unhealthyErr != nil {
+			return r.rollback(ctx, log, node, osc, oscChecksum, unhealthyErr)
 		}
 	}
 
@@ -312,6 +330,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{RequeueAfter: 5 * time.Second}, nil
 	}
 
+	if err := r.cleanUpRollback(ctx, log, node, oscChecksum); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed cleaning up rollback state: %w", err)
+	}
+
 	log.Info("Deleting kubelet bootstrap kubeconfig file (in case it still exists)")
 	if err := r.FS.Remove(kubeletcomponent.PathKubeconfigBootstrap); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
 		return reconcile.Result{}, fmt.Errorf("failed removing kubelet bootstrap kubeconfig file %q: %w", kubeletcomponent.PathKubeconfigBootstrap, err)
@@ -325,7 +347,54 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	metav1.SetMetaDataLabel(&node.ObjectMeta, v1beta1constants.LabelWorkerKubernetesVersion, r.Config.KubernetesVersion.String())
 	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, oscChecksum)
 
-	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, r.Client.Patch(ctx, node, patch)
+	requeueAfter := r.Config.SyncPeriod.Duration
+	if r.Config.DriftDetection != nil {
+		requeueAfter = min(requeueAfter, r.Config.DriftDetection.SyncPeriod.Duration)
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, r.Client.Patch(ctx, node, patch)
+}
+
+// applyChanges applies the changed files and units, removes the deleted units and executes the unit commands. The
+// returned function waits for the containerd registries to be configured.
+func (r *Reconciler) applyChanges(ctx context.Context, log logr.Logger, node *corev1.Node, oscChanges *operatingSystemConfigChanges) (func() error, error) {
+	log.Info("Applying new or changed inline files")
+	if err := r.applyChangedInlineFiles(log, oscChanges); err != nil {
+		return nil, fmt.Errorf("failed applying changed inline files: %w", err)
+	}
+
+	log.Info("Applying containerd registries")
+	waitForRegistries, err := r.ReconcileContainerdRegistries(ctx, log, oscChanges)
+	if err != nil {
+		return nil, fmt.Errorf("failed reconciling containerd registries: %w", err)
+	}
+
+	log.Info("Applying new or changed imageRef files")
+	if err := r.applyChangedImageRefFiles(ctx, log, oscChanges); err != nil {
+		return nil, fmt.Errorf("failed applying changed imageRef files: %w", err)
+	}
+
+	log.Info("Applying new or changed units", "changedUnits", len(oscChanges.Units.Changed))
+	if err := r.applyChangedUnits(ctx, log, oscChanges); err != nil {
+		return nil, fmt.Errorf("failed applying changed units: %w", err)
+	}
+
+	log.Info("Removing no longer needed units", "deletedUnits", len(oscChanges.Units.Deleted))
+	if err := r.removeDeletedUnits(ctx, log, node, oscChanges); err != nil {
+		return nil, fmt.Errorf("failed removing deleted units: %w", err)
+	}
+
+	log.Info("Reloading systemd daemon")
+	if err := r.DBus.DaemonReload(ctx); err != nil {
+		return nil, fmt.Errorf("failed reloading systemd daemon: %w", err)
+	}
+
+	log.Info("Executing unit commands (start/stop)", "unitCommands", len(oscChanges.Units.Commands))
+	if err := r.executeUnitCommands(ctx, log, node, oscChanges); err != nil {
+		return nil, fmt.Errorf("failed executing unit commands: %w", err)
+	}
+
+	return waitForRegistries, nil
 }
 
 func (r *Reconciler) getNode(ctx context.Context) (*corev1.Node, bool, error) {
@@ -775,23 +844,34 @@ func isInPlaceKubeletUpdate(changes *operatingSystemConfigChanges) bool {
 	return changes.InPlaceUpdates.Kubelet.MinorVersion || changes.InPlaceUpdates.Kubelet.Config || changes.InPlaceUpdates.Kubelet.CPUManagerPolicy
 }
 
-func (r *Reconciler) checkKubeletHealth(ctx context.Context, log logr.Logger, node *corev1.Node) error {
+// checkKubeletHealthEndpoint returns an error if the kubelet health endpoint does not respond with 200 OK.
+func checkKubeletHealthEndpoint(ctx context.Context) error {
 	httpClient := &http.Client{Timeout: 10 * time.Second}
 	request, err := http.NewRequestWithContext(ctx, http.MethodGet, healthcheckcontroller.DefaultKubeletHealthEndpoint, nil)
 	if err != nil {
-		log.Error(err, "Creating request to kubelet health endpoint failed")
-		return err
+		return fmt.Errorf("creating request to kubelet health endpoint failed: %w", err)
 	}
 
-	if err := retryutils.UntilTimeout(ctx, KubeletHealthCheckRetryInterval, KubeletHealthCheckRetryTimeout, func(_ context.Context) (bool, error) {
-		if response, err2 := httpClient.Do(request); err2 != nil {
-			return retryutils.MinorError(fmt.Errorf("HTTP request to kubelet health endpoint failed: %w", err2))
-		} else if response.StatusCode == http.StatusOK {
-			log.Info("Kubelet is healthy after in-place update")
-			return retryutils.Ok()
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("HTTP request to kubelet health endpoint failed: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("kubelet health endpoint responded with status code %d", response.StatusCode)
+	}
+	return nil
+}
+
+func (r *Reconciler) checkKubeletHealth(ctx context.Context, log logr.Logger, node *corev1.Node) error {
+	if err := retryutils.UntilTimeout(ctx, KubeletHealthCheckRetryInterval, KubeletHealthCheckRetryTimeout, func(ctx context.Context) (bool, error) {
+		if err := checkKubeletHealthEndpoint(ctx); err != nil {
+			return retryutils.MinorError(err)
 		}
 
-		return retryutils.NotOk()
+		log.Info("Kubelet is healthy after in-place update")
+		return retryutils.Ok()
 	}); err != nil {
 		if patchErr := r.patchNodeUpdateFailed(ctx, log, node, fmt.Sprintf("kubelet is not healthy after in-place update: %s", err.Error())); patchErr != nil {
 			return patchErr
@@ -1068,6 +1148,11 @@ func (r *Reconciler) updateOSInPlace(ctx context.Context, log logr.Logger, oscCh
 	return nil
 }
 
+// patchNodeCondition patches the node condition of the given type if its status, reason or message changed.
+func (r *Reconciler) patchNodeCondition(ctx context.Context, node *corev1.Node, conditionType corev1.NodeConditionType, status corev1.ConditionStatus, reason, message string) error {
+	return nodeagent.PatchNodeCondition(ctx, r.Client, r.Clock, node, conditionType, status, reason, message)
+}
+
 func (r *Reconciler) patchNodeUpdateSuccessful(ctx context.Context, log logr.Logger, node *corev1.Node) error {
 	log.Info("Marking the node with in-place update successful label", "node", node.Name)
 
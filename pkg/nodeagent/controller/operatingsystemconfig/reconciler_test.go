@@ -23,6 +23,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/component-base/version"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -70,6 +71,7 @@ var _ = Describe("Reconciler", func() {
 
 		reconciler = &Reconciler{
 			Client:    c,
+			Clock:     clock.RealClock{},
 			FS:        fs,
 			DBus:      fakeDBus,
 			ConfigDir: "/var/lib/gardener-node-agent",
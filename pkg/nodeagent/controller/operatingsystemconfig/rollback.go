@@ -0,0 +1,351 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+const (
+	// rollbackOperatingSystemConfigFilePath contains the last known-good operating system config while a rollback is in
+	// progress.
+	rollbackOperatingSystemConfigFilePath = nodeagentconfigv1alpha1.BaseDir + "/rollback-osc.yaml"
+	// failedOperatingSystemConfigChecksumFilePath contains the checksum of the operating system config which was rolled
+	// back. It is not applied again.
+	failedOperatingSystemConfigChecksumFilePath = nodeagentconfigv1alpha1.BaseDir + "/failed-osc-checksum"
+	// unitHealthVerificationFilePath contains the state of the verification of the units started for an operating
+	// system config while it is in progress.
+	unitHealthVerificationFilePath = nodeagentconfigv1alpha1.BaseDir + "/unit-health-verification.yaml"
+
+	reasonUnitsUnhealthy   = "UnitsUnhealthy"
+	reasonKubeletUnhealthy = "KubeletUnhealthy"
+	reasonApplied          = "OperatingSystemConfigApplied"
+)
+
+var (
+	// errKubeletUnhealthy is returned (wrapped) by checkUnitsHealthy if the kubelet health endpoint does not succeed.
+	errKubeletUnhealthy = errors.New("kubelet is not healthy")
+	// errUnitFailed is returned (wrapped) by checkUnitsHealthy if one of the units is in the failed state.
+	errUnitFailed = errors.New("unit failed")
+)
+
+// UnitHealthCheckRetryInterval is the interval at which the health of the started units is checked after applying an
+// operating system config.
+const UnitHealthCheckRetryInterval = 5 * time.Second
+
+// rollbackEnabled returns true if the given changes should be rolled back when the started units do not become
+// healthy. In-place updates have their own failure handling, and there is nothing to roll back to if no operating
+// system config was applied before.
+func (r *Reconciler) rollbackEnabled(changes *operatingSystemConfigChanges) bool {
+	if r.Config.Rollback == nil || r.SkipWritingStateFiles || isInPlaceUpdate(changes) {
+		return false
+	}
+
+	exists, err := r.FS.Exists(lastAppliedOperatingSystemConfigFilePath)
+	return err == nil && exists
+}
+
+// unitsToVerify returns the names of the units which are started or restarted when the given changes are applied.
+func unitsToVerify(changes *operatingSystemConfigChanges) []string {
+	var unitNames []string
+
+	for _, unit := range changes.Units.Commands {
+		if unit.Name == nodeagentconfigv1alpha1.UnitName || (unit.Command != extensionsv1alpha1.CommandStart && unit.Command != extensionsv1alpha1.CommandRestart) {
+			continue
+		}
+		unitNames = append(unitNames, unit.Name)
+	}
+
+	if changes.Containerd.ConfigFileChanged && !slices.Contains(unitNames, v1beta1constants.OperatingSystemConfigUnitNameContainerDService) {
+		unitNames = append(unitNames, v1beta1constants.OperatingSystemConfigUnitNameContainerDService)
+	}
+
+	return unitNames
+}
+
+// unitHealthVerification is the state of the verification of the units started for an operating system config. It
+// is persisted so that the verification is continued with the same units and deadline after gardener-node-agent was
+// restarted.
+type unitHealthVerification struct {
+	// OperatingSystemConfigChecksum is the checksum of the operating system config whose units are verified.
+	OperatingSystemConfigChecksum string `json:"operatingSystemConfigChecksum"`
+	// UnitNames are the names of the units which were started or restarted when applying the operating system config.
+	UnitNames []string `json:"unitNames,omitempty"`
+	// Deadline is the time until which the units have to become healthy.
+	Deadline metav1.Time `json:"deadline"`
+}
+
+// startUnitHealthVerification returns the persisted verification state for the operating system config with the given
+// checksum. If there is none, the verification of the given units is started with a deadline based on the grace
+// period. The given units are ignored when the verification is continued since the units which were already started
+// are no longer part of the remaining changes.
+func (r *Reconciler) startUnitHealthVerification(oscChecksum string, unitNames []string) (*unitHealthVerification, error) {
+	raw, err := r.FS.ReadFile(unitHealthVerificationFilePath)
+	if err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return nil, fmt.Errorf("failed reading file %q: %w", unitHealthVerificationFilePath, err)
+	}
+
+	if err == nil {
+		verification := &unitHealthVerification{}
+		if err := yaml.Unmarshal(raw, verification); err != nil {
+			return nil, fmt.Errorf("failed unmarshalling the unit health verification: %w", err)
+		}
+
+		if verification.OperatingSystemConfigChecksum == oscChecksum {
+			return verification, nil
+		}
+	}
+
+	verification := &unitHealthVerification{
+		OperatingSystemConfigChecksum: oscChecksum,
+		UnitNames:                     unitNames,
+		Deadline:                      metav1.NewTime(r.Clock.Now().Add(r.Config.Rollback.GracePeriod.Duration)),
+	}
+
+	raw, err = yaml.Marshal(verification)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling the unit health verification: %w", err)
+	}
+
+	if err := r.FS.WriteFile(unitHealthVerificationFilePath, raw, 0600); err != nil {
+		return nil, fmt.Errorf("unable to write unit health verification to file path %q: %w", unitHealthVerificationFilePath, err)
+	}
+
+	return verification, nil
+}
+
+// completeUnitHealthVerification removes the persisted verification state.
+func (r *Reconciler) completeUnitHealthVerification() error {
+	if err := r.FS.Remove(unitHealthVerificationFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return fmt.Errorf("failed removing file %q: %w", unitHealthVerificationFilePath, err)
+	}
+	return nil
+}
+
+// verifyUnitsHealthy checks the health of the units of the given verification once. It returns true if the units are
+// not healthy yet but might still become healthy before the deadline, i.e., the check should be repeated after
+// UnitHealthCheckRetryInterval. It returns an error if one of the units is in the failed state or if the units did not
+// become healthy before the deadline.
+func (r *Reconciler) verifyUnitsHealthy(ctx context.Context, verification *unitHealthVerification) (bool, error) {
+	err := r.checkUnitsHealthy(ctx, verification.UnitNames)
+	if err == nil {
+		return false, nil
+	}
+
+	if !errors.Is(err, errUnitFailed) && r.Clock.Now().Before(verification.Deadline.Time) {
+		return true, nil
+	}
+
+	return false, err
+}
+
+// checkUnitsHealthy checks that none of the given units is failed or still activating. If the kubelet is one of the
+// units, its health endpoint must succeed as well.
+func (r *Reconciler) checkUnitsHealthy(ctx context.Context, unitNames []string) error {
+	if len(unitNames) == 0 {
+		return nil
+	}
+
+	unitStatuses, err := r.DBus.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed listing units: %w", err)
+	}
+
+	activeStates := make(map[string]string, len(unitStatuses))
+	for _, unitStatus := range unitStatuses {
+		activeStates[unitStatus.Name] = unitStatus.ActiveState
+	}
+
+	var notActive []string
+	for _, unitName := range unitNames {
+		switch activeState := activeStates[unitName]; activeState {
+		case "failed":
+			return fmt.Errorf("%w: unit %q is in failed state", errUnitFailed, unitName)
+		case "activating", "deactivating", "reloading":
+			notActive = append(notActive, fmt.Sprintf("%s (%s)", unitName, activeState))
+		}
+	}
+
+	if len(notActive) > 0 {
+		return fmt.Errorf("units are not active yet: %s", strings.Join(notActive, ", "))
+	}
+
+	if slices.Contains(unitNames, v1beta1constants.OperatingSystemConfigUnitNameKubeletService) {
+		if err := checkKubeletHealthEndpoint(ctx); err != nil {
+			return fmt.Errorf("%w: %w", errKubeletUnhealthy, err)
+		}
+	}
+
+	return nil
+}
+
+// rollback re-applies the last applied operating system config after the given one has been applied but the started
+// units did not become healthy. The checksum of the failed operating system config is persisted so that it is not
+// applied again.
+func (r *Reconciler) rollback(ctx context.Context, log logr.Logger, node *corev1.Node, failedOSC *extensionsv1alpha1.OperatingSystemConfig, failedOSCChecksum string, cause error) (reconcile.Result, error) {
+	log.Error(cause, "Units are not healthy after applying operating system config, rolling back to last applied operating system config", "checksum", failedOSCChecksum)
+
+	lastAppliedOSCRaw, err := r.FS.ReadFile(lastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reading last applied OSC from file path %q: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	if err := r.FS.WriteFile(rollbackOperatingSystemConfigFilePath, lastAppliedOSCRaw, 0600); err != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to write last applied OSC to file path %q: %w", rollbackOperatingSystemConfigFilePath, err)
+	}
+
+	if err := r.FS.WriteFile(failedOperatingSystemConfigChecksumFilePath, []byte(failedOSCChecksum), 0600); err != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to write failed OSC checksum to file path %q: %w", failedOperatingSystemConfigChecksumFilePath, err)
+	}
+
+	// The failed operating system config is what is on the disk now, hence the changes for the rollback must be computed
+	// based on it.
+	failedOSCRaw, err := runtime.Encode(codec, failedOSC)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to encode OSC: %w", err)
+	}
+
+	if err := r.FS.WriteFile(lastAppliedOperatingSystemConfigFilePath, failedOSCRaw, 0600); err != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to write failed OSC to file path %q: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	reason := reasonUnitsUnhealthy
+	if errors.Is(cause, errKubeletUnhealthy) {
+		reason = reasonKubeletUnhealthy
+	}
+
+	message := fmt.Sprintf("Operating system config with checksum %s was rolled back to the last applied operating system config: %s", failedOSCChecksum, cause.Error())
+	if node != nil {
+		r.Recorder.Event(node, corev1.EventTypeWarning, "OSCRolledBack", message)
+		if err := r.patchNodeCondition(ctx, node, nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigRolledBack, corev1.ConditionTrue, reason, message); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return r.completeRollback(ctx, log, node, failedOSCChecksum)
+}
+
+// completeRollback applies the last known-good operating system config persisted by rollback. It is also used to
+// resume a rollback which was interrupted.
+func (r *Reconciler) completeRollback(ctx context.Context, log logr.Logger, node *corev1.Node, failedOSCChecksum string) (reconcile.Result, error) {
+	oscRaw, err := r.FS.ReadFile(rollbackOperatingSystemConfigFilePath)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reading OSC to roll back to from file path %q: %w", rollbackOperatingSystemConfigFilePath, err)
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := runtime.DecodeInto(decoder, oscRaw, osc); err != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to decode OSC to roll back to: %w", err)
+	}
+
+	log.Info("Rolling back containerd configuration")
+	if err := r.ReconcileContainerdConfig(ctx, log, osc); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed rolling back containerd configuration: %w", err)
+	}
+
+	osVersion, err := GetOSVersion(osc.Spec.InPlaceUpdates, r.FS)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed getting OS version: %w", err)
+	}
+
+	oscChanges, err := computeOperatingSystemConfigChanges(log, r.FS, osc, "rollback-"+failedOSCChecksum, osVersion, false)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed calculating the OSC changes for the rollback: %w", err)
+	}
+
+	waitForRegistries, err := r.applyChanges(ctx, log, node, oscChanges)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed rolling back: %w", err)
+	}
+
+	log.Info("Waiting for containerd registries to be configured")
+	if err := waitForRegistries(); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed configuring containerd registries: %w", err)
+	}
+
+	log.Info("Removing no longer needed files")
+	if err := r.removeDeletedFiles(log, oscChanges); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed removing deleted files: %w", err)
+	}
+
+	if err := r.FS.WriteFile(lastAppliedOperatingSystemConfigFilePath, oscRaw, 0600); err != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to write rolled back OSC to file path %q: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	if err := r.FS.Remove(rollbackOperatingSystemConfigFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return reconcile.Result{}, fmt.Errorf("failed removing file %q: %w", rollbackOperatingSystemConfigFilePath, err)
+	}
+
+	log.Info("Successfully rolled back to last applied operating system config")
+
+	if oscChanges.MustRestartNodeAgent {
+		return r.restartNodeAgent(oscChanges, log)
+	}
+
+	// Do not retry, the failed operating system config is not applied again until it changes.
+	return reconcile.Result{}, nil
+}
+
+// skipRolledBackOperatingSystemConfig returns true if the operating system config with the given checksum was rolled
+// back before. An interrupted rollback is resumed.
+func (r *Reconciler) skipRolledBackOperatingSystemConfig(ctx context.Context, log logr.Logger, node *corev1.Node, oscChecksum string) (bool, error) {
+	failedOSCChecksum, err := r.FS.ReadFile(failedOperatingSystemConfigChecksumFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed reading file %q: %w", failedOperatingSystemConfigChecksumFilePath, err)
+	}
+
+	if string(failedOSCChecksum) != oscChecksum {
+		return false, nil
+	}
+
+	if exists, err := r.FS.Exists(rollbackOperatingSystemConfigFilePath); err != nil {
+		return false, fmt.Errorf("failed checking whether file %q exists: %w", rollbackOperatingSystemConfigFilePath, err)
+	} else if exists {
+		log.Info("Resuming rollback of operating system config", "checksum", oscChecksum)
+		_, err := r.completeRollback(ctx, log, node, oscChecksum)
+		return true, err
+	}
+
+	log.Info("Operating system config was rolled back before, not applying it again until it changes", "checksum", oscChecksum)
+	return true, nil
+}
+
+// cleanUpRollback removes the state of a previous rollback after an operating system config was applied successfully.
+func (r *Reconciler) cleanUpRollback(ctx context.Context, log logr.Logger, node *corev1.Node, oscChecksum string) error {
+	for _, path := range []string{failedOperatingSystemConfigChecksumFilePath, rollbackOperatingSystemConfigFilePath} {
+		if err := r.FS.Remove(path); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("failed removing file %q: %w", path, err)
+		}
+	}
+
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigRolledBack && condition.Status != corev1.ConditionFalse {
+			log.Info("Operating system config was applied successfully after a rollback, updating node condition")
+			return r.patchNodeCondition(ctx, node, nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigRolledBack, corev1.ConditionFalse, reasonApplied, fmt.Sprintf("Operating system config with checksum %s was applied successfully", oscChecksum))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,389 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	systemddbus "github.com/coreos/go-systemd/v22/dbus"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	healthcheckcontroller "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Rollback", func() {
+	var (
+		ctx        context.Context
+		log        logr.Logger
+		fs         afero.Afero
+		fakeDBus   *fakedbus.DBus
+		c          client.Client
+		recorder   *record.FakeRecorder
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+		node       *corev1.Node
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		log = logr.Discard()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeDBus = fakedbus.New()
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&corev1.Node{}).Build()
+		recorder = record.NewFakeRecorder(10)
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		reconciler = &Reconciler{
+			Client:   c,
+			Clock:    fakeClock,
+			FS:       fs,
+			DBus:     fakeDBus,
+			Recorder: recorder,
+			Config: nodeagentconfigv1alpha1.OperatingSystemConfigControllerConfig{
+				Rollback: &nodeagentconfigv1alpha1.OperatingSystemConfigRollbackConfig{
+					GracePeriod: &metav1.Duration{Duration: 5 * time.Minute},
+				},
+			},
+		}
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "test-node"}}
+		Expect(c.Create(ctx, node)).To(Succeed())
+	})
+
+	Describe("#rollbackEnabled", func() {
+		var changes *operatingSystemConfigChanges
+
+		BeforeEach(func() {
+			changes = &operatingSystemConfigChanges{}
+			Expect(fs.WriteFile(lastAppliedOperatingSystemConfigFilePath, []byte("osc"), 0600)).To(Succeed())
+		})
+
+		It("should return true if rollback is configured and an operating system config was applied before", func() {
+			Expect(reconciler.rollbackEnabled(changes)).To(BeTrue())
+		})
+
+		It("should return false if rollback is not configured", func() {
+			reconciler.Config.Rollback = nil
+
+			Expect(reconciler.rollbackEnabled(changes)).To(BeFalse())
+		})
+
+		It("should return false if state files are not written", func() {
+			reconciler.SkipWritingStateFiles = true
+
+			Expect(reconciler.rollbackEnabled(changes)).To(BeFalse())
+		})
+
+		It("should return false for in-place updates", func() {
+			changes.InPlaceUpdates.OperatingSystem = true
+
+			Expect(reconciler.rollbackEnabled(changes)).To(BeFalse())
+		})
+
+		It("should return false if no operating system config was applied before", func() {
+			Expect(fs.Remove(lastAppliedOperatingSystemConfigFilePath)).To(Succeed())
+
+			Expect(reconciler.rollbackEnabled(changes)).To(BeFalse())
+		})
+	})
+
+	Describe("#unitsToVerify", func() {
+		It("should return the started and restarted units except gardener-node-agent", func() {
+			changes := &operatingSystemConfigChanges{}
+			changes.Units.Commands = []unitCommand{
+				{Name: "foo.service", Command: extensionsv1alpha1.CommandRestart},
+				{Name: "bar.service", Command: extensionsv1alpha1.CommandStart},
+				{Name: "baz.service", Command: extensionsv1alpha1.CommandStop},
+				{Name: nodeagentconfigv1alpha1.UnitName, Command: extensionsv1alpha1.CommandRestart},
+			}
+			changes.Containerd.ConfigFileChanged = true
+
+			Expect(unitsToVerify(changes)).To(ConsistOf("foo.service", "bar.service", v1beta1constants.OperatingSystemConfigUnitNameContainerDService))
+		})
+	})
+
+	Describe("#startUnitHealthVerification", func() {
+		It("should start and persist the verification of the given units", func() {
+			verification, err := reconciler.startUnitHealthVerification("checksum", []string{"foo.service"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(verification).To(Equal(&unitHealthVerification{
+				OperatingSystemConfigChecksum: "checksum",
+				UnitNames:                     []string{"foo.service"},
+				Deadline:                      metav1.NewTime(fakeClock.Now().Add(5 * time.Minute)),
+			}))
+
+			exists, err := fs.Exists(unitHealthVerificationFilePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeTrue())
+		})
+
+		It("should continue the persisted verification of the same operating system config", func() {
+			verification, err := reconciler.startUnitHealthVerification("checksum", []string{"foo.service"})
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.Step(time.Minute)
+
+			Expect(reconciler.startUnitHealthVerification("checksum", nil)).To(And(
+				HaveField("OperatingSystemConfigChecksum", "checksum"),
+				HaveField("UnitNames", ConsistOf("foo.service")),
+				HaveField("Deadline.Time", BeTemporally("==", verification.Deadline.Time)),
+			))
+		})
+
+		It("should start a new verification for another operating system config", func() {
+			_, err := reconciler.startUnitHealthVerification("other-checksum", []string{"foo.service"})
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.Step(time.Minute)
+
+			Expect(reconciler.startUnitHealthVerification("checksum", []string{"bar.service"})).To(Equal(&unitHealthVerification{
+				OperatingSystemConfigChecksum: "checksum",
+				UnitNames:                     []string{"bar.service"},
+				Deadline:                      metav1.NewTime(fakeClock.Now().Add(5 * time.Minute)),
+			}))
+		})
+	})
+
+	Describe("#completeUnitHealthVerification", func() {
+		It("should remove the persisted verification", func() {
+			_, err := reconciler.startUnitHealthVerification("checksum", []string{"foo.service"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.completeUnitHealthVerification()).To(Succeed())
+			test.AssertNoFileOnDisk(fs, unitHealthVerificationFilePath)
+		})
+
+		It("should succeed if there is no persisted verification", func() {
+			Expect(reconciler.completeUnitHealthVerification()).To(Succeed())
+		})
+	})
+
+	Describe("#verifyUnitsHealthy", func() {
+		var verification *unitHealthVerification
+
+		BeforeEach(func() {
+			verification = &unitHealthVerification{
+				OperatingSystemConfigChecksum: "checksum",
+				UnitNames:                     []string{"foo.service"},
+				Deadline:                      metav1.NewTime(fakeClock.Now().Add(time.Minute)),
+			}
+		})
+
+		It("should succeed if there are no units to verify", func() {
+			verification.UnitNames = nil
+
+			Expect(reconciler.verifyUnitsHealthy(ctx, verification)).To(BeFalse())
+		})
+
+		It("should succeed if all units are active", func() {
+			fakeDBus.AddUnitsToList(
+				systemddbus.UnitStatus{Name: "foo.service", ActiveState: "active"},
+				systemddbus.UnitStatus{Name: "bar.service", ActiveState: "failed"},
+			)
+
+			Expect(reconciler.verifyUnitsHealthy(ctx, verification)).To(BeFalse())
+		})
+
+		It("should fail if a unit is in failed state even if the deadline has not passed", func() {
+			verification.UnitNames = []string{"foo.service", "bar.service"}
+			fakeDBus.AddUnitsToList(
+				systemddbus.UnitStatus{Name: "foo.service", ActiveState: "active"},
+				systemddbus.UnitStatus{Name: "bar.service", ActiveState: "failed"},
+			)
+
+			requeue, err := reconciler.verifyUnitsHealthy(ctx, verification)
+			Expect(requeue).To(BeFalse())
+			Expect(err).To(MatchError(ContainSubstring(`unit "bar.service" is in failed state`)))
+		})
+
+		It("should request a requeue if a unit is not active yet and the deadline has not passed", func() {
+			fakeDBus.AddUnitsToList(systemddbus.UnitStatus{Name: "foo.service", ActiveState: "activating"})
+
+			Expect(reconciler.verifyUnitsHealthy(ctx, verification)).To(BeTrue())
+		})
+
+		It("should fail if a unit does not become active until the deadline", func() {
+			fakeDBus.AddUnitsToList(systemddbus.UnitStatus{Name: "foo.service", ActiveState: "activating"})
+			fakeClock.Step(time.Minute)
+
+			requeue, err := reconciler.verifyUnitsHealthy(ctx, verification)
+			Expect(requeue).To(BeFalse())
+			Expect(err).To(MatchError(ContainSubstring("units are not active yet: foo.service (activating)")))
+		})
+
+		Context("kubelet", func() {
+			var statusCode int
+
+			BeforeEach(func() {
+				statusCode = http.StatusOK
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(statusCode)
+				}))
+				DeferCleanup(server.Close)
+				DeferCleanup(test.WithVar(&healthcheckcontroller.DefaultKubeletHealthEndpoint, server.URL))
+
+				fakeDBus.AddUnitsToList(systemddbus.UnitStatus{Name: v1beta1constants.OperatingSystemConfigUnitNameKubeletService, ActiveState: "active"})
+				verification.UnitNames = []string{v1beta1constants.OperatingSystemConfigUnitNameKubeletService}
+			})
+
+			It("should succeed if the kubelet is healthy", func() {
+				Expect(reconciler.verifyUnitsHealthy(ctx, verification)).To(BeFalse())
+			})
+
+			It("should request a requeue if the kubelet is not healthy yet", func() {
+				statusCode = http.StatusInternalServerError
+
+				Expect(reconciler.verifyUnitsHealthy(ctx, verification)).To(BeTrue())
+			})
+
+			It("should fail if the kubelet is not healthy until the deadline", func() {
+				statusCode = http.StatusInternalServerError
+				fakeClock.Step(time.Minute)
+
+				requeue, err := reconciler.verifyUnitsHealthy(ctx, verification)
+				Expect(requeue).To(BeFalse())
+				Expect(err).To(MatchError(ContainSubstring("kubelet health endpoint")))
+				Expect(err).To(MatchError(errKubeletUnhealthy))
+			})
+		})
+	})
+
+	Describe("#rollback", func() {
+		var (
+			goodOSC, failedOSC *extensionsv1alpha1.OperatingSystemConfig
+			goodOSCRaw         []byte
+		)
+
+		BeforeEach(func() {
+			goodOSC = &extensionsv1alpha1.OperatingSystemConfig{
+				Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+					Files: []extensionsv1alpha1.File{{
+						Path:    "/etc/good",
+						Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "good"}},
+					}},
+				},
+			}
+
+			failedOSC = goodOSC.DeepCopy()
+			failedOSC.Spec.Files = append(failedOSC.Spec.Files, extensionsv1alpha1.File{
+				Path:    "/etc/bad",
+				Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "bad"}},
+			})
+			failedOSC.Spec.Units = []extensionsv1alpha1.Unit{{
+				Name:    "bad.service",
+				Enable:  ptr.To(true),
+				Content: ptr.To("[Service]\nExecStart=/bin/false"),
+			}}
+
+			var err error
+			goodOSCRaw, err = runtime.Encode(codec, goodOSC)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fs.WriteFile(lastAppliedOperatingSystemConfigFilePath, goodOSCRaw, 0600)).To(Succeed())
+
+			Expect(fs.WriteFile("/etc/good", []byte("good"), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/etc/bad", []byte("bad"), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/etc/systemd/system/bad.service", []byte("[Service]\nExecStart=/bin/false"), 0600)).To(Succeed())
+		})
+
+		It("should re-apply the last applied operating system config and report the rollback", func() {
+			result, err := reconciler.rollback(ctx, log, node, failedOSC, "failed-checksum", fmt.Errorf(`unit "bad.service" is in failed state`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.IsZero()).To(BeTrue())
+
+			test.AssertFileOnDisk(fs, "/etc/good", "good", 0600)
+			test.AssertNoFileOnDisk(fs, "/etc/bad")
+			test.AssertNoFileOnDisk(fs, "/etc/systemd/system/bad.service")
+			test.AssertFileOnDisk(fs, lastAppliedOperatingSystemConfigFilePath, string(goodOSCRaw), 0600)
+			test.AssertFileOnDisk(fs, failedOperatingSystemConfigChecksumFilePath, "failed-checksum", 0600)
+			test.AssertNoFileOnDisk(fs, rollbackOperatingSystemConfigFilePath)
+
+			Expect(recorder.Events).To(Receive(ContainSubstring("OSCRolledBack")))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(ConsistOf(And(
+				HaveField("Type", corev1.NodeConditionType(nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigRolledBack)),
+				HaveField("Status", corev1.ConditionTrue),
+				HaveField("Reason", "UnitsUnhealthy"),
+				HaveField("Message", ContainSubstring(`unit "bad.service" is in failed state`)),
+			)))
+		})
+
+		It("should report that the kubelet was unhealthy", func() {
+			_, err := reconciler.rollback(ctx, log, node, failedOSC, "failed-checksum", fmt.Errorf("%w: kubelet health endpoint responded with status code 500", errKubeletUnhealthy))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(ConsistOf(And(
+				HaveField("Type", corev1.NodeConditionType(nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigRolledBack)),
+				HaveField("Status", corev1.ConditionTrue),
+				HaveField("Reason", "KubeletUnhealthy"),
+				HaveField("Message", ContainSubstring("kubelet is not healthy")),
+			)))
+		})
+	})
+
+	Describe("#skipRolledBackOperatingSystemConfig", func() {
+		It("should return false if no operating system config was rolled back", func() {
+			Expect(reconciler.skipRolledBackOperatingSystemConfig(ctx, log, node, "checksum")).To(BeFalse())
+		})
+
+		It("should return false if another operating system config was rolled back", func() {
+			Expect(fs.WriteFile(failedOperatingSystemConfigChecksumFilePath, []byte("other-checksum"), 0600)).To(Succeed())
+
+			Expect(reconciler.skipRolledBackOperatingSystemConfig(ctx, log, node, "checksum")).To(BeFalse())
+		})
+
+		It("should return true if the operating system config was rolled back", func() {
+			Expect(fs.WriteFile(failedOperatingSystemConfigChecksumFilePath, []byte("checksum"), 0600)).To(Succeed())
+
+			Expect(reconciler.skipRolledBackOperatingSystemConfig(ctx, log, node, "checksum")).To(BeTrue())
+		})
+	})
+
+	Describe("#cleanUpRollback", func() {
+		It("should remove the rollback state and update the node condition", func() {
+			Expect(fs.WriteFile(failedOperatingSystemConfigChecksumFilePath, []byte("other-checksum"), 0600)).To(Succeed())
+			Expect(reconciler.patchNodeCondition(ctx, node, nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigRolledBack, corev1.ConditionTrue, "UnitsUnhealthy", "rolled back")).To(Succeed())
+
+			Expect(reconciler.cleanUpRollback(ctx, log, node, "checksum")).To(Succeed())
+
+			test.AssertNoFileOnDisk(fs, failedOperatingSystemConfigChecksumFilePath)
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(ConsistOf(And(
+				HaveField("Type", corev1.NodeConditionType(nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigRolledBack)),
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("Reason", "OperatingSystemConfigApplied"),
+			)))
+		})
+
+		It("should not add the node condition if no rollback happened", func() {
+			Expect(reconciler.cleanUpRollback(ctx, log, node, "checksum")).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(BeEmpty())
+		})
+	})
+})
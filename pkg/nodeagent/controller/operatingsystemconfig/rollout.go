@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// waitForRolloutPermission returns true if the operating system config is rolled out in stages and the node is not yet
+// permitted to apply the given checksum. The permission is granted by gardener-resource-manager by annotating the node.
+// Nodes which have not applied any operating system config yet, and in-place updates, do not need to wait.
+func waitForRolloutPermission(secret *corev1.Secret, node *corev1.Node, osc *extensionsv1alpha1.OperatingSystemConfig, oscChecksum string) bool {
+	if _, ok := secret.Annotations[v1beta1constants.AnnotationNodeAgentRolloutMaxUnavailable]; !ok {
+		return false
+	}
+
+	if node == nil || osc.Spec.InPlaceUpdates != nil {
+		return false
+	}
+
+	appliedChecksum, ok := node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]
+	if !ok || appliedChecksum == oscChecksum {
+		return false
+	}
+
+	return node.Annotations[v1beta1constants.AnnotationNodeAgentRolloutPermittedChecksum] != oscChecksum
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+var _ = Describe("Rollout", func() {
+	Describe("#waitForRolloutPermission", func() {
+		var (
+			secret *corev1.Secret
+			node   *corev1.Node
+			osc    *extensionsv1alpha1.OperatingSystemConfig
+		)
+
+		BeforeEach(func() {
+			secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				v1beta1constants.AnnotationNodeAgentRolloutMaxUnavailable: "1",
+			}}}
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig: "old",
+			}}}
+			osc = &extensionsv1alpha1.OperatingSystemConfig{}
+		})
+
+		It("should wait if the node is not permitted to apply the checksum", func() {
+			Expect(waitForRolloutPermission(secret, node, osc, "new")).To(BeTrue())
+
+			node.Annotations[v1beta1constants.AnnotationNodeAgentRolloutPermittedChecksum] = "old"
+			Expect(waitForRolloutPermission(secret, node, osc, "new")).To(BeTrue())
+		})
+
+		It("should not wait if the node is permitted to apply the checksum", func() {
+			node.Annotations[v1beta1constants.AnnotationNodeAgentRolloutPermittedChecksum] = "new"
+			Expect(waitForRolloutPermission(secret, node, osc, "new")).To(BeFalse())
+		})
+
+		It("should not wait if the operating system config is not rolled out in stages", func() {
+			delete(secret.Annotations, v1beta1constants.AnnotationNodeAgentRolloutMaxUnavailable)
+			Expect(waitForRolloutPermission(secret, node, osc, "new")).To(BeFalse())
+		})
+
+		It("should not wait if the node has not applied any operating system config yet", func() {
+			Expect(waitForRolloutPermission(secret, nil, osc, "new")).To(BeFalse())
+
+			delete(node.Annotations, nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig)
+			Expect(waitForRolloutPermission(secret, node, osc, "new")).To(BeFalse())
+		})
+
+		It("should not wait if the node already applied the checksum", func() {
+			Expect(waitForRolloutPermission(secret, node, osc, "old")).To(BeFalse())
+		})
+
+		It("should not wait for in-place updates", func() {
+			osc.Spec.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdates{}
+			Expect(waitForRolloutPermission(secret, node, osc, "new")).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeagent
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PatchNodeCondition patches the node condition of the given type if its status, reason or message changed. The
+// transition time is only updated if the status changed.
+func PatchNodeCondition(ctx context.Context, c client.Client, clock clock.PassiveClock, node *corev1.Node, conditionType corev1.NodeConditionType, status corev1.ConditionStatus, reason, message string) error {
+	var (
+		patch     = client.StrategicMergeFrom(node.DeepCopy())
+		now       = metav1.NewTime(clock.Now())
+		condition = corev1.NodeCondition{
+			Type:               conditionType,
+			Status:             status,
+			Reason:             reason,
+			Message:            message,
+			LastHeartbeatTime:  now,
+			LastTransitionTime: now,
+		}
+	)
+
+	if i := slices.IndexFunc(node.Status.Conditions, func(c corev1.NodeCondition) bool { return c.Type == conditionType }); i >= 0 {
+		existing := node.Status.Conditions[i]
+		if existing.Status == status && existing.Reason == reason && existing.Message == message {
+			return nil
+		}
+		if existing.Status == status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+		node.Status.Conditions[i] = condition
+	} else {
+		node.Status.Conditions = append(node.Status.Conditions, condition)
+	}
+
+	if err := c.Status().Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed patching node condition %s: %w", conditionType, err)
+	}
+	return nil
+}
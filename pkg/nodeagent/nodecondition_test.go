@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeagent_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/pkg/nodeagent"
+)
+
+var _ = Describe("NodeCondition", func() {
+	Describe("#PatchNodeCondition", func() {
+		var (
+			ctx        = context.Background()
+			fakeClient client.Client
+			fakeClock  *testclock.FakeClock
+
+			node          *corev1.Node
+			conditionType corev1.NodeConditionType = "Foo"
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithStatusSubresource(&corev1.Node{}).Build()
+			fakeClock = testclock.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+		})
+
+		getCondition := func() corev1.NodeCondition {
+			ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			ExpectWithOffset(1, node.Status.Conditions).To(HaveLen(1))
+			return node.Status.Conditions[0]
+		}
+
+		It("should add the condition", func() {
+			Expect(PatchNodeCondition(ctx, fakeClient, fakeClock, node, conditionType, corev1.ConditionTrue, "Reason", "message")).To(Succeed())
+
+			condition := getCondition()
+			Expect(condition.Type).To(Equal(conditionType))
+			Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+			Expect(condition.Reason).To(Equal("Reason"))
+			Expect(condition.Message).To(Equal("message"))
+			Expect(condition.LastTransitionTime.Time).To(BeTemporally("==", fakeClock.Now()))
+		})
+
+		It("should keep the transition time if only the message changed", func() {
+			Expect(PatchNodeCondition(ctx, fakeClient, fakeClock, node, conditionType, corev1.ConditionTrue, "Reason", "message")).To(Succeed())
+			transitionTime := fakeClock.Now()
+			fakeClock.Step(time.Minute)
+
+			Expect(PatchNodeCondition(ctx, fakeClient, fakeClock, node, conditionType, corev1.ConditionTrue, "Reason", "other message")).To(Succeed())
+
+			condition := getCondition()
+			Expect(condition.Message).To(Equal("other message"))
+			Expect(condition.LastTransitionTime.Time).To(BeTemporally("==", transitionTime))
+			Expect(condition.LastHeartbeatTime.Time).To(BeTemporally("==", fakeClock.Now()))
+		})
+
+		It("should update the transition time if the status changed", func() {
+			Expect(PatchNodeCondition(ctx, fakeClient, fakeClock, node, conditionType, corev1.ConditionTrue, "Reason", "message")).To(Succeed())
+			fakeClock.Step(time.Minute)
+
+			Expect(PatchNodeCondition(ctx, fakeClient, fakeClock, node, conditionType, corev1.ConditionFalse, "Reason", "message")).To(Succeed())
+
+			condition := getCondition()
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(condition.LastTransitionTime.Time).To(BeTemporally("==", fakeClock.Now()))
+		})
+
+		It("should not patch the node if nothing changed", func() {
+			Expect(PatchNodeCondition(ctx, fakeClient, fakeClock, node, conditionType, corev1.ConditionTrue, "Reason", "message")).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			resourceVersion := node.ResourceVersion
+			fakeClock.Step(time.Minute)
+
+			Expect(PatchNodeCondition(ctx, fakeClient, fakeClock, node, conditionType, corev1.ConditionTrue, "Reason", "message")).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.ResourceVersion).To(Equal(resourceVersion))
+		})
+	})
+})
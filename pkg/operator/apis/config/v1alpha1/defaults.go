@@ -23,6 +23,11 @@ func SetDefaults_OperatorConfiguration(obj *OperatorConfiguration) {
 	if obj.LogFormat == "" {
 		obj.LogFormat = logger.FormatJSON
 	}
+
+	if obj.ChartCache == nil {
+		obj.ChartCache = &gardenletconfigv1alpha1.ChartCacheConfiguration{}
+	}
+	gardenletconfigv1alpha1.SetDefaults_ChartCacheConfiguration(obj.ChartCache)
 }
 
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"k8s.io/utils/ptr"
@@ -32,6 +33,7 @@ var _ = Describe("Defaults", func() {
 
 			Expect(obj.LogLevel).To(Equal(logger.InfoLevel))
 			Expect(obj.LogFormat).To(Equal(logger.FormatJSON))
+			Expect(obj.ChartCache).To(Equal(&v1alpha1.ChartCacheConfiguration{MaxSize: ptr.To(resource.MustParse("128Mi"))}))
 		})
 
 		It("should not overwrite already set values for OperatorConfiguration", func() {
@@ -42,11 +44,13 @@ var _ = Describe("Defaults", func() {
 
 			obj.LogLevel = expectedLogLevel
 			obj.LogFormat = expectedLogFormat
+			obj.ChartCache = &v1alpha1.ChartCacheConfiguration{MaxSize: ptr.To(resource.MustParse("1Gi"))}
 
 			SetObjectDefaults_OperatorConfiguration(obj)
 
 			Expect(obj.LogLevel).To(Equal(expectedLogLevel))
 			Expect(obj.LogFormat).To(Equal(expectedLogFormat))
+			Expect(obj.ChartCache.MaxSize).To(PointTo(Equal(resource.MustParse("1Gi"))))
 		})
 	})
 
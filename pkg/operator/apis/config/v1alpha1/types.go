@@ -44,6 +44,12 @@ type OperatorConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeTolerationConfiguration `json:"nodeToleration,omitempty"`
+	// ChartCache contains configuration for the cache of Helm charts pulled from OCI registries.
+	// +optional
+	ChartCache *gardenletconfigv1alpha1.ChartCacheConfiguration `json:"chartCache,omitempty"`
+	// ChartVerification contains configuration for verifying the signatures of Helm charts pulled from OCI registries.
+	// +optional
+	ChartVerification *gardenletconfigv1alpha1.ChartVerificationConfiguration `json:"chartVerification,omitempty"`
 }
 
 // ConditionThreshold defines the threshold of the given condition type.
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
+	gardenletvalidation "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1/validation"
 	"github.com/gardener/gardener/pkg/logger"
 	operatorconfigv1alpha1 "github.com/gardener/gardener/pkg/operator/apis/config/v1alpha1"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
@@ -37,6 +38,8 @@ func ValidateOperatorConfiguration(conf *operatorconfigv1alpha1.OperatorConfigur
 
 	allErrs = append(allErrs, validateControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
 	allErrs = append(allErrs, validateNodeTolerationConfiguration(conf.NodeToleration, field.NewPath("nodeToleration"))...)
+	allErrs = append(allErrs, gardenletvalidation.ValidateChartCacheConfiguration(conf.ChartCache, field.NewPath("chartCache"))...)
+	allErrs = append(allErrs, gardenletvalidation.ValidateChartVerificationConfiguration(conf.ChartVerification, field.NewPath("chartVerification"))...)
 
 	return allErrs
 }
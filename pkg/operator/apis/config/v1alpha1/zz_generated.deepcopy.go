@@ -310,6 +310,16 @@ func (in *OperatorConfiguration) DeepCopyInto(out *OperatorConfiguration) {
 		*out = new(NodeTolerationConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ChartCache != nil {
+		in, out := &in.ChartCache, &out.ChartCache
+		*out = new(configv1alpha1.ChartCacheConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChartVerification != nil {
+		in, out := &in.ChartVerification, &out.ChartVerification
+		*out = new(configv1alpha1.ChartVerificationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -550,7 +550,9 @@ func (r *Reconciler) newEtcd(
 		garden.Spec.VirtualCluster.Maintenance.TimeWindow.End,
 		garden.UID,
 		garden.CreationTimestamp,
+		r.Clock.Now(),
 		timewindow.RandomizeWithinTimeWindow,
+		v1beta1helper.MaintenanceTimeWindowParseOptions(&garden.Spec.VirtualCluster.Maintenance.TimeWindow)...,
 	)
 	if err != nil {
 		return nil, err
@@ -1235,6 +1237,7 @@ func (r *Reconciler) newGardenerScheduler(garden *operatorv1alpha1.Garden, secre
 		if config.LogLevel != nil {
 			values.LogLevel = *config.LogLevel
 		}
+		values.EnablePreviewHandler = ptr.Deref(config.EnablePreviewHandler, false)
 	}
 
 	return gardenerscheduler.New(r.RuntimeClientSet.Client(), r.GardenNamespace, secretsManager, values), nil
@@ -845,7 +845,9 @@ func (r *Reconciler) deployEtcdsFunc(garden *operatorv1alpha1.Garden, etcdMain,
 				garden.Spec.VirtualCluster.Maintenance.TimeWindow.End,
 				garden.UID,
 				garden.CreationTimestamp,
+				r.Clock.Now(),
 				timewindow.RandomizeWithinFirstHourOfTimeWindow,
+				v1beta1helper.MaintenanceTimeWindowParseOptions(&garden.Spec.VirtualCluster.Maintenance.TimeWindow)...,
 			)
 			if err != nil {
 				return err
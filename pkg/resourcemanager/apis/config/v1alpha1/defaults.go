@@ -138,6 +138,13 @@ func SetDefaults_ManagedResourceControllerConfig(obj *ManagedResourceControllerC
 	}
 }
 
+// SetDefaults_DriftDetectionConfig sets defaults for the DriftDetectionConfig object.
+func SetDefaults_DriftDetectionConfig(obj *DriftDetectionConfig) {
+	if obj.Policy == nil {
+		obj.Policy = ptr.To(DriftPolicyRevertOnNextSync)
+	}
+}
+
 // SetDefaults_TokenRequestorControllerConfig sets defaults for the TokenRequestorControllerConfig object.
 func SetDefaults_TokenRequestorControllerConfig(obj *TokenRequestorControllerConfig) {
 	if obj.Enabled && obj.ConcurrentSyncs == nil {
@@ -344,6 +344,30 @@ var _ = Describe("ResourceManager defaulting", func() {
 			Expect(obj.Controllers.ManagedResource.AlwaysUpdate).To(PointTo(BeTrue()))
 			Expect(obj.Controllers.ManagedResource.ManagedByLabelValue).To(PointTo(Equal("foo")))
 		})
+
+		It("should not default the drift detection because it is not configured", func() {
+			obj.Controllers.ManagedResource = ManagedResourceControllerConfig{}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ManagedResource.DriftDetection).To(BeNil())
+		})
+
+		It("should default the drift policy", func() {
+			obj.Controllers.ManagedResource = ManagedResourceControllerConfig{DriftDetection: &DriftDetectionConfig{}}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ManagedResource.DriftDetection.Policy).To(PointTo(Equal(DriftPolicyRevertOnNextSync)))
+		})
+
+		It("should not overwrite an already set drift policy", func() {
+			obj.Controllers.ManagedResource = ManagedResourceControllerConfig{DriftDetection: &DriftDetectionConfig{Policy: ptr.To(DriftPolicyReportOnly)}}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ManagedResource.DriftDetection.Policy).To(PointTo(Equal(DriftPolicyReportOnly)))
+		})
 	})
 
 	Describe("TokenRequestorControllerConfig defaulting", func() {
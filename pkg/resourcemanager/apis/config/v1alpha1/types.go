@@ -112,6 +112,8 @@ type ResourceManagerControllerConfiguration struct {
 	NodeCriticalComponents NodeCriticalComponentsControllerConfig `json:"nodeCriticalComponents"`
 	// NodeAgentReconciliationDelay is the configuration for the node-agent reconciliation delay controller.
 	NodeAgentReconciliationDelay NodeAgentReconciliationDelayControllerConfig `json:"nodeAgentReconciliationDelay"`
+	// NodeAgentRollout is the configuration for the node-agent rollout controller.
+	NodeAgentRollout NodeAgentRolloutControllerConfig `json:"nodeAgentRollout"`
 	// TokenRequestor is the configuration for the token-requestor controller.
 	TokenRequestor TokenRequestorControllerConfig `json:"tokenRequestor"`
 }
@@ -164,8 +166,38 @@ type ManagedResourceControllerConfig struct {
 	// Default: gardener
 	// +optional
 	ManagedByLabelValue *string `json:"managedByLabelValue,omitempty"`
+	// DriftDetection configures the detection of resources which were changed in the target cluster by someone else than
+	// the gardener-resource-manager. Drift detection is disabled if not set.
+	// +optional
+	DriftDetection *DriftDetectionConfig `json:"driftDetection,omitempty"`
 }
 
+// DriftDetectionConfig is the configuration for the drift detection of resources managed by ManagedResources.
+type DriftDetectionConfig struct {
+	// Policy specifies how drifted resources are handled.
+	// Possible values: ReportOnly, RevertImmediately, RevertOnNextSync
+	// Default: RevertOnNextSync
+	// +optional
+	Policy *DriftPolicy `json:"policy,omitempty"`
+	// ClassPolicies overrides the policy for ManagedResources of specific classes. The keys are the names of the
+	// classes, ManagedResources without a class belong to the `resources` class.
+	// +optional
+	ClassPolicies map[string]DriftPolicy `json:"classPolicies,omitempty"`
+}
+
+// DriftPolicy specifies how drifted resources are handled.
+type DriftPolicy string
+
+const (
+	// DriftPolicyReportOnly means that drifted resources are only reported. They are not reverted by the periodic
+	// syncs but only when the desired state of the ManagedResource changes.
+	DriftPolicyReportOnly DriftPolicy = "ReportOnly"
+	// DriftPolicyRevertImmediately means that drifted resources are reported and reverted right away.
+	DriftPolicyRevertImmediately DriftPolicy = "RevertImmediately"
+	// DriftPolicyRevertOnNextSync means that drifted resources are reported and reverted with the next periodic sync.
+	DriftPolicyRevertOnNextSync DriftPolicy = "RevertOnNextSync"
+)
+
 // NetworkPolicyControllerConfig is the configuration for the networkpolicy controller.
 type NetworkPolicyControllerConfig struct {
 	// Enabled defines whether this controller is enabled.
@@ -225,6 +257,12 @@ type NodeAgentReconciliationDelayControllerConfig struct {
 	MaxDelay *metav1.Duration `json:"maxDelay,omitempty"`
 }
 
+// NodeAgentRolloutControllerConfig is the configuration for the node-agent rollout controller.
+type NodeAgentRolloutControllerConfig struct {
+	// Enabled defines whether this controller is enabled.
+	Enabled bool `json:"enabled"`
+}
+
 // ResourceManagerWebhookConfiguration defines the configuration of the webhooks.
 type ResourceManagerWebhookConfiguration struct {
 	// CRDDeletionProtection is the configuration for the crd-deletion-protection webhook.
@@ -19,6 +19,12 @@ import (
 	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
 
+var availableDriftPolicies = sets.New(
+	resourcemanagerconfigv1alpha1.DriftPolicyReportOnly,
+	resourcemanagerconfigv1alpha1.DriftPolicyRevertImmediately,
+	resourcemanagerconfigv1alpha1.DriftPolicyRevertOnNextSync,
+)
+
 // ValidateResourceManagerConfiguration validates the given `ResourceManagerConfiguration`.
 func ValidateResourceManagerConfiguration(conf *resourcemanagerconfigv1alpha1.ResourceManagerConfiguration) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -124,6 +130,18 @@ func validateManagedResourceControllerConfiguration(conf resourcemanagerconfigv1
 		allErrs = append(allErrs, field.Required(fldPath.Child("managedByLabelValue"), "must specify value of managed-by label"))
 	}
 
+	if conf.DriftDetection != nil {
+		if conf.DriftDetection.Policy != nil && !availableDriftPolicies.Has(*conf.DriftDetection.Policy) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("driftDetection", "policy"), *conf.DriftDetection.Policy, sets.List(availableDriftPolicies)))
+		}
+
+		for class, policy := range conf.DriftDetection.ClassPolicies {
+			if !availableDriftPolicies.Has(policy) {
+				allErrs = append(allErrs, field.NotSupported(fldPath.Child("driftDetection", "classPolicies").Key(class), policy, sets.List(availableDriftPolicies)))
+			}
+		}
+	}
+
 	return allErrs
 }
 
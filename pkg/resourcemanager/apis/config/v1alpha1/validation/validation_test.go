@@ -383,6 +383,39 @@ var _ = Describe("Validation", func() {
 						})),
 					))
 				})
+
+				It("should allow valid drift policies", func() {
+					for _, policy := range []resourcemanagerconfigv1alpha1.DriftPolicy{"ReportOnly", "RevertImmediately", "RevertOnNextSync"} {
+						conf.Controllers.ManagedResource.DriftDetection = &resourcemanagerconfigv1alpha1.DriftDetectionConfig{Policy: &policy}
+
+						Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+					}
+				})
+
+				It("should return errors because the drift policy is not supported", func() {
+					conf.Controllers.ManagedResource.DriftDetection = &resourcemanagerconfigv1alpha1.DriftDetectionConfig{Policy: ptr.To[resourcemanagerconfigv1alpha1.DriftPolicy]("foo")}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("controllers.managedResources.driftDetection.policy"),
+						})),
+					))
+				})
+
+				It("should return errors because a drift policy of a class is not supported", func() {
+					conf.Controllers.ManagedResource.DriftDetection = &resourcemanagerconfigv1alpha1.DriftDetectionConfig{ClassPolicies: map[string]resourcemanagerconfigv1alpha1.DriftPolicy{
+						"resources": "ReportOnly",
+						"shoot":     "foo",
+					}}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("controllers.managedResources.driftDetection.classPolicies[shoot]"),
+						})),
+					))
+				})
 			})
 
 			Context("node agent reconciliation delay", func() {
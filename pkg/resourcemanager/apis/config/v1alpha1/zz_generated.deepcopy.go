@@ -85,6 +85,34 @@ func (in *ClientConnection) DeepCopy() *ClientConnection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetectionConfig) DeepCopyInto(out *DriftDetectionConfig) {
+	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(DriftPolicy)
+		**out = **in
+	}
+	if in.ClassPolicies != nil {
+		in, out := &in.ClassPolicies, &out.ClassPolicies
+		*out = make(map[string]DriftPolicy, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetectionConfig.
+func (in *DriftDetectionConfig) DeepCopy() *DriftDetectionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetectionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointSliceHintsWebhookConfig) DeepCopyInto(out *EndpointSliceHintsWebhookConfig) {
 	*out = *in
@@ -264,6 +292,11 @@ func (in *ManagedResourceControllerConfig) DeepCopyInto(out *ManagedResourceCont
 		*out = new(string)
 		**out = **in
 	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(DriftDetectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -362,6 +395,22 @@ func (in *NodeAgentReconciliationDelayControllerConfig) DeepCopy() *NodeAgentRec
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeAgentRolloutControllerConfig) DeepCopyInto(out *NodeAgentRolloutControllerConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAgentRolloutControllerConfig.
+func (in *NodeAgentRolloutControllerConfig) DeepCopy() *NodeAgentRolloutControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeAgentRolloutControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeCriticalComponentsControllerConfig) DeepCopyInto(out *NodeCriticalComponentsControllerConfig) {
 	*out = *in
@@ -522,6 +571,7 @@ func (in *ResourceManagerControllerConfiguration) DeepCopyInto(out *ResourceMana
 	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
 	in.NodeCriticalComponents.DeepCopyInto(&out.NodeCriticalComponents)
 	in.NodeAgentReconciliationDelay.DeepCopyInto(&out.NodeAgentReconciliationDelay)
+	out.NodeAgentRollout = in.NodeAgentRollout
 	in.TokenRequestor.DeepCopyInto(&out.TokenRequestor)
 	return
 }
@@ -38,6 +38,9 @@ func SetObjectDefaults_ResourceManagerConfiguration(in *ResourceManagerConfigura
 	SetDefaults_HealthControllerConfig(&in.Controllers.Health)
 	SetDefaults_CSRApproverControllerConfig(&in.Controllers.CSRApprover)
 	SetDefaults_ManagedResourceControllerConfig(&in.Controllers.ManagedResource)
+	if in.Controllers.ManagedResource.DriftDetection != nil {
+		SetDefaults_DriftDetectionConfig(in.Controllers.ManagedResource.DriftDetection)
+	}
 	SetDefaults_NetworkPolicyControllerConfig(&in.Controllers.NetworkPolicy)
 	SetDefaults_NodeCriticalComponentsControllerConfig(&in.Controllers.NodeCriticalComponents)
 	SetDefaults_NodeAgentReconciliationDelayControllerConfig(&in.Controllers.NodeAgentReconciliationDelay)
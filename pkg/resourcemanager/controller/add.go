@@ -57,11 +57,18 @@ func AddToManager(ctx context.Context, mgr manager.Manager, sourceCluster, targe
 		return fmt.Errorf("failed adding health controller: %w", err)
 	}
 
+	// the target cluster is the source cluster if no separate target client connection is configured
+	targetNamespaces := cfg.SourceClientConnection.Namespaces
+	if cfg.TargetClientConnection != nil {
+		targetNamespaces = cfg.TargetClientConnection.Namespaces
+	}
+
 	if err := (&managedresource.Reconciler{
 		Config:                    cfg.Controllers.ManagedResource,
 		ClassFilter:               resourcemanagerpredicate.NewClassFilter(*cfg.Controllers.ResourceClass),
 		ClusterID:                 *cfg.Controllers.ClusterID,
 		GarbageCollectorActivated: cfg.Controllers.GarbageCollector.Enabled,
+		TargetNamespaces:          targetNamespaces,
 	}).AddToManager(mgr, sourceCluster, targetCluster); err != nil {
 		return fmt.Errorf("failed adding managed resource controller: %w", err)
 	}
@@ -6,29 +6,42 @@ package managedresource
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	healthutils "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
 	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
 )
 
-// ControllerName is the name of the controller.
-const ControllerName = "managedresource"
+const (
+	// ControllerName is the name of the controller.
+	ControllerName = "managedresource"
+	// DriftControllerName is the name of the controller detecting drifted resources of ManagedResources.
+	DriftControllerName = "managedresource-drift"
+)
 
 // AddToManager adds Reconciler to the given manager.
 func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetCluster cluster.Cluster) error {
@@ -50,8 +63,11 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 	if r.RequeueAfterOnDeletionPending == nil {
 		r.RequeueAfterOnDeletionPending = ptr.To(5 * time.Second)
 	}
+	if r.RequeueAfterOnApplyPhasePending == nil {
+		r.RequeueAfterOnApplyPhasePending = ptr.To(5 * time.Second)
+	}
 
-	return builder.
+	b := builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
 		WithOptions(controller.Options{
@@ -67,6 +83,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 				resourcemanagerpredicate.NoLongerIgnored(),
 				// we need to reconcile once if the ManagedResource got marked as ignored in order to update the conditions
 				resourcemanagerpredicate.GotMarkedAsIgnored(),
+				resourcemanagerpredicate.DryRunModeChanged(),
 			),
 			// TODO: refactor this predicate chain into a single predicate.Funcs that can be properly tested as a whole
 			predicate.Or(
@@ -85,12 +102,124 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 					predicateutils.IsDeleting(),
 				),
 			)),
-		).
-		Complete(reconcilerutils.OperationAnnotationWrapper(
-			mgr,
-			func() client.Object { return &resourcesv1alpha1.ManagedResource{} },
-			r,
-		))
+		)
+
+	if r.Config.DriftDetection != nil {
+		if r.driftReverts == nil {
+			r.driftReverts = make(chan event.GenericEvent)
+		}
+		// ManagedResources with drifted resources are enqueued directly by the drift controller if they must be
+		// reverted immediately, see ReconcileDrift.
+		b = b.WatchesRawSource(source.Channel(r.driftReverts, &handler.EnqueueRequestForObject{}))
+	}
+
+	if err := b.Complete(reconcilerutils.OperationAnnotationWrapper(
+		mgr,
+		func() client.Object { return &resourcesv1alpha1.ManagedResource{} },
+		r,
+	)); err != nil {
+		return err
+	}
+
+	if r.Config.DriftDetection == nil {
+		return nil
+	}
+
+	return r.addDriftControllerToManager(mgr, targetCluster)
+}
+
+// addDriftControllerToManager adds the controller detecting drifted resources of ManagedResources to the given
+// manager. It watches the ManagedResources and dynamically starts watches for the kinds of their resources.
+func (r *Reconciler) addDriftControllerToManager(mgr manager.Manager, targetCluster cluster.Cluster) error {
+	if r.driftCache == nil {
+		// The resources are watched with a dedicated cache which only contains the metadata of the objects managed by
+		// this gardener-resource-manager (i.e., the objects listed in the status of the ManagedResources), instead of
+		// all objects of the watched kinds in the target cluster.
+		driftCache, err := cache.New(targetCluster.GetConfig(), cache.Options{
+			HTTPClient:           targetCluster.GetHTTPClient(),
+			Scheme:               targetCluster.GetScheme(),
+			Mapper:               targetCluster.GetRESTMapper(),
+			DefaultNamespaces:    r.targetCacheNamespaces(),
+			DefaultLabelSelector: labels.SelectorFromSet(labels.Set{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue}),
+		})
+		if err != nil {
+			return fmt.Errorf("failed creating cache for drift detection: %w", err)
+		}
+
+		if err := mgr.Add(driftCache); err != nil {
+			return fmt.Errorf("failed adding cache for drift detection to manager: %w", err)
+		}
+		r.driftCache = driftCache
+	}
+
+	c, err := builder.
+		ControllerManagedBy(mgr).
+		Named(DriftControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+			ReconciliationTimeout:   r.Config.SyncPeriod.Duration,
+		}).
+		For(&resourcesv1alpha1.ManagedResource{}, builder.WithPredicates(
+			predicate.Or(
+				// start drift detection immediately after MR has been reconciled
+				resourcemanagerpredicate.ConditionStatusChanged(resourcesv1alpha1.ResourcesApplied, resourcemanagerpredicate.DefaultConditionChange),
+				resourcemanagerpredicate.NoLongerIgnored(),
+			),
+			resourcemanagerpredicate.NotIgnored(),
+			r.ClassFilter,
+		)).
+		Build(reconcile.Func(r.ReconcileDrift))
+	if err != nil {
+		return err
+	}
+
+	lock := sync.RWMutex{}
+	watchedObjectGVKs := sets.New[schema.GroupVersionKind]()
+	r.ensureWatchForGVK = func(gvk schema.GroupVersionKind, obj client.Object) error {
+		// fast-check: have we already added watch for this GVK?
+		lock.RLock()
+		if watchedObjectGVKs.Has(gvk) {
+			lock.RUnlock()
+			return nil
+		}
+		lock.RUnlock()
+
+		// slow-check: two goroutines might concurrently call this func. If neither exited early, the first one added
+		// the watch and the second one should return now.
+		lock.Lock()
+		defer lock.Unlock()
+		if watchedObjectGVKs.Has(gvk) {
+			return nil
+		}
+
+		c.GetLogger().Info("Adding new watch for GroupVersionKind", "groupVersionKind", gvk)
+
+		if err := c.Watch(source.Kind[client.Object](
+			r.driftCache,
+			obj,
+			handler.EnqueueRequestsFromMapFunc(healthutils.MapToOriginManagedResource(c.GetLogger(), r.ClusterID)),
+			resourcemanagerpredicate.ObjectModifiedOrDeleted(),
+		)); err != nil {
+			return fmt.Errorf("error starting watch for GVK %s: %w", gvk.String(), err)
+		}
+
+		watchedObjectGVKs.Insert(gvk)
+		return nil
+	}
+
+	return nil
+}
+
+func (r *Reconciler) targetCacheNamespaces() map[string]cache.Config {
+	if len(r.TargetNamespaces) == 0 {
+		return nil
+	}
+
+	namespaces := make(map[string]cache.Config, len(r.TargetNamespaces))
+	for _, namespace := range r.TargetNamespaces {
+		namespaces[namespace] = cache.Config{}
+	}
+	return namespaces
 }
 
 // MapSecretToManagedResources maps secrets to relevant ManagedResources.
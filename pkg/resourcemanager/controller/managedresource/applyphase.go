@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	healthutils "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
+)
+
+type applyPhase struct {
+	phase   int
+	objects []object
+}
+
+// groupByApplyPhase groups the given objects by the value of their `resources.gardener.cloud/apply-phase` annotation.
+// The returned phases are sorted in ascending order.
+func groupByApplyPhase(objects []object) ([]applyPhase, error) {
+	objectsByPhase := make(map[int][]object)
+
+	for _, obj := range objects {
+		phase := 0
+		if v, ok := obj.obj.GetAnnotations()[resourcesv1alpha1.ApplyPhase]; ok {
+			var err error
+			if phase, err = strconv.Atoi(v); err != nil || phase < 0 {
+				return nil, fmt.Errorf("invalid value %q for annotation %s of object %q, must be a non-negative integer", v, resourcesv1alpha1.ApplyPhase, unstructuredToString(obj.obj))
+			}
+		}
+
+		objectsByPhase[phase] = append(objectsByPhase[phase], obj)
+	}
+
+	phases := make([]applyPhase, 0, len(objectsByPhase))
+	for phase, objs := range objectsByPhase {
+		phases = append(phases, applyPhase{phase: phase, objects: objs})
+	}
+	slices.SortFunc(phases, func(a, b applyPhase) int { return a.phase - b.phase })
+
+	return phases, nil
+}
+
+// applyPhasePendingError is returned if the objects of an apply phase are not yet healthy, hence, the objects of the
+// next phases cannot be applied yet.
+type applyPhasePendingError struct {
+	phase   int
+	message string
+}
+
+func (e *applyPhasePendingError) Error() string {
+	return fmt.Sprintf("waiting for objects of apply phase %d to become healthy: %s", e.phase, e.message)
+}
+
+func isApplyPhasePending(err error) bool {
+	var pendingErr *applyPhasePendingError
+	return errors.As(err, &pendingErr)
+}
+
+// checkApplyPhaseHealth executes the health checks of the health controller for all objects of the given apply phase.
+// It returns an *applyPhasePendingError if any object is not yet healthy.
+func (r *Reconciler) checkApplyPhaseHealth(ctx context.Context, phase applyPhase) error {
+	for _, o := range phase.objects {
+		if ignore(o.obj) {
+			continue
+		}
+
+		var (
+			gvk       = o.obj.GroupVersionKind()
+			objectKey = client.ObjectKeyFromObject(o.obj)
+		)
+
+		obj, err := r.TargetScheme.New(gvk)
+		if err != nil {
+			if runtime.IsNotRegisteredError(err) {
+				// there is no dedicated health check for unknown types, and the object was just applied successfully
+				continue
+			}
+			return err
+		}
+
+		typedObj, ok := obj.(client.Object)
+		if !ok {
+			continue
+		}
+
+		if err := r.TargetClient.Get(ctx, objectKey, typedObj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return &applyPhasePendingError{phase: phase.phase, message: fmt.Sprintf("%s %q is missing", gvk.Kind, objectKey.String())}
+			}
+			return fmt.Errorf("failed getting %s %q for health check: %w", gvk.Kind, objectKey.String(), err)
+		}
+
+		if _, err := healthutils.CheckHealth(typedObj); err != nil {
+			return &applyPhasePendingError{phase: phase.phase, message: fmt.Sprintf("%s %q is unhealthy: %v", gvk.Kind, objectKey.String(), err)}
+		}
+	}
+
+	return nil
+}
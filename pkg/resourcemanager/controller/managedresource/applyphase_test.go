@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("apply phases", func() {
+	Describe("#groupByApplyPhase", func() {
+		newObject := func(name, phase string) object {
+			obj := &unstructured.Unstructured{}
+			obj.SetAPIVersion("v1")
+			obj.SetKind("ConfigMap")
+			obj.SetName(name)
+			if phase != "" {
+				obj.SetAnnotations(map[string]string{"resources.gardener.cloud/apply-phase": phase})
+			}
+			return object{obj: obj}
+		}
+
+		It("should group the objects by phase in ascending order", func() {
+			var (
+				obj1 = newObject("obj1", "2")
+				obj2 = newObject("obj2", "")
+				obj3 = newObject("obj3", "1")
+				obj4 = newObject("obj4", "0")
+				obj5 = newObject("obj5", "2")
+			)
+
+			Expect(groupByApplyPhase([]object{obj1, obj2, obj3, obj4, obj5})).To(Equal([]applyPhase{
+				{phase: 0, objects: []object{obj2, obj4}},
+				{phase: 1, objects: []object{obj3}},
+				{phase: 2, objects: []object{obj1, obj5}},
+			}))
+		})
+
+		It("should return a single phase if no object has the annotation", func() {
+			obj1, obj2 := newObject("obj1", ""), newObject("obj2", "")
+
+			Expect(groupByApplyPhase([]object{obj1, obj2})).To(Equal([]applyPhase{{phase: 0, objects: []object{obj1, obj2}}}))
+		})
+
+		DescribeTable("should fail for invalid values",
+			func(value string) {
+				_, err := groupByApplyPhase([]object{newObject("obj", value)})
+				Expect(err).To(MatchError(ContainSubstring("must be a non-negative integer")))
+			},
+
+			Entry("non-integer", "foo"),
+			Entry("negative", "-1"),
+		)
+	})
+
+	Describe("#Reconcile", func() {
+		var (
+			ctx = context.Background()
+
+			sourceClient client.Client
+			targetClient client.Client
+			reconciler   *Reconciler
+
+			managedResource *resourcesv1alpha1.ManagedResource
+			deployment      *appsv1.Deployment
+		)
+
+		BeforeEach(func() {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "mr-secret", Namespace: "garden"},
+				Data: map[string][]byte{"objects.yaml": []byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: backend
+  namespace: default
+spec:
+  selector:
+    matchLabels:
+      app: backend
+  template:
+    metadata:
+      labels:
+        app: backend
+    spec:
+      containers:
+      - name: backend
+        image: backend
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: frontend
+  namespace: default
+  annotations:
+    resources.gardener.cloud/apply-phase: "1"
+`)},
+			}
+
+			managedResource = &resourcesv1alpha1.ManagedResource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "mr",
+					Namespace:  "garden",
+					Finalizers: []string{"resources.gardener.cloud/gardener-resource-manager"},
+				},
+				Spec: resourcesv1alpha1.ManagedResourceSpec{
+					SecretRefs: []corev1.LocalObjectReference{{Name: secret.Name}},
+				},
+			}
+
+			deployment = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "backend", Namespace: "default"}}
+
+			sourceClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.SeedScheme).
+				WithObjects(managedResource, secret).
+				WithStatusSubresource(&resourcesv1alpha1.ManagedResource{}).
+				Build()
+			targetClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.SeedScheme).
+				WithStatusSubresource(&appsv1.Deployment{}).
+				Build()
+
+			reconciler = &Reconciler{
+				SourceClient:     sourceClient,
+				TargetClient:     targetClient,
+				TargetScheme:     kubernetes.SeedScheme,
+				TargetRESTMapper: targetClient.RESTMapper(),
+				Config: resourcemanagerconfigv1alpha1.ManagedResourceControllerConfig{
+					SyncPeriod:          &metav1.Duration{Duration: time.Minute},
+					ManagedByLabelValue: ptr.To("gardener"),
+				},
+				Clock:                           testclock.NewFakeClock(time.Now()),
+				ClassFilter:                     resourcemanagerpredicate.NewClassFilter(""),
+				RequeueAfterOnApplyPhasePending: ptr.To(5 * time.Second),
+			}
+		})
+
+		It("should apply the next phase only after the objects of the previous phase are healthy", func() {
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(managedResource)})).To(Equal(reconcile.Result{RequeueAfter: 5 * time.Second}))
+
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+			Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "frontend"}, &corev1.ConfigMap{})).To(BeNotFoundError())
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource.Status.Resources).To(HaveLen(2))
+			condition := v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionProgressing))
+			Expect(condition.Reason).To(Equal("ApplyPhasePending"))
+			Expect(condition.Message).To(ContainSubstring(`waiting for objects of apply phase 0 to become healthy: Deployment "default/backend" is unhealthy`))
+
+			deployment.Status.ObservedGeneration = deployment.Generation
+			deployment.Status.Conditions = []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue}}
+			Expect(targetClient.Status().Update(ctx, deployment)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(managedResource)})).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "frontend"}, &corev1.ConfigMap{})).To(Succeed())
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			condition = v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal("ApplySucceeded"))
+		})
+	})
+})
@@ -0,0 +1,336 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	resourcesv1alpha1helper "github.com/gardener/gardener/pkg/apis/resources/v1alpha1/helper"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/resourcemanager/metrics"
+)
+
+// ReconcileDrift detects whether the actual state of the resources of a ManagedResource differs from their desired
+// state. Drifted resources are reported in the status of the ManagedResource and handled according to the configured
+// drift policy.
+func (r *Reconciler) ReconcileDrift(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	mr := &resourcesv1alpha1.ManagedResource{}
+	if err := r.SourceClient.Get(ctx, req.NamespacedName, mr); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			metrics.ManagedResourceDriftedObjects.DeleteLabelValues(req.Namespace, req.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if ignore(mr) || mr.DeletionTimestamp != nil || !r.ClassFilter.Responsible(mr) || keyExistsAndValueTrue(mr.Annotations, resourcesv1alpha1.DryRun) {
+		log.V(1).Info("Skipping drift detection for ManagedResource")
+		metrics.ManagedResourceDriftedObjects.DeleteLabelValues(mr.Namespace, mr.Name)
+		return reconcile.Result{}, nil
+	}
+
+	// drift can only be detected reliably once the current desired state has been applied successfully
+	conditionResourcesApplied := v1beta1helper.GetCondition(mr.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
+	if conditionResourcesApplied == nil || conditionResourcesApplied.Status != gardencorev1beta1.ConditionTrue || mr.Status.ObservedGeneration != mr.Generation {
+		log.Info("Skipping drift detection for ManagedResource, as it has not been reconciled successfully yet")
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	var (
+		equivalences = NewEquivalences(mr.Spec.Equivalences...)
+		origin       = resourcesv1alpha1helper.OriginForManagedResource(r.ClusterID, mr)
+		injectLabels = mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
+	)
+
+	resources, err := r.decodeResources(ctx, log, mr, NewObjectIndex(mr.Status.Resources, equivalences))
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if ptr.Deref(mr.Status.SecretsDataChecksum, "") != resources.secretsDataChecksum {
+		log.Info("Skipping drift detection for ManagedResource, as its desired state has not been applied yet")
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	for _, ref := range mr.Status.Resources {
+		gvk := ref.GroupVersionKind()
+
+		// Changes are detected via the metadata (resource version or generation) of the objects, hence there is no need
+		// to watch the full objects.
+		obj := &metav1.PartialObjectMetadata{}
+		obj.SetGroupVersionKind(gvk)
+
+		if err := r.ensureWatchForGVK(gvk, obj); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	driftedObjects, err := r.detectDrift(ctx, log, mr, origin, resources.objects, injectLabels, equivalences)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	oldStatus := mr.Status.DeepCopy()
+	r.updateDriftStatus(mr, driftedObjects)
+	if !apiequality.Semantic.DeepEqual(oldStatus, &mr.Status) {
+		if err := r.SourceClient.Status().Update(ctx, mr); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+		}
+	}
+
+	if len(driftedObjects) > 0 && r.driftPolicy(mr) == resourcemanagerconfigv1alpha1.DriftPolicyRevertImmediately {
+		log.Info("Triggering reconciliation of ManagedResource to revert drifted objects")
+
+		select {
+		case r.driftReverts <- event.GenericEvent{Object: mr}:
+		case <-ctx.Done():
+			return reconcile.Result{}, fmt.Errorf("failed triggering reconciliation of ManagedResource: %w", ctx.Err())
+		}
+	}
+
+	log.Info("Finished drift detection for ManagedResource", "driftedObjects", len(driftedObjects))
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// detectDrift compares the actual state of the given objects with their desired state and returns the drifted objects.
+func (r *Reconciler) detectDrift(
+	ctx context.Context,
+	log logr.Logger,
+	mr *resourcesv1alpha1.ManagedResource,
+	origin string,
+	objects []object,
+	labelsToInject map[string]string,
+	equivalences Equivalences,
+) ([]resourcesv1alpha1.DriftedObject, error) {
+	horizontallyScaledObjects, err := computeHorizontallyScaledObjectKeys(ctx, r.TargetClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute all HPA target ref object keys: %w", err)
+	}
+
+	var (
+		serverSideApply = ptr.Deref(mr.Spec.ApplyMode, resourcesv1alpha1.ApplyModeUpdate) == resourcesv1alpha1.ApplyModeServerSideApply
+		detectionTimes  = make(map[corev1.ObjectReference]metav1.Time, len(mr.Status.DriftedObjects))
+		driftedObjects  []resourcesv1alpha1.DriftedObject
+		now             = metav1.NewTime(r.Clock.Now())
+	)
+
+	for _, driftedObject := range mr.Status.DriftedObjects {
+		detectionTimes[driftedObject.ObjectReference] = driftedObject.DetectionTime
+	}
+
+	for _, obj := range sortByKind(objects) {
+		// if the ignore annotation is set to true, the object is not touched when applying
+		if ignore(obj.obj) {
+			continue
+		}
+
+		driftedObject, err := r.detectObjectDrift(ctx, origin, obj, labelsToInject, isScaled(obj.obj, horizontallyScaledObjects, equivalences), serverSideApply)
+		if err != nil {
+			return nil, err
+		}
+		if driftedObject == nil {
+			continue
+		}
+
+		driftedObject.ObjectReference = objectReferenceFor(obj.obj)
+		driftedObject.DetectionTime = now
+		if detectionTime, ok := detectionTimes[driftedObject.ObjectReference]; ok {
+			driftedObject.DetectionTime = detectionTime
+		}
+
+		log.Info("Detected drifted object", "resource", unstructuredToString(obj.obj), "missing", driftedObject.Missing, "changedFields", driftedObject.ChangedFields, "managers", driftedObject.Managers)
+		driftedObjects = append(driftedObjects, *driftedObject)
+	}
+
+	return driftedObjects, nil
+}
+
+// detectObjectDrift compares the actual state of the given object with its desired state. The desired state is
+// computed by sending the same request as for applying the object in server-side dry-run mode. It returns nil if the
+// object has not drifted.
+func (r *Reconciler) detectObjectDrift(ctx context.Context, origin string, obj object, labelsToInject map[string]string, scaledHorizontally, serverSideApply bool) (*resourcesv1alpha1.DriftedObject, error) {
+	resource := unstructuredToString(obj.obj)
+
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(obj.obj.GroupVersionKind())
+	if err := r.TargetClient.Get(ctx, client.ObjectKeyFromObject(obj.obj), current); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return &resourcesv1alpha1.DriftedObject{Missing: true}, nil
+		}
+		return nil, fmt.Errorf("error getting object %q: %w", resource, err)
+	}
+
+	var desired *unstructured.Unstructured
+	if serverSideApply {
+		applyObj, err := desiredObjectForServerSideApply(origin, obj, labelsToInject)
+		if err != nil {
+			return nil, err
+		}
+		if err := leavePreservedFields(applyObj, current, scaledHorizontally); err != nil {
+			return nil, fmt.Errorf("error preserving fields of object %q: %w", resource, err)
+		}
+
+		if desired, _, err = r.serverSideApply(ctx, logr.Discard(), resource, applyObj, client.DryRunAll); err != nil {
+			return nil, fmt.Errorf("error during server-side dry-run apply of object %q: %w", resource, err)
+		}
+	} else {
+		desiredObj := obj.obj.DeepCopy()
+		if err := injectLabels(desiredObj, labelsToInject); err != nil {
+			return nil, fmt.Errorf("error injecting labels into object %q: %w", resource, err)
+		}
+
+		desired = current.DeepCopy()
+		if err := merge(origin, desiredObj, desired, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally); err != nil {
+			return nil, fmt.Errorf("error merging object %q: %w", resource, err)
+		}
+
+		if apiequality.Semantic.DeepEqual(current, desired) {
+			return nil, nil
+		}
+
+		// The server-side dry-run request defaults and admits the object like a real update, hence, fields which are
+		// only different because they are not defaulted in the desired state are not reported.
+		if err := r.TargetClient.Update(ctx, desired, client.DryRunAll); err != nil {
+			return nil, fmt.Errorf("error during server-side dry-run update of object %q: %w", resource, err)
+		}
+	}
+
+	fields := changedFields(current.Object, desired.Object)
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	return &resourcesv1alpha1.DriftedObject{
+		ChangedFields: fields,
+		Managers:      fieldManagersOf(current, fields),
+	}, nil
+}
+
+// fieldManagersOf returns the field managers other than the gardener-resource-manager which manage any of the given
+// fields (or fields nested in them) of the given object.
+func fieldManagersOf(obj *unstructured.Unstructured, fields []string) []string {
+	managers := sets.New[string]()
+
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager == fieldManager || entry.Subresource != "" || entry.FieldsV1 == nil || managers.Has(entry.Manager) {
+			continue
+		}
+
+		managedFields := &fieldpath.Set{}
+		if err := managedFields.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+			continue
+		}
+
+		managedFields.Iterate(func(path fieldpath.Path) {
+			// paths have the format `.spec.replicas` or `.spec.containers[name="foo"].image`
+			managedPath := path.String()
+			for _, field := range fields {
+				field = "." + field
+				if managedPath == field || strings.HasPrefix(managedPath, field+".") || strings.HasPrefix(managedPath, field+"[") {
+					managers.Insert(entry.Manager)
+					return
+				}
+			}
+		})
+	}
+
+	return sets.List(managers)
+}
+
+// updateDriftStatus maintains the drifted objects and the `ResourcesDrifted` condition in the status of the given
+// ManagedResource. Both are removed if drift detection is disabled.
+func (r *Reconciler) updateDriftStatus(mr *resourcesv1alpha1.ManagedResource, driftedObjects []resourcesv1alpha1.DriftedObject) {
+	if r.Config.DriftDetection == nil {
+		mr.Status.Conditions = v1beta1helper.RemoveConditions(mr.Status.Conditions, resourcesv1alpha1.ResourcesDrifted)
+		mr.Status.DriftedObjects = nil
+		return
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesDrifted)
+	if len(driftedObjects) == 0 {
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionNoDrift, "The actual state of all resources matches their desired state.")
+	} else {
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, resourcesv1alpha1.ConditionObjectsDrifted,
+			fmt.Sprintf("The actual state of %d resource(s) differs from their desired state (drift policy %s), see .status.driftedObjects for details.", len(driftedObjects), r.driftPolicy(mr)))
+	}
+
+	mr.Status.Conditions = v1beta1helper.MergeConditions(mr.Status.Conditions, condition)
+	mr.Status.DriftedObjects = driftedObjects
+	metrics.ManagedResourceDriftedObjects.WithLabelValues(mr.Namespace, mr.Name).Set(float64(len(driftedObjects)))
+}
+
+// withoutDriftedObjects removes the drifted objects from the given objects if they must not be reverted, i.e., if the
+// `ReportOnly` drift policy is configured and the desired state of the ManagedResource did not change. It returns the
+// objects to apply and the drifted objects which are kept.
+func (r *Reconciler) withoutDriftedObjects(mr *resourcesv1alpha1.ManagedResource, objects []object, secretsDataChecksum string) ([]object, []resourcesv1alpha1.DriftedObject) {
+	if r.Config.DriftDetection == nil ||
+		r.driftPolicy(mr) != resourcemanagerconfigv1alpha1.DriftPolicyReportOnly ||
+		mr.Status.ObservedGeneration != mr.Generation ||
+		ptr.Deref(mr.Status.SecretsDataChecksum, "") != secretsDataChecksum {
+		return objects, nil
+	}
+
+	driftedObjects := make(map[corev1.ObjectReference]resourcesv1alpha1.DriftedObject, len(mr.Status.DriftedObjects))
+	for _, driftedObject := range mr.Status.DriftedObjects {
+		driftedObjects[driftedObject.ObjectReference] = driftedObject
+	}
+
+	var (
+		objectsToApply []object
+		keptObjects    []resourcesv1alpha1.DriftedObject
+	)
+
+	for _, obj := range objects {
+		if driftedObject, ok := driftedObjects[objectReferenceFor(obj.obj)]; ok {
+			keptObjects = append(keptObjects, driftedObject)
+			continue
+		}
+		objectsToApply = append(objectsToApply, obj)
+	}
+
+	return objectsToApply, keptObjects
+}
+
+// driftPolicy returns the drift policy for the given ManagedResource, i.e., the policy configured for its class or the
+// default policy otherwise.
+func (r *Reconciler) driftPolicy(mr *resourcesv1alpha1.ManagedResource) resourcemanagerconfigv1alpha1.DriftPolicy {
+	if r.Config.DriftDetection == nil {
+		return ""
+	}
+
+	class := ptr.Deref(mr.Spec.Class, "")
+	if class == "" {
+		class = resourcemanagerconfigv1alpha1.DefaultResourceClass
+	}
+	if policy, ok := r.Config.DriftDetection.ClassPolicies[class]; ok {
+		return policy
+	}
+
+	return ptr.Deref(r.Config.DriftDetection.Policy, resourcemanagerconfigv1alpha1.DriftPolicyRevertOnNextSync)
+}
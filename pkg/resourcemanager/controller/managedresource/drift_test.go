@@ -0,0 +1,265 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+)
+
+var _ = Describe("drift detection", func() {
+	Describe("#fieldManagersOf", func() {
+		It("should return the other managers of the given fields", func() {
+			obj := &unstructured.Unstructured{}
+			obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+				{Manager: "gardener-resource-manager", Operation: metav1.ManagedFieldsOperationUpdate, FieldsType: "FieldsV1", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:data":{".":{},"f:foo":{}}}`)}},
+				{Manager: "kubectl-edit", Operation: metav1.ManagedFieldsOperationUpdate, FieldsType: "FieldsV1", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:foo":{}}}`)}},
+				{Manager: "kubectl-label", Operation: metav1.ManagedFieldsOperationUpdate, FieldsType: "FieldsV1", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:app.kubernetes.io/name":{}}}}`)}},
+				{Manager: "unrelated", Operation: metav1.ManagedFieldsOperationUpdate, FieldsType: "FieldsV1", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:bar":{}}}`)}},
+				{Manager: "status-manager", Operation: metav1.ManagedFieldsOperationUpdate, Subresource: "status", FieldsType: "FieldsV1", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:foo":{}}}`)}},
+			})
+
+			Expect(fieldManagersOf(obj, []string{"data.foo", "metadata.labels"})).To(Equal([]string{"kubectl-edit", "kubectl-label"}))
+		})
+	})
+
+	Describe("#Reconcile and #ReconcileDrift", func() {
+		var (
+			ctx = context.Background()
+
+			sourceClient client.Client
+			targetClient client.Client
+			fakeClock    *testclock.FakeClock
+			reconciler   *Reconciler
+			watchedGVKs  []schema.GroupVersionKind
+			driftReverts chan event.GenericEvent
+
+			managedResource *resourcesv1alpha1.ManagedResource
+			configMap       *corev1.ConfigMap
+			request         reconcile.Request
+		)
+
+		BeforeEach(func() {
+			fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+			watchedGVKs = nil
+			driftReverts = make(chan event.GenericEvent, 1)
+
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "mr-secret", Namespace: "garden"},
+				Data: map[string][]byte{"objects.yaml": []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: foo
+  namespace: default
+data:
+  foo: bar
+`)},
+			}
+
+			managedResource = &resourcesv1alpha1.ManagedResource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "mr",
+					Namespace:  "garden",
+					Finalizers: []string{"resources.gardener.cloud/gardener-resource-manager"},
+				},
+				Spec: resourcesv1alpha1.ManagedResourceSpec{
+					SecretRefs: []corev1.LocalObjectReference{{Name: secret.Name}},
+				},
+			}
+			request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(managedResource)}
+			configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}}
+
+			sourceClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.SeedScheme).
+				WithObjects(managedResource, secret).
+				WithStatusSubresource(&resourcesv1alpha1.ManagedResource{}).
+				Build()
+			targetClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+			reconciler = &Reconciler{
+				SourceClient:     sourceClient,
+				TargetClient:     targetClient,
+				TargetScheme:     kubernetes.SeedScheme,
+				TargetRESTMapper: targetClient.RESTMapper(),
+				Config: resourcemanagerconfigv1alpha1.ManagedResourceControllerConfig{
+					SyncPeriod:          &metav1.Duration{Duration: time.Minute},
+					ManagedByLabelValue: ptr.To("gardener"),
+					DriftDetection:      &resourcemanagerconfigv1alpha1.DriftDetectionConfig{Policy: ptr.To(resourcemanagerconfigv1alpha1.DriftPolicyRevertOnNextSync)},
+				},
+				Clock:       fakeClock,
+				ClassFilter: resourcemanagerpredicate.NewClassFilter(""),
+				ensureWatchForGVK: func(gvk schema.GroupVersionKind, obj client.Object) error {
+					Expect(obj).To(BeAssignableToTypeOf(&metav1.PartialObjectMetadata{}))
+					watchedGVKs = append(watchedGVKs, gvk)
+					return nil
+				},
+				driftReverts: driftReverts,
+			}
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+		})
+
+		tamper := func() {
+			configMap.Data["foo"] = "tampered"
+			Expect(targetClient.Update(ctx, configMap)).To(Succeed())
+		}
+
+		expectDrift := func(missing bool, changedFields ...string) {
+			ExpectWithOffset(1, sourceClient.Get(ctx, request.NamespacedName, managedResource)).To(Succeed())
+
+			condition := v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesDrifted)
+			ExpectWithOffset(1, condition).NotTo(BeNil())
+			ExpectWithOffset(1, condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			ExpectWithOffset(1, condition.Reason).To(Equal("ObjectsDrifted"))
+
+			ExpectWithOffset(1, managedResource.Status.DriftedObjects).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"ObjectReference": Equal(corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "foo"}),
+				"DetectionTime":   WithTransform(func(t metav1.Time) time.Time { return t.Time }, BeTemporally("==", fakeClock.Now())),
+				"Missing":         Equal(missing),
+				"ChangedFields":   BeEquivalentTo(changedFields),
+			})))
+		}
+
+		expectNoDrift := func() {
+			ExpectWithOffset(1, sourceClient.Get(ctx, request.NamespacedName, managedResource)).To(Succeed())
+
+			condition := v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesDrifted)
+			ExpectWithOffset(1, condition).NotTo(BeNil())
+			ExpectWithOffset(1, condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			ExpectWithOffset(1, condition.Reason).To(Equal("NoDrift"))
+			ExpectWithOffset(1, managedResource.Status.DriftedObjects).To(BeEmpty())
+		}
+
+		It("should report no drift and watch the resources if the objects are unchanged", func() {
+			Expect(reconciler.ReconcileDrift(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			expectNoDrift()
+			Expect(watchedGVKs).To(ConsistOf(corev1.SchemeGroupVersion.WithKind("ConfigMap")))
+		})
+
+		It("should report changed fields and keep the detection time", func() {
+			tamper()
+
+			Expect(reconciler.ReconcileDrift(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			expectDrift(false, "data.foo")
+
+			fakeClock.Step(time.Minute)
+			Expect(reconciler.ReconcileDrift(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(sourceClient.Get(ctx, request.NamespacedName, managedResource)).To(Succeed())
+			Expect(managedResource.Status.DriftedObjects[0].DetectionTime.Time).To(BeTemporally("==", fakeClock.Now().Add(-time.Minute)))
+		})
+
+		It("should report missing objects", func() {
+			Expect(targetClient.Delete(ctx, configMap)).To(Succeed())
+
+			Expect(reconciler.ReconcileDrift(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			expectDrift(true)
+		})
+
+		It("should skip drift detection if the desired state has not been applied yet", func() {
+			tamper()
+			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "mr-secret", Namespace: "garden"}}
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+			secret.Data["objects.yaml"] = []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: foo\n  namespace: default\ndata:\n  foo: tampered\n")
+			Expect(sourceClient.Update(ctx, secret)).To(Succeed())
+
+			Expect(reconciler.ReconcileDrift(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(sourceClient.Get(ctx, request.NamespacedName, managedResource)).To(Succeed())
+			Expect(v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesDrifted)).To(PointTo(MatchFields(IgnoreExtras, Fields{"Reason": Equal("NoDrift")})))
+			Expect(managedResource.Status.DriftedObjects).To(BeEmpty())
+		})
+
+		It("should revert drifted objects with the next sync (RevertOnNextSync)", func() {
+			tamper()
+			Expect(reconciler.ReconcileDrift(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			expectDrift(false, "data.foo")
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("foo", "bar"))
+			expectNoDrift()
+		})
+
+		It("should trigger a reconciliation to revert drifted objects immediately (RevertImmediately)", func() {
+			reconciler.Config.DriftDetection.Policy = ptr.To(resourcemanagerconfigv1alpha1.DriftPolicyRevertImmediately)
+			tamper()
+
+			Expect(reconciler.ReconcileDrift(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			expectDrift(false, "data.foo")
+			Expect(managedResource.Annotations).NotTo(HaveKey("gardener.cloud/operation"))
+			Expect(driftReverts).To(Receive(HaveField("Object", HaveField("ObjectMeta.Name", Equal(managedResource.Name)))))
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("foo", "bar"))
+		})
+
+		It("should use the drift policy configured for the class of the ManagedResource", func() {
+			reconciler.Config.DriftDetection.ClassPolicies = map[string]resourcemanagerconfigv1alpha1.DriftPolicy{"resources": resourcemanagerconfigv1alpha1.DriftPolicyRevertImmediately}
+			tamper()
+
+			Expect(reconciler.ReconcileDrift(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			expectDrift(false, "data.foo")
+			Expect(driftReverts).To(Receive())
+
+			reconciler.Config.DriftDetection.ClassPolicies = map[string]resourcemanagerconfigv1alpha1.DriftPolicy{"shoot": resourcemanagerconfigv1alpha1.DriftPolicyRevertImmediately}
+
+			Expect(reconciler.ReconcileDrift(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(driftReverts).NotTo(Receive())
+		})
+
+		It("should not revert drifted objects with the next sync (ReportOnly)", func() {
+			reconciler.Config.DriftDetection.Policy = ptr.To(resourcemanagerconfigv1alpha1.DriftPolicyReportOnly)
+			tamper()
+
+			Expect(reconciler.ReconcileDrift(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			expectDrift(false, "data.foo")
+			Expect(driftReverts).NotTo(Receive())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("foo", "tampered"))
+			expectDrift(false, "data.foo")
+		})
+
+		It("should remove the drift status if drift detection is disabled", func() {
+			tamper()
+			Expect(reconciler.ReconcileDrift(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			expectDrift(false, "data.foo")
+
+			reconciler.Config.DriftDetection = nil
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(sourceClient.Get(ctx, request.NamespacedName, managedResource)).To(Succeed())
+			Expect(v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesDrifted)).To(BeNil())
+			Expect(managedResource.Status.DriftedObjects).To(BeEmpty())
+		})
+	})
+})
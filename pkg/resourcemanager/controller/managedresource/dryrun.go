@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// ignoredDryRunFieldPaths contains the paths of fields which are maintained by the API server and thus not reported as
+// changed fields in the dry-run result.
+var ignoredDryRunFieldPaths = sets.New(
+	"metadata.creationTimestamp",
+	"metadata.generation",
+	"metadata.managedFields",
+	"metadata.resourceVersion",
+	"metadata.uid",
+	"status",
+)
+
+// dryRun computes which objects would be created, updated, or deleted when applying the new resources and stores the
+// result in the status of the ManagedResource. Objects in the target cluster are not changed.
+func (r *Reconciler) dryRun(
+	ctx context.Context,
+	log logr.Logger,
+	mr *resourcesv1alpha1.ManagedResource,
+	origin string,
+	newResourcesObjects []object,
+	labelsToInject map[string]string,
+	equivalences Equivalences,
+	existingResourcesIndex *objectIndex,
+	secretsDataChecksum string,
+) (reconcile.Result, error) {
+	log.Info("ManagedResource is in dry-run mode, computing changes without applying them")
+
+	horizontallyScaledObjects, err := computeHorizontallyScaledObjectKeys(ctx, r.TargetClient)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to compute all HPA target ref object keys: %w", err)
+	}
+
+	result := &resourcesv1alpha1.DryRunResult{
+		LastUpdateTime:      metav1.NewTime(r.Clock.Now()),
+		SecretsDataChecksum: &secretsDataChecksum,
+	}
+
+	for _, obj := range sortByKind(newResourcesObjects) {
+		// if the ignore annotation is set to true, the object is not touched when applying
+		if ignore(obj.obj) {
+			continue
+		}
+
+		var (
+			resource           = unstructuredToString(obj.obj)
+			scaledHorizontally = isScaled(obj.obj, horizontallyScaledObjects, equivalences)
+			current            = obj.obj.DeepCopy()
+		)
+
+		if err := injectLabels(obj.obj, labelsToInject); err != nil {
+			return reconcile.Result{}, fmt.Errorf("error injecting labels into object %q: %w", resource, err)
+		}
+
+		if err := r.TargetClient.Get(ctx, client.ObjectKeyFromObject(current), current); err != nil {
+			if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+				return reconcile.Result{}, fmt.Errorf("error getting object %q: %w", resource, err)
+			}
+
+			desired := obj.obj.DeepCopy()
+			if err := merge(origin, obj.obj, desired, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally); err != nil {
+				return reconcile.Result{}, fmt.Errorf("error merging object %q: %w", resource, err)
+			}
+
+			dryRunObject := resourcesv1alpha1.DryRunObject{ObjectReference: objectReferenceFor(obj.obj)}
+			if err := r.TargetClient.Create(ctx, desired, client.DryRunAll); err != nil {
+				dryRunObject.Error = ptr.To(err.Error())
+			}
+			result.Create = append(result.Create, dryRunObject)
+			continue
+		}
+
+		existing := current.DeepCopy()
+		if err := merge(origin, obj.obj, current, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally); err != nil {
+			return reconcile.Result{}, fmt.Errorf("error merging object %q: %w", resource, err)
+		}
+
+		if apiequality.Semantic.DeepEqual(existing, current) {
+			continue
+		}
+
+		dryRunObject := resourcesv1alpha1.DryRunObject{ObjectReference: objectReferenceFor(obj.obj)}
+		if err := r.TargetClient.Update(ctx, current, client.DryRunAll); err != nil {
+			dryRunObject.Error = ptr.To(err.Error())
+		}
+
+		// On success, current is the object returned by the server-side dry-run request, i.e., it has been defaulted and
+		// admitted like a real update.
+		if dryRunObject.ChangedFields = changedFields(existing.Object, current.Object); len(dryRunObject.ChangedFields) == 0 && dryRunObject.Error == nil {
+			continue
+		}
+		result.Update = append(result.Update, dryRunObject)
+	}
+
+	var deletions []resourcesv1alpha1.ObjectReference
+	for _, ref := range existingResourcesIndex.Objects() {
+		if existingResourcesIndex.Found(ref) {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(ref.APIVersion)
+		obj.SetKind(ref.Kind)
+
+		if err := r.TargetClient.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, obj); err != nil {
+			if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+				return reconcile.Result{}, fmt.Errorf("error getting object %q: %w", unstructuredToString(obj), err)
+			}
+			continue
+		}
+
+		if keepObject(obj) || (r.GarbageCollectorActivated && isGarbageCollectableResource(obj)) {
+			continue
+		}
+
+		deletions = append(deletions, ref)
+	}
+
+	sortObjectReferences(deletions)
+	for _, ref := range deletions {
+		result.Delete = append(result.Delete, ref.ObjectReference)
+	}
+
+	mr.Status.DryRun = result
+	if err := r.SourceClient.Status().Update(ctx, mr); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+	}
+
+	log.Info("Finished to compute changes of ManagedResource in dry-run mode", "create", len(result.Create), "update", len(result.Update), "delete", len(result.Delete))
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func objectReferenceFor(obj *unstructured.Unstructured) corev1.ObjectReference {
+	return corev1.ObjectReference{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Name:       obj.GetName(),
+		Namespace:  obj.GetNamespace(),
+	}
+}
+
+// changedFields returns the sorted paths of all fields which differ between the two given objects. Lists are compared
+// as a whole, i.e., the path of the list is returned if any of its elements differs.
+func changedFields(oldObj, newObj map[string]any) []string {
+	var paths []string
+	collectChangedFields("", oldObj, newObj, &paths)
+	slices.Sort(paths)
+	return paths
+}
+
+func collectChangedFields(path string, oldValue, newValue any, paths *[]string) {
+	if ignoredDryRunFieldPaths.Has(path) {
+		return
+	}
+
+	oldMap, oldIsMap := oldValue.(map[string]any)
+	newMap, newIsMap := newValue.(map[string]any)
+	if !oldIsMap || !newIsMap {
+		if !apiequality.Semantic.DeepEqual(oldValue, newValue) {
+			*paths = append(*paths, path)
+		}
+		return
+	}
+
+	for key := range sets.KeySet(oldMap).Union(sets.KeySet(newMap)) {
+		childPath := key
+		if path != "" {
+			childPath = path + "." + key
+		}
+		collectChangedFields(childPath, oldMap[key], newMap[key], paths)
+	}
+}
@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("dry-run", func() {
+	var (
+		ctx = context.Background()
+
+		sourceClient client.Client
+		targetClient client.Client
+		fakeClock    *testclock.FakeClock
+		reconciler   *Reconciler
+
+		managedResource *resourcesv1alpha1.ManagedResource
+		secret          *corev1.Secret
+		existing        *corev1.ConfigMap
+		obsolete        *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "mr-secret", Namespace: "garden"},
+			Data: map[string][]byte{"configmaps.yaml": []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: existing
+  namespace: default
+data:
+  foo: baz
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: new
+  namespace: default
+data:
+  foo: bar
+`)},
+		}
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "mr",
+				Namespace:   "garden",
+				Annotations: map[string]string{"resources.gardener.cloud/dry-run": "true"},
+				Finalizers:  []string{"resources.gardener.cloud/gardener-resource-manager"},
+			},
+			Spec: resourcesv1alpha1.ManagedResourceSpec{
+				SecretRefs: []corev1.LocalObjectReference{{Name: secret.Name}},
+			},
+			Status: resourcesv1alpha1.ManagedResourceStatus{
+				Resources: []resourcesv1alpha1.ObjectReference{
+					{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "existing"}},
+					{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "obsolete"}},
+				},
+			},
+		}
+
+		existing = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "default"},
+			Data:       map[string]string{"foo": "bar"},
+		}
+		obsolete = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "obsolete", Namespace: "default"},
+		}
+
+		sourceClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithObjects(managedResource, secret).
+			WithStatusSubresource(&resourcesv1alpha1.ManagedResource{}).
+			Build()
+		targetClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithObjects(existing, obsolete).
+			Build()
+
+		reconciler = &Reconciler{
+			SourceClient:     sourceClient,
+			TargetClient:     targetClient,
+			TargetScheme:     kubernetes.SeedScheme,
+			TargetRESTMapper: targetClient.RESTMapper(),
+			Config: resourcemanagerconfigv1alpha1.ManagedResourceControllerConfig{
+				SyncPeriod:          &metav1.Duration{Duration: time.Minute},
+				ManagedByLabelValue: ptr.To("gardener"),
+			},
+			Clock:       fakeClock,
+			ClassFilter: resourcemanagerpredicate.NewClassFilter(""),
+		}
+	})
+
+	It("should compute the changes without applying them", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(managedResource)})).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+		Expect(managedResource.Status.Resources).To(HaveLen(2))
+		Expect(managedResource.Status.DryRun).NotTo(BeNil())
+		Expect(managedResource.Status.DryRun.LastUpdateTime.Time.Equal(fakeClock.Now())).To(BeTrue())
+		Expect(managedResource.Status.DryRun.SecretsDataChecksum).NotTo(BeNil())
+		Expect(managedResource.Status.DryRun.Create).To(ConsistOf(
+			resourcesv1alpha1.DryRunObject{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "new"}},
+		))
+		Expect(managedResource.Status.DryRun.Update).To(ConsistOf(
+			resourcesv1alpha1.DryRunObject{
+				ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "existing"},
+				ChangedFields: []string{
+					"data.foo",
+					"metadata.annotations",
+					"metadata.labels",
+				},
+			},
+		))
+		Expect(managedResource.Status.DryRun.Delete).To(ConsistOf(
+			corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "obsolete"},
+		))
+
+		Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(existing), existing)).To(Succeed())
+		Expect(existing.Data).To(Equal(map[string]string{"foo": "bar"}))
+		Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "new"}, &corev1.ConfigMap{})).To(BeNotFoundError())
+		Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(obsolete), obsolete)).To(Succeed())
+	})
+
+	It("should remove the result when leaving dry-run mode and not report objects which are up-to-date", func() {
+		metav1.SetMetaDataAnnotation(&obsolete.ObjectMeta, "resources.gardener.cloud/keep-object", "true")
+		Expect(targetClient.Update(ctx, obsolete)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(managedResource)})).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+		Expect(managedResource.Status.DryRun.Delete).To(BeEmpty())
+
+		delete(managedResource.Annotations, "resources.gardener.cloud/dry-run")
+		Expect(sourceClient.Update(ctx, managedResource)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(managedResource)})).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+		Expect(managedResource.Status.DryRun).To(BeNil())
+
+		metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "resources.gardener.cloud/dry-run", "true")
+		Expect(sourceClient.Update(ctx, managedResource)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(managedResource)})).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+		Expect(managedResource.Status.DryRun.Create).To(BeEmpty())
+		Expect(managedResource.Status.DryRun.Update).To(BeEmpty())
+		Expect(managedResource.Status.DryRun.Delete).To(BeEmpty())
+	})
+
+	Describe("#changedFields", func() {
+		It("should return the paths of all changed fields", func() {
+			Expect(changedFields(
+				map[string]any{
+					"metadata": map[string]any{"name": "foo", "resourceVersion": "1", "labels": map[string]any{"foo": "bar"}},
+					"spec":     map[string]any{"replicas": int64(1), "list": []any{"a"}, "removed": true},
+					"status":   map[string]any{"ready": true},
+				},
+				map[string]any{
+					"metadata": map[string]any{"name": "foo", "resourceVersion": "2", "labels": map[string]any{"foo": "baz"}},
+					"spec":     map[string]any{"replicas": int64(2), "list": []any{"a", "b"}, "added": "x"},
+					"status":   map[string]any{"ready": false},
+				},
+			)).To(Equal([]string{
+				"metadata.labels.foo",
+				"spec.added",
+				"spec.list",
+				"spec.removed",
+				"spec.replicas",
+			}))
+		})
+
+		It("should return nothing for equal objects", func() {
+			obj := map[string]any{"spec": map[string]any{"replicas": int64(1)}}
+			Expect(changedFields(obj, obj)).To(BeEmpty())
+		})
+	})
+})
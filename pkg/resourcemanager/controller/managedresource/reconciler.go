@@ -39,8 +39,10 @@ import (
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -64,16 +66,29 @@ var (
 
 // Reconciler manages the resources reference by ManagedResources.
 type Reconciler struct {
-	SourceClient                  client.Client
-	TargetClient                  client.Client
-	TargetScheme                  *runtime.Scheme
-	TargetRESTMapper              meta.RESTMapper
-	Config                        resourcemanagerconfigv1alpha1.ManagedResourceControllerConfig
-	Clock                         clock.Clock
-	ClassFilter                   *resourcemanagerpredicate.ClassFilter
-	ClusterID                     string
-	GarbageCollectorActivated     bool
-	RequeueAfterOnDeletionPending *time.Duration
+	SourceClient                    client.Client
+	TargetClient                    client.Client
+	TargetScheme                    *runtime.Scheme
+	TargetRESTMapper                meta.RESTMapper
+	Config                          resourcemanagerconfigv1alpha1.ManagedResourceControllerConfig
+	Clock                           clock.Clock
+	ClassFilter                     *resourcemanagerpredicate.ClassFilter
+	ClusterID                       string
+	GarbageCollectorActivated       bool
+	RequeueAfterOnDeletionPending   *time.Duration
+	RequeueAfterOnApplyPhasePending *time.Duration
+	// TargetNamespaces are the namespaces of the target cluster which are watched for drift detection. All namespaces
+	// are watched if empty.
+	TargetNamespaces []string
+
+	// ensureWatchForGVK ensures that the drift controller is watching the given object to detect drift of the
+	// corresponding ManagedResources.
+	ensureWatchForGVK func(gvk schema.GroupVersionKind, obj client.Object) error
+	// driftCache is the cache containing the metadata of the resources watched for drift detection.
+	driftCache cache.Cache
+	// driftReverts is used by the drift controller to enqueue ManagedResources whose drifted resources must be reverted
+	// immediately.
+	driftReverts chan event.GenericEvent
 }
 
 // Reconcile manages the resources reference by ManagedResources.
@@ -128,19 +143,162 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	}
 
 	var (
-		newResourcesObjects          []object
-		newResourcesObjectReferences []resourcesv1alpha1.ObjectReference
-		orphanedObjectReferences     []resourcesv1alpha1.ObjectReference
-
 		equivalences           = NewEquivalences(mr.Spec.Equivalences...)
 		existingResourcesIndex = NewObjectIndex(mr.Status.Resources, equivalences)
 		origin                 = resourcesv1alpha1helper.OriginForManagedResource(r.ClusterID, mr)
+	)
+
+	// Initialize condition based on the current status.
+	conditionResourcesApplied := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
+
+	resources, err := r.decodeResources(ctx, log, mr, existingResourcesIndex)
+	if err != nil {
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, "CannotReadSecret", err.Error())
+		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+		}
+
+		return reconcile.Result{}, err
+	}
+
+	var (
+		newResourcesObjects          = resources.objects
+		newResourcesObjectReferences = resources.objectReferences
+		orphanedObjectReferences     = resources.orphanedObjectReferences
+		decodingErrors               = resources.decodingErrors
+		secretsDataChecksum          = resources.secretsDataChecksum
+	)
+
+	injectLabels := mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
+	if keyExistsAndValueTrue(mr.Annotations, resourcesv1alpha1.DryRun) {
+		return r.dryRun(ctx, log, mr, origin, newResourcesObjects, injectLabels, equivalences, existingResourcesIndex, secretsDataChecksum)
+	}
+
+	// invalidate conditions, if resources have been added/removed from the managed resource
+	if !apiequality.Semantic.DeepEqual(mr.Status.Resources, newResourcesObjectReferences) || mr.Status.SecretsDataChecksum == nil || *mr.Status.SecretsDataChecksum != secretsDataChecksum {
+		conditionResourcesHealthy := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesHealthy)
+		conditionResourcesHealthy = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesHealthy, gardencorev1beta1.ConditionUnknown,
+			resourcesv1alpha1.ConditionChecksPending, "The health checks have not yet been executed for the current set of resources.")
+		conditionResourcesProgressing := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesProgressing)
+		conditionResourcesProgressing = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesProgressing, gardencorev1beta1.ConditionUnknown,
+			resourcesv1alpha1.ConditionChecksPending, "Checks have not yet been executed for the current set of resources.")
+
+		reason := resourcesv1alpha1.ConditionApplyProgressing
+		msg := "The resources are currently being reconciled."
+		switch conditionResourcesApplied.Reason {
+		case resourcesv1alpha1.ConditionApplyFailed, resourcesv1alpha1.ConditionApplyPhasePending, resourcesv1alpha1.ConditionDeletionFailed, resourcesv1alpha1.ConditionDeletionPending:
+			// keep condition reason and message if last reconciliation failed
+			reason = conditionResourcesApplied.Reason
+			msg = conditionResourcesApplied.Message
+		}
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionProgressing, reason, msg)
+
+		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesHealthy, conditionResourcesProgressing, conditionResourcesApplied); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+		}
+	}
+
+	if deletionPending, err := r.cleanOldResources(ctx, log, mr, existingResourcesIndex); err != nil {
+		var (
+			reason string
+			status gardencorev1beta1.ConditionStatus
+		)
+		if deletionPending {
+			reason = resourcesv1alpha1.ConditionDeletionPending
+			status = gardencorev1beta1.ConditionProgressing
+			log.Info("Deletion is still pending", "err", err)
+		} else {
+			reason = resourcesv1alpha1.ConditionDeletionFailed
+			status = gardencorev1beta1.ConditionFalse
+			log.Error(err, "Deletion of old resources failed")
+		}
+
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, status, reason, err.Error())
+		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+		}
+
+		if deletionPending {
+			return reconcile.Result{RequeueAfter: *r.RequeueAfterOnDeletionPending}, nil
+		} else {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if err := r.releaseOrphanedResources(ctx, log, orphanedObjectReferences, origin); err != nil {
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ReleaseOfOrphanedResourcesFailed, err.Error())
+		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+		}
+
+		return reconcile.Result{}, fmt.Errorf("could not release all orphaned resources: %+v", err)
+	}
+
+	objectsToApply, keptDriftedObjects := r.withoutDriftedObjects(mr, newResourcesObjects, secretsDataChecksum)
+	if len(keptDriftedObjects) > 0 {
+		log.Info("Not reverting drifted objects because of the drift policy", "driftPolicy", r.driftPolicy(mr), "driftedObjects", len(keptDriftedObjects))
+	}
+
+	serverSideApply := ptr.Deref(mr.Spec.ApplyMode, resourcesv1alpha1.ApplyModeUpdate) == resourcesv1alpha1.ApplyModeServerSideApply
+	conflicts, err := r.applyNewResources(ctx, log, origin, objectsToApply, injectLabels, equivalences, serverSideApply)
+	r.updateConditionResourcesConflicting(mr, serverSideApply, conflicts)
+	if err != nil {
+		if isApplyPhasePending(err) {
+			log.Info("Apply phase is still pending", "reason", err.Error())
+
+			conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionProgressing, resourcesv1alpha1.ConditionApplyPhasePending, err.Error())
+			// The status is updated with all new resources to make sure that the objects applied so far are deleted when
+			// the ManagedResource is deleted.
+			if err := updateManagedResourceStatus(ctx, r.SourceClient, mr, &secretsDataChecksum, newResourcesObjectReferences, conditionResourcesApplied); err != nil {
+				return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+			}
+
+			return reconcile.Result{RequeueAfter: *r.RequeueAfterOnApplyPhasePending}, nil
+		}
+
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionApplyFailed, err.Error())
+		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+		}
+
+		return reconcile.Result{}, fmt.Errorf("could not apply all new resources: %+v", err)
+	}
+
+	if len(decodingErrors) != 0 {
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionDecodingFailed, fmt.Sprintf("Could not decode all new resources: %v", decodingErrors))
+	} else {
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionTrue, resourcesv1alpha1.ConditionApplySucceeded, "All resources are applied.")
+	}
+
+	// all drifted objects except the kept ones have been reverted
+	r.updateDriftStatus(mr, keptDriftedObjects)
+
+	if err := updateManagedResourceStatus(ctx, r.SourceClient, mr, &secretsDataChecksum, newResourcesObjectReferences, conditionResourcesApplied); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+	}
+
+	log.Info("Finished to reconcile ManagedResource")
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// decodedResources contains the objects decoded from the secrets referenced by a ManagedResource.
+type decodedResources struct {
+	objects                  []object
+	objectReferences         []resourcesv1alpha1.ObjectReference
+	orphanedObjectReferences []resourcesv1alpha1.ObjectReference
+	decodingErrors           []*decodingError
+	secretsDataChecksum      string
+}
+
+// decodeResources reads the secrets referenced by the given ManagedResource and decodes the contained objects. Objects
+// which cannot be decoded are collected as decoding errors, an error is only returned if a secret cannot be read.
+func (r *Reconciler) decodeResources(ctx context.Context, log logr.Logger, mr *resourcesv1alpha1.ManagedResource, existingResourcesIndex *objectIndex) (*decodedResources, error) {
+	var (
+		resources = &decodedResources{}
 
 		forceOverwriteLabels      bool
 		forceOverwriteAnnotations bool
 
-		decodingErrors []*decodingError
-
 		hash = sha256.New()
 	)
 
@@ -151,18 +309,10 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 		forceOverwriteAnnotations = *v
 	}
 
-	// Initialize condition based on the current status.
-	conditionResourcesApplied := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
-
 	for _, ref := range mr.Spec.SecretRefs {
 		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ref.Name, Namespace: mr.Namespace}}
 		if err := r.SourceClient.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
-			conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, "CannotReadSecret", err.Error())
-			if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
-				return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
-			}
-
-			return reconcile.Result{}, fmt.Errorf("could not read secret '%s': %+v", secret.Name, err)
+			return nil, fmt.Errorf("could not read secret '%s': %w", secret.Name, err)
 		}
 
 		// Sort secret's data key to keep consistent ordering while calculating checksum
@@ -197,7 +347,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 						secretKey:   secretKey,
 						indexInFile: indexInFile,
 					}
-					decodingErrors = append(decodingErrors, dErr)
+					resources.decodingErrors = append(resources.decodingErrors, dErr)
 					objLog.Error(dErr.err, "Could not decode resource")
 					continue
 				}
@@ -263,7 +413,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 
 				if ignoreMode(obj) {
 					if found {
-						orphanedObjectReferences = append(orphanedObjectReferences, objectReference)
+						resources.orphanedObjectReferences = append(resources.orphanedObjectReferences, objectReference)
 					}
 
 					objLog.Info("Skipping object because it is marked to be ignored")
@@ -271,101 +421,20 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 				}
 
 				hash.Write(secret.Data[secretKey])
-				newResourcesObjects = append(newResourcesObjects, newObj)
-				newResourcesObjectReferences = append(newResourcesObjectReferences, objectReference)
+				resources.objects = append(resources.objects, newObj)
+				resources.objectReferences = append(resources.objectReferences, objectReference)
 			}
 		}
 	}
 
 	// calculate the checksum for the referenced secrets data.
-	secretsDataChecksum := hex.EncodeToString(hash.Sum(nil))
+	resources.secretsDataChecksum = hex.EncodeToString(hash.Sum(nil))
 
 	// sort object references before updating status, to keep consistent ordering
 	// (otherwise, the order will be different on each update)
-	sortObjectReferences(newResourcesObjectReferences)
-
-	// invalidate conditions, if resources have been added/removed from the managed resource
-	if !apiequality.Semantic.DeepEqual(mr.Status.Resources, newResourcesObjectReferences) || mr.Status.SecretsDataChecksum == nil || *mr.Status.SecretsDataChecksum != secretsDataChecksum {
-		conditionResourcesHealthy := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesHealthy)
-		conditionResourcesHealthy = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesHealthy, gardencorev1beta1.ConditionUnknown,
-			resourcesv1alpha1.ConditionChecksPending, "The health checks have not yet been executed for the current set of resources.")
-		conditionResourcesProgressing := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesProgressing)
-		conditionResourcesProgressing = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesProgressing, gardencorev1beta1.ConditionUnknown,
-			resourcesv1alpha1.ConditionChecksPending, "Checks have not yet been executed for the current set of resources.")
-
-		reason := resourcesv1alpha1.ConditionApplyProgressing
-		msg := "The resources are currently being reconciled."
-		switch conditionResourcesApplied.Reason {
-		case resourcesv1alpha1.ConditionApplyFailed, resourcesv1alpha1.ConditionDeletionFailed, resourcesv1alpha1.ConditionDeletionPending:
-			// keep condition reason and message if last reconciliation failed
-			reason = conditionResourcesApplied.Reason
-			msg = conditionResourcesApplied.Message
-		}
-		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionProgressing, reason, msg)
-
-		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesHealthy, conditionResourcesProgressing, conditionResourcesApplied); err != nil {
-			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
-		}
-	}
-
-	if deletionPending, err := r.cleanOldResources(ctx, log, mr, existingResourcesIndex); err != nil {
-		var (
-			reason string
-			status gardencorev1beta1.ConditionStatus
-		)
-		if deletionPending {
-			reason = resourcesv1alpha1.ConditionDeletionPending
-			status = gardencorev1beta1.ConditionProgressing
-			log.Info("Deletion is still pending", "err", err)
-		} else {
-			reason = resourcesv1alpha1.ConditionDeletionFailed
-			status = gardencorev1beta1.ConditionFalse
-			log.Error(err, "Deletion of old resources failed")
-		}
-
-		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, status, reason, err.Error())
-		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
-			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
-		}
-
-		if deletionPending {
-			return reconcile.Result{RequeueAfter: *r.RequeueAfterOnDeletionPending}, nil
-		} else {
-			return reconcile.Result{}, err
-		}
-	}
-
-	if err := r.releaseOrphanedResources(ctx, log, orphanedObjectReferences, origin); err != nil {
-		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ReleaseOfOrphanedResourcesFailed, err.Error())
-		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
-			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
-		}
-
-		return reconcile.Result{}, fmt.Errorf("could not release all orphaned resources: %+v", err)
-	}
+	sortObjectReferences(resources.objectReferences)
 
-	injectLabels := mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
-	if err := r.applyNewResources(ctx, log, origin, newResourcesObjects, injectLabels, equivalences); err != nil {
-		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionApplyFailed, err.Error())
-		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
-			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
-		}
-
-		return reconcile.Result{}, fmt.Errorf("could not apply all new resources: %+v", err)
-	}
-
-	if len(decodingErrors) != 0 {
-		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionDecodingFailed, fmt.Sprintf("Could not decode all new resources: %v", decodingErrors))
-	} else {
-		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionTrue, resourcesv1alpha1.ConditionApplySucceeded, "All resources are applied.")
-	}
-
-	if err := updateManagedResourceStatus(ctx, r.SourceClient, mr, &secretsDataChecksum, newResourcesObjectReferences, conditionResourcesApplied); err != nil {
-		return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
-	}
-
-	log.Info("Finished to reconcile ManagedResource")
-	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	return resources, nil
 }
 
 func (r *Reconciler) delete(ctx context.Context, log logr.Logger, mr *resourcesv1alpha1.ManagedResource) (reconcile.Result, error) {
@@ -460,72 +529,108 @@ func (r *Reconciler) updateConditionsForDeletion(ctx context.Context, mr *resour
 	return updateConditions(ctx, r.SourceClient, mr, conditionResourcesHealthy, conditionResourcesProgressing)
 }
 
-func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, origin string, newResourcesObjects []object, labelsToInject map[string]string, equivalences Equivalences) error {
-	newResourcesObjects = sortByKind(newResourcesObjects)
+func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, origin string, newResourcesObjects []object, labelsToInject map[string]string, equivalences Equivalences, serverSideApply bool) ([]fieldConflict, error) {
+	phases, err := groupByApplyPhase(newResourcesObjects)
+	if err != nil {
+		return nil, err
+	}
 
 	// get all HPA targetRefs to check if we should prevent overwriting replicas.
 	// VPAs don't have to be checked, as they don't update the spec directly and only mutate Pods via a MutatingWebhook
 	// and therefore don't interfere with the resource manager.
 	horizontallyScaledObjects, err := computeHorizontallyScaledObjectKeys(ctx, r.TargetClient)
 	if err != nil {
-		return fmt.Errorf("failed to compute all HPA target ref object keys: %w", err)
+		return nil, fmt.Errorf("failed to compute all HPA target ref object keys: %w", err)
 	}
 
-	for _, obj := range newResourcesObjects {
-		var (
-			current            = obj.obj.DeepCopy()
-			resource           = unstructuredToString(obj.obj)
-			scaledHorizontally = isScaled(obj.obj, horizontallyScaledObjects, equivalences)
-		)
-
-		resourceLogger := log.WithValues("resource", resource)
+	var conflicts []fieldConflict
 
-		resourceLogger.V(1).Info("Applying")
+	for i, phase := range phases {
+		phaseLog := log
+		if len(phases) > 1 {
+			phaseLog = log.WithValues("applyPhase", phase.phase)
+		}
 
-		operationResult, err := controllerutils.TypedCreateOrUpdate(ctx, r.TargetClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), func() error {
-			metadata, err := meta.Accessor(obj.obj)
-			if err != nil {
-				return fmt.Errorf("error getting metadata of object %q: %s", resource, err)
+		for _, obj := range sortByKind(phase.objects) {
+			scaledHorizontally := isScaled(obj.obj, horizontallyScaledObjects, equivalences)
+			if serverSideApply {
+				objectConflicts, err := r.serverSideApplyObject(ctx, phaseLog, origin, obj, labelsToInject, scaledHorizontally)
+				conflicts = append(conflicts, objectConflicts...)
+				if err != nil {
+					return conflicts, err
+				}
+				continue
 			}
 
-			// if the ignore annotation is set to false, do nothing (ignore the resource)
-			if ignore(metadata) {
-				annotations := current.GetAnnotations()
-				delete(annotations, descriptionAnnotation)
-				current.SetAnnotations(annotations)
-				return nil
+			if err := r.applyObject(ctx, phaseLog, origin, obj, labelsToInject, scaledHorizontally); err != nil {
+				return conflicts, err
 			}
+		}
 
-			if err := injectLabels(obj.obj, labelsToInject); err != nil {
-				return fmt.Errorf("error injecting labels into object %q: %s", resource, err)
+		// the objects of the last phase don't need to be checked here, this is done by the health controller
+		if i < len(phases)-1 {
+			if err := r.checkApplyPhaseHealth(ctx, phase); err != nil {
+				return conflicts, err
 			}
+		}
+	}
+
+	return conflicts, nil
+}
+
+func (r *Reconciler) applyObject(ctx context.Context, log logr.Logger, origin string, obj object, labelsToInject map[string]string, scaledHorizontally bool) error {
+	var (
+		current  = obj.obj.DeepCopy()
+		resource = unstructuredToString(obj.obj)
+	)
+
+	resourceLogger := log.WithValues("resource", resource)
 
-			return merge(origin, obj.obj, current, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally)
-		})
+	resourceLogger.V(1).Info("Applying")
+
+	operationResult, err := controllerutils.TypedCreateOrUpdate(ctx, r.TargetClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), func() error {
+		metadata, err := meta.Accessor(obj.obj)
 		if err != nil {
-			if apierrors.IsConflict(err) {
-				return err
-			}
+			return fmt.Errorf("error getting metadata of object %q: %s", resource, err)
+		}
 
-			if apierrors.IsInvalid(err) && operationResult == controllerutil.OperationResultUpdated && deleteOnInvalidUpdate(current, err) {
-				if deleteErr := r.TargetClient.Delete(ctx, current); client.IgnoreNotFound(deleteErr) != nil {
-					return fmt.Errorf("error deleting object %q after 'invalid' update error: %s", resource, deleteErr)
-				}
-				// return error directly, so that the create after delete will be retried
-				return fmt.Errorf("deleted object %q because of 'invalid' update error, and 'delete-on-invalid-update' annotation on object or the resource is an immutable ConfigMap/Secret: %s", resource, err)
-			}
+		// if the ignore annotation is set to false, do nothing (ignore the resource)
+		if ignore(metadata) {
+			annotations := current.GetAnnotations()
+			delete(annotations, descriptionAnnotation)
+			current.SetAnnotations(annotations)
+			return nil
+		}
+
+		if err := injectLabels(obj.obj, labelsToInject); err != nil {
+			return fmt.Errorf("error injecting labels into object %q: %s", resource, err)
+		}
 
-			return fmt.Errorf("error during apply of object %q: %s", resource, err)
+		return merge(origin, obj.obj, current, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally)
+	})
+	if err != nil {
+		if apierrors.IsConflict(err) {
+			return err
 		}
 
-		switch operationResult {
-		case controllerutil.OperationResultCreated:
-			resourceLogger.Info("Created resource because it was not existing before")
-		case controllerutil.OperationResultUpdated:
-			resourceLogger.Info("Updated resource because its actual state differed from the desired state")
-		case controllerutil.OperationResultNone:
-			resourceLogger.V(1).Info("Resource was neither created nor updated because its actual state matches with the desired state")
+		if apierrors.IsInvalid(err) && operationResult == controllerutil.OperationResultUpdated && deleteOnInvalidUpdate(current, err) {
+			if deleteErr := r.TargetClient.Delete(ctx, current); client.IgnoreNotFound(deleteErr) != nil {
+				return fmt.Errorf("error deleting object %q after 'invalid' update error: %s", resource, deleteErr)
+			}
+			// return error directly, so that the create after delete will be retried
+			return fmt.Errorf("deleted object %q because of 'invalid' update error, and 'delete-on-invalid-update' annotation on object or the resource is an immutable ConfigMap/Secret: %s", resource, err)
 		}
+
+		return fmt.Errorf("error during apply of object %q: %s", resource, err)
+	}
+
+	switch operationResult {
+	case controllerutil.OperationResultCreated:
+		resourceLogger.Info("Created resource because it was not existing before")
+	case controllerutil.OperationResultUpdated:
+		resourceLogger.Info("Updated resource because its actual state differed from the desired state")
+	case controllerutil.OperationResultNone:
+		resourceLogger.V(1).Info("Resource was neither created nor updated because its actual state matches with the desired state")
 	}
 
 	return nil
@@ -863,6 +968,7 @@ func updateManagedResourceStatus(
 	mr.Status.SecretsDataChecksum = secretsDataChecksum
 	mr.Status.Resources = resources
 	mr.Status.ObservedGeneration = mr.Generation
+	mr.Status.DryRun = nil
 	return c.Status().Update(ctx, mr)
 }
 
@@ -0,0 +1,397 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v6/value"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// fieldManager is the field manager used for applying objects of ManagedResources via server-side apply.
+const fieldManager = "gardener-resource-manager"
+
+// fieldConflict is a field of an object which was changed by another field manager.
+type fieldConflict struct {
+	resource string
+	field    string
+	manager  string
+}
+
+func (c fieldConflict) String() string {
+	return fmt.Sprintf("%s: %s (manager %q)", c.resource, c.field, c.manager)
+}
+
+// serverSideApplyObject applies the given object via server-side apply. Fields changed by other field managers are
+// taken over and returned as conflicts. Only replicas and resources which must be preserved are left to the other
+// managers, see leavePreservedFields.
+func (r *Reconciler) serverSideApplyObject(ctx context.Context, log logr.Logger, origin string, obj object, labelsToInject map[string]string, scaledHorizontally bool) ([]fieldConflict, error) {
+	resource := unstructuredToString(obj.obj)
+
+	if ignore(obj.obj) {
+		return nil, nil
+	}
+
+	desired, err := desiredObjectForServerSideApply(origin, obj, labelsToInject)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceLogger := log.WithValues("resource", resource)
+	resourceLogger.V(1).Info("Applying via server-side apply")
+
+	if podTemplatePath(desired) != nil {
+		current := &unstructured.Unstructured{}
+		current.SetGroupVersionKind(desired.GroupVersionKind())
+		if err := r.TargetClient.Get(ctx, client.ObjectKeyFromObject(desired), current); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("error getting object %q: %w", resource, err)
+			}
+		} else if err := leavePreservedFields(desired, current, scaledHorizontally); err != nil {
+			return nil, fmt.Errorf("error preserving fields of object %q: %w", resource, err)
+		}
+	}
+
+	_, conflicts, err := r.serverSideApply(ctx, resourceLogger, resource, desired)
+	if err != nil {
+		if apierrors.IsInvalid(err) && deleteOnInvalidUpdate(desired, err) {
+			if deleteErr := r.TargetClient.Delete(ctx, desired); client.IgnoreNotFound(deleteErr) != nil {
+				return conflicts, fmt.Errorf("error deleting object %q after 'invalid' update error: %s", resource, deleteErr)
+			}
+			// return error directly, so that the create after delete will be retried
+			return conflicts, fmt.Errorf("deleted object %q because of 'invalid' update error, and 'delete-on-invalid-update' annotation on object or the resource is an immutable ConfigMap/Secret: %s", resource, err)
+		}
+
+		return conflicts, fmt.Errorf("error during server-side apply of object %q: %w", resource, err)
+	}
+
+	return conflicts, nil
+}
+
+// desiredObjectForServerSideApply returns the object which is sent in server-side apply requests for the given object.
+func desiredObjectForServerSideApply(origin string, obj object, labelsToInject map[string]string) (*unstructured.Unstructured, error) {
+	desired := obj.obj.DeepCopy()
+	if err := injectLabels(desired, labelsToInject); err != nil {
+		return nil, fmt.Errorf("error injecting labels into object %q: %s", unstructuredToString(obj.obj), err)
+	}
+
+	annotations := desired.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[descriptionAnnotation] = descriptionAnnotationText
+	annotations[resourcesv1alpha1.OriginAnnotation] = origin
+	desired.SetAnnotations(annotations)
+	desired.SetResourceVersion("")
+	desired.SetManagedFields(nil)
+
+	return desired, nil
+}
+
+// serverSideApply sends the given object in a server-side apply request. In case of conflicts, the request is retried
+// once with forced ownership, i.e., the conflicting fields are taken over from the other field managers. It returns
+// the object as returned by the API server and the fields which have been taken over from other field managers.
+func (r *Reconciler) serverSideApply(ctx context.Context, log logr.Logger, resource string, desired *unstructured.Unstructured, opts ...client.PatchOption) (*unstructured.Unstructured, []fieldConflict, error) {
+	applied := desired.DeepCopy()
+	opts = append([]client.PatchOption{client.FieldOwner(fieldManager)}, opts...)
+
+	err := r.TargetClient.Patch(ctx, applied, client.Apply, opts...)
+	if !apierrors.IsConflict(err) {
+		return applied, nil, err
+	}
+
+	var conflicts []fieldConflict
+	for _, conflict := range fieldManagerConflicts(resource, err) {
+		// fields owned by the gardener-resource-manager because of an update request are taken over without reporting
+		if conflict.manager != fieldManager {
+			conflicts = append(conflicts, conflict)
+		}
+	}
+
+	if len(conflicts) > 0 {
+		log.Info("Taking over fields changed by other field managers", "conflicts", conflicts)
+	}
+
+	applied = desired.DeepCopy()
+	err = r.TargetClient.Patch(ctx, applied, client.Apply, append(opts, client.ForceOwnership)...)
+	return applied, conflicts, err
+}
+
+// leavePreservedFields handles the fields of the given desired workload which must not be overwritten (see
+// preservedFields) according to their ownership in the given current object: fields which are managed by other field
+// managers (e.g., an HPA or a VPA) are removed from the desired object, i.e., they are left to these managers. All other
+// preserved fields are set to their current values, so that they are not changed but stay owned by the
+// gardener-resource-manager.
+func leavePreservedFields(desired, current *unstructured.Unstructured, preserveReplicas bool) error {
+	paths := preservedFields(desired, preserveReplicas)
+	if len(paths) == 0 {
+		return nil
+	}
+
+	managedByOthers, err := fieldsManagedByOthers(current)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		if managedByOthers.Has(path) {
+			if parent, name, ok := fieldParent(desired.Object, path, false); ok {
+				delete(parent, name)
+			}
+			continue
+		}
+
+		currentParent, name, ok := fieldParent(current.Object, path, false)
+		if !ok {
+			continue
+		}
+		currentValue, ok := currentParent[name]
+		if !ok {
+			continue
+		}
+		if parent, name, ok := fieldParent(desired.Object, path, true); ok {
+			parent[name] = runtime.DeepCopyJSONValue(currentValue)
+		}
+	}
+
+	return nil
+}
+
+// preservedFields returns the paths of the fields of the given object which must not be overwritten, following the same
+// rules as merge: the `.spec.replicas` of Deployments and StatefulSets which are scaled horizontally, annotated with
+// `resources.gardener.cloud/preserve-replicas` or which do not specify replicas, and the CPU and memory
+// requests/limits of all containers of workloads annotated with `resources.gardener.cloud/preserve-resources`.
+func preservedFields(obj *unstructured.Unstructured, preserveReplicas bool) []fieldpath.Path {
+	templatePath := podTemplatePath(obj)
+	if templatePath == nil {
+		return nil
+	}
+
+	var (
+		paths       []fieldpath.Path
+		annotations = obj.GetAnnotations()
+	)
+
+	switch obj.GroupVersionKind().Kind {
+	case "Deployment", "StatefulSet":
+		if _, found, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", "replicas"); !found || preserveReplicas || annotations[resourcesv1alpha1.PreserveReplicas] == "true" {
+			paths = append(paths, fieldpath.MakePathOrDie("spec", "replicas"))
+		}
+	}
+
+	if annotations[resourcesv1alpha1.PreserveResources] != "true" {
+		return paths
+	}
+
+	containersPath := append(slices.Clone(templatePath), "spec", "containers")
+	containers, _, _ := unstructured.NestedSlice(obj.Object, containersPath...)
+	for _, container := range containers {
+		containerMap, ok := container.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, ok := containerMap["name"].(string)
+		if !ok {
+			continue
+		}
+
+		for _, resourceType := range []string{"requests", "limits"} {
+			for _, resourceName := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+				var parts []any
+				for _, field := range containersPath {
+					parts = append(parts, field)
+				}
+				parts = append(parts, fieldpath.KeyByFields("name", name), "resources", resourceType, string(resourceName))
+				paths = append(paths, fieldpath.MakePathOrDie(parts...))
+			}
+		}
+	}
+
+	return paths
+}
+
+// podTemplatePath returns the path of the pod template in the given workload object, or nil if the object is no
+// workload.
+func podTemplatePath(obj *unstructured.Unstructured) []string {
+	switch obj.GroupVersionKind().GroupKind() {
+	case appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind(), extensionsv1beta1.SchemeGroupVersion.WithKind("Deployment").GroupKind(),
+		appsv1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind(), extensionsv1beta1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind(),
+		appsv1.SchemeGroupVersion.WithKind("DaemonSet").GroupKind(),
+		batchv1.SchemeGroupVersion.WithKind("Job").GroupKind():
+		return []string{"spec", "template"}
+	case batchv1.SchemeGroupVersion.WithKind("CronJob").GroupKind():
+		return []string{"spec", "jobTemplate", "spec", "template"}
+	}
+	return nil
+}
+
+// fieldsManagedByOthers returns the set of fields of the given object which are managed by field managers other than
+// the gardener-resource-manager.
+func fieldsManagedByOthers(obj *unstructured.Unstructured) (*fieldpath.Set, error) {
+	fields := &fieldpath.Set{}
+
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager == fieldManager || entry.FieldsV1 == nil {
+			continue
+		}
+
+		managedFields := &fieldpath.Set{}
+		if err := managedFields.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+			return nil, fmt.Errorf("failed decoding fields managed by %q: %w", entry.Manager, err)
+		}
+		fields = fields.Union(managedFields)
+	}
+
+	return fields, nil
+}
+
+// fieldParent returns the map containing the field with the given path in the given object together with the name of
+// the field. Only paths consisting of field names and keys of list elements are supported. If create is true, missing
+// maps are added to the object.
+func fieldParent(obj map[string]any, path fieldpath.Path, create bool) (map[string]any, string, bool) {
+	var current any = obj
+
+	for i, element := range path {
+		switch {
+		case element.FieldName != nil:
+			m, ok := current.(map[string]any)
+			if !ok {
+				return nil, "", false
+			}
+			if i == len(path)-1 {
+				return m, *element.FieldName, true
+			}
+
+			child, ok := m[*element.FieldName]
+			if !ok && create && path[i+1].FieldName != nil {
+				child = map[string]any{}
+				m[*element.FieldName] = child
+			}
+			current = child
+
+		case element.Key != nil:
+			list, ok := current.([]any)
+			if !ok {
+				return nil, "", false
+			}
+
+			j := slices.IndexFunc(list, func(item any) bool { return matchesKey(item, *element.Key) })
+			if j == -1 {
+				return nil, "", false
+			}
+			current = list[j]
+
+		default:
+			return nil, "", false
+		}
+	}
+
+	return nil, "", false
+}
+
+// matchesKey returns true if the given list element has the given key fields.
+func matchesKey(item any, key value.FieldList) bool {
+	m, ok := item.(map[string]any)
+	if !ok {
+		return false
+	}
+
+	for _, field := range key {
+		v, ok := m[field.Name]
+		if !ok || !value.Equals(field.Value, value.NewValueInterface(v)) {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldManagerConflicts extracts the conflicting fields and their managers from the given server-side apply error.
+func fieldManagerConflicts(resource string, err error) []fieldConflict {
+	var (
+		conflicts []fieldConflict
+		statusErr apierrors.APIStatus
+	)
+
+	if !errors.As(err, &statusErr) || statusErr.Status().Details == nil {
+		return nil
+	}
+
+	for _, cause := range statusErr.Status().Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+
+		// the message has the format `conflict with "<manager>"[ with subresource "<subresource>"] using <api-version>`
+		manager := cause.Message
+		if _, rest, found := strings.Cut(cause.Message, `conflict with "`); found {
+			if name, _, found := strings.Cut(rest, `"`); found {
+				manager = name
+			}
+		}
+
+		conflicts = append(conflicts, fieldConflict{resource: resource, field: cause.Field, manager: manager})
+	}
+
+	return conflicts
+}
+
+// updateConditionResourcesConflicting maintains the `ResourcesConflicting` condition in the status of the given
+// ManagedResource. The condition is removed if server-side apply is not used. It is `True` if conflicting fields have
+// been taken over during the current reconciliation and `False` otherwise. Once conflicting fields have been taken over,
+// they are owned by the gardener-resource-manager and are not reported again, hence, the last conflicting fields are
+// kept in the message when the condition goes back to `False`.
+func (r *Reconciler) updateConditionResourcesConflicting(mr *resourcesv1alpha1.ManagedResource, serverSideApply bool, conflicts []fieldConflict) {
+	if !serverSideApply {
+		mr.Status.Conditions = v1beta1helper.RemoveConditions(mr.Status.Conditions, resourcesv1alpha1.ResourcesConflicting)
+		return
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesConflicting)
+	if len(conflicts) == 0 {
+		message := "All fields of the resources are owned by the gardener-resource-manager."
+		switch condition.Status {
+		case gardencorev1beta1.ConditionTrue:
+			if _, lastConflicts, ok := strings.Cut(condition.Message, "\n"); ok {
+				message += " The following fields were taken over from other field managers last:\n" + lastConflicts
+			}
+		case gardencorev1beta1.ConditionFalse:
+			// keep the last conflicting fields (if any)
+			message = condition.Message
+		}
+
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionNoConflicts, message)
+	} else {
+		lines := make([]string, 0, len(conflicts))
+		for _, conflict := range conflicts {
+			lines = append(lines, "- "+conflict.String())
+		}
+		slices.Sort(lines)
+
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, resourcesv1alpha1.ConditionFieldManagerConflict,
+			"The following fields were changed by other field managers and have been taken over:\n"+strings.Join(lines, "\n"))
+	}
+
+	mr.Status.Conditions = v1beta1helper.MergeConditions(mr.Status.Conditions, condition)
+}
@@ -0,0 +1,348 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+)
+
+var _ = Describe("server-side apply", func() {
+	Describe("#fieldManagerConflicts", func() {
+		It("should extract the conflicting fields and managers", func() {
+			err := apierrors.NewApplyConflict([]metav1.StatusCause{
+				{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kube-controller-manager" using apps/v1`, Field: ".spec.replicas"},
+				{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "vpa-updater" with subresource "status" using apps/v1`, Field: ".spec.template.spec.containers[name=\"foo\"].resources"},
+				{Type: metav1.CauseTypeFieldValueInvalid, Message: "foo", Field: ".spec.foo"},
+			}, "Apply failed with 2 conflicts")
+
+			Expect(fieldManagerConflicts("resource", fmt.Errorf("wrapped: %w", err))).To(ConsistOf(
+				fieldConflict{resource: "resource", field: ".spec.replicas", manager: "kube-controller-manager"},
+				fieldConflict{resource: "resource", field: `.spec.template.spec.containers[name="foo"].resources`, manager: "vpa-updater"},
+			))
+		})
+
+		It("should return nothing for other errors", func() {
+			Expect(fieldManagerConflicts("resource", fmt.Errorf("foo"))).To(BeEmpty())
+		})
+	})
+
+	Describe("#leavePreservedFields", func() {
+		var (
+			current, desired *unstructured.Unstructured
+
+			newDeployment = func(replicas int64, cpu string) *unstructured.Unstructured {
+				return &unstructured.Unstructured{Object: map[string]any{
+					"apiVersion": "apps/v1",
+					"kind":       "Deployment",
+					"spec": map[string]any{
+						"replicas": replicas,
+						"template": map[string]any{
+							"spec": map[string]any{
+								"containers": []any{
+									map[string]any{"name": "foo", "resources": map[string]any{"requests": map[string]any{"cpu": cpu, "memory": "1Gi"}}},
+									map[string]any{"name": "bar"},
+								},
+							},
+						},
+					},
+				}}
+			}
+
+			containers = func(obj *unstructured.Unstructured) []any {
+				containers, _, err := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+				Expect(err).NotTo(HaveOccurred())
+				return containers
+			}
+
+			managedBy = func(manager, fields string) metav1.ManagedFieldsEntry {
+				return metav1.ManagedFieldsEntry{Manager: manager, Operation: metav1.ManagedFieldsOperationUpdate, FieldsType: "FieldsV1", FieldsV1: &metav1.FieldsV1{Raw: []byte(fields)}}
+			}
+		)
+
+		BeforeEach(func() {
+			current = newDeployment(3, "500m")
+			desired = newDeployment(1, "100m")
+		})
+
+		It("should not preserve anything by default", func() {
+			expected := desired.DeepCopy()
+			Expect(leavePreservedFields(desired, current, false)).To(Succeed())
+			Expect(desired).To(Equal(expected))
+		})
+
+		It("should keep the current replicas if the deployment is scaled horizontally", func() {
+			Expect(leavePreservedFields(desired, current, true)).To(Succeed())
+			Expect(desired.Object["spec"]).To(HaveKeyWithValue("replicas", int64(3)))
+		})
+
+		It("should keep the current replicas if the preserve-replicas annotation is set", func() {
+			desired.SetAnnotations(map[string]string{resourcesv1alpha1.PreserveReplicas: "true"})
+			Expect(leavePreservedFields(desired, current, false)).To(Succeed())
+			Expect(desired.Object["spec"]).To(HaveKeyWithValue("replicas", int64(3)))
+		})
+
+		It("should keep the current replicas if they are not desired", func() {
+			unstructured.RemoveNestedField(desired.Object, "spec", "replicas")
+			Expect(leavePreservedFields(desired, current, false)).To(Succeed())
+			Expect(desired.Object["spec"]).To(HaveKeyWithValue("replicas", int64(3)))
+		})
+
+		It("should leave the replicas to other field managers", func() {
+			current.SetManagedFields([]metav1.ManagedFieldsEntry{
+				managedBy("gardener-resource-manager", `{"f:spec":{"f:template":{}}}`),
+				managedBy("horizontal-pod-autoscaler", `{"f:spec":{"f:replicas":{}}}`),
+			})
+
+			Expect(leavePreservedFields(desired, current, true)).To(Succeed())
+			Expect(desired.Object["spec"]).NotTo(HaveKey("replicas"))
+		})
+
+		It("should keep the current resources if the preserve-resources annotation is set", func() {
+			desired.SetAnnotations(map[string]string{resourcesv1alpha1.PreserveResources: "true"})
+			Expect(leavePreservedFields(desired, current, false)).To(Succeed())
+
+			Expect(containers(desired)).To(Equal([]any{
+				map[string]any{"name": "foo", "resources": map[string]any{"requests": map[string]any{"cpu": "500m", "memory": "1Gi"}}},
+				map[string]any{"name": "bar"},
+			}))
+			Expect(desired.Object["spec"]).To(HaveKeyWithValue("replicas", int64(1)))
+		})
+
+		It("should add the current resources if they are not desired", func() {
+			desired.SetAnnotations(map[string]string{resourcesv1alpha1.PreserveResources: "true"})
+			current.Object["spec"].(map[string]any)["template"].(map[string]any)["spec"].(map[string]any)["containers"] = []any{
+				map[string]any{"name": "foo"},
+				map[string]any{"name": "bar", "resources": map[string]any{"limits": map[string]any{"memory": "2Gi"}}},
+			}
+
+			Expect(leavePreservedFields(desired, current, false)).To(Succeed())
+			Expect(containers(desired)).To(Equal([]any{
+				map[string]any{"name": "foo", "resources": map[string]any{"requests": map[string]any{"cpu": "100m", "memory": "1Gi"}}},
+				map[string]any{"name": "bar", "resources": map[string]any{"limits": map[string]any{"memory": "2Gi"}}},
+			}))
+		})
+
+		It("should leave the resources to other field managers", func() {
+			desired.SetAnnotations(map[string]string{resourcesv1alpha1.PreserveResources: "true"})
+			current.SetManagedFields([]metav1.ManagedFieldsEntry{
+				managedBy("vpa", `{"f:spec":{"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"foo\"}":{"f:resources":{"f:requests":{"f:cpu":{}}}}}}}}}`),
+			})
+
+			Expect(leavePreservedFields(desired, current, false)).To(Succeed())
+			Expect(containers(desired)).To(Equal([]any{
+				map[string]any{"name": "foo", "resources": map[string]any{"requests": map[string]any{"memory": "1Gi"}}},
+				map[string]any{"name": "bar"},
+			}))
+		})
+
+		It("should fail if the managed fields cannot be decoded", func() {
+			current.SetManagedFields([]metav1.ManagedFieldsEntry{managedBy("foo", `{"f:spec":{"k:foo":{}}}`)})
+			Expect(leavePreservedFields(desired, current, true)).To(MatchError(ContainSubstring(`failed decoding fields managed by "foo"`)))
+		})
+
+		It("should not touch other objects", func() {
+			current.SetKind("ConfigMap")
+			desired.SetKind("ConfigMap")
+			desired.SetAnnotations(map[string]string{resourcesv1alpha1.PreserveReplicas: "true"})
+			expected := desired.DeepCopy()
+
+			Expect(leavePreservedFields(desired, current, true)).To(Succeed())
+			Expect(desired).To(Equal(expected))
+		})
+	})
+
+	Describe("#Reconcile", func() {
+		var (
+			ctx = context.Background()
+
+			sourceClient client.Client
+			targetClient client.Client
+			reconciler   *Reconciler
+			applyCalls   int
+			conflictWith string
+
+			managedResource *resourcesv1alpha1.ManagedResource
+		)
+
+		BeforeEach(func() {
+			applyCalls = 0
+			conflictWith = "kubectl"
+
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "mr-secret", Namespace: "garden"},
+				Data: map[string][]byte{"objects.yaml": []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: foo
+  namespace: default
+data:
+  foo: bar
+  bar: baz
+`)},
+			}
+
+			managedResource = &resourcesv1alpha1.ManagedResource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "mr",
+					Namespace:  "garden",
+					Finalizers: []string{"resources.gardener.cloud/gardener-resource-manager"},
+				},
+				Spec: resourcesv1alpha1.ManagedResourceSpec{
+					SecretRefs: []corev1.LocalObjectReference{{Name: secret.Name}},
+					ApplyMode:  ptr.To(resourcesv1alpha1.ApplyModeServerSideApply),
+				},
+			}
+
+			sourceClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.SeedScheme).
+				WithObjects(managedResource, secret).
+				WithStatusSubresource(&resourcesv1alpha1.ManagedResource{}).
+				Build()
+			targetClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.SeedScheme).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						if patch.Type() != client.Apply.Type() {
+							return c.Patch(ctx, obj, patch, opts...)
+						}
+
+						applyCalls++
+						patchOptions := (&client.PatchOptions{}).ApplyOptions(opts)
+						Expect(patchOptions.FieldManager).To(Equal("gardener-resource-manager"))
+
+						configMap := obj.(*unstructured.Unstructured)
+						if conflictWith != "" && !ptr.Deref(patchOptions.Force, false) {
+							return apierrors.NewApplyConflict([]metav1.StatusCause{
+								{Type: metav1.CauseTypeFieldManagerConflict, Message: fmt.Sprintf(`conflict with %q using v1`, conflictWith), Field: ".data.foo"},
+							}, "Apply failed with 1 conflict")
+						}
+
+						configMap.SetResourceVersion("")
+						if err := c.Create(ctx, configMap); !apierrors.IsAlreadyExists(err) {
+							return err
+						}
+						return c.Update(ctx, configMap)
+					},
+				}).
+				Build()
+
+			reconciler = &Reconciler{
+				SourceClient:     sourceClient,
+				TargetClient:     targetClient,
+				TargetScheme:     kubernetes.SeedScheme,
+				TargetRESTMapper: targetClient.RESTMapper(),
+				Config: resourcemanagerconfigv1alpha1.ManagedResourceControllerConfig{
+					SyncPeriod:          &metav1.Duration{Duration: time.Minute},
+					ManagedByLabelValue: ptr.To("gardener"),
+				},
+				Clock:       testclock.NewFakeClock(time.Now()),
+				ClassFilter: resourcemanagerpredicate.NewClassFilter(""),
+			}
+		})
+
+		It("should take over conflicting fields from the other field managers and report them", func() {
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(managedResource)})).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(applyCalls).To(Equal(2))
+
+			configMap := &corev1.ConfigMap{}
+			Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "foo"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{"foo": "bar", "bar": "baz"}))
+			Expect(configMap.Labels).To(HaveKeyWithValue("resources.gardener.cloud/managed-by", "gardener"))
+			Expect(configMap.Annotations).To(HaveKeyWithValue("resources.gardener.cloud/origin", "garden/mr"))
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			condition := v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesConflicting)
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal("FieldManagerConflict"))
+			Expect(condition.Message).To(Equal("The following fields were changed by other field managers and have been taken over:\n" +
+				`- v1/ConfigMap/default/foo: .data.foo (manager "kubectl")`))
+			Expect(v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesApplied).Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		})
+
+		It("should report that there are no conflicts anymore but keep the last conflicts", func() {
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(managedResource)})).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			condition := v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesConflicting)
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+
+			By("Reconcile without conflicts")
+			conflictWith = ""
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(managedResource)})).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(applyCalls).To(Equal(3))
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			condition = v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesConflicting)
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("NoConflicts"))
+			Expect(condition.Message).To(Equal("All fields of the resources are owned by the gardener-resource-manager. The following fields were taken over from other field managers last:\n" +
+				`- v1/ConfigMap/default/foo: .data.foo (manager "kubectl")`))
+
+			By("Reconcile again without conflicts")
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(managedResource)})).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesConflicting)).To(Equal(condition))
+
+			By("Reconcile with conflicts of another manager")
+			conflictWith = "helm"
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(managedResource)})).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			condition = v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesConflicting)
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Message).To(Equal("The following fields were changed by other field managers and have been taken over:\n" +
+				`- v1/ConfigMap/default/foo: .data.foo (manager "helm")`))
+		})
+
+		It("should report that there are no conflicts", func() {
+			conflictWith = ""
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(managedResource)})).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(applyCalls).To(Equal(1))
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			condition := v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesConflicting)
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("NoConflicts"))
+			Expect(condition.Message).To(Equal("All fields of the resources are owned by the gardener-resource-manager."))
+		})
+
+		It("should remove the condition when switching back to the update mode", func() {
+			managedResource.Status.Conditions = []gardencorev1beta1.Condition{{Type: resourcesv1alpha1.ResourcesConflicting, Status: gardencorev1beta1.ConditionTrue}}
+			Expect(sourceClient.Status().Update(ctx, managedResource)).To(Succeed())
+			managedResource.Spec.ApplyMode = ptr.To(resourcesv1alpha1.ApplyModeUpdate)
+			Expect(sourceClient.Update(ctx, managedResource)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(managedResource)})).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(applyCalls).To(BeZero())
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesConflicting)).To(BeNil())
+		})
+	})
+})
@@ -12,6 +12,7 @@ import (
 
 	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/node/agentreconciliationdelay"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/node/agentrollout"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/node/criticalcomponents"
 )
 
@@ -33,5 +34,13 @@ func AddToManager(mgr manager.Manager, targetCluster cluster.Cluster, cfg resour
 		}
 	}
 
+	if cfg.Controllers.NodeAgentRollout.Enabled {
+		if err := (&agentrollout.Reconciler{
+			Config: cfg.Controllers.NodeAgentRollout,
+		}).AddToManager(mgr, targetCluster); err != nil {
+			return fmt.Errorf("failed adding node-agent-rollout controller: %w", err)
+		}
+	}
+
 	return nil
 }
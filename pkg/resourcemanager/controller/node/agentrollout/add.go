@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package agentrollout
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// ControllerName is the name of the controller.
+const ControllerName = "node-agent-rollout"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager, targetCluster cluster.Cluster) error {
+	if r.TargetClient == nil {
+		r.TargetClient = targetCluster.GetClient()
+	}
+
+	secret := &metav1.PartialObjectMetadata{}
+	secret.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 1,
+			ReconciliationTimeout:   controllerutils.DefaultReconciliationTimeout,
+		}).
+		WatchesRawSource(
+			source.Kind[client.Object](targetCluster.GetCache(),
+				secret,
+				&handler.EnqueueRequestForObject{},
+				r.SecretPredicate()),
+		).
+		WatchesRawSource(
+			source.Kind[client.Object](targetCluster.GetCache(),
+				&corev1.Node{},
+				handler.EnqueueRequestsFromMapFunc(MapNodeToSecret),
+				r.NodePredicate()),
+		).
+		Complete(r)
+}
+
+// SecretPredicate returns a predicate which returns true for operating system config secrets whose checksum or
+// rollout annotations changed.
+func (r *Reconciler) SecretPredicate() predicate.Predicate {
+	return predicate.And(
+		predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return obj.GetNamespace() == metav1.NamespaceSystem && obj.GetLabels()[v1beta1constants.GardenRole] == v1beta1constants.GardenRoleOperatingSystemConfig
+		}),
+		predicate.Funcs{
+			CreateFunc: func(_ event.CreateEvent) bool { return true },
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				return annotationsChanged(e.ObjectOld, e.ObjectNew,
+					nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig,
+					v1beta1constants.AnnotationNodeAgentRolloutMaxUnavailable,
+					v1beta1constants.AnnotationNodeAgentRolloutCoordinatedExternally,
+				)
+			},
+			DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+			GenericFunc: func(_ event.GenericEvent) bool { return false },
+		},
+	)
+}
+
+// NodePredicate returns a predicate which returns true for created or deleted nodes, and for nodes whose applied or
+// permitted checksum annotations or Ready condition changed.
+func (r *Reconciler) NodePredicate() predicate.Predicate {
+	return predicate.Or(
+		predicateutils.ForEventTypes(predicateutils.Create, predicateutils.Delete),
+		predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				oldNode, ok := e.ObjectOld.(*corev1.Node)
+				if !ok {
+					return false
+				}
+				newNode, ok := e.ObjectNew.(*corev1.Node)
+				if !ok {
+					return false
+				}
+
+				return annotationsChanged(oldNode, newNode,
+					nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig,
+					v1beta1constants.AnnotationNodeAgentRolloutPermittedChecksum,
+				) || nodeIsReady(*oldNode) != nodeIsReady(*newNode)
+			},
+		},
+	)
+}
+
+// MapNodeToSecret maps a node to the operating system config secret of its worker pool.
+func MapNodeToSecret(_ context.Context, obj client.Object) []reconcile.Request {
+	secretName, ok := obj.GetLabels()[v1beta1constants.LabelWorkerPoolGardenerNodeAgentSecretName]
+	if !ok {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: secretName, Namespace: metav1.NamespaceSystem}}}
+}
+
+func annotationsChanged(oldObj, newObj client.Object, keys ...string) bool {
+	for _, key := range keys {
+		if oldObj.GetAnnotations()[key] != newObj.GetAnnotations()[key] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package agentrollout
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// Reconciler manages the node-agent.gardener.cloud/rollout-permitted-checksum annotation on nodes. It permits only
+// a limited number of gardener-node-agents per worker pool to apply a new operating system config at the same time.
+type Reconciler struct {
+	TargetClient client.Client
+	Config       resourcemanagerconfigv1alpha1.NodeAgentRolloutControllerConfig
+}
+
+// Reconcile permits further nodes of the worker pool of the operating system config secret to apply its current
+// checksum as long as not more than the configured maximum number of nodes are applying it or are unhealthy after
+// having applied it.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	secret := &metav1.PartialObjectMetadata{}
+	secret.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+	if err := r.TargetClient.Get(ctx, request.NamespacedName, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	maxUnavailableValue, ok := secret.Annotations[v1beta1constants.AnnotationNodeAgentRolloutMaxUnavailable]
+	if !ok {
+		log.V(1).Info("Staged rollout is not enabled for this operating system config, nothing to be done")
+		return reconcile.Result{}, nil
+	}
+
+	if secret.Annotations[v1beta1constants.AnnotationNodeAgentRolloutCoordinatedExternally] == "true" {
+		log.V(1).Info("Staged rollout of this operating system config is coordinated externally, nothing to be done")
+		return reconcile.Result{}, nil
+	}
+
+	checksum := secret.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig]
+	if checksum == "" {
+		log.Info("Operating system config secret has no checksum annotation, nothing to be done")
+		return reconcile.Result{}, nil
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := r.TargetClient.List(ctx, nodeList, client.MatchingLabels{v1beta1constants.LabelWorkerPoolGardenerNodeAgentSecretName: secret.Name}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	maxUnavailableIntOrString := intstr.Parse(maxUnavailableValue)
+	maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailableIntOrString, len(nodeList.Items), false)
+	if err != nil {
+		log.Error(err, "Failed parsing max unavailable annotation, falling back to 1", "annotationValue", maxUnavailableValue)
+	}
+	// At least one node must be permitted to apply the operating system config, otherwise it would never be rolled out.
+	maxUnavailable = max(maxUnavailable, 1)
+
+	kubernetesutils.ByName().Sort(nodeList)
+
+	var (
+		unavailable int
+		pending     []corev1.Node
+	)
+
+	for _, node := range nodeList.Items {
+		appliedChecksum, ok := node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]
+		if !ok {
+			// New nodes apply the operating system config without waiting for the permission.
+			continue
+		}
+
+		permitted := node.Annotations[v1beta1constants.AnnotationNodeAgentRolloutPermittedChecksum] == checksum
+
+		switch {
+		case appliedChecksum == checksum:
+			if permitted && !nodeIsReady(node) {
+				unavailable++
+			}
+		case permitted:
+			// The node applies the operating system config right now, or it failed to apply it (e.g., it was rolled
+			// back). In the latter case, the rollout does not continue until the issue is resolved.
+			unavailable++
+		default:
+			pending = append(pending, node)
+		}
+	}
+
+	if len(pending) == 0 {
+		log.V(1).Info("No nodes are waiting for the permission to apply the operating system config")
+		return reconcile.Result{}, nil
+	}
+
+	budget := maxUnavailable - unavailable
+	if budget <= 0 {
+		log.Info("Waiting for nodes to apply the operating system config and to become ready before permitting further nodes", "maxUnavailable", maxUnavailable, "unavailable", unavailable, "pending", len(pending))
+		return reconcile.Result{}, nil
+	}
+
+	for _, node := range pending[:min(budget, len(pending))] {
+		log.Info("Permitting node to apply operating system config", "nodeName", node.Name, "checksum", checksum)
+
+		// In environments with high churn rates, the patch call might write to a stale object, resulting in an
+		// unexpected final state. To mitigate this, we use optimistic locking.
+		patch := client.MergeFromWithOptions(node.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, v1beta1constants.AnnotationNodeAgentRolloutPermittedChecksum, checksum)
+		if err := r.TargetClient.Patch(ctx, &node, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed permitting node %s to apply operating system config: %w", node.Name, err)
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func nodeIsReady(node corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
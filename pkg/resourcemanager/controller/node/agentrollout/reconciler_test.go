@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package agentrollout_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/node/agentrollout"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		secret  *corev1.Secret
+		request reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		reconciler = &Reconciler{TargetClient: fakeClient}
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gardener-node-agent-worker-1",
+				Namespace: "kube-system",
+				Annotations: map[string]string{
+					nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig: "new",
+					v1beta1constants.AnnotationNodeAgentRolloutMaxUnavailable:                    "2",
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(secret)}
+	})
+
+	JustBeforeEach(func() {
+		Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+	})
+
+	createNode := func(name, appliedChecksum, permittedChecksum string, ready bool) {
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Labels:      map[string]string{v1beta1constants.LabelWorkerPoolGardenerNodeAgentSecretName: secret.Name},
+				Annotations: map[string]string{},
+			},
+			Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionFalse}}},
+		}
+		if appliedChecksum != "" {
+			node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] = appliedChecksum
+		}
+		if permittedChecksum != "" {
+			node.Annotations[v1beta1constants.AnnotationNodeAgentRolloutPermittedChecksum] = permittedChecksum
+		}
+		if ready {
+			node.Status.Conditions[0].Status = corev1.ConditionTrue
+		}
+		ExpectWithOffset(1, fakeClient.Create(ctx, node)).To(Succeed())
+	}
+
+	permittedNodes := func() []string {
+		nodeList := &corev1.NodeList{}
+		ExpectWithOffset(1, fakeClient.List(ctx, nodeList)).To(Succeed())
+
+		var names []string
+		for _, node := range nodeList.Items {
+			if node.Annotations[v1beta1constants.AnnotationNodeAgentRolloutPermittedChecksum] == "new" {
+				names = append(names, node.Name)
+			}
+		}
+		return names
+	}
+
+	It("should do nothing if the secret does not exist", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: "foo", Namespace: "kube-system"}})).To(Equal(reconcile.Result{}))
+	})
+
+	Context("staged rollout is disabled", func() {
+		BeforeEach(func() {
+			delete(secret.Annotations, v1beta1constants.AnnotationNodeAgentRolloutMaxUnavailable)
+		})
+
+		It("should not permit any node", func() {
+			createNode("node-1", "old", "", true)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(permittedNodes()).To(BeEmpty())
+		})
+	})
+
+	Context("staged rollout is coordinated externally", func() {
+		BeforeEach(func() {
+			secret.Annotations[v1beta1constants.AnnotationNodeAgentRolloutCoordinatedExternally] = "true"
+		})
+
+		It("should not permit any node", func() {
+			createNode("node-1", "old", "", true)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(permittedNodes()).To(BeEmpty())
+		})
+	})
+
+	It("should permit max unavailable nodes in alphabetical order", func() {
+		createNode("node-3", "old", "", true)
+		createNode("node-1", "old", "", true)
+		createNode("node-2", "old", "", true)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(permittedNodes()).To(ConsistOf("node-1", "node-2"))
+	})
+
+	It("should not count nodes which applied the operating system config and are ready", func() {
+		createNode("node-1", "new", "new", true)
+		createNode("node-2", "new", "", false)
+		createNode("node-3", "old", "", true)
+		createNode("node-4", "old", "", true)
+		createNode("node-5", "old", "", true)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(permittedNodes()).To(ConsistOf("node-1", "node-3", "node-4"))
+	})
+
+	It("should wait for permitted nodes which did not apply the operating system config or are not ready", func() {
+		createNode("node-1", "old", "new", true)
+		createNode("node-2", "new", "new", false)
+		createNode("node-3", "old", "", true)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(permittedNodes()).To(ConsistOf("node-1", "node-2"))
+	})
+
+	It("should ignore new nodes", func() {
+		createNode("node-1", "", "", false)
+		createNode("node-2", "", "", false)
+		createNode("node-3", "old", "", true)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(permittedNodes()).To(ConsistOf("node-3"))
+	})
+
+	Context("max unavailable is a percentage", func() {
+		BeforeEach(func() {
+			secret.Annotations[v1beta1constants.AnnotationNodeAgentRolloutMaxUnavailable] = "50%"
+		})
+
+		It("should scale the value with the number of nodes", func() {
+			for _, name := range []string{"node-1", "node-2", "node-3", "node-4", "node-5"} {
+				createNode(name, "old", "", true)
+			}
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(permittedNodes()).To(ConsistOf("node-1", "node-2"))
+		})
+	})
+
+	Context("max unavailable is zero", func() {
+		BeforeEach(func() {
+			secret.Annotations[v1beta1constants.AnnotationNodeAgentRolloutMaxUnavailable] = "0"
+		})
+
+		It("should permit one node at a time", func() {
+			createNode("node-1", "old", "", true)
+			createNode("node-2", "old", "", true)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(permittedNodes()).To(ConsistOf("node-1"))
+		})
+	})
+})
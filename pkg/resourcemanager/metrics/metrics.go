@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Namespace is the metric namespace for the gardener-resource-manager.
+const Namespace = "gardener_resource_manager"
+
+var (
+	// Factory is used for registering metrics in the controller-runtime metrics registry.
+	factory = promauto.With(runtimemetrics.Registry)
+	// ManagedResourceDriftedObjects defines the gauge managedresource_drifted_objects.
+	ManagedResourceDriftedObjects = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "managedresource_drifted_objects",
+			Help:      "Number of objects of a ManagedResource whose actual state differs from the desired state.",
+		},
+		[]string{
+			"namespace",
+			"name",
+		},
+	)
+)
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package predicate
+
+import (
+	"strconv"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// DryRunModeChanged returns a predicate that detects if the resources.gardener.cloud/dry-run=true annotation was
+// added or removed during an update.
+func DryRunModeChanged() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return isDryRun(e.ObjectOld) != isDryRun(e.ObjectNew)
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(_ event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+func isDryRun(obj client.Object) bool {
+	value, ok := obj.GetAnnotations()[resourcesv1alpha1.DryRun]
+	if !ok {
+		return false
+	}
+	truthy, _ := strconv.ParseBool(value)
+	return truthy
+}
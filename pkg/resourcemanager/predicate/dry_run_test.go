@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package predicate_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	. "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+)
+
+var _ = Describe("dry-run", func() {
+	var (
+		managedResource *resourcesv1alpha1.ManagedResource
+		predicate       predicate.Predicate
+	)
+
+	BeforeEach(func() {
+		managedResource = &resourcesv1alpha1.ManagedResource{}
+		predicate = DryRunModeChanged()
+	})
+
+	Describe("#DryRunModeChanged", func() {
+		Context("#Create", func() {
+			It("should return false", func() {
+				Expect(predicate.Create(event.CreateEvent{Object: managedResource})).To(BeFalse())
+			})
+		})
+
+		Context("#Update", func() {
+			DescribeTable("#Update",
+				func(oldValue, newValue string, matcher gomegatypes.GomegaMatcher) {
+					old := managedResource.DeepCopy()
+
+					if oldValue != "" {
+						metav1.SetMetaDataAnnotation(&old.ObjectMeta, "resources.gardener.cloud/dry-run", oldValue)
+					}
+					if newValue != "" {
+						metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "resources.gardener.cloud/dry-run", newValue)
+					}
+
+					Expect(predicate.Update(event.UpdateEvent{
+						ObjectNew: managedResource,
+						ObjectOld: old,
+					})).To(matcher)
+				},
+
+				Entry("annotation absent on both", "", "", BeFalse()),
+				Entry("annotation added", "", "true", BeTrue()),
+				Entry("annotation removed", "true", "", BeTrue()),
+				Entry("annotation set to false", "true", "false", BeTrue()),
+				Entry("annotation unchanged", "true", "True", BeFalse()),
+				Entry("annotation with non-boolean value added", "", "foo", BeFalse()),
+			)
+		})
+
+		Describe("#Delete", func() {
+			It("should return false", func() {
+				Expect(predicate.Delete(event.DeleteEvent{Object: managedResource})).To(BeFalse())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(predicate.Generic(event.GenericEvent{Object: managedResource})).To(BeFalse())
+			})
+		})
+	})
+})
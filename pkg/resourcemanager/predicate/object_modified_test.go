@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package predicate_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	. "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+)
+
+var _ = Describe("#ObjectModifiedOrDeleted", func() {
+	var (
+		p          predicate.Predicate
+		deployment *appsv1.Deployment
+		configMap  *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		p = ObjectModifiedOrDeleted()
+		deployment = &appsv1.Deployment{}
+		deployment.Generation = 1
+		deployment.ResourceVersion = "1"
+		configMap = &corev1.ConfigMap{}
+		configMap.ResourceVersion = "1"
+	})
+
+	It("should return false for create events", func() {
+		Expect(p.Create(event.CreateEvent{Object: deployment})).To(BeFalse())
+	})
+
+	Describe("#Update", func() {
+		It("should return true if the generation changed", func() {
+			newDeployment := deployment.DeepCopy()
+			newDeployment.Generation = 2
+			Expect(p.Update(event.UpdateEvent{ObjectOld: deployment, ObjectNew: newDeployment})).To(BeTrue())
+		})
+
+		It("should return true if the labels changed", func() {
+			newDeployment := deployment.DeepCopy()
+			newDeployment.Labels = map[string]string{"foo": "bar"}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: deployment, ObjectNew: newDeployment})).To(BeTrue())
+		})
+
+		It("should return true if the annotations changed", func() {
+			newDeployment := deployment.DeepCopy()
+			newDeployment.Annotations = map[string]string{"foo": "bar"}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: deployment, ObjectNew: newDeployment})).To(BeTrue())
+		})
+
+		It("should return false if only the status changed", func() {
+			newDeployment := deployment.DeepCopy()
+			newDeployment.ResourceVersion = "2"
+			newDeployment.Status.Replicas = 1
+			Expect(p.Update(event.UpdateEvent{ObjectOld: deployment, ObjectNew: newDeployment})).To(BeFalse())
+		})
+
+		It("should return true if the resource version of an object without generation changed", func() {
+			newConfigMap := configMap.DeepCopy()
+			newConfigMap.ResourceVersion = "2"
+			Expect(p.Update(event.UpdateEvent{ObjectOld: configMap, ObjectNew: newConfigMap})).To(BeTrue())
+		})
+
+		It("should return false if the resource version of an object without generation is unchanged", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: configMap, ObjectNew: configMap.DeepCopy()})).To(BeFalse())
+		})
+	})
+
+	It("should return true for delete events", func() {
+		Expect(p.Delete(event.DeleteEvent{Object: deployment})).To(BeTrue())
+	})
+
+	It("should return false for generic events", func() {
+		Expect(p.Generic(event.GenericEvent{Object: deployment})).To(BeFalse())
+	})
+})
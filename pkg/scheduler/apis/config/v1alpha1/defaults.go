@@ -36,13 +36,16 @@ func SetDefaults_SchedulerControllerConfiguration(obj *SchedulerControllerConfig
 	if obj.Shoot == nil {
 		obj.Shoot = &ShootSchedulerConfiguration{}
 	}
+}
 
-	if len(obj.Shoot.Strategy) == 0 {
-		obj.Shoot.Strategy = Default
+// SetDefaults_ShootSchedulerConfiguration sets defaults for the configuration of the shoot scheduler.
+func SetDefaults_ShootSchedulerConfiguration(obj *ShootSchedulerConfiguration) {
+	if len(obj.Strategy) == 0 {
+		obj.Strategy = Default
 	}
 
-	if obj.Shoot.ConcurrentSyncs == 0 {
-		obj.Shoot.ConcurrentSyncs = 5
+	if obj.ConcurrentSyncs == 0 {
+		obj.ConcurrentSyncs = 5
 	}
 }
 
@@ -91,4 +94,12 @@ func SetDefaults_ServerConfiguration(obj *ServerConfiguration) {
 	if obj.Metrics.Port == 0 {
 		obj.Metrics.Port = 19251
 	}
+
+	if obj.Preview == nil {
+		obj.Preview = &Server{}
+	}
+
+	if obj.Preview.Port == 0 {
+		obj.Preview.Port = 10253
+	}
 }
@@ -99,6 +99,9 @@ var _ = Describe("Defaults", func() {
 				Metrics: &schedulerconfigv1alpha1.Server{
 					Port: 1235,
 				},
+				Preview: &schedulerconfigv1alpha1.Server{
+					Port: 1236,
+				},
 			}
 			obj.Server = *serverConfiguration
 
@@ -118,6 +121,9 @@ var _ = Describe("Defaults", func() {
 				Metrics: &schedulerconfigv1alpha1.Server{
 					Port: 19251,
 				},
+				Preview: &schedulerconfigv1alpha1.Server{
+					Port: 10253,
+				},
 			}
 
 			schedulerconfigv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
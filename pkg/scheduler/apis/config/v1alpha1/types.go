@@ -98,8 +98,174 @@ type ShootSchedulerConfiguration struct {
 	ConcurrentSyncs int `json:"concurrentSyncs"`
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy `json:"candidateDeterminationStrategy"`
+	// Plugins configures the plugins of the scheduling framework.
+	// +optional
+	Plugins *SchedulerPlugins `json:"plugins,omitempty"`
+	// ResourceUtilizationThreshold is the maximum CPU and memory utilization in percent a seed may reach when a shoot
+	// is scheduled to it. The utilization is computed from the `.status.usage` and `.status.allocatable` resources of
+	// the seed plus the average usage of the shoots already running on the seed. Seeds which would exceed the threshold
+	// are not considered for scheduling. If not set, seeds are not filtered by their utilization.
+	// +optional
+	ResourceUtilizationThreshold *int32 `json:"resourceUtilizationThreshold,omitempty"`
+	// EnablePreviewHandler enables the `/schedule/preview` handler on the preview server (see `.server.preview`). It
+	// accepts Shoot manifests via POST requests and responds with the seed the shoot would be scheduled to, the scores
+	// of the seed candidates, and the reasons why the other seeds were rejected. Requests must be authenticated and
+	// authorized for the `/schedule/preview` non-resource URL, and the requesting user must be allowed to create shoots
+	// in the namespace of the shoot. Defaults to false.
+	// +optional
+	EnablePreviewHandler *bool `json:"enablePreviewHandler,omitempty"`
+}
+
+// SchedulerPlugins configures the plugins of the scheduling framework.
+type SchedulerPlugins struct {
+	// Filter configures the filter plugins which remove the seeds that are not suitable for a shoot. All built-in filter
+	// plugins are enabled by default.
+	// +optional
+	Filter *FilterPlugins `json:"filter,omitempty"`
+	// Score is the list of score plugins used to rank the seed candidates which passed all filters. The scores of all
+	// plugins are normalized to the range [0, 100] and summed up according to their weights. The seed with the highest
+	// total score is chosen.
+	// If no score plugins are configured, the seed with the least number of shoots is chosen.
+	// +optional
+	Score []ScorePlugin `json:"score,omitempty"`
+	// ScoreNormalization is the strategy for normalizing the raw scores of the score plugins to the range [0, 100].
+	// Defaults to 'MinMax'.
+	// +optional
+	ScoreNormalization *ScoreNormalization `json:"scoreNormalization,omitempty"`
+}
+
+// FilterPlugins configures the filter plugins.
+type FilterPlugins struct {
+	// Disabled is the list of filter plugins which are not executed. Only the filter plugins contained in
+	// OptionalFilterPluginNames can be disabled.
+	// +optional
+	Disabled []FilterPluginName `json:"disabled,omitempty"`
+}
+
+// FilterPluginName is the name of a filter plugin.
+type FilterPluginName string
+
+const (
+	// FilterPluginExcludedSeeds removes seeds which are explicitly excluded, e.g., the current seed of a shoot whose
+	// control plane shall be migrated.
+	FilterPluginExcludedSeeds FilterPluginName = "ExcludedSeeds"
+	// FilterPluginUsableSeeds removes seeds which are deleting, invisible or not ready.
+	FilterPluginUsableSeeds FilterPluginName = "UsableSeeds"
+	// FilterPluginCloudProfileSeedSelector removes seeds which do not match the seed selector of the CloudProfile.
+	FilterPluginCloudProfileSeedSelector FilterPluginName = "CloudProfileSeedSelector"
+	// FilterPluginShootSeedSelector removes seeds which do not match the seed selector of the Shoot.
+	FilterPluginShootSeedSelector FilterPluginName = "ShootSeedSelector"
+	// FilterPluginProviders removes seeds which do not support the provider type of the Shoot.
+	FilterPluginProviders FilterPluginName = "Providers"
+	// FilterPluginZonalShootControlPlanes removes seeds with less than three zones for shoots with a control plane with
+	// failure tolerance type 'zone'.
+	FilterPluginZonalShootControlPlanes FilterPluginName = "ZonalShootControlPlanes"
+	// FilterPluginAccessRestrictions removes seeds which do not support the access restrictions of the Shoot.
+	FilterPluginAccessRestrictions FilterPluginName = "AccessRestrictions"
+	// FilterPluginDomain removes seeds which do not support the domain of the Shoot.
+	FilterPluginDomain FilterPluginName = "Domain"
+	// FilterPluginShootReconciliationsEnabled removes seeds with disabled shoot reconciliations.
+	FilterPluginShootReconciliationsEnabled FilterPluginName = "ShootReconciliationsEnabled"
+	// FilterPluginCandidates removes seeds whose taints are not tolerated by the Shoot or whose capacity for shoots
+	// would be exceeded.
+	FilterPluginCandidates FilterPluginName = "Candidates"
+	// FilterPluginResourceUtilization removes seeds whose resource utilization would exceed the configured threshold,
+	// see ShootSchedulerConfiguration.ResourceUtilizationThreshold.
+	FilterPluginResourceUtilization FilterPluginName = "ResourceUtilization"
+	// FilterPluginStrategy removes seeds which are not candidates of the configured candidate determination strategy.
+	FilterPluginStrategy FilterPluginName = "Strategy"
+)
+
+// FilterPluginNames contains all filter plugin names in the order the filter plugins are executed.
+var FilterPluginNames = []FilterPluginName{
+	FilterPluginExcludedSeeds,
+	FilterPluginUsableSeeds,
+	FilterPluginCloudProfileSeedSelector,
+	FilterPluginShootSeedSelector,
+	FilterPluginProviders,
+	FilterPluginZonalShootControlPlanes,
+	FilterPluginAccessRestrictions,
+	FilterPluginDomain,
+	FilterPluginShootReconciliationsEnabled,
+	FilterPluginCandidates,
+	FilterPluginResourceUtilization,
+	FilterPluginStrategy,
 }
 
+// OptionalFilterPluginNames contains the names of the filter plugins which can be disabled. The other filter plugins
+// are required for placing shoots onto seeds which are able to host them.
+var OptionalFilterPluginNames = []FilterPluginName{FilterPluginResourceUtilization, FilterPluginStrategy}
+
+// ScoreNormalization is a strategy for normalizing raw scores.
+type ScoreNormalization string
+
+const (
+	// ScoreNormalizationMinMax maps the raw scores linearly to the range [0, 100], i.e., the most preferred seed gets
+	// 100, the least preferred seed gets 0, and the other seeds get scores proportional to their raw scores.
+	ScoreNormalizationMinMax ScoreNormalization = "MinMax"
+	// ScoreNormalizationRank maps the ranks of the raw scores evenly to the range [0, 100], i.e., the differences
+	// between the raw scores do not matter, only their order. Seeds with equal raw scores get the same score.
+	ScoreNormalizationRank ScoreNormalization = "Rank"
+)
+
+// ScoreNormalizations contains all supported score normalization strategies.
+var ScoreNormalizations = []ScoreNormalization{ScoreNormalizationMinMax, ScoreNormalizationRank}
+
+// ScorePlugin configures a score plugin.
+type ScorePlugin struct {
+	// Name is the name of the score plugin.
+	Name ScorePluginName `json:"name"`
+	// Weight is the weight of the normalized scores of this plugin. Defaults to 1.
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+	// LabelKey is the key of the seed label whose numeric value is used by the 'SeedLabel' plugin, e.g., to express
+	// costs. Seeds without the label, with a non-numeric value, or with a value whose absolute value exceeds 10^13 get
+	// the worst score.
+	// +optional
+	LabelKey *string `json:"labelKey,omitempty"`
+	// LabelValuePreference specifies whether seeds with lower or higher values of the label are preferred by the
+	// 'SeedLabel' plugin. Defaults to 'Lower'.
+	// +optional
+	LabelValuePreference *LabelValuePreference `json:"labelValuePreference,omitempty"`
+}
+
+// LabelValuePreference specifies which numeric label values are preferred.
+type LabelValuePreference string
+
+const (
+	// LabelValuePreferenceLower prefers seeds with lower label values, e.g., costs.
+	LabelValuePreferenceLower LabelValuePreference = "Lower"
+	// LabelValuePreferenceHigher prefers seeds with higher label values, e.g., available bandwidth.
+	LabelValuePreferenceHigher LabelValuePreference = "Higher"
+)
+
+// LabelValuePreferences contains all supported label value preferences.
+var LabelValuePreferences = []LabelValuePreference{LabelValuePreferenceLower, LabelValuePreferenceHigher}
+
+// ScorePluginName is the name of a score plugin.
+type ScorePluginName string
+
+const (
+	// ScorePluginLeastShoots prefers seeds with fewer shoots.
+	ScorePluginLeastShoots ScorePluginName = "LeastShoots"
+	// ScorePluginAllocatableShoots prefers seeds with a higher ratio of available capacity for shoots (see
+	// `.status.allocatable.shoots` of seeds). Seeds without allocatable shoots are considered to have full capacity.
+	ScorePluginAllocatableShoots ScorePluginName = "AllocatableShoots"
+	// ScorePluginRegionDistance prefers seeds whose region is closer to the shoot's region (based on the region config
+	// ConfigMaps and falling back to the Levenshtein distance). If the region config contains the shoot's region, seeds
+	// in regions not listed there are considered unreachable and get the worst score, i.e., they are not filtered out.
+	ScorePluginRegionDistance ScorePluginName = "RegionDistance"
+	// ScorePluginSeedLabel prefers seeds with lower (or higher, see ScorePlugin.LabelValuePreference) numeric values of a
+	// configurable label, e.g., costs.
+	ScorePluginSeedLabel ScorePluginName = "SeedLabel"
+	// ScorePluginResourceUtilization prefers seeds with a lower CPU and memory utilization (see `.status.usage` and
+	// `.status.allocatable` of seeds). Seeds which do not report their usage get the worst score.
+	ScorePluginResourceUtilization ScorePluginName = "ResourceUtilization"
+)
+
+// ScorePluginNames contains all supported score plugin names.
+var ScorePluginNames = []ScorePluginName{ScorePluginLeastShoots, ScorePluginAllocatableShoots, ScorePluginRegionDistance, ScorePluginSeedLabel, ScorePluginResourceUtilization}
+
 // ServerConfiguration contains details for the HTTP(S) servers.
 type ServerConfiguration struct {
 	// HealthProbes is the configuration for serving the healthz and readyz endpoints.
@@ -108,6 +274,11 @@ type ServerConfiguration struct {
 	// Metrics is the configuration for serving the metrics endpoint.
 	// +optional
 	Metrics *Server `json:"metrics,omitempty"`
+	// Preview is the configuration for serving the schedule preview handler. It is only served if the handler is
+	// enabled, see `.schedulers.shoot.enablePreviewHandler`. The server is served via HTTPS with a self-signed
+	// certificate and requires authentication and authorization.
+	// +optional
+	Preview *Server `json:"preview,omitempty"`
 }
 
 // Server contains information for HTTP(S) server configuration.
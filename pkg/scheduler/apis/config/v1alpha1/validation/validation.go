@@ -5,7 +5,10 @@
 package validation
 
 import (
+	"slices"
+
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -49,8 +52,25 @@ func validateSchedulerControllerConfiguration(schedulers schedulerconfigv1alpha1
 	}
 
 	if schedulers.Shoot != nil {
-		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(schedulers.Shoot.ConcurrentSyncs), fldPath.Child("shoot", "concurrentSyncs"))...)
-		allErrs = append(allErrs, validateStrategy(schedulers.Shoot.Strategy, fldPath.Child("shoot", "strategy"))...)
+		allErrs = append(allErrs, ValidateShootSchedulerConfiguration(schedulers.Shoot, fldPath.Child("shoot"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateShootSchedulerConfiguration validates the configuration of the shoot scheduler.
+func ValidateShootSchedulerConfiguration(conf *schedulerconfigv1alpha1.ShootSchedulerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(conf.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	allErrs = append(allErrs, validateStrategy(conf.Strategy, fldPath.Child("strategy"))...)
+	if conf.Plugins != nil {
+		allErrs = append(allErrs, validateFilterPlugins(conf.Plugins.Filter, fldPath.Child("plugins", "filter"))...)
+		allErrs = append(allErrs, validateScorePlugins(conf.Plugins.Score, fldPath.Child("plugins", "score"))...)
+		allErrs = append(allErrs, validateScoreNormalization(conf.Plugins.ScoreNormalization, fldPath.Child("plugins", "scoreNormalization"))...)
+	}
+	if threshold := conf.ResourceUtilizationThreshold; threshold != nil && (*threshold < 1 || *threshold > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("resourceUtilizationThreshold"), *threshold, "must be between 1 and 100"))
 	}
 
 	return allErrs
@@ -74,3 +94,98 @@ func validateStrategy(strategy schedulerconfigv1alpha1.CandidateDeterminationStr
 
 	return allErrs
 }
+
+func validateFilterPlugins(plugins *schedulerconfigv1alpha1.FilterPlugins, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if plugins == nil {
+		return allErrs
+	}
+
+	var (
+		optionalNames []string
+		names         = sets.New[schedulerconfigv1alpha1.FilterPluginName]()
+	)
+
+	for _, name := range schedulerconfigv1alpha1.OptionalFilterPluginNames {
+		optionalNames = append(optionalNames, string(name))
+	}
+
+	for i, name := range plugins.Disabled {
+		idxPath := fldPath.Child("disabled").Index(i)
+
+		if !slices.Contains(schedulerconfigv1alpha1.OptionalFilterPluginNames, name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath, name, optionalNames))
+		}
+
+		if names.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, name))
+		}
+		names.Insert(name)
+	}
+
+	return allErrs
+}
+
+func validateScoreNormalization(normalization *schedulerconfigv1alpha1.ScoreNormalization, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if normalization != nil && !slices.Contains(schedulerconfigv1alpha1.ScoreNormalizations, *normalization) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, *normalization, schedulerconfigv1alpha1.ScoreNormalizations))
+	}
+
+	return allErrs
+}
+
+func validateScorePlugins(plugins []schedulerconfigv1alpha1.ScorePlugin, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs        = field.ErrorList{}
+		supportedNames []string
+		names          = sets.New[schedulerconfigv1alpha1.ScorePluginName]()
+	)
+
+	for _, name := range schedulerconfigv1alpha1.ScorePluginNames {
+		supportedNames = append(supportedNames, string(name))
+	}
+
+	for i, plugin := range plugins {
+		idxPath := fldPath.Index(i)
+
+		if !slices.Contains(schedulerconfigv1alpha1.ScorePluginNames, plugin.Name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), plugin.Name, supportedNames))
+		}
+
+		// The SeedLabel plugin may be configured multiple times for different labels.
+		if plugin.Name != schedulerconfigv1alpha1.ScorePluginSeedLabel {
+			if names.Has(plugin.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), plugin.Name))
+			}
+			names.Insert(plugin.Name)
+		}
+
+		if plugin.Weight != nil && *plugin.Weight < 1 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), *plugin.Weight, "must be greater than 0"))
+		}
+
+		if plugin.Name == schedulerconfigv1alpha1.ScorePluginSeedLabel {
+			if plugin.LabelKey == nil {
+				allErrs = append(allErrs, field.Required(idxPath.Child("labelKey"), "must be set for the SeedLabel plugin"))
+			} else {
+				allErrs = append(allErrs, metav1validation.ValidateLabelName(*plugin.LabelKey, idxPath.Child("labelKey"))...)
+			}
+
+			if plugin.LabelValuePreference != nil && !slices.Contains(schedulerconfigv1alpha1.LabelValuePreferences, *plugin.LabelValuePreference) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("labelValuePreference"), *plugin.LabelValuePreference, schedulerconfigv1alpha1.LabelValuePreferences))
+			}
+		} else {
+			if plugin.LabelKey != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("labelKey"), "is only supported for the SeedLabel plugin"))
+			}
+			if plugin.LabelValuePreference != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("labelValuePreference"), "is only supported for the SeedLabel plugin"))
+			}
+		}
+	}
+
+	return allErrs
+}
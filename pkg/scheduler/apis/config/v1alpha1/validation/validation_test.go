@@ -161,5 +161,118 @@ var _ = Describe("#ValidateConfiguration", func() {
 				"Field": Equal("schedulers.shoot.concurrentSyncs"),
 			}))))
 		})
+
+		It("should pass because the score plugins are valid", func() {
+			conf.Schedulers.Shoot.Plugins = &schedulerconfigv1alpha1.SchedulerPlugins{
+				Score: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: schedulerconfigv1alpha1.ScorePluginLeastShoots},
+					{Name: schedulerconfigv1alpha1.ScorePluginRegionDistance, Weight: ptr.To[int32](3)},
+					{Name: schedulerconfigv1alpha1.ScorePluginSeedLabel, LabelKey: ptr.To("seed.gardener.cloud/cost")},
+					{Name: schedulerconfigv1alpha1.ScorePluginSeedLabel, LabelKey: ptr.To("seed.gardener.cloud/latency")},
+					{Name: schedulerconfigv1alpha1.ScorePluginSeedLabel, LabelKey: ptr.To("seed.gardener.cloud/bandwidth"), LabelValuePreference: ptr.To(schedulerconfigv1alpha1.LabelValuePreferenceHigher)},
+				},
+			}
+
+			Expect(ValidateConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail because the score plugins are invalid", func() {
+			conf.Schedulers.Shoot.Plugins = &schedulerconfigv1alpha1.SchedulerPlugins{
+				Score: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: "Unknown"},
+					{Name: schedulerconfigv1alpha1.ScorePluginLeastShoots, Weight: ptr.To[int32](0)},
+					{Name: schedulerconfigv1alpha1.ScorePluginLeastShoots, LabelKey: ptr.To("foo")},
+					{Name: schedulerconfigv1alpha1.ScorePluginSeedLabel},
+					{Name: schedulerconfigv1alpha1.ScorePluginSeedLabel, LabelKey: ptr.To("in valid")},
+					{Name: schedulerconfigv1alpha1.ScorePluginSeedLabel, LabelKey: ptr.To("foo"), LabelValuePreference: ptr.To[schedulerconfigv1alpha1.LabelValuePreference]("Middle")},
+					{Name: schedulerconfigv1alpha1.ScorePluginRegionDistance, LabelValuePreference: ptr.To(schedulerconfigv1alpha1.LabelValuePreferenceLower)},
+				},
+			}
+
+			Expect(ValidateConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("schedulers.shoot.plugins.score[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.plugins.score[1].weight"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("schedulers.shoot.plugins.score[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("schedulers.shoot.plugins.score[2].labelKey"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("schedulers.shoot.plugins.score[3].labelKey"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.plugins.score[4].labelKey"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("schedulers.shoot.plugins.score[5].labelValuePreference"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("schedulers.shoot.plugins.score[6].labelValuePreference"),
+				})),
+			))
+		})
+
+		It("should pass because the filter plugins and the score normalization are valid", func() {
+			conf.Schedulers.Shoot.Plugins = &schedulerconfigv1alpha1.SchedulerPlugins{
+				Filter:             &schedulerconfigv1alpha1.FilterPlugins{Disabled: []schedulerconfigv1alpha1.FilterPluginName{schedulerconfigv1alpha1.FilterPluginStrategy}},
+				ScoreNormalization: ptr.To(schedulerconfigv1alpha1.ScoreNormalizationRank),
+			}
+
+			Expect(ValidateConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail because the filter plugins and the score normalization are invalid", func() {
+			conf.Schedulers.Shoot.Plugins = &schedulerconfigv1alpha1.SchedulerPlugins{
+				Filter: &schedulerconfigv1alpha1.FilterPlugins{Disabled: []schedulerconfigv1alpha1.FilterPluginName{
+					schedulerconfigv1alpha1.FilterPluginProviders,
+					schedulerconfigv1alpha1.FilterPluginStrategy,
+					schedulerconfigv1alpha1.FilterPluginStrategy,
+				}},
+				ScoreNormalization: ptr.To[schedulerconfigv1alpha1.ScoreNormalization]("Unknown"),
+			}
+
+			Expect(ValidateConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("schedulers.shoot.plugins.filter.disabled[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("schedulers.shoot.plugins.filter.disabled[2]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("schedulers.shoot.plugins.scoreNormalization"),
+				})),
+			))
+		})
+
+		It("should pass because the resource utilization threshold is valid", func() {
+			conf.Schedulers.Shoot.ResourceUtilizationThreshold = ptr.To[int32](80)
+
+			Expect(ValidateConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail because the resource utilization threshold is out of range", func() {
+			conf.Schedulers.Shoot.ResourceUtilizationThreshold = ptr.To[int32](101)
+
+			Expect(ValidateConfiguration(conf)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("schedulers.shoot.resourceUtilizationThreshold"),
+			}))))
+		})
 	})
 })
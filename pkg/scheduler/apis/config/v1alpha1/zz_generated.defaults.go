@@ -29,4 +29,7 @@ func SetObjectDefaults_SchedulerConfiguration(in *SchedulerConfiguration) {
 	}
 	SetDefaults_ServerConfiguration(&in.Server)
 	SetDefaults_SchedulerControllerConfiguration(&in.Schedulers)
+	if in.Schedulers.Shoot != nil {
+		SetDefaults_ShootSchedulerConfiguration(in.Schedulers.Shoot)
+	}
 }
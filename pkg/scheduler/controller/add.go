@@ -16,7 +16,8 @@ import (
 // AddToManager adds all scheduler controllers to the given manager.
 func AddToManager(mgr manager.Manager, cfg *schedulerconfigv1alpha1.SchedulerConfiguration) error {
 	if err := (&shoot.Reconciler{
-		Config: cfg.Schedulers.Shoot,
+		Config:        cfg.Schedulers.Shoot,
+		PreviewServer: cfg.Server.Preview,
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding Shoot controller: %w", err)
 	}
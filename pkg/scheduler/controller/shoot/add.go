@@ -5,6 +5,8 @@
 package shoot
 
 import (
+	"fmt"
+
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -36,6 +38,15 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		r.GardenNamespace = v1beta1constants.GardenNamespace
 	}
 
+	if ptr.Deref(r.Config.EnablePreviewHandler, false) {
+		if r.PreviewServer == nil {
+			return fmt.Errorf("preview server configuration is required when the preview handler is enabled")
+		}
+		if err := r.addPreviewServerToManager(mgr, mgr.GetLogger().WithName("schedule-preview")); err != nil {
+			return fmt.Errorf("failed adding preview server: %w", err)
+		}
+	}
+
 	return builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
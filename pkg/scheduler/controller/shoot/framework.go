@@ -0,0 +1,525 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+// MaxScore is the maximum normalized score a score plugin can assign to a seed.
+const MaxScore int64 = 100
+
+// schedulingContext contains all information the plugins need to schedule a shoot.
+type schedulingContext struct {
+	log          logr.Logger
+	shoot        *gardencorev1beta1.Shoot
+	shootList    []*gardencorev1beta1.Shoot
+	seedUsage    map[string]int
+	cloudProfile *gardencorev1beta1.CloudProfile
+	regionConfig *corev1.ConfigMap
+	projectName  string
+	// regionDistances are the distances from the shoot's region to seed regions according to the region config.
+	regionDistances map[string]int
+	strategy        schedulerconfigv1alpha1.CandidateDeterminationStrategy
+	// resourceUtilizationThreshold is the maximum CPU and memory utilization in percent a seed may reach.
+	resourceUtilizationThreshold *int32
+	// excludedSeeds are the names of the seeds which must not be chosen.
+	excludedSeeds sets.Set[string]
+}
+
+// ScheduleOption is an option for scheduling a shoot.
+type ScheduleOption func(*schedulingContext)
+
+// WithExcludedSeeds excludes the seeds with the given names from scheduling, e.g., the current seed of a shoot whose
+// control plane shall be migrated.
+func WithExcludedSeeds(names ...string) ScheduleOption {
+	return func(sc *schedulingContext) {
+		sc.excludedSeeds = sets.New(names...)
+	}
+}
+
+// WithResourceUtilizationThreshold overrides the maximum CPU and memory utilization in percent a seed may reach.
+func WithResourceUtilizationThreshold(threshold *int32) ScheduleOption {
+	return func(sc *schedulingContext) {
+		sc.resourceUtilizationThreshold = threshold
+	}
+}
+
+// filterPlugin removes the seeds which are not suitable for the shoot. It returns an error if no seed remains.
+type filterPlugin struct {
+	name   schedulerconfigv1alpha1.FilterPluginName
+	filter func(sc *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error)
+	// check optionally returns why the given seed is not suitable for the shoot. If it is not set, the reason is
+	// determined by running the filter on the given seed only.
+	check func(sc *schedulingContext, seed *gardencorev1beta1.Seed) error
+}
+
+// rejectionReason returns why the given seed was removed by the filter plugin. Filters which compare the seeds with
+// each other (e.g., the Strategy filter) might remove seeds which would be suitable on their own, hence, a generic
+// reason is returned for such seeds.
+func (p filterPlugin) rejectionReason(sc *schedulingContext, seed *gardencorev1beta1.Seed) string {
+	var err error
+	if p.check != nil {
+		err = p.check(sc, seed)
+	} else {
+		_, err = p.filter(sc, []gardencorev1beta1.Seed{*seed})
+	}
+
+	if err != nil {
+		return err.Error()
+	}
+	return fmt.Sprintf("seed was rejected by filter plugin %q in favor of other seeds", p.name)
+}
+
+// newFilterPlugins returns the built-in filter plugins in the order they are executed, except for those which are
+// disabled in the given configuration.
+func newFilterPlugins(config *schedulerconfigv1alpha1.ShootSchedulerConfiguration) []filterPlugin {
+	var disabled []schedulerconfigv1alpha1.FilterPluginName
+	if config != nil && config.Plugins != nil && config.Plugins.Filter != nil {
+		disabled = config.Plugins.Filter.Disabled
+	}
+
+	return slices.DeleteFunc(builtinFilterPlugins(), func(plugin filterPlugin) bool {
+		return slices.Contains(disabled, plugin.name)
+	})
+}
+
+func builtinFilterPlugins() []filterPlugin {
+	return []filterPlugin{
+		{
+			name: schedulerconfigv1alpha1.FilterPluginExcludedSeeds,
+			filter: func(sc *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterExcludedSeeds(seeds, sc.excludedSeeds)
+			},
+			check: func(sc *schedulingContext, seed *gardencorev1beta1.Seed) error {
+				if sc.excludedSeeds.Has(seed.Name) {
+					return fmt.Errorf("seed %q is excluded from scheduling", seed.Name)
+				}
+				return nil
+			},
+		},
+		{
+			name: schedulerconfigv1alpha1.FilterPluginUsableSeeds,
+			filter: func(_ *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterUsableSeeds(seeds)
+			},
+		},
+		{
+			name: schedulerconfigv1alpha1.FilterPluginCloudProfileSeedSelector,
+			filter: func(sc *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsMatchingLabelSelector(seeds, sc.cloudProfile.Spec.SeedSelector, "CloudProfile")
+			},
+		},
+		{
+			name: schedulerconfigv1alpha1.FilterPluginShootSeedSelector,
+			filter: func(sc *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsMatchingLabelSelector(seeds, sc.shoot.Spec.SeedSelector, "Shoot")
+			},
+		},
+		{
+			name: schedulerconfigv1alpha1.FilterPluginProviders,
+			filter: func(sc *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsMatchingProviders(sc.cloudProfile, sc.shoot, seeds)
+			},
+		},
+		{
+			name: schedulerconfigv1alpha1.FilterPluginZonalShootControlPlanes,
+			filter: func(sc *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsForZonalShootControlPlanes(seeds, sc.shoot)
+			},
+		},
+		{
+			name: schedulerconfigv1alpha1.FilterPluginAccessRestrictions,
+			filter: func(sc *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsForAccessRestrictions(seeds, sc.shoot)
+			},
+		},
+		{
+			name: schedulerconfigv1alpha1.FilterPluginDomain,
+			filter: func(sc *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsMatchingDomain(seeds, sc.shoot, sc.projectName)
+			},
+		},
+		{
+			name: schedulerconfigv1alpha1.FilterPluginShootReconciliationsEnabled,
+			filter: func(_ *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsWithDisabledShootReconciliations(seeds)
+			},
+		},
+		{
+			name: schedulerconfigv1alpha1.FilterPluginCandidates,
+			filter: func(sc *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterCandidates(sc.shoot, sc.shootList, seeds)
+			},
+			check: func(sc *schedulingContext, seed *gardencorev1beta1.Seed) error {
+				return checkCandidate(seed, sc.shoot, sc.seedUsage)
+			},
+		},
+		{
+			name: schedulerconfigv1alpha1.FilterPluginResourceUtilization,
+			filter: func(sc *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsByResourceUtilization(seeds, sc.seedUsage, sc.resourceUtilizationThreshold)
+			},
+			check: func(sc *schedulingContext, seed *gardencorev1beta1.Seed) error {
+				return checkResourceUtilization(seed, sc.seedUsage[seed.Name], sc.resourceUtilizationThreshold)
+			},
+		},
+		{
+			name: schedulerconfigv1alpha1.FilterPluginStrategy,
+			filter: func(sc *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return applyStrategy(sc.log, sc.shoot, seeds, sc.strategy, sc.regionConfig)
+			},
+		},
+	}
+}
+
+// runFilterPlugins runs the given filter plugins on the given seeds and returns the remaining seeds. Additionally, it
+// returns the reasons why the removed seeds were rejected, even if an error occurred.
+func runFilterPlugins(sc *schedulingContext, plugins []filterPlugin, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]string, error) {
+	rejections := make(map[string]string)
+
+	for _, plugin := range plugins {
+		remaining, err := plugin.filter(sc, seeds)
+
+		for i, seed := range seeds {
+			if err == nil && slices.ContainsFunc(remaining, func(s gardencorev1beta1.Seed) bool { return s.Name == seed.Name }) {
+				continue
+			}
+
+			rejections[seed.Name] = plugin.rejectionReason(sc, &seeds[i])
+		}
+
+		if err != nil {
+			return nil, rejections, err
+		}
+
+		sc.log.V(1).Info("Filtered seeds", "plugin", plugin.name, "remaining", len(remaining))
+		seeds = remaining
+	}
+
+	return seeds, rejections, nil
+}
+
+// scorePlugin ranks the seed candidates which passed all filter plugins.
+type scorePlugin struct {
+	name   string
+	weight int64
+	// score returns the raw score of the given seed. If ok is false, the seed gets the lowest normalized score.
+	score func(sc *schedulingContext, seed *gardencorev1beta1.Seed) (score int64, ok bool)
+	// lowerIsBetter specifies whether lower raw scores are preferred during normalization.
+	lowerIsBetter bool
+	// normalization is the strategy for normalizing the raw scores, defaults to MinMax.
+	normalization schedulerconfigv1alpha1.ScoreNormalization
+}
+
+// normalize maps the given raw scores to the range [0, MaxScore] so that the preferred seeds get the highest scores.
+func (p scorePlugin) normalize(rawScores []int64, ok []bool) []int64 {
+	if p.normalization == schedulerconfigv1alpha1.ScoreNormalizationRank {
+		return p.normalizeRanks(rawScores, ok)
+	}
+
+	var (
+		normalized       = make([]int64, len(rawScores))
+		minimum, maximum int64
+		found            bool
+	)
+
+	for i, score := range rawScores {
+		if !ok[i] {
+			continue
+		}
+		if !found || score < minimum {
+			minimum = score
+		}
+		if !found || score > maximum {
+			maximum = score
+		}
+		found = true
+	}
+
+	for i, score := range rawScores {
+		switch {
+		case !ok[i]:
+			normalized[i] = 0
+		case maximum == minimum:
+			normalized[i] = MaxScore
+		case p.lowerIsBetter:
+			normalized[i] = (maximum - score) * MaxScore / (maximum - minimum)
+		default:
+			normalized[i] = (score - minimum) * MaxScore / (maximum - minimum)
+		}
+	}
+
+	return normalized
+}
+
+// normalizeRanks maps the ranks of the given raw scores evenly to the range [0, MaxScore] so that the preferred seeds get
+// the highest scores. Equal raw scores get the same normalized score.
+func (p scorePlugin) normalizeRanks(rawScores []int64, ok []bool) []int64 {
+	var distinct []int64
+	for i, score := range rawScores {
+		if ok[i] && !slices.Contains(distinct, score) {
+			distinct = append(distinct, score)
+		}
+	}
+
+	// Sort the distinct raw scores from the least to the most preferred one.
+	slices.Sort(distinct)
+	if p.lowerIsBetter {
+		slices.Reverse(distinct)
+	}
+
+	normalized := make([]int64, len(rawScores))
+	for i, score := range rawScores {
+		switch {
+		case !ok[i]:
+			normalized[i] = 0
+		case len(distinct) == 1:
+			normalized[i] = MaxScore
+		default:
+			normalized[i] = int64(slices.Index(distinct, score)) * MaxScore / int64(len(distinct)-1)
+		}
+	}
+
+	return normalized
+}
+
+// newScorePlugins returns the score plugins for the given configuration. If no score plugins are configured, only the
+// LeastShoots plugin is used.
+func newScorePlugins(config *schedulerconfigv1alpha1.ShootSchedulerConfiguration) []scorePlugin {
+	var (
+		configs       = []schedulerconfigv1alpha1.ScorePlugin{{Name: schedulerconfigv1alpha1.ScorePluginLeastShoots}}
+		normalization = schedulerconfigv1alpha1.ScoreNormalizationMinMax
+	)
+
+	if config != nil && config.Plugins != nil {
+		if len(config.Plugins.Score) > 0 {
+			configs = config.Plugins.Score
+		}
+		normalization = ptr.Deref(config.Plugins.ScoreNormalization, normalization)
+	}
+
+	plugins := make([]scorePlugin, 0, len(configs))
+	for _, c := range configs {
+		plugin := scorePlugin{name: string(c.Name), weight: int64(ptr.Deref(c.Weight, 1)), normalization: normalization}
+
+		switch c.Name {
+		case schedulerconfigv1alpha1.ScorePluginLeastShoots:
+			plugin.score, plugin.lowerIsBetter = scoreLeastShoots, true
+		case schedulerconfigv1alpha1.ScorePluginAllocatableShoots:
+			plugin.score = scoreAllocatableShoots
+		case schedulerconfigv1alpha1.ScorePluginRegionDistance:
+			plugin.score, plugin.lowerIsBetter = scoreRegionDistance, true
+		case schedulerconfigv1alpha1.ScorePluginResourceUtilization:
+			plugin.score, plugin.lowerIsBetter = scoreResourceUtilization, true
+		case schedulerconfigv1alpha1.ScorePluginSeedLabel:
+			labelKey := ptr.Deref(c.LabelKey, "")
+			plugin.name = fmt.Sprintf("%s(%s)", c.Name, labelKey)
+			plugin.score = scoreSeedLabel(labelKey)
+			plugin.lowerIsBetter = ptr.Deref(c.LabelValuePreference, schedulerconfigv1alpha1.LabelValuePreferenceLower) == schedulerconfigv1alpha1.LabelValuePreferenceLower
+		default:
+			// Unknown plugins are rejected by the validation of the configuration.
+			continue
+		}
+
+		plugins = append(plugins, plugin)
+	}
+
+	return plugins
+}
+
+func scoreLeastShoots(sc *schedulingContext, seed *gardencorev1beta1.Seed) (int64, bool) {
+	return int64(sc.seedUsage[seed.Name]), true
+}
+
+func scoreAllocatableShoots(sc *schedulingContext, seed *gardencorev1beta1.Seed) (int64, bool) {
+	allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]
+	if !ok || allocatableShoots.Value() <= 0 {
+		return MaxScore, true
+	}
+
+	free := allocatableShoots.Value() - int64(sc.seedUsage[seed.Name])
+	return max(free, 0) * MaxScore / allocatableShoots.Value(), true
+}
+
+// scoreRegionDistance returns the distance of the seed's region to the shoot's region. Seeds in regions which are not
+// listed in the region config are unreachable, hence they get the lowest normalized score but are not filtered out.
+func scoreRegionDistance(sc *schedulingContext, seed *gardencorev1beta1.Seed) (int64, bool) {
+	if sc.regionDistances != nil {
+		dist, ok := sc.regionDistances[seed.Spec.Provider.Region]
+		return int64(dist), ok
+	}
+
+	dist := distance(seed.Spec.Provider.Region, sc.shoot.Spec.Region)
+	if sc.shoot.Spec.Provider.Type != seed.Spec.Provider.Type {
+		dist += 2
+	}
+	return int64(dist), true
+}
+
+// scoreResourceUtilization returns the highest utilization of the seed's CPU and memory in per mille.
+func scoreResourceUtilization(_ *schedulingContext, seed *gardencorev1beta1.Seed) (int64, bool) {
+	var (
+		highest int64
+		found   bool
+	)
+
+	for _, resourceName := range utilizationResourceNames {
+		if utilization, ok := resourceUtilization(seed, resourceName, 0); ok {
+			highest, found = max(highest, utilization), true
+		}
+	}
+	return highest, found
+}
+
+// regionDistances returns the distances from the given region to the seed regions configured in the given region
+// config. It returns nil if there is no (valid) configuration for the region.
+func regionDistances(regionConfig *corev1.ConfigMap, region string) map[string]int {
+	if regionConfig == nil || regionConfig.Data[region] == "" {
+		return nil
+	}
+
+	distances := make(map[string]int)
+	if err := yaml.Unmarshal([]byte(regionConfig.Data[region]), &distances); err != nil {
+		return nil
+	}
+
+	// If not configured otherwise, assume that a region has the smallest possible distance to itself.
+	if _, ok := distances[region]; !ok {
+		distances[region] = 0
+	}
+	return distances
+}
+
+// maxSeedLabelValue is the maximum absolute value of seed labels considered by the SeedLabel plugin. It ensures that the
+// raw scores and their differences can be normalized without overflowing int64.
+const maxSeedLabelValue = 1e13
+
+func scoreSeedLabel(labelKey string) func(*schedulingContext, *gardencorev1beta1.Seed) (int64, bool) {
+	return func(sc *schedulingContext, seed *gardencorev1beta1.Seed) (int64, bool) {
+		value, ok := seed.Labels[labelKey]
+		if !ok {
+			return 0, false
+		}
+
+		score, err := parseSeedLabelValue(value)
+		if err != nil {
+			sc.log.Error(err, "Seed label value cannot be used for scoring, assigning the lowest score", "seedName", seed.Name, "labelKey", labelKey)
+			return 0, false
+		}
+		return score, true
+	}
+}
+
+// parseSeedLabelValue parses the given numeric label value and returns it in thousandths to keep three decimal places
+// of precision.
+func parseSeedLabelValue(value string) (int64, error) {
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(parsed) {
+		return 0, fmt.Errorf("value %q is not numeric", value)
+	}
+
+	if math.Abs(parsed) > maxSeedLabelValue {
+		return 0, fmt.Errorf("value %q is out of range, its absolute value must not exceed %g", value, maxSeedLabelValue)
+	}
+
+	return int64(parsed * 1000), nil
+}
+
+// SeedScore is the result of scoring a seed candidate.
+type SeedScore struct {
+	// SeedName is the name of the seed.
+	SeedName string `json:"seedName"`
+	// Total is the weighted sum of the normalized scores of all plugins.
+	Total int64 `json:"total"`
+	// PluginScores are the normalized scores of the individual plugins.
+	PluginScores []PluginScore `json:"pluginScores,omitempty"`
+}
+
+// PluginScore is the normalized score a score plugin assigned to a seed.
+type PluginScore struct {
+	// Plugin is the name of the score plugin.
+	Plugin string `json:"plugin"`
+	// Score is the normalized score.
+	Score int64 `json:"score"`
+}
+
+// scoreSeeds scores the given seeds with the given plugins and returns the scores in the order of the seeds.
+func scoreSeeds(sc *schedulingContext, plugins []scorePlugin, seeds []gardencorev1beta1.Seed) []SeedScore {
+	scores := make([]SeedScore, len(seeds))
+	for i, seed := range seeds {
+		scores[i].SeedName = seed.Name
+	}
+
+	for _, plugin := range plugins {
+		var (
+			rawScores = make([]int64, len(seeds))
+			ok        = make([]bool, len(seeds))
+		)
+
+		for i := range seeds {
+			rawScores[i], ok[i] = plugin.score(sc, &seeds[i])
+		}
+
+		for i, score := range plugin.normalize(rawScores, ok) {
+			scores[i].Total += plugin.weight * score
+			scores[i].PluginScores = append(scores[i].PluginScores, PluginScore{Plugin: plugin.name, Score: score})
+		}
+	}
+
+	return scores
+}
+
+// bestSeedScore returns the index of the seed with the highest total score. Ties are resolved by choosing the first
+// seed.
+func bestSeedScore(scores []SeedScore) int {
+	best := 0
+	for i, score := range scores {
+		if score.Total > scores[best].Total {
+			best = i
+		}
+	}
+	return best
+}
+
+// maxScoresInEvent is the maximum number of seed scores included in the scheduling event.
+const maxScoresInEvent = 5
+
+// scoresToString returns a human-readable representation of the given scores. Only the scores of the top
+// maxScoresInEvent seeds are included to keep the event message short.
+func scoresToString(scores []SeedScore) string {
+	// Sort stable to keep the order of seeds with equal total scores, see bestSeedScore.
+	sorted := slices.Clone(scores)
+	slices.SortStableFunc(sorted, func(a, b SeedScore) int {
+		return cmp.Compare(b.Total, a.Total)
+	})
+
+	out := make([]string, 0, min(len(sorted), maxScoresInEvent)+1)
+	for _, score := range sorted[:min(len(sorted), maxScoresInEvent)] {
+		pluginScores := make([]string, 0, len(score.PluginScores))
+		for _, pluginScore := range score.PluginScores {
+			pluginScores = append(pluginScores, fmt.Sprintf("%s=%d", pluginScore.Plugin, pluginScore.Score))
+		}
+		out = append(out, fmt.Sprintf("%s => %d (%s)", score.SeedName, score.Total, strings.Join(pluginScores, ", ")))
+	}
+	if len(sorted) > maxScoresInEvent {
+		out = append(out, fmt.Sprintf("and %d more", len(sorted)-maxScoresInEvent))
+	}
+	return "{" + strings.Join(out, ", ") + "}"
+}
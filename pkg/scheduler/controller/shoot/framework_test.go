@@ -0,0 +1,365 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+var _ = Describe("Framework", func() {
+	var (
+		sc    *schedulingContext
+		seeds []gardencorev1beta1.Seed
+
+		newSeed = func(name, region string, labels map[string]string) gardencorev1beta1.Seed {
+			return gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+				Spec:       gardencorev1beta1.SeedSpec{Provider: gardencorev1beta1.SeedProvider{Type: "local", Region: region}},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		sc = &schedulingContext{
+			log: logr.Discard(),
+			shoot: &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{
+				Region:   "eu-west-1",
+				Provider: gardencorev1beta1.Provider{Type: "local"},
+			}},
+			seedUsage: map[string]int{"seed-1": 10, "seed-2": 5, "seed-3": 0},
+		}
+
+		seeds = []gardencorev1beta1.Seed{
+			newSeed("seed-1", "eu-west-1", map[string]string{"cost": "1.5"}),
+			newSeed("seed-2", "eu-central-1", map[string]string{"cost": "3"}),
+			newSeed("seed-3", "us-east-1", nil),
+		}
+	})
+
+	Describe("#normalize", func() {
+		It("should map higher raw scores to higher normalized scores", func() {
+			Expect(scorePlugin{}.normalize([]int64{10, 20, 30}, []bool{true, true, true})).To(Equal([]int64{0, 50, 100}))
+		})
+
+		It("should map lower raw scores to higher normalized scores", func() {
+			Expect(scorePlugin{lowerIsBetter: true}.normalize([]int64{10, 20, 30}, []bool{true, true, true})).To(Equal([]int64{100, 50, 0}))
+		})
+
+		It("should assign the maximum score if all raw scores are equal", func() {
+			Expect(scorePlugin{}.normalize([]int64{7, 7}, []bool{true, true})).To(Equal([]int64{100, 100}))
+		})
+
+		It("should assign the minimum score to seeds without raw score", func() {
+			Expect(scorePlugin{lowerIsBetter: true}.normalize([]int64{1, 0, 3}, []bool{true, false, true})).To(Equal([]int64{100, 0, 0}))
+		})
+
+		Context("rank normalization", func() {
+			It("should map the ranks of the raw scores evenly", func() {
+				Expect(scorePlugin{normalization: schedulerconfigv1alpha1.ScoreNormalizationRank}.normalize([]int64{10, 1000, 20}, []bool{true, true, true})).To(Equal([]int64{0, 100, 50}))
+				Expect(scorePlugin{normalization: schedulerconfigv1alpha1.ScoreNormalizationRank, lowerIsBetter: true}.normalize([]int64{10, 1000, 20}, []bool{true, true, true})).To(Equal([]int64{100, 0, 50}))
+			})
+
+			It("should assign the same score to equal raw scores", func() {
+				Expect(scorePlugin{normalization: schedulerconfigv1alpha1.ScoreNormalizationRank}.normalize([]int64{5, 5, 7}, []bool{true, true, true})).To(Equal([]int64{0, 0, 100}))
+				Expect(scorePlugin{normalization: schedulerconfigv1alpha1.ScoreNormalizationRank}.normalize([]int64{7, 7}, []bool{true, true})).To(Equal([]int64{100, 100}))
+			})
+
+			It("should assign the minimum score to seeds without raw score", func() {
+				Expect(scorePlugin{normalization: schedulerconfigv1alpha1.ScoreNormalizationRank}.normalize([]int64{1, 0, 3}, []bool{true, false, true})).To(Equal([]int64{0, 0, 100}))
+			})
+		})
+	})
+
+	Describe("#newFilterPlugins", func() {
+		filterPluginNames := func(plugins []filterPlugin) []schedulerconfigv1alpha1.FilterPluginName {
+			var names []schedulerconfigv1alpha1.FilterPluginName
+			for _, plugin := range plugins {
+				names = append(names, plugin.name)
+			}
+			return names
+		}
+
+		It("should return all built-in filter plugins by default", func() {
+			Expect(filterPluginNames(newFilterPlugins(nil))).To(Equal(schedulerconfigv1alpha1.FilterPluginNames))
+		})
+
+		It("should not return the disabled filter plugins", func() {
+			names := filterPluginNames(newFilterPlugins(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{Plugins: &schedulerconfigv1alpha1.SchedulerPlugins{
+				Filter: &schedulerconfigv1alpha1.FilterPlugins{Disabled: []schedulerconfigv1alpha1.FilterPluginName{schedulerconfigv1alpha1.FilterPluginStrategy}},
+			}}))
+			Expect(names).To(HaveLen(len(schedulerconfigv1alpha1.FilterPluginNames) - 1))
+			Expect(names).NotTo(ContainElement(schedulerconfigv1alpha1.FilterPluginStrategy))
+		})
+	})
+
+	Describe("#newScorePlugins", func() {
+		It("should default to the LeastShoots plugin", func() {
+			plugins := newScorePlugins(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{})
+			Expect(plugins).To(HaveLen(1))
+			Expect(plugins[0].name).To(Equal("LeastShoots"))
+			Expect(plugins[0].weight).To(Equal(int64(1)))
+		})
+
+		It("should return the configured plugins", func() {
+			plugins := newScorePlugins(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{Plugins: &schedulerconfigv1alpha1.SchedulerPlugins{
+				Score: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: schedulerconfigv1alpha1.ScorePluginRegionDistance, Weight: ptr.To[int32](2)},
+					{Name: schedulerconfigv1alpha1.ScorePluginSeedLabel, LabelKey: ptr.To("cost")},
+				},
+			}})
+			Expect(plugins).To(HaveLen(2))
+			Expect(plugins[0].name).To(Equal("RegionDistance"))
+			Expect(plugins[0].weight).To(Equal(int64(2)))
+			Expect(plugins[1].name).To(Equal("SeedLabel(cost)"))
+		})
+
+		It("should prefer lower label values of the SeedLabel plugin by default", func() {
+			plugins := newScorePlugins(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{Plugins: &schedulerconfigv1alpha1.SchedulerPlugins{
+				Score: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: schedulerconfigv1alpha1.ScorePluginSeedLabel, LabelKey: ptr.To("cost")},
+					{Name: schedulerconfigv1alpha1.ScorePluginSeedLabel, LabelKey: ptr.To("bandwidth"), LabelValuePreference: ptr.To(schedulerconfigv1alpha1.LabelValuePreferenceHigher)},
+				},
+			}})
+			Expect(plugins).To(HaveLen(2))
+			Expect(plugins[0].lowerIsBetter).To(BeTrue())
+			Expect(plugins[1].lowerIsBetter).To(BeFalse())
+		})
+
+		It("should use the configured score normalization", func() {
+			Expect(newScorePlugins(nil)[0].normalization).To(Equal(schedulerconfigv1alpha1.ScoreNormalizationMinMax))
+			Expect(newScorePlugins(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{Plugins: &schedulerconfigv1alpha1.SchedulerPlugins{
+				ScoreNormalization: ptr.To(schedulerconfigv1alpha1.ScoreNormalizationRank),
+			}})[0].normalization).To(Equal(schedulerconfigv1alpha1.ScoreNormalizationRank))
+		})
+	})
+
+	Describe("#scoreSeeds", func() {
+		It("should prefer the seed with the least shoots by default", func() {
+			scores := scoreSeeds(sc, newScorePlugins(nil), seeds)
+
+			Expect(scores).To(Equal([]SeedScore{
+				{SeedName: "seed-1", Total: 0, PluginScores: []PluginScore{{Plugin: "LeastShoots", Score: 0}}},
+				{SeedName: "seed-2", Total: 50, PluginScores: []PluginScore{{Plugin: "LeastShoots", Score: 50}}},
+				{SeedName: "seed-3", Total: 100, PluginScores: []PluginScore{{Plugin: "LeastShoots", Score: 100}}},
+			}))
+			Expect(bestSeedScore(scores)).To(Equal(2))
+		})
+
+		It("should combine the weighted scores of multiple plugins", func() {
+			scores := scoreSeeds(sc, newScorePlugins(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{Plugins: &schedulerconfigv1alpha1.SchedulerPlugins{
+				Score: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: schedulerconfigv1alpha1.ScorePluginLeastShoots},
+					{Name: schedulerconfigv1alpha1.ScorePluginSeedLabel, LabelKey: ptr.To("cost"), Weight: ptr.To[int32](3)},
+				},
+			}}), seeds)
+
+			Expect(scores).To(Equal([]SeedScore{
+				{SeedName: "seed-1", Total: 300, PluginScores: []PluginScore{{Plugin: "LeastShoots", Score: 0}, {Plugin: "SeedLabel(cost)", Score: 100}}},
+				{SeedName: "seed-2", Total: 50, PluginScores: []PluginScore{{Plugin: "LeastShoots", Score: 50}, {Plugin: "SeedLabel(cost)", Score: 0}}},
+				{SeedName: "seed-3", Total: 100, PluginScores: []PluginScore{{Plugin: "LeastShoots", Score: 100}, {Plugin: "SeedLabel(cost)", Score: 0}}},
+			}))
+			Expect(bestSeedScore(scores)).To(Equal(0))
+			Expect(scoresToString(scores)).To(Equal("{seed-1 => 300 (LeastShoots=0, SeedLabel(cost)=100), seed-3 => 100 (LeastShoots=100, SeedLabel(cost)=0), seed-2 => 50 (LeastShoots=50, SeedLabel(cost)=0)}"))
+		})
+
+		It("should prefer seeds with more available capacity", func() {
+			seeds[0].Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("20")}
+			seeds[1].Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("6")}
+
+			scores := scoreSeeds(sc, []scorePlugin{{name: "AllocatableShoots", weight: 1, score: scoreAllocatableShoots}}, seeds)
+			Expect(scores[0].Total).To(Equal(int64(40)))
+			Expect(scores[1].Total).To(Equal(int64(0)))
+			Expect(scores[2].Total).To(Equal(int64(100)))
+		})
+
+		It("should prefer seeds with higher label values if configured", func() {
+			plugins := newScorePlugins(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{Plugins: &schedulerconfigv1alpha1.SchedulerPlugins{
+				Score: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: schedulerconfigv1alpha1.ScorePluginSeedLabel, LabelKey: ptr.To("cost"), LabelValuePreference: ptr.To(schedulerconfigv1alpha1.LabelValuePreferenceHigher)},
+				},
+			}})
+
+			scores := scoreSeeds(sc, plugins, seeds)
+			Expect(scores[0].Total).To(Equal(int64(0)))
+			Expect(scores[1].Total).To(Equal(int64(100)))
+			Expect(scores[2].Total).To(Equal(int64(0)))
+		})
+
+		It("should assign the lowest score to seeds with out-of-range label values", func() {
+			seeds[2].Labels = map[string]string{"cost": "1e19"}
+
+			scores := scoreSeeds(sc, []scorePlugin{{name: "SeedLabel(cost)", weight: 1, score: scoreSeedLabel("cost")}}, seeds)
+			Expect(scores[0].Total).To(Equal(int64(0)))
+			Expect(scores[1].Total).To(Equal(int64(100)))
+			Expect(scores[2].Total).To(Equal(int64(0)))
+		})
+
+		It("should prefer closer seeds according to the region config", func() {
+			sc.regionDistances = regionDistances(&corev1.ConfigMap{Data: map[string]string{
+				"eu-west-1": "eu-central-1: 10\nus-east-1: 100",
+			}}, "eu-west-1")
+
+			scores := scoreSeeds(sc, []scorePlugin{{name: "RegionDistance", weight: 1, score: scoreRegionDistance, lowerIsBetter: true}}, seeds)
+			Expect(scores[0].Total).To(Equal(int64(100)))
+			Expect(scores[1].Total).To(Equal(int64(90)))
+			Expect(scores[2].Total).To(Equal(int64(0)))
+		})
+
+		It("should assign the lowest score to seeds in regions missing from the region config instead of filtering them out", func() {
+			sc.regionDistances = regionDistances(&corev1.ConfigMap{Data: map[string]string{
+				"eu-west-1": "eu-central-1: 10",
+			}}, "eu-west-1")
+
+			plugins := []scorePlugin{
+				{name: "RegionDistance", weight: 1, score: scoreRegionDistance, lowerIsBetter: true},
+				{name: "LeastShoots", weight: 2, score: scoreLeastShoots, lowerIsBetter: true},
+			}
+
+			scores := scoreSeeds(sc, plugins, seeds)
+			Expect(scores).To(Equal([]SeedScore{
+				{SeedName: "seed-1", Total: 100, PluginScores: []PluginScore{{Plugin: "RegionDistance", Score: 100}, {Plugin: "LeastShoots", Score: 0}}},
+				{SeedName: "seed-2", Total: 100, PluginScores: []PluginScore{{Plugin: "RegionDistance", Score: 0}, {Plugin: "LeastShoots", Score: 50}}},
+				{SeedName: "seed-3", Total: 200, PluginScores: []PluginScore{{Plugin: "RegionDistance", Score: 0}, {Plugin: "LeastShoots", Score: 100}}},
+			}))
+			Expect(bestSeedScore(scores)).To(Equal(2))
+		})
+
+		It("should prefer seeds with lower resource utilization", func() {
+			seeds[0].Status.Allocatable = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10"), corev1.ResourceMemory: resource.MustParse("40Gi")}
+			seeds[0].Status.Usage = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourceMemory: resource.MustParse("30Gi")}
+			seeds[1].Status.Allocatable = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10"), corev1.ResourceMemory: resource.MustParse("40Gi")}
+			seeds[1].Status.Usage = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("5"), corev1.ResourceMemory: resource.MustParse("10Gi")}
+
+			scores := scoreSeeds(sc, []scorePlugin{{name: "ResourceUtilization", weight: 1, score: scoreResourceUtilization, lowerIsBetter: true}}, seeds)
+			Expect(scores[0].Total).To(Equal(int64(0)))
+			Expect(scores[1].Total).To(Equal(int64(100)))
+			Expect(scores[2].Total).To(Equal(int64(0)))
+		})
+
+		It("should prefer closer seeds according to the Levenshtein distance if there is no region config", func() {
+			scores := scoreSeeds(sc, []scorePlugin{{name: "RegionDistance", weight: 1, score: scoreRegionDistance, lowerIsBetter: true}}, seeds)
+			Expect(bestSeedScore(scores)).To(Equal(0))
+			Expect(scores[1].Total).To(BeNumerically(">", scores[2].Total))
+		})
+	})
+
+	Describe("#parseSeedLabelValue", func() {
+		It("should return the value in thousandths", func() {
+			Expect(parseSeedLabelValue("1.5")).To(Equal(int64(1500)))
+			Expect(parseSeedLabelValue("-2")).To(Equal(int64(-2000)))
+			Expect(parseSeedLabelValue("1e13")).To(Equal(int64(1e16)))
+		})
+
+		It("should reject non-numeric values", func() {
+			_, err := parseSeedLabelValue("foo")
+			Expect(err).To(MatchError(`value "foo" is not numeric`))
+			_, err = parseSeedLabelValue("NaN")
+			Expect(err).To(MatchError(`value "NaN" is not numeric`))
+		})
+
+		It("should reject out-of-range values", func() {
+			for _, value := range []string{"1.1e13", "-1e19", "Inf", "-Inf"} {
+				_, err := parseSeedLabelValue(value)
+				Expect(err).To(MatchError(ContainSubstring("is out of range")), value)
+			}
+		})
+	})
+
+	Describe("#scoresToString", func() {
+		It("should only include the top scores", func() {
+			var scores []SeedScore
+			for i := range 7 {
+				scores = append(scores, SeedScore{SeedName: fmt.Sprintf("seed-%d", i), Total: int64(i * 10)})
+			}
+
+			Expect(scoresToString(scores)).To(Equal("{seed-6 => 60 (), seed-5 => 50 (), seed-4 => 40 (), seed-3 => 30 (), seed-2 => 20 (), and 2 more}"))
+		})
+	})
+
+	Describe("#resourceUtilization", func() {
+		expectUtilization := func(seed *gardencorev1beta1.Seed, resourceName corev1.ResourceName, shoots int) Assertion {
+			utilization, ok := resourceUtilization(seed, resourceName, shoots)
+			ExpectWithOffset(1, ok).To(BeTrue())
+			return ExpectWithOffset(1, utilization)
+		}
+
+		It("should return the utilization in per mille", func() {
+			seeds[0].Status.Allocatable = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10")}
+			seeds[0].Status.Usage = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2500m")}
+
+			expectUtilization(&seeds[0], corev1.ResourceCPU, 0).To(Equal(int64(250)))
+			expectUtilization(&seeds[0], corev1.ResourceCPU, 5).To(Equal(int64(300)))
+		})
+
+		It("should not overflow for large amounts of memory", func() {
+			seeds[0].Status.Allocatable = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Ti")}
+			seeds[0].Status.Usage = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("16Ti")}
+
+			expectUtilization(&seeds[0], corev1.ResourceMemory, 0).To(Equal(int64(250)))
+			expectUtilization(&seeds[0], corev1.ResourceMemory, 4).To(Equal(int64(313)))
+		})
+
+		It("should return false if the seed does not report its usage or allocatable resources", func() {
+			_, ok := resourceUtilization(&seeds[0], corev1.ResourceMemory, 0)
+			Expect(ok).To(BeFalse())
+
+			seeds[0].Status.Usage = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("16Ti")}
+			_, ok = resourceUtilization(&seeds[0], corev1.ResourceMemory, 0)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("#filterSeedsByResourceUtilization", func() {
+		BeforeEach(func() {
+			seeds[0].Status.Allocatable = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10"), corev1.ResourceMemory: resource.MustParse("40Gi")}
+			seeds[0].Status.Usage = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("7"), corev1.ResourceMemory: resource.MustParse("20Gi")}
+			seeds[1].Status.Allocatable = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10"), corev1.ResourceMemory: resource.MustParse("40Gi")}
+			seeds[1].Status.Usage = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourceMemory: resource.MustParse("20Gi")}
+		})
+
+		It("should not filter seeds if no threshold is configured", func() {
+			Expect(filterSeedsByResourceUtilization(seeds, sc.seedUsage, nil)).To(Equal(seeds))
+		})
+
+		It("should filter seeds which would exceed the threshold", func() {
+			// seed-1 would reach 77% CPU utilization (7 cores + 0.7 cores per shoot), seed-2 would reach 60% memory
+			// utilization (20Gi + 4Gi per shoot), seed-3 does not report its usage.
+			Expect(filterSeedsByResourceUtilization(seeds, sc.seedUsage, ptr.To[int32](75))).To(Equal([]gardencorev1beta1.Seed{seeds[1], seeds[2]}))
+			Expect(filterSeedsByResourceUtilization(seeds, sc.seedUsage, ptr.To[int32](55))).To(Equal([]gardencorev1beta1.Seed{seeds[2]}))
+		})
+
+		It("should return an error if all seeds would exceed the threshold", func() {
+			_, err := filterSeedsByResourceUtilization(seeds[:2], sc.seedUsage, ptr.To[int32](50))
+			Expect(err).To(MatchError("0/2 seed cluster candidate(s) are eligible for scheduling: {seed-1 => seed would exceed the cpu utilization threshold (77.0% > 50%), seed-2 => seed would exceed the memory utilization threshold (60.0% > 50%)}"))
+		})
+	})
+
+	Describe("#filterExcludedSeeds", func() {
+		It("should not filter seeds if no seed is excluded", func() {
+			Expect(filterExcludedSeeds(seeds, nil)).To(Equal(seeds))
+		})
+
+		It("should filter the excluded seeds", func() {
+			Expect(filterExcludedSeeds(seeds, sets.New("seed-1", "seed-3"))).To(Equal([]gardencorev1beta1.Seed{seeds[1]}))
+		})
+
+		It("should return an error if all seeds are excluded", func() {
+			_, err := filterExcludedSeeds(seeds[:1], sets.New("seed-1"))
+			Expect(err).To(MatchError("all of the 1 seeds are excluded from scheduling"))
+		})
+	})
+})
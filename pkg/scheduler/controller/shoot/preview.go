@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// PreviewHandlerPath is the HTTP handler path for previewing the scheduling decision for a shoot.
+const PreviewHandlerPath = "/schedule/preview"
+
+// maxPreviewRequestBytes is the maximum size of a Shoot manifest accepted by the preview handler.
+const maxPreviewRequestBytes = 1 << 20
+
+// SchedulingPreview is the response of the preview handler.
+type SchedulingPreview struct {
+	// Seed is the name of the seed the shoot would be scheduled to. It is empty if no seed is suitable.
+	Seed string `json:"seed,omitempty"`
+	// Error is the reason why no seed is suitable for the shoot.
+	Error string `json:"error,omitempty"`
+	// Scores are the scores of all seed candidates which passed the filter plugins.
+	Scores []SeedScore `json:"scores,omitempty"`
+	// Rejections maps the names of the seeds which were removed by the filter plugins to the reason of their rejection.
+	Rejections map[string]string `json:"rejections,omitempty"`
+}
+
+// Preview returns the scheduling decision for the given shoot without binding it to a seed.
+func (r *Reconciler) Preview(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*SchedulingPreview, error) {
+	result, err := r.Schedule(ctx, log, shoot)
+	if result == nil {
+		return nil, err
+	}
+
+	preview := &SchedulingPreview{
+		Scores:     result.Scores,
+		Rejections: result.Rejections,
+	}
+	if err != nil {
+		preview.Error = err.Error()
+	}
+	if result.Seed != nil {
+		preview.Seed = result.Seed.Name
+	}
+	return preview, nil
+}
+
+// PreviewHandler returns an HTTP handler which accepts a Shoot manifest (JSON or YAML) via POST requests and responds
+// with the SchedulingPreview for it.
+func (r *Reconciler) PreviewHandler(log logr.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "only POST requests are supported", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(req.Body, maxPreviewRequestBytes+1))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed reading request body: %v", err), http.StatusBadRequest)
+			return
+		}
+		if len(body) > maxPreviewRequestBytes {
+			http.Error(w, "request body is too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		shoot := &gardencorev1beta1.Shoot{}
+		if err := yaml.Unmarshal(body, shoot); err != nil {
+			http.Error(w, fmt.Sprintf("failed decoding shoot: %v", err), http.StatusBadRequest)
+			return
+		}
+		if err := validatePreviewShoot(shoot); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		previewLog := log.WithValues("shoot", client.ObjectKeyFromObject(shoot))
+
+		userInfo, ok := request.UserFrom(req.Context())
+		if !ok {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if allowed, err := r.canCreateShoots(req.Context(), userInfo, shoot.Namespace); err != nil {
+			previewLog.Error(err, "Failed checking whether user is allowed to create shoots", "user", userInfo.GetName())
+			http.Error(w, fmt.Sprintf("failed checking whether user %s is allowed to create shoots: %v", userInfo.GetName(), err), http.StatusInternalServerError)
+			return
+		} else if !allowed {
+			http.Error(w, fmt.Sprintf("user %s is not allowed to create shoots in namespace %s", userInfo.GetName(), shoot.Namespace), http.StatusForbidden)
+			return
+		}
+
+		preview, err := r.Preview(req.Context(), previewLog, shoot)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			previewLog.Error(err, "Failed previewing scheduling decision")
+			http.Error(w, fmt.Sprintf("failed previewing scheduling decision: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(preview); err != nil {
+			previewLog.Error(err, "Failed writing response")
+		}
+	}
+}
+
+// canCreateShoots checks via a SubjectAccessReview whether the given user is allowed to create shoots in the given
+// namespace. Previews are only served for users which could create the shoot as well, since they reveal information
+// about seeds.
+func (r *Reconciler) canCreateShoots(ctx context.Context, userInfo user.Info, namespace string) (bool, error) {
+	extra := make(map[string]authorizationv1.ExtraValue, len(userInfo.GetExtra()))
+	for key, values := range userInfo.GetExtra() {
+		extra[key] = values
+	}
+
+	subjectAccessReview := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   userInfo.GetName(),
+			UID:    userInfo.GetUID(),
+			Groups: userInfo.GetGroups(),
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      "create",
+				Group:     gardencorev1beta1.SchemeGroupVersion.Group,
+				Resource:  "shoots",
+			},
+		},
+	}
+	if err := r.Client.Create(ctx, subjectAccessReview); err != nil {
+		return false, err
+	}
+
+	return subjectAccessReview.Status.Allowed, nil
+}
+
+// validatePreviewShoot checks that the given shoot contains all fields the scheduler relies on.
+func validatePreviewShoot(shoot *gardencorev1beta1.Shoot) error {
+	switch {
+	case shoot.Namespace == "":
+		return fmt.Errorf("shoot namespace must be set")
+	case gardenerutils.BuildV1beta1CloudProfileReference(shoot) == nil:
+		return fmt.Errorf("shoot cloud profile must be set")
+	case shoot.Spec.Region == "":
+		return fmt.Errorf("shoot region must be set")
+	case shoot.Spec.Provider.Type == "":
+		return fmt.Errorf("shoot provider type must be set")
+	}
+	return nil
+}
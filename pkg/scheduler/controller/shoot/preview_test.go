@@ -0,0 +1,302 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+var _ = Describe("Preview", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		subjectAccessReviews []*authorizationv1.SubjectAccessReview
+		allowed              bool
+
+		shoot *gardencorev1beta1.Shoot
+
+		newSeed = func(name, region string, taints ...gardencorev1beta1.SeedTaint) *gardencorev1beta1.Seed {
+			return &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: gardencorev1beta1.SeedSpec{
+					Provider: gardencorev1beta1.SeedProvider{Type: "local", Region: region},
+					Networks: gardencorev1beta1.SeedNetworks{Pods: "10.20.0.0/16", Services: "10.30.0.0/16"},
+					Settings: &gardencorev1beta1.SeedSettings{Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true}},
+					Taints:   taints,
+				},
+				Status: gardencorev1beta1.SeedStatus{
+					Conditions:    []gardencorev1beta1.Condition{{Type: gardencorev1beta1.GardenletReady, Status: gardencorev1beta1.ConditionTrue}},
+					LastOperation: &gardencorev1beta1.LastOperation{},
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		subjectAccessReviews = nil
+		allowed = true
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Project{}, gardencore.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					if subjectAccessReview, ok := obj.(*authorizationv1.SubjectAccessReview); ok {
+						subjectAccessReviews = append(subjectAccessReviews, subjectAccessReview.DeepCopy())
+						subjectAccessReview.Status.Allowed = allowed
+						return nil
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+			}).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.SameRegion},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: ptr.To("local"),
+				Region:           "eu-west-1",
+				Provider:         gardencorev1beta1.Provider{Type: "local"},
+				Networking:       &gardencorev1beta1.Networking{Pods: ptr.To("10.50.0.0/16"), Services: ptr.To("10.60.0.0/16")},
+			},
+		}
+
+		for _, obj := range []client.Object{
+			&gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "local"}},
+			&gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "dev"}, Spec: gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-dev")}},
+			newSeed("seed-1", "eu-west-1"),
+			newSeed("seed-2", "eu-west-1", gardencorev1beta1.SeedTaint{Key: "foo"}),
+			newSeed("seed-3", "us-east-1"),
+		} {
+			Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+		}
+	})
+
+	Describe("#Preview", func() {
+		It("should return the chosen seed and the rejection reasons", func() {
+			preview, err := reconciler.Preview(ctx, logr.Discard(), shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(preview.Seed).To(Equal("seed-1"))
+			Expect(preview.Error).To(BeEmpty())
+			Expect(preview.Scores).To(ConsistOf(SeedScore{SeedName: "seed-1", Total: 100, PluginScores: []PluginScore{{Plugin: "LeastShoots", Score: 100}}}))
+			Expect(preview.Rejections).To(Equal(map[string]string{
+				"seed-2": "shoot does not tolerate the seed's taints",
+				"seed-3": "no matching seed candidate found for Configuration (Cloud Profile 'local', Region 'eu-west-1', SeedDeterminationStrategy 'SameRegion')",
+			}))
+		})
+
+		It("should return the error and the rejection reasons if no seed is suitable", func() {
+			shoot.Spec.Region = "ap-south-1"
+
+			preview, err := reconciler.Preview(ctx, logr.Discard(), shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(preview.Seed).To(BeEmpty())
+			Expect(preview.Error).To(ContainSubstring("no matching seed candidate found"))
+			Expect(preview.Scores).To(BeEmpty())
+			Expect(preview.Rejections).To(Equal(map[string]string{
+				"seed-1": "no matching seed candidate found for Configuration (Cloud Profile 'local', Region 'ap-south-1', SeedDeterminationStrategy 'SameRegion')",
+				"seed-2": "shoot does not tolerate the seed's taints",
+				"seed-3": "no matching seed candidate found for Configuration (Cloud Profile 'local', Region 'ap-south-1', SeedDeterminationStrategy 'SameRegion')",
+			}))
+		})
+
+		It("should not choose excluded seeds", func() {
+			shoot.Spec.Tolerations = []gardencorev1beta1.Toleration{{Key: "foo"}}
+
+			result, err := reconciler.Schedule(ctx, logr.Discard(), shoot, WithExcludedSeeds("seed-1"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Seed.Name).To(Equal("seed-2"))
+			Expect(result.Rejections).To(HaveKeyWithValue("seed-1", `seed "seed-1" is excluded from scheduling`))
+		})
+
+		It("should not bind the shoot", func() {
+			_, err := reconciler.Preview(ctx, logr.Discard(), shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(shoot.Spec.SeedName).To(BeNil())
+		})
+	})
+
+	Describe("#PreviewHandler", func() {
+		var (
+			handler  http.HandlerFunc
+			userInfo *user.DefaultInfo
+
+			newRequest = func(method string, body io.Reader) *http.Request {
+				req := httptest.NewRequest(method, PreviewHandlerPath, body)
+				return req.WithContext(request.WithUser(req.Context(), userInfo))
+			}
+		)
+
+		BeforeEach(func() {
+			handler = reconciler.PreviewHandler(logr.Discard())
+			userInfo = &user.DefaultInfo{Name: "foo", UID: "1", Groups: []string{"bar"}, Extra: map[string][]string{"baz": {"qux"}}}
+		})
+
+		It("should respond with the preview for the given shoot", func() {
+			body, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			recorder := httptest.NewRecorder()
+			handler(recorder, newRequest(http.MethodPost, strings.NewReader(string(body))))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+
+			preview := &SchedulingPreview{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), preview)).To(Succeed())
+			Expect(preview.Seed).To(Equal("seed-1"))
+			Expect(preview.Rejections).To(HaveLen(2))
+
+			Expect(subjectAccessReviews).To(ConsistOf(&authorizationv1.SubjectAccessReview{
+				Spec: authorizationv1.SubjectAccessReviewSpec{
+					User:   "foo",
+					UID:    "1",
+					Groups: []string{"bar"},
+					Extra:  map[string]authorizationv1.ExtraValue{"baz": {"qux"}},
+					ResourceAttributes: &authorizationv1.ResourceAttributes{
+						Namespace: "garden-dev",
+						Verb:      "create",
+						Group:     "core.gardener.cloud",
+						Resource:  "shoots",
+					},
+				},
+			}))
+		})
+
+		It("should reject users which are not allowed to create shoots in the namespace", func() {
+			allowed = false
+
+			body, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			recorder := httptest.NewRecorder()
+			handler(recorder, newRequest(http.MethodPost, strings.NewReader(string(body))))
+
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			Expect(recorder.Body.String()).To(ContainSubstring("user foo is not allowed to create shoots in namespace garden-dev"))
+		})
+
+		It("should reject unauthenticated requests", func() {
+			body, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			recorder := httptest.NewRecorder()
+			handler(recorder, httptest.NewRequest(http.MethodPost, PreviewHandlerPath, strings.NewReader(string(body))))
+
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+			Expect(subjectAccessReviews).To(BeEmpty())
+		})
+
+		It("should accept YAML manifests", func() {
+			recorder := httptest.NewRecorder()
+			handler(recorder, newRequest(http.MethodPost, strings.NewReader(`apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: shoot
+  namespace: garden-dev
+spec:
+  cloudProfileName: local
+  region: eu-west-1
+  provider:
+    type: local
+`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"seed":"seed-1"`))
+		})
+
+		It("should reject other methods than POST", func() {
+			recorder := httptest.NewRecorder()
+			handler(recorder, newRequest(http.MethodGet, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+		})
+
+		It("should reject invalid manifests", func() {
+			recorder := httptest.NewRecorder()
+			handler(recorder, newRequest(http.MethodPost, strings.NewReader("{")))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should reject shoots without namespace", func() {
+			recorder := httptest.NewRecorder()
+			handler(recorder, newRequest(http.MethodPost, strings.NewReader(`{"metadata":{"name":"shoot"}}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(recorder.Body.String()).To(ContainSubstring("shoot namespace must be set"))
+		})
+
+		It("should reject empty requests", func() {
+			recorder := httptest.NewRecorder()
+			handler(recorder, newRequest(http.MethodPost, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should reject shoots without cloud profile", func() {
+			recorder := httptest.NewRecorder()
+			handler(recorder, newRequest(http.MethodPost, strings.NewReader(`{"metadata":{"name":"shoot","namespace":"garden-dev"},"spec":{"region":"eu-west-1"}}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(recorder.Body.String()).To(ContainSubstring("shoot cloud profile must be set"))
+		})
+
+		It("should reject shoots without region", func() {
+			recorder := httptest.NewRecorder()
+			handler(recorder, newRequest(http.MethodPost, strings.NewReader(`{"metadata":{"name":"shoot","namespace":"garden-dev"},"spec":{"cloudProfileName":"local"}}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(recorder.Body.String()).To(ContainSubstring("shoot region must be set"))
+		})
+
+		It("should respond with not found if the cloud profile does not exist", func() {
+			recorder := httptest.NewRecorder()
+			handler(recorder, newRequest(http.MethodPost, strings.NewReader(`{"metadata":{"name":"shoot","namespace":"garden-dev"},"spec":{"cloudProfileName":"foo","region":"eu-west-1","provider":{"type":"local"}}}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("should respond with not found if the project does not exist", func() {
+			recorder := httptest.NewRecorder()
+			handler(recorder, newRequest(http.MethodPost, strings.NewReader(`{"metadata":{"name":"shoot","namespace":"garden-foo"},"spec":{"cloudProfileName":"local","region":"eu-west-1","provider":{"type":"local"}}}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/apis/apiserver"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/authenticatorfactory"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/authorization/authorizerfactory"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	authenticationv1 "k8s.io/client-go/kubernetes/typed/authentication/v1"
+	authorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/client-go/rest"
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// webhookRetryBackoff is the backoff for retrying TokenReviews and SubjectAccessReviews, see
+// k8s.io/apiserver/pkg/server/options.DefaultAuthWebhookRetryBackoff.
+var webhookRetryBackoff = wait.Backoff{
+	Duration: 500 * time.Millisecond,
+	Factor:   1.5,
+	Jitter:   0.2,
+	Steps:    5,
+}
+
+// previewServer serves the preview handler via HTTPS. Contrary to the servers of the manager, it is not bound to a
+// port before it is started.
+type previewServer struct {
+	address string
+	handler http.Handler
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. The preview handler is served by all replicas since it
+// does not change any state.
+func (p *previewServer) NeedLeaderElection() bool {
+	return false
+}
+
+// Start implements manager.Runnable.
+func (p *previewServer) Start(ctx context.Context) error {
+	// The server is served with a self-signed certificate like the metrics server of controller-runtime, clients are
+	// expected to authenticate via bearer tokens.
+	certificate, key, err := certutil.GenerateSelfSignedCertKeyWithFixtures("localhost", []net.IP{{127, 0, 0, 1}}, nil, "")
+	if err != nil {
+		return fmt.Errorf("failed generating self-signed certificate: %w", err)
+	}
+	keyPair, err := tls.X509KeyPair(certificate, key)
+	if err != nil {
+		return fmt.Errorf("failed loading self-signed certificate: %w", err)
+	}
+
+	listener, err := tls.Listen("tcp", p.address, &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		MinVersion:   tls.VersionTLS12,
+		NextProtos:   []string{"h2"},
+	})
+	if err != nil {
+		return fmt.Errorf("failed listening on %s: %w", p.address, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(PreviewHandlerPath, p.handler)
+
+	return (&manager.Server{
+		Name: "schedule-preview",
+		Server: &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: 32 * time.Second,
+		},
+		Listener:        listener,
+		ShutdownTimeout: ptr.To(5 * time.Second),
+	}).Start(ctx)
+}
+
+// addPreviewServerToManager adds a server to the given manager which serves the preview handler on the configured
+// address. Requests are authenticated via TokenReviews and authorized via SubjectAccessReviews for the handler path.
+func (r *Reconciler) addPreviewServerToManager(mgr manager.Manager, log logr.Logger) error {
+	authn, authz, err := newDelegatingAuthenticatorAndAuthorizer(mgr.GetConfig(), mgr.GetHTTPClient())
+	if err != nil {
+		return err
+	}
+
+	return mgr.Add(&previewServer{
+		address: net.JoinHostPort(r.PreviewServer.BindAddress, strconv.Itoa(r.PreviewServer.Port)),
+		handler: withAuthenticationAndAuthorization(log, authn, authz, r.PreviewHandler(log)),
+	})
+}
+
+func newDelegatingAuthenticatorAndAuthorizer(config *rest.Config, httpClient *http.Client) (authenticator.Request, authorizer.Authorizer, error) {
+	authenticationClient, err := authenticationv1.NewForConfigAndClient(config, httpClient)
+	if err != nil {
+		return nil, nil, err
+	}
+	authorizationClient, err := authorizationv1.NewForConfigAndClient(config, httpClient)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authn, _, err := authenticatorfactory.DelegatingAuthenticatorConfig{
+		Anonymous:                &apiserver.AnonymousAuthConfig{Enabled: false},
+		CacheTTL:                 time.Minute,
+		TokenAccessReviewClient:  authenticationClient,
+		TokenAccessReviewTimeout: 10 * time.Second,
+		WebhookRetryBackoff:      &webhookRetryBackoff,
+	}.New()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating authenticator: %w", err)
+	}
+
+	authz, err := authorizerfactory.DelegatingAuthorizerConfig{
+		SubjectAccessReviewClient: authorizationClient,
+		AllowCacheTTL:             5 * time.Minute,
+		DenyCacheTTL:              30 * time.Second,
+		WebhookRetryBackoff:       &webhookRetryBackoff,
+	}.New()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating authorizer: %w", err)
+	}
+
+	return authn, authz, nil
+}
+
+// withAuthenticationAndAuthorization returns an HTTP handler which authenticates requests with the given authenticator
+// and authorizes them for the request path with the given authorizer before passing them to the given handler. The
+// authenticated user is added to the request context, see request.UserFrom.
+func withAuthenticationAndAuthorization(log logr.Logger, authn authenticator.Request, authz authorizer.Authorizer, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		res, ok, err := authn.AuthenticateRequest(req)
+		if err != nil {
+			log.Error(err, "Authentication failed")
+			http.Error(w, "Authentication failed", http.StatusInternalServerError)
+			return
+		}
+		if !ok {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		decision, reason, err := authz.Authorize(req.Context(), authorizer.AttributesRecord{
+			User: res.User,
+			Verb: strings.ToLower(req.Method),
+			Path: req.URL.Path,
+		})
+		if err != nil {
+			log.Error(err, "Authorization failed", "user", res.User.GetName())
+			http.Error(w, fmt.Sprintf("Authorization for user %s failed", res.User.GetName()), http.StatusInternalServerError)
+			return
+		}
+		if decision != authorizer.DecisionAllow {
+			log.V(1).Info("Authorization denied", "user", res.User.GetName(), "reason", reason)
+			http.Error(w, fmt.Sprintf("Authorization denied for user %s", res.User.GetName()), http.StatusForbidden)
+			return
+		}
+
+		handler.ServeHTTP(w, req.WithContext(request.WithUser(req.Context(), res.User)))
+	})
+}
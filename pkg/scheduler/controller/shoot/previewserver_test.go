@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+var _ = Describe("PreviewServer", func() {
+	Describe("#withAuthenticationAndAuthorization", func() {
+		var (
+			authenticated bool
+			authnErr      error
+			decision      authorizer.Decision
+			authzErr      error
+			attributes    authorizer.Attributes
+
+			handledUser user.Info
+			handler     http.Handler
+		)
+
+		BeforeEach(func() {
+			authenticated = true
+			authnErr = nil
+			decision = authorizer.DecisionAllow
+			authzErr = nil
+			attributes = nil
+			handledUser = nil
+
+			authn := authenticator.RequestFunc(func(*http.Request) (*authenticator.Response, bool, error) {
+				return &authenticator.Response{User: &user.DefaultInfo{Name: "foo"}}, authenticated, authnErr
+			})
+			authz := authorizer.AuthorizerFunc(func(_ context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+				attributes = a
+				return decision, "", authzErr
+			})
+
+			handler = withAuthenticationAndAuthorization(logr.Discard(), authn, authz, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				handledUser, _ = request.UserFrom(req.Context())
+				w.WriteHeader(http.StatusOK)
+			}))
+		})
+
+		serve := func() int {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, PreviewHandlerPath, nil))
+			return recorder.Code
+		}
+
+		It("should pass authorized requests with the authenticated user to the handler", func() {
+			Expect(serve()).To(Equal(http.StatusOK))
+			Expect(handledUser.GetName()).To(Equal("foo"))
+			Expect(attributes.GetVerb()).To(Equal("post"))
+			Expect(attributes.GetPath()).To(Equal(PreviewHandlerPath))
+			Expect(attributes.GetUser().GetName()).To(Equal("foo"))
+		})
+
+		It("should reject unauthenticated requests", func() {
+			authenticated = false
+
+			Expect(serve()).To(Equal(http.StatusUnauthorized))
+			Expect(handledUser).To(BeNil())
+		})
+
+		It("should fail if the authentication fails", func() {
+			authnErr = fmt.Errorf("fake")
+
+			Expect(serve()).To(Equal(http.StatusInternalServerError))
+			Expect(handledUser).To(BeNil())
+		})
+
+		It("should reject unauthorized requests", func() {
+			decision = authorizer.DecisionNoOpinion
+
+			Expect(serve()).To(Equal(http.StatusForbidden))
+			Expect(handledUser).To(BeNil())
+		})
+
+		It("should fail if the authorization fails", func() {
+			authzErr = fmt.Errorf("fake")
+
+			Expect(serve()).To(Equal(http.StatusInternalServerError))
+			Expect(handledUser).To(BeNil())
+		})
+	})
+})
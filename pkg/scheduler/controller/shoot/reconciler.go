@@ -18,6 +18,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,6 +40,9 @@ type Reconciler struct {
 	Config          *schedulerconfigv1alpha1.ShootSchedulerConfiguration
 	GardenNamespace string
 	Recorder        record.EventRecorder
+	// PreviewServer is the configuration of the server for the preview handler. It is only used if the preview handler
+	// is enabled.
+	PreviewServer *schedulerconfigv1alpha1.Server
 }
 
 // Reconcile schedules shoots to seeds.
@@ -65,11 +69,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	// If no Seed is referenced, we try to determine an adequate one.
-	seed, err := r.DetermineSeed(ctx, log, shoot)
+	result, err := r.Schedule(ctx, log, shoot)
 	if err != nil {
 		r.reportFailedScheduling(ctx, log, shoot, err)
 		return reconcile.Result{}, fmt.Errorf("failed to determine seed for shoot: %w", err)
 	}
+	seed := result.Seed
 
 	shoot.Spec.SeedName = &seed.Name
 	if err = r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
@@ -85,7 +90,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		"strategy", r.Config.Strategy,
 	)
 
-	r.reportEvent(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventSchedulingSuccessful, "Scheduled to seed '%s'", seed.Name)
+	r.reportEvent(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventSchedulingSuccessful, "Scheduled to seed '%s' (scores: %s)", seed.Name, scoresToString(result.Scores))
 	return reconcile.Result{}, nil
 }
 
@@ -110,6 +115,16 @@ func (r *Reconciler) reportEvent(shoot *gardencorev1beta1.Shoot, eventType strin
 	r.Recorder.Eventf(shoot, eventType, eventReason, messageFmt, args...)
 }
 
+// SchedulingResult is the result of scheduling a shoot.
+type SchedulingResult struct {
+	// Seed is the chosen seed.
+	Seed *gardencorev1beta1.Seed
+	// Scores are the scores of all seed candidates which passed the filter plugins.
+	Scores []SeedScore
+	// Rejections maps the names of the seeds which were removed by the filter plugins to the reason of their rejection.
+	Rejections map[string]string
+}
+
 // DetermineSeed returns an appropriate Seed cluster (or nil).
 func (r *Reconciler) DetermineSeed(
 	ctx context.Context,
@@ -119,6 +134,17 @@ func (r *Reconciler) DetermineSeed(
 	*gardencorev1beta1.Seed,
 	error,
 ) {
+	result, err := r.Schedule(ctx, log, shoot)
+	if err != nil {
+		return nil, err
+	}
+	return result.Seed, nil
+}
+
+// Schedule runs all filter plugins on the existing seeds, scores the remaining candidates with the configured score
+// plugins, and returns the seed with the highest score. If no seed could be chosen, the result with the scores and the
+// rejected seeds is returned together with the error (unless the error occurred before the plugins were run).
+func (r *Reconciler) Schedule(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, opts ...ScheduleOption) (*SchedulingResult, error) {
 	seedList := &gardencorev1beta1.SeedList{}
 	if err := r.Client.List(ctx, seedList); err != nil {
 		return nil, err
@@ -143,47 +169,34 @@ func (r *Reconciler) DetermineSeed(
 		return nil, err
 	}
 
-	filteredSeeds, err := filterUsableSeeds(seedList.Items)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, shoot.Spec.SeedSelector, "Shoot")
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingProviders(cloudProfile, shoot, filteredSeeds)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsForZonalShootControlPlanes(filteredSeeds, shoot)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsForAccessRestrictions(filteredSeeds, shoot)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingDomain(filteredSeeds, shoot, project.Name)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsWithDisabledShootReconciliations(filteredSeeds)
-	if err != nil {
-		return nil, err
+	sc := &schedulingContext{
+		log:             log,
+		shoot:           shoot,
+		shootList:       shootList,
+		seedUsage:       v1beta1helper.CalculateSeedUsage(shootList),
+		cloudProfile:    cloudProfile,
+		regionConfig:    regionConfig,
+		projectName:     project.Name,
+		regionDistances: regionDistances(regionConfig, shoot.Spec.Region),
+		strategy:        r.Config.Strategy,
+
+		resourceUtilizationThreshold: r.Config.ResourceUtilizationThreshold,
 	}
-	filteredSeeds, err = filterCandidates(shoot, shootList, filteredSeeds)
-	if err != nil {
-		return nil, err
+	for _, opt := range opts {
+		opt(sc)
 	}
-	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
+
+	filteredSeeds, rejections, err := runFilterPlugins(sc, newFilterPlugins(r.Config), seedList.Items)
 	if err != nil {
-		return nil, err
+		return &SchedulingResult{Rejections: rejections}, err
 	}
-	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList)
+
+	scores := scoreSeeds(sc, newScorePlugins(r.Config), filteredSeeds)
+	return &SchedulingResult{
+		Seed:       &filteredSeeds[bestSeedScore(scores)],
+		Scores:     scores,
+		Rejections: rejections,
+	}, nil
 }
 
 func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cloudProfile *gardencorev1beta1.CloudProfile) (*corev1.ConfigMap, error) {
@@ -214,6 +227,25 @@ func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cl
 	return regionConfig, nil
 }
 
+// filterExcludedSeeds removes the seeds with the given names.
+func filterExcludedSeeds(seedList []gardencorev1beta1.Seed, excludedSeeds sets.Set[string]) ([]gardencorev1beta1.Seed, error) {
+	if excludedSeeds.Len() == 0 {
+		return seedList, nil
+	}
+
+	var remainingSeeds []gardencorev1beta1.Seed
+	for _, seed := range seedList {
+		if !excludedSeeds.Has(seed.Name) {
+			remainingSeeds = append(remainingSeeds, seed)
+		}
+	}
+
+	if len(remainingSeeds) == 0 {
+		return nil, fmt.Errorf("all of the %d seeds are excluded from scheduling", len(seedList))
+	}
+	return remainingSeeds, nil
+}
+
 func isUsableSeed(seed *gardencorev1beta1.Seed) bool {
 	return seed.DeletionTimestamp == nil && seed.Spec.Settings.Scheduling.Visible && verifySeedReadiness(seed)
 }
@@ -400,20 +432,56 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 	)
 
 	for _, seed := range seedList {
-		if shoot.Spec.Networking != nil {
-			if disjointed, err := networksAreDisjointed(&seed, shoot); !disjointed {
-				seedNameToErr[seed.Name] = err
-				continue
-			}
+		if err := checkCandidate(&seed, shoot, seedUsage); err != nil {
+			seedNameToErr[seed.Name] = err
+			continue
 		}
 
-		if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, shoot.Spec.Tolerations) {
-			seedNameToErr[seed.Name] = errors.New("shoot does not tolerate the seed's taints")
-			continue
+		candidates = append(candidates, seed)
+	}
+
+	if candidates == nil {
+		return nil, fmt.Errorf("0/%d seed cluster candidate(s) are eligible for scheduling: %v", len(seedList), errorMapToString(seedNameToErr))
+	}
+	return candidates, nil
+}
+
+// checkCandidate returns an error if the networks of the given seed and shoot are not disjoint, if the shoot does not
+// tolerate the seed's taints, or if the seed does not have available capacity for shoots.
+func checkCandidate(seed *gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, seedUsage map[string]int) error {
+	if shoot.Spec.Networking != nil {
+		if disjointed, err := networksAreDisjointed(seed, shoot); !disjointed {
+			return err
 		}
+	}
+
+	if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, shoot.Spec.Tolerations) {
+		return errors.New("shoot does not tolerate the seed's taints")
+	}
+
+	if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(seedUsage[seed.Name]) >= allocatableShoots.Value() {
+		return errors.New("seed does not have available capacity for shoots")
+	}
+
+	return nil
+}
+
+// filterSeedsByResourceUtilization removes the seeds whose CPU or memory utilization would exceed the given threshold
+// (in percent) if the shoot was scheduled to them. The resource requests of the shoot's control plane are estimated
+// as the average usage of the shoots already running on the seed. Seeds which do not report their usage are kept.
+func filterSeedsByResourceUtilization(seedList []gardencorev1beta1.Seed, seedUsage map[string]int, threshold *int32) ([]gardencorev1beta1.Seed, error) {
+	if threshold == nil {
+		return seedList, nil
+	}
 
-		if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(seedUsage[seed.Name]) >= allocatableShoots.Value() {
-			seedNameToErr[seed.Name] = errors.New("seed does not have available capacity for shoots")
+	var (
+		candidates    []gardencorev1beta1.Seed
+		seedNameToErr = make(map[string]error)
+	)
+
+	for _, seed := range seedList {
+		if err := checkResourceUtilization(&seed, seedUsage[seed.Name], threshold); err != nil {
+			seedNameToErr[seed.Name] = err
 			continue
 		}
 
@@ -426,22 +494,56 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 	return candidates, nil
 }
 
-// getSeedWithLeastShootsDeployed finds the best candidate (i.e. the one managing the smallest number of shoots right now).
-func getSeedWithLeastShootsDeployed(seedList []gardencorev1beta1.Seed, shootList []*gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
-	var (
-		bestCandidate gardencorev1beta1.Seed
-		min           *int
-		seedUsage     = v1beta1helper.CalculateSeedUsage(shootList)
-	)
+// checkResourceUtilization returns an error if the CPU or memory utilization of the given seed would exceed the given
+// threshold (in percent) if another shoot was scheduled to it.
+func checkResourceUtilization(seed *gardencorev1beta1.Seed, shoots int, threshold *int32) error {
+	if threshold == nil {
+		return nil
+	}
 
-	for _, seed := range seedList {
-		if numberOfManagedShoots := seedUsage[seed.Name]; min == nil || numberOfManagedShoots < *min {
-			bestCandidate = seed
-			min = &numberOfManagedShoots
+	for _, resourceName := range utilizationResourceNames {
+		if utilization, ok := resourceUtilization(seed, resourceName, shoots); ok && utilization > int64(*threshold)*10 {
+			return fmt.Errorf("seed would exceed the %s utilization threshold (%.1f%% > %d%%)", resourceName, float64(utilization)/10, *threshold)
 		}
 	}
+	return nil
+}
+
+// CheckSeedResourceUtilization returns an error if the current CPU or memory utilization of the given seed exceeds the
+// given threshold (in percent).
+func CheckSeedResourceUtilization(seed *gardencorev1beta1.Seed, threshold int32) error {
+	for _, resourceName := range utilizationResourceNames {
+		if utilization, ok := resourceUtilization(seed, resourceName, 0); ok && utilization > int64(threshold)*10 {
+			return fmt.Errorf("seed exceeds the %s utilization threshold (%.1f%% > %d%%)", resourceName, float64(utilization)/10, threshold)
+		}
+	}
+	return nil
+}
+
+// utilizationResourceNames are the resources considered for the utilization of seeds.
+var utilizationResourceNames = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+// resourceUtilization returns the utilization of the given resource of the seed in per mille. If the number of shoots
+// running on the seed is positive, their average usage is added to estimate the utilization after scheduling another
+// shoot to the seed. It returns false if the seed does not report its usage or allocatable amount of the resource.
+func resourceUtilization(seed *gardencorev1beta1.Seed, resourceName corev1.ResourceName, shoots int) (int64, bool) {
+	usage, ok := seed.Status.Usage[resourceName]
+	if !ok {
+		return 0, false
+	}
+	allocatable, ok := seed.Status.Allocatable[resourceName]
+	if !ok || allocatable.Sign() <= 0 {
+		return 0, false
+	}
+
+	// Compute with floating point numbers since the milli values of large amounts of memory multiplied by 1000 would
+	// overflow int64.
+	expectedUsage := usage.AsApproximateFloat64()
+	if shoots > 0 {
+		expectedUsage += expectedUsage / float64(shoots)
+	}
 
-	return &bestCandidate, nil
+	return int64(math.Round(expectedUsage * 1000 / allocatable.AsApproximateFloat64())), true
 }
 
 func matchProvider(seedProviderType, shootProviderType string, enabledProviderTypes []string) bool {
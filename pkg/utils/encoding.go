@@ -5,6 +5,8 @@
 package utils
 
 import (
+	"crypto"
+	"crypto/ecdsa"
 	"crypto/rsa"
 	"crypto/sha256"
 	"crypto/x509"
@@ -12,6 +14,7 @@ import (
 	"encoding/hex"
 	"encoding/pem"
 	"errors"
+	"fmt"
 	"slices"
 	"strconv"
 
@@ -78,6 +81,71 @@ func DecodePrivateKey(bytes []byte) (*rsa.PrivateKey, error) {
 	return x509.ParsePKCS1PrivateKey(block.Bytes)
 }
 
+// EncodeECPrivateKey takes an ECDSA private key object, encodes it to the SEC 1 PEM format, and returns it as a byte
+// slice.
+func EncodeECPrivateKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	bytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "EC PRIVATE KEY",
+		Bytes: bytes,
+	}), nil
+}
+
+// EncodePKCS8PrivateKey takes a private key object (RSA, ECDSA, or Ed25519), encodes it to the PKCS8 PEM format with
+// the "PRIVATE KEY" block type, and returns it as a byte slice.
+func EncodePKCS8PrivateKey(key crypto.Signer) ([]byte, error) {
+	bytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "PRIVATE KEY",
+		Bytes: bytes,
+	}), nil
+}
+
+// DecodeSigner takes a byte slice, decodes it from the PEM format, and returns the contained RSA, ECDSA, or Ed25519
+// private key. It supports PKCS1 ("RSA PRIVATE KEY"), SEC 1 ("EC PRIVATE KEY"), and PKCS8 ("PRIVATE KEY") encoded
+// keys. In case an error occurs, it returns the error.
+func DecodeSigner(bytes []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(bytes)
+	if block == nil {
+		return nil, errors.New("could not decode the PEM-encoded private key")
+	}
+
+	var (
+		key any
+		err error
+	)
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			// EncodePrivateKeyInPKCS8 uses the "RSA PRIVATE KEY" block type for PKCS8 encoded keys as well.
+			key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		}
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q for private key", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
+
 // EncodeCertificate takes a certificate as a byte slice, encodes it to the PEM format, and returns
 // it as byte slice.
 func EncodeCertificate(certificate []byte) []byte {
@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"cmp"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync/atomic"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+const (
+	// DebugHandlerPath is the HTTP handler path for the flow debug handler.
+	DebugHandlerPath = "/debug/flows"
+
+	// maxRecordedExecutions is the maximum number of recorded executions. If it is exceeded, the least recently
+	// recorded executions are dropped, e.g., the ones of deleted or migrated objects.
+	maxRecordedExecutions = 1000
+	// maxValidatedGraphs is the maximum number of graphs whose validation issues are remembered.
+	maxValidatedGraphs = 100
+)
+
+var recorder atomic.Pointer[executionRecorder]
+
+// RecordedExecution is the recorded most recent execution of a flow, see LastExecution. It only contains the exported
+// graph of the flow, i.e., it does not keep the flow and its tasks alive.
+type RecordedExecution struct {
+	// DOT is the flow rendered in the Graphviz DOT language, see Flow.DOT.
+	DOT string
+	// Mermaid is the flow rendered as Mermaid flowchart, see Flow.Mermaid.
+	Mermaid string
+	// Issues are the issues found by Validate for the flow (without ValidateOpts).
+	Issues []Issue
+	// Stats are the statistics of the execution.
+	Stats *Stats
+}
+
+type executionKey struct {
+	name      string
+	objectKey string
+}
+
+type executionRecorder struct {
+	executions *lru.Cache[executionKey, RecordedExecution]
+	// issues contains the validation issues per checksum of the graph, so that the same graph is validated only once.
+	issues *lru.Cache[string, []Issue]
+}
+
+func newExecutionRecorder() *executionRecorder {
+	executions, err := lru.New[executionKey, RecordedExecution](maxRecordedExecutions)
+	utilruntime.Must(err)
+	issues, err := lru.New[string, []Issue](maxValidatedGraphs)
+	utilruntime.Must(err)
+
+	return &executionRecorder{executions: executions, issues: issues}
+}
+
+// recordExecution records the exported graph of the given Flow and the Stats of its most recent execution for the
+// given object key if the debug handler is enabled.
+func recordExecution(f *Flow, objectKey string, stats *Stats) {
+	r := recorder.Load()
+	if r == nil {
+		return
+	}
+
+	r.executions.Add(executionKey{f.name, objectKey}, RecordedExecution{
+		DOT:     f.DOT(stats),
+		Mermaid: f.Mermaid(stats),
+		Issues:  r.validate(f),
+		Stats:   stats,
+	})
+}
+
+// validate returns the issues found by Validate for the given Flow. The issues are only computed once per graph, i.e.,
+// flows with the same tasks and dependencies are not validated again.
+func (r *executionRecorder) validate(f *Flow) []Issue {
+	checksum := utils.ComputeSHA256Hex([]byte(f.DOT(nil)))
+	if issues, ok := r.issues.Get(checksum); ok {
+		return issues
+	}
+
+	issues := Validate(f, ValidateOpts{})
+	r.issues.Add(checksum, issues)
+	return issues
+}
+
+// EnableRecording enables recording the most recent execution of each flow per object, see LastExecution. Executions
+// are only recorded after recording has been enabled. Only the most recently recorded executions are kept.
+func EnableRecording() {
+	recorder.CompareAndSwap(nil, newExecutionRecorder())
+}
+
+// LastExecution returns the most recent execution of the flow with the given name for the given object key (see
+// Opts.ObjectKey). It returns false if recording is not enabled or if no such execution has been recorded.
+func LastExecution(name, objectKey string) (RecordedExecution, bool) {
+	r := recorder.Load()
+	if r == nil {
+		return RecordedExecution{}, false
+	}
+
+	return r.executions.Peek(executionKey{name, objectKey})
+}
+
+// NewDebugHandler creates a new HTTP handler for inspecting the most recent execution of each flow per object. It
+// enables recording, i.e., executions are only recorded after the handler has been created. Components should only
+// create and register the handler if profiling is enabled (gardenlet serves it on the metrics port if
+// debugging.enableProfiling is set), since it reveals internals of the reconciliations.
+// Without query parameters, the handler lists all recorded executions. The 'name' and 'object' query parameters select
+// an execution, and the 'format' query parameter selects the output format ('dot' or 'mermaid', defaults to 'mermaid').
+func NewDebugHandler() http.HandlerFunc {
+	EnableRecording()
+	return recorder.Load().handle
+}
+
+func (r *executionRecorder) handle(w http.ResponseWriter, req *http.Request) {
+	var (
+		name      = req.URL.Query().Get("name")
+		objectKey = req.URL.Query().Get("object")
+		format    = req.URL.Query().Get("format")
+	)
+
+	if name == "" {
+		keys := r.executions.Keys()
+		slices.SortFunc(keys, func(a, b executionKey) int {
+			return cmp.Or(cmp.Compare(a.name, b.name), cmp.Compare(a.objectKey, b.objectKey))
+		})
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprint(w, "<html><body><h1>Flows</h1><ul>")
+		for _, k := range keys {
+			title, query := html.EscapeString(k.name), "name="+url.QueryEscape(k.name)
+			if k.objectKey != "" {
+				title += " (" + html.EscapeString(k.objectKey) + ")"
+				query += "&object=" + url.QueryEscape(k.objectKey)
+			}
+			fmt.Fprintf(w, `<li>%s (<a href="%s?%s&format=dot">dot</a>, <a href="%s?%s&format=mermaid">mermaid</a>)</li>`,
+				title, DebugHandlerPath, query, DebugHandlerPath, query)
+		}
+		fmt.Fprint(w, "</ul></body></html>")
+		return
+	}
+
+	e, ok := r.executions.Peek(executionKey{name, objectKey})
+	if !ok {
+		http.Error(w, fmt.Sprintf("no execution of flow %q for object %q recorded", name, objectKey), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	switch format {
+	case "dot":
+		fmt.Fprint(w, e.DOT)
+	case "", "mermaid":
+		fmt.Fprint(w, e.Mermaid)
+	default:
+		http.Error(w, fmt.Sprintf("unsupported format %q", format), http.StatusBadRequest)
+	}
+}
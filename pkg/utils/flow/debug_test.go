@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Debug", func() {
+	Describe("#NewDebugHandler", func() {
+		var handler http.HandlerFunc
+
+		BeforeEach(func() {
+			handler = flow.NewDebugHandler()
+
+			g := flow.NewGraph("debug")
+			x := g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+			_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return nil }, Dependencies: flow.NewTaskIDs(x)})
+			Expect(g.Compile().Run(context.Background(), flow.Opts{})).To(Succeed())
+			Expect(g.Compile().Run(context.Background(), flow.Opts{ObjectKey: "foo/bar"})).To(Succeed())
+		})
+
+		serve := func(target string) *httptest.ResponseRecorder {
+			recorder := httptest.NewRecorder()
+			handler(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+			return recorder
+		}
+
+		It("should list the recorded flows", func() {
+			response := serve(flow.DebugHandlerPath)
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(response.Body.String()).To(ContainSubstring(`<li>debug (<a href="/debug/flows?name=debug&format=dot">dot</a>`))
+			Expect(response.Body.String()).To(ContainSubstring(`<li>debug (foo/bar) (<a href="/debug/flows?name=debug&object=foo%2Fbar&format=dot">dot</a>`))
+		})
+
+		It("should render the recorded flow", func() {
+			response := serve(flow.DebugHandlerPath + "?name=debug&format=dot")
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(response.Body.String()).To(ContainSubstring(`"x" -> "y";`))
+			Expect(response.Body.String()).To(ContainSubstring(`tooltip="succeeded"`))
+
+			response = serve(flow.DebugHandlerPath + "?name=debug")
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(response.Body.String()).To(HavePrefix("flowchart TD\n"))
+
+			response = serve(flow.DebugHandlerPath + "?name=debug&object=foo%2Fbar")
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(response.Body.String()).To(HavePrefix("flowchart TD\n"))
+		})
+
+		It("should fail for unknown flows and formats", func() {
+			Expect(serve(flow.DebugHandlerPath + "?name=unknown").Code).To(Equal(http.StatusNotFound))
+			Expect(serve(flow.DebugHandlerPath + "?name=debug&object=unknown").Code).To(Equal(http.StatusNotFound))
+			Expect(serve(flow.DebugHandlerPath + "?name=debug&format=svg").Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("#LastExecution", func() {
+		BeforeEach(func() {
+			flow.EnableRecording()
+		})
+
+		It("should return the most recent execution of the flow for the object", func() {
+			g := flow.NewGraph("last-execution")
+			x := g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+			f := g.Compile()
+			Expect(f.Run(context.Background(), flow.Opts{ObjectKey: "foo"})).To(Succeed())
+
+			execution, ok := flow.LastExecution("last-execution", "foo")
+			Expect(ok).To(BeTrue())
+			Expect(execution.DOT).To(Equal(f.DOT(execution.Stats)))
+			Expect(execution.Mermaid).To(Equal(f.Mermaid(execution.Stats)))
+			Expect(execution.Issues).To(BeEmpty())
+			Expect(execution.Stats.Succeeded).To(Equal(flow.NewTaskIDs(x)))
+		})
+
+		It("should record the validation issues of the flow", func() {
+			g := flow.NewGraph("last-execution-issues")
+			_ = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+			_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return nil }})
+			Expect(g.Compile().Run(context.Background(), flow.Opts{})).To(Succeed())
+
+			execution, ok := flow.LastExecution("last-execution-issues", "")
+			Expect(ok).To(BeTrue())
+			Expect(execution.Issues).To(ConsistOf(
+				HaveField("TaskID", flow.TaskID("x")),
+				HaveField("TaskID", flow.TaskID("y")),
+			))
+		})
+
+		It("should drop the least recently recorded executions", func() {
+			g := flow.NewGraph("last-execution-evicted")
+			_ = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+			f := g.Compile()
+
+			for i := range 1001 {
+				Expect(f.Run(context.Background(), flow.Opts{ObjectKey: strconv.Itoa(i)})).To(Succeed())
+			}
+
+			_, ok := flow.LastExecution("last-execution-evicted", "0")
+			Expect(ok).To(BeFalse())
+			_, ok = flow.LastExecution("last-execution-evicted", "1")
+			Expect(ok).To(BeTrue())
+			_, ok = flow.LastExecution("last-execution-evicted", "1000")
+			Expect(ok).To(BeTrue())
+		})
+
+		It("should return false if no execution of the flow has been recorded for the object", func() {
+			_, ok := flow.LastExecution("unknown", "")
+			Expect(ok).To(BeFalse())
+
+			_, ok = flow.LastExecution("last-execution", "bar")
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
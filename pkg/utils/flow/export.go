@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// taskPhase is the phase of a task in the Stats of a Flow execution.
+type taskPhase string
+
+const (
+	taskPhaseSucceeded taskPhase = "succeeded"
+	taskPhaseFailed    taskPhase = "failed"
+	taskPhaseRunning   taskPhase = "running"
+	taskPhaseSkipped   taskPhase = "skipped"
+	taskPhasePending   taskPhase = "pending"
+)
+
+var taskPhaseColors = map[taskPhase]string{
+	taskPhaseSucceeded: "#a3e4a3",
+	taskPhaseFailed:    "#f4a3a3",
+	taskPhaseRunning:   "#f4e3a3",
+	taskPhaseSkipped:   "#d9d9d9",
+	taskPhasePending:   "#ffffff",
+}
+
+func (s *Stats) phaseOf(id TaskID) (taskPhase, bool) {
+	switch {
+	case s == nil:
+		return "", false
+	case s.Succeeded.Has(id):
+		return taskPhaseSucceeded, true
+	case s.Failed.Has(id):
+		return taskPhaseFailed, true
+	case s.Running.Has(id):
+		return taskPhaseRunning, true
+	case s.Skipped.Has(id):
+		return taskPhaseSkipped, true
+	case s.Pending.Has(id):
+		return taskPhasePending, true
+	}
+	return "", false
+}
+
+// taskLabel returns the label of the given task including its duration, if available in the given Stats.
+func taskLabel(id TaskID, stats *Stats, lineBreak string) string {
+	label := string(id)
+	if stats != nil {
+		if duration, ok := stats.Durations[id]; ok {
+			label += lineBreak + duration.String()
+		}
+	}
+	return label
+}
+
+// edges returns all dependencies of the Flow as sorted list of (dependency, dependent) pairs.
+func (f *Flow) edges() [][2]TaskID {
+	var edges [][2]TaskID
+	for _, id := range f.taskIDs().List() {
+		for _, target := range f.nodes[id].targetIDs.List() {
+			edges = append(edges, [2]TaskID{id, target})
+		}
+	}
+	return edges
+}
+
+// taskIDs returns the set of all TaskIDs of the Flow.
+func (f *Flow) taskIDs() TaskIDs {
+	ids := NewTaskIDs()
+	for id := range f.nodes {
+		ids.Insert(id)
+	}
+	return ids
+}
+
+// DOT renders the Flow as directed graph in the Graphviz DOT language. If stats are given, tasks are colored according
+// to their phase and labeled with their durations.
+func (f *Flow) DOT(stats *Stats) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "digraph %s {\n", strconv.Quote(f.name))
+	b.WriteString("  node [shape=box, style=filled, fillcolor=\"#ffffff\"];\n")
+
+	for _, id := range f.taskIDs().List() {
+		attributes := []string{"label=" + strconv.Quote(taskLabel(id, stats, "\n"))}
+		if phase, ok := stats.phaseOf(id); ok {
+			attributes = append(attributes, "fillcolor="+strconv.Quote(taskPhaseColors[phase]), "tooltip="+strconv.Quote(string(phase)))
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", strconv.Quote(string(id)), strings.Join(attributes, ", "))
+	}
+
+	for _, edge := range f.edges() {
+		fmt.Fprintf(&b, "  %s -> %s;\n", strconv.Quote(string(edge[0])), strconv.Quote(string(edge[1])))
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Mermaid renders the Flow as Mermaid flowchart. If stats are given, tasks are colored according to their phase and
+// labeled with their durations.
+func (f *Flow) Mermaid(stats *Stats) string {
+	var (
+		b       strings.Builder
+		ids     = f.taskIDs().List()
+		nodeIDs = make(map[TaskID]string, len(ids))
+		classes = make(map[taskPhase][]string)
+	)
+
+	b.WriteString("flowchart TD\n")
+
+	// Mermaid node IDs must not contain arbitrary characters, hence, we use generated IDs and put the task IDs into the
+	// labels.
+	for i, id := range ids {
+		nodeIDs[id] = "t" + strconv.Itoa(i)
+		label := strings.ReplaceAll(taskLabel(id, stats, "<br/>"), `"`, "#quot;")
+		fmt.Fprintf(&b, "  %s[\"%s\"]\n", nodeIDs[id], label)
+
+		if phase, ok := stats.phaseOf(id); ok {
+			classes[phase] = append(classes[phase], nodeIDs[id])
+		}
+	}
+
+	for _, edge := range f.edges() {
+		fmt.Fprintf(&b, "  %s --> %s\n", nodeIDs[edge[0]], nodeIDs[edge[1]])
+	}
+
+	for _, phase := range []taskPhase{taskPhaseSucceeded, taskPhaseFailed, taskPhaseRunning, taskPhaseSkipped, taskPhasePending} {
+		if len(classes[phase]) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "  classDef %s fill:%s\n", phase, taskPhaseColors[phase])
+		fmt.Fprintf(&b, "  class %s %s\n", strings.Join(classes[phase], ","), phase)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Export", func() {
+	var f *flow.Flow
+
+	BeforeEach(func() {
+		g := flow.NewGraph("foo")
+		x := g.Add(flow.Task{Name: "x"})
+		y := g.Add(flow.Task{Name: "y \"quoted\"", Dependencies: flow.NewTaskIDs(x)})
+		_ = g.Add(flow.Task{Name: "z", Dependencies: flow.NewTaskIDs(x, y)})
+		f = g.Compile()
+	})
+
+	Describe("#DOT", func() {
+		It("should render the flow without stats", func() {
+			Expect(f.DOT(nil)).To(Equal(`digraph "foo" {
+  node [shape=box, style=filled, fillcolor="#ffffff"];
+  "x" [label="x"];
+  "y \"quoted\"" [label="y \"quoted\""];
+  "z" [label="z"];
+  "x" -> "y \"quoted\"";
+  "x" -> "z";
+  "y \"quoted\"" -> "z";
+}
+`))
+		})
+
+		It("should render the flow with stats", func() {
+			stats := flow.InitialStats("foo", flow.NewTaskIDs(flow.TaskID("x"), flow.TaskID(`y "quoted"`), flow.TaskID("z")))
+			stats.Pending.Delete(flow.TaskID("x"), flow.TaskID(`y "quoted"`))
+			stats.Succeeded.Insert(flow.TaskID("x"))
+			stats.Failed.Insert(flow.TaskID(`y "quoted"`))
+			stats.Durations["x"] = 2 * time.Second
+
+			Expect(f.DOT(stats)).To(Equal(`digraph "foo" {
+  node [shape=box, style=filled, fillcolor="#ffffff"];
+  "x" [label="x\n2s", fillcolor="#a3e4a3", tooltip="succeeded"];
+  "y \"quoted\"" [label="y \"quoted\"", fillcolor="#f4a3a3", tooltip="failed"];
+  "z" [label="z", fillcolor="#ffffff", tooltip="pending"];
+  "x" -> "y \"quoted\"";
+  "x" -> "z";
+  "y \"quoted\"" -> "z";
+}
+`))
+		})
+	})
+
+	Describe("#Mermaid", func() {
+		It("should render the flow without stats", func() {
+			Expect(f.Mermaid(nil)).To(Equal(`flowchart TD
+  t0["x"]
+  t1["y #quot;quoted#quot;"]
+  t2["z"]
+  t0 --> t1
+  t0 --> t2
+  t1 --> t2
+`))
+		})
+
+		It("should render the flow with stats", func() {
+			stats := flow.InitialStats("foo", flow.NewTaskIDs(flow.TaskID("x"), flow.TaskID(`y "quoted"`), flow.TaskID("z")))
+			stats.Pending.Delete(flow.TaskID("x"), flow.TaskID(`y "quoted"`))
+			stats.Succeeded.Insert(flow.TaskID("x"))
+			stats.Running.Insert(flow.TaskID(`y "quoted"`))
+			stats.Durations["x"] = 2 * time.Second
+
+			Expect(f.Mermaid(stats)).To(Equal(`flowchart TD
+  t0["x<br/>2s"]
+  t1["y #quot;quoted#quot;"]
+  t2["z"]
+  t0 --> t1
+  t0 --> t2
+  t1 --> t2
+  classDef succeeded fill:#a3e4a3
+  class t0 succeeded
+  classDef running fill:#f4e3a3
+  class t1 running
+  classDef pending fill:#ffffff
+  class t2 pending
+`))
+		})
+	})
+})
@@ -9,10 +9,15 @@ package flow
 import (
 	"context"
 	"fmt"
+	"maps"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/utils/clock"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -23,6 +28,11 @@ import (
 const (
 	logKeyFlow = "flow"
 	logKeyTask = "task"
+
+	tracerName = "github.com/gardener/gardener/pkg/utils/flow"
+
+	attributeKeyFlow = attribute.Key("flow")
+	attributeKeyTask = attribute.Key("task")
 )
 
 // ErrorCleaner is called when a task which errored during the previous reconciliation phase completes with success
@@ -71,10 +81,11 @@ func (f *Flow) Len() int {
 // node is a compiled Task that contains the triggered Tasks, the
 // number of triggers the node itself requires and its payload function.
 type node struct {
-	targetIDs TaskIDs
-	required  int
-	fn        TaskFn
-	skip      bool
+	targetIDs     TaskIDs
+	required      int
+	fn            TaskFn
+	skip          bool
+	inputChecksum InputChecksumFn
 }
 
 func (n *node) String() string {
@@ -102,7 +113,24 @@ type Opts struct {
 	ErrorCleaner func(ctx context.Context, taskID string)
 	// ErrorContext is used to store any error related context.
 	ErrorContext *errorsutils.ErrorContext
-}
+	// TracerProvider is used to create the spans for the flow execution and its tasks. If it is not set, the global
+	// TracerProvider is used (which does not record anything unless it has been configured explicitly).
+	TracerProvider trace.TracerProvider
+	// StateStore is used to persist the input checksums of succeeded tasks. If it is set, tasks which succeeded in a
+	// previous (interrupted or failed) execution with unchanged input checksums are not executed again. The state is
+	// cleared once the flow has finished successfully.
+	StateStore StateStore
+	// StateStoreInterval is the minimum interval between two writes of the task state to the StateStore while the
+	// flow is running. Changes which have not been persisted yet are always written when the flow finishes
+	// unsuccessfully. Defaults to DefaultStateStoreInterval.
+	StateStoreInterval time.Duration
+	// ObjectKey identifies the object which is reconciled by the flow execution (e.g., the namespace and name of a
+	// Shoot). It distinguishes the recorded executions of the same flow for different objects, see LastExecution.
+	ObjectKey string
+}
+
+// DefaultStateStoreInterval is the default value for Opts.StateStoreInterval.
+const DefaultStateStoreInterval = 15 * time.Second
 
 // Run starts an execution of a Flow.
 // It blocks until the Flow has finished and returns the error, if any.
@@ -114,6 +142,9 @@ type nodeResult struct {
 	TaskID  TaskID
 	Error   error
 	skipped bool
+	resumed bool
+
+	inputChecksum string
 
 	delay    time.Duration
 	duration time.Duration
@@ -128,6 +159,8 @@ type Stats struct {
 	Running   TaskIDs
 	Skipped   TaskIDs
 	Pending   TaskIDs
+	// Durations contains the durations of all tasks that have been executed.
+	Durations map[TaskID]time.Duration
 }
 
 // ProgressPercent retrieves the progress of a Flow execution in percent.
@@ -146,6 +179,7 @@ func (s *Stats) Copy() *Stats {
 		s.Running.Copy(),
 		s.Skipped.Copy(),
 		s.Pending.Copy(),
+		maps.Clone(s.Durations),
 	}
 }
 
@@ -160,6 +194,7 @@ func InitialStats(flowName string, all TaskIDs) *Stats {
 		NewTaskIDs(),
 		NewTaskIDs(),
 		all.Copy(),
+		make(map[TaskID]time.Duration),
 	}
 }
 
@@ -177,14 +212,33 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		log = opts.Log.WithValues(logKeyFlow, flow.name)
 	}
 
+	stateStoreInterval := DefaultStateStoreInterval
+	if opts.StateStoreInterval > 0 {
+		stateStoreInterval = opts.StateStoreInterval
+	}
+
+	tracerProvider := otel.GetTracerProvider()
+	if opts.TracerProvider != nil {
+		tracerProvider = opts.TracerProvider
+	}
+
 	return &execution{
 		flow,
 		InitialStats(flow.name, all),
 		nil,
 		log,
+		tracerProvider.Tracer(tracerName),
 		opts.ProgressReporter,
 		opts.ErrorCleaner,
 		opts.ErrorContext,
+		opts.StateStore,
+		stateStoreInterval,
+		opts.ObjectKey,
+		nil,
+		false,
+		false,
+		time.Time{},
+		NewTaskIDs(),
 		make(chan *nodeResult),
 		make(map[TaskID]int),
 	}
@@ -197,9 +251,23 @@ type execution struct {
 	taskErrors []error
 
 	log              logr.Logger
+	tracer           trace.Tracer
 	progressReporter ProgressReporter
 	errorCleaner     ErrorCleaner
 	errorContext     *errorsutils.ErrorContext
+	stateStore       StateStore
+	// stateStoreInterval is the minimum interval between two writes of the task state.
+	stateStoreInterval time.Duration
+	objectKey          string
+	taskState          TaskState
+	// taskStateDirty is true if the task state has changed since it was loaded or stored the last time.
+	taskStateDirty bool
+	// taskStatePersisted is true if the StateStore might contain a task state of this flow which has to be cleared.
+	taskStatePersisted bool
+	taskStateStoredAt  time.Time
+	// notResumable contains the tasks which must be executed even if they succeeded in the previous execution with
+	// unchanged inputs because one of their dependencies with an input checksum was executed again.
+	notResumable TaskIDs
 
 	done          chan *nodeResult
 	triggerCounts map[TaskID]int
@@ -228,7 +296,25 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	e.stats.Pending.Delete(id)
 	e.stats.Running.Insert(id)
 
+	var inputChecksum string
+	if e.stateStore != nil && node.inputChecksum != nil {
+		inputChecksum = node.inputChecksum()
+
+		if checksum, ok := e.taskState[id]; ok && checksum == inputChecksum && !e.notResumable.Has(id) {
+			log.Info("Skipped, succeeded in previous execution with unchanged inputs")
+
+			go func() {
+				e.done <- &nodeResult{TaskID: id, Error: nil, resumed: true, inputChecksum: inputChecksum, delay: taskStartDelay}
+			}()
+
+			return
+		}
+	}
+
 	go func() {
+		ctx, span := e.tracer.Start(ctx, string(id), trace.WithAttributes(attributeKeyFlow.String(e.flow.name), attributeKeyTask.String(string(id))))
+		defer span.End()
+
 		start := e.flow.clock.Now().UTC()
 		log.V(1).Info("Started")
 		err := node.fn(ctx)
@@ -237,15 +323,23 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 
 		if err != nil {
 			log.Error(err, "Error")
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
 			err = fmt.Errorf("task %q failed: %w", id, err)
 		} else {
 			log.Info("Succeeded")
 		}
 
-		e.done <- &nodeResult{TaskID: id, Error: err, delay: taskStartDelay, duration: duration}
+		e.done <- &nodeResult{TaskID: id, Error: err, inputChecksum: inputChecksum, delay: taskStartDelay, duration: duration}
 	}()
 }
 
+func (e *execution) updateDuration(r *nodeResult) {
+	if !r.skipped && !r.resumed {
+		e.stats.Durations[r.TaskID] = r.duration
+	}
+}
+
 func (e *execution) updateSuccess(id TaskID) {
 	e.stats.Running.Delete(id)
 	e.stats.Succeeded.Insert(id)
@@ -268,20 +362,110 @@ func (e *execution) processTriggers(ctx context.Context, id TaskID) {
 
 func (e *execution) cleanErrors(ctx context.Context, taskID TaskID) {
 	if e.errorCleaner != nil {
+		trace.SpanFromContext(ctx).AddEvent("ErrorCleaned", trace.WithAttributes(attributeKeyTask.String(string(taskID))))
 		e.errorCleaner(ctx, string(taskID))
 	}
 }
 
+func (e *execution) loadTaskState(ctx context.Context) error {
+	if e.stateStore == nil {
+		return nil
+	}
+
+	taskState, err := e.stateStore.Load(ctx, e.flow.name)
+	if err != nil {
+		return fmt.Errorf("failed loading state of flow %q: %w", e.flow.name, err)
+	}
+
+	if taskState == nil {
+		taskState = make(TaskState)
+	}
+	e.taskState = taskState
+	e.taskStatePersisted = len(taskState) > 0
+	e.taskStateStoredAt = e.flow.clock.Now()
+	return nil
+}
+
+func (e *execution) updateTaskState(ctx context.Context, result *nodeResult) {
+	if e.stateStore == nil || e.flow.nodes[result.TaskID].inputChecksum == nil {
+		return
+	}
+
+	if !result.resumed {
+		// The task changed the state its dependents might rely on (e.g., a deploy task followed by its readiness wait),
+		// hence, they must not be skipped either.
+		e.notResumable.Insert(e.flow.nodes[result.TaskID].targetIDs)
+	}
+
+	if result.Error != nil {
+		if _, ok := e.taskState[result.TaskID]; !ok {
+			return
+		}
+		delete(e.taskState, result.TaskID)
+	} else {
+		if checksum, ok := e.taskState[result.TaskID]; ok && checksum == result.inputChecksum {
+			return
+		}
+		e.taskState[result.TaskID] = result.inputChecksum
+	}
+
+	e.taskStateDirty = true
+	e.storeTaskState(ctx, false)
+}
+
+// storeTaskState writes the task state to the StateStore if it has changed. Unless force is true, writes are skipped
+// if the last one happened less than stateStoreInterval ago to avoid one write per finished task.
+func (e *execution) storeTaskState(ctx context.Context, force bool) {
+	if e.stateStore == nil || !e.taskStateDirty {
+		return
+	}
+
+	now := e.flow.clock.Now()
+	if !force && now.Sub(e.taskStateStoredAt) < e.stateStoreInterval {
+		return
+	}
+
+	if err := e.stateStore.Store(ctx, e.flow.name, e.taskState); err != nil {
+		// The state is only an optimization for subsequent executions, hence, failing to persist it must not fail the
+		// flow.
+		e.log.Error(err, "Failed storing task state")
+		return
+	}
+
+	e.taskStateDirty = false
+	e.taskStatePersisted = true
+	e.taskStateStoredAt = now
+}
+
+func (e *execution) clearTaskState(ctx context.Context) {
+	if e.stateStore == nil || !e.taskStatePersisted {
+		return
+	}
+
+	if err := e.stateStore.Delete(ctx, e.flow.name); err != nil {
+		e.log.Error(err, "Failed deleting task state")
+	}
+}
+
 func (e *execution) reportProgress(ctx context.Context) {
 	if e.progressReporter != nil {
 		e.progressReporter.Report(ctx, e.stats.Copy())
 	}
 }
 
-func (e *execution) run(ctx context.Context) error {
+func (e *execution) run(ctx context.Context) (err error) {
 	e.flow.start = e.flow.clock.Now()
 	defer close(e.done)
 
+	ctx, span := e.tracer.Start(ctx, e.flow.name, trace.WithAttributes(attributeKeyFlow.String(e.flow.name)))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	if e.progressReporter != nil {
 		if err := e.progressReporter.Start(ctx); err != nil {
 			return err
@@ -289,6 +473,10 @@ func (e *execution) run(ctx context.Context) error {
 		defer e.progressReporter.Stop()
 	}
 
+	if err := e.loadTaskState(ctx); err != nil {
+		return err
+	}
+
 	e.log.Info("Starting")
 	e.reportProgress(ctx)
 
@@ -307,12 +495,14 @@ func (e *execution) run(ctx context.Context) error {
 	for e.stats.Running.Len() > 0 || e.stats.Skipped.Len() > 0 {
 		result := <-e.done
 		e.reportTaskMetrics(result)
+		e.updateDuration(result)
 		if result.skipped {
 			e.stats.Skipped.Delete(result.TaskID)
 			if cancelErr = ctx.Err(); cancelErr == nil {
 				e.processTriggers(ctx, result.TaskID)
 			}
 		} else {
+			e.updateTaskState(ctx, result)
 			if result.Error != nil {
 				e.taskErrors = append(e.taskErrors, errorsutils.WithID(string(result.TaskID), result.Error))
 				e.updateFailure(result.TaskID)
@@ -331,7 +521,14 @@ func (e *execution) run(ctx context.Context) error {
 	}
 
 	e.log.Info("Finished")
-	return e.result(cancelErr)
+	recordExecution(e.flow, e.objectKey, e.stats.Copy())
+	if err := e.result(cancelErr); err != nil {
+		e.storeTaskState(context.WithoutCancel(ctx), true)
+		return err
+	}
+
+	e.clearTaskState(ctx)
+	return nil
 }
 
 func (e *execution) result(cancelErr error) error {
@@ -359,7 +556,7 @@ func (e *execution) reportTaskMetrics(r *nodeResult) {
 			WithLabelValues(e.flow.name, string(r.TaskID), utils.IifString(r.skipped, "true", "false")).
 			Observe(r.delay.Seconds())
 	}
-	if flowTaskDurationSeconds != nil && !r.skipped {
+	if flowTaskDurationSeconds != nil && !r.skipped && !r.resumed {
 		flowTaskDurationSeconds.WithLabelValues(e.flow.name, string(r.TaskID)).Observe(r.duration.Seconds())
 	}
 	if flowTaskResults != nil {
@@ -11,8 +11,12 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -169,6 +173,184 @@ var _ = Describe("Flow", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(flow.WasCanceled(err)).To(BeTrue())
 		})
+
+		Context("with state store", func() {
+			var (
+				store flow.StateStore
+				calls *AtomicStringList
+				fail  bool
+
+				mkTask = func(name, checksum string, dependencies ...flow.TaskIDer) flow.Task {
+					return flow.Task{
+						Name: name,
+						Fn: func(_ context.Context) error {
+							calls.Append(name)
+							if fail && name == "z" {
+								return errors.New("err")
+							}
+							return nil
+						},
+						InputChecksum: func() string { return checksum },
+						Dependencies:  flow.NewTaskIDs(dependencies...),
+					}
+				}
+			)
+
+			BeforeEach(func() {
+				store = flow.NewConfigMapStateStore(fakeclient.NewClientBuilder().Build(), "namespace", "flow-state")
+				calls = NewAtomicStringList()
+				fail = false
+			})
+
+			It("should skip tasks which succeeded in the previous execution with unchanged inputs", func() {
+				g := flow.NewGraph("foo")
+				x := g.Add(mkTask("x", "1"))
+				y := g.Add(mkTask("y", "1", x))
+				_ = g.Add(mkTask("z", "1", y))
+				f := g.Compile()
+
+				fail = true
+				Expect(f.Run(ctx, flow.Opts{StateStore: store})).To(MatchError(ContainSubstring("err")))
+				Expect(calls.Values()).To(Equal([]string{"x", "y", "z"}))
+				Expect(store.Load(ctx, "foo")).To(Equal(flow.TaskState{"x": "1", "y": "1"}))
+
+				fail = false
+				calls = NewAtomicStringList()
+				Expect(f.Run(ctx, flow.Opts{StateStore: store})).To(Succeed())
+				Expect(calls.Values()).To(Equal([]string{"z"}))
+				Expect(store.Load(ctx, "foo")).To(BeEmpty())
+			})
+
+			It("should execute tasks whose inputs have changed", func() {
+				Expect(store.Store(ctx, "foo", flow.TaskState{"x": "1", "y": "1"})).To(Succeed())
+
+				g := flow.NewGraph("foo")
+				x := g.Add(mkTask("x", "1"))
+				_ = g.Add(mkTask("y", "2", x))
+				f := g.Compile()
+
+				Expect(f.Run(ctx, flow.Opts{StateStore: store})).To(Succeed())
+				Expect(calls.Values()).To(Equal([]string{"y"}))
+			})
+
+			It("should execute tasks again whose dependencies are executed again", func() {
+				Expect(store.Store(ctx, "foo", flow.TaskState{"deploy": "1", "wait": "1", "other": "1"})).To(Succeed())
+
+				g := flow.NewGraph("foo")
+				deploy := g.Add(mkTask("deploy", "2"))
+				wait := g.Add(mkTask("wait", "1", deploy))
+				_ = g.Add(mkTask("z", "1", wait))
+				_ = g.Add(mkTask("other", "1"))
+				f := g.Compile()
+
+				Expect(f.Run(ctx, flow.Opts{StateStore: store})).To(Succeed())
+				Expect(calls.Values()).To(ConsistOf("deploy", "wait", "z"))
+			})
+
+			It("should batch the writes of the task state", func() {
+				countingStore := &countingStateStore{StateStore: store}
+
+				g := flow.NewGraph("foo")
+				x := g.Add(mkTask("x", "1"))
+				y := g.Add(mkTask("y", "1", x))
+				_ = g.Add(mkTask("z", "1", y))
+				f := g.Compile()
+
+				fail = true
+				Expect(f.Run(ctx, flow.Opts{StateStore: countingStore})).To(MatchError(ContainSubstring("err")))
+				Expect(countingStore.stores).To(Equal(1))
+				Expect(store.Load(ctx, "foo")).To(Equal(flow.TaskState{"x": "1", "y": "1"}))
+			})
+
+			It("should not access the state store when clearing if nothing was persisted", func() {
+				countingStore := &countingStateStore{StateStore: store}
+
+				g := flow.NewGraph("foo")
+				_ = g.Add(mkTask("x", "1"))
+				f := g.Compile()
+
+				Expect(f.Run(ctx, flow.Opts{StateStore: countingStore})).To(Succeed())
+				Expect(countingStore.stores).To(Equal(0))
+				Expect(countingStore.deletes).To(Equal(0))
+			})
+
+			It("should always execute tasks without input checksum", func() {
+				Expect(store.Store(ctx, "foo", flow.TaskState{"x": "1"})).To(Succeed())
+
+				g := flow.NewGraph("foo")
+				_ = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error {
+					calls.Append("x")
+					return nil
+				}})
+				f := g.Compile()
+
+				Expect(f.Run(ctx, flow.Opts{StateStore: store})).To(Succeed())
+				Expect(calls.Values()).To(Equal([]string{"x"}))
+			})
+		})
+
+		It("should record a span for the flow and a child span for each task", func() {
+			var (
+				spanRecorder   = tracetest.NewSpanRecorder()
+				tracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder))
+
+				err1 = errors.New("err1")
+
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+				_ = g.Add(flow.Task{Name: "y", Fn: flow.TaskFn(func(_ context.Context) error { return err1 }).Recover(func(_ context.Context, err error) error {
+					return err
+				}), Dependencies: flow.NewTaskIDs(x)})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{TracerProvider: tracerProvider})).To(MatchError(ContainSubstring("err1")))
+
+			spans := spanRecorder.Ended()
+			Expect(spans).To(HaveLen(3))
+
+			spansByName := make(map[string]sdktrace.ReadOnlySpan, len(spans))
+			for _, span := range spans {
+				spansByName[span.Name()] = span
+			}
+			Expect(spansByName).To(HaveKey("foo"))
+			Expect(spansByName).To(HaveKey("x"))
+			Expect(spansByName).To(HaveKey("y"))
+
+			flowSpan := spansByName["foo"]
+			Expect(flowSpan.Status().Code).To(Equal(codes.Error))
+			for _, name := range []string{"x", "y"} {
+				Expect(spansByName[name].Parent().SpanID()).To(Equal(flowSpan.SpanContext().SpanID()))
+				Expect(spansByName[name].SpanContext().TraceID()).To(Equal(flowSpan.SpanContext().TraceID()))
+			}
+
+			Expect(spansByName["x"].Status().Code).To(Equal(codes.Unset))
+			Expect(spansByName["y"].Status().Code).To(Equal(codes.Error))
+			Expect(spansByName["y"].Events()).To(ContainElement(HaveField("Name", "Recover")))
+		})
+
+		It("should record an event on the flow span when an error is cleaned", func() {
+			var (
+				spanRecorder   = tracetest.NewSpanRecorder()
+				tracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder))
+
+				errorContext = errorsutils.NewErrorContext("foo", []string{"x"})
+				g            = flow.NewGraph("foo")
+				_            = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+				f            = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{
+				TracerProvider: tracerProvider,
+				ErrorContext:   errorContext,
+				ErrorCleaner:   func(_ context.Context, _ string) {},
+			})).To(Succeed())
+
+			Expect(spanRecorder.Ended()).To(ContainElement(And(
+				HaveField("Name()", "foo"),
+				HaveField("Events()", ContainElement(HaveField("Name", "ErrorCleaned"))),
+			)))
+		})
 	})
 
 	Describe("#Sequential", func() {
@@ -220,3 +402,18 @@ var _ = Describe("Flow", func() {
 		})
 	})
 })
+
+type countingStateStore struct {
+	flow.StateStore
+	stores, deletes int
+}
+
+func (c *countingStateStore) Store(ctx context.Context, flowName string, state flow.TaskState) error {
+	c.stores++
+	return c.StateStore.Store(ctx, flowName, state)
+}
+
+func (c *countingStateStore) Delete(ctx context.Context, flowName string) error {
+	c.deletes++
+	return c.StateStore.Delete(ctx, flowName)
+}
@@ -17,6 +17,12 @@ type Task struct {
 	Fn           TaskFn
 	SkipIf       bool
 	Dependencies TaskIDs
+	// InputChecksum optionally computes a checksum of the inputs of the Task. It is evaluated right before the Task
+	// would be started, i.e., after all its dependencies have completed. If the Flow is executed with a StateStore and
+	// the Task already succeeded in a previous execution with the same checksum, the Task is not executed again unless
+	// one of its dependencies with an InputChecksum is executed again. Tasks which wait for the effects of dependencies
+	// without an InputChecksum (which are always executed) must not set it.
+	InputChecksum InputChecksumFn
 }
 
 // Spec returns the TaskSpec of a task.
@@ -25,15 +31,17 @@ func (t *Task) Spec() *TaskSpec {
 		t.Fn,
 		t.SkipIf,
 		t.Dependencies.Copy(),
+		t.InputChecksum,
 	}
 }
 
 // TaskSpec is functional body of a Task, consisting only of the payload function and
 // the dependencies of the Task.
 type TaskSpec struct {
-	Fn           TaskFn
-	Skip         bool
-	Dependencies TaskIDs
+	Fn            TaskFn
+	Skip          bool
+	Dependencies  TaskIDs
+	InputChecksum InputChecksumFn
 }
 
 // Tasks is a mapping from TaskID to TaskSpec.
@@ -91,6 +99,7 @@ func (g *Graph) Compile() *Flow {
 		node := nodes.getOrCreate(taskName)
 		node.fn = taskSpec.Fn
 		node.skip = taskSpec.Skip
+		node.inputChecksum = taskSpec.InputChecksum
 		node.required = taskSpec.Dependencies.Len()
 	}
 
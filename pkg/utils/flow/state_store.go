@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// InputChecksumFn computes a checksum of the inputs of a task.
+type InputChecksumFn func() string
+
+// TaskState is a mapping from the TaskIDs of succeeded tasks to their input checksums.
+type TaskState map[TaskID]string
+
+// StateStore persists the TaskState of flow executions so that a subsequent execution can skip tasks which already
+// succeeded with unchanged inputs.
+type StateStore interface {
+	// Load returns the TaskState of the previous execution of the flow with the given name. It returns an empty
+	// TaskState if there is none.
+	Load(ctx context.Context, flowName string) (TaskState, error)
+	// Store persists the TaskState of the flow with the given name.
+	Store(ctx context.Context, flowName string, state TaskState) error
+	// Delete removes the TaskState of the flow with the given name.
+	Delete(ctx context.Context, flowName string) error
+}
+
+const (
+	// DataKeyFlowName is the key in the data of the ConfigMap used by the ConfigMap-backed StateStore which holds the
+	// name of the flow.
+	DataKeyFlowName = "flow"
+	// DataKeyTaskState is the key in the data of the ConfigMap used by the ConfigMap-backed StateStore which holds the
+	// JSON-encoded TaskState.
+	DataKeyTaskState = "tasks"
+)
+
+type configMapStateStore struct {
+	client    client.Client
+	namespace string
+	name      string
+}
+
+// NewConfigMapStateStore returns a StateStore which persists the TaskState in the ConfigMap with the given name and
+// namespace. The ConfigMap must not be shared between different flows.
+func NewConfigMapStateStore(c client.Client, namespace, name string) StateStore {
+	return &configMapStateStore{
+		client:    c,
+		namespace: namespace,
+		name:      name,
+	}
+}
+
+func (c *configMapStateStore) emptyConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: c.name, Namespace: c.namespace}}
+}
+
+// Load implements StateStore.
+func (c *configMapStateStore) Load(ctx context.Context, flowName string) (TaskState, error) {
+	configMap := c.emptyConfigMap()
+	if err := c.client.Get(ctx, client.ObjectKeyFromObject(configMap), configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return TaskState{}, nil
+		}
+		return nil, err
+	}
+
+	if configMap.Data[DataKeyFlowName] != flowName {
+		return TaskState{}, nil
+	}
+
+	state := TaskState{}
+	if data, ok := configMap.Data[DataKeyTaskState]; ok {
+		if err := json.Unmarshal([]byte(data), &state); err != nil {
+			return nil, fmt.Errorf("failed unmarshalling task state from ConfigMap %s: %w", client.ObjectKeyFromObject(configMap), err)
+		}
+	}
+
+	return state, nil
+}
+
+// Store implements StateStore.
+func (c *configMapStateStore) Store(ctx context.Context, flowName string, state TaskState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed marshalling task state: %w", err)
+	}
+
+	configMap := c.emptyConfigMap()
+	_, err = controllerutil.CreateOrUpdate(ctx, c.client, configMap, func() error {
+		configMap.Data = map[string]string{
+			DataKeyFlowName:  flowName,
+			DataKeyTaskState: string(data),
+		}
+		return nil
+	})
+	return err
+}
+
+// Delete implements StateStore.
+func (c *configMapStateStore) Delete(ctx context.Context, flowName string) error {
+	configMap := c.emptyConfigMap()
+	if err := c.client.Get(ctx, client.ObjectKeyFromObject(configMap), configMap); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if configMap.Data[DataKeyFlowName] != flowName {
+		return nil
+	}
+
+	return client.IgnoreNotFound(c.client.Delete(ctx, configMap))
+}
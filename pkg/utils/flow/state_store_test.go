@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("StateStore", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		store      flow.StateStore
+		configMap  *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().Build()
+		store = flow.NewConfigMapStateStore(fakeClient, "namespace", "flow-state")
+		configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "flow-state", Namespace: "namespace"}}
+	})
+
+	Describe("#Load", func() {
+		It("should return an empty state if the ConfigMap does not exist", func() {
+			Expect(store.Load(ctx, "foo")).To(BeEmpty())
+		})
+
+		It("should return an empty state if the ConfigMap belongs to another flow", func() {
+			Expect(store.Store(ctx, "bar", flow.TaskState{"x": "1"})).To(Succeed())
+
+			Expect(store.Load(ctx, "foo")).To(BeEmpty())
+		})
+
+		It("should return an error if the state cannot be decoded", func() {
+			configMap.Data = map[string]string{"flow": "foo", "tasks": "{"}
+			Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+
+			_, err := store.Load(ctx, "foo")
+			Expect(err).To(MatchError(ContainSubstring("failed unmarshalling task state")))
+		})
+	})
+
+	Describe("#Store", func() {
+		It("should create and update the ConfigMap", func() {
+			Expect(store.Store(ctx, "foo", flow.TaskState{"x": "1"})).To(Succeed())
+			Expect(store.Load(ctx, "foo")).To(Equal(flow.TaskState{"x": "1"}))
+
+			Expect(store.Store(ctx, "foo", flow.TaskState{"x": "1", "y": "2"})).To(Succeed())
+			Expect(store.Load(ctx, "foo")).To(Equal(flow.TaskState{"x": "1", "y": "2"}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{"flow": "foo", "tasks": `{"x":"1","y":"2"}`}))
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should succeed if the ConfigMap does not exist", func() {
+			Expect(store.Delete(ctx, "foo")).To(Succeed())
+		})
+
+		It("should not delete the ConfigMap of another flow", func() {
+			Expect(store.Store(ctx, "bar", flow.TaskState{"x": "1"})).To(Succeed())
+
+			Expect(store.Delete(ctx, "foo")).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+		})
+
+		It("should delete the ConfigMap", func() {
+			Expect(store.Store(ctx, "foo", flow.TaskState{"x": "1"})).To(Succeed())
+
+			Expect(store.Delete(ctx, "foo")).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(BeNotFoundError())
+		})
+	})
+})
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/gardener/gardener/pkg/utils/retry"
 )
@@ -44,6 +46,7 @@ func (t TaskFn) RetryUntilTimeout(interval, timeout time.Duration) TaskFn {
 
 		return retry.Until(ctx, interval, func(ctx context.Context) (done bool, err error) {
 			if err := t(ctx); err != nil {
+				trace.SpanFromContext(ctx).AddEvent("Retry", trace.WithAttributes(attribute.String("error", err.Error())))
 				return retry.MinorError(err)
 			}
 			return retry.Ok()
@@ -65,6 +68,7 @@ func (t TaskFn) Recover(recoverFn RecoverFn) TaskFn {
 			if ctx.Err() != nil {
 				return err
 			}
+			trace.SpanFromContext(ctx).AddEvent("Recover", trace.WithAttributes(attribute.String("error", err.Error())))
 			return recoverFn(ctx, err)
 		}
 		return nil
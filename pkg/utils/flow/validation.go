@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// IssueType is the type of an Issue found by Validate.
+type IssueType string
+
+const (
+	// IssueTypeUnreachable is the type of issues about tasks which are not connected to any other task of the flow.
+	// Such tasks are started immediately and nothing waits for them, which is typically a forgotten dependency.
+	IssueTypeUnreachable IssueType = "Unreachable"
+	// IssueTypeRedundantDependency is the type of issues about dependencies which are already implied transitively by
+	// other dependencies of the same task.
+	IssueTypeRedundantDependency IssueType = "RedundantDependency"
+	// IssueTypeMissingDependency is the type of issues about tasks which touch the same component but are not ordered,
+	// i.e., which might run concurrently.
+	IssueTypeMissingDependency IssueType = "MissingDependency"
+)
+
+// Issue is a finding of Validate.
+type Issue struct {
+	// Type is the type of the issue.
+	Type IssueType
+	// TaskID is the ID of the task the issue is about.
+	TaskID TaskID
+	// Related are the IDs of the other tasks involved in the issue.
+	Related TaskIDSlice
+	// Message is a human-readable description of the issue.
+	Message string
+}
+
+// String returns a human-readable representation of the issue.
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s", i.Type, i.Message)
+}
+
+// ValidateOpts are options for Validate.
+type ValidateOpts struct {
+	// TaskComponents maps TaskIDs to the components the respective task touches (e.g., deploys or destroys). Tasks
+	// touching the same component are expected to be ordered by (transitive) dependencies.
+	TaskComponents map[TaskID][]string
+}
+
+// Validate statically checks the given Flow and returns all found issues ordered by type and TaskID. It detects
+// - tasks which are not connected to any other task (only if the flow has more than one task)
+// - dependencies which are redundant because they are already implied by other dependencies
+// - missing dependencies between tasks which touch the same component (see ValidateOpts.TaskComponents)
+func Validate(f *Flow, opts ValidateOpts) []Issue {
+	var (
+		issues       []Issue
+		ids          = f.taskIDs().List()
+		dependencies = f.dependencies()
+		reachable    = make(map[TaskID]TaskIDs, len(ids))
+	)
+
+	for _, id := range ids {
+		reachable[id] = f.reachableFrom(id)
+	}
+
+	if len(ids) > 1 {
+		for _, id := range ids {
+			if dependencies[id].Len() == 0 && f.nodes[id].targetIDs.Len() == 0 {
+				issues = append(issues, Issue{
+					Type:    IssueTypeUnreachable,
+					TaskID:  id,
+					Message: fmt.Sprintf("task %q has neither dependencies nor dependents", id),
+				})
+			}
+		}
+	}
+
+	for _, id := range ids {
+		for _, dependency := range dependencies[id].List() {
+			var via TaskIDSlice
+			for other := range dependencies[id] {
+				if other != dependency && reachable[dependency].Has(other) {
+					via = append(via, other)
+				}
+			}
+
+			if len(via) > 0 {
+				slices.Sort(via)
+				issues = append(issues, Issue{
+					Type:    IssueTypeRedundantDependency,
+					TaskID:  id,
+					Related: TaskIDSlice{dependency},
+					Message: fmt.Sprintf("dependency of task %q on %q is already implied by %s", id, dependency, quoteTaskIDs(via)),
+				})
+			}
+		}
+	}
+
+	for i, id := range ids {
+		for _, other := range ids[i+1:] {
+			shared := sharedComponents(opts.TaskComponents[id], opts.TaskComponents[other])
+			if len(shared) == 0 || reachable[id].Has(other) || reachable[other].Has(id) {
+				continue
+			}
+
+			issues = append(issues, Issue{
+				Type:    IssueTypeMissingDependency,
+				TaskID:  id,
+				Related: TaskIDSlice{other},
+				Message: fmt.Sprintf("tasks %q and %q both touch %s but do not depend on each other", id, other, strings.Join(shared, ", ")),
+			})
+		}
+	}
+
+	slices.SortStableFunc(issues, func(a, b Issue) int {
+		return strings.Compare(string(a.Type), string(b.Type))
+	})
+	return issues
+}
+
+// dependencies returns the direct dependencies of all tasks of the Flow.
+func (f *Flow) dependencies() map[TaskID]TaskIDs {
+	dependencies := make(map[TaskID]TaskIDs, len(f.nodes))
+	for id := range f.nodes {
+		dependencies[id] = NewTaskIDs()
+	}
+	for id, node := range f.nodes {
+		for target := range node.targetIDs {
+			dependencies[target].Insert(id)
+		}
+	}
+	return dependencies
+}
+
+// reachableFrom returns all tasks which (transitively) depend on the given task.
+func (f *Flow) reachableFrom(id TaskID) TaskIDs {
+	var (
+		reachable = NewTaskIDs()
+		queue     = f.nodes[id].targetIDs.UnsortedList()
+	)
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if reachable.Has(current) {
+			continue
+		}
+		reachable.Insert(current)
+		queue = append(queue, f.nodes[current].targetIDs.UnsortedList()...)
+	}
+
+	return reachable
+}
+
+func sharedComponents(a, b []string) []string {
+	var shared []string
+	for _, component := range a {
+		if slices.Contains(b, component) && !slices.Contains(shared, component) {
+			shared = append(shared, component)
+		}
+	}
+	slices.Sort(shared)
+	return shared
+}
+
+func quoteTaskIDs(ids TaskIDSlice) string {
+	quoted := make([]string, 0, len(ids))
+	for _, id := range ids {
+		quoted = append(quoted, fmt.Sprintf("%q", id))
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Validation", func() {
+	Describe("#Validate", func() {
+		It("should not find any issues for a valid flow", func() {
+			g := flow.NewGraph("foo")
+			x := g.Add(flow.Task{Name: "x"})
+			y := g.Add(flow.Task{Name: "y", Dependencies: flow.NewTaskIDs(x)})
+			_ = g.Add(flow.Task{Name: "z", Dependencies: flow.NewTaskIDs(y)})
+
+			Expect(flow.Validate(g.Compile(), flow.ValidateOpts{
+				TaskComponents: map[flow.TaskID][]string{"x": {"etcd"}, "z": {"etcd"}},
+			})).To(BeEmpty())
+		})
+
+		It("should not report a single task as unreachable", func() {
+			g := flow.NewGraph("foo")
+			_ = g.Add(flow.Task{Name: "x"})
+
+			Expect(flow.Validate(g.Compile(), flow.ValidateOpts{})).To(BeEmpty())
+		})
+
+		It("should find all issues", func() {
+			g := flow.NewGraph("foo")
+			x := g.Add(flow.Task{Name: "x"})
+			y := g.Add(flow.Task{Name: "y", Dependencies: flow.NewTaskIDs(x)})
+			_ = g.Add(flow.Task{Name: "z", Dependencies: flow.NewTaskIDs(x, y)})
+			_ = g.Add(flow.Task{Name: "a", Dependencies: flow.NewTaskIDs(x)})
+			_ = g.Add(flow.Task{Name: "isolated"})
+
+			Expect(flow.Validate(g.Compile(), flow.ValidateOpts{
+				TaskComponents: map[flow.TaskID][]string{"a": {"kube-apiserver", "etcd"}, "z": {"etcd", "kube-apiserver"}},
+			})).To(Equal([]flow.Issue{
+				{
+					Type:    flow.IssueTypeMissingDependency,
+					TaskID:  "a",
+					Related: flow.TaskIDSlice{"z"},
+					Message: `tasks "a" and "z" both touch etcd, kube-apiserver but do not depend on each other`,
+				},
+				{
+					Type:    flow.IssueTypeRedundantDependency,
+					TaskID:  "z",
+					Related: flow.TaskIDSlice{"x"},
+					Message: `dependency of task "z" on "x" is already implied by "y"`,
+				},
+				{
+					Type:    flow.IssueTypeUnreachable,
+					TaskID:  "isolated",
+					Message: `task "isolated" has neither dependencies nor dependents`,
+				},
+			}))
+		})
+	})
+})
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// QuotaMetrics is the list of metrics which can be constrained by a Quota.
+var QuotaMetrics = []corev1.ResourceName{
+	core.QuotaMetricCPU,
+	core.QuotaMetricGPU,
+	core.QuotaMetricMemory,
+	core.QuotaMetricStorageStandard,
+	core.QuotaMetricStoragePremium,
+	core.QuotaMetricLoadbalancer,
+}
+
+// ComputeShootQuotaResources computes the amount of resources the given shoot allocates with regard to the Quota
+// metrics. For now, the maximum number of machines of each worker pool is used for the calculation.
+func ComputeShootQuotaResources(shoot *gardencorev1beta1.Shoot, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) (corev1.ResourceList, error) {
+	var (
+		countLB   int64 = 1
+		resources       = make(corev1.ResourceList)
+	)
+
+	for _, worker := range shootWorkersWithVolumes(shoot, cloudProfileSpec) {
+		var (
+			machineType *gardencorev1beta1.MachineType
+			volumeType  *gardencorev1beta1.VolumeType
+		)
+
+		for _, m := range cloudProfileSpec.MachineTypes {
+			if m.Name == worker.Machine.Type {
+				machineType = &m
+				break
+			}
+		}
+		if machineType == nil {
+			return nil, fmt.Errorf("machineType %s not found in CloudProfile", worker.Machine.Type)
+		}
+
+		if worker.Volume != nil {
+			if machineType.Storage != nil {
+				volumeType = &gardencorev1beta1.VolumeType{
+					Class: machineType.Storage.Class,
+				}
+			} else {
+				for _, v := range cloudProfileSpec.VolumeTypes {
+					if worker.Volume.Type != nil && v.Name == *worker.Volume.Type {
+						volumeType = &v
+						break
+					}
+				}
+			}
+		}
+		if volumeType == nil {
+			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile", worker.Machine.Type)
+		}
+
+		resources[core.QuotaMetricCPU] = SumQuantities(resources[core.QuotaMetricCPU], multiplyQuantity(machineType.CPU, worker.Maximum))
+		resources[core.QuotaMetricGPU] = SumQuantities(resources[core.QuotaMetricGPU], multiplyQuantity(machineType.GPU, worker.Maximum))
+		resources[core.QuotaMetricMemory] = SumQuantities(resources[core.QuotaMetricMemory], multiplyQuantity(machineType.Memory, worker.Maximum))
+
+		size := resource.MustParse("0Gi")
+		if worker.Volume != nil {
+			var err error
+			if size, err = resource.ParseQuantity(worker.Volume.VolumeSize); err != nil {
+				return nil, err
+			}
+		}
+
+		switch volumeType.Class {
+		case gardencorev1beta1.VolumeClassStandard:
+			resources[core.QuotaMetricStorageStandard] = SumQuantities(resources[core.QuotaMetricStorageStandard], multiplyQuantity(size, worker.Maximum))
+		case gardencorev1beta1.VolumeClassPremium:
+			resources[core.QuotaMetricStoragePremium] = SumQuantities(resources[core.QuotaMetricStoragePremium], multiplyQuantity(size, worker.Maximum))
+		default:
+			return nil, fmt.Errorf("unknown volumeType class %s", volumeType.Class)
+		}
+	}
+
+	if v1beta1helper.NginxIngressEnabled(shoot.Spec.Addons) {
+		countLB++
+	}
+	resources[core.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
+
+	return resources, nil
+}
+
+// SumQuantities returns the sum of the given quantities.
+func SumQuantities(values ...resource.Quantity) resource.Quantity {
+	res := resource.Quantity{}
+	for _, v := range values {
+		res.Add(v)
+	}
+	return res
+}
+
+func multiplyQuantity(quantity resource.Quantity, multiplier int32) resource.Quantity {
+	res := resource.Quantity{}
+	for i := 0; i < int(multiplier); i++ {
+		res.Add(quantity)
+	}
+	return res
+}
+
+// shootWorkersWithVolumes returns the workers of the given shoot. Workers without a volume get the default storage of
+// their machine type, if configured in the cloud profile.
+func shootWorkersWithVolumes(shoot *gardencorev1beta1.Shoot, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) []gardencorev1beta1.Worker {
+	workers := make([]gardencorev1beta1.Worker, 0, len(shoot.Spec.Provider.Workers))
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		workerCopy := worker.DeepCopy()
+
+		if worker.Volume == nil {
+			for _, machineType := range cloudProfileSpec.MachineTypes {
+				if worker.Machine.Type == machineType.Name && machineType.Storage != nil && machineType.Storage.StorageSize != nil {
+					workerCopy.Volume = &gardencorev1beta1.Volume{
+						Type:       &machineType.Storage.Type,
+						VolumeSize: machineType.Storage.StorageSize.String(),
+					}
+				}
+			}
+		}
+
+		workers = append(workers, *workerCopy)
+	}
+
+	return workers
+}
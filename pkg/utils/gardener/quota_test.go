@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("Quota", func() {
+	Describe("#ComputeShootQuotaResources", func() {
+		var (
+			shoot            *gardencorev1beta1.Shoot
+			cloudProfileSpec *gardencorev1beta1.CloudProfileSpec
+		)
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{
+							{
+								Name:    "standard",
+								Machine: gardencorev1beta1.Machine{Type: "m1"},
+								Maximum: 2,
+								Volume:  &gardencorev1beta1.Volume{Type: ptr.To("standard"), VolumeSize: "20Gi"},
+							},
+							{
+								Name:    "premium",
+								Machine: gardencorev1beta1.Machine{Type: "m2"},
+								Maximum: 3,
+							},
+						},
+					},
+				},
+			}
+			cloudProfileSpec = &gardencorev1beta1.CloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{
+					{Name: "m1", CPU: resource.MustParse("2"), GPU: resource.MustParse("0"), Memory: resource.MustParse("8Gi")},
+					{
+						Name:    "m2",
+						CPU:     resource.MustParse("4"),
+						GPU:     resource.MustParse("1"),
+						Memory:  resource.MustParse("16Gi"),
+						Storage: &gardencorev1beta1.MachineTypeStorage{Class: "premium", Type: "ssd", StorageSize: ptr.To(resource.MustParse("50Gi"))},
+					},
+				},
+				VolumeTypes: []gardencorev1beta1.VolumeType{{Name: "standard", Class: "standard"}},
+			}
+		})
+
+		It("should compute the resources of all workers and the load balancers", func() {
+			resources, err := ComputeShootQuotaResources(shoot, cloudProfileSpec)
+			Expect(err).NotTo(HaveOccurred())
+
+			for metric, expected := range map[corev1.ResourceName]string{
+				core.QuotaMetricCPU:             "16",
+				core.QuotaMetricGPU:             "3",
+				core.QuotaMetricMemory:          "64Gi",
+				core.QuotaMetricStorageStandard: "40Gi",
+				core.QuotaMetricStoragePremium:  "150Gi",
+				core.QuotaMetricLoadbalancer:    "1",
+			} {
+				quantity := resources[metric]
+				Expect(quantity.Cmp(resource.MustParse(expected))).To(BeZero(), "metric %s is %s, expected %s", metric, quantity.String(), expected)
+			}
+		})
+
+		It("should count an additional load balancer for the nginx-ingress addon", func() {
+			shoot.Spec.Addons = &gardencorev1beta1.Addons{NginxIngress: &gardencorev1beta1.NginxIngress{Addon: gardencorev1beta1.Addon{Enabled: true}}}
+
+			resources, err := ComputeShootQuotaResources(shoot, cloudProfileSpec)
+			Expect(err).NotTo(HaveOccurred())
+			loadBalancers := resources[core.QuotaMetricLoadbalancer]
+			Expect(loadBalancers.Value()).To(Equal(int64(2)))
+		})
+
+		It("should fail if the machine type is unknown", func() {
+			shoot.Spec.Provider.Workers[0].Machine.Type = "unknown"
+
+			_, err := ComputeShootQuotaResources(shoot, cloudProfileSpec)
+			Expect(err).To(MatchError("machineType unknown not found in CloudProfile"))
+		})
+	})
+})
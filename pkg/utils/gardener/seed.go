@@ -152,3 +152,38 @@ func RequiredExtensionsReady(ctx context.Context, gardenClient client.Client, se
 func GetIPStackForSeed(seed *gardencorev1beta1.Seed) string {
 	return getIPStackForFamilies(seed.Spec.Networks.IPFamilies)
 }
+
+// ObservedSeedResourceNames are the names of the resources whose capacity, allocatable amount and usage are observed in
+// the seed cluster and published in the Seed status by gardenlet.
+var ObservedSeedResourceNames = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+// SeedNodeResources returns the sums of the capacity and of the allocatable amount of the observed resources (see
+// ObservedSeedResourceNames) of the given nodes. Unschedulable nodes are not considered.
+func SeedNodeResources(nodes []corev1.Node) (capacity, allocatable corev1.ResourceList) {
+	capacity, allocatable = corev1.ResourceList{}, corev1.ResourceList{}
+
+	for _, node := range nodes {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		AddObservedSeedResources(capacity, node.Status.Capacity)
+		AddObservedSeedResources(allocatable, node.Status.Allocatable)
+	}
+
+	return capacity, allocatable
+}
+
+// AddObservedSeedResources adds the quantities of the observed resources (see ObservedSeedResourceNames) in <add> to
+// <total>.
+func AddObservedSeedResources(total, add corev1.ResourceList) {
+	for _, resourceName := range ObservedSeedResourceNames {
+		quantity, ok := add[resourceName]
+		if !ok {
+			continue
+		}
+
+		sum := total[resourceName]
+		sum.Add(quantity)
+		total[resourceName] = sum
+	}
+}
@@ -15,6 +15,7 @@ import (
 	gomegatypes "github.com/onsi/gomega/types"
 	certificatesv1 "k8s.io/api/certificates/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
@@ -464,4 +465,39 @@ var _ = Describe("utils", func() {
 		Entry("dual-stack seed (ipv4 preferred)", &gardencorev1beta1.Seed{Spec: gardencorev1beta1.SeedSpec{Networks: gardencorev1beta1.SeedNetworks{IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}}}}, "dual-stack"),
 		Entry("dual-stack seed (ipv6 preferred)", &gardencorev1beta1.Seed{Spec: gardencorev1beta1.SeedSpec{Networks: gardencorev1beta1.SeedNetworks{IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv6, gardencorev1beta1.IPFamilyIPv4}}}}, "dual-stack"),
 	)
+
+	Describe("#SeedNodeResources", func() {
+		It("should sum up the capacity and the allocatable resources of the schedulable nodes", func() {
+			node := func(unschedulable bool, cpuCapacity, cpuAllocatable, memoryCapacity, memoryAllocatable string) corev1.Node {
+				return corev1.Node{
+					Spec: corev1.NodeSpec{Unschedulable: unschedulable},
+					Status: corev1.NodeStatus{
+						Capacity: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse(cpuCapacity),
+							corev1.ResourceMemory: resource.MustParse(memoryCapacity),
+							corev1.ResourcePods:   resource.MustParse("110"),
+						},
+						Allocatable: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse(cpuAllocatable),
+							corev1.ResourceMemory: resource.MustParse(memoryAllocatable),
+							corev1.ResourcePods:   resource.MustParse("110"),
+						},
+					},
+				}
+			}
+
+			capacity, allocatable := SeedNodeResources([]corev1.Node{
+				node(false, "4", "3800m", "16Gi", "15Gi"),
+				node(false, "8", "7800m", "32Gi", "31Gi"),
+				node(true, "8", "7800m", "32Gi", "31Gi"),
+			})
+
+			Expect(capacity).To(HaveLen(2))
+			Expect(capacity.Cpu().Cmp(resource.MustParse("12"))).To(Equal(0))
+			Expect(capacity.Memory().Cmp(resource.MustParse("48Gi"))).To(Equal(0))
+			Expect(allocatable).To(HaveLen(2))
+			Expect(allocatable.Cpu().Cmp(resource.MustParse("11600m"))).To(Equal(0))
+			Expect(allocatable.Memory().Cmp(resource.MustParse("46Gi"))).To(Equal(0))
+		})
+	})
 })
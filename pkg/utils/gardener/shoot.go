@@ -144,7 +144,7 @@ func EffectiveShootMaintenanceTimeWindow(shoot *gardencorev1beta1.Shoot) *timewi
 		return timewindow.AlwaysTimeWindow
 	}
 
-	timeWindow, err := timewindow.ParseMaintenanceTimeWindow(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End)
+	timeWindow, err := timewindow.ParseMaintenanceTimeWindow(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End, v1beta1helper.MaintenanceTimeWindowParseOptions(maintenance.TimeWindow)...)
 	if err != nil {
 		return timewindow.AlwaysTimeWindow
 	}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootstate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// flowStateDataName is the name of the GardenerResourceData entry in the ShootState which holds the task state of
+// the last interrupted or failed flow execution.
+const flowStateDataName = "flow-state"
+
+type flowState struct {
+	Flow  string         `json:"flow"`
+	Tasks flow.TaskState `json:"tasks"`
+}
+
+type flowStateStore struct {
+	client    client.Client
+	name      string
+	namespace string
+}
+
+// NewFlowStateStore returns a flow.StateStore which persists the task state of flow executions for the given shoot in
+// its ShootState resource in the garden cluster. Only the state of one flow is kept at a time. If the ShootState does
+// not contain any other data after the state has been deleted, the ShootState is deleted as well.
+func NewFlowStateStore(gardenClient client.Client, shoot *gardencorev1beta1.Shoot) flow.StateStore {
+	return &flowStateStore{
+		client:    gardenClient,
+		name:      shoot.Name,
+		namespace: shoot.Namespace,
+	}
+}
+
+func (f *flowStateStore) emptyShootState() *gardencorev1beta1.ShootState {
+	return &gardencorev1beta1.ShootState{ObjectMeta: metav1.ObjectMeta{Name: f.name, Namespace: f.namespace}}
+}
+
+// Load implements flow.StateStore.
+func (f *flowStateStore) Load(ctx context.Context, flowName string) (flow.TaskState, error) {
+	shootState := f.emptyShootState()
+	if err := f.client.Get(ctx, client.ObjectKeyFromObject(shootState), shootState); err != nil {
+		if apierrors.IsNotFound(err) {
+			return flow.TaskState{}, nil
+		}
+		return nil, err
+	}
+
+	state, err := getFlowState(shootState)
+	if err != nil {
+		return nil, err
+	}
+
+	if state == nil || state.Flow != flowName || state.Tasks == nil {
+		return flow.TaskState{}, nil
+	}
+	return state.Tasks, nil
+}
+
+// Store implements flow.StateStore.
+func (f *flowStateStore) Store(ctx context.Context, flowName string, state flow.TaskState) error {
+	data, err := json.Marshal(flowState{Flow: flowName, Tasks: state})
+	if err != nil {
+		return fmt.Errorf("failed marshalling flow state: %w", err)
+	}
+
+	shootState := f.emptyShootState()
+	if err := f.client.Get(ctx, client.ObjectKeyFromObject(shootState), shootState); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		setFlowState(shootState, data)
+		return f.client.Create(ctx, shootState)
+	}
+
+	// A strategic merge patch cannot be computed for the raw data, hence, the list is replaced as a whole. The
+	// optimistic lock prevents overwriting concurrent changes.
+	patch := client.MergeFromWithOptions(shootState.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	setFlowState(shootState, data)
+	return f.client.Patch(ctx, shootState, patch)
+}
+
+func setFlowState(shootState *gardencorev1beta1.ShootState, data []byte) {
+	gardenerData := v1beta1helper.GardenerResourceDataList(shootState.Spec.Gardener)
+	gardenerData.Upsert(&gardencorev1beta1.GardenerResourceData{
+		Name: flowStateDataName,
+		Type: v1beta1constants.DataTypeFlowState,
+		Data: runtime.RawExtension{Raw: data},
+	})
+	shootState.Spec.Gardener = gardenerData
+}
+
+// Delete implements flow.StateStore.
+func (f *flowStateStore) Delete(ctx context.Context, flowName string) error {
+	shootState := f.emptyShootState()
+	if err := f.client.Get(ctx, client.ObjectKeyFromObject(shootState), shootState); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	state, err := getFlowState(shootState)
+	if err != nil {
+		return err
+	}
+	if state == nil || state.Flow != flowName {
+		return nil
+	}
+
+	patch := client.MergeFromWithOptions(shootState.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	gardenerData := v1beta1helper.GardenerResourceDataList(shootState.Spec.Gardener)
+	gardenerData.Delete(flowStateDataName)
+	shootState.Spec.Gardener = gardenerData
+
+	if len(shootState.Spec.Gardener) == 0 && len(shootState.Spec.Extensions) == 0 && len(shootState.Spec.Resources) == 0 {
+		// The ShootState was only created for persisting the flow state, hence, it is no longer needed.
+		return deleteShootState(ctx, f.client, shootState)
+	}
+
+	return client.IgnoreNotFound(f.client.Patch(ctx, shootState, patch))
+}
+
+func getFlowState(shootState *gardencorev1beta1.ShootState) (*flowState, error) {
+	gardenerData := v1beta1helper.GardenerResourceDataList(shootState.Spec.Gardener)
+	data := gardenerData.Get(flowStateDataName)
+	if data == nil {
+		return nil, nil
+	}
+
+	state := &flowState{}
+	if err := json.Unmarshal(data.Data.Raw, state); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling flow state from ShootState %s: %w", client.ObjectKeyFromObject(shootState), err)
+	}
+	return state, nil
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootstate_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	. "github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("FlowStateStore", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeGardenClient client.Client
+		shoot            *gardencorev1beta1.Shoot
+		shootState       *gardencorev1beta1.ShootState
+
+		store flow.StateStore
+	)
+
+	BeforeEach(func() {
+		fakeGardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "my-shoot", Namespace: "garden-my-project"}}
+		shootState = &gardencorev1beta1.ShootState{ObjectMeta: metav1.ObjectMeta{Name: "my-shoot", Namespace: "garden-my-project"}}
+
+		store = NewFlowStateStore(fakeGardenClient, shoot)
+	})
+
+	It("should return an empty state if the ShootState does not exist", func() {
+		Expect(store.Load(ctx, "foo")).To(BeEmpty())
+		Expect(store.Delete(ctx, "foo")).To(Succeed())
+	})
+
+	It("should store, load and delete the state and remove the ShootState if it is empty afterwards", func() {
+		Expect(store.Store(ctx, "foo", flow.TaskState{"x": "1"})).To(Succeed())
+		Expect(store.Load(ctx, "foo")).To(Equal(flow.TaskState{"x": "1"}))
+		Expect(store.Load(ctx, "bar")).To(BeEmpty())
+
+		Expect(store.Store(ctx, "foo", flow.TaskState{"x": "1", "y": "2"})).To(Succeed())
+		Expect(store.Load(ctx, "foo")).To(Equal(flow.TaskState{"x": "1", "y": "2"}))
+
+		Expect(store.Delete(ctx, "bar")).To(Succeed())
+		Expect(store.Load(ctx, "foo")).To(Equal(flow.TaskState{"x": "1", "y": "2"}))
+
+		Expect(store.Delete(ctx, "foo")).To(Succeed())
+		Expect(store.Load(ctx, "foo")).To(BeEmpty())
+		Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(BeNotFoundError())
+	})
+
+	It("should keep other data of the ShootState", func() {
+		shootState.Spec.Gardener = []gardencorev1beta1.GardenerResourceData{{Name: "secret", Type: "secret", Data: runtime.RawExtension{Raw: []byte(`{}`)}}}
+		Expect(fakeGardenClient.Create(ctx, shootState)).To(Succeed())
+
+		Expect(store.Store(ctx, "foo", flow.TaskState{"x": "1"})).To(Succeed())
+		Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+		Expect(shootState.Spec.Gardener).To(HaveLen(2))
+
+		Expect(store.Delete(ctx, "foo")).To(Succeed())
+		Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+		Expect(shootState.Spec.Gardener).To(ConsistOf(HaveField("Name", "secret")))
+	})
+
+	It("should keep the state when the ShootState spec is overwritten", func() {
+		Expect(store.Store(ctx, "foo", flow.TaskState{"x": "1"})).To(Succeed())
+
+		shoot.Status.TechnicalID = "shoot--my-project--my-shoot"
+		fakeSeedClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		Expect(Deploy(ctx, testclock.NewFakeClock(time.Now()), fakeGardenClient, fakeSeedClient, shoot, true)).To(Succeed())
+
+		Expect(store.Load(ctx, "foo")).To(Equal(flow.TaskState{"x": "1"}))
+	})
+})
@@ -49,7 +49,16 @@ func Deploy(ctx context.Context, clock clock.Clock, gardenClient, seedClient cli
 		metav1.SetMetaDataAnnotation(&shootState.ObjectMeta, v1beta1constants.GardenerTimestamp, clock.Now().UTC().Format(time.RFC3339))
 
 		if overwriteSpec {
+			// The task state of an interrupted or failed flow execution is not computed from the seed, hence, it must
+			// be kept.
+			existingGardenerData := v1beta1helper.GardenerResourceDataList(shootState.Spec.Gardener)
+			flowStateData := existingGardenerData.Get(flowStateDataName)
 			shootState.Spec = *spec
+			if flowStateData != nil {
+				gardenerData := v1beta1helper.GardenerResourceDataList(shootState.Spec.Gardener)
+				gardenerData.Upsert(flowStateData.DeepCopy())
+				shootState.Spec.Gardener = gardenerData
+			}
 			return nil
 		}
 
@@ -78,13 +87,15 @@ func Deploy(ctx context.Context, clock clock.Clock, gardenClient, seedClient cli
 
 // Delete deletes the ShootState resource for the given shoot from the garden cluster.
 func Delete(ctx context.Context, gardenClient client.Client, shoot *gardencorev1beta1.Shoot) error {
-	shootState := &gardencorev1beta1.ShootState{
+	return deleteShootState(ctx, gardenClient, &gardencorev1beta1.ShootState{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      shoot.Name,
 			Namespace: shoot.Namespace,
 		},
-	}
+	})
+}
 
+func deleteShootState(ctx context.Context, gardenClient client.Client, shootState *gardencorev1beta1.ShootState) error {
 	if err := gardenerutils.ConfirmDeletion(ctx, gardenClient, shootState); err != nil {
 		if apierrors.IsNotFound(err) {
 			return nil
@@ -4,36 +4,362 @@
 
 package oci
 
-import "sync"
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
-var defaultCache = newCache()
+	"github.com/go-logr/logr"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// DefaultCacheMaxBytes is the default maximum size of all Helm charts kept in memory.
+const DefaultCacheMaxBytes int64 = 128 << 20
+
+var (
+	defaultCacheMu sync.RWMutex
+	defaultCache   cacher = newCache(CacheOptions{MaxBytes: DefaultCacheMaxBytes})
+)
 
 type cacher interface {
 	Get(key string) ([]byte, bool)
 	Set(key string, blob []byte)
 }
 
-func newCache() *cache {
+// CacheOptions configures the cache for Helm charts pulled from OCI registries.
+type CacheOptions struct {
+	// MaxBytes is the maximum size of all Helm charts kept in memory. When exceeded, the least recently used charts are
+	// evicted. Charts bigger than MaxBytes are not kept in memory at all. If zero or negative, charts are not kept in
+	// memory.
+	MaxBytes int64
+	// Directory is an optional directory in which the Helm charts are persisted, so that they do not need to be pulled
+	// again after a restart. Charts are stored by their digest and verified when they are read.
+	Directory string
+	// DirectoryMaxBytes is the maximum size of all Helm charts persisted in Directory. When exceeded, the least recently
+	// used charts are removed from the directory. If zero or negative, the size of the directory is not limited.
+	DirectoryMaxBytes int64
+}
+
+// ConfigureDefaultCache replaces the cache used by all HelmRegistry instances created afterward with a cache configured
+// by the given options.
+func ConfigureDefaultCache(opts CacheOptions) error {
+	c := newCache(opts)
+	if err := c.initDirectory(); err != nil {
+		return err
+	}
+
+	defaultCacheMu.Lock()
+	defaultCache = c
+	defaultCacheMu.Unlock()
+	return nil
+}
+
+func getDefaultCache() cacher {
+	defaultCacheMu.RLock()
+	defer defaultCacheMu.RUnlock()
+	return defaultCache
+}
+
+func newCache(opts CacheOptions) *cache {
 	return &cache{
-		items: map[string][]byte{},
+		log:     logf.Log.WithName("oci-cache"),
+		opts:    opts,
+		lru:     list.New(),
+		items:   map[string]*list.Element{},
+		nowFunc: time.Now,
 	}
 }
 
-// cache is a basic key-value cache i.e. a map protected by a mutex. Items are never removed from the cache.
+// cache is a size-bounded LRU cache for Helm charts. Optionally, the charts are additionally persisted in a directory,
+// which serves as a second, bigger cache tier surviving restarts.
 type cache struct {
-	mu    sync.RWMutex
-	items map[string][]byte
+	log     logr.Logger
+	opts    CacheOptions
+	nowFunc func() time.Time
+
+	mu    sync.Mutex
+	lru   *list.List
+	items map[string]*list.Element
+	size  int64
+
+	diskMu sync.Mutex
+}
+
+type cacheEntry struct {
+	key  string
+	blob []byte
 }
 
 func (c *cache) Get(key string) ([]byte, bool) {
-	c.mu.RLock()
-	blob, found := c.items[key]
-	c.mu.RUnlock()
-	return blob, found
+	if blob, found := c.getFromMemory(key); found {
+		cacheHits.WithLabelValues(cacheSourceMemory).Inc()
+		return blob, true
+	}
+
+	if blob, found := c.getFromDisk(key); found {
+		cacheHits.WithLabelValues(cacheSourceDisk).Inc()
+		c.setInMemory(key, blob)
+		return blob, true
+	}
+
+	cacheMisses.Inc()
+	return nil, false
 }
 
 func (c *cache) Set(key string, blob []byte) {
+	c.setInMemory(key, blob)
+	c.setOnDisk(key, blob)
+}
+
+func (c *cache) getFromMemory(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, found := c.items[key]
+	if !found {
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*cacheEntry).blob, true
+}
+
+func (c *cache) setInMemory(key string, blob []byte) {
 	c.mu.Lock()
-	c.items[key] = blob
-	c.mu.Unlock()
+	defer c.mu.Unlock()
+
+	if elem, found := c.items[key]; found {
+		c.removeElement(elem)
+	}
+
+	if int64(len(blob)) > c.opts.MaxBytes {
+		return
+	}
+
+	c.items[key] = c.lru.PushFront(&cacheEntry{key: key, blob: blob})
+	c.size += int64(len(blob))
+
+	for c.size > c.opts.MaxBytes {
+		c.removeElement(c.lru.Back())
+		cacheEvictions.WithLabelValues(cacheSourceMemory).Inc()
+	}
+	cacheSize.WithLabelValues(cacheSourceMemory).Set(float64(c.size))
+}
+
+func (c *cache) removeElement(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*cacheEntry)
+	delete(c.items, entry.key)
+	c.size -= int64(len(entry.blob))
+}
+
+// The directory has the following layout:
+//   - blobs/sha256/<digest>: the Helm chart with the given sha256 digest
+//   - refs/<sha256 of key>: the digest of the Helm chart stored for the cache key
+//
+// Cache keys are hashed since they contain characters which are not allowed in file names.
+
+func (c *cache) blobsDir() string {
+	return filepath.Join(c.opts.Directory, "blobs", "sha256")
+}
+
+func (c *cache) refsDir() string {
+	return filepath.Join(c.opts.Directory, "refs")
+}
+
+func (c *cache) refPath(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.refsDir(), hex.EncodeToString(sum[:]))
+}
+
+func (c *cache) initDirectory() error {
+	if c.opts.Directory == "" {
+		return nil
+	}
+
+	for _, dir := range []string{c.blobsDir(), c.refsDir()} {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return fmt.Errorf("failed creating cache directory %s: %w", dir, err)
+		}
+	}
+
+	c.diskMu.Lock()
+	defer c.diskMu.Unlock()
+	return c.pruneDisk()
+}
+
+func (c *cache) getFromDisk(key string) ([]byte, bool) {
+	if c.opts.Directory == "" {
+		return nil, false
+	}
+
+	c.diskMu.Lock()
+	defer c.diskMu.Unlock()
+
+	refPath := c.refPath(key)
+	ref, err := os.ReadFile(refPath) // #nosec G304 -- The path is constructed from a hash.
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			c.log.Error(err, "Failed reading cache reference", "key", key)
+		}
+		return nil, false
+	}
+
+	digest, ok := strings.CutPrefix(string(ref), "sha256:")
+	if !ok || !isHexDigest(digest) {
+		c.log.Info("Removing invalid cache reference", "key", key)
+		c.removeFile(refPath)
+		return nil, false
+	}
+
+	blobPath := filepath.Join(c.blobsDir(), digest)
+	blob, err := os.ReadFile(blobPath) // #nosec G304 -- The path is constructed from a validated digest.
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			c.log.Error(err, "Failed reading cached chart", "key", key)
+		}
+		// The blob was pruned or could not be read, the reference is useless without it.
+		c.removeFile(refPath)
+		return nil, false
+	}
+
+	if sum := sha256.Sum256(blob); hex.EncodeToString(sum[:]) != digest {
+		c.log.Info("Removing cached chart with mismatching digest", "key", key, "digest", "sha256:"+digest)
+		c.removeFile(blobPath)
+		c.removeFile(refPath)
+		return nil, false
+	}
+
+	// Update the modification time, it is used to determine the least recently used charts when pruning the directory.
+	now := c.nowFunc()
+	if err := os.Chtimes(blobPath, now, now); err != nil {
+		c.log.Error(err, "Failed updating modification time of cached chart", "key", key)
+	}
+
+	return blob, true
+}
+
+func (c *cache) setOnDisk(key string, blob []byte) {
+	if c.opts.Directory == "" {
+		return
+	}
+
+	c.diskMu.Lock()
+	defer c.diskMu.Unlock()
+
+	sum := sha256.Sum256(blob)
+	digest := hex.EncodeToString(sum[:])
+
+	if err := c.writeFile(filepath.Join(c.blobsDir(), digest), blob); err != nil {
+		c.log.Error(err, "Failed persisting chart", "key", key)
+		return
+	}
+	if err := c.writeFile(c.refPath(key), []byte("sha256:"+digest)); err != nil {
+		c.log.Error(err, "Failed persisting cache reference", "key", key)
+		return
+	}
+
+	if err := c.pruneDisk(); err != nil {
+		c.log.Error(err, "Failed pruning cache directory")
+	}
+}
+
+// writeFile writes the data to a temporary file first and renames it afterward, so that readers never see partially
+// written files.
+func (c *cache) writeFile(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer c.removeFile(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	now := c.nowFunc()
+	if err := os.Chtimes(f.Name(), now, now); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// pruneDisk removes the least recently used charts from the directory until its size is within the budget. References
+// to removed charts are cleaned up lazily when they are read.
+func (c *cache) pruneDisk() error {
+	entries, err := os.ReadDir(c.blobsDir())
+	if err != nil {
+		return err
+	}
+
+	type blobFile struct {
+		name    string
+		size    int64
+		modTime time.Time
+	}
+
+	var (
+		blobs []blobFile
+		size  int64
+	)
+
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".tmp-") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return err
+		}
+		blobs = append(blobs, blobFile{name: entry.Name(), size: info.Size(), modTime: info.ModTime()})
+		size += info.Size()
+	}
+
+	if c.opts.DirectoryMaxBytes > 0 && size > c.opts.DirectoryMaxBytes {
+		slices.SortFunc(blobs, func(a, b blobFile) int {
+			return a.modTime.Compare(b.modTime)
+		})
+
+		for _, blob := range blobs {
+			if size <= c.opts.DirectoryMaxBytes {
+				break
+			}
+			if err := os.Remove(filepath.Join(c.blobsDir(), blob.name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+			size -= blob.size
+			cacheEvictions.WithLabelValues(cacheSourceDisk).Inc()
+		}
+	}
+
+	cacheSize.WithLabelValues(cacheSourceDisk).Set(float64(size))
+	return nil
+}
+
+func (c *cache) removeFile(path string) {
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		c.log.Error(err, "Failed removing file from cache directory", "path", path)
+	}
+}
+
+func isHexDigest(s string) bool {
+	if len(s) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
 }
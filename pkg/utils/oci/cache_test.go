@@ -5,15 +5,20 @@
 package oci
 
 import (
+	"os"
+	"path/filepath"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 )
 
 var _ = Describe("cache", func() {
 	It("should store and retrieve values", func() {
 		key := "foo"
 		data := []byte("bar")
-		c := newCache()
+		c := newCache(CacheOptions{MaxBytes: DefaultCacheMaxBytes})
 
 		_, found := c.Get(key)
 		Expect(found).To(BeFalse())
@@ -24,4 +29,161 @@ var _ = Describe("cache", func() {
 		Expect(found).To(BeTrue())
 		Expect(out).To(Equal(data))
 	})
+
+	Describe("in memory", func() {
+		var c *cache
+
+		BeforeEach(func() {
+			c = newCache(CacheOptions{MaxBytes: 10})
+		})
+
+		It("should evict the least recently used values when exceeding the size limit", func() {
+			evictions := testutil.ToFloat64(cacheEvictions.WithLabelValues(cacheSourceMemory))
+
+			c.Set("a", []byte("1234"))
+			c.Set("b", []byte("1234"))
+			_, found := c.Get("a")
+			Expect(found).To(BeTrue())
+
+			c.Set("c", []byte("1234"))
+
+			_, found = c.Get("b")
+			Expect(found).To(BeFalse())
+			_, found = c.Get("a")
+			Expect(found).To(BeTrue())
+			_, found = c.Get("c")
+			Expect(found).To(BeTrue())
+			Expect(c.size).To(Equal(int64(8)))
+			Expect(testutil.ToFloat64(cacheEvictions.WithLabelValues(cacheSourceMemory))).To(Equal(evictions + 1))
+		})
+
+		It("should not store values exceeding the size limit", func() {
+			c.Set("a", []byte("12345678901"))
+
+			_, found := c.Get("a")
+			Expect(found).To(BeFalse())
+			Expect(c.size).To(BeZero())
+		})
+
+		It("should account for replaced values", func() {
+			c.Set("a", []byte("1234"))
+			c.Set("a", []byte("123456"))
+
+			out, found := c.Get("a")
+			Expect(found).To(BeTrue())
+			Expect(out).To(Equal([]byte("123456")))
+			Expect(c.size).To(Equal(int64(6)))
+		})
+
+		It("should record hits and misses", func() {
+			hits := testutil.ToFloat64(cacheHits.WithLabelValues(cacheSourceMemory))
+			misses := testutil.ToFloat64(cacheMisses)
+
+			c.Get("a")
+			c.Set("a", []byte("1234"))
+			c.Get("a")
+
+			Expect(testutil.ToFloat64(cacheHits.WithLabelValues(cacheSourceMemory))).To(Equal(hits + 1))
+			Expect(testutil.ToFloat64(cacheMisses)).To(Equal(misses + 1))
+		})
+	})
+
+	Describe("on disk", func() {
+		var (
+			dir string
+			now time.Time
+		)
+
+		newDiskCache := func(opts CacheOptions) *cache {
+			opts.Directory = dir
+			c := newCache(opts)
+			c.nowFunc = func() time.Time { return now }
+			Expect(c.initDirectory()).To(Succeed())
+			return c
+		}
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+			now = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		})
+
+		It("should persist values across cache instances", func() {
+			newDiskCache(CacheOptions{MaxBytes: 10}).Set("repo@sha256:abc", []byte("chart"))
+
+			c := newDiskCache(CacheOptions{MaxBytes: 10})
+			hits := testutil.ToFloat64(cacheHits.WithLabelValues(cacheSourceDisk))
+
+			out, found := c.Get("repo@sha256:abc")
+			Expect(found).To(BeTrue())
+			Expect(out).To(Equal([]byte("chart")))
+			Expect(testutil.ToFloat64(cacheHits.WithLabelValues(cacheSourceDisk))).To(Equal(hits + 1))
+
+			By("promoting the value to memory")
+			Expect(c.items).To(HaveKey("repo@sha256:abc"))
+		})
+
+		It("should persist values which do not fit in memory", func() {
+			c := newDiskCache(CacheOptions{MaxBytes: 1})
+			c.Set("a", []byte("chart"))
+
+			out, found := c.Get("a")
+			Expect(found).To(BeTrue())
+			Expect(out).To(Equal([]byte("chart")))
+		})
+
+		It("should store identical values only once", func() {
+			c := newDiskCache(CacheOptions{})
+			c.Set("a", []byte("chart"))
+			c.Set("b", []byte("chart"))
+
+			Expect(os.ReadDir(c.blobsDir())).To(HaveLen(1))
+			Expect(os.ReadDir(c.refsDir())).To(HaveLen(2))
+		})
+
+		It("should remove values with mismatching digest", func() {
+			newDiskCache(CacheOptions{}).Set("a", []byte("chart"))
+
+			c := newDiskCache(CacheOptions{})
+			blobs, err := os.ReadDir(c.blobsDir())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(blobs).To(HaveLen(1))
+			Expect(os.WriteFile(filepath.Join(c.blobsDir(), blobs[0].Name()), []byte("tampered"), 0o600)).To(Succeed())
+
+			_, found := c.Get("a")
+			Expect(found).To(BeFalse())
+			Expect(os.ReadDir(c.blobsDir())).To(BeEmpty())
+			Expect(os.ReadDir(c.refsDir())).To(BeEmpty())
+		})
+
+		It("should remove invalid references", func() {
+			c := newDiskCache(CacheOptions{})
+			Expect(os.WriteFile(c.refPath("a"), []byte("sha256:../../foo"), 0o600)).To(Succeed())
+
+			_, found := c.Get("a")
+			Expect(found).To(BeFalse())
+			Expect(os.ReadDir(c.refsDir())).To(BeEmpty())
+		})
+
+		It("should remove the least recently used values when exceeding the size limit", func() {
+			c := newDiskCache(CacheOptions{DirectoryMaxBytes: 10})
+			evictions := testutil.ToFloat64(cacheEvictions.WithLabelValues(cacheSourceDisk))
+
+			c.Set("a", []byte("1234"))
+			now = now.Add(time.Minute)
+			c.Set("b", []byte("5678"))
+			now = now.Add(time.Minute)
+			_, found := c.getFromDisk("a")
+			Expect(found).To(BeTrue())
+			now = now.Add(time.Minute)
+			c.Set("c", []byte("abcd"))
+
+			_, found = c.getFromDisk("b")
+			Expect(found).To(BeFalse())
+			_, found = c.getFromDisk("a")
+			Expect(found).To(BeTrue())
+			_, found = c.getFromDisk("c")
+			Expect(found).To(BeTrue())
+			Expect(testutil.ToFloat64(cacheEvictions.WithLabelValues(cacheSourceDisk))).To(Equal(evictions + 1))
+		})
+	})
 })
@@ -42,16 +42,18 @@ type Interface interface {
 
 // HelmRegistry can pull OCI Helm Charts.
 type HelmRegistry struct {
-	cache  cacher
-	client client.Client
+	cache    cacher
+	verifier verifier
+	client   client.Client
 }
 
 // NewHelmRegistry creates a new HelmRegistry.
 // The client is used to get pull secrets if needed.
 func NewHelmRegistry(c client.Client) *HelmRegistry {
 	return &HelmRegistry{
-		cache:  defaultCache,
-		client: c,
+		cache:    getDefaultCache(),
+		verifier: getDefaultVerifier(),
+		client:   c,
 	}
 }
 
@@ -84,14 +86,22 @@ func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository
 		remoteOpts = append(remoteOpts, remote.WithAuthFromKeychain(&keychain{pullSecret: string(secret.Data[corev1.DockerConfigJsonKey])}))
 	}
 
-	key, err := cacheKeyFromRef(ref, remoteOpts...)
+	digest, err := digestFromRef(ref, remoteOpts...)
 	if err != nil {
 		return nil, err
 	}
-	if key != "" {
-		if blob, found := r.cache.Get(key); found {
-			return blob, nil
+
+	if r.verifier != nil {
+		if err := r.verifier.Verify(digest, remoteOpts...); err != nil {
+			return nil, err
 		}
+		// Pull exactly the verified artifact, the tag might have been moved in the meantime.
+		ref = digest
+	}
+
+	key := digest.Name()
+	if blob, found := r.cache.Get(key); found {
+		return blob, nil
 	}
 
 	img, err := remote.Image(ref, remoteOpts...)
@@ -104,11 +114,11 @@ func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository
 	}
 
 	// construct cache key based on digest of the pulled artifact
-	digest, err := img.Digest()
+	imgDigest, err := img.Digest()
 	if err != nil {
 		return nil, err
 	}
-	key = ref.Context().Digest(digest.String()).Name()
+	key = ref.Context().Digest(imgDigest.String()).Name()
 	r.cache.Set(key, blob)
 
 	return blob, nil
@@ -129,11 +139,11 @@ func buildRef(oci *gardencorev1.OCIRepository) (name.Reference, error) {
 	return name.ParseReference(ref, opts...)
 }
 
-// cacheKeyFromRef returns "repo@sha256:digest". If the ref is not a digest, the remote repository is queried to
+// digestFromRef returns "repo@sha256:digest". If the ref is not a digest, the remote repository is queried to
 // retrieve the digest pointed to by the ref.
-func cacheKeyFromRef(ref name.Reference, opts ...remote.Option) (string, error) {
+func digestFromRef(ref name.Reference, opts ...remote.Option) (name.Digest, error) {
 	if ref, ok := ref.(name.Digest); ok {
-		return ref.Name(), nil
+		return ref, nil
 	}
 
 	var digest gcrv1.Hash
@@ -143,11 +153,11 @@ func cacheKeyFromRef(ref name.Reference, opts ...remote.Option) (string, error)
 	} else {
 		rd, gErr := remote.Get(ref, opts...)
 		if gErr != nil {
-			return "", fmt.Errorf("failed get manifest from remote trying to determine digest: %w", errors.Join(gErr, hErr))
+			return name.Digest{}, fmt.Errorf("failed get manifest from remote trying to determine digest: %w", errors.Join(gErr, hErr))
 		}
 		digest = rd.Digest
 	}
-	return ref.Context().Digest(digest.String()).Name(), nil
+	return ref.Context().Digest(digest.String()), nil
 }
 
 func extractHelmLayer(image gcrv1.Image) ([]byte, error) {
@@ -32,7 +32,7 @@ var _ = Describe("helmregistry", func() {
 
 	BeforeEach(func() {
 		ctx = context.Background()
-		rc = &recordingCache{cache: newCache()}
+		rc = &recordingCache{cache: newCache(CacheOptions{MaxBytes: DefaultCacheMaxBytes})}
 		hr = &HelmRegistry{cache: rc}
 	})
 
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "gardener"
+	metricsSubsystem = "oci_chart_cache"
+
+	cacheSourceMemory = "memory"
+	cacheSourceDisk   = "disk"
+)
+
+var (
+	factory = promauto.With(runtimemetrics.Registry)
+
+	cacheHits = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "hits_total",
+			Help:      "Number of Helm charts served from the cache. The value of the label 'source' can either be 'memory' or 'disk'.",
+		},
+		[]string{"source"},
+	)
+
+	cacheMisses = factory.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "misses_total",
+			Help:      "Number of Helm charts which were not found in the cache and had to be pulled from the registry.",
+		},
+	)
+
+	cacheEvictions = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "evictions_total",
+			Help:      "Number of Helm charts evicted from the cache because its size limit was exceeded. The value of the label 'source' can either be 'memory' or 'disk'.",
+		},
+		[]string{"source"},
+	)
+
+	cacheSize = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "size_bytes",
+			Help:      "Size of all Helm charts in the cache. The value of the label 'source' can either be 'memory' or 'disk'.",
+		},
+		[]string{"source"},
+	)
+)
@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"k8s.io/utils/lru"
+)
+
+const (
+	// mediaTypeCosignSimpleSigning is the media type of the layers of cosign signature artifacts.
+	mediaTypeCosignSimpleSigning = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// annotationCosignSignature is the annotation of cosign signature layers containing the base64-encoded signature
+	// of the layer's payload.
+	annotationCosignSignature = "dev.cosignproject.cosign/signature"
+	// cosignSignatureType is the type of the payload of cosign signatures for container images.
+	cosignSignatureType = "cosign container image signature"
+	// maxVerifiedDigests is the maximum number of successfully verified artifacts remembered by a CosignVerifier.
+	maxVerifiedDigests = 1024
+)
+
+var (
+	defaultVerifierMu sync.RWMutex
+	defaultVerifier   verifier
+)
+
+// ErrSignatureVerification is returned (wrapped) if the signature of a pulled artifact could not be verified.
+var ErrSignatureVerification = errors.New("signature verification failed")
+
+type verifier interface {
+	Verify(ref name.Digest, opts ...remote.Option) error
+}
+
+// ConfigureSignatureVerification makes all HelmRegistry instances created afterward verify that pulled Helm charts
+// are signed with cosign by one of the given PEM-encoded public keys. Unsigned charts or charts with signatures not
+// matching any of the keys are rejected. If no keys are given, signatures are not verified.
+// TODO: Verify charts with public keys referenced by their ControllerDeployment once the OCIRepository API allows
+// referencing them, see the follow-ups in docs/extensions/registration.md.
+func ConfigureSignatureVerification(publicKeys ...[]byte) error {
+	var v verifier
+	if len(publicKeys) > 0 {
+		cv, err := NewCosignVerifier(publicKeys...)
+		if err != nil {
+			return err
+		}
+		v = cv
+	}
+
+	defaultVerifierMu.Lock()
+	defaultVerifier = v
+	defaultVerifierMu.Unlock()
+	return nil
+}
+
+func getDefaultVerifier() verifier {
+	defaultVerifierMu.RLock()
+	defer defaultVerifierMu.RUnlock()
+	return defaultVerifier
+}
+
+// CosignVerifier verifies cosign signatures of OCI artifacts created with a public/private key pair. The signatures are
+// expected in the same repository as the artifact, tagged with "sha256-<digest>.sig".
+type CosignVerifier struct {
+	publicKeys []crypto.PublicKey
+	// verified contains the names of the artifacts which were successfully verified. The least recently used entries
+	// are evicted if it exceeds maxVerifiedDigests.
+	verified *lru.Cache
+}
+
+// NewCosignVerifier creates a new CosignVerifier accepting signatures of any of the given PEM-encoded public keys.
+// ECDSA, RSA and Ed25519 keys are supported.
+func NewCosignVerifier(publicKeys ...[]byte) (*CosignVerifier, error) {
+	v := &CosignVerifier{verified: lru.New(maxVerifiedDigests)}
+
+	for i, data := range publicKeys {
+		block, _ := pem.Decode(data)
+		if block == nil {
+			return nil, fmt.Errorf("public key %d is not PEM-encoded", i)
+		}
+
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing public key %d: %w", i, err)
+		}
+
+		switch key.(type) {
+		case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		default:
+			return nil, fmt.Errorf("public key %d has unsupported type %T", i, key)
+		}
+
+		v.publicKeys = append(v.publicKeys, key)
+	}
+
+	return v, nil
+}
+
+// Verify verifies that the artifact with the given digest has a valid signature of one of the public keys. Successful
+// verifications of the most recently used artifacts are remembered, so that their signatures are only fetched once.
+func (v *CosignVerifier) Verify(ref name.Digest, opts ...remote.Option) error {
+	if _, verified := v.verified.Get(ref.Name()); verified {
+		return nil
+	}
+
+	if err := v.verify(ref, opts...); err != nil {
+		return err
+	}
+
+	v.verified.Add(ref.Name(), struct{}{})
+	return nil
+}
+
+func (v *CosignVerifier) verify(ref name.Digest, opts ...remote.Option) error {
+	signatureTag := ref.Context().Tag(strings.Replace(ref.DigestStr(), ":", "-", 1) + ".sig")
+
+	img, err := remote.Image(signatureTag, opts...)
+	if err != nil {
+		var transportErr *transport.Error
+		if errors.As(err, &transportErr) && transportErr.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("%w: no signature found for %s", ErrSignatureVerification, ref)
+		}
+		return fmt.Errorf("failed fetching signature %s: %w", signatureTag, err)
+	}
+
+	manifest, err := img.Manifest()
+	if err != nil {
+		return fmt.Errorf("failed reading manifest of signature %s: %w", signatureTag, err)
+	}
+
+	var errs []error
+	for _, desc := range manifest.Layers {
+		if string(desc.MediaType) != mediaTypeCosignSimpleSigning {
+			continue
+		}
+
+		layer, err := img.LayerByDigest(desc.Digest)
+		if err != nil {
+			return fmt.Errorf("failed reading layer %s of signature %s: %w", desc.Digest, signatureTag, err)
+		}
+		rc, err := layer.Compressed()
+		if err != nil {
+			return fmt.Errorf("failed reading layer %s of signature %s: %w", desc.Digest, signatureTag, err)
+		}
+		payload, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			return fmt.Errorf("failed reading layer %s of signature %s: %w", desc.Digest, signatureTag, err)
+		}
+
+		if err := v.verifySignature(ref, payload, desc.Annotations[annotationCosignSignature]); err != nil {
+			errs = append(errs, fmt.Errorf("signature %s: %w", desc.Digest, err))
+			continue
+		}
+
+		return nil
+	}
+
+	if len(errs) == 0 {
+		return fmt.Errorf("%w: no signature found for %s", ErrSignatureVerification, ref)
+	}
+	return fmt.Errorf("%w: no valid signature found for %s: %w", ErrSignatureVerification, ref, errors.Join(errs...))
+}
+
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+func (v *CosignVerifier) verifySignature(ref name.Digest, payload []byte, encodedSignature string) error {
+	if encodedSignature == "" {
+		return fmt.Errorf("annotation %s is missing", annotationCosignSignature)
+	}
+	signature, err := base64.StdEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return fmt.Errorf("failed decoding signature: %w", err)
+	}
+
+	if !v.signedByAnyKey(payload, signature) {
+		return errors.New("not signed by any of the trusted public keys")
+	}
+
+	// Only inspect the payload after the signature was verified, it must not be trusted before.
+	var p simpleSigningPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("failed decoding payload: %w", err)
+	}
+	if p.Critical.Type != cosignSignatureType {
+		return fmt.Errorf("unexpected payload type %q", p.Critical.Type)
+	}
+	if p.Critical.Image.DockerManifestDigest != ref.DigestStr() {
+		return fmt.Errorf("signature is for digest %s", p.Critical.Image.DockerManifestDigest)
+	}
+
+	return nil
+}
+
+func (v *CosignVerifier) signedByAnyKey(payload, signature []byte) bool {
+	digest := sha256.Sum256(payload)
+
+	for _, key := range v.publicKeys {
+		switch k := key.(type) {
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(k, digest[:], signature) {
+				return true
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], signature) == nil {
+				return true
+			}
+		case ed25519.PublicKey:
+			if ed25519.Verify(k, payload, signature) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,248 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+)
+
+var _ = Describe("CosignVerifier", func() {
+	var (
+		ctx = context.Background()
+
+		ecdsaKey   *ecdsa.PrivateKey
+		ecdsaPEM   []byte
+		digestRef  name.Digest
+		repository string
+	)
+
+	BeforeEach(func() {
+		var err error
+		ecdsaKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		ecdsaPEM = encodePublicKey(&ecdsaKey.PublicKey)
+
+		// use a dedicated repository per test, so that signatures of other tests do not interfere
+		repository = fmt.Sprintf("%s/charts/signed-%s", registryAddress, strings.ToLower(rand.Text()[:8]))
+		digestRef = pushChart(repository)
+	})
+
+	Describe("#NewCosignVerifier", func() {
+		It("should fail for keys which are not PEM-encoded", func() {
+			_, err := NewCosignVerifier([]byte("foo"))
+			Expect(err).To(MatchError(ContainSubstring("not PEM-encoded")))
+		})
+
+		It("should fail for invalid keys", func() {
+			_, err := NewCosignVerifier(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte("foo")}))
+			Expect(err).To(MatchError(ContainSubstring("failed parsing public key 0")))
+		})
+	})
+
+	Describe("#Verify", func() {
+		It("should accept an ECDSA signature", func() {
+			pushSignature(digestRef, signatureLayer(digestRef.DigestStr(), ecdsaSigner(ecdsaKey)))
+
+			v, err := NewCosignVerifier(ecdsaPEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.Verify(digestRef, remote.WithContext(ctx))).To(Succeed())
+		})
+
+		It("should accept an RSA signature", func() {
+			rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			pushSignature(digestRef, signatureLayer(digestRef.DigestStr(), func(payload []byte) []byte {
+				digest := sha256.Sum256(payload)
+				signature, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest[:])
+				Expect(err).NotTo(HaveOccurred())
+				return signature
+			}))
+
+			v, err := NewCosignVerifier(ecdsaPEM, encodePublicKey(&rsaKey.PublicKey))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.Verify(digestRef)).To(Succeed())
+		})
+
+		It("should accept an Ed25519 signature", func() {
+			publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			pushSignature(digestRef, signatureLayer(digestRef.DigestStr(), func(payload []byte) []byte {
+				return ed25519.Sign(privateKey, payload)
+			}))
+
+			v, err := NewCosignVerifier(encodePublicKey(publicKey))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.Verify(digestRef)).To(Succeed())
+		})
+
+		It("should accept if any of the signatures is valid", func() {
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			pushSignature(digestRef,
+				signatureLayer(digestRef.DigestStr(), ecdsaSigner(otherKey)),
+				signatureLayer(digestRef.DigestStr(), ecdsaSigner(ecdsaKey)),
+			)
+
+			v, err := NewCosignVerifier(ecdsaPEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.Verify(digestRef)).To(Succeed())
+		})
+
+		It("should reject unsigned artifacts", func() {
+			v, err := NewCosignVerifier(ecdsaPEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.Verify(digestRef)).To(And(MatchError(ErrSignatureVerification), MatchError(ContainSubstring("no signature found"))))
+		})
+
+		It("should reject signatures of other keys", func() {
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			pushSignature(digestRef, signatureLayer(digestRef.DigestStr(), ecdsaSigner(otherKey)))
+
+			v, err := NewCosignVerifier(ecdsaPEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.Verify(digestRef)).To(And(MatchError(ErrSignatureVerification), MatchError(ContainSubstring("not signed by any of the trusted public keys"))))
+		})
+
+		It("should reject signatures for other digests", func() {
+			pushSignature(digestRef, signatureLayer("sha256:7a855a6d69033dd3240d9648e8bd46a67a528059158e098c7794ac9227735b4a", ecdsaSigner(ecdsaKey)))
+
+			v, err := NewCosignVerifier(ecdsaPEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.Verify(digestRef)).To(And(MatchError(ErrSignatureVerification), MatchError(ContainSubstring("signature is for digest"))))
+		})
+
+		It("should remember successful verifications", func() {
+			pushSignature(digestRef, signatureLayer(digestRef.DigestStr(), ecdsaSigner(ecdsaKey)))
+
+			v, err := NewCosignVerifier(ecdsaPEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.Verify(digestRef)).To(Succeed())
+			_, verified := v.verified.Get(digestRef.Name())
+			Expect(verified).To(BeTrue())
+		})
+
+		It("should limit the number of remembered verifications", func() {
+			pushSignature(digestRef, signatureLayer(digestRef.DigestStr(), ecdsaSigner(ecdsaKey)))
+
+			v, err := NewCosignVerifier(ecdsaPEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.Verify(digestRef)).To(Succeed())
+			for i := range maxVerifiedDigests {
+				v.verified.Add(fmt.Sprintf("other-%d", i), struct{}{})
+			}
+
+			Expect(v.verified.Len()).To(Equal(maxVerifiedDigests))
+			_, verified := v.verified.Get(digestRef.Name())
+			Expect(verified).To(BeFalse())
+		})
+	})
+
+	Describe("HelmRegistry", func() {
+		var hr *HelmRegistry
+
+		BeforeEach(func() {
+			v, err := NewCosignVerifier(ecdsaPEM)
+			Expect(err).NotTo(HaveOccurred())
+			hr = &HelmRegistry{cache: newCache(CacheOptions{MaxBytes: DefaultCacheMaxBytes}), verifier: v}
+		})
+
+		It("should pull signed charts", func() {
+			pushSignature(digestRef, signatureLayer(digestRef.DigestStr(), ecdsaSigner(ecdsaKey)))
+
+			out, err := hr.Pull(ctx, &gardencorev1.OCIRepository{Repository: ptr.To(repository), Tag: ptr.To("0.1.0")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(rawChart))
+		})
+
+		It("should reject unsigned charts", func() {
+			_, err := hr.Pull(ctx, &gardencorev1.OCIRepository{Repository: ptr.To(repository), Tag: ptr.To("0.1.0")})
+			Expect(err).To(MatchError(ErrSignatureVerification))
+		})
+
+		It("should reject unsigned charts even if they are cached", func() {
+			hr.cache.Set(digestRef.Name(), rawChart)
+
+			_, err := hr.Pull(ctx, &gardencorev1.OCIRepository{Repository: ptr.To(repository), Digest: ptr.To(digestRef.DigestStr())})
+			Expect(err).To(MatchError(ErrSignatureVerification))
+		})
+	})
+})
+
+func encodePublicKey(key crypto.PublicKey) []byte {
+	GinkgoHelper()
+
+	der, err := x509.MarshalPKIXPublicKey(key)
+	Expect(err).NotTo(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func ecdsaSigner(key *ecdsa.PrivateKey) func([]byte) []byte {
+	return func(payload []byte) []byte {
+		GinkgoHelper()
+
+		digest := sha256.Sum256(payload)
+		signature, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+		Expect(err).NotTo(HaveOccurred())
+		return signature
+	}
+}
+
+// signatureLayer creates a layer like cosign does for a signature of an artifact with the given digest.
+func signatureLayer(digest string, sign func([]byte) []byte) mutate.Addendum {
+	payload := fmt.Appendf(nil, `{"critical":{"identity":{"docker-reference":"example"},"image":{"docker-manifest-digest":%q},"type":%q},"optional":null}`, digest, cosignSignatureType)
+
+	return mutate.Addendum{
+		Layer:       static.NewLayer(payload, mediaTypeCosignSimpleSigning),
+		Annotations: map[string]string{annotationCosignSignature: base64.StdEncoding.EncodeToString(sign(payload))},
+	}
+}
+
+func pushChart(repository string) name.Digest {
+	GinkgoHelper()
+
+	ref, err := name.ParseReference(repository+":0.1.0", name.Insecure)
+	Expect(err).NotTo(HaveOccurred())
+
+	img, err := mutate.Append(empty.Image, mutate.Addendum{Layer: static.NewLayer(rawChart, mediaTypeHelm)})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(remote.Write(ref, img)).To(Succeed())
+
+	digest, err := img.Digest()
+	Expect(err).NotTo(HaveOccurred())
+	return ref.Context().Digest(digest.String())
+}
+
+func pushSignature(ref name.Digest, layers ...mutate.Addendum) {
+	GinkgoHelper()
+
+	img, err := mutate.Append(mutate.MediaType(empty.Image, types.OCIManifestSchema1), layers...)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(remote.Write(ref.Context().Tag(strings.Replace(ref.DigestStr(), ":", "-", 1)+".sig"), img)).To(Succeed())
+}
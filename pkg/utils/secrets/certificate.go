@@ -5,6 +5,7 @@
 package secrets
 
 import (
+	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -39,6 +40,9 @@ const (
 	DataKeyCertificateCA = "ca.crt"
 	// DataKeyPrivateKeyCA is the key in a secret data holding the CA private key.
 	DataKeyPrivateKeyCA = "ca.key"
+	// DataKeyPrivateKeyCAReference is the key in a secret data holding the reference to the CA private key in case it
+	// is kept in an external key store instead of the secret.
+	DataKeyPrivateKeyCAReference = "ca.key-ref"
 )
 
 const (
@@ -54,7 +58,7 @@ const (
 )
 
 // CertificateSecretConfig contains the specification a to-be-generated CA, server, or client certificate.
-// It always contains a 3072-bit RSA private key.
+// It contains a 3072-bit RSA private key unless another key algorithm is specified.
 type CertificateSecretConfig struct {
 	Name string
 
@@ -66,6 +70,11 @@ type CertificateSecretConfig struct {
 	CertType  CertType
 	SigningCA *Certificate
 	PKCS      int
+	// KeyAlgorithm is the algorithm of the generated private key. Defaults to RSA.
+	KeyAlgorithm KeyAlgorithm
+	// Signer is an existing private key which is used instead of generating a new one, e.g., a signer for a key kept in
+	// an external key store. In this case, KeyAlgorithm is ignored and the private key is not part of the secret data.
+	Signer crypto.Signer `hash:"ignore"`
 
 	Validity                          *time.Duration
 	SkipPublishingCACertificate       bool
@@ -82,7 +91,10 @@ type Certificate struct {
 	SkipPublishingCACertificate       bool
 	IncludeCACertificateInServerChain bool
 
-	PrivateKey    *rsa.PrivateKey
+	// PrivateKey is only set if the private key of the certificate is an RSA key.
+	PrivateKey *rsa.PrivateKey
+	// Signer is the private key of the certificate, independent of its algorithm.
+	Signer        crypto.Signer `hash:"ignore"`
 	PrivateKeyPEM []byte
 
 	Certificate    *x509.Certificate
@@ -111,20 +123,23 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 
 	// If no cert type is given then we only return a certificate object that contains the CA.
 	if s.CertType != "" {
-		privateKey, err := GenerateKey(rand.Reader, 3072)
-		if err != nil {
-			return nil, err
+		privateKey := s.Signer
+		if privateKey == nil {
+			var err error
+			if privateKey, err = GeneratePrivateKey(s.KeyAlgorithm, 3072); err != nil {
+				return nil, err
+			}
 		}
 
 		var (
-			certificate       = s.generateCertificateTemplate()
+			certificate       = s.generateCertificateTemplate(privateKey)
 			certificateSigner = certificate
 			privateKeySigner  = privateKey
 		)
 
 		if s.SigningCA != nil {
 			certificateSigner = s.SigningCA.Certificate
-			privateKeySigner = s.SigningCA.PrivateKey
+			privateKeySigner = s.SigningCA.signer()
 		}
 
 		certificatePEM, err := signCertificate(certificate, privateKey, certificateSigner, privateKeySigner)
@@ -132,20 +147,16 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 			return nil, err
 		}
 
-		var pk []byte
-		switch s.PKCS {
-		case PKCS1:
-			pk = utils.EncodePrivateKey(privateKey)
-		case PKCS8:
-			pk, err = utils.EncodePrivateKeyInPKCS8(privateKey)
-
+		if s.Signer == nil {
+			pk, err := encodePrivateKey(privateKey, s.PKCS)
 			if err != nil {
 				return nil, err
 			}
+			certificateObj.PrivateKeyPEM = pk
 		}
 
-		certificateObj.PrivateKey = privateKey
-		certificateObj.PrivateKeyPEM = pk
+		certificateObj.PrivateKey, _ = privateKey.(*rsa.PrivateKey)
+		certificateObj.Signer = privateKey
 		certificateObj.Certificate = certificate
 		certificateObj.CertificatePEM = certificatePEM
 	}
@@ -162,7 +173,9 @@ func (c *Certificate) SecretData() map[string][]byte {
 		// The certificate is a CA certificate itself, so we use different keys in the secret data (for backwards-
 		// compatibility).
 		data[DataKeyCertificateCA] = c.CertificatePEM
-		data[DataKeyPrivateKeyCA] = c.PrivateKeyPEM
+		if c.PrivateKeyPEM != nil {
+			data[DataKeyPrivateKeyCA] = c.PrivateKeyPEM
+		}
 
 	case c.CA != nil:
 		cert := c.CertificatePEM
@@ -173,7 +186,9 @@ func (c *Certificate) SecretData() map[string][]byte {
 		}
 
 		data[DataKeyCertificate] = cert
-		data[DataKeyPrivateKey] = c.PrivateKeyPEM
+		if c.PrivateKeyPEM != nil {
+			data[DataKeyPrivateKey] = c.PrivateKeyPEM
+		}
 		if !c.SkipPublishingCACertificate {
 			data[DataKeyCertificateCA] = c.CA.CertificatePEM
 		}
@@ -182,10 +197,19 @@ func (c *Certificate) SecretData() map[string][]byte {
 	return data
 }
 
+// signer returns the private key of the certificate. It falls back to the RSA private key for certificates which were
+// not generated or loaded by this package and only have the PrivateKey field set.
+func (c *Certificate) signer() crypto.Signer {
+	if c.Signer == nil && c.PrivateKey != nil {
+		return c.PrivateKey
+	}
+	return c.Signer
+}
+
 // LoadCertificate takes a byte slice representation of a certificate and the corresponding private key, and returns its de-serialized private
 // key, certificate template and PEM certificate which can be used to sign other x509 certificates.
 func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certificate, error) {
-	privateKey, err := utils.DecodePrivateKey(privateKeyPEM)
+	privateKey, err := utils.DecodeSigner(privateKeyPEM)
 	if err != nil {
 		return nil, err
 	}
@@ -194,10 +218,13 @@ func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certif
 		return nil, err
 	}
 
+	rsaPrivateKey, _ := privateKey.(*rsa.PrivateKey)
+
 	return &Certificate{
 		Name: name,
 
-		PrivateKey:    privateKey,
+		PrivateKey:    rsaPrivateKey,
+		Signer:        privateKey,
 		PrivateKeyPEM: privateKeyPEM,
 
 		Certificate:    certificate,
@@ -209,7 +236,7 @@ func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certif
 // common name, organization, SANs (DNS names and IP addresses). It can create a server or a client certificate
 // or both, depending on the <certType> value. If <isCACert> is true, then a CA certificate is being created.
 // The certificates a valid for 10 years.
-func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificate {
+func (s *CertificateSecretConfig) generateCertificateTemplate(privateKey crypto.Signer) *x509.Certificate {
 	now := Clock.Now()
 
 	expiration := now.AddDate(10, 0, 0) // + 10 years
@@ -227,7 +254,7 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 			SerialNumber:          serialNumber,
 			NotBefore:             AdjustToClockSkew(now),
 			NotAfter:              expiration,
-			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			KeyUsage:              x509.KeyUsageDigitalSignature,
 			Subject: pkix.Name{
 				CommonName:   s.CommonName,
 				Organization: s.Organization,
@@ -237,6 +264,11 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 		}
 	)
 
+	// Key encipherment is only applicable to RSA keys, see https://www.rfc-editor.org/rfc/rfc5480#section-3.
+	if _, ok := privateKey.Public().(*rsa.PublicKey); ok {
+		template.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
 	switch s.CertType {
 	case CACert:
 		template.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
@@ -254,8 +286,8 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 // SignCertificate takes a <certificateTemplate> and a <certificateTemplateSigner> which is used to sign
 // the first. It also requires the corresponding private keys of both certificates. The created certificate
 // is returned as byte slice.
-func signCertificate(certificateTemplate *x509.Certificate, privateKey *rsa.PrivateKey, certificateTemplateSigner *x509.Certificate, privateKeySigner *rsa.PrivateKey) ([]byte, error) {
-	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, &privateKey.PublicKey, privateKeySigner)
+func signCertificate(certificateTemplate *x509.Certificate, privateKey crypto.Signer, certificateTemplateSigner *x509.Certificate, privateKeySigner crypto.Signer) ([]byte, error) {
+	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, privateKey.Public(), privateKeySigner)
 	if err != nil {
 		return nil, err
 	}
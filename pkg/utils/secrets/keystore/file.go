@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keystore
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+type fileKeyStore struct {
+	fs        afero.Afero
+	directory string
+}
+
+// NewFile returns a key store which keeps the private keys as plain PEM files in the given directory. It is meant for
+// testing and development purposes only and must not be used in production since the private keys are not protected.
+func NewFile(fs afero.Afero, directory string) Interface {
+	return &fileKeyStore{fs: fs, directory: directory}
+}
+
+func (f *fileKeyStore) Create(ctx context.Context, id string, algorithm secretsutils.KeyAlgorithm) (crypto.Signer, error) {
+	signer, err := f.Get(ctx, id)
+	if err == nil || !errors.Is(err, ErrKeyNotFound) {
+		return signer, err
+	}
+
+	privateKey, err := secretsutils.GeneratePrivateKey(algorithm, 3072)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyPEM, err := utils.EncodePKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed encoding private key %q: %w", id, err)
+	}
+
+	path, err := f.path(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := f.fs.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed creating directory for private key %q: %w", id, err)
+	}
+
+	if err := f.fs.WriteFile(path, privateKeyPEM, 0600); err != nil {
+		return nil, fmt.Errorf("failed writing private key %q: %w", id, err)
+	}
+
+	return &opaqueSigner{privateKey}, nil
+}
+
+func (f *fileKeyStore) Get(_ context.Context, id string) (crypto.Signer, error) {
+	path, err := f.path(id)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyPEM, err := f.fs.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %q", ErrKeyNotFound, id)
+		}
+		return nil, fmt.Errorf("failed reading private key %q: %w", id, err)
+	}
+
+	privateKey, err := utils.DecodeSigner(privateKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding private key %q: %w", id, err)
+	}
+
+	return &opaqueSigner{privateKey}, nil
+}
+
+func (f *fileKeyStore) Delete(_ context.Context, id string) error {
+	path, err := f.path(id)
+	if err != nil {
+		return err
+	}
+
+	if err := f.fs.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed deleting private key %q: %w", id, err)
+	}
+	return nil
+}
+
+func (f *fileKeyStore) path(id string) (string, error) {
+	if !filepath.IsLocal(id) {
+		return "", fmt.Errorf("invalid key id %q", id)
+	}
+	return filepath.Join(f.directory, id+".pem"), nil
+}
+
+// opaqueSigner hides the concrete type of the private key so that consumers cannot access the key material, similar to
+// signers of external key management systems.
+type opaqueSigner struct {
+	privateKey crypto.Signer
+}
+
+func (s *opaqueSigner) Public() crypto.PublicKey {
+	return s.privateKey.Public()
+}
+
+func (s *opaqueSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return s.privateKey.Sign(rand, digest, opts)
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keystore_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	. "github.com/gardener/gardener/pkg/utils/secrets/keystore"
+)
+
+var _ = Describe("File", func() {
+	var (
+		ctx = context.Background()
+
+		fs       afero.Afero
+		keyStore Interface
+	)
+
+	BeforeEach(func() {
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		keyStore = NewFile(fs, "/keys")
+	})
+
+	Describe("#Create", func() {
+		It("should create a new private key and return an opaque signer", func() {
+			signer, err := keyStore.Create(ctx, "foo/ca", secretsutils.KeyAlgorithmECDSAP256)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signer).NotTo(BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+			Expect(signer.Public()).To(BeAssignableToTypeOf(&ecdsa.PublicKey{}))
+
+			digest := sha256.Sum256([]byte("foo"))
+			signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ecdsa.VerifyASN1(signer.Public().(*ecdsa.PublicKey), digest[:], signature)).To(BeTrue())
+
+			Expect(fs.Exists("/keys/foo/ca.pem")).To(BeTrue())
+		})
+
+		It("should return the existing private key", func() {
+			signer, err := keyStore.Create(ctx, "ca", secretsutils.KeyAlgorithmECDSAP256)
+			Expect(err).NotTo(HaveOccurred())
+
+			existing, err := keyStore.Create(ctx, "ca", secretsutils.KeyAlgorithmECDSAP384)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(existing.Public()).To(Equal(signer.Public()))
+		})
+
+		It("should reject IDs escaping the directory", func() {
+			_, err := keyStore.Create(ctx, "../ca", secretsutils.KeyAlgorithmECDSAP256)
+			Expect(err).To(MatchError(ContainSubstring("invalid key id")))
+		})
+	})
+
+	Describe("#Get", func() {
+		It("should return the signer for an existing private key", func() {
+			signer, err := keyStore.Create(ctx, "ca", secretsutils.KeyAlgorithmEd25519)
+			Expect(err).NotTo(HaveOccurred())
+
+			existing, err := keyStore.Get(ctx, "ca")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(existing.Public()).To(Equal(signer.Public()))
+		})
+
+		It("should return an error if the private key does not exist", func() {
+			_, err := keyStore.Get(ctx, "ca")
+			Expect(err).To(MatchError(ErrKeyNotFound))
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete the private key", func() {
+			_, err := keyStore.Create(ctx, "ca", secretsutils.KeyAlgorithmECDSAP256)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(keyStore.Delete(ctx, "ca")).To(Succeed())
+			Expect(fs.Exists("/keys/ca.pem")).To(BeFalse())
+		})
+
+		It("should not return an error if the private key does not exist", func() {
+			Expect(keyStore.Delete(ctx, "ca")).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keystore
+
+import (
+	"context"
+	"crypto"
+	"errors"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// ErrKeyNotFound is returned if a key does not exist in the key store.
+var ErrKeyNotFound = errors.New("key not found")
+
+// Interface is a store for private keys which are kept outside the cluster, e.g., in a KMS or an HSM. The private keys
+// never leave the store. Instead, signers are returned which delegate all signing operations to the store.
+// This package only provides a file-based implementation for testing (see NewFile). Implementations for KMS or HSM
+// backends (e.g., via PKCS#11) are not part of Gardener and must be provided by the consumers of this interface.
+type Interface interface {
+	// Create creates a new private key with the given ID and algorithm and returns a signer for it. If a key with the
+	// given ID already exists, a signer for the existing key is returned.
+	Create(ctx context.Context, id string, algorithm secretsutils.KeyAlgorithm) (crypto.Signer, error)
+	// Get returns a signer for the existing private key with the given ID. If it does not exist, ErrKeyNotFound is
+	// returned.
+	Get(ctx context.Context, id string) (crypto.Signer, error)
+	// Delete deletes the private key with the given ID. It does not return an error if the key does not exist.
+	Delete(ctx context.Context, id string) error
+}
@@ -6,10 +6,12 @@ package manager
 
 import (
 	"context"
+	"fmt"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/pkg/utils/flow"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
 func (m *manager) Cleanup(ctx context.Context) error {
@@ -36,6 +38,19 @@ func (m *manager) Cleanup(ctx context.Context) error {
 		}
 
 		fns = append(fns, func(ctx context.Context) error {
+			// The private key is deleted before the secret, otherwise the reference to it would be lost if the deletion
+			// from the key store fails.
+			if keyReference, ok := secret.Data[secretsutils.DataKeyPrivateKeyCAReference]; ok {
+				if m.caKeyStore == nil {
+					return fmt.Errorf("stale secret %s references private key %q in a key store, but no CA key store is configured", client.ObjectKeyFromObject(&secret), keyReference)
+				}
+
+				m.logger.Info("Deleting stale CA private key from key store", "namespace", secret.Namespace, "name", secret.Name, "keyReference", string(keyReference))
+				if err := m.caKeyStore.Delete(ctx, string(keyReference)); err != nil {
+					return fmt.Errorf("failed deleting private key %q of stale secret %s from key store: %w", keyReference, client.ObjectKeyFromObject(&secret), err)
+				}
+			}
+
 			m.logger.Info("Deleting stale secret", "namespace", secret.Namespace, "name", secret.Name)
 			return client.IgnoreNotFound(m.client.Delete(ctx, &secret))
 		})
@@ -6,10 +6,12 @@ package manager
 
 import (
 	"context"
+	"errors"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
@@ -17,6 +19,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/secrets/keystore"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
@@ -92,6 +96,53 @@ var _ = Describe("Cleanup", func() {
 			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secrets[7]), &corev1.Secret{})).To(BeNotFoundError())
 		})
 
+		It("should delete the private keys of stale CA secrets from the key store", func() {
+			keyStore := keystore.NewFile(afero.Afero{Fs: afero.NewMemMapFs()}, "/keys")
+			m.caKeyStore = keyStore
+
+			_, err := keyStore.Create(ctx, namespace+"/ca-current", secretsutils.KeyAlgorithmECDSAP256)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = keyStore.Create(ctx, namespace+"/ca-stale", secretsutils.KeyAlgorithmECDSAP256)
+			Expect(err).NotTo(HaveOccurred())
+
+			secrets := secretList(testIdentity)[:2]
+			secrets[0].Data = map[string][]byte{"ca.crt": []byte("cert"), "ca.key-ref": []byte(namespace + "/ca-current")}
+			secrets[1].Data = map[string][]byte{"ca.crt": []byte("cert"), "ca.key-ref": []byte(namespace + "/ca-stale")}
+			for i := range secrets {
+				Expect(fakeClient.Create(ctx, secrets[i])).To(Succeed())
+			}
+
+			Expect(m.addToStore("first", secrets[0], current)).To(Succeed())
+
+			Expect(m.Cleanup(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secrets[1]), &corev1.Secret{})).To(BeNotFoundError())
+			_, err = keyStore.Get(ctx, namespace+"/ca-current")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = keyStore.Get(ctx, namespace+"/ca-stale")
+			Expect(err).To(MatchError(keystore.ErrKeyNotFound))
+		})
+
+		It("should keep stale CA secrets if their private keys cannot be deleted from the key store", func() {
+			m.caKeyStore = &failingDeleteKeyStore{Interface: keystore.NewFile(afero.Afero{Fs: afero.NewMemMapFs()}, "/keys")}
+
+			secret := secretList(testIdentity)[0]
+			secret.Data = map[string][]byte{"ca.crt": []byte("cert"), "ca.key-ref": []byte(namespace + "/ca-stale")}
+			Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+
+			Expect(m.Cleanup(ctx)).To(MatchError(ContainSubstring("fake delete error")))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+		})
+
+		It("should keep stale CA secrets referencing private keys if no key store is configured", func() {
+			secret := secretList(testIdentity)[0]
+			secret.Data = map[string][]byte{"ca.crt": []byte("cert"), "ca.key-ref": []byte(namespace + "/ca-stale")}
+			Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+
+			Expect(m.Cleanup(ctx)).To(MatchError(ContainSubstring("no CA key store is configured")))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+		})
+
 		It("should not touch secrets from other manager instance", func() {
 			secrets := secretList(testIdentity + "other")
 			for i := range secrets {
@@ -106,3 +157,11 @@ var _ = Describe("Cleanup", func() {
 		})
 	})
 })
+
+type failingDeleteKeyStore struct {
+	keystore.Interface
+}
+
+func (f *failingDeleteKeyStore) Delete(_ context.Context, _ string) error {
+	return errors.New("fake delete error")
+}
@@ -7,6 +7,7 @@ package manager
 import (
 	"context"
 	"fmt"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -23,6 +24,10 @@ import (
 )
 
 func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterface, opts ...GenerateOption) (*corev1.Secret, error) {
+	if certConfig := certificateSecretConfig(config); certConfig != nil && certConfig.CertType != secretsutils.CACert && certConfig.KeyAlgorithm == "" {
+		certConfig.KeyAlgorithm = m.keyAlgorithm
+	}
+
 	options := &GenerateOptions{}
 	if err := options.ApplyOptions(m, config, opts); err != nil {
 		return nil, fmt.Errorf("failed applying generate options for config %s: %w", config.GetName(), err)
@@ -54,7 +59,7 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 			return nil, fmt.Errorf("failed reading secret %s for config %s: %w", client.ObjectKeyFromObject(secret), config.GetName(), err)
 		}
 
-		secret, err = m.generateAndCreate(ctx, config, objectMeta)
+		secret, err = m.generateAndCreate(ctx, config, objectMeta, options)
 		if err != nil {
 			return nil, fmt.Errorf("failed generating and creating new secret %s for config %s: %w", client.ObjectKey{Name: objectMeta.Name, Namespace: objectMeta.Namespace}, config.GetName(), err)
 		}
@@ -89,10 +94,37 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 	return secret, nil
 }
 
-func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.ConfigInterface, objectMeta metav1.ObjectMeta) (*corev1.Secret, error) {
-	// Use secret name as common name to make sure the x509 subject names in the CA certificates are always unique.
-	if certConfig := certificateSecretConfig(config); certConfig != nil && certConfig.CertType == secretsutils.CACert {
-		certConfig.CommonName = objectMeta.Name
+func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.ConfigInterface, objectMeta metav1.ObjectMeta, options *GenerateOptions) (*corev1.Secret, error) {
+	var caKeyReference string
+
+	if certConfig := certificateSecretConfig(config); certConfig != nil {
+		if certConfig.CertType == secretsutils.CACert {
+			// Use secret name as common name to make sure the x509 subject names in the CA certificates are always unique.
+			certConfig.CommonName = objectMeta.Name
+
+			if m.caKeyStore != nil {
+				caKeyReference = path.Join(m.namespace, objectMeta.Name)
+
+				signer, err := m.caKeyStore.Create(ctx, caKeyReference, certConfig.KeyAlgorithm)
+				if err != nil {
+					return nil, fmt.Errorf("failed creating CA private key %q in key store: %w", caKeyReference, err)
+				}
+				certConfig.Signer = signer
+			}
+		}
+
+		// The private key of the signing CA is only retrieved when it is actually needed for signing a new certificate.
+		if options.signingCAKeyReference != nil {
+			if m.caKeyStore == nil {
+				return nil, fmt.Errorf("signing CA references private key %q in a key store, but no CA key store is configured", *options.signingCAKeyReference)
+			}
+
+			signer, err := m.caKeyStore.Get(ctx, *options.signingCAKeyReference)
+			if err != nil {
+				return nil, fmt.Errorf("failed getting signing CA private key %q from key store: %w", *options.signingCAKeyReference, err)
+			}
+			certConfig.SigningCA.Signer = signer
+		}
 	}
 
 	data, err := config.Generate()
@@ -100,7 +132,12 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 		return nil, fmt.Errorf("failed generating data: %w", err)
 	}
 
-	dataMap, err := m.keepExistingSecretsIfNeeded(ctx, config.GetName(), data.SecretData())
+	secretData := data.SecretData()
+	if caKeyReference != "" {
+		secretData[secretsutils.DataKeyPrivateKeyCAReference] = []byte(caKeyReference)
+	}
+
+	dataMap, err := m.keepExistingSecretsIfNeeded(ctx, config.GetName(), secretData)
 	if err != nil {
 		return nil, fmt.Errorf("failed taking over data from existing secret when needed: %w", err)
 	}
@@ -381,8 +418,9 @@ type GenerateOptions struct {
 	// computing the secret name for CA secrets.
 	IgnoreConfigChecksumForCASecretName bool
 
-	signingCAChecksum *string
-	isBundleSecret    bool
+	signingCAChecksum     *string
+	signingCAKeyReference *string
+	isBundleSecret        bool
 }
 
 type rotationStrategy string
@@ -480,11 +518,15 @@ func SignedByCA(name string, opts ...SignedByCAOption) GenerateOption {
 			}
 		}
 
-		ca, err := secretsutils.LoadCertificate(name, secret.obj.Data[secretsutils.DataKeyPrivateKeyCA], secret.obj.Data[secretsutils.DataKeyCertificateCA])
+		ca, err := loadCA(name, secret.obj.Data)
 		if err != nil {
 			return err
 		}
 
+		if keyReference, ok := secret.obj.Data[secretsutils.DataKeyPrivateKeyCAReference]; ok {
+			options.signingCAKeyReference = ptr.To(string(keyReference))
+		}
+
 		certificateConfig.SigningCA = ca
 		options.signingCAChecksum = ptr.To(kubernetesutils.TruncateLabelValue(secret.dataChecksum))
 		return nil
@@ -550,6 +592,50 @@ func IgnoreConfigChecksumForCASecretName() GenerateOption {
 	}
 }
 
+// KeyAlgorithm returns a function which sets the 'KeyAlgorithm' field in case the ConfigInterface provided to the
+// Generate request is a CertificateSecretConfig or an RSASecretConfig. Since the algorithm is part of the config
+// checksum, changing it results in a new secret. RSA is normalized to the zero value so that existing secrets are not
+// rotated when it is specified explicitly.
+func KeyAlgorithm(algorithm secretsutils.KeyAlgorithm) GenerateOption {
+	if algorithm == secretsutils.KeyAlgorithmRSA {
+		algorithm = ""
+	}
+
+	return func(_ Interface, config secretsutils.ConfigInterface, _ *GenerateOptions) error {
+		if cfg, ok := config.(*secretsutils.RSASecretConfig); ok {
+			cfg.KeyAlgorithm = algorithm
+			return nil
+		}
+
+		certificateConfig := certificateSecretConfig(config)
+		if certificateConfig == nil {
+			return fmt.Errorf("could not apply option to %T, expected *secrets.CertificateSecretConfig or *secrets.RSASecretConfig", config)
+		}
+
+		certificateConfig.KeyAlgorithm = algorithm
+		return nil
+	}
+}
+
+// loadCA loads the CA certificate from the given secret data. If the CA private key is kept in an external key store, the
+// returned certificate does not contain the private key.
+func loadCA(name string, data map[string][]byte) (*secretsutils.Certificate, error) {
+	if _, ok := data[secretsutils.DataKeyPrivateKeyCAReference]; !ok {
+		return secretsutils.LoadCertificate(name, data[secretsutils.DataKeyPrivateKeyCA], data[secretsutils.DataKeyCertificateCA])
+	}
+
+	certificate, err := utils.DecodeCertificate(data[secretsutils.DataKeyCertificateCA])
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretsutils.Certificate{
+		Name:           name,
+		Certificate:    certificate,
+		CertificatePEM: data[secretsutils.DataKeyCertificateCA],
+	}, nil
+}
+
 func isBundleSecret() GenerateOption {
 	return func(_ Interface, _ secretsutils.ConfigInterface, options *GenerateOptions) error {
 		options.isBundleSecret = true
@@ -6,6 +6,9 @@ package manager
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/x509"
 	"fmt"
 	"sort"
 	"strconv"
@@ -15,6 +18,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
@@ -23,7 +27,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/utils"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/secrets/keystore"
 	"github.com/gardener/gardener/pkg/utils/test"
 )
 
@@ -447,6 +453,24 @@ var _ = Describe("Generate", func() {
 				Expect(secret.Name).To(Equal(name))
 			})
 
+			It("should generate a new CA secret when the key algorithm changes even if the config checksum is ignored for its name", func() {
+				By("Generate new secret")
+				secret, err := m.Generate(ctx, config, IgnoreConfigChecksumForCASecretName(), KeyAlgorithm(secretsutils.KeyAlgorithmRSA))
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, secret)
+				Expect(secret.Name).To(Equal(name))
+
+				By("Change key algorithm and generate again")
+				newSecret, err := m.Generate(ctx, config, IgnoreConfigChecksumForCASecretName(), KeyAlgorithm(secretsutils.KeyAlgorithmECDSAP256))
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, newSecret)
+				Expect(newSecret.Name).To(HavePrefix(name + "-"))
+
+				cert, err := secretsutils.LoadCertificate("", newSecret.Data["ca.key"], newSecret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.Signer).To(BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+			})
+
 			It("should rotate a CA secret and add old and new to the corresponding bundle", func() {
 				By("Generate new secret")
 				secret, err := m.Generate(ctx, config)
@@ -758,6 +782,102 @@ var _ = Describe("Generate", func() {
 				Expect(newClientSecret).NotTo(Equal(clientSecret))
 			})
 
+			It("should generate the server cert with the configured key algorithm", func() {
+				By("Generate new CA secret")
+				caSecret, err := m.Generate(ctx, caConfig, KeyAlgorithm(secretsutils.KeyAlgorithmECDSAP384))
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, caSecret)
+
+				By("Generate new server secret")
+				serverSecret, err := m.Generate(ctx, serverConfig, SignedByCA(caName), KeyAlgorithm(secretsutils.KeyAlgorithmECDSAP256))
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, serverSecret)
+
+				cert, err := secretsutils.LoadCertificate("", serverSecret.Data["tls.key"], serverSecret.Data["tls.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.Signer).To(BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+				Expect(cert.Certificate.PublicKeyAlgorithm).To(Equal(x509.ECDSA))
+			})
+
+			It("should generate the server cert with the default key algorithm of the manager but not the CA", func() {
+				mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{KeyAlgorithm: secretsutils.KeyAlgorithmECDSAP256})
+				Expect(err).NotTo(HaveOccurred())
+				m = mgr.(*manager)
+
+				By("Generate new CA secret")
+				caSecret, err := m.Generate(ctx, caConfig)
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, caSecret)
+
+				ca, err := secretsutils.LoadCertificate("", caSecret.Data["ca.key"], caSecret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ca.Certificate.PublicKeyAlgorithm).To(Equal(x509.RSA))
+
+				By("Generate new server secrets")
+				serverSecret, err := m.Generate(ctx, serverConfig, SignedByCA(caName))
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, serverSecret)
+
+				cert, err := secretsutils.LoadCertificate("", serverSecret.Data["tls.key"], serverSecret.Data["tls.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.Certificate.PublicKeyAlgorithm).To(Equal(x509.ECDSA))
+
+				rsaServerSecret, err := m.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "rsa-server", CommonName: "rsa-server", CertType: secretsutils.ServerCert}, SignedByCA(caName), KeyAlgorithm(secretsutils.KeyAlgorithmRSA))
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, rsaServerSecret)
+
+				rsaCert, err := secretsutils.LoadCertificate("", rsaServerSecret.Data["tls.key"], rsaServerSecret.Data["tls.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rsaCert.Certificate.PublicKeyAlgorithm).To(Equal(x509.RSA))
+			})
+
+			It("should keep the CA private key in the key store and sign the server cert with it", func() {
+				keyStore := keystore.NewFile(afero.Afero{Fs: afero.NewMemMapFs()}, "/keys")
+				mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{CAKeyStore: keyStore})
+				Expect(err).NotTo(HaveOccurred())
+				m = mgr.(*manager)
+
+				By("Generate new CA secret")
+				caSecret, err := m.Generate(ctx, caConfig, KeyAlgorithm(secretsutils.KeyAlgorithmECDSAP256))
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, caSecret)
+				Expect(caSecret.Data).NotTo(HaveKey("ca.key"))
+				Expect(caSecret.Data).To(HaveKeyWithValue("ca.key-ref", []byte(namespace+"/"+caSecret.Name)))
+
+				caPrivateKey, err := keyStore.Get(ctx, namespace+"/"+caSecret.Name)
+				Expect(err).NotTo(HaveOccurred())
+				caCertificate, err := utils.DecodeCertificate(caSecret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(caCertificate.PublicKey).To(Equal(caPrivateKey.Public()))
+
+				By("Generate new server secret")
+				serverSecret, err := m.Generate(ctx, serverConfig, SignedByCA(caName))
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, serverSecret)
+				Expect(serverSecret.Data).To(HaveKey("tls.key"))
+
+				serverCertificate, err := utils.DecodeCertificate(serverSecret.Data["tls.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serverCertificate.CheckSignatureFrom(caCertificate)).To(Succeed())
+			})
+
+			It("should fail signing with a CA whose private key is kept in a key store if no key store is configured", func() {
+				keyStore := keystore.NewFile(afero.Afero{Fs: afero.NewMemMapFs()}, "/keys")
+				mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{CAKeyStore: keyStore})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = mgr.Generate(ctx, caConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				mgr, err = New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{})
+				Expect(err).NotTo(HaveOccurred())
+				_, err = mgr.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: caName, CommonName: caName, CertType: secretsutils.CACert})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = mgr.Generate(ctx, serverConfig, SignedByCA(caName))
+				Expect(err).To(MatchError(ContainSubstring("no CA key store is configured")))
+			})
+
 			It("should also accept ControlPlaneSecretConfigs", func() {
 				DeferCleanup(test.WithVar(&secretsutils.Clock, fakeClock))
 
@@ -879,6 +999,34 @@ var _ = Describe("Generate", func() {
 				Expect(secretInfos.old).To(BeNil())
 				Expect(secretInfos.bundle).To(BeNil())
 			})
+
+			It("should not change the secret name when RSA is specified explicitly", func() {
+				secret, err := m.Generate(ctx, config, KeyAlgorithm(secretsutils.KeyAlgorithmRSA))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secret.Name).To(Equal(name + "-c34363f0"))
+			})
+
+			It("should generate a new private key secret when the key algorithm changes", func() {
+				By("Generate new secret")
+				secret, err := m.Generate(ctx, config)
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, secret)
+
+				By("Change key algorithm and generate again")
+				newSecret, err := m.Generate(ctx, config, KeyAlgorithm(secretsutils.KeyAlgorithmEd25519))
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, newSecret)
+				Expect(newSecret.Name).NotTo(Equal(secret.Name))
+
+				privateKey, err := utils.DecodeSigner(newSecret.Data["id_rsa"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(privateKey).To(BeAssignableToTypeOf(ed25519.PrivateKey{}))
+			})
+
+			It("should return an error for configs which do not support a key algorithm", func() {
+				_, err := m.Generate(ctx, &secretsutils.BasicAuthSecretConfig{Name: name, Format: secretsutils.BasicAuthFormatNormal, Username: "foo", PasswordLength: 3}, KeyAlgorithm(secretsutils.KeyAlgorithmECDSAP256))
+				Expect(err).To(MatchError(ContainSubstring("could not apply option")))
+			})
 		})
 
 		Context("adoption of existing secret data", func() {
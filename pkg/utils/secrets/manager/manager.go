@@ -20,6 +20,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/utils"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/secrets/keystore"
 )
 
 const (
@@ -77,6 +78,8 @@ type (
 		namespace                   string
 		identity                    string
 		lastRotationInitiationTimes nameToUnixTime
+		caKeyStore                  keystore.Interface
+		keyAlgorithm                secretsutils.KeyAlgorithm
 	}
 
 	nameToUnixTime map[string]string
@@ -100,6 +103,16 @@ type (
 		// SecretNamesToTimes is a map whose keys are secret names and whose values are the last rotation initiation
 		// times.
 		SecretNamesToTimes map[string]time.Time
+		// CAKeyStore is an optional store for the private keys of CA secrets. If set, the private keys of newly generated
+		// CAs are kept in the store and the secrets only contain references to them. Note that consumers which require
+		// the CA private key (e.g., for signing certificate signing requests) cannot use such CA secrets.
+		// The key store is not configured by any Gardener component yet, i.e., it can only be used when the manager is used
+		// as a library.
+		CAKeyStore keystore.Interface
+		// KeyAlgorithm is the algorithm of the private keys of server and client certificates which do not specify an
+		// algorithm via the KeyAlgorithm option (defaults to RSA). CA certificates are not affected since changing their
+		// algorithm results in new CAs which must be introduced via the regular CA rotation.
+		KeyAlgorithm secretsutils.KeyAlgorithm
 	}
 )
 
@@ -134,6 +147,12 @@ func New(
 		namespace:                   namespace,
 		identity:                    identity,
 		lastRotationInitiationTimes: make(nameToUnixTime),
+		caKeyStore:                  rotation.CAKeyStore,
+	}
+
+	// RSA is normalized to the zero value so that existing secrets are not regenerated, see the KeyAlgorithm option.
+	if rotation.KeyAlgorithm != secretsutils.KeyAlgorithmRSA {
+		m.keyAlgorithm = rotation.KeyAlgorithm
 	}
 
 	if err := m.initialize(ctx, rotation); err != nil {
@@ -195,25 +214,38 @@ func (m *manager) initialize(ctx context.Context, rotation Config) error {
 }
 
 func (m *manager) mustAutoRenewSecret(secret corev1.Secret) (bool, error) {
+	renewAt, ok, err := AutoRenewalTime(secret)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	return m.clock.Now().UTC().After(renewAt), nil
+}
+
+// AutoRenewalTime returns the point in time after which the given secret is automatically renewed by the secrets
+// manager. Secrets are renewed if 80% (or the percentage in the renew-after-validity-percentage label) of their
+// validity has been reached or if they expire in less than 10d, whatever comes first. The returned bool is false if the
+// secret does not carry the labels required for computing this time, i.e., if it is not subject to automatic renewal.
+func AutoRenewalTime(secret corev1.Secret) (time.Time, bool, error) {
 	if secret.Labels[LabelKeyIssuedAtTime] == "" || secret.Labels[LabelKeyValidUntilTime] == "" {
-		return false, nil
+		return time.Time{}, false, nil
 	}
 
 	issuedAtUnix, err := strconv.ParseInt(secret.Labels[LabelKeyIssuedAtTime], 10, 64)
 	if err != nil {
-		return false, err
+		return time.Time{}, false, err
 	}
 
 	validUntilUnix, err := strconv.ParseInt(secret.Labels[LabelKeyValidUntilTime], 10, 64)
 	if err != nil {
-		return false, err
+		return time.Time{}, false, err
 	}
 
 	renewAfterValidityPercentage := 80
 	if secret.Labels[LabelKeyRenewAfterValidityPercentage] != "" {
 		value, err := strconv.Atoi(secret.Labels[LabelKeyRenewAfterValidityPercentage])
 		if err != nil {
-			return false, err
+			return time.Time{}, false, err
 		}
 		renewAfterValidityPercentage = value
 	}
@@ -223,11 +255,14 @@ func (m *manager) mustAutoRenewSecret(secret corev1.Secret) (bool, error) {
 		renewAtUnix = issuedAtUnix + validity*int64(renewAfterValidityPercentage)/100
 		renewAt     = time.Unix(renewAtUnix, 0).UTC()
 		validUntil  = time.Unix(validUntilUnix, 0).UTC()
-		now         = m.clock.Now().UTC()
 	)
 
 	// Renew if 80% of the validity has been reached or if the secret expires in less than 10d.
-	return now.After(renewAt) || now.After(validUntil.Add(-10*24*time.Hour)), nil
+	if latest := validUntil.Add(-10 * 24 * time.Hour); latest.Before(renewAt) {
+		renewAt = latest
+	}
+
+	return renewAt, true, nil
 }
 
 func (m *manager) addToStore(name string, secret *corev1.Secret, class secretClass) error {
@@ -339,6 +374,9 @@ func computeSecretName(config secretsutils.ConfigInterface, labels map[string]st
 		if infix := labels[LabelKeyChecksumConfig] + labels[LabelKeyChecksumSigningCA]; len(infix) > 0 {
 			name += "-" + utils.ComputeSHA256Hex([]byte(infix))[:8]
 		}
+	} else if cfg.KeyAlgorithm != "" && cfg.KeyAlgorithm != secretsutils.KeyAlgorithmRSA {
+		// Even if the config checksum is ignored, CA secrets must be regenerated when the key algorithm changes.
+		name += "-" + utils.ComputeSHA256Hex([]byte(cfg.KeyAlgorithm))[:8]
 	}
 
 	if suffix := labels[LabelKeyLastRotationInitiationTime]; len(suffix) > 0 {
@@ -371,7 +409,8 @@ func unixTime(in time.Time) string {
 }
 
 func isCASecret(data map[string][]byte) bool {
-	return data[secretsutils.DataKeyCertificateCA] != nil && data[secretsutils.DataKeyPrivateKeyCA] != nil
+	return data[secretsutils.DataKeyCertificateCA] != nil &&
+		(data[secretsutils.DataKeyPrivateKeyCA] != nil || data[secretsutils.DataKeyPrivateKeyCAReference] != nil)
 }
 
 func certificateSecretConfig(config secretsutils.ConfigInterface) *secretsutils.CertificateSecretConfig {
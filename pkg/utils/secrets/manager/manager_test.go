@@ -6,6 +6,9 @@ package manager
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"strconv"
 	"time"
 
@@ -345,6 +348,50 @@ var _ = Describe("Manager", func() {
 			Entry("with persist", "a9c2fcb9", nil, ptr.To(true), nil, map[string]string{"persist": "true"}),
 			Entry("with bundleFor", "a9c2fcb9", nil, nil, ptr.To("bundle-origin"), map[string]string{"bundle-for": "bundle-origin"}),
 		)
+
+		It("should not consider the signer of the signing CA for the config checksum", func() {
+			privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			config := &secretsutils.CertificateSecretConfig{
+				Name:      configName,
+				SigningCA: &secretsutils.Certificate{Signer: privateKey},
+			}
+
+			meta, err := ObjectMeta(namespace, "test", config, false, "", nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(meta.Name).To(Equal(configName + "-a9c2fcb9"))
+			Expect(meta.Labels).To(HaveKeyWithValue("checksum-of-config", "17861245496710117091"))
+		})
+	})
+
+	DescribeTable("#AutoRenewalTime",
+		func(labels map[string]string, expectedRenewAt time.Time, expectedOK bool) {
+			renewAt, ok, err := AutoRenewalTime(corev1.Secret{ObjectMeta: metav1.ObjectMeta{Labels: labels}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(Equal(expectedOK))
+			Expect(renewAt).To(Equal(expectedRenewAt))
+		},
+
+		Entry("no validity labels", nil, time.Time{}, false),
+		Entry("only issued-at label", map[string]string{"issued-at-time": "0"}, time.Time{}, false),
+		Entry("80% of validity reached first",
+			map[string]string{"issued-at-time": "0", "valid-until-time": strconv.Itoa(100 * 24 * 3600)},
+			time.Unix(80*24*3600, 0).UTC(), true,
+		),
+		Entry("custom validity percentage",
+			map[string]string{"issued-at-time": "0", "valid-until-time": strconv.Itoa(100 * 24 * 3600), "renew-after-validity-percentage": "50"},
+			time.Unix(50*24*3600, 0).UTC(), true,
+		),
+		Entry("10d before expiration reached first",
+			map[string]string{"issued-at-time": "0", "valid-until-time": strconv.Itoa(20 * 24 * 3600)},
+			time.Unix(10*24*3600, 0).UTC(), true,
+		),
+	)
+
+	It("#AutoRenewalTime should return an error for invalid labels", func() {
+		_, _, err := AutoRenewalTime(corev1.Secret{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"issued-at-time": "foo", "valid-until-time": "1"}}})
+		Expect(err).To(HaveOccurred())
 	})
 
 	DescribeTable("#Secret",
@@ -6,7 +6,7 @@ package secrets
 
 import (
 	"bytes"
-	"crypto/rand"
+	"crypto"
 	"crypto/rsa"
 
 	"golang.org/x/crypto/ssh"
@@ -15,26 +15,35 @@ import (
 )
 
 const (
-	// DataKeyRSAPrivateKey is the key in a secret data holding the RSA private key.
+	// DataKeyRSAPrivateKey is the key in a secret data holding the private key. For backwards-compatibility, it is also
+	// used for keys generated with other algorithms than RSA.
 	DataKeyRSAPrivateKey = "id_rsa"
 	// DataKeySSHAuthorizedKeys is the key in a secret data holding the OpenSSH authorized keys.
 	DataKeySSHAuthorizedKeys = "id_rsa.pub"
 )
 
 // RSASecretConfig containing information about the number of bits which should be used for the to-be-created RSA private key.
+// Despite its name, it can also be used to generate private keys with other algorithms, see KeyAlgorithm.
 type RSASecretConfig struct {
 	Name string
 
 	Bits       int
 	UsedForSSH bool
+	// KeyAlgorithm is the algorithm of the generated private key. Defaults to RSA. Bits is only considered for RSA keys.
+	KeyAlgorithm KeyAlgorithm
 }
 
 // RSAKeys contains the private key, the public key, and optionally the OpenSSH-formatted authorized keys file data.
 type RSAKeys struct {
 	Name string
 
+	// PrivateKey and PublicKey are only set if the generated private key is an RSA key.
 	PrivateKey *rsa.PrivateKey
 	PublicKey  *rsa.PublicKey
+	// Signer and Public are the private and public key, independent of their algorithm.
+	Signer        crypto.Signer
+	Public        crypto.PublicKey
+	PrivateKeyPEM []byte
 
 	OpenSSHAuthorizedKey []byte
 }
@@ -46,33 +55,49 @@ func (s *RSASecretConfig) GetName() string {
 
 // Generate implements ConfigInterface.
 func (s *RSASecretConfig) Generate() (DataInterface, error) {
-	privateKey, err := GenerateKey(rand.Reader, s.Bits)
+	privateKey, err := GeneratePrivateKey(s.KeyAlgorithm, s.Bits)
 	if err != nil {
 		return nil, err
 	}
 
-	rsa := &RSAKeys{
+	privateKeyPEM, err := encodePrivateKey(privateKey, PKCS1)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := &RSAKeys{
 		Name: s.Name,
 
-		PrivateKey: privateKey,
-		PublicKey:  &privateKey.PublicKey,
+		Signer:        privateKey,
+		Public:        privateKey.Public(),
+		PrivateKeyPEM: privateKeyPEM,
+	}
+
+	if rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey); ok {
+		keys.PrivateKey = rsaPrivateKey
+		keys.PublicKey = &rsaPrivateKey.PublicKey
 	}
 
 	if s.UsedForSSH {
-		sshPublicKey, err := generateSSHAuthorizedKeys(rsa.PrivateKey)
+		sshPublicKey, err := generateSSHAuthorizedKeys(keys.Public)
 		if err != nil {
 			return nil, err
 		}
-		rsa.OpenSSHAuthorizedKey = sshPublicKey
+		keys.OpenSSHAuthorizedKey = sshPublicKey
 	}
 
-	return rsa, nil
+	return keys, nil
 }
 
 // SecretData computes the data map which can be used in a Kubernetes secret.
 func (r *RSAKeys) SecretData() map[string][]byte {
+	privateKeyPEM := r.PrivateKeyPEM
+	if privateKeyPEM == nil && r.PrivateKey != nil {
+		privateKeyPEM = utils.EncodePrivateKey(r.PrivateKey)
+	}
+
 	data := map[string][]byte{
-		DataKeyRSAPrivateKey: utils.EncodePrivateKey(r.PrivateKey),
+		DataKeyRSAPrivateKey: privateKeyPEM,
 	}
 
 	if r.OpenSSHAuthorizedKey != nil {
@@ -82,14 +107,13 @@ func (r *RSAKeys) SecretData() map[string][]byte {
 	return data
 }
 
-// generateSSHAuthorizedKeys takes a RSA private key <privateKey> and generates the corresponding public key.
-// It serializes the public key for inclusion in an OpenSSH `authorized_keys` file and it trims the new-
-// line at the end.
-func generateSSHAuthorizedKeys(privateKey *rsa.PrivateKey) ([]byte, error) {
-	pubKey, err := ssh.NewPublicKey(&privateKey.PublicKey)
+// generateSSHAuthorizedKeys takes a public key <publicKey> and serializes it for inclusion in an OpenSSH
+// `authorized_keys` file and it trims the new-line at the end.
+func generateSSHAuthorizedKeys(publicKey crypto.PublicKey) ([]byte, error) {
+	pubKey, err := ssh.NewPublicKey(publicKey)
 	if err != nil {
 		return nil, err
 	}
-	publicKey := ssh.MarshalAuthorizedKey(pubKey)
-	return bytes.Trim(publicKey, "\x0a"), nil
+	authorizedKey := ssh.MarshalAuthorizedKey(pubKey)
+	return bytes.Trim(authorizedKey, "\x0a"), nil
 }
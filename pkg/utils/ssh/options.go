@@ -6,7 +6,7 @@ package ssh
 
 import (
 	"context"
-	"crypto/rsa"
+	"crypto"
 	"net"
 	"time"
 
@@ -44,8 +44,8 @@ func WithUser(user string) Option {
 	}
 }
 
-// WithPrivateKey configures the client to authenticate using the given RSA private key.
-func WithPrivateKey(key *rsa.PrivateKey) Option {
+// WithPrivateKey configures the client to authenticate using the given private key.
+func WithPrivateKey(key crypto.Signer) Option {
 	return func(opts *Config) error {
 		signer, err := ssh.NewSignerFromKey(key)
 		if err != nil {
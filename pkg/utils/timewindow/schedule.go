@@ -7,61 +7,119 @@ package timewindow
 import (
 	"fmt"
 	"hash/crc32"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
-// MutateScheduleFunc is a function for mutating the schedule based on the maintenance time window and UID.
-type MutateScheduleFunc func(string, MaintenanceTimeWindow, types.UID) string
+// MutateScheduleFunc is a function for mutating the schedule based on the maintenance time window, UID, and current
+// time.
+type MutateScheduleFunc func(string, MaintenanceTimeWindow, types.UID, time.Time) string
 
 // DetermineSchedule determines a schedule based on the provided format and the creation timestamp. If both the begin
 // and end of a maintenance time window are provided and different from the 'always time window' then the provided
-// mutation function is applied.
+// mutation function is applied. The current time is used to determine the offset of the location of the maintenance
+// time window at the next execution. The options are used for parsing the maintenance time window, e.g., for
+// specifying its location or weekdays.
 func DetermineSchedule(
 	scheduleFormat string,
 	begin, end string,
 	uid types.UID,
 	creationTimestamp metav1.Time,
+	now time.Time,
 	mutate MutateScheduleFunc,
+	opts ...ParseOption,
 ) (
 	string,
 	error,
 ) {
 	if len(begin) != 0 && len(end) != 0 {
-		maintenanceTimeWindow, err := ParseMaintenanceTimeWindow(begin, end)
+		maintenanceTimeWindow, err := ParseMaintenanceTimeWindow(begin, end, opts...)
 		if err != nil {
 			return "", err
 		}
 
 		if !maintenanceTimeWindow.Equal(AlwaysTimeWindow) {
-			return mutate(scheduleFormat, *maintenanceTimeWindow, uid), nil
+			return mutate(scheduleFormat, *maintenanceTimeWindow, uid, now), nil
 		}
 	}
 
 	return fmt.Sprintf(scheduleFormat, creationTimestamp.Minute(), creationTimestamp.Hour()), nil
 }
 
-// RandomizeWithinTimeWindow computes a random time (based on the provided UID) within the provided time window.
-func RandomizeWithinTimeWindow(scheduleFormat string, window MaintenanceTimeWindow, uid types.UID) string {
+// RandomizeWithinTimeWindow computes a random time (based on the provided UID) within the provided time window. If the
+// time window is restricted to certain weekdays, the schedule is restricted to these weekdays as well.
+func RandomizeWithinTimeWindow(scheduleFormat string, window MaintenanceTimeWindow, uid types.UID, now time.Time) string {
 	var (
-		windowBegin     = window.Begin()
 		windowInMinutes = uint32(window.Duration().Minutes())
 		randomMinutes   = int(crc32.ChecksumIEEE([]byte(uid)) % windowInMinutes)
-		randomTime      = windowBegin.Add(0, randomMinutes, 0)
 	)
 
-	return fmt.Sprintf(scheduleFormat, randomTime.Minute(), randomTime.Hour())
+	return window.schedule(scheduleFormat, now, time.Duration(randomMinutes)*time.Minute, true)
 }
 
 // RandomizeWithinFirstHourOfTimeWindow computes a random time (based on the provided UID) within the first hour of the
 // provided time window. It adds a 15 minutes time buffer before the start.
-func RandomizeWithinFirstHourOfTimeWindow(scheduleFormat string, window MaintenanceTimeWindow, uid types.UID) string {
+func RandomizeWithinFirstHourOfTimeWindow(scheduleFormat string, window MaintenanceTimeWindow, uid types.UID, now time.Time) string {
+	randomMinutes := int(crc32.ChecksumIEEE([]byte(uid)) % 60)
+
+	return window.schedule(scheduleFormat, now, time.Duration(randomMinutes-75)*time.Minute, false)
+}
+
+// schedule formats the begin of the time window shifted by the given offset with the schedule format. Schedules are
+// evaluated in UTC, hence, the time is converted based on the offset of the location of the time window at the next
+// execution after now. As schedules cannot follow daylight saving time transitions, they must be recomputed regularly (e.g., on
+// every reconciliation), so that the offset of the following executions is used after a transition. If
+// restrictWeekdays is true and the time window only begins on certain weekdays, the day-of-month and day-of-week fields
+// of the schedule are replaced accordingly (considering that the conversion to UTC might shift the day).
+func (m *MaintenanceTimeWindow) schedule(scheduleFormat string, now time.Time, offset time.Duration, restrictWeekdays bool) string {
+	var (
+		begin    = m.nextExecutionBegin(now, offset, restrictWeekdays)
+		t        = begin.Add(offset).UTC()
+		schedule = fmt.Sprintf(scheduleFormat, t.Minute(), t.Hour())
+		fields   = strings.Fields(schedule)
+	)
+
+	if !restrictWeekdays || len(m.weekdays) == 0 || len(fields) != 5 {
+		return schedule
+	}
+
 	var (
-		windowBegin   = window.Begin().Add(0, -15, 0)
-		randomMinutes = int(crc32.ChecksumIEEE([]byte(uid)) % 60)
-		randomTime    = windowBegin.Add(-1, randomMinutes, 0)
+		beginDate = time.Date(begin.Year(), begin.Month(), begin.Day(), 0, 0, 0, 0, time.UTC)
+		date      = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		dayShift  = int(date.Sub(beginDate) / (24 * time.Hour))
+		weekdays  []string
 	)
 
-	return fmt.Sprintf(scheduleFormat, randomTime.Minute(), randomTime.Hour())
+	for _, day := range m.weekdays {
+		weekdays = append(weekdays, strconv.Itoa((int(day)+dayShift%7+7)%7))
+	}
+	slices.Sort(weekdays)
+
+	fields[2], fields[4] = "*", strings.Join(weekdays, ",")
+	return strings.Join(fields, " ")
+}
+
+// nextExecutionBegin returns the begin of the occurrence of the time window whose begin shifted by the given offset is
+// the next execution at or after now. If restrictWeekdays is true, only occurrences on the weekdays of the time window
+// are considered.
+func (m *MaintenanceTimeWindow) nextExecutionBegin(now time.Time, offset time.Duration, restrictWeekdays bool) time.Time {
+	// The offset is less than a day, i.e., the execution belonging to yesterday's occurrence might still be pending.
+	// Executions happen at least once a week, i.e., the next one belongs to an occurrence within the next eight days.
+	for i := -1; i < 8; i++ {
+		day := m.day(now, i)
+		if restrictWeekdays && !m.beginsOn(day.Weekday()) {
+			continue
+		}
+		if begin := m.AdjustedBegin(day); !begin.Add(offset).Before(now) {
+			return begin
+		}
+	}
+
+	// unreachable
+	return m.AdjustedBegin(now)
 }
@@ -5,6 +5,8 @@
 package timewindow_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -28,25 +30,34 @@ var _ = Describe("Schedule", func() {
 			begin             = "140000+0100"
 			end               = "220000+0100"
 			creationTimestamp = metav1.Time{}
-			mutate            = func(string, MaintenanceTimeWindow, types.UID) string {
+			now               = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+			mutate            = func(string, MaintenanceTimeWindow, types.UID, time.Time) string {
 				return "foo"
 			}
 		)
 
 		It("should return an error because the time window cannot be parsed", func() {
-			schedule, err := DetermineSchedule(scheduleFormat, begin, "not-parseable", uid, creationTimestamp, mutate)
+			schedule, err := DetermineSchedule(scheduleFormat, begin, "not-parseable", uid, creationTimestamp, now, mutate)
 			Expect(err).To(HaveOccurred())
 			Expect(schedule).To(BeEmpty())
 		})
 
 		It("should use the mutate function", func() {
-			schedule, err := DetermineSchedule(scheduleFormat, begin, end, uid, creationTimestamp, mutate)
+			schedule, err := DetermineSchedule(scheduleFormat, begin, end, uid, creationTimestamp, now, mutate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(schedule).To(Equal("foo"))
 		})
 
+		It("should pass the options for parsing the time window", func() {
+			schedule, err := DetermineSchedule(scheduleFormat, begin, end, uid, creationTimestamp, now, func(_ string, window MaintenanceTimeWindow, _ types.UID, _ time.Time) string {
+				return window.Location().String()
+			}, InLocation("Europe/Berlin"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(schedule).To(Equal("Europe/Berlin"))
+		})
+
 		It("should not use the mutate function because time window is equal to always window", func() {
-			schedule, err := DetermineSchedule(scheduleFormat, "000000+0000", "235959+0000", uid, creationTimestamp, mutate)
+			schedule, err := DetermineSchedule(scheduleFormat, "000000+0000", "235959+0000", uid, creationTimestamp, now, mutate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(schedule).To(Equal("0 0"))
 		})
@@ -54,13 +65,62 @@ var _ = Describe("Schedule", func() {
 
 	Describe("#RandomizeWithinTimeWindow", func() {
 		It("should compute a pseudo-randomized time within the time window", func() {
-			Expect(RandomizeWithinTimeWindow(scheduleFormat, *window, uid)).To(Equal("10 15"))
+			Expect(RandomizeWithinTimeWindow(scheduleFormat, *window, uid, time.Now())).To(Equal("10 15"))
 		})
 	})
 
 	Describe("#RandomizeWithinFirstHourOfTimeWindow", func() {
 		It("should compute a pseudo-randomized time within the first hour of the time window", func() {
-			Expect(RandomizeWithinFirstHourOfTimeWindow(scheduleFormat, *window, uid)).To(Equal("55 12"))
+			Expect(RandomizeWithinFirstHourOfTimeWindow(scheduleFormat, *window, uid, time.Now())).To(Equal("55 12"))
+		})
+	})
+
+	Context("time window with location and weekdays", func() {
+		var berlinWindow *MaintenanceTimeWindow
+
+		BeforeEach(func() {
+			var err error
+			berlinWindow, err = ParseMaintenanceTimeWindow("003000+0000", "043000+0000", InLocation("Europe/Berlin"), OnWeekdays("Mon", "Thu"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should convert the time to UTC based on the current offset (CET)", func() {
+			now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+			Expect(RandomizeWithinFirstHourOfTimeWindow(scheduleFormat, *berlinWindow, uid, now)).To(Equal("25 22"))
+		})
+
+		It("should convert the time to UTC based on the current offset (CEST)", func() {
+			now := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+			Expect(RandomizeWithinFirstHourOfTimeWindow(scheduleFormat, *berlinWindow, uid, now)).To(Equal("25 21"))
+		})
+
+		It("should convert the time to UTC based on the offset of the next execution (DST transition)", func() {
+			// the execution of Sunday's occurrence (still CET) has already happened, the next one is on Monday (CEST)
+			now := time.Date(2025, time.March, 29, 23, 0, 0, 0, time.UTC)
+			Expect(RandomizeWithinFirstHourOfTimeWindow("%d %d * * *", *berlinWindow, uid, now)).To(Equal("25 21 * * *"))
+		})
+
+		It("should convert the time to UTC based on the offset of the next execution on the weekdays (DST transition)", func() {
+			// the next occurrence on the weekdays is on Monday (CEST)
+			now := time.Date(2025, time.March, 27, 12, 0, 0, 0, time.UTC)
+			Expect(RandomizeWithinTimeWindow("%d %d * * *", *berlinWindow, uid, now)).To(Equal("40 23 * * 0,3"))
+		})
+
+		It("should restrict the schedule to the weekdays", func() {
+			now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+			Expect(RandomizeWithinTimeWindow("%d %d */3 * *", *berlinWindow, uid, now)).To(Equal("40 0 * * 1,4"))
+		})
+
+		It("should shift the weekdays if the conversion to UTC changes the day", func() {
+			now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+			tw, err := ParseMaintenanceTimeWindow("000000+0000", "003000+0000", InLocation("Europe/Berlin"), OnWeekdays("Mon", "Sun"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(RandomizeWithinTimeWindow("%d %d * * *", *tw, uid, now)).To(Equal("10 23 * * 0,6"))
+		})
+
+		It("should not restrict the schedule to the weekdays for backups", func() {
+			now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+			Expect(RandomizeWithinFirstHourOfTimeWindow("%d %d * * *", *berlinWindow, uid, now)).To(Equal("25 22 * * *"))
 		})
 	})
 })
@@ -6,6 +6,8 @@ package timewindow
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/rand"
@@ -112,14 +114,18 @@ func (m *MaintenanceTime) Compare(other *MaintenanceTime) int {
 }
 
 func (m *MaintenanceTime) adjust(t time.Time) time.Time {
-	t = t.UTC()
 	return time.Date(t.Year(), t.Month(), t.Day(), m.hour, m.minute, m.second, 0, t.Location())
 }
 
 // MaintenanceTimeWindow contains the beginning and the end of a time window in which maintenance operations can be performed.
+// The beginning and the end are wall clock times in the location of the time window (UTC by default), i.e., the time
+// window follows daylight saving time transitions. Optionally, the time window can be restricted to certain weekdays, in
+// which case it only begins on these days.
 type MaintenanceTimeWindow struct {
-	begin *MaintenanceTime
-	end   *MaintenanceTime
+	begin    *MaintenanceTime
+	end      *MaintenanceTime
+	location *time.Location
+	weekdays []time.Weekday
 }
 
 // AlwaysTimeWindow is a MaintenanceTimeWindow that contains all durations.
@@ -127,12 +133,72 @@ var AlwaysTimeWindow = NewMaintenanceTimeWindow(NewMaintenanceTime(0, 0, 0), New
 
 // NewMaintenanceTimeWindow takes a begin and an end of a time window and returns a pointer to a MaintenanceTimeWindow structure.
 func NewMaintenanceTimeWindow(begin, end *MaintenanceTime) *MaintenanceTimeWindow {
-	return &MaintenanceTimeWindow{begin, end}
+	return &MaintenanceTimeWindow{begin: begin, end: end}
+}
+
+// ParseOption is an option for parsing a MaintenanceTimeWindow.
+type ParseOption func(*MaintenanceTimeWindow) error
+
+// InLocation makes the time window being evaluated in the location with the given IANA time zone name, e.g.
+// "Europe/Berlin". An empty name means UTC.
+func InLocation(name string) ParseOption {
+	return func(m *MaintenanceTimeWindow) error {
+		if name == "" {
+			return nil
+		}
+
+		location, err := time.LoadLocation(name)
+		if err != nil {
+			return fmt.Errorf("could not load location %q: %w", name, err)
+		}
+		m.location = location
+		return nil
+	}
+}
+
+// OnWeekdays restricts the time window to begin on the given weekdays only. Valid values are the abbreviations "Mon",
+// "Tue", "Wed", "Thu", "Fri", "Sat", and "Sun". If no weekdays are given, the time window begins on every day.
+func OnWeekdays(weekdays ...string) ParseOption {
+	return func(m *MaintenanceTimeWindow) error {
+		days, err := ParseWeekdays(weekdays...)
+		if err != nil {
+			return err
+		}
+		m.weekdays = days
+		return nil
+	}
+}
+
+var weekdayAbbreviations = map[string]time.Weekday{
+	"Sun": time.Sunday,
+	"Mon": time.Monday,
+	"Tue": time.Tuesday,
+	"Wed": time.Wednesday,
+	"Thu": time.Thursday,
+	"Fri": time.Friday,
+	"Sat": time.Saturday,
+}
+
+// ParseWeekdays parses the given weekday abbreviations ("Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun") and returns
+// them sorted and without duplicates.
+func ParseWeekdays(weekdays ...string) ([]time.Weekday, error) {
+	var days []time.Weekday
+
+	for _, weekday := range weekdays {
+		day, ok := weekdayAbbreviations[weekday]
+		if !ok {
+			return nil, fmt.Errorf("unknown weekday %q, valid values are Mon, Tue, Wed, Thu, Fri, Sat, Sun", weekday)
+		}
+		days = append(days, day)
+	}
+
+	slices.Sort(days)
+	return slices.Compact(days), nil
 }
 
 // ParseMaintenanceTimeWindow takes a begin and an end of a time window in the maintenance format and returns a pointer
 // to a MaintenanceTimeWindow structure.
-func ParseMaintenanceTimeWindow(begin, end string) (*MaintenanceTimeWindow, error) {
+func ParseMaintenanceTimeWindow(begin, end string, opts ...ParseOption) (*MaintenanceTimeWindow, error) {
 	maintenanceWindowBegin, err := ParseMaintenanceTime(begin)
 	if err != nil {
 		return nil, fmt.Errorf("could not parse begin time: %s", err.Error())
@@ -142,17 +208,38 @@ func ParseMaintenanceTimeWindow(begin, end string) (*MaintenanceTimeWindow, erro
 	if err != nil {
 		return nil, fmt.Errorf("could not parse end time: %s", err.Error())
 	}
-	return NewMaintenanceTimeWindow(maintenanceWindowBegin, maintenanceWindowEnd), nil
+
+	maintenanceTimeWindow := NewMaintenanceTimeWindow(maintenanceWindowBegin, maintenanceWindowEnd)
+	for _, opt := range opts {
+		if err := opt(maintenanceTimeWindow); err != nil {
+			return nil, err
+		}
+	}
+	return maintenanceTimeWindow, nil
 }
 
 // String returns the string representation of the time window.
 func (m *MaintenanceTimeWindow) String() string {
-	return fmt.Sprintf("begin=%s, end=%s", m.begin, m.end)
+	out := fmt.Sprintf("begin=%s, end=%s", m.begin, m.end)
+	if m.location != nil {
+		out += fmt.Sprintf(", location=%s", m.location)
+	}
+	if len(m.weekdays) > 0 {
+		var weekdays []string
+		for _, day := range m.weekdays {
+			weekdays = append(weekdays, day.String()[:3])
+		}
+		out += fmt.Sprintf(", weekdays=%s", strings.Join(weekdays, ","))
+	}
+	return out
 }
 
 // Equal returns true if the time windows are the same.
 func (m *MaintenanceTimeWindow) Equal(o *MaintenanceTimeWindow) bool {
-	return m.Begin().Compare(o.Begin()) == 0 && m.End().Compare(o.End()) == 0
+	return m.Begin().Compare(o.Begin()) == 0 &&
+		m.End().Compare(o.End()) == 0 &&
+		m.Location().String() == o.Location().String() &&
+		slices.Equal(m.weekdays, o.weekdays)
 }
 
 // Begin returns the begin of the time window.
@@ -165,24 +252,33 @@ func (m *MaintenanceTimeWindow) End() *MaintenanceTime {
 	return m.end
 }
 
-// WithBegin returns a new maintenance time window with the given <begin> (ending will be kept).
+// Location returns the location in which the time window is evaluated.
+func (m *MaintenanceTimeWindow) Location() *time.Location {
+	if m.location == nil {
+		return time.UTC
+	}
+	return m.location
+}
+
+// Weekdays returns the weekdays on which the time window begins. If empty, the time window begins on every day.
+func (m *MaintenanceTimeWindow) Weekdays() []time.Weekday {
+	return slices.Clone(m.weekdays)
+}
+
+// WithBegin returns a new maintenance time window with the given <begin> (ending, location and weekdays will be kept).
 func (m *MaintenanceTimeWindow) WithBegin(begin *MaintenanceTime) *MaintenanceTimeWindow {
-	return NewMaintenanceTimeWindow(begin, m.end)
+	return &MaintenanceTimeWindow{begin: begin, end: m.end, location: m.location, weekdays: m.weekdays}
 }
 
-// WithEnd returns a new maintenance time window with the given <end> (beginning will be kept).
+// WithEnd returns a new maintenance time window with the given <end> (beginning, location and weekdays will be kept).
 func (m *MaintenanceTimeWindow) WithEnd(end *MaintenanceTime) *MaintenanceTimeWindow {
-	return NewMaintenanceTimeWindow(m.begin, end)
+	return &MaintenanceTimeWindow{begin: m.begin, end: end, location: m.location, weekdays: m.weekdays}
 }
 
 // Contains returns true in case the given time is within the time window.
 func (m *MaintenanceTimeWindow) Contains(tTime time.Time) bool {
-	t := timeToMaintenanceTime(tTime)
-
-	if m.spansDifferentDays() {
-		return t.Compare(m.end) <= 0 || t.Compare(m.begin) >= 0
-	}
-	return t.Compare(m.begin) >= 0 && t.Compare(m.end) <= 0
+	_, _, ok := m.current(tTime)
+	return ok
 }
 
 // RandomFunc is a function that computes a random number.
@@ -197,55 +293,101 @@ var RandomFunc = rand.Int63nRange
 // consequence, this will return a random duration from <from> until the end of the maintenance time window which is
 // shorter than 24h.
 func (m *MaintenanceTimeWindow) RandomDurationUntilNext(from time.Time, shiftBeginToFromIfContained bool) time.Duration {
-	from = from.UTC()
-
-	var (
-		begin = m.AdjustedBegin(from)
-		end   = m.AdjustedEnd(from)
-	)
-
-	if shiftBeginToFromIfContained && m.Contains(from) {
-		dayOffset := 0
-		if from.Hour()-begin.Hour() < 0 {
-			dayOffset = 1
+	if shiftBeginToFromIfContained {
+		if _, end, ok := m.current(from); ok {
+			return time.Duration(RandomFunc(0, end.Sub(from).Nanoseconds()))
 		}
-		begin = from.AddDate(0, 0, dayOffset)
-		from = from.AddDate(0, 0, dayOffset)
-	}
-
-	if begin.Sub(from) < 0 && (m.Contains(from) || from.After(end)) {
-		begin = begin.AddDate(0, 0, 1)
-		end = end.AddDate(0, 0, 1)
 	}
 
-	delta := end.Sub(begin)
-	return time.Duration(int64(begin.Sub(from)) + RandomFunc(0, delta.Nanoseconds()))
+	begin, end := m.next(from)
+	return time.Duration(int64(begin.Sub(from)) + RandomFunc(0, end.Sub(begin).Nanoseconds()))
 }
 
-// Duration returns the duration of the maintenance time window.
+// Duration returns the nominal duration of the maintenance time window, i.e., the duration between its wall clock
+// begin and end times. The actual duration of a single occurrence might differ on days with daylight saving time
+// transitions.
 func (m *MaintenanceTimeWindow) Duration() time.Duration {
-	var (
-		from  = time.Date(0, time.January, 1, 0, 0, 0, 0, time.UTC)
-		begin = m.AdjustedBegin(from)
-		end   = m.AdjustedEnd(from)
-	)
-	return end.Sub(begin)
+	duration := m.end.zeroTime().Sub(m.begin.zeroTime())
+	if duration <= 0 {
+		duration += 24 * time.Hour
+	}
+	return duration
 }
 
-// AdjustedBegin returns the MaintenanceTimeWindow's begin time, projected on the day, month and year given by the parameter t.
+// AdjustedBegin returns the MaintenanceTimeWindow's begin time, projected on the day, month and year given by the
+// parameter t in the location of the time window.
 func (m *MaintenanceTimeWindow) AdjustedBegin(t time.Time) time.Time {
-	return m.begin.adjust(t)
+	return m.begin.adjust(t.In(m.Location()))
 }
 
-// AdjustedEnd returns the MaintenanceWindow's end time, projected on the day, month and year given by the parameter t.
+// AdjustedEnd returns the MaintenanceWindow's end time, projected on the day, month and year given by the parameter t
+// in the location of the time window.
 func (m *MaintenanceTimeWindow) AdjustedEnd(t time.Time) time.Time {
-	end := m.end.adjust(t)
+	end := m.end.adjust(t.In(m.Location()))
 	if m.end.Compare(m.begin) <= 0 {
 		return end.AddDate(0, 0, 1)
 	}
 	return end
 }
 
-func (m *MaintenanceTimeWindow) spansDifferentDays() bool {
-	return m.end.Compare(m.begin) < 0
+// NextBegin returns the begin of the next occurrence of the time window, starting at or after t.
+func (m *MaintenanceTimeWindow) NextBegin(t time.Time) time.Time {
+	begin, _ := m.next(t)
+	return begin
+}
+
+// CurrentEnd returns the end of the occurrence of the time window containing t. If t is not contained in the time
+// window, the end of the next occurrence is returned.
+func (m *MaintenanceTimeWindow) CurrentEnd(t time.Time) time.Time {
+	if _, end, ok := m.current(t); ok {
+		return end
+	}
+	_, end := m.next(t)
+	return end
+}
+
+// current returns the begin and the end of the occurrence of the time window containing t, if any.
+func (m *MaintenanceTimeWindow) current(t time.Time) (time.Time, time.Time, bool) {
+	// The time window is specified with a precision of seconds.
+	t = t.Truncate(time.Second)
+
+	// An occurrence containing t begins either on the same day or on the day before (if it spans different days).
+	for _, day := range []time.Time{m.day(t, -1), m.day(t, 0)} {
+		if !m.beginsOn(day.Weekday()) {
+			continue
+		}
+		if begin, end := m.AdjustedBegin(day), m.AdjustedEnd(day); !t.Before(begin) && !t.After(end) {
+			return begin, end, true
+		}
+	}
+
+	return time.Time{}, time.Time{}, false
+}
+
+// next returns the begin and the end of the next occurrence of the time window beginning at or after t.
+func (m *MaintenanceTimeWindow) next(t time.Time) (time.Time, time.Time) {
+	// The time window begins at least once a week, i.e., the next occurrence begins within the next eight days.
+	for i := 0; i < 8; i++ {
+		day := m.day(t, i)
+		if !m.beginsOn(day.Weekday()) {
+			continue
+		}
+		if begin := m.AdjustedBegin(day); !begin.Before(t) {
+			return begin, m.AdjustedEnd(day)
+		}
+	}
+
+	// unreachable
+	return m.AdjustedBegin(t), m.AdjustedEnd(t)
+}
+
+// day returns noon of the day of t in the location of the time window, shifted by the given number of days. Using noon
+// avoids ambiguities with daylight saving time transitions which usually happen at night.
+func (m *MaintenanceTimeWindow) day(t time.Time, offset int) time.Time {
+	t = t.In(m.Location())
+	return time.Date(t.Year(), t.Month(), t.Day()+offset, 12, 0, 0, 0, m.Location())
+}
+
+func (m *MaintenanceTimeWindow) beginsOn(weekday time.Weekday) bool {
+	return len(m.weekdays) == 0 || slices.Contains(m.weekdays, weekday)
 }
@@ -285,6 +285,122 @@ var _ = Describe("utils", func() {
 			Entry("begin and end on different day (23-0)", from23to0, 1*time.Hour),
 		)
 	})
+
+	Context("MaintenanceTimeWindow with location and weekdays", func() {
+		var (
+			berlin *time.Location
+			// 02:00-04:00 Europe/Berlin on Tuesdays and Thursdays
+			window *MaintenanceTimeWindow
+		)
+
+		BeforeEach(func() {
+			var err error
+			berlin, err = time.LoadLocation("Europe/Berlin")
+			Expect(err).NotTo(HaveOccurred())
+
+			window, err = ParseMaintenanceTimeWindow("020000+0000", "040000+0000", InLocation("Europe/Berlin"), OnWeekdays("Thu", "Tue", "Tue"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Describe("#ParseMaintenanceTimeWindow", func() {
+			It("should parse the location and the weekdays", func() {
+				Expect(window.Location()).To(Equal(berlin))
+				Expect(window.Weekdays()).To(Equal([]time.Weekday{time.Tuesday, time.Thursday}))
+				Expect(window.String()).To(Equal("begin=02:00:00, end=04:00:00, location=Europe/Berlin, weekdays=Tue,Thu"))
+			})
+
+			It("should fail for unknown locations", func() {
+				_, err := ParseMaintenanceTimeWindow("020000+0000", "040000+0000", InLocation("Foo/Bar"))
+				Expect(err).To(MatchError(ContainSubstring(`could not load location "Foo/Bar"`)))
+			})
+
+			It("should fail for unknown weekdays", func() {
+				_, err := ParseMaintenanceTimeWindow("020000+0000", "040000+0000", OnWeekdays("Tuesday"))
+				Expect(err).To(MatchError(ContainSubstring(`unknown weekday "Tuesday"`)))
+			})
+
+			It("should default to UTC and every day", func() {
+				tw, err := ParseMaintenanceTimeWindow("020000+0000", "040000+0000", InLocation(""))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tw.Location()).To(Equal(time.UTC))
+				Expect(tw.Weekdays()).To(BeEmpty())
+			})
+		})
+
+		Describe("#Equal", func() {
+			It("should consider the location and the weekdays", func() {
+				Expect(window.Equal(window.WithEnd(window.End()))).To(BeTrue())
+				Expect(window.Equal(NewMaintenanceTimeWindow(window.Begin(), window.End()))).To(BeFalse())
+			})
+		})
+
+		DescribeTable("#Contains",
+			func(t time.Time, contained bool) {
+				Expect(window.Contains(t)).To(Equal(contained))
+			},
+
+			Entry("Tuesday in summer (CEST), inside", time.Date(2025, time.July, 1, 1, 30, 0, 0, time.UTC), true),
+			Entry("Tuesday in summer (CEST), before", time.Date(2025, time.June, 30, 23, 59, 59, 0, time.UTC), false),
+			Entry("Tuesday in summer (CEST), after", time.Date(2025, time.July, 1, 2, 0, 1, 0, time.UTC), false),
+			Entry("Tuesday in winter (CET), inside", time.Date(2025, time.January, 7, 2, 30, 0, 0, time.UTC), true),
+			Entry("Tuesday in winter (CET), before", time.Date(2025, time.January, 7, 0, 59, 59, 0, time.UTC), false),
+			Entry("Wednesday in winter (CET)", time.Date(2025, time.January, 8, 2, 30, 0, 0, time.UTC), false),
+			Entry("Thursday in winter (CET)", time.Date(2025, time.January, 9, 2, 30, 0, 0, time.UTC), true),
+		)
+
+		It("should follow daylight saving time transitions", func() {
+			// the clocks are turned forward from 02:00 to 03:00 on 2025-03-30 (Sunday) in Europe/Berlin
+			tw, err := ParseMaintenanceTimeWindow("010000+0000", "040000+0000", InLocation("Europe/Berlin"), OnWeekdays("Sun"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(tw.Contains(time.Date(2025, time.March, 30, 0, 0, 0, 0, time.UTC))).To(BeTrue())
+			Expect(tw.Contains(time.Date(2025, time.March, 30, 2, 0, 0, 0, time.UTC))).To(BeTrue())
+			Expect(tw.Contains(time.Date(2025, time.March, 30, 2, 0, 1, 0, time.UTC))).To(BeFalse())
+			Expect(tw.Duration()).To(Equal(3 * time.Hour))
+		})
+
+		It("should handle time windows spanning different days", func() {
+			tw, err := ParseMaintenanceTimeWindow("230000+0000", "010000+0000", InLocation("Europe/Berlin"), OnWeekdays("Mon"))
+			Expect(err).NotTo(HaveOccurred())
+
+			// Tuesday 00:30 CET belongs to the time window beginning on Monday
+			Expect(tw.Contains(time.Date(2025, time.January, 6, 23, 30, 0, 0, time.UTC))).To(BeTrue())
+			// Monday 00:30 CET belongs to the time window beginning on Sunday
+			Expect(tw.Contains(time.Date(2025, time.January, 5, 23, 30, 0, 0, time.UTC))).To(BeFalse())
+		})
+
+		Describe("#RandomDurationUntilNext", func() {
+			BeforeEach(func() {
+				randomFunc := RandomFunc
+				DeferCleanup(func() { RandomFunc = randomFunc })
+				RandomFunc = func(_ int64, delta int64) int64 {
+					return delta
+				}
+			})
+
+			It("should compute the duration until the end of the next time window on one of the weekdays", func() {
+				// Wednesday 12:00 CET, next time window ends on Thursday 04:00 CET
+				Expect(window.RandomDurationUntilNext(time.Date(2025, time.January, 8, 11, 0, 0, 0, time.UTC), false)).To(Equal(16 * time.Hour))
+			})
+
+			It("should compute the duration until the end of the current time window", func() {
+				// Tuesday 03:00 CET
+				Expect(window.RandomDurationUntilNext(time.Date(2025, time.January, 7, 2, 0, 0, 0, time.UTC), true)).To(Equal(time.Hour))
+			})
+
+			It("should skip the current time window", func() {
+				// Tuesday 03:00 CET, next time window ends on Thursday 04:00 CET
+				Expect(window.RandomDurationUntilNext(time.Date(2025, time.January, 7, 2, 0, 0, 0, time.UTC), false)).To(Equal(49 * time.Hour))
+			})
+		})
+
+		It("should return the begin of the next and the end of the current time window", func() {
+			// Tuesday 03:00 CET
+			now := time.Date(2025, time.January, 7, 2, 0, 0, 0, time.UTC)
+			Expect(window.CurrentEnd(now)).To(BeTemporally("==", time.Date(2025, time.January, 7, 3, 0, 0, 0, time.UTC)))
+			Expect(window.NextBegin(now)).To(BeTemporally("==", time.Date(2025, time.January, 9, 1, 0, 0, 0, time.UTC)))
+		})
+	})
 })
 
 func newTime(hour, minute, second, nanosecond int) time.Time {
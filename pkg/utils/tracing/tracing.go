@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tracing contains utilities for setting up OpenTelemetry tracing and for propagating trace contexts via
+// annotations on Kubernetes objects.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	// EnvOTLPEndpoint is the standard environment variable for configuring the OTLP endpoint for all signals.
+	EnvOTLPEndpoint = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	// EnvOTLPTracesEndpoint is the standard environment variable for configuring the OTLP endpoint for traces.
+	EnvOTLPTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+
+	keyTraceParent = "traceparent"
+)
+
+// propagator is the propagator used for injecting and extracting trace contexts into/from object annotations.
+var propagator = propagation.TraceContext{}
+
+// EnabledFromEnvironment returns true if an OTLP endpoint is configured via the standard OpenTelemetry environment
+// variables.
+func EnabledFromEnvironment() bool {
+	return os.Getenv(EnvOTLPEndpoint) != "" || os.Getenv(EnvOTLPTracesEndpoint) != ""
+}
+
+// SetupGlobalTracerProvider creates a TracerProvider exporting spans via OTLP/gRPC and registers it as the global
+// TracerProvider. The exporter is configured via the standard OpenTelemetry environment variables (e.g.
+// OTEL_EXPORTER_OTLP_ENDPOINT). The returned function must be called to flush and shut down the provider.
+// If no OTLP endpoint is configured, tracing is not set up and a no-op shutdown function is returned.
+func SetupGlobalTracerProvider(ctx context.Context, serviceName string) (func(context.Context) error, error) {
+	if !EnabledFromEnvironment() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating tracing resource: %w", err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagator)
+
+	return tracerProvider.Shutdown, nil
+}
+
+// InjectIntoObject stores the trace context of the span in the given context in the GardenerTraceParent annotation
+// of the given object. If the context does not carry a valid span context, the object is not changed.
+func InjectIntoObject(ctx context.Context, obj metav1.Object) {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+
+	if traceParent := carrier.Get(keyTraceParent); traceParent != "" {
+		kubernetesutils.SetMetaDataAnnotation(obj, v1beta1constants.GardenerTraceParent, traceParent)
+	}
+}
+
+// ExtractFromObject returns a copy of the given context which carries the remote span context stored in the
+// GardenerTraceParent annotation of the given object. Spans started with the returned context continue the trace of
+// the operation which requested the reconciliation.
+func ExtractFromObject(ctx context.Context, obj metav1.Object) context.Context {
+	traceParent, ok := obj.GetAnnotations()[v1beta1constants.GardenerTraceParent]
+	if !ok {
+		return ctx
+	}
+
+	return propagator.Extract(ctx, propagation.MapCarrier{keyTraceParent: traceParent})
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/pkg/utils/tracing"
+)
+
+var _ = Describe("Tracing", func() {
+	var (
+		ctx context.Context
+		obj *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		obj = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}}
+	})
+
+	Describe("#InjectIntoObject", func() {
+		It("should not change the object if the context does not carry a span", func() {
+			InjectIntoObject(ctx, obj)
+
+			Expect(obj.Annotations).To(BeEmpty())
+		})
+
+		It("should store the trace context in the annotation", func() {
+			ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(ctx, "test")
+			defer span.End()
+
+			InjectIntoObject(ctx, obj)
+
+			Expect(obj.Annotations).To(HaveKeyWithValue("gardener.cloud/traceparent",
+				"00-"+span.SpanContext().TraceID().String()+"-"+span.SpanContext().SpanID().String()+"-01"))
+		})
+	})
+
+	Describe("#ExtractFromObject", func() {
+		It("should return the given context if the object is not annotated", func() {
+			Expect(ExtractFromObject(ctx, obj)).To(Equal(ctx))
+		})
+
+		It("should continue the trace stored in the annotation", func() {
+			ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(ctx, "test")
+			defer span.End()
+
+			InjectIntoObject(ctx, obj)
+
+			spanContext := trace.SpanContextFromContext(ExtractFromObject(context.Background(), obj))
+			Expect(spanContext.IsRemote()).To(BeTrue())
+			Expect(spanContext.TraceID()).To(Equal(span.SpanContext().TraceID()))
+			Expect(spanContext.SpanID()).To(Equal(span.SpanContext().SpanID()))
+		})
+	})
+})
@@ -34,16 +34,6 @@ import (
 	plugin "github.com/gardener/gardener/plugin/pkg"
 )
 
-var (
-	quotaMetricNames = [6]corev1.ResourceName{
-		core.QuotaMetricCPU,
-		core.QuotaMetricGPU,
-		core.QuotaMetricMemory,
-		core.QuotaMetricStorageStandard,
-		core.QuotaMetricStoragePremium,
-		core.QuotaMetricLoadbalancer}
-)
-
 // Register registers a plugin.
 func Register(plugins *admission.Plugins) {
 	plugins.Register(plugin.PluginNameShootQuotaValidator, func(_ io.Reader) (admission.Interface, error) {
@@ -275,7 +265,7 @@ func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota gardencorev1bet
 	}
 
 	exceededMetrics := make([]corev1.ResourceName, 0)
-	for _, metric := range quotaMetricNames {
+	for _, metric := range gardenerutils.QuotaMetrics {
 		if _, ok := quota.Spec.Metrics[metric]; !ok {
 			continue
 		}
@@ -303,8 +293,8 @@ func (q *QuotaValidator) determineAllocatedResources(quota gardencorev1beta1.Quo
 			return nil, err
 		}
 
-		for _, metric := range quotaMetricNames {
-			allocatedResources[metric] = sumQuantity(allocatedResources[metric], shootResources[metric])
+		for _, metric := range gardenerutils.QuotaMetrics {
+			allocatedResources[metric] = gardenerutils.SumQuantities(allocatedResources[metric], shootResources[metric])
 		}
 	}
 
@@ -399,8 +389,8 @@ func (q *QuotaValidator) determineRequiredResources(allocatedResources corev1.Re
 	}
 
 	requiredResources := make(corev1.ResourceList)
-	for _, metric := range quotaMetricNames {
-		requiredResources[metric] = sumQuantity(allocatedResources[metric], shootResources[metric])
+	for _, metric := range gardenerutils.QuotaMetrics {
+		requiredResources[metric] = gardenerutils.SumQuantities(allocatedResources[metric], shootResources[metric])
 	}
 	return requiredResources, nil
 }
@@ -414,104 +404,12 @@ func (q *QuotaValidator) getShootResources(shoot core.Shoot) (corev1.ResourceLis
 		return nil, fmt.Errorf("no cloudprofile reference has been provided")
 	}
 
-	var (
-		countLB      int64 = 1
-		resources          = make(corev1.ResourceList)
-		workers            = getShootWorkerResources(&shoot, cloudProfileSpec)
-		machineTypes       = cloudProfileSpec.MachineTypes
-		volumeTypes        = cloudProfileSpec.VolumeTypes
-	)
-
-	for _, worker := range workers {
-		var (
-			machineType *gardencorev1beta1.MachineType
-			volumeType  *gardencorev1beta1.VolumeType
-		)
-
-		// Get the proper machineType
-		for _, e := range machineTypes {
-			element := e
-			if element.Name == worker.Machine.Type {
-				machineType = &element
-				break
-			}
-		}
-		if machineType == nil {
-			return nil, fmt.Errorf("machineType %s not found in CloudProfile", worker.Machine.Type)
-		}
-
-		if worker.Volume != nil {
-			if machineType.Storage != nil {
-				volumeType = &gardencorev1beta1.VolumeType{
-					Class: machineType.Storage.Class,
-				}
-			} else {
-				// Get the proper VolumeType
-				for _, e := range volumeTypes {
-					element := e
-					if worker.Volume.Type != nil && element.Name == *worker.Volume.Type {
-						volumeType = &element
-						break
-					}
-				}
-			}
-		}
-		if volumeType == nil {
-			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile", worker.Machine.Type)
-		}
-
-		// For now we always use the max. amount of resources for quota calculation
-		resources[core.QuotaMetricCPU] = sumQuantity(resources[core.QuotaMetricCPU], multiplyQuantity(machineType.CPU, worker.Maximum))
-		resources[core.QuotaMetricGPU] = sumQuantity(resources[core.QuotaMetricGPU], multiplyQuantity(machineType.GPU, worker.Maximum))
-		resources[core.QuotaMetricMemory] = sumQuantity(resources[core.QuotaMetricMemory], multiplyQuantity(machineType.Memory, worker.Maximum))
-
-		size, _ := resource.ParseQuantity("0Gi")
-		if worker.Volume != nil {
-			size, err = resource.ParseQuantity(worker.Volume.VolumeSize)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		switch volumeType.Class {
-		case core.VolumeClassStandard:
-			resources[core.QuotaMetricStorageStandard] = sumQuantity(resources[core.QuotaMetricStorageStandard], multiplyQuantity(size, worker.Maximum))
-		case core.VolumeClassPremium:
-			resources[core.QuotaMetricStoragePremium] = sumQuantity(resources[core.QuotaMetricStoragePremium], multiplyQuantity(size, worker.Maximum))
-		default:
-			return nil, fmt.Errorf("unknown volumeType class %s", volumeType.Class)
-		}
-	}
-
-	if helper.NginxIngressEnabled(shoot.Spec.Addons) {
-		countLB++
-	}
-	resources[core.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
-
-	return resources, nil
-}
-
-func getShootWorkerResources(shoot *core.Shoot, cloudProfile *gardencorev1beta1.CloudProfileSpec) []core.Worker {
-	workers := make([]core.Worker, 0, len(shoot.Spec.Provider.Workers))
-
-	for _, worker := range shoot.Spec.Provider.Workers {
-		workerCopy := worker.DeepCopy()
-
-		if worker.Volume == nil {
-			for _, machineType := range cloudProfile.MachineTypes {
-				if worker.Machine.Type == machineType.Name && machineType.Storage != nil && machineType.Storage.StorageSize != nil {
-					workerCopy.Volume = &core.Volume{
-						Type:       &machineType.Storage.Type,
-						VolumeSize: machineType.Storage.StorageSize.String(),
-					}
-				}
-			}
-		}
-
-		workers = append(workers, *workerCopy)
+	v1beta1Shoot := &gardencorev1beta1.Shoot{}
+	if err := gardencorev1beta1.Convert_core_Shoot_To_v1beta1_Shoot(&shoot, v1beta1Shoot, nil); err != nil {
+		return nil, apierrors.NewInternalError(err)
 	}
 
-	return workers
+	return gardenerutils.ComputeShootQuotaResources(v1beta1Shoot, cloudProfileSpec)
 }
 
 func lifetimeVerificationNeeded(new, old core.Shoot) bool {
@@ -554,19 +452,3 @@ func hasSufficientQuota(limit, required resource.Quantity) bool {
 	compareCode := limit.Cmp(required)
 	return compareCode != -1
 }
-
-func sumQuantity(values ...resource.Quantity) resource.Quantity {
-	res := resource.Quantity{}
-	for _, v := range values {
-		res.Add(v)
-	}
-	return res
-}
-
-func multiplyQuantity(quantity resource.Quantity, multiplier int32) resource.Quantity {
-	res := resource.Quantity{}
-	for i := 0; i < int(multiplier); i++ {
-		res.Add(quantity)
-	}
-	return res
-}
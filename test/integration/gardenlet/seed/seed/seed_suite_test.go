@@ -28,6 +28,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/gardenlet/features"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerenvtest "github.com/gardener/gardener/test/envtest"
 )
 
@@ -62,6 +63,8 @@ var _ = BeforeSuite(func() {
 	log = logf.Log.WithName(testID)
 
 	features.RegisterFeatureGates()
+	// Record the flow executions, so that the tests can validate the executed flows.
+	flow.EnableRecording()
 
 	By("Start test environment")
 	testEnv = &gardenerenvtest.GardenerTestEnvironment{
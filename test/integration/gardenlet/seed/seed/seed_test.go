@@ -48,6 +48,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	seedcontroller "github.com/gardener/gardener/pkg/gardenlet/controller/seed/seed"
+	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/retry"
@@ -748,6 +749,11 @@ var _ = Describe("Seed controller tests", func() {
 					g.Expect(seed.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
 				}).Should(Succeed())
 
+				By("Validate the executed reconciliation flow")
+				reconciliation, ok := flow.LastExecution("Seed reconciliation", seed.Name)
+				Expect(ok).To(BeTrue())
+				Expect(reconciliation.Issues).NotTo(ContainElement(HaveField("Type", flow.IssueTypeUnreachable)))
+
 				By("Delete Seed")
 				Expect(testClient.Delete(ctx, seed)).To(Succeed())
 
@@ -789,6 +795,11 @@ var _ = Describe("Seed controller tests", func() {
 				Eventually(func() error {
 					return testClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)
 				}).Should(BeNotFoundError())
+
+				By("Validate the executed deletion flow")
+				deletion, ok := flow.LastExecution("Seed deletion", seed.Name)
+				Expect(ok).To(BeTrue())
+				Expect(deletion.Issues).NotTo(ContainElement(HaveField("Type", flow.IssueTypeUnreachable)))
 			}
 
 			It("should properly maintain the last operation and deploy all seed system components", func() {